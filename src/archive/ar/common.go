@@ -0,0 +1,153 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package ar implements access to ar archives.
+//
+// The ar format is a flat sequence of files that can be read and written
+// in a streaming manner. It is used for static libraries (.a files) and
+// Debian packages (.deb files). There is no standard for the format;
+// this package reads the common variants, including the long names of
+// GNU and BSD ar, and writes archives that both can read.
+// Thin archives are not supported.
+package ar
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"time"
+)
+
+var (
+	ErrHeader          = errors.New("archive/ar: invalid ar header")
+	ErrWriteTooLong    = errors.New("archive/ar: write too long")
+	ErrFieldTooLong    = errors.New("archive/ar: header field too long")
+	ErrWriteAfterClose = errors.New("archive/ar: write after close")
+)
+
+const (
+	magic      = "!<arch>\n"
+	headerSize = 60
+	headerMag  = "`\n"
+
+	// bsdNamePrefix starts the name of an entry whose real name is
+	// stored at the start of its data, as BSD ar does for long names.
+	bsdNamePrefix = "#1/"
+)
+
+// Names of the GNU and System V symbol tables, with 32-bit and 64-bit
+// offsets, that linkers use to look up the members of a static library.
+const (
+	symbolTableName   = "/"
+	symbolTable64Name = "/SYM64/"
+)
+
+// A Header represents a single header in an ar archive.
+// Some fields may not be populated.
+type Header struct {
+	Name    string    // Name of file entry
+	ModTime time.Time // Modification time, in seconds
+	Uid     int       // User ID of owner
+	Gid     int       // Group ID of owner
+
+	// Mode holds the permission and mode bits, including the file type
+	// bits of the st_mode field of a Unix stat structure.
+	Mode int64
+
+	Size int64 // Logical file size in bytes
+}
+
+// FileInfo returns an fs.FileInfo for the Header.
+func (h *Header) FileInfo() fs.FileInfo {
+	return headerFileInfo{h}
+}
+
+// headerFileInfo implements fs.FileInfo.
+type headerFileInfo struct {
+	h *Header
+}
+
+func (fi headerFileInfo) Size() int64        { return fi.h.Size }
+func (fi headerFileInfo) IsDir() bool        { return false }
+func (fi headerFileInfo) ModTime() time.Time { return fi.h.ModTime }
+func (fi headerFileInfo) Sys() any           { return fi.h }
+
+// Name returns the base name of the file.
+func (fi headerFileInfo) Name() string {
+	return path.Base(fi.h.Name)
+}
+
+// Mode returns the permission and mode bits for the headerFileInfo.
+// Every entry of an ar archive is a regular file.
+func (fi headerFileInfo) Mode() fs.FileMode {
+	mode := fs.FileMode(fi.h.Mode).Perm()
+	if fi.h.Mode&c_ISUID != 0 {
+		mode |= fs.ModeSetuid
+	}
+	if fi.h.Mode&c_ISGID != 0 {
+		mode |= fs.ModeSetgid
+	}
+	if fi.h.Mode&c_ISVTX != 0 {
+		mode |= fs.ModeSticky
+	}
+	return mode
+}
+
+func (fi headerFileInfo) String() string {
+	return fs.FormatFileInfo(fi)
+}
+
+const (
+	c_ISUID = 04000   // Set uid
+	c_ISGID = 02000   // Set gid
+	c_ISVTX = 01000   // Save text (sticky bit)
+	c_ISREG = 0100000 // Regular file
+)
+
+// sysStat, if non-nil, populates h from system-dependent fields of fi.
+var sysStat func(fi fs.FileInfo, h *Header) error
+
+// FileInfoHeader creates a partially-populated [Header] from fi,
+// which must describe a regular file.
+//
+// Since fs.FileInfo's Name method only returns the base name of
+// the file it describes, it may be necessary to modify Header.Name
+// to provide the full path name of the file.
+func FileInfoHeader(fi fs.FileInfo) (*Header, error) {
+	if fi == nil {
+		return nil, errors.New("archive/ar: FileInfo is nil")
+	}
+	fm := fi.Mode()
+	if !fm.IsRegular() {
+		return nil, fmt.Errorf("archive/ar: cannot add non-regular file with mode %v", fm)
+	}
+	h := &Header{
+		Name:    fi.Name(),
+		ModTime: fi.ModTime(),
+		Mode:    c_ISREG | int64(fm.Perm()),
+		Size:    fi.Size(),
+	}
+	if fm&fs.ModeSetuid != 0 {
+		h.Mode |= c_ISUID
+	}
+	if fm&fs.ModeSetgid != 0 {
+		h.Mode |= c_ISGID
+	}
+	if fm&fs.ModeSticky != 0 {
+		h.Mode |= c_ISVTX
+	}
+	if sys, ok := fi.Sys().(*Header); ok {
+		// This FileInfo came from a Header (not the OS). Use the
+		// original Header to populate all remaining fields.
+		h.Uid = sys.Uid
+		h.Gid = sys.Gid
+		h.Mode = sys.Mode
+		return h, nil
+	}
+	if sysStat != nil {
+		return h, sysStat(fi, h)
+	}
+	return h, nil
+}
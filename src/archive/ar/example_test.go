@@ -0,0 +1,66 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ar_test
+
+import (
+	"archive/ar"
+	"bytes"
+	"fmt"
+	"io"
+	"log"
+	"time"
+)
+
+func Example_minimal() {
+	// Create an archive laid out like a Debian package.
+	var buf bytes.Buffer
+	aw := ar.NewWriter(&buf)
+	var files = []struct {
+		Name, Body string
+	}{
+		{"debian-binary", "2.0\n"},
+		{"control.tar.gz", "(compressed control files)"},
+		{"data.tar.gz", "(compressed data files)"},
+	}
+	for _, file := range files {
+		hdr := &ar.Header{
+			Name:    file.Name,
+			ModTime: time.Unix(1700000000, 0),
+			Mode:    0100644,
+			Size:    int64(len(file.Body)),
+		}
+		if err := aw.WriteHeader(hdr); err != nil {
+			log.Fatal(err)
+		}
+		if _, err := aw.Write([]byte(file.Body)); err != nil {
+			log.Fatal(err)
+		}
+	}
+	if err := aw.Close(); err != nil {
+		log.Fatal(err)
+	}
+
+	// Open and iterate through the files in the archive.
+	r := ar.NewReader(&buf)
+	for {
+		hdr, err := r.Next()
+		if err == io.EOF {
+			break // End of archive
+		}
+		if err != nil {
+			log.Fatal(err)
+		}
+		b, err := io.ReadAll(r)
+		if err != nil {
+			log.Fatal(err)
+		}
+		fmt.Printf("%s: %q\n", hdr.Name, b)
+	}
+
+	// Output:
+	// debian-binary: "2.0\n"
+	// control.tar.gz: "(compressed control files)"
+	// data.tar.gz: "(compressed data files)"
+}
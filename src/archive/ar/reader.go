@@ -0,0 +1,243 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ar
+
+import (
+	"bytes"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// maxNameTableSize limits the size of the GNU long name table and of BSD
+// long names, which are read into memory.
+const maxNameTableSize = 1 << 24
+
+// Reader provides sequential access to the contents of an ar archive.
+// Reader.Next advances to the next file in the archive (including the first),
+// and then Reader can be treated as an io.Reader to access the file's data.
+type Reader struct {
+	r       io.Reader
+	nb      int64                // Number of remaining bytes of the current file entry
+	pad     int64                // Amount of padding (ignored) after current file entry
+	started bool                 // Whether the global header has been read
+	names   []byte               // GNU long name table
+	blk     [1 + headerSize]byte // Padding byte and header
+
+	// err is a persistent error.
+	// It is only the responsibility of every exported method of Reader to
+	// ensure that this error is sticky.
+	err error
+}
+
+// NewReader creates a new [Reader] reading from r.
+func NewReader(r io.Reader) *Reader {
+	return &Reader{r: r}
+}
+
+// Next advances to the next entry in the ar archive.
+// The Header.Size determines how many bytes can be read for the next file.
+// Any remaining data in the current file is automatically discarded.
+// At the end of the archive, Next returns the error io.EOF.
+//
+// Next resolves the long names of GNU and BSD ar, and does not return the
+// GNU long name table itself. The symbol tables of static libraries are
+// returned as ordinary entries, with names such as "/" and "/SYM64/" for
+// GNU ar and "__.SYMDEF" for BSD ar.
+func (ar *Reader) Next() (*Header, error) {
+	if ar.err != nil {
+		return nil, ar.err
+	}
+	hdr, err := ar.next()
+	ar.err = err
+	return hdr, err
+}
+
+func (ar *Reader) next() (*Header, error) {
+	if !ar.started {
+		b := ar.blk[:len(magic)]
+		if _, err := io.ReadFull(ar.r, b); err != nil {
+			return nil, err // EOF is okay here; exactly 0 bytes read
+		}
+		if string(b) != magic {
+			return nil, ErrHeader
+		}
+		ar.started = true
+	}
+	for {
+		if err := discard(ar.r, ar.nb); err != nil {
+			return nil, err
+		}
+		ar.nb = 0
+
+		// The padding after the last file is sometimes missing,
+		// so read it together with the header.
+		b := ar.blk[:ar.pad+headerSize]
+		if n, err := io.ReadFull(ar.r, b); err != nil {
+			if int64(n) <= ar.pad && (err == io.EOF || err == io.ErrUnexpectedEOF) {
+				return nil, io.EOF
+			}
+			return nil, unexpectedEOF(err)
+		}
+		b = b[ar.pad:]
+		ar.pad = 0
+
+		hdr, err := parseHeader(b)
+		if err != nil {
+			return nil, err
+		}
+		ar.nb = hdr.Size
+		ar.pad = hdr.Size & 1
+
+		switch name := hdr.Name; {
+		case name == "//":
+			// The GNU long name table, which must be read before the
+			// entries that use it.
+			if hdr.Size > maxNameTableSize {
+				return nil, ErrHeader
+			}
+			ar.names = make([]byte, hdr.Size)
+			if _, err := io.ReadFull(ar.r, ar.names); err != nil {
+				return nil, unexpectedEOF(err)
+			}
+			ar.nb = 0
+			continue
+		case name == symbolTableName || name == symbolTable64Name:
+		case strings.HasPrefix(name, bsdNamePrefix):
+			n, err := strconv.ParseInt(name[len(bsdNamePrefix):], 10, 64)
+			if err != nil || n < 0 || n > hdr.Size || n > maxNameTableSize {
+				return nil, ErrHeader
+			}
+			b := make([]byte, n)
+			if _, err := io.ReadFull(ar.r, b); err != nil {
+				return nil, unexpectedEOF(err)
+			}
+			// BSD ar may pad the name with NULs.
+			hdr.Name = string(bytes.TrimRight(b, "\x00"))
+			hdr.Size -= n
+			ar.nb = hdr.Size
+		case name[0] == '/':
+			// A reference to the GNU long name table.
+			off, err := strconv.ParseInt(name[1:], 10, 64)
+			if err != nil || off < 0 || off >= int64(len(ar.names)) {
+				return nil, ErrHeader
+			}
+			long := ar.names[off:]
+			if i := bytes.IndexByte(long, '\n'); i >= 0 {
+				long = long[:i]
+			}
+			hdr.Name = strings.TrimSuffix(string(long), "/")
+		default:
+			// GNU ar ends names with a slash so that they may contain
+			// spaces.
+			hdr.Name = strings.TrimSuffix(name, "/")
+		}
+		if hdr.Name == "" {
+			return nil, ErrHeader
+		}
+		return hdr, nil
+	}
+}
+
+// parseHeader parses the header of an entry. The name is returned
+// without its trailing spaces, and is not otherwise interpreted.
+func parseHeader(b []byte) (*Header, error) {
+	if string(b[58:60]) != headerMag {
+		return nil, ErrHeader
+	}
+	name := strings.TrimRight(string(b[0:16]), " ")
+	mtime, ok1 := parseNumber(b[16:28], 10)
+	uid, ok2 := parseNumber(b[28:34], 10)
+	gid, ok3 := parseNumber(b[34:40], 10)
+	mode, ok4 := parseNumber(b[40:48], 8)
+	size, ok5 := parseNumber(b[48:58], 10)
+	if name == "" || !ok1 || !ok2 || !ok3 || !ok4 || !ok5 {
+		return nil, ErrHeader
+	}
+	return &Header{
+		Name:    name,
+		ModTime: time.Unix(mtime, 0),
+		Uid:     int(uid),
+		Gid:     int(gid),
+		Mode:    mode,
+		Size:    size,
+	}, nil
+}
+
+// parseNumber parses a left-aligned, space-padded number in base 8 or
+// 10. A field of only spaces is zero, as in the GNU long name table.
+func parseNumber(b []byte, base int) (int64, bool) {
+	s := strings.TrimRight(string(b), " ")
+	if s == "" {
+		return 0, true
+	}
+	v, err := strconv.ParseInt(s, base, 64)
+	return v, err == nil && v >= 0
+}
+
+// Read reads from the current file in the ar archive.
+// It returns (0, io.EOF) when it reaches the end of that file,
+// until [Reader.Next] is called to advance to the next file.
+func (ar *Reader) Read(b []byte) (int, error) {
+	if ar.err != nil {
+		return 0, ar.err
+	}
+	if ar.nb == 0 {
+		return 0, io.EOF
+	}
+	if int64(len(b)) > ar.nb {
+		b = b[:ar.nb]
+	}
+	n, err := ar.r.Read(b)
+	ar.nb -= int64(n)
+	switch {
+	case err == io.EOF && ar.nb > 0:
+		err = io.ErrUnexpectedEOF
+	case err == io.EOF:
+		err = nil
+	}
+	if err != nil {
+		ar.err = err
+	}
+	return n, err
+}
+
+func unexpectedEOF(err error) error {
+	if err == io.EOF {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
+
+// discard skips n bytes in r, reporting an error if unable to do so.
+func discard(r io.Reader, n int64) error {
+	// If possible, Seek to the last byte before the end of the data section.
+	// Do this because Seek is often lazy about reporting errors; this will mask
+	// the fact that the stream may be truncated. We can rely on the
+	// io.CopyN done shortly afterwards to trigger any IO errors.
+	var seekSkipped int64 // Number of bytes skipped via Seek
+	if sr, ok := r.(io.Seeker); ok && n > 1 {
+		// Not all io.Seeker can actually Seek. For example, os.Stdin implements
+		// io.Seeker, but calling Seek always returns an error and performs
+		// no action. Thus, we try an innocent seek to the current position
+		// to see if Seek is really supported.
+		pos1, err := sr.Seek(0, io.SeekCurrent)
+		if pos1 >= 0 && err == nil {
+			// Seek seems supported, so perform the real Seek.
+			pos2, err := sr.Seek(n-1, io.SeekCurrent)
+			if pos2 < 0 || err != nil {
+				return err
+			}
+			seekSkipped = pos2 - pos1
+		}
+	}
+
+	copySkipped, err := io.CopyN(io.Discard, r, n-seekSkipped)
+	if err == io.EOF && seekSkipped+copySkipped < n {
+		err = io.ErrUnexpectedEOF
+	}
+	return err
+}
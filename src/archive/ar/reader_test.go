@@ -0,0 +1,179 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ar
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestReader(t *testing.T) {
+	mtime := time.Unix(1700000000, 0)
+	vectors := []struct {
+		file     string
+		headers  []*Header
+		contents []string
+	}{{
+		// Written by GNU ar, with a long name table.
+		file: "testdata/gnu.a",
+		headers: []*Header{
+			{Name: "short.txt", ModTime: mtime, Mode: 0100644, Size: 11},
+			{Name: "a-very-long-file-name.txt", ModTime: mtime, Mode: 0100644, Size: 3},
+			{Name: "debian-binary", ModTime: mtime, Mode: 0100644, Size: 4},
+		},
+		contents: []string{"Hello, ar!\n", "odd", "2.0\n"},
+	}, {
+		// Written by bsdtar, with long names before the file data.
+		file: "testdata/bsd.a",
+		headers: []*Header{
+			{Name: "short.txt", ModTime: mtime, Uid: 1000, Gid: 1000, Mode: 0100644, Size: 11},
+			{Name: "a-very-long-file-name.txt", ModTime: mtime, Uid: 1000, Gid: 1000, Mode: 0100644, Size: 3},
+			{Name: "debian-binary", ModTime: mtime, Uid: 1000, Gid: 1000, Mode: 0100644, Size: 4},
+		},
+		contents: []string{"Hello, ar!\n", "odd", "2.0\n"},
+	}, {
+		// A static library written by GNU ar in deterministic mode,
+		// with a symbol table.
+		file: "testdata/lib.a",
+		headers: []*Header{
+			{Name: "/", ModTime: time.Unix(0, 0), Size: 14},
+			{Name: "hello-world-object.o", ModTime: time.Unix(0, 0), Mode: 0644, Size: 1104},
+		},
+		contents: []string{"\x00\x00\x00\x01\x00\x00\x00\xa4hello\x00", ""},
+	}}
+
+	for _, v := range vectors {
+		t.Run(v.file, func(t *testing.T) {
+			f, err := os.Open(v.file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer f.Close()
+			ar := NewReader(f)
+			for i := 0; ; i++ {
+				hdr, err := ar.Next()
+				if err == io.EOF {
+					if i != len(v.headers) {
+						t.Errorf("got %d headers, want %d", i, len(v.headers))
+					}
+					break
+				}
+				if err != nil {
+					t.Fatalf("Next: %v", err)
+				}
+				if i >= len(v.headers) {
+					t.Fatalf("unexpected header %+v", hdr)
+				}
+				if !reflect.DeepEqual(hdr, v.headers[i]) {
+					t.Errorf("entry %d:\ngot  %+v\nwant %+v", i, hdr, v.headers[i])
+				}
+				if v.contents[i] == "" {
+					continue // Skip the data.
+				}
+				b, err := io.ReadAll(ar)
+				if err != nil {
+					t.Fatalf("entry %d: ReadAll: %v", i, err)
+				}
+				if string(b) != v.contents[i] {
+					t.Errorf("entry %d: got %q, want %q", i, b, v.contents[i])
+				}
+			}
+		})
+	}
+}
+
+func TestReaderErrors(t *testing.T) {
+	b, err := os.ReadFile("testdata/gnu.a")
+	if err != nil {
+		t.Fatal(err)
+	}
+	gnu := string(b)
+	// The archive has the global header, the long name table, and the
+	// header of "short.txt" at offset 96, followed by its data.
+	tests := []struct {
+		name string
+		data string
+		want error
+	}{
+		{"empty", "", io.EOF},
+		{"global header only", magic, io.EOF},
+		{"thin archive", "!<thin>\n" + gnu[8:], ErrHeader},
+		{"truncated global header", gnu[:5], io.ErrUnexpectedEOF},
+		{"truncated header", gnu[:130], io.ErrUnexpectedEOF},
+		{"bad header magic", strings.Replace(gnu, "`\n", "`x", 1), ErrHeader},
+		{"bad size", strings.Replace(gnu, "11        `", "1x        `", 1), ErrHeader},
+		{"bad long name", strings.Replace(gnu, "/0     ", "/99    ", 1), ErrHeader},
+		{"missing padding", magic + header("odd", 3) + "odd", io.EOF},
+		{"truncated padding", magic + header("odd", 3) + "odd\n" + header("odd", 3)[:10], io.ErrUnexpectedEOF},
+	}
+	for _, tt := range tests {
+		ar := NewReader(strings.NewReader(tt.data))
+		var err error
+		for err == nil {
+			_, err = ar.Next()
+		}
+		if err != tt.want {
+			t.Errorf("%s: got %v, want %v", tt.name, err, tt.want)
+		}
+	}
+
+	// Truncated file data.
+	ar := NewReader(strings.NewReader(gnu[:160]))
+	if _, err := ar.Next(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := io.ReadAll(ar); err != io.ErrUnexpectedEOF {
+		t.Errorf("ReadAll of truncated data: %v, want %v", err, io.ErrUnexpectedEOF)
+	}
+	if _, err := ar.Next(); err != io.ErrUnexpectedEOF {
+		t.Errorf("Next after truncated data: %v, want %v", err, io.ErrUnexpectedEOF)
+	}
+}
+
+func TestHeaderFileInfo(t *testing.T) {
+	mtime := time.Unix(1700000000, 0)
+	h := &Header{Name: "lib/libfoo.a", ModTime: mtime, Uid: 1000, Mode: 0104755, Size: 42}
+	fi := h.FileInfo()
+	if fi.Name() != "libfoo.a" || fi.Mode().String() != "urwxr-xr-x" || fi.Size() != 42 || !fi.ModTime().Equal(mtime) || fi.IsDir() {
+		t.Errorf("FileInfo = %v", fi)
+	}
+	h2, err := FileInfoHeader(fi)
+	if err != nil {
+		t.Fatal(err)
+	}
+	h.Name = "libfoo.a"
+	if !reflect.DeepEqual(h2, h) {
+		t.Errorf("FileInfoHeader(FileInfo) = %+v, want %+v", h2, h)
+	}
+
+	fi, err = os.Stat("testdata")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := FileInfoHeader(fi); err == nil {
+		t.Error("FileInfoHeader of a directory succeeded")
+	}
+	fi, err = os.Stat("testdata/gnu.a")
+	if err != nil {
+		t.Fatal(err)
+	}
+	h, err = FileInfoHeader(fi)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if h.Name != "gnu.a" || h.Size != fi.Size() || h.Mode&^0777 != c_ISREG {
+		t.Errorf("FileInfoHeader(%v) = %+v", fi, h)
+	}
+}
+
+// header returns the header of an entry with the given short name and size.
+func header(name string, size int) string {
+	return fmt.Sprintf("%-16s%-12d%-6d%-6d%-8o%-10d`\n", name, 0, 0, 0, 0644, size)
+}
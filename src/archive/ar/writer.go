@@ -0,0 +1,206 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ar
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"strconv"
+	"strings"
+)
+
+// Writer provides sequential writing of an ar archive.
+// [Writer.WriteHeader] begins a new file with the provided [Header],
+// and then Writer can be treated as an io.Writer to supply that file's data.
+type Writer struct {
+	w       io.Writer
+	nb      int64 // Number of remaining bytes to write of the current file entry
+	pad     int64 // Amount of padding to write after current file entry
+	started bool  // Whether the global header has been written
+
+	// err is a persistent error.
+	// It is only the responsibility of every exported method of Writer to
+	// ensure that this error is sticky.
+	err error
+}
+
+// NewWriter creates a new Writer writing to w.
+func NewWriter(w io.Writer) *Writer {
+	return &Writer{w: w}
+}
+
+// Flush finishes writing the current file's padding.
+// The current file must be fully written before Flush can be called.
+//
+// This is unnecessary as the next call to [Writer.WriteHeader] or [Writer.Close]
+// will implicitly flush out the file's padding.
+func (aw *Writer) Flush() error {
+	if aw.err != nil {
+		return aw.err
+	}
+	if aw.nb > 0 {
+		return fmt.Errorf("archive/ar: missed writing %d bytes", aw.nb)
+	}
+	if !aw.started {
+		if _, aw.err = io.WriteString(aw.w, magic); aw.err != nil {
+			return aw.err
+		}
+		aw.started = true
+	}
+	if _, aw.err = io.WriteString(aw.w, "\n"[:aw.pad]); aw.err != nil {
+		return aw.err
+	}
+	aw.pad = 0
+	return nil
+}
+
+// WriteHeader writes hdr and prepares to accept the file's contents.
+// The Header.Size determines how many bytes can be written for the next file.
+// If the current file is not fully written, then this returns an error.
+// This implicitly flushes any padding necessary before writing the header.
+//
+// Names of up to 16 bytes that contain no spaces or slashes are written
+// in the header, as both GNU and BSD ar do. Other names are written
+// before the file data, as BSD ar does for long names, which GNU ar
+// and LLVM also read. The names "/" and "/SYM64/" of GNU symbol tables
+// are written as is.
+func (aw *Writer) WriteHeader(hdr *Header) error {
+	if err := aw.Flush(); err != nil {
+		return err
+	}
+	name, long := hdr.Name, ""
+	switch {
+	case name == "":
+		return errors.New("archive/ar: empty name")
+	case strings.IndexByte(name, 0) >= 0 || strings.IndexByte(name, '\n') >= 0:
+		return fmt.Errorf("archive/ar: invalid name %q", name)
+	case name == symbolTableName || name == symbolTable64Name:
+	case len(name) > 16 || strings.ContainsAny(name, " /"):
+		long = name
+		name = bsdNamePrefix + strconv.Itoa(len(long))
+	}
+
+	if hdr.Size < 0 {
+		return fmt.Errorf("archive/ar: negative Size %d", hdr.Size)
+	}
+	var mtime int64
+	if !hdr.ModTime.IsZero() {
+		mtime = hdr.ModTime.Unix()
+	}
+	size := hdr.Size + int64(len(long))
+	var b [headerSize]byte
+	for i := range b {
+		b[i] = ' '
+	}
+	copy(b[0:16], name)
+	for _, f := range []struct {
+		name       string
+		v          int64
+		base       int
+		start, end int
+	}{
+		{"ModTime", mtime, 10, 16, 28},
+		{"Uid", int64(hdr.Uid), 10, 28, 34},
+		{"Gid", int64(hdr.Gid), 10, 34, 40},
+		{"Mode", hdr.Mode, 8, 40, 48},
+		{"Size", size, 10, 48, 58},
+	} {
+		s := strconv.FormatInt(f.v, f.base)
+		if f.v < 0 || len(s) > f.end-f.start {
+			return fmt.Errorf("%w: %s", ErrFieldTooLong, f.name)
+		}
+		copy(b[f.start:f.end], s)
+	}
+	copy(b[58:], headerMag)
+	if _, aw.err = aw.w.Write(b[:]); aw.err != nil {
+		return aw.err
+	}
+	if _, aw.err = io.WriteString(aw.w, long); aw.err != nil {
+		return aw.err
+	}
+	aw.nb = hdr.Size
+	aw.pad = size & 1
+	return nil
+}
+
+// AddFS adds the files from fs.FS to the archive.
+// It walks the directory tree starting at the root of the filesystem
+// adding each regular file to the ar archive, with its path as its name.
+// Since the archive is flat, directories are not added.
+func (aw *Writer) AddFS(fsys fs.FS) error {
+	return fs.WalkDir(fsys, ".", func(name string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		if !info.Mode().IsRegular() {
+			return errors.New("archive/ar: cannot add non-regular file")
+		}
+		h, err := FileInfoHeader(info)
+		if err != nil {
+			return err
+		}
+		h.Name = name
+		if err := aw.WriteHeader(h); err != nil {
+			return err
+		}
+		f, err := fsys.Open(name)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		_, err = io.Copy(aw, f)
+		return err
+	})
+}
+
+// Write writes to the current file in the ar archive.
+// Write returns the error [ErrWriteTooLong] if more than
+// Header.Size bytes are written after [Writer.WriteHeader].
+func (aw *Writer) Write(b []byte) (int, error) {
+	if aw.err != nil {
+		return 0, aw.err
+	}
+	overwrite := int64(len(b)) > aw.nb
+	if overwrite {
+		b = b[:aw.nb]
+	}
+	n, err := aw.w.Write(b)
+	aw.nb -= int64(n)
+	if err != nil {
+		aw.err = err
+		return n, err
+	}
+	if overwrite {
+		return n, ErrWriteTooLong
+	}
+	return n, nil
+}
+
+// Close closes the ar archive by flushing the padding.
+// An archive without entries consists of only the global header.
+// If the current file (from a prior call to [Writer.WriteHeader]) is not
+// fully written, then this returns an error.
+func (aw *Writer) Close() error {
+	if aw.err == ErrWriteAfterClose {
+		return nil
+	}
+	if aw.err != nil {
+		return aw.err
+	}
+	err := aw.Flush()
+
+	// Ensure all future actions are invalid.
+	aw.err = ErrWriteAfterClose
+	return err // Report IO errors
+}
@@ -0,0 +1,204 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ar
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+	"testing/fstest"
+	"time"
+)
+
+// TestWriterTestdata checks that writing the entries of an archive
+// written by bsdtar reproduces it.
+func TestWriterTestdata(t *testing.T) {
+	want, err := os.ReadFile("testdata/bsd.a")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	ar := NewReader(bytes.NewReader(want))
+	aw := NewWriter(&buf)
+	for {
+		hdr, err := ar.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := aw.WriteHeader(hdr); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := io.Copy(aw, ar); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := aw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if got := buf.Bytes(); !bytes.Equal(got, want) {
+		t.Errorf("output differs from testdata/bsd.a:\ngot  %q\nwant %q", got, want)
+	}
+}
+
+func TestWriterRoundTrip(t *testing.T) {
+	mtime := time.Unix(1700000000, 0)
+	headers := []*Header{
+		{Name: "/", ModTime: time.Unix(0, 0), Size: 4},
+		{Name: "debian-binary", Mode: 0100644, Size: 4},
+		{Name: "exactly-16-bytes", Mode: 0100644, Size: 1},
+		{Name: "a name with spaces", Mode: 0100600, Size: 5},
+		{Name: "dir/file", Uid: 1000, Gid: 1000, Mode: 0100755, Size: 0},
+		{Name: "#1/3", Mode: 0100644, Size: 2},
+		{Name: "trailing/", Mode: 0100644, Size: 3},
+	}
+	contents := []string{"\x00\x00\x00\x00", "2.0\n", "x", "hello", "", "ab", "end"}
+	var buf bytes.Buffer
+	aw := NewWriter(&buf)
+	for i, h := range headers {
+		if h.ModTime.IsZero() {
+			h.ModTime = mtime
+		}
+		if err := aw.WriteHeader(h); err != nil {
+			t.Fatalf("WriteHeader(%q): %v", h.Name, err)
+		}
+		if _, err := io.WriteString(aw, contents[i]); err != nil {
+			t.Fatalf("Write(%q): %v", h.Name, err)
+		}
+	}
+	if err := aw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(buf.String(), "debian-binary   1700000000  0     0     100644  4         `\n2.0\n") {
+		t.Errorf("debian-binary does not have a short name:\n%s", buf.String())
+	}
+
+	ar := NewReader(&buf)
+	for i, want := range headers {
+		got, err := ar.Next()
+		if err != nil {
+			t.Fatalf("Next: %v", err)
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("got %+v, want %+v", got, want)
+		}
+		if b, err := io.ReadAll(ar); err != nil || string(b) != contents[i] {
+			t.Errorf("ReadAll(%q) = (%q, %v), want %q", want.Name, b, err, contents[i])
+		}
+	}
+	if _, err := ar.Next(); err != io.EOF {
+		t.Errorf("Next at the end: %v, want io.EOF", err)
+	}
+}
+
+func TestWriterErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		h    Header
+		want error
+	}{
+		{"empty name", Header{}, nil},
+		{"newline in name", Header{Name: "a\nb"}, nil},
+		{"negative size", Header{Name: "file", Size: -1}, nil},
+		{"negative uid", Header{Name: "file", Uid: -1}, ErrFieldTooLong},
+		{"large uid", Header{Name: "file", Uid: 1000000}, ErrFieldTooLong},
+		{"large mode", Header{Name: "file", Mode: 1 << 24}, ErrFieldTooLong},
+		{"large size", Header{Name: "file", Size: 1e10}, ErrFieldTooLong},
+		{"large size with name", Header{Name: strings.Repeat("x", 20), Size: 1e10 - 10}, ErrFieldTooLong},
+	}
+	for _, tt := range tests {
+		aw := NewWriter(io.Discard)
+		err := aw.WriteHeader(&tt.h)
+		if err == nil || tt.want != nil && !errors.Is(err, tt.want) {
+			t.Errorf("%s: WriteHeader = %v, want %v", tt.name, err, tt.want)
+		}
+	}
+
+	aw := NewWriter(io.Discard)
+	if err := aw.WriteHeader(&Header{Name: "file", Size: 2}); err != nil {
+		t.Fatal(err)
+	}
+	if n, err := aw.Write([]byte("abc")); n != 2 || err != ErrWriteTooLong {
+		t.Errorf("Write past Size = (%d, %v), want (2, %v)", n, err, ErrWriteTooLong)
+	}
+	if err := aw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := aw.Write(nil); err != ErrWriteAfterClose {
+		t.Errorf("Write after Close: %v, want %v", err, ErrWriteAfterClose)
+	}
+
+	aw = NewWriter(io.Discard)
+	if err := aw.WriteHeader(&Header{Name: "file", Size: 2}); err != nil {
+		t.Fatal(err)
+	}
+	if err := aw.Close(); err == nil {
+		t.Error("Close with missing data succeeded")
+	}
+}
+
+func TestWriterEmpty(t *testing.T) {
+	var buf bytes.Buffer
+	if err := NewWriter(&buf).Close(); err != nil {
+		t.Fatal(err)
+	}
+	if buf.String() != magic {
+		t.Errorf("empty archive is %q, want %q", buf.String(), magic)
+	}
+}
+
+func TestWriterAddFS(t *testing.T) {
+	fsys := fstest.MapFS{
+		"file.go":              {Data: []byte("hello"), Mode: 0644},
+		"subfolder/another.go": {Data: []byte("world!"), Mode: 0600},
+	}
+	var buf bytes.Buffer
+	aw := NewWriter(&buf)
+	if err := aw.AddFS(fsys); err != nil {
+		t.Fatal(err)
+	}
+	if err := aw.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	ar := NewReader(&buf)
+	for _, name := range []string{"file.go", "subfolder/another.go"} {
+		hdr, err := ar.Next()
+		if err != nil {
+			t.Fatal(err)
+		}
+		data, err := io.ReadAll(ar)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := fsys[name]
+		if hdr.Name != name || hdr.Mode != c_ISREG|int64(want.Mode) || string(data) != string(want.Data) {
+			t.Errorf("got %q (mode %o) with %q, want %q (mode %o) with %q",
+				hdr.Name, hdr.Mode, data, name, c_ISREG|want.Mode, want.Data)
+		}
+	}
+	if _, err := ar.Next(); err != io.EOF {
+		t.Errorf("Next at the end: %v, want io.EOF", err)
+	}
+}
+
+func TestWriterAddFSNonRegularFiles(t *testing.T) {
+	fsys := fstest.MapFS{
+		"device":  {Data: []byte("hello"), Mode: 0755 | fs.ModeDevice},
+		"symlink": {Data: []byte("world"), Mode: 0755 | fs.ModeSymlink},
+	}
+	var buf bytes.Buffer
+	aw := NewWriter(&buf)
+	if err := aw.AddFS(fsys); err == nil {
+		t.Fatal("expected error, got nil")
+	}
+}
@@ -0,0 +1,259 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package cpio implements access to cpio archives.
+//
+// A cpio archive is a sequence of files, each preceded by a header,
+// that can be read and written in a streaming manner.
+// This package supports the "new" ASCII format (newc) used by the Linux
+// kernel for initramfs images and by RPM, its checksummed variant,
+// and the portable ASCII format (odc) standardized by POSIX.1.
+// The obsolete binary format is not supported.
+package cpio
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"time"
+)
+
+var (
+	ErrHeader          = errors.New("archive/cpio: invalid cpio header")
+	ErrChecksum        = errors.New("archive/cpio: checksum error")
+	ErrWriteTooLong    = errors.New("archive/cpio: write too long")
+	ErrFieldTooLong    = errors.New("archive/cpio: header field too long")
+	ErrWriteAfterClose = errors.New("archive/cpio: write after close")
+)
+
+// Format represents the cpio archive format.
+//
+// The Reader detects the format of each entry from its header.
+// The Writer uses the format in Header.Format, and FormatNewc if it
+// is FormatUnknown.
+type Format int
+
+const (
+	// FormatUnknown indicates that the format is unknown.
+	FormatUnknown Format = iota
+
+	// FormatNewc represents the "new" ASCII format, with the magic
+	// number "070701". Numeric fields are 8 hexadecimal digits, which
+	// limits files to 4GiB, and names and file data are padded to a
+	// multiple of 4 bytes.
+	//
+	// This is the format of Linux initramfs images.
+	FormatNewc
+
+	// FormatCRC represents the "new" ASCII format with a checksum, with
+	// the magic number "070702". It is FormatNewc where Header.Checksum
+	// holds the sum of the bytes of the file data.
+	FormatCRC
+
+	// FormatODC represents the portable ASCII format, with the magic
+	// number "070707". Numeric fields are octal; the size and
+	// modification time have 11 digits and the other fields 6 digits.
+	// Device numbers are stored as a single value with an 8-bit minor
+	// number.
+	FormatODC
+)
+
+func (f Format) String() string {
+	switch f {
+	case FormatNewc:
+		return "newc"
+	case FormatCRC:
+		return "crc"
+	case FormatODC:
+		return "odc"
+	}
+	return "<unknown>"
+}
+
+// File types, in the bits of Header.Mode selected by TypeMask.
+const (
+	TypeMask    = 0170000
+	TypeFifo    = 0010000 // FIFO
+	TypeChar    = 0020000 // Character device node
+	TypeDir     = 0040000 // Directory
+	TypeBlock   = 0060000 // Block device node
+	TypeReg     = 0100000 // Regular file
+	TypeSymlink = 0120000 // Symbolic link
+	TypeSocket  = 0140000 // Socket
+)
+
+const (
+	c_ISUID = 04000 // Set uid
+	c_ISGID = 02000 // Set gid
+	c_ISVTX = 01000 // Save text (sticky bit)
+)
+
+// trailerName is the name of the entry that ends a cpio archive.
+const trailerName = "TRAILER!!!"
+
+// A Header represents a single header in a cpio archive.
+// Some fields may not be populated.
+//
+// Hard links are represented by entries that share the same Inode and
+// Devmajor and Devminor, and have an Nlink greater than one. Archivers
+// commonly store the file data only with the last such entry, so that
+// the others have a Size of zero.
+type Header struct {
+	Name     string // Name of file entry
+	Linkname string // Target name of link (valid for TypeSymlink)
+
+	// Size is the length in bytes of the file data.
+	// It is zero for symbolic links, whose target is in Linkname.
+	Size int64
+
+	Mode    int64     // Permission and mode bits, and the file type in TypeMask
+	Uid     int       // User ID of owner
+	Gid     int       // Group ID of owner
+	ModTime time.Time // Modification time, in seconds
+	Inode   int64     // Inode number
+	Nlink   int       // Number of links
+
+	Devmajor  int64 // Major number of the device containing the file
+	Devminor  int64 // Minor number of the device containing the file
+	Rdevmajor int64 // Major device number (valid for TypeChar or TypeBlock)
+	Rdevminor int64 // Minor device number (valid for TypeChar or TypeBlock)
+
+	// Checksum is the sum of the bytes of the file data, modulo 2³².
+	// It is only used by FormatCRC.
+	Checksum uint32
+
+	// Format specifies the format of the cpio header.
+	Format Format
+}
+
+// FileInfo returns an fs.FileInfo for the Header.
+func (h *Header) FileInfo() fs.FileInfo {
+	return headerFileInfo{h}
+}
+
+// headerFileInfo implements fs.FileInfo.
+type headerFileInfo struct {
+	h *Header
+}
+
+func (fi headerFileInfo) Size() int64        { return fi.h.Size }
+func (fi headerFileInfo) IsDir() bool        { return fi.Mode().IsDir() }
+func (fi headerFileInfo) ModTime() time.Time { return fi.h.ModTime }
+func (fi headerFileInfo) Sys() any           { return fi.h }
+
+// Name returns the base name of the file.
+func (fi headerFileInfo) Name() string {
+	return path.Base(path.Clean(fi.h.Name))
+}
+
+// Mode returns the permission and mode bits for the headerFileInfo.
+func (fi headerFileInfo) Mode() (mode fs.FileMode) {
+	mode = fs.FileMode(fi.h.Mode).Perm()
+	if fi.h.Mode&c_ISUID != 0 {
+		mode |= fs.ModeSetuid
+	}
+	if fi.h.Mode&c_ISGID != 0 {
+		mode |= fs.ModeSetgid
+	}
+	if fi.h.Mode&c_ISVTX != 0 {
+		mode |= fs.ModeSticky
+	}
+	switch fi.h.Mode & TypeMask {
+	case TypeDir:
+		mode |= fs.ModeDir
+	case TypeFifo:
+		mode |= fs.ModeNamedPipe
+	case TypeSymlink:
+		mode |= fs.ModeSymlink
+	case TypeBlock:
+		mode |= fs.ModeDevice
+	case TypeChar:
+		mode |= fs.ModeDevice | fs.ModeCharDevice
+	case TypeSocket:
+		mode |= fs.ModeSocket
+	}
+	return mode
+}
+
+func (fi headerFileInfo) String() string {
+	return fs.FormatFileInfo(fi)
+}
+
+// sysStat, if non-nil, populates h from system-dependent fields of fi.
+var sysStat func(fi fs.FileInfo, h *Header) error
+
+// FileInfoHeader creates a partially-populated [Header] from fi.
+// If fi describes a symlink, FileInfoHeader records link as the link target.
+//
+// Since fs.FileInfo's Name method only returns the base name of
+// the file it describes, it may be necessary to modify Header.Name
+// to provide the full path name of the file.
+//
+// On Unix systems, FileInfoHeader also records the owner, inode number,
+// link count and device numbers of files from the operating system.
+func FileInfoHeader(fi fs.FileInfo, link string) (*Header, error) {
+	if fi == nil {
+		return nil, errors.New("archive/cpio: FileInfo is nil")
+	}
+	fm := fi.Mode()
+	h := &Header{
+		Name:    fi.Name(),
+		ModTime: fi.ModTime(),
+		Mode:    int64(fm.Perm()),
+		Nlink:   1,
+	}
+	switch {
+	case fm.IsRegular():
+		h.Mode |= TypeReg
+		h.Size = fi.Size()
+	case fi.IsDir():
+		h.Mode |= TypeDir
+		h.Nlink = 2
+	case fm&fs.ModeSymlink != 0:
+		h.Mode |= TypeSymlink
+		h.Linkname = link
+	case fm&fs.ModeDevice != 0:
+		if fm&fs.ModeCharDevice != 0 {
+			h.Mode |= TypeChar
+		} else {
+			h.Mode |= TypeBlock
+		}
+	case fm&fs.ModeNamedPipe != 0:
+		h.Mode |= TypeFifo
+	case fm&fs.ModeSocket != 0:
+		h.Mode |= TypeSocket
+	default:
+		return nil, fmt.Errorf("archive/cpio: unknown file mode %v", fm)
+	}
+	if fm&fs.ModeSetuid != 0 {
+		h.Mode |= c_ISUID
+	}
+	if fm&fs.ModeSetgid != 0 {
+		h.Mode |= c_ISGID
+	}
+	if fm&fs.ModeSticky != 0 {
+		h.Mode |= c_ISVTX
+	}
+	if sys, ok := fi.Sys().(*Header); ok {
+		// This FileInfo came from a Header (not the OS). Use the
+		// original Header to populate all remaining fields.
+		h.Uid = sys.Uid
+		h.Gid = sys.Gid
+		h.Inode = sys.Inode
+		h.Nlink = sys.Nlink
+		h.Devmajor = sys.Devmajor
+		h.Devminor = sys.Devminor
+		h.Rdevmajor = sys.Rdevmajor
+		h.Rdevminor = sys.Rdevminor
+		if sys.Mode&TypeMask == TypeSymlink && link == "" {
+			h.Linkname = sys.Linkname
+		}
+		return h, nil
+	}
+	if sysStat != nil {
+		return h, sysStat(fi, h)
+	}
+	return h, nil
+}
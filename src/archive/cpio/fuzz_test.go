@@ -0,0 +1,71 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cpio
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"testing"
+)
+
+func FuzzReader(f *testing.F) {
+	for _, file := range []string{"testdata/newc.cpio", "testdata/odc.cpio"} {
+		b, err := os.ReadFile(file)
+		if err != nil {
+			f.Fatal(err)
+		}
+		f.Add(b)
+	}
+
+	f.Fuzz(func(t *testing.T, b []byte) {
+		r := NewReader(bytes.NewReader(b))
+		type file struct {
+			header  *Header
+			content []byte
+		}
+		files := []file{}
+		for {
+			hdr, err := r.Next()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				return
+			}
+			buf := bytes.NewBuffer(nil)
+			if _, err := io.Copy(buf, r); err != nil {
+				return
+			}
+			files = append(files, file{header: hdr, content: buf.Bytes()})
+		}
+
+		// Every archive that can be read can be written again, and
+		// reading that yields the same entries.
+		out := bytes.NewBuffer(nil)
+		w := NewWriter(out)
+		for _, f := range files {
+			if err := w.WriteHeader(f.header); err != nil {
+				t.Fatalf("unable to write previously parsed header: %s", err)
+			}
+			if _, err := w.Write(f.content); err != nil {
+				t.Fatalf("unable to write previously parsed content: %s", err)
+			}
+		}
+		if err := w.Close(); err != nil {
+			t.Fatalf("unable to write archive: %s", err)
+		}
+		r = NewReader(out)
+		for _, f := range files {
+			hdr, err := r.Next()
+			if err != nil {
+				t.Fatalf("unable to read rewritten archive: %s", err)
+			}
+			if hdr.Name != f.header.Name || hdr.Mode != f.header.Mode || hdr.Linkname != f.header.Linkname {
+				t.Fatalf("rewritten header %+v, want %+v", hdr, f.header)
+			}
+		}
+	})
+}
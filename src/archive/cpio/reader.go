@@ -0,0 +1,323 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cpio
+
+import (
+	"bytes"
+	"io"
+	"time"
+)
+
+const (
+	magicNewc = "070701"
+	magicCRC  = "070702"
+	magicODC  = "070707"
+
+	newcHeaderSize = 110
+	odcHeaderSize  = 76
+
+	// maxNameSize limits the size of names and link targets, which are
+	// read into memory. It is far larger than PATH_MAX on any system.
+	maxNameSize = 1 << 16
+)
+
+// Reader provides sequential access to the contents of a cpio archive.
+// Reader.Next advances to the next file in the archive (including the first),
+// and then Reader can be treated as an io.Reader to access the file's data.
+type Reader struct {
+	r    io.Reader
+	nb   int64 // Number of remaining bytes of the current file entry
+	pad  int64 // Amount of padding (ignored) after current file entry
+	blk  [newcHeaderSize]byte
+	crc  bool   // Whether to verify the checksum of the current file entry
+	sum  uint32 // Sum of the bytes read of the current file entry
+	want uint32 // Expected sum of the current file entry
+
+	// err is a persistent error.
+	// It is only the responsibility of every exported method of Reader to
+	// ensure that this error is sticky.
+	err error
+}
+
+// NewReader creates a new [Reader] reading from r.
+func NewReader(r io.Reader) *Reader {
+	return &Reader{r: r}
+}
+
+// Next advances to the next entry in the cpio archive.
+// The Header.Size determines how many bytes can be read for the next file.
+// Any remaining data in the current file is automatically discarded.
+// At the trailer entry that ends the archive, or at the end of the input
+// if the archive has no trailer, Next returns the error io.EOF.
+// Data after the trailer, such as block padding, is not read.
+func (cr *Reader) Next() (*Header, error) {
+	if cr.err != nil {
+		return nil, cr.err
+	}
+	hdr, err := cr.next()
+	cr.err = err
+	return hdr, err
+}
+
+func (cr *Reader) next() (*Header, error) {
+	if err := discard(cr.r, cr.nb+cr.pad); err != nil {
+		return nil, err
+	}
+	cr.nb, cr.pad, cr.crc = 0, 0, false
+
+	magic := cr.blk[:6]
+	if _, err := io.ReadFull(cr.r, magic); err != nil {
+		return nil, err // EOF is okay here; exactly 0 bytes read
+	}
+	var (
+		hdr      *Header
+		nameSize int64
+		err      error
+	)
+	switch string(magic) {
+	case magicNewc, magicCRC:
+		hdr, nameSize, err = cr.readNewc(string(magic) == magicCRC)
+	case magicODC:
+		hdr, nameSize, err = cr.readODC()
+	default:
+		return nil, ErrHeader
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Read the name, which is non-empty and NUL-terminated and, in the
+	// new ASCII formats, padded so that the file data starts at a
+	// multiple of four bytes.
+	if nameSize < 2 || nameSize > maxNameSize {
+		return nil, ErrHeader
+	}
+	namePad := int64(0)
+	if hdr.Format != FormatODC {
+		namePad = -(newcHeaderSize + nameSize) & 3
+	}
+	name := make([]byte, nameSize+namePad)
+	if _, err := io.ReadFull(cr.r, name); err != nil {
+		return nil, unexpectedEOF(err)
+	}
+	name = name[:nameSize]
+	if name[nameSize-1] != 0 || bytes.IndexByte(name[:nameSize-1], 0) >= 0 {
+		return nil, ErrHeader
+	}
+	hdr.Name = string(name[:nameSize-1])
+	if hdr.Name == trailerName {
+		return nil, io.EOF
+	}
+
+	cr.nb = hdr.Size
+	if hdr.Format != FormatODC {
+		cr.pad = -hdr.Size & 3
+	}
+	if hdr.Mode&TypeMask == TypeSymlink {
+		if hdr.Size > maxNameSize {
+			return nil, ErrHeader
+		}
+		link := make([]byte, hdr.Size+cr.pad)
+		if _, err := io.ReadFull(cr.r, link); err != nil {
+			return nil, unexpectedEOF(err)
+		}
+		if hdr.Format == FormatCRC && checksum(0, link[:hdr.Size]) != hdr.Checksum {
+			return nil, ErrChecksum
+		}
+		hdr.Linkname = string(link[:hdr.Size])
+		hdr.Size = 0
+		cr.nb, cr.pad = 0, 0
+		return hdr, nil
+	}
+	if hdr.Format == FormatCRC {
+		cr.crc, cr.sum, cr.want = true, 0, hdr.Checksum
+	}
+	return hdr, nil
+}
+
+// readNewc reads the rest of a header in the new ASCII format, after the
+// magic number, and returns it along with the size of the name.
+func (cr *Reader) readNewc(crc bool) (*Header, int64, error) {
+	b := cr.blk[6:newcHeaderSize]
+	if _, err := io.ReadFull(cr.r, b); err != nil {
+		return nil, 0, unexpectedEOF(err)
+	}
+	var f [13]int64
+	for i := range f {
+		v, ok := parseHex(b[8*i : 8*i+8])
+		if !ok {
+			return nil, 0, ErrHeader
+		}
+		f[i] = v
+	}
+	hdr := &Header{
+		Inode:     f[0],
+		Mode:      f[1],
+		Uid:       int(f[2]),
+		Gid:       int(f[3]),
+		Nlink:     int(f[4]),
+		ModTime:   time.Unix(f[5], 0),
+		Size:      f[6],
+		Devmajor:  f[7],
+		Devminor:  f[8],
+		Rdevmajor: f[9],
+		Rdevminor: f[10],
+		Checksum:  uint32(f[12]),
+		Format:    FormatNewc,
+	}
+	if crc {
+		hdr.Format = FormatCRC
+	}
+	return hdr, f[11], nil
+}
+
+// readODC reads the rest of a header in the portable ASCII format, after
+// the magic number, and returns it along with the size of the name.
+func (cr *Reader) readODC() (*Header, int64, error) {
+	b := cr.blk[6:odcHeaderSize]
+	if _, err := io.ReadFull(cr.r, b); err != nil {
+		return nil, 0, unexpectedEOF(err)
+	}
+	var f [10]int64
+	for i, width := range odcWidths {
+		v, ok := parseOctal(b[:width])
+		if !ok {
+			return nil, 0, ErrHeader
+		}
+		f[i] = v
+		b = b[width:]
+	}
+	hdr := &Header{
+		Devmajor:  f[0] >> 8,
+		Devminor:  f[0] & 0xff,
+		Inode:     f[1],
+		Mode:      f[2],
+		Uid:       int(f[3]),
+		Gid:       int(f[4]),
+		Nlink:     int(f[5]),
+		Rdevmajor: f[6] >> 8,
+		Rdevminor: f[6] & 0xff,
+		ModTime:   time.Unix(f[7], 0),
+		Size:      f[9],
+		Format:    FormatODC,
+	}
+	return hdr, f[8], nil
+}
+
+// odcWidths are the widths of the fields of a header in the portable
+// ASCII format after the magic number: dev, ino, mode, uid, gid, nlink,
+// rdev, mtime, namesize and filesize.
+var odcWidths = [10]int{6, 6, 6, 6, 6, 6, 6, 11, 6, 11}
+
+func parseHex(b []byte) (int64, bool) {
+	var v int64
+	for _, c := range b {
+		switch {
+		case '0' <= c && c <= '9':
+			c -= '0'
+		case 'a' <= c && c <= 'f':
+			c -= 'a' - 10
+		case 'A' <= c && c <= 'F':
+			c -= 'A' - 10
+		default:
+			return 0, false
+		}
+		v = v<<4 | int64(c)
+	}
+	return v, true
+}
+
+func parseOctal(b []byte) (int64, bool) {
+	var v int64
+	for _, c := range b {
+		if c < '0' || c > '7' {
+			return 0, false
+		}
+		v = v<<3 | int64(c-'0')
+	}
+	return v, true
+}
+
+// checksum adds the bytes of b to sum.
+func checksum(sum uint32, b []byte) uint32 {
+	for _, c := range b {
+		sum += uint32(c)
+	}
+	return sum
+}
+
+// Read reads from the current file in the cpio archive.
+// It returns (0, io.EOF) when it reaches the end of that file,
+// until [Reader.Next] is called to advance to the next file.
+//
+// If the current file is in [FormatCRC], Read returns [ErrChecksum]
+// at the end of the file if its data does not match Header.Checksum.
+func (cr *Reader) Read(b []byte) (int, error) {
+	if cr.err != nil {
+		return 0, cr.err
+	}
+	if cr.nb == 0 {
+		if cr.crc && cr.sum != cr.want {
+			cr.err = ErrChecksum
+			return 0, cr.err
+		}
+		return 0, io.EOF
+	}
+	if int64(len(b)) > cr.nb {
+		b = b[:cr.nb]
+	}
+	n, err := cr.r.Read(b)
+	cr.nb -= int64(n)
+	cr.sum = checksum(cr.sum, b[:n])
+	switch {
+	case cr.nb == 0 && cr.crc && cr.sum != cr.want:
+		err = ErrChecksum
+	case err == io.EOF && cr.nb > 0:
+		err = io.ErrUnexpectedEOF
+	case err == io.EOF:
+		err = nil
+	}
+	if err != nil {
+		cr.err = err
+	}
+	return n, err
+}
+
+func unexpectedEOF(err error) error {
+	if err == io.EOF {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
+
+// discard skips n bytes in r, reporting an error if unable to do so.
+func discard(r io.Reader, n int64) error {
+	// If possible, Seek to the last byte before the end of the data section.
+	// Do this because Seek is often lazy about reporting errors; this will mask
+	// the fact that the stream may be truncated. We can rely on the
+	// io.CopyN done shortly afterwards to trigger any IO errors.
+	var seekSkipped int64 // Number of bytes skipped via Seek
+	if sr, ok := r.(io.Seeker); ok && n > 1 {
+		// Not all io.Seeker can actually Seek. For example, os.Stdin implements
+		// io.Seeker, but calling Seek always returns an error and performs
+		// no action. Thus, we try an innocent seek to the current position
+		// to see if Seek is really supported.
+		pos1, err := sr.Seek(0, io.SeekCurrent)
+		if pos1 >= 0 && err == nil {
+			// Seek seems supported, so perform the real Seek.
+			pos2, err := sr.Seek(n-1, io.SeekCurrent)
+			if pos2 < 0 || err != nil {
+				return err
+			}
+			seekSkipped = pos2 - pos1
+		}
+	}
+
+	copySkipped, err := io.CopyN(io.Discard, r, n-seekSkipped)
+	if err == io.EOF && seekSkipped+copySkipped < n {
+		err = io.ErrUnexpectedEOF
+	}
+	return err
+}
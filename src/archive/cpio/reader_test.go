@@ -0,0 +1,228 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cpio
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestReader(t *testing.T) {
+	mtime := time.Unix(1700000000, 0)
+	// The testdata was written by bsdtar, which stores the data of a
+	// hard-linked file in the new ASCII formats only with its last link.
+	vectors := []struct {
+		file     string
+		headers  []*Header
+		contents []string
+	}{{
+		file: "testdata/newc.cpio",
+		headers: []*Header{{
+			Name: "dir", Mode: TypeDir | 0755, Uid: 1000, Gid: 1000, ModTime: mtime,
+			Inode: 9617764, Nlink: 2, Devmajor: 254, Format: FormatNewc,
+		}, {
+			Name: "dir/file.txt", Mode: TypeReg | 0644, Uid: 1000, Gid: 1000, ModTime: mtime,
+			Inode: 9618244, Nlink: 2, Devmajor: 254, Format: FormatNewc,
+		}, {
+			Name: "hardlink", Size: 13, Mode: TypeReg | 0644, Uid: 1000, Gid: 1000, ModTime: mtime,
+			Inode: 9618244, Nlink: 2, Devmajor: 254, Format: FormatNewc,
+		}, {
+			Name: "odd", Size: 2, Mode: TypeReg | 0644, Uid: 1000, Gid: 1000, ModTime: mtime,
+			Inode: 9618258, Nlink: 1, Devmajor: 254, Format: FormatNewc,
+		}, {
+			Name: "symlink", Linkname: "dir/file.txt", Mode: TypeSymlink | 0777, Uid: 1000, Gid: 1000, ModTime: mtime,
+			Inode: 9618273, Nlink: 1, Devmajor: 254, Format: FormatNewc,
+		}},
+		contents: []string{"", "", "Hello, cpio!\n", "ab", ""},
+	}, {
+		file: "testdata/odc.cpio",
+		headers: []*Header{{
+			Name: "dir", Mode: TypeDir | 0755, Uid: 1000, Gid: 1000, ModTime: mtime,
+			Inode: 1, Nlink: 2, Devmajor: 254, Format: FormatODC,
+		}, {
+			Name: "dir/file.txt", Size: 13, Mode: TypeReg | 0644, Uid: 1000, Gid: 1000, ModTime: mtime,
+			Inode: 2, Nlink: 2, Devmajor: 254, Format: FormatODC,
+		}, {
+			Name: "hardlink", Size: 13, Mode: TypeReg | 0644, Uid: 1000, Gid: 1000, ModTime: mtime,
+			Inode: 2, Nlink: 2, Devmajor: 254, Format: FormatODC,
+		}, {
+			Name: "odd", Size: 2, Mode: TypeReg | 0644, Uid: 1000, Gid: 1000, ModTime: mtime,
+			Inode: 3, Nlink: 1, Devmajor: 254, Format: FormatODC,
+		}, {
+			Name: "symlink", Linkname: "dir/file.txt", Mode: TypeSymlink | 0777, Uid: 1000, Gid: 1000, ModTime: mtime,
+			Inode: 4, Nlink: 1, Devmajor: 254, Format: FormatODC,
+		}},
+		contents: []string{"", "Hello, cpio!\n", "Hello, cpio!\n", "ab", ""},
+	}}
+
+	for _, v := range vectors {
+		t.Run(v.file, func(t *testing.T) {
+			f, err := os.Open(v.file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer f.Close()
+			cr := NewReader(f)
+			for i := 0; ; i++ {
+				hdr, err := cr.Next()
+				if err == io.EOF {
+					if i != len(v.headers) {
+						t.Errorf("got %d headers, want %d", i, len(v.headers))
+					}
+					break
+				}
+				if err != nil {
+					t.Fatalf("Next: %v", err)
+				}
+				if i >= len(v.headers) {
+					t.Fatalf("unexpected header %+v", hdr)
+				}
+				if !reflect.DeepEqual(hdr, v.headers[i]) {
+					t.Errorf("entry %d:\ngot  %+v\nwant %+v", i, hdr, v.headers[i])
+				}
+				// Skip the data of every other entry.
+				if i%2 == 1 && hdr.Size > 0 {
+					continue
+				}
+				b, err := io.ReadAll(cr)
+				if err != nil {
+					t.Fatalf("entry %d: ReadAll: %v", i, err)
+				}
+				if string(b) != v.contents[i] {
+					t.Errorf("entry %d: got %q, want %q", i, b, v.contents[i])
+				}
+			}
+			if _, err := cr.Next(); err != io.EOF {
+				t.Errorf("Next after the trailer: %v, want io.EOF", err)
+			}
+		})
+	}
+}
+
+func TestReaderCRC(t *testing.T) {
+	var buf bytes.Buffer
+	cw := NewWriter(&buf)
+	data := "checksummed"
+	hdr := &Header{Name: "file", Mode: TypeReg | 0644, Size: int64(len(data)), Format: FormatCRC}
+	hdr.Checksum = checksum(0, []byte(data))
+	if err := cw.WriteHeader(hdr); err != nil {
+		t.Fatal(err)
+	}
+	io.WriteString(cw, data)
+	if err := cw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	b := buf.Bytes()
+	if !bytes.HasPrefix(b, []byte(magicCRC)) {
+		t.Fatalf("archive starts with %q, want %q", b[:6], magicCRC)
+	}
+
+	cr := NewReader(bytes.NewReader(b))
+	if _, err := cr.Next(); err != nil {
+		t.Fatal(err)
+	}
+	if got, err := io.ReadAll(cr); err != nil || string(got) != data {
+		t.Errorf("ReadAll = (%q, %v), want %q", got, err, data)
+	}
+
+	// Corrupt the data.
+	i := bytes.Index(b, []byte(data))
+	b[i] ^= 1
+	cr = NewReader(bytes.NewReader(b))
+	if _, err := cr.Next(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := io.ReadAll(cr); err != ErrChecksum {
+		t.Errorf("ReadAll of corrupt data: %v, want %v", err, ErrChecksum)
+	}
+}
+
+func TestReaderErrors(t *testing.T) {
+	b, err := os.ReadFile("testdata/newc.cpio")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The second entry starts at offset 116, and "hardlink" has its
+	// data at 360.
+	tests := []struct {
+		name string
+		data string
+		want error
+	}{
+		{"empty", "", io.EOF},
+		{"bad magic", "070708" + string(b[6:]), ErrHeader},
+		{"binary", "\xc7\x71" + string(b[2:]), ErrHeader},
+		{"bad number", string(b[:14]) + "x" + string(b[15:]), ErrHeader},
+		{"truncated magic", string(b[:3]), io.ErrUnexpectedEOF},
+		{"truncated header", string(b[:50]), io.ErrUnexpectedEOF},
+		{"truncated name", string(b[:112]), io.ErrUnexpectedEOF},
+		{"no trailer", string(b[:116]), io.EOF},
+		{"unterminated name", strings.Replace(string(b), "dir\x00", "dirx", 1), ErrHeader},
+	}
+	for _, tt := range tests {
+		cr := NewReader(strings.NewReader(tt.data))
+		var err error
+		for err == nil {
+			_, err = cr.Next()
+		}
+		if err != tt.want {
+			t.Errorf("%s: got %v, want %v", tt.name, err, tt.want)
+		}
+	}
+
+	// Truncated file data.
+	cr := NewReader(bytes.NewReader(b[:365]))
+	for range 3 {
+		if _, err := cr.Next(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := io.ReadAll(cr); err != io.ErrUnexpectedEOF {
+		t.Errorf("ReadAll of truncated data: %v, want %v", err, io.ErrUnexpectedEOF)
+	}
+	if _, err := cr.Next(); err != io.ErrUnexpectedEOF {
+		t.Errorf("Next after truncated data: %v, want %v", err, io.ErrUnexpectedEOF)
+	}
+}
+
+func TestHeaderFileInfo(t *testing.T) {
+	mtime := time.Unix(1700000000, 0)
+	tests := []struct {
+		h    Header
+		name string
+		mode string
+	}{
+		{Header{Name: "dir/file", Mode: TypeReg | 0644, Size: 3}, "file", "-rw-r--r--"},
+		{Header{Name: "dir", Mode: TypeDir | 0755}, "dir", "drwxr-xr-x"},
+		{Header{Name: "link", Mode: TypeSymlink | 0777}, "link", "Lrwxrwxrwx"},
+		{Header{Name: "tty", Mode: TypeChar | 0620}, "tty", "Dcrw--w----"},
+		{Header{Name: "sda", Mode: TypeBlock | 0660}, "sda", "Drw-rw----"},
+		{Header{Name: "fifo", Mode: TypeFifo | 0600}, "fifo", "prw-------"},
+		{Header{Name: "sock", Mode: TypeSocket | 0600}, "sock", "Srw-------"},
+		{Header{Name: "suid", Mode: TypeReg | c_ISUID | c_ISGID | c_ISVTX | 0755}, "suid", "ugtrwxr-xr-x"},
+	}
+	for _, tt := range tests {
+		tt.h.ModTime = mtime
+		fi := tt.h.FileInfo()
+		if fi.Name() != tt.name || fi.Mode().String() != tt.mode || fi.Size() != tt.h.Size || !fi.ModTime().Equal(mtime) {
+			t.Errorf("FileInfo of %q = %v, want name %q and mode %v", tt.h.Name, fi, tt.name, tt.mode)
+		}
+
+		// FileInfoHeader reverses FileInfo.
+		h, err := FileInfoHeader(fi, tt.h.Linkname)
+		if err != nil {
+			t.Errorf("FileInfoHeader of %q: %v", tt.h.Name, err)
+			continue
+		}
+		if h.Mode != tt.h.Mode || h.Name != tt.name {
+			t.Errorf("FileInfoHeader of %q = %+v, want mode %o", tt.h.Name, h, tt.h.Mode)
+		}
+	}
+}
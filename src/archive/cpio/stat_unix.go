@@ -0,0 +1,58 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build unix
+
+package cpio
+
+import (
+	"io/fs"
+	"runtime"
+	"syscall"
+)
+
+func init() {
+	sysStat = statUnix
+}
+
+func statUnix(fi fs.FileInfo, h *Header) error {
+	sys, ok := fi.Sys().(*syscall.Stat_t)
+	if !ok {
+		return nil
+	}
+	h.Uid = int(sys.Uid)
+	h.Gid = int(sys.Gid)
+	h.Inode = int64(sys.Ino)
+	h.Nlink = int(sys.Nlink)
+	h.Devmajor, h.Devminor = splitDev(uint64(sys.Dev)) // May be int32 or uint32
+	if t := h.Mode & TypeMask; t == TypeChar || t == TypeBlock {
+		h.Rdevmajor, h.Rdevminor = splitDev(uint64(sys.Rdev))
+	}
+	return nil
+}
+
+// splitDev splits a device number into its major and minor numbers.
+// It reports 0, 0 on operating systems that it does not know.
+func splitDev(dev uint64) (major, minor int64) {
+	// Copied from archive/tar's statUnix.
+	switch runtime.GOOS {
+	case "aix":
+		return int64((dev & 0x3fffffff00000000) >> 32), int64(dev & 0x00000000ffffffff)
+	case "linux":
+		ma := (dev & 0x00000000000fff00) >> 8
+		ma |= (dev & 0xfffff00000000000) >> 32
+		mi := (dev & 0x00000000000000ff) >> 0
+		mi |= (dev & 0x00000ffffff00000) >> 12
+		return int64(ma), int64(mi)
+	case "darwin", "ios":
+		return int64((dev >> 24) & 0xff), int64(dev & 0xffffff)
+	case "dragonfly", "freebsd":
+		return int64((dev >> 8) & 0xff), int64(dev & 0xffff00ff)
+	case "netbsd":
+		return int64((dev & 0x000fff00) >> 8), int64(dev&0x000000ff | (dev&0xfff00000)>>12)
+	case "openbsd":
+		return int64((dev & 0x0000ff00) >> 8), int64(dev&0x000000ff | (dev&0xffff0000)>>8)
+	}
+	return 0, 0
+}
@@ -0,0 +1,322 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cpio
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"strconv"
+	"strings"
+)
+
+// Writer provides sequential writing of a cpio archive.
+// [Writer.WriteHeader] begins a new file with the provided [Header],
+// and then Writer can be treated as an io.Writer to supply that file's data.
+type Writer struct {
+	w      io.Writer
+	nb     int64  // Number of remaining bytes to write of the current file entry
+	pad    int64  // Amount of padding to write after current file entry
+	format Format // Format of the last header, used for the trailer
+	crc    bool   // Whether to verify the checksum of the current file entry
+	sum    uint32 // Sum of the bytes written of the current file entry
+	want   uint32 // Expected sum of the current file entry
+	ino    int64  // Last inode number assigned by AddFS
+
+	// err is a persistent error.
+	// It is only the responsibility of every exported method of Writer to
+	// ensure that this error is sticky.
+	err error
+}
+
+// NewWriter creates a new Writer writing to w.
+func NewWriter(w io.Writer) *Writer {
+	return &Writer{w: w, format: FormatNewc}
+}
+
+// Flush finishes writing the current file's padding.
+// The current file must be fully written before Flush can be called.
+//
+// This is unnecessary as the next call to [Writer.WriteHeader] or [Writer.Close]
+// will implicitly flush out the file's padding.
+func (cw *Writer) Flush() error {
+	if cw.err != nil {
+		return cw.err
+	}
+	if cw.nb > 0 {
+		return fmt.Errorf("archive/cpio: missed writing %d bytes", cw.nb)
+	}
+	if cw.crc && cw.sum != cw.want {
+		cw.err = ErrChecksum
+		return cw.err
+	}
+	cw.crc = false
+	if _, cw.err = cw.w.Write(zeroPad[:cw.pad]); cw.err != nil {
+		return cw.err
+	}
+	cw.pad = 0
+	return nil
+}
+
+var zeroPad [4]byte
+
+// WriteHeader writes hdr and prepares to accept the file's contents.
+// The Header.Size determines how many bytes can be written for the next file.
+// If the current file is not fully written, then this returns an error.
+// This implicitly flushes any padding necessary before writing the header.
+//
+// The header is written in the format given by Header.Format,
+// or [FormatNewc] if that is [FormatUnknown]. For [FormatCRC],
+// Header.Checksum must be the checksum of the data that will be written.
+// The Linkname of a symbolic link is written as its file data,
+// so its Header.Size must be zero.
+func (cw *Writer) WriteHeader(hdr *Header) error {
+	if err := cw.Flush(); err != nil {
+		return err
+	}
+	h := *hdr // Shallow copy of Header
+	if h.Format == FormatUnknown {
+		h.Format = FormatNewc
+	}
+	var data string
+	if h.Mode&TypeMask == TypeSymlink {
+		if h.Size != 0 {
+			return errors.New("archive/cpio: symbolic link has non-zero Size")
+		}
+		data = h.Linkname
+		h.Size = int64(len(data))
+		h.Checksum = checksum(0, []byte(data))
+	}
+	if h.Name == "" || h.Name == trailerName || strings.IndexByte(h.Name, 0) >= 0 {
+		return fmt.Errorf("archive/cpio: invalid name %q", h.Name)
+	}
+	b, err := appendHeader(nil, &h)
+	if err != nil {
+		return err
+	}
+	if _, cw.err = cw.w.Write(b); cw.err != nil {
+		return cw.err
+	}
+	cw.format = h.Format
+	cw.nb = h.Size
+	if h.Format != FormatODC {
+		cw.pad = -h.Size & 3
+	}
+	cw.crc, cw.sum, cw.want = h.Format == FormatCRC, 0, h.Checksum
+	if data != "" {
+		_, err := io.WriteString(cw, data)
+		return err
+	}
+	return nil
+}
+
+// appendHeader appends the encoding of h, including its name and the
+// padding after it, to b.
+func appendHeader(b []byte, h *Header) ([]byte, error) {
+	nameSize := int64(len(h.Name)) + 1
+	var mtime int64
+	if !h.ModTime.IsZero() {
+		mtime = h.ModTime.Unix()
+	}
+	if nameSize > maxNameSize {
+		return nil, fieldError("Name", h.Format)
+	}
+	switch h.Format {
+	case FormatNewc, FormatCRC:
+		var check int64
+		magic := magicNewc
+		if h.Format == FormatCRC {
+			magic, check = magicCRC, int64(h.Checksum)
+		}
+		b = append(b, magic...)
+		for _, f := range []struct {
+			name string
+			v    int64
+		}{
+			{"Inode", h.Inode},
+			{"Mode", h.Mode},
+			{"Uid", int64(h.Uid)},
+			{"Gid", int64(h.Gid)},
+			{"Nlink", int64(h.Nlink)},
+			{"ModTime", mtime},
+			{"Size", h.Size},
+			{"Devmajor", h.Devmajor},
+			{"Devminor", h.Devminor},
+			{"Rdevmajor", h.Rdevmajor},
+			{"Rdevminor", h.Rdevminor},
+			{"Name", nameSize},
+			{"Checksum", check},
+		} {
+			if f.v < 0 || f.v > 0xffffffff {
+				return nil, fieldError(f.name, h.Format)
+			}
+			b = appendNumber(b, f.v, 16, 8)
+		}
+		b = append(b, h.Name...)
+		b = append(b, zeroPad[:1+(-(newcHeaderSize+nameSize)&3)]...)
+	case FormatODC:
+		dev, ok1 := odcDev(h.Devmajor, h.Devminor)
+		rdev, ok2 := odcDev(h.Rdevmajor, h.Rdevminor)
+		if !ok1 {
+			return nil, fieldError("Devmajor or Devminor", h.Format)
+		}
+		if !ok2 {
+			return nil, fieldError("Rdevmajor or Rdevminor", h.Format)
+		}
+		b = append(b, magicODC...)
+		for i, f := range []struct {
+			name string
+			v    int64
+		}{
+			{"Devmajor", dev},
+			{"Inode", h.Inode},
+			{"Mode", h.Mode},
+			{"Uid", int64(h.Uid)},
+			{"Gid", int64(h.Gid)},
+			{"Nlink", int64(h.Nlink)},
+			{"Rdevmajor", rdev},
+			{"ModTime", mtime},
+			{"Name", nameSize},
+			{"Size", h.Size},
+		} {
+			width := odcWidths[i]
+			if f.v < 0 || f.v >= 1<<(3*width) {
+				return nil, fieldError(f.name, h.Format)
+			}
+			b = appendNumber(b, f.v, 8, width)
+		}
+		b = append(b, h.Name...)
+		b = append(b, 0)
+	default:
+		return nil, fmt.Errorf("archive/cpio: unknown format %v", h.Format)
+	}
+	return b, nil
+}
+
+// odcDev combines major and minor device numbers into the single
+// device number of the portable ASCII format.
+func odcDev(major, minor int64) (int64, bool) {
+	if major < 0 || minor < 0 || minor > 0xff {
+		return 0, false
+	}
+	return major<<8 | minor, true
+}
+
+// appendNumber appends v to b in the given base, zero-padded to width digits.
+func appendNumber(b []byte, v int64, base, width int) []byte {
+	s := strconv.FormatInt(v, base)
+	for i := len(s); i < width; i++ {
+		b = append(b, '0')
+	}
+	return append(b, s...)
+}
+
+func fieldError(name string, f Format) error {
+	return fmt.Errorf("%w: %s does not fit in the %v format", ErrFieldTooLong, name, f)
+}
+
+// AddFS adds the files from fs.FS to the archive.
+// It walks the directory tree starting at the root of the filesystem
+// adding each directory and regular file to the cpio archive,
+// so that the archive can be unpacked without creating directories first.
+//
+// AddFS numbers the entries with unique inode numbers, so that they are
+// not taken for hard links of each other, and writes them in [FormatNewc].
+func (cw *Writer) AddFS(fsys fs.FS) error {
+	return fs.WalkDir(fsys, ".", func(name string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if name == "." {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		// TODO(#49580): Handle symlinks when fs.ReadLinkFS is available.
+		if !info.IsDir() && !info.Mode().IsRegular() {
+			return errors.New("archive/cpio: cannot add non-regular file")
+		}
+		h, err := FileInfoHeader(info, "")
+		if err != nil {
+			return err
+		}
+		cw.ino++
+		h.Name = name
+		h.Inode = cw.ino
+		h.Nlink = 1
+		if info.IsDir() {
+			h.Nlink = 2
+		}
+		h.Devmajor, h.Devminor = 0, 0
+		if err := cw.WriteHeader(h); err != nil {
+			return err
+		}
+		if info.IsDir() {
+			return nil
+		}
+		f, err := fsys.Open(name)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		_, err = io.Copy(cw, f)
+		return err
+	})
+}
+
+// Write writes to the current file in the cpio archive.
+// Write returns the error [ErrWriteTooLong] if more than
+// Header.Size bytes are written after [Writer.WriteHeader].
+func (cw *Writer) Write(b []byte) (int, error) {
+	if cw.err != nil {
+		return 0, cw.err
+	}
+	overwrite := int64(len(b)) > cw.nb
+	if overwrite {
+		b = b[:cw.nb]
+	}
+	n, err := cw.w.Write(b)
+	cw.nb -= int64(n)
+	cw.sum = checksum(cw.sum, b[:n])
+	if err != nil {
+		cw.err = err
+		return n, err
+	}
+	if overwrite {
+		return n, ErrWriteTooLong
+	}
+	return n, nil
+}
+
+// Close closes the cpio archive by flushing the padding, and writing the
+// trailer entry. If the current file (from a prior call to
+// [Writer.WriteHeader]) is not fully written, then this returns an error.
+//
+// Close does not pad the archive to a multiple of a block size,
+// as some cpio implementations do.
+func (cw *Writer) Close() error {
+	if cw.err == ErrWriteAfterClose {
+		return nil
+	}
+	if cw.err != nil {
+		return cw.err
+	}
+
+	err := cw.Flush()
+	if err == nil {
+		var b []byte
+		b, err = appendHeader(nil, &Header{Name: trailerName, Nlink: 1, Format: cw.format})
+		if err == nil {
+			_, err = cw.w.Write(b)
+		}
+	}
+
+	// Ensure all future actions are invalid.
+	cw.err = ErrWriteAfterClose
+	return err // Report IO errors
+}
@@ -0,0 +1,234 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cpio
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+	"reflect"
+	"testing"
+	"testing/fstest"
+	"time"
+)
+
+// TestWriterTestdata checks that writing the entries of the testdata
+// archives reproduces them, apart from the block padding at the end.
+func TestWriterTestdata(t *testing.T) {
+	for _, file := range []string{"testdata/newc.cpio", "testdata/odc.cpio"} {
+		t.Run(file, func(t *testing.T) {
+			want, err := os.ReadFile(file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var buf bytes.Buffer
+			cr := NewReader(bytes.NewReader(want))
+			cw := NewWriter(&buf)
+			for {
+				hdr, err := cr.Next()
+				if err == io.EOF {
+					break
+				}
+				if err != nil {
+					t.Fatal(err)
+				}
+				if err := cw.WriteHeader(hdr); err != nil {
+					t.Fatal(err)
+				}
+				if _, err := io.Copy(cw, cr); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if err := cw.Close(); err != nil {
+				t.Fatal(err)
+			}
+			got := buf.Bytes()
+			if !bytes.Equal(got, want[:len(got)]) {
+				t.Errorf("output differs from %s:\ngot  %q\nwant %q", file, got, want[:len(got)])
+			}
+			if rest := want[len(got):]; len(bytes.Trim(rest, "\x00")) != 0 {
+				t.Errorf("output is missing %q", rest)
+			}
+		})
+	}
+}
+
+func TestWriterRoundTrip(t *testing.T) {
+	mtime := time.Unix(1700000000, 0)
+	for _, format := range []Format{FormatNewc, FormatCRC, FormatODC} {
+		headers := []*Header{
+			{Name: "dir", Mode: TypeDir | 0755, Nlink: 2, Inode: 1},
+			{Name: "dir/file", Mode: TypeReg | 0644, Size: 5, Nlink: 1, Inode: 2, Uid: 1000, Gid: 100},
+			{Name: "dir/link", Mode: TypeSymlink | 0777, Linkname: "file", Nlink: 1, Inode: 3},
+			{Name: "dev/null", Mode: TypeChar | 0666, Nlink: 1, Inode: 4, Rdevmajor: 1, Rdevminor: 3},
+			{Name: "odd", Mode: TypeReg | 0600, Size: 1, Nlink: 1, Inode: 5, Devmajor: 8, Devminor: 1},
+		}
+		contents := []string{"", "hello", "", "", "x"}
+		var buf bytes.Buffer
+		cw := NewWriter(&buf)
+		for i, h := range headers {
+			h.ModTime = mtime
+			h.Format = format
+			if format == FormatCRC {
+				h.Checksum = checksum(0, []byte(contents[i]))
+			}
+			if err := cw.WriteHeader(h); err != nil {
+				t.Fatalf("%v: WriteHeader(%q): %v", format, h.Name, err)
+			}
+			if _, err := io.WriteString(cw, contents[i]); err != nil {
+				t.Fatalf("%v: Write(%q): %v", format, h.Name, err)
+			}
+		}
+		if err := cw.Close(); err != nil {
+			t.Fatal(err)
+		}
+
+		cr := NewReader(&buf)
+		for i, want := range headers {
+			got, err := cr.Next()
+			if err != nil {
+				t.Fatalf("%v: Next: %v", format, err)
+			}
+			if format == FormatCRC && got.Mode&TypeMask == TypeSymlink {
+				want.Checksum = checksum(0, []byte(want.Linkname))
+			}
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("%v: got %+v, want %+v", format, got, want)
+			}
+			if b, err := io.ReadAll(cr); err != nil || string(b) != contents[i] {
+				t.Errorf("%v: ReadAll(%q) = (%q, %v), want %q", format, want.Name, b, err, contents[i])
+			}
+		}
+		if _, err := cr.Next(); err != io.EOF {
+			t.Errorf("%v: Next at the end: %v, want io.EOF", format, err)
+		}
+	}
+}
+
+func TestWriterErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		h    Header
+	}{
+		{"empty name", Header{Mode: TypeReg}},
+		{"trailer name", Header{Name: trailerName, Mode: TypeReg}},
+		{"NUL in name", Header{Name: "a\x00b", Mode: TypeReg}},
+		{"symlink with data", Header{Name: "link", Mode: TypeSymlink, Linkname: "x", Size: 1}},
+		{"negative uid", Header{Name: "file", Mode: TypeReg, Uid: -1}},
+		{"newc size", Header{Name: "file", Mode: TypeReg, Size: 1 << 32}},
+		{"newc mtime", Header{Name: "file", Mode: TypeReg, ModTime: time.Unix(-1, 0)}},
+		{"odc inode", Header{Name: "file", Mode: TypeReg, Inode: 1 << 18, Format: FormatODC}},
+		{"odc minor", Header{Name: "file", Mode: TypeChar, Rdevminor: 256, Format: FormatODC}},
+		{"odc size", Header{Name: "file", Mode: TypeReg, Size: 1 << 33, Format: FormatODC}},
+		{"unknown format", Header{Name: "file", Mode: TypeReg, Format: 42}},
+	}
+	for _, tt := range tests {
+		cw := NewWriter(io.Discard)
+		if err := cw.WriteHeader(&tt.h); err == nil {
+			t.Errorf("%s: WriteHeader succeeded", tt.name)
+		}
+	}
+
+	cw := NewWriter(io.Discard)
+	if err := cw.WriteHeader(&Header{Name: "file", Mode: TypeReg, Size: 2}); err != nil {
+		t.Fatal(err)
+	}
+	if n, err := cw.Write([]byte("abc")); n != 2 || err != ErrWriteTooLong {
+		t.Errorf("Write past Size = (%d, %v), want (2, %v)", n, err, ErrWriteTooLong)
+	}
+	if err := cw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := cw.Write(nil); err != ErrWriteAfterClose {
+		t.Errorf("Write after Close: %v, want %v", err, ErrWriteAfterClose)
+	}
+
+	cw = NewWriter(io.Discard)
+	if err := cw.WriteHeader(&Header{Name: "file", Mode: TypeReg, Size: 2}); err != nil {
+		t.Fatal(err)
+	}
+	if err := cw.Close(); err == nil {
+		t.Error("Close with missing data succeeded")
+	}
+
+	cw = NewWriter(io.Discard)
+	if err := cw.WriteHeader(&Header{Name: "file", Mode: TypeReg, Size: 2, Checksum: 1, Format: FormatCRC}); err != nil {
+		t.Fatal(err)
+	}
+	cw.Write([]byte("ab"))
+	if err := cw.Close(); err != ErrChecksum {
+		t.Errorf("Close with a wrong checksum: %v, want %v", err, ErrChecksum)
+	}
+}
+
+func TestWriterAddFS(t *testing.T) {
+	fsys := fstest.MapFS{
+		"file.go":              {Data: []byte("hello"), Mode: 0644},
+		"subfolder/another.go": {Data: []byte("world"), Mode: 0600},
+		"empty":                {Mode: fs.ModeDir | 0700},
+	}
+	var buf bytes.Buffer
+	cw := NewWriter(&buf)
+	if err := cw.AddFS(fsys); err != nil {
+		t.Fatal(err)
+	}
+	if err := cw.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	want := []struct {
+		name string
+		mode int64
+		data string
+	}{
+		{"empty", TypeDir | 0700, ""},
+		{"file.go", TypeReg | 0644, "hello"},
+		{"subfolder", TypeDir | 0555, ""},
+		{"subfolder/another.go", TypeReg | 0600, "world"},
+	}
+	cr := NewReader(&buf)
+	for i, w := range want {
+		hdr, err := cr.Next()
+		if err != nil {
+			t.Fatal(err)
+		}
+		data, err := io.ReadAll(cr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if hdr.Name != w.name || hdr.Mode != w.mode || string(data) != w.data {
+			t.Errorf("got %q (mode %o) with %q, want %q (mode %o) with %q",
+				hdr.Name, hdr.Mode, data, w.name, w.mode, w.data)
+		}
+		if hdr.Inode != int64(i+1) {
+			t.Errorf("%q has inode %d, want %d", hdr.Name, hdr.Inode, i+1)
+		}
+	}
+	if _, err := cr.Next(); err != io.EOF {
+		t.Errorf("Next at the end: %v, want io.EOF", err)
+	}
+}
+
+func TestWriterAddFSNonRegularFiles(t *testing.T) {
+	fsys := fstest.MapFS{
+		"device":  {Data: []byte("hello"), Mode: 0755 | fs.ModeDevice},
+		"symlink": {Data: []byte("world"), Mode: 0755 | fs.ModeSymlink},
+	}
+	var buf bytes.Buffer
+	cw := NewWriter(&buf)
+	if err := cw.AddFS(fsys); err == nil {
+		t.Fatal("expected error, got nil")
+	}
+}
+
+func TestWriterFieldTooLong(t *testing.T) {
+	cw := NewWriter(io.Discard)
+	err := cw.WriteHeader(&Header{Name: "file", Mode: TypeReg, Size: 1 << 32})
+	if !errors.Is(err, ErrFieldTooLong) {
+		t.Errorf("WriteHeader of a 4GiB file: %v, want %v", err, ErrFieldTooLong)
+	}
+}
@@ -14,9 +14,11 @@ import (
 	"errors"
 	"fmt"
 	"internal/godebug"
+	"io"
 	"io/fs"
 	"maps"
 	"math"
+	"os"
 	"path"
 	"reflect"
 	"strconv"
@@ -172,6 +174,21 @@ type Header struct {
 	Devmajor int64 // Major device number (valid for TypeChar or TypeBlock)
 	Devminor int64 // Minor device number (valid for TypeChar or TypeBlock)
 
+	// SparseHoles represents a sequence of holes in a sparse file.
+	//
+	// A sparse file consists of fragments of data, intermixed with holes
+	// (described by this field). A hole is semantically a block of NUL-bytes,
+	// but does not actually exist within the tar file.
+	// The holes must be sorted in ascending order,
+	// not overlap with each other, and not extend past the specified Size.
+	//
+	// Reader.Next populates this field for sparse files of any format.
+	// Writer.WriteHeader writes sparse files only in the PAX format
+	// (using the GNU sparse 1.0 PAX records), and so writes a header
+	// with Typeflag TypeGNUSparse as TypeReg. Use DetectSparseHoles to
+	// populate this field from a file in the operating system's file system.
+	SparseHoles []SparseEntry
+
 	// Xattrs stores extended attributes as PAX records under the
 	// "SCHILY.xattr." namespace.
 	//
@@ -209,10 +226,10 @@ type Header struct {
 	Format Format
 }
 
-// sparseEntry represents a Length-sized fragment at Offset in the file.
-type sparseEntry struct{ Offset, Length int64 }
+// SparseEntry represents a Length-sized fragment at Offset in the file.
+type SparseEntry struct{ Offset, Length int64 }
 
-func (s sparseEntry) endOffset() int64 { return s.Offset + s.Length }
+func (s SparseEntry) endOffset() int64 { return s.Offset + s.Length }
 
 // A sparse file can be represented as either a sparseDatas or a sparseHoles.
 // As long as the total size is known, they are equivalent and one can be
@@ -233,11 +250,11 @@ func (s sparseEntry) endOffset() int64 { return s.Offset + s.Length }
 //
 // And the sparse map has the following entries:
 //
-//	var spd sparseDatas = []sparseEntry{
+//	var spd sparseDatas = []SparseEntry{
 //		{Offset: 2,  Length: 5},  // Data fragment for 2..6
 //		{Offset: 18, Length: 3},  // Data fragment for 18..20
 //	}
-//	var sph sparseHoles = []sparseEntry{
+//	var sph sparseHoles = []SparseEntry{
 //		{Offset: 0,  Length: 2},  // Hole fragment for 0..1
 //		{Offset: 7,  Length: 11}, // Hole fragment for 7..17
 //		{Offset: 21, Length: 4},  // Hole fragment for 21..24
@@ -247,19 +264,19 @@ func (s sparseEntry) endOffset() int64 { return s.Offset + s.Length }
 //
 //	var sparseFile = "\x00"*2 + "abcde" + "\x00"*11 + "fgh" + "\x00"*4
 type (
-	sparseDatas []sparseEntry
-	sparseHoles []sparseEntry
+	sparseDatas []SparseEntry
+	sparseHoles []SparseEntry
 )
 
 // validateSparseEntries reports whether sp is a valid sparse map.
 // It does not matter whether sp represents data fragments or hole fragments.
-func validateSparseEntries(sp []sparseEntry, size int64) bool {
+func validateSparseEntries(sp []SparseEntry, size int64) bool {
 	// Validate all sparse entries. These are the same checks as performed by
 	// the BSD tar utility.
 	if size < 0 {
 		return false
 	}
-	var pre sparseEntry
+	var pre SparseEntry
 	for _, cur := range sp {
 		switch {
 		case cur.Offset < 0 || cur.Length < 0:
@@ -283,7 +300,7 @@ func validateSparseEntries(sp []sparseEntry, size int64) bool {
 // Even though the Go tar Reader and the BSD tar utility can handle entries
 // with arbitrary offsets and lengths, the GNU tar utility can only handle
 // offsets and lengths that are multiples of blockSize.
-func alignSparseEntries(src []sparseEntry, size int64) []sparseEntry {
+func alignSparseEntries(src []SparseEntry, size int64) []SparseEntry {
 	dst := src[:0]
 	for _, s := range src {
 		pos, end := s.Offset, s.endOffset()
@@ -292,7 +309,7 @@ func alignSparseEntries(src []sparseEntry, size int64) []sparseEntry {
 			end -= blockPadding(-end) // Round-down to nearest blockSize
 		}
 		if pos < end {
-			dst = append(dst, sparseEntry{Offset: pos, Length: end - pos})
+			dst = append(dst, SparseEntry{Offset: pos, Length: end - pos})
 		}
 	}
 	return dst
@@ -306,9 +323,9 @@ func alignSparseEntries(src []sparseEntry, size int64) []sparseEntry {
 //   - adjacent fragments are coalesced together
 //   - only the last fragment may be empty
 //   - the endOffset of the last fragment is the total size
-func invertSparseEntries(src []sparseEntry, size int64) []sparseEntry {
+func invertSparseEntries(src []SparseEntry, size int64) []SparseEntry {
 	dst := src[:0]
-	var pre sparseEntry
+	var pre SparseEntry
 	for _, cur := range src {
 		if cur.Length == 0 {
 			continue // Skip empty fragments
@@ -490,28 +507,17 @@ func (h Header) allowedFormats() (format Format, paxHdrs map[string]string, err
 		}
 	}
 
-	// TODO(dsnet): Re-enable this when adding sparse support.
-	// See https://golang.org/issue/22735
-	/*
-		// Check sparse files.
-		if len(h.SparseHoles) > 0 || h.Typeflag == TypeGNUSparse {
-			if isHeaderOnlyType(h.Typeflag) {
-				return FormatUnknown, nil, headerError{"header-only type cannot be sparse"}
-			}
-			if !validateSparseEntries(h.SparseHoles, h.Size) {
-				return FormatUnknown, nil, headerError{"invalid sparse holes"}
-			}
-			if h.Typeflag == TypeGNUSparse {
-				whyOnlyGNU = "only GNU supports TypeGNUSparse"
-				format.mayOnlyBe(FormatGNU)
-			} else {
-				whyNoGNU = "GNU supports sparse files only with TypeGNUSparse"
-				format.mustNotBe(FormatGNU)
-			}
-			whyNoUSTAR = "USTAR does not support sparse files"
-			format.mustNotBe(FormatUSTAR)
+	// Check sparse files.
+	if len(h.SparseHoles) > 0 {
+		if isHeaderOnlyType(h.Typeflag) {
+			return FormatUnknown, nil, headerError{"header-only type cannot be sparse"}
+		}
+		if !validateSparseEntries(h.SparseHoles, h.Size) {
+			return FormatUnknown, nil, headerError{"invalid sparse holes"}
 		}
-	*/
+		whyOnlyPAX = "only PAX supports SparseHoles"
+		format.mayOnlyBe(FormatPAX)
+	}
 
 	// Check desired format.
 	if wantFormat := h.Format; wantFormat != FormatUnknown {
@@ -615,6 +621,14 @@ func (fi headerFileInfo) String() string {
 // sysStat, if non-nil, populates h from system-dependent fields of fi.
 var sysStat func(fi fs.FileInfo, h *Header, doNameLookups bool) error
 
+// sysXattrs, if non-nil, records the extended attributes of the file
+// at path in h.PAXRecords.
+var sysXattrs func(path string, h *Header) error
+
+// sysSparseDetect, if non-nil, reports the holes of f.
+// It may leave the file offset of f anywhere.
+var sysSparseDetect func(f *os.File) (sparseHoles, error)
+
 const (
 	// Mode constants from the USTAR spec:
 	// See http://pubs.opengroup.org/onlinepubs/9699919799/utilities/pax.html#tag_20_92_13_06
@@ -644,6 +658,9 @@ const (
 // If fi implements [FileInfoNames]
 // Header.Gname and Header.Uname
 // are provided by the methods of the interface.
+//
+// FileInfoHeader does not record extended attributes, since fi does not
+// identify its file; use [Header.DetectXattrs] to record them.
 func FileInfoHeader(fi fs.FileInfo, link string) (*Header, error) {
 	if fi == nil {
 		return nil, errors.New("archive/tar: FileInfo is nil")
@@ -725,6 +742,46 @@ func FileInfoHeader(fi fs.FileInfo, link string) (*Header, error) {
 	return h, nil
 }
 
+// DetectSparseHoles sets h.SparseHoles to the holes within f,
+// on operating systems and file systems that can report them
+// (using SEEK_HOLE and SEEK_DATA), and to nil otherwise.
+// The holes are only valid if h.Size is the size of f.
+// The file offset of f is reset to zero.
+//
+// When packing a sparse file, DetectSparseHoles should be called prior to
+// serializing the header to the archive with [Writer.WriteHeader], and the
+// content of f should then be written with [Writer.ReadFrom], which skips
+// over the holes rather than reading them.
+func (h *Header) DetectSparseHoles(f *os.File) (err error) {
+	defer func() {
+		if _, serr := f.Seek(0, io.SeekStart); err == nil {
+			err = serr
+		}
+	}()
+
+	h.SparseHoles = nil
+	if sysSparseDetect != nil {
+		h.SparseHoles, err = sysSparseDetect(f)
+	}
+	return err
+}
+
+// DetectXattrs records the extended attributes of the file at path in
+// h.PAXRecords, as records with keys of the form "SCHILY.xattr."+name,
+// on operating systems that support them (currently Linux).
+// This includes POSIX ACLs, which Linux exposes as the
+// "system.posix_acl_access" and "system.posix_acl_default" attributes.
+// If h describes a symbolic link, DetectXattrs does nothing.
+//
+// Like DetectSparseHoles, DetectXattrs should be called prior to
+// serializing the header to the archive with [Writer.WriteHeader].
+func (h *Header) DetectXattrs(path string) error {
+	if sysXattrs == nil || h.Typeflag == TypeSymlink {
+		return nil
+	}
+	return sysXattrs(path, h)
+}
+
 // FileInfoNames extends [fs.FileInfo].
 // Passing an instance of this to [FileInfoHeader] permits the caller
 // to avoid a system-dependent name lookup by specifying the Uname and Gname directly.
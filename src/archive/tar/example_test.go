@@ -9,6 +9,7 @@ import (
 	"bytes"
 	"fmt"
 	"io"
+	"io/fs"
 	"log"
 	"os"
 )
@@ -69,3 +70,41 @@ func Example_minimal() {
 	// Contents of todo.txt:
 	// Get animal handling license.
 }
+
+func ExampleNewIndex() {
+	// Create an archive with a file and a symbolic link to its directory.
+	var buf bytes.Buffer
+	tw := tar.NewWriter(&buf)
+	body := "Gopher names:\nGeorge\nGeoffrey\nGonzo"
+	hdr := &tar.Header{Name: "doc/gopher.txt", Mode: 0600, Size: int64(len(body))}
+	if err := tw.WriteHeader(hdr); err != nil {
+		log.Fatal(err)
+	}
+	if _, err := tw.Write([]byte(body)); err != nil {
+		log.Fatal(err)
+	}
+	hdr = &tar.Header{Name: "latest", Typeflag: tar.TypeSymlink, Linkname: "doc"}
+	if err := tw.WriteHeader(hdr); err != nil {
+		log.Fatal(err)
+	}
+	if err := tw.Close(); err != nil {
+		log.Fatal(err)
+	}
+
+	// Index the archive and read the file through the link.
+	index, err := tar.NewIndex(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		log.Fatal(err)
+	}
+	b, err := fs.ReadFile(index, "latest/gopher.txt")
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Printf("%s\n", b)
+
+	// Output:
+	// Gopher names:
+	// George
+	// Geoffrey
+	// Gonzo
+}
@@ -0,0 +1,464 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tar
+
+import (
+	"cmp"
+	"errors"
+	"io"
+	"io/fs"
+	"path"
+	"slices"
+	"strings"
+	"time"
+)
+
+// An Index provides random access to the files of a tar archive.
+// It is built by a single pass over the headers of the archive,
+// which seeks past the content of the files rather than reading it.
+//
+// Index implements [fs.FS], [fs.ReadDirFS], [fs.ReadFileFS] and [fs.StatFS]
+// over the files of the archive; see [Index.Open].
+type Index struct {
+	// Entries lists the entries of the archive, in archive order.
+	Entries []*IndexEntry
+
+	nodes map[string]*indexNode
+}
+
+// An IndexEntry is an entry of an [Index]: its header, as returned by
+// [Reader.Next], and the location of its content in the archive.
+type IndexEntry struct {
+	Header
+
+	r      io.ReaderAt // Logical content
+	offset int64       // Offset of the physical content in the archive
+}
+
+// NewIndex returns an [Index] of the tar archive in r,
+// which is assumed to have the given size in bytes.
+//
+// If any entry has a name that is not a local path, as defined by
+// [filepath.IsLocal], and the GODEBUG environment variable contains
+// `tarinsecurepath=0`, NewIndex returns the index along with the error
+// [ErrInsecurePath], like [Reader.Next]. Such entries are still made
+// available by Index.Open, with their names cleaned up the way
+// [Index.Open] documents.
+func NewIndex(r io.ReaderAt, size int64) (*Index, error) {
+	sr := io.NewSectionReader(r, 0, size)
+	tr := NewReader(sr)
+	x := new(Index)
+	var insecure bool
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err == ErrInsecurePath {
+			insecure = true
+		} else if err != nil {
+			return nil, err
+		}
+
+		// Reader.Next stops at the start of the physical content,
+		// past any sparse map stored with it.
+		offset, err := sr.Seek(0, io.SeekCurrent)
+		if err != nil {
+			return nil, err
+		}
+		physSize := tr.curr.physicalRemaining()
+		if offset > size-physSize {
+			return nil, io.ErrUnexpectedEOF
+		}
+		e := &IndexEntry{Header: *hdr, offset: offset}
+		data := io.NewSectionReader(r, offset, physSize)
+		if _, ok := tr.curr.(*sparseFileReader); ok {
+			s, err := newSparseReaderAt(data, hdr.SparseHoles, hdr.Size)
+			if err != nil {
+				return nil, err
+			}
+			e.r = s
+		} else {
+			e.r = data
+		}
+		x.Entries = append(x.Entries, e)
+	}
+	x.initNodes()
+	if insecure {
+		return x, ErrInsecurePath
+	}
+	return x, nil
+}
+
+// Open returns a reader of the content of e. For a sparse file, the
+// content includes the holes, which read as NUL bytes. The content of
+// a hard link is empty; use [Index.Open] to follow links.
+func (e *IndexEntry) Open() *io.SectionReader {
+	return io.NewSectionReader(e.r, 0, e.contentSize())
+}
+
+// DataOffset returns the offset of the content of e within the archive.
+// For a sparse file, it is the offset of the data fragments, which are
+// stored one after another.
+func (e *IndexEntry) DataOffset() int64 {
+	return e.offset
+}
+
+// contentSize returns the size of the content of e.
+func (e *IndexEntry) contentSize() int64 {
+	if isHeaderOnlyType(e.Typeflag) {
+		return 0
+	}
+	return e.Size
+}
+
+// sparseReaderAt reads the logical content of a sparse file, whose data
+// fragments are stored one after another in r.
+type sparseReaderAt struct {
+	r    io.ReaderAt
+	spd  sparseDatas // Normalized list of data fragments
+	phys []int64     // Offset in r of each data fragment
+}
+
+func newSparseReaderAt(r *io.SectionReader, sph sparseHoles, size int64) (*sparseReaderAt, error) {
+	spd := invertSparseEntries(slices.Clone(sph), size)
+	phys := make([]int64, len(spd))
+	var pos int64
+	for i, s := range spd {
+		phys[i] = pos
+		pos += s.Length
+	}
+	if pos != r.Size() {
+		return nil, ErrHeader // Sparse map does not match the stored data
+	}
+	return &sparseReaderAt{r, spd, phys}, nil
+}
+
+func (s *sparseReaderAt) ReadAt(b []byte, off int64) (n int, err error) {
+	size := s.spd[len(s.spd)-1].endOffset()
+	if off < 0 {
+		return 0, errors.New("archive/tar: negative offset")
+	}
+
+	// Find the first fragment that ends after off.
+	i, _ := slices.BinarySearchFunc(s.spd, off+1, func(e SparseEntry, end int64) int {
+		return cmp.Compare(e.endOffset(), end)
+	})
+	for n < len(b) && off < size {
+		var nf int // Bytes read in fragment
+		dataStart, dataEnd := size, size
+		if i < len(s.spd) {
+			dataStart, dataEnd = s.spd[i].Offset, s.spd[i].endOffset()
+		}
+		bf := b[n:]
+		if off < dataStart { // In a hole fragment
+			if rem := dataStart - off; int64(len(bf)) > rem {
+				bf = bf[:rem]
+			}
+			clear(bf)
+			nf = len(bf)
+		} else { // In a data fragment
+			if rem := dataEnd - off; int64(len(bf)) > rem {
+				bf = bf[:rem]
+			}
+			nf, err = s.r.ReadAt(bf, s.phys[i]+off-dataStart)
+			if nf == len(bf) {
+				err = nil
+			} else if err == io.EOF {
+				err = io.ErrUnexpectedEOF
+			}
+		}
+		n += nf
+		off += int64(nf)
+		if err != nil {
+			return n, err
+		}
+		if off >= dataEnd {
+			i++
+		}
+	}
+	if n < len(b) {
+		return n, io.EOF
+	}
+	return n, nil
+}
+
+// An indexNode is a file in the fs.FS view of an Index.
+// If e is nil, the node is a directory without metadata.
+type indexNode struct {
+	name     string      // Cleaned path, or "." for the root
+	e        *IndexEntry // Entry with the metadata and content of the file
+	children []*indexNode
+}
+
+func (n *indexNode) isDir() bool {
+	return n.e == nil || n.e.Typeflag == TypeDir
+}
+
+func (n *indexNode) isSymlink() bool {
+	return n.e != nil && n.e.Typeflag == TypeSymlink
+}
+
+// info returns the fs.FileInfo of n, using name as the file name.
+func (n *indexNode) info(name string) *indexFileInfo {
+	h := &Header{Typeflag: TypeDir, Mode: 0555}
+	if n.e != nil {
+		h = &n.e.Header
+	}
+	return &indexFileInfo{path.Base(name), h}
+}
+
+// indexName returns the name of the file in the fs.FS view for the
+// entry name: it is cleaned, and stripped of leading "/" and "../"
+// elements. The root directory is named ".".
+func indexName(name string) string {
+	name = path.Clean("/" + name)
+	if name == "/" {
+		return "."
+	}
+	return name[1:]
+}
+
+// initNodes builds the fs.FS view of x. Entries replace earlier entries
+// with the same name, as they would when the archive is extracted.
+func (x *Index) initNodes() {
+	x.nodes = map[string]*indexNode{".": {name: "."}}
+	for _, e := range x.Entries {
+		switch e.Typeflag {
+		case TypeXGlobalHeader, TypeGNULongName, TypeGNULongLink, TypeXHeader:
+			continue
+		}
+		name := indexName(e.Name)
+		if name == "." && e.Typeflag != TypeDir {
+			continue
+		}
+		if e.Typeflag == TypeLink {
+			// A hard link shares the metadata and content of its target
+			// as it was when the link was extracted.
+			target := x.nodes[indexName(e.Linkname)]
+			if target == nil || target.isDir() {
+				continue
+			}
+			e = target.e
+		}
+		n := x.nodes[name]
+		if n == nil {
+			n = &indexNode{name: name}
+			x.nodes[name] = n
+			// Add the parent directories without metadata if needed.
+			for child := n; child.name != "."; {
+				dir := path.Dir(child.name)
+				parent, ok := x.nodes[dir]
+				if !ok {
+					parent = &indexNode{name: dir}
+					x.nodes[dir] = parent
+				}
+				parent.children = append(parent.children, child)
+				if ok {
+					break // The ancestors of parent already exist
+				}
+				child = parent
+			}
+		}
+		n.e = e
+	}
+	for _, n := range x.nodes {
+		slices.SortFunc(n.children, func(a, b *indexNode) int {
+			return strings.Compare(a.name, b.name)
+		})
+	}
+}
+
+// maxSymlinks is the maximum number of symbolic links followed
+// when resolving a name.
+const maxSymlinks = 40
+
+// lookup returns the node of the named file, following symbolic links
+// in the directories of the name, and in the file itself if follow is set.
+//
+// Symbolic links are resolved within the archive: an absolute target is
+// relative to the root of the archive, and ".." at the root is the root.
+func (x *Index) lookup(op, name string, follow bool) (*indexNode, error) {
+	if !fs.ValidPath(name) {
+		return nil, &fs.PathError{Op: op, Path: name, Err: fs.ErrInvalid}
+	}
+	if name == "." {
+		return x.nodes["."], nil
+	}
+	dir, rest := ".", name
+	for links := 0; ; {
+		elem, more, _ := strings.Cut(rest, "/")
+		cur := path.Join(dir, elem)
+		n := x.nodes[cur]
+		switch {
+		case n == nil:
+			return nil, &fs.PathError{Op: op, Path: name, Err: fs.ErrNotExist}
+		case n.isSymlink() && (more != "" || follow):
+			if links++; links > maxSymlinks {
+				return nil, &fs.PathError{Op: op, Path: name, Err: errors.New("too many levels of symbolic links")}
+			}
+			target := n.e.Linkname
+			if !path.IsAbs(target) {
+				target = path.Join(dir, target)
+			}
+			target = indexName(target)
+			if more != "" {
+				target = path.Join(target, more)
+			}
+			if target == "." {
+				return x.nodes["."], nil
+			}
+			dir, rest = ".", target
+			continue
+		case more == "":
+			return n, nil
+		case !n.isDir():
+			return nil, &fs.PathError{Op: op, Path: name, Err: fs.ErrNotExist}
+		}
+		dir, rest = cur, more
+	}
+}
+
+// Open opens the named file in the archive, using the semantics of
+// [fs.FS.Open]: paths are always slash separated, with no leading / or
+// ../ elements. The names of the entries are cleaned up to fit these
+// rules, so that "./a/b", "/a/b" and "a//b" all name "a/b".
+//
+// When an archive has several entries with the same name, the last one
+// is used, as when the archive is extracted. Open follows hard links to
+// the file they link to, and symbolic links to their target within the
+// archive, as do [Index.Stat] and [Index.ReadFile]. Directory entries
+// describe symbolic links themselves, with mode [fs.ModeSymlink], so
+// that [fs.WalkDir] does not follow them. Directories that are only
+// implied by the names of other entries have no metadata, and mode 0555.
+//
+// The file returned implements [io.ReaderAt] and [io.Seeker] in addition
+// to [fs.File], and [fs.ReadDirFile] if it is a directory.
+func (x *Index) Open(name string) (fs.File, error) {
+	n, err := x.lookup("open", name, true)
+	if err != nil {
+		return nil, err
+	}
+	info := n.info(name)
+	if n.isDir() {
+		return &indexDir{info: info, name: name, children: n.children}, nil
+	}
+	return &indexFile{info, n.e.Open()}, nil
+}
+
+// ReadDir reads the named directory and returns a list of directory
+// entries sorted by filename, as [fs.ReadDirFS] specifies.
+func (x *Index) ReadDir(name string) ([]fs.DirEntry, error) {
+	n, err := x.lookup("readdir", name, true)
+	if err != nil {
+		return nil, err
+	}
+	if !n.isDir() {
+		return nil, &fs.PathError{Op: "readdir", Path: name, Err: errors.New("not a directory")}
+	}
+	list := make([]fs.DirEntry, len(n.children))
+	for i, c := range n.children {
+		list[i] = c.info(c.name)
+	}
+	return list, nil
+}
+
+// ReadFile reads the named file and returns its contents,
+// as [fs.ReadFileFS] specifies.
+func (x *Index) ReadFile(name string) ([]byte, error) {
+	n, err := x.lookup("read", name, true)
+	if err != nil {
+		return nil, err
+	}
+	if n.isDir() {
+		return nil, &fs.PathError{Op: "read", Path: name, Err: errors.New("is a directory")}
+	}
+	b := make([]byte, n.e.contentSize())
+	if _, err := n.e.Open().ReadAt(b, 0); err != nil && err != io.EOF {
+		return nil, &fs.PathError{Op: "read", Path: name, Err: err}
+	}
+	return b, nil
+}
+
+// Stat returns a [fs.FileInfo] describing the named file,
+// following symbolic links, as [fs.StatFS] specifies.
+func (x *Index) Stat(name string) (fs.FileInfo, error) {
+	n, err := x.lookup("stat", name, true)
+	if err != nil {
+		return nil, err
+	}
+	return n.info(name), nil
+}
+
+// indexFileInfo implements fs.FileInfo and fs.DirEntry for a file
+// of an Index. Unlike headerFileInfo, it is named by the path used
+// to reach the file, which differs from the header for links.
+type indexFileInfo struct {
+	name string
+	h    *Header
+}
+
+func (fi *indexFileInfo) Name() string               { return fi.name }
+func (fi *indexFileInfo) Mode() fs.FileMode          { return headerFileInfo{fi.h}.Mode() }
+func (fi *indexFileInfo) Type() fs.FileMode          { return fi.Mode().Type() }
+func (fi *indexFileInfo) ModTime() time.Time         { return fi.h.ModTime }
+func (fi *indexFileInfo) IsDir() bool                { return fi.Mode().IsDir() }
+func (fi *indexFileInfo) Sys() any                   { return fi.h }
+func (fi *indexFileInfo) Info() (fs.FileInfo, error) { return fi, nil }
+
+func (fi *indexFileInfo) Size() int64 {
+	if isHeaderOnlyType(fi.h.Typeflag) {
+		return 0
+	}
+	return fi.h.Size
+}
+
+func (fi *indexFileInfo) String() string {
+	return fs.FormatFileInfo(fi)
+}
+
+// indexFile is an open file of an Index.
+type indexFile struct {
+	info *indexFileInfo
+	*io.SectionReader
+}
+
+func (f *indexFile) Stat() (fs.FileInfo, error) { return f.info, nil }
+func (f *indexFile) Close() error               { return nil }
+
+// indexDir is an open directory of an Index.
+type indexDir struct {
+	info     *indexFileInfo
+	name     string
+	children []*indexNode
+	offset   int
+}
+
+func (d *indexDir) Stat() (fs.FileInfo, error) { return d.info, nil }
+func (d *indexDir) Close() error               { return nil }
+
+func (d *indexDir) Read([]byte) (int, error) {
+	return 0, &fs.PathError{Op: "read", Path: d.name, Err: errors.New("is a directory")}
+}
+
+func (d *indexDir) ReadDir(count int) ([]fs.DirEntry, error) {
+	n := len(d.children) - d.offset
+	if count > 0 && n > count {
+		n = count
+	}
+	if n == 0 {
+		if count <= 0 {
+			return nil, nil
+		}
+		return nil, io.EOF
+	}
+	list := make([]fs.DirEntry, n)
+	for i := range list {
+		c := d.children[d.offset+i]
+		list[i] = c.info(c.name)
+	}
+	d.offset += n
+	return list, nil
+}
@@ -0,0 +1,276 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tar
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+	"testing/fstest"
+	"time"
+)
+
+func TestIndexTestdata(t *testing.T) {
+	for _, file := range []string{
+		"testdata/gnu.tar",
+		"testdata/sparse-formats.tar",
+		"testdata/star.tar",
+		"testdata/v7.tar",
+		"testdata/pax.tar",
+		"testdata/pax-records.tar",
+		"testdata/pax-global-records.tar",
+		"testdata/gnu-nil-sparse-data.tar",
+		"testdata/pax-nil-sparse-hole.tar",
+		"testdata/hardlink.tar",
+		"testdata/xattrs.tar",
+	} {
+		t.Run(file, func(t *testing.T) {
+			b, err := os.ReadFile(file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			x, err := NewIndex(bytes.NewReader(b), int64(len(b)))
+			if err != nil {
+				t.Fatalf("NewIndex: %v", err)
+			}
+
+			// The index has the same entries as the Reader.
+			tr := NewReader(bytes.NewReader(b))
+			for i := 0; ; i++ {
+				hdr, err := tr.Next()
+				if err == io.EOF {
+					if i != len(x.Entries) {
+						t.Errorf("index has %d entries, want %d", len(x.Entries), i)
+					}
+					break
+				}
+				if err != nil {
+					t.Fatalf("Next: %v", err)
+				}
+				if i >= len(x.Entries) {
+					t.Fatalf("index has %d entries, want more", len(x.Entries))
+				}
+				e := x.Entries[i]
+				if !reflect.DeepEqual(&e.Header, hdr) {
+					t.Errorf("entry %d: header mismatch:\ngot  %+v\nwant %+v", i, e.Header, *hdr)
+				}
+				want, err := io.ReadAll(tr)
+				if err != nil {
+					t.Fatalf("entry %d: %v", i, err)
+				}
+				got, err := io.ReadAll(e.Open())
+				if err != nil {
+					t.Fatalf("entry %d: %v", i, err)
+				}
+				if !bytes.Equal(got, want) {
+					t.Errorf("entry %d: content mismatch:\ngot  %q\nwant %q", i, got, want)
+				}
+
+				// Read the content at many offsets.
+				buf := make([]byte, 7)
+				for off := 0; off < len(want); off += 1 + off/1000 {
+					n, err := e.Open().ReadAt(buf, int64(off))
+					if n != min(len(buf), len(want)-off) || n < len(buf) && err != io.EOF {
+						t.Fatalf("entry %d: ReadAt(%d) = (%d, %v)", i, off, n, err)
+					}
+					if !bytes.Equal(buf[:n], want[off:off+n]) {
+						t.Fatalf("entry %d: ReadAt(%d) = %q, want %q", i, off, buf[:n], want[off:off+n])
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestIndexErrors(t *testing.T) {
+	b, err := os.ReadFile("testdata/gnu.tar")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Truncated in the content of a file. Like Reader, NewIndex
+	// accepts archives truncated in the padding after a file.
+	for _, size := range []int{512, 515, 1536, 1540} {
+		if _, err := NewIndex(bytes.NewReader(b[:size]), int64(size)); err == nil {
+			t.Errorf("NewIndex of %d bytes succeeded", size)
+		}
+	}
+	b, err = os.ReadFile("testdata/neg-size.tar")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := NewIndex(bytes.NewReader(b), int64(len(b))); err != ErrHeader {
+		t.Errorf("NewIndex of neg-size.tar = %v, want %v", err, ErrHeader)
+	}
+	if x, err := NewIndex(bytes.NewReader(nil), 0); err != nil || len(x.Entries) != 0 {
+		t.Errorf("NewIndex of an empty archive = (%v, %v)", x, err)
+	}
+}
+
+// indexTestFS writes an archive with the given headers and returns its
+// index. The content of a regular file is its name, or "x" bytes outside
+// the holes of a sparse file.
+func indexTestFS(t *testing.T, hdrs ...*Header) *Index {
+	t.Helper()
+	var buf bytes.Buffer
+	tw := NewWriter(&buf)
+	for _, h := range hdrs {
+		content := []byte(h.Name)
+		if len(h.SparseHoles) > 0 {
+			content = bytes.Repeat([]byte("x"), int(h.Size))
+			for _, s := range h.SparseHoles {
+				clear(content[s.Offset:s.endOffset()])
+			}
+		} else if h.Typeflag == TypeReg {
+			h.Size = int64(len(content))
+		}
+		if err := tw.WriteHeader(h); err != nil {
+			t.Fatal(err)
+		}
+		if h.Typeflag == TypeReg {
+			tw.Write(content)
+		}
+	}
+	if err := tw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	x, err := NewIndex(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return x
+}
+
+func TestIndexFS(t *testing.T) {
+	mtime := time.Unix(1e9, 0)
+	x := indexTestFS(t,
+		&Header{Name: "./", Typeflag: TypeDir, Mode: 0755, ModTime: mtime},
+		&Header{Name: "./a/", Typeflag: TypeDir, Mode: 0700, ModTime: mtime},
+		&Header{Name: "./a/file", Typeflag: TypeReg, Mode: 0644, ModTime: mtime},
+		&Header{Name: "/b/c/file", Typeflag: TypeReg, Mode: 0600},
+		&Header{Name: "a/hardlink", Typeflag: TypeLink, Linkname: "a/file"},
+		&Header{Name: "a/file", Typeflag: TypeReg, Mode: 0644}, // replaces the first one
+		&Header{Name: "link-rel", Typeflag: TypeSymlink, Linkname: "a/file"},
+		&Header{Name: "b/link-up", Typeflag: TypeSymlink, Linkname: "../a"},
+		&Header{Name: "b/link-abs", Typeflag: TypeSymlink, Linkname: "/b/c"},
+		&Header{Name: "b/link-root", Typeflag: TypeSymlink, Linkname: "../../../"},
+		&Header{Name: "dangling", Typeflag: TypeSymlink, Linkname: "missing"},
+		&Header{Name: "loop", Typeflag: TypeSymlink, Linkname: "loop"},
+		&Header{Name: "missing-target", Typeflag: TypeLink, Linkname: "nowhere"},
+	)
+
+	for _, tt := range []struct {
+		name, content string
+	}{
+		{"a/file", "a/file"},
+		{"a/hardlink", "./a/file"}, // the file as it was when linked
+		{"b/c/file", "/b/c/file"},
+		{"link-rel", "a/file"},
+		{"b/link-up/file", "a/file"},
+		{"b/link-abs/file", "/b/c/file"},
+		{"b/link-root/b/link-up/hardlink", "./a/file"},
+	} {
+		got, err := fs.ReadFile(x, tt.name)
+		if err != nil || string(got) != tt.content {
+			t.Errorf("ReadFile(%q) = (%q, %v), want %q", tt.name, got, err, tt.content)
+		}
+	}
+	for _, name := range []string{"dangling", "missing", "missing-target", "a/file/x", "link-rel/x"} {
+		if _, err := x.Open(name); !errors.Is(err, fs.ErrNotExist) {
+			t.Errorf("Open(%q): %v, want ErrNotExist", name, err)
+		}
+	}
+	if _, err := x.Open("loop"); err == nil || errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("Open of a symlink loop: %v, want an error", err)
+	}
+	if _, err := x.Open("./a"); !errors.Is(err, fs.ErrInvalid) {
+		t.Errorf("Open of an invalid name: %v, want ErrInvalid", err)
+	}
+
+	for _, tt := range []struct {
+		name  string
+		mode  fs.FileMode
+		size  int64
+		mtime time.Time
+	}{
+		{".", fs.ModeDir | 0755, 0, mtime},
+		{"a", fs.ModeDir | 0700, 0, mtime},
+		{"b", fs.ModeDir | 0555, 0, time.Time{}},
+		{"a/hardlink", 0644, 8, mtime},
+		{"b/link-up", fs.ModeDir | 0700, 0, mtime},
+		{"link-rel", 0644, 6, time.Unix(0, 0)},
+	} {
+		fi, err := x.Stat(tt.name)
+		if err != nil {
+			t.Errorf("Stat(%q): %v", tt.name, err)
+			continue
+		}
+		base := tt.name[strings.LastIndex(tt.name, "/")+1:]
+		if fi.Name() != base || fi.Mode() != tt.mode || fi.Size() != tt.size || !fi.ModTime().Equal(tt.mtime) {
+			t.Errorf("Stat(%q) = %v, want mode %v, size %d, mtime %v", tt.name, fi, tt.mode, tt.size, tt.mtime)
+		}
+	}
+
+	// Directory entries describe symbolic links themselves,
+	// as they do for os.DirFS.
+	entries, err := x.ReadDir("b")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, e := range entries {
+		got = append(got, e.Name()+":"+e.Type().String())
+	}
+	want := "c:d---------,link-abs:L---------,link-root:L---------,link-up:L---------"
+	if strings.Join(got, ",") != want {
+		t.Errorf("ReadDir(b) = %v, want %v", got, want)
+	}
+}
+
+func TestIndexWalkDirSymlinks(t *testing.T) {
+	// fs.WalkDir does not follow the symbolic links,
+	// so it terminates even though they form cycles.
+	x := indexTestFS(t,
+		&Header{Name: "dir/", Typeflag: TypeDir, Mode: 0755},
+		&Header{Name: "dir/file", Typeflag: TypeReg, Mode: 0644},
+		&Header{Name: "dir/loop", Typeflag: TypeSymlink, Linkname: "."},
+		&Header{Name: "dir/up", Typeflag: TypeSymlink, Linkname: ".."},
+	)
+	var got []string
+	err := fs.WalkDir(x, ".", func(name string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		got = append(got, name)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{".", "dir", "dir/file", "dir/loop", "dir/up"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("WalkDir visited %v, want %v", got, want)
+	}
+}
+
+func TestIndexFSTest(t *testing.T) {
+	x := indexTestFS(t,
+		&Header{Name: "dir/", Typeflag: TypeDir, Mode: 0755},
+		&Header{Name: "dir/file", Typeflag: TypeReg, Mode: 0644},
+		&Header{Name: "dir/sub/file", Typeflag: TypeReg, Mode: 0644},
+		&Header{Name: "file", Typeflag: TypeReg, Mode: 0644},
+		&Header{Name: "hardlink", Typeflag: TypeLink, Linkname: "file"},
+		&Header{Name: "symlink", Typeflag: TypeSymlink, Linkname: "dir/file"},
+		&Header{Name: "sparse", Typeflag: TypeReg, Mode: 0644, Size: 1 << 20,
+			SparseHoles: []SparseEntry{{0, 1<<20 - 512}}},
+	)
+	if err := fstest.TestFS(x, "dir/file", "dir/sub/file", "file", "hardlink", "symlink", "sparse"); err != nil {
+		t.Fatal(err)
+	}
+}
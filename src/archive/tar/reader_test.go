@@ -24,6 +24,14 @@ import (
 )
 
 func TestReader(t *testing.T) {
+	// The sparse files in sparse-formats.tar have a 1-byte hole at every
+	// even offset, followed by a 10-byte hole at the end.
+	var sparseHoles []SparseEntry
+	for i := int64(0); i < 190; i += 2 {
+		sparseHoles = append(sparseHoles, SparseEntry{i, 1})
+	}
+	sparseHoles = append(sparseHoles, SparseEntry{190, 10})
+
 	vectors := []struct {
 		file    string    // Test input file
 		headers []*Header // Expected output headers
@@ -61,32 +69,34 @@ func TestReader(t *testing.T) {
 	}, {
 		file: "testdata/sparse-formats.tar",
 		headers: []*Header{{
-			Name:     "sparse-gnu",
-			Mode:     420,
-			Uid:      1000,
-			Gid:      1000,
-			Size:     200,
-			ModTime:  time.Unix(1392395740, 0),
-			Typeflag: 0x53,
-			Linkname: "",
-			Uname:    "david",
-			Gname:    "david",
-			Devmajor: 0,
-			Devminor: 0,
-			Format:   FormatGNU,
+			Name:        "sparse-gnu",
+			Mode:        420,
+			Uid:         1000,
+			Gid:         1000,
+			Size:        200,
+			SparseHoles: sparseHoles,
+			ModTime:     time.Unix(1392395740, 0),
+			Typeflag:    0x53,
+			Linkname:    "",
+			Uname:       "david",
+			Gname:       "david",
+			Devmajor:    0,
+			Devminor:    0,
+			Format:      FormatGNU,
 		}, {
-			Name:     "sparse-posix-0.0",
-			Mode:     420,
-			Uid:      1000,
-			Gid:      1000,
-			Size:     200,
-			ModTime:  time.Unix(1392342187, 0),
-			Typeflag: 0x30,
-			Linkname: "",
-			Uname:    "david",
-			Gname:    "david",
-			Devmajor: 0,
-			Devminor: 0,
+			Name:        "sparse-posix-0.0",
+			Mode:        420,
+			Uid:         1000,
+			Gid:         1000,
+			Size:        200,
+			SparseHoles: sparseHoles,
+			ModTime:     time.Unix(1392342187, 0),
+			Typeflag:    0x30,
+			Linkname:    "",
+			Uname:       "david",
+			Gname:       "david",
+			Devmajor:    0,
+			Devminor:    0,
 			PAXRecords: map[string]string{
 				"GNU.sparse.size":      "200",
 				"GNU.sparse.numblocks": "95",
@@ -94,18 +104,19 @@ func TestReader(t *testing.T) {
 			},
 			Format: FormatPAX,
 		}, {
-			Name:     "sparse-posix-0.1",
-			Mode:     420,
-			Uid:      1000,
-			Gid:      1000,
-			Size:     200,
-			ModTime:  time.Unix(1392340456, 0),
-			Typeflag: 0x30,
-			Linkname: "",
-			Uname:    "david",
-			Gname:    "david",
-			Devmajor: 0,
-			Devminor: 0,
+			Name:        "sparse-posix-0.1",
+			Mode:        420,
+			Uid:         1000,
+			Gid:         1000,
+			Size:        200,
+			SparseHoles: sparseHoles,
+			ModTime:     time.Unix(1392340456, 0),
+			Typeflag:    0x30,
+			Linkname:    "",
+			Uname:       "david",
+			Gname:       "david",
+			Devmajor:    0,
+			Devminor:    0,
 			PAXRecords: map[string]string{
 				"GNU.sparse.size":      "200",
 				"GNU.sparse.numblocks": "95",
@@ -114,18 +125,19 @@ func TestReader(t *testing.T) {
 			},
 			Format: FormatPAX,
 		}, {
-			Name:     "sparse-posix-1.0",
-			Mode:     420,
-			Uid:      1000,
-			Gid:      1000,
-			Size:     200,
-			ModTime:  time.Unix(1392337404, 0),
-			Typeflag: 0x30,
-			Linkname: "",
-			Uname:    "david",
-			Gname:    "david",
-			Devmajor: 0,
-			Devminor: 0,
+			Name:        "sparse-posix-1.0",
+			Mode:        420,
+			Uid:         1000,
+			Gid:         1000,
+			Size:        200,
+			SparseHoles: sparseHoles,
+			ModTime:     time.Unix(1392337404, 0),
+			Typeflag:    0x30,
+			Linkname:    "",
+			Uname:       "david",
+			Gname:       "david",
+			Devmajor:    0,
+			Devminor:    0,
 			PAXRecords: map[string]string{
 				"GNU.sparse.major":    "1",
 				"GNU.sparse.minor":    "0",
@@ -432,18 +444,19 @@ func TestReader(t *testing.T) {
 			ChangeTime: time.Unix(1441973436, 0),
 			Format:     FormatGNU,
 		}, {
-			Name:       "test2/sparse",
-			Mode:       33188,
-			Uid:        1000,
-			Gid:        1000,
-			Size:       536870912,
-			ModTime:    time.Unix(1441973427, 0),
-			Typeflag:   'S',
-			Uname:      "rawr",
-			Gname:      "dsnet",
-			AccessTime: time.Unix(1441991948, 0),
-			ChangeTime: time.Unix(1441973436, 0),
-			Format:     FormatGNU,
+			Name:        "test2/sparse",
+			Mode:        33188,
+			Uid:         1000,
+			Gid:         1000,
+			Size:        536870912,
+			SparseHoles: []SparseEntry{{0, 536870912}},
+			ModTime:     time.Unix(1441973427, 0),
+			Typeflag:    'S',
+			Uname:       "rawr",
+			Gname:       "dsnet",
+			AccessTime:  time.Unix(1441991948, 0),
+			ChangeTime:  time.Unix(1441973436, 0),
+			Format:      FormatGNU,
 		}},
 	}, {
 		// Matches the behavior of GNU and BSD tar utilities.
@@ -560,30 +573,33 @@ func TestReader(t *testing.T) {
 		// Generated by Go, works on BSD tar v3.1.2 and GNU tar v.1.27.1.
 		file: "testdata/gnu-nil-sparse-data.tar",
 		headers: []*Header{{
-			Name:     "sparse.db",
-			Typeflag: TypeGNUSparse,
-			Size:     1000,
-			ModTime:  time.Unix(0, 0),
-			Format:   FormatGNU,
+			Name:        "sparse.db",
+			Typeflag:    TypeGNUSparse,
+			Size:        1000,
+			SparseHoles: []SparseEntry{{1000, 0}},
+			ModTime:     time.Unix(0, 0),
+			Format:      FormatGNU,
 		}},
 	}, {
 		// Generated by Go, works on BSD tar v3.1.2 and GNU tar v.1.27.1.
 		file: "testdata/gnu-nil-sparse-hole.tar",
 		headers: []*Header{{
-			Name:     "sparse.db",
-			Typeflag: TypeGNUSparse,
-			Size:     1000,
-			ModTime:  time.Unix(0, 0),
-			Format:   FormatGNU,
+			Name:        "sparse.db",
+			Typeflag:    TypeGNUSparse,
+			Size:        1000,
+			SparseHoles: []SparseEntry{{0, 1000}},
+			ModTime:     time.Unix(0, 0),
+			Format:      FormatGNU,
 		}},
 	}, {
 		// Generated by Go, works on BSD tar v3.1.2 and GNU tar v.1.27.1.
 		file: "testdata/pax-nil-sparse-data.tar",
 		headers: []*Header{{
-			Name:     "sparse.db",
-			Typeflag: TypeReg,
-			Size:     1000,
-			ModTime:  time.Unix(0, 0),
+			Name:        "sparse.db",
+			Typeflag:    TypeReg,
+			Size:        1000,
+			SparseHoles: []SparseEntry{{1000, 0}},
+			ModTime:     time.Unix(0, 0),
 			PAXRecords: map[string]string{
 				"size":                "1512",
 				"GNU.sparse.major":    "1",
@@ -597,10 +613,11 @@ func TestReader(t *testing.T) {
 		// Generated by Go, works on BSD tar v3.1.2 and GNU tar v.1.27.1.
 		file: "testdata/pax-nil-sparse-hole.tar",
 		headers: []*Header{{
-			Name:     "sparse.db",
-			Typeflag: TypeReg,
-			Size:     1000,
-			ModTime:  time.Unix(0, 0),
+			Name:        "sparse.db",
+			Typeflag:    TypeReg,
+			Size:        1000,
+			SparseHoles: []SparseEntry{{0, 1000}},
+			ModTime:     time.Unix(0, 0),
 			PAXRecords: map[string]string{
 				"size":                "512",
 				"GNU.sparse.major":    "1",
@@ -1061,7 +1078,7 @@ func TestReadOldGNUSparseMap(t *testing.T) {
 		return out
 	}
 
-	makeSparseStrings := func(sp []sparseEntry) (out []string) {
+	makeSparseStrings := func(sp []SparseEntry) (out []string) {
 		var f formatter
 		for _, s := range sp {
 			var b [24]byte
@@ -1315,7 +1332,7 @@ func TestReadGNUSparsePAXHeaders(t *testing.T) {
 		inputHdrs: map[string]string{paxGNUSparseMajor: "1", paxGNUSparseMinor: "0"},
 		wantMap: func() (spd sparseDatas) {
 			for i := 0; i < 100; i++ {
-				spd = append(spd, sparseEntry{int64(i) << 30, 512})
+				spd = append(spd, SparseEntry{int64(i) << 30, 512})
 			}
 			return spd
 		}(),
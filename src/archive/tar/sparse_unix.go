@@ -0,0 +1,59 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build linux || darwin || dragonfly || freebsd || illumos || ios || solaris
+
+package tar
+
+import (
+	"errors"
+	"io"
+	"os"
+	"runtime"
+	"syscall"
+)
+
+func init() {
+	sysSparseDetect = sparseDetectUnix
+}
+
+func sparseDetectUnix(f *os.File) (sph sparseHoles, err error) {
+	// SEEK_DATA and SEEK_HOLE originated from Solaris and support for them
+	// has been added to most of the other major Unix systems.
+	seekData, seekHole := 3, 4 // SEEK_DATA/SEEK_HOLE from unistd.h
+	if runtime.GOOS == "darwin" || runtime.GOOS == "ios" {
+		// Darwin has the constants swapped, compared to all other Unix.
+		seekData, seekHole = 4, 3
+	}
+
+	end, err := f.Seek(0, io.SeekEnd)
+	if err != nil {
+		return nil, err
+	}
+	for pos := int64(0); pos < end; {
+		hole, err := f.Seek(pos, seekHole)
+		if err != nil {
+			if pos == 0 {
+				// Different systems and file systems report the lack of
+				// support with different errors. Rather than special-casing
+				// every one of them, treat the file as having no holes.
+				return nil, nil
+			}
+			return nil, err
+		}
+		if hole >= end {
+			break // The implicit hole at the end of the file
+		}
+		data, err := f.Seek(hole, seekData)
+		if errors.Is(err, syscall.ENXIO) {
+			data, err = end, nil // There is no data after the hole
+		}
+		if err != nil {
+			return nil, err
+		}
+		sph = append(sph, SparseEntry{Offset: hole, Length: data - hole})
+		pos = data
+	}
+	return sph, nil
+}
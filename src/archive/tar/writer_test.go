@@ -233,6 +233,27 @@ func TestWriter(t *testing.T) {
 				Name:     "null\x00.txt",
 			}, headerError{}},
 		},
+	}, {
+		tests: []testFnc{
+			testHeader{Header{
+				Typeflag:    TypeDir,
+				Name:        "dir/",
+				SparseHoles: []SparseEntry{{Offset: 0, Length: 0}},
+			}, headerError{}},
+			testHeader{Header{
+				Typeflag:    TypeReg,
+				Name:        "sparse.db",
+				Size:        1000,
+				SparseHoles: []SparseEntry{{Offset: 500, Length: 1000}},
+			}, headerError{}},
+			testHeader{Header{
+				Typeflag:    TypeReg,
+				Name:        "sparse.db",
+				Size:        1000,
+				SparseHoles: []SparseEntry{{Offset: 0, Length: 1000}},
+				Format:      FormatGNU,
+			}, headerError{}},
+		},
 	}, {
 		file: "testdata/pax-records.tar",
 		tests: []testFnc{
@@ -340,122 +361,62 @@ func TestWriter(t *testing.T) {
 			}, nil},
 			testClose{nil},
 		},
-		// TODO(dsnet): Re-enable this test when adding sparse support.
-		// See https://golang.org/issue/22735
-		/*
-			}, {
-				file: "testdata/gnu-nil-sparse-data.tar",
-				tests: []testFnc{
-					testHeader{Header{
-						Typeflag:    TypeGNUSparse,
-						Name:        "sparse.db",
-						Size:        1000,
-						SparseHoles: []sparseEntry{{Offset: 1000, Length: 0}},
-					}, nil},
-					testWrite{strings.Repeat("0123456789", 100), 1000, nil},
-					testClose{},
-				},
-			}, {
-				file: "testdata/gnu-nil-sparse-hole.tar",
-				tests: []testFnc{
-					testHeader{Header{
-						Typeflag:    TypeGNUSparse,
-						Name:        "sparse.db",
-						Size:        1000,
-						SparseHoles: []sparseEntry{{Offset: 0, Length: 1000}},
-					}, nil},
-					testWrite{strings.Repeat("\x00", 1000), 1000, nil},
-					testClose{},
-				},
-			}, {
-				file: "testdata/pax-nil-sparse-data.tar",
-				tests: []testFnc{
-					testHeader{Header{
-						Typeflag:    TypeReg,
-						Name:        "sparse.db",
-						Size:        1000,
-						SparseHoles: []sparseEntry{{Offset: 1000, Length: 0}},
-					}, nil},
-					testWrite{strings.Repeat("0123456789", 100), 1000, nil},
-					testClose{},
-				},
-			}, {
-				file: "testdata/pax-nil-sparse-hole.tar",
-				tests: []testFnc{
-					testHeader{Header{
-						Typeflag:    TypeReg,
-						Name:        "sparse.db",
-						Size:        1000,
-						SparseHoles: []sparseEntry{{Offset: 0, Length: 1000}},
-					}, nil},
-					testWrite{strings.Repeat("\x00", 1000), 1000, nil},
-					testClose{},
-				},
-			}, {
-				file: "testdata/gnu-sparse-big.tar",
-				tests: []testFnc{
-					testHeader{Header{
-						Typeflag: TypeGNUSparse,
-						Name:     "gnu-sparse",
-						Size:     6e10,
-						SparseHoles: []sparseEntry{
-							{Offset: 0e10, Length: 1e10 - 100},
-							{Offset: 1e10, Length: 1e10 - 100},
-							{Offset: 2e10, Length: 1e10 - 100},
-							{Offset: 3e10, Length: 1e10 - 100},
-							{Offset: 4e10, Length: 1e10 - 100},
-							{Offset: 5e10, Length: 1e10 - 100},
-						},
-					}, nil},
-					testReadFrom{fileOps{
-						int64(1e10 - blockSize),
-						strings.Repeat("\x00", blockSize-100) + strings.Repeat("0123456789", 10),
-						int64(1e10 - blockSize),
-						strings.Repeat("\x00", blockSize-100) + strings.Repeat("0123456789", 10),
-						int64(1e10 - blockSize),
-						strings.Repeat("\x00", blockSize-100) + strings.Repeat("0123456789", 10),
-						int64(1e10 - blockSize),
-						strings.Repeat("\x00", blockSize-100) + strings.Repeat("0123456789", 10),
-						int64(1e10 - blockSize),
-						strings.Repeat("\x00", blockSize-100) + strings.Repeat("0123456789", 10),
-						int64(1e10 - blockSize),
-						strings.Repeat("\x00", blockSize-100) + strings.Repeat("0123456789", 10),
-					}, 6e10, nil},
-					testClose{nil},
-				},
-			}, {
-				file: "testdata/pax-sparse-big.tar",
-				tests: []testFnc{
-					testHeader{Header{
-						Typeflag: TypeReg,
-						Name:     "pax-sparse",
-						Size:     6e10,
-						SparseHoles: []sparseEntry{
-							{Offset: 0e10, Length: 1e10 - 100},
-							{Offset: 1e10, Length: 1e10 - 100},
-							{Offset: 2e10, Length: 1e10 - 100},
-							{Offset: 3e10, Length: 1e10 - 100},
-							{Offset: 4e10, Length: 1e10 - 100},
-							{Offset: 5e10, Length: 1e10 - 100},
-						},
-					}, nil},
-					testReadFrom{fileOps{
-						int64(1e10 - blockSize),
-						strings.Repeat("\x00", blockSize-100) + strings.Repeat("0123456789", 10),
-						int64(1e10 - blockSize),
-						strings.Repeat("\x00", blockSize-100) + strings.Repeat("0123456789", 10),
-						int64(1e10 - blockSize),
-						strings.Repeat("\x00", blockSize-100) + strings.Repeat("0123456789", 10),
-						int64(1e10 - blockSize),
-						strings.Repeat("\x00", blockSize-100) + strings.Repeat("0123456789", 10),
-						int64(1e10 - blockSize),
-						strings.Repeat("\x00", blockSize-100) + strings.Repeat("0123456789", 10),
-						int64(1e10 - blockSize),
-						strings.Repeat("\x00", blockSize-100) + strings.Repeat("0123456789", 10),
-					}, 6e10, nil},
-					testClose{nil},
+	}, {
+		file: "testdata/pax-nil-sparse-data.tar",
+		tests: []testFnc{
+			testHeader{Header{
+				Typeflag:    TypeReg,
+				Name:        "sparse.db",
+				Size:        1000,
+				SparseHoles: []SparseEntry{{Offset: 1000, Length: 0}},
+			}, nil},
+			testWrite{strings.Repeat("0123456789", 100), 1000, nil},
+			testClose{nil},
+		},
+	}, {
+		file: "testdata/pax-nil-sparse-hole.tar",
+		tests: []testFnc{
+			testHeader{Header{
+				Typeflag:    TypeReg,
+				Name:        "sparse.db",
+				Size:        1000,
+				SparseHoles: []SparseEntry{{Offset: 0, Length: 1000}},
+			}, nil},
+			testWrite{strings.Repeat("\x00", 1000), 1000, nil},
+			testClose{nil},
+		},
+	}, {
+		file: "testdata/pax-sparse-big.tar",
+		tests: []testFnc{
+			testHeader{Header{
+				Typeflag: TypeReg,
+				Name:     "pax-sparse",
+				Size:     6e10,
+				SparseHoles: []SparseEntry{
+					{Offset: 0e10, Length: 1e10 - 100},
+					{Offset: 1e10, Length: 1e10 - 100},
+					{Offset: 2e10, Length: 1e10 - 100},
+					{Offset: 3e10, Length: 1e10 - 100},
+					{Offset: 4e10, Length: 1e10 - 100},
+					{Offset: 5e10, Length: 1e10 - 100},
 				},
-		*/
+			}, nil},
+			testReadFrom{fileOps{
+				int64(1e10 - blockSize),
+				strings.Repeat("\x00", blockSize-100) + strings.Repeat("0123456789", 10),
+				int64(1e10 - blockSize),
+				strings.Repeat("\x00", blockSize-100) + strings.Repeat("0123456789", 10),
+				int64(1e10 - blockSize),
+				strings.Repeat("\x00", blockSize-100) + strings.Repeat("0123456789", 10),
+				int64(1e10 - blockSize),
+				strings.Repeat("\x00", blockSize-100) + strings.Repeat("0123456789", 10),
+				int64(1e10 - blockSize),
+				strings.Repeat("\x00", blockSize-100) + strings.Repeat("0123456789", 10),
+				int64(1e10 - blockSize),
+				strings.Repeat("\x00", blockSize-100) + strings.Repeat("0123456789", 10),
+			}, 6e10, nil},
+			testClose{nil},
+		},
 	}, {
 		file: "testdata/trailing-slash.tar",
 		tests: []testFnc{
@@ -501,7 +462,7 @@ func TestWriter(t *testing.T) {
 					}
 				case testReadFrom:
 					f := &testFile{ops: tf.ops}
-					got, err := tw.readFrom(f)
+					got, err := tw.ReadFrom(f)
 					if _, ok := err.(testError); ok {
 						t.Errorf("test %d, ReadFrom(): %v", i, err)
 					} else if got != tf.wantCnt || !equalError(err, tf.wantErr) {
@@ -825,6 +786,86 @@ func TestValidTypeflagWithPAXHeader(t *testing.T) {
 	}
 }
 
+func TestWriterCopySparse(t *testing.T) {
+	// Copying the entries of an archive with sparse files in every
+	// format writes them as PAX sparse files.
+	b, err := os.ReadFile("testdata/sparse-formats.tar")
+	if err != nil {
+		t.Fatal(err)
+	}
+	type entry struct {
+		hdr  *Header
+		data []byte
+	}
+	readAll := func(r io.Reader) []entry {
+		var entries []entry
+		tr := NewReader(r)
+		for {
+			hdr, err := tr.Next()
+			if err == io.EOF {
+				return entries
+			}
+			if err != nil {
+				t.Fatalf("tr.Next: %v", err)
+			}
+			data, err := io.ReadAll(tr)
+			if err != nil {
+				t.Fatalf("%s: Read: %v", hdr.Name, err)
+			}
+			entries = append(entries, entry{hdr, data})
+		}
+	}
+	want := readAll(bytes.NewReader(b))
+
+	var buf bytes.Buffer
+	tw := NewWriter(&buf)
+	tr := NewReader(bytes.NewReader(b))
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatalf("tr.Next: %v", err)
+		}
+		if err := tw.WriteHeader(hdr); err != nil {
+			t.Fatalf("%s: tw.WriteHeader: %v", hdr.Name, err)
+		}
+		if _, err := io.Copy(tw, tr); err != nil {
+			t.Fatalf("%s: io.Copy: %v", hdr.Name, err)
+		}
+	}
+	if err := tw.Close(); err != nil {
+		t.Fatalf("tw.Close: %v", err)
+	}
+
+	got := readAll(&buf)
+	if len(got) != len(want) {
+		t.Fatalf("got %d entries, want %d", len(got), len(want))
+	}
+	for i, g := range got {
+		w := want[i]
+		if g.hdr.Name != w.hdr.Name || g.hdr.Size != w.hdr.Size {
+			t.Errorf("entry %d: got name %q, size %d, want %q, %d", i, g.hdr.Name, g.hdr.Size, w.hdr.Name, w.hdr.Size)
+		}
+		if g.hdr.Typeflag != TypeReg {
+			t.Errorf("%s: got type %q, want %q", g.hdr.Name, g.hdr.Typeflag, TypeReg)
+		}
+		// The Writer aligns the holes to blocks.
+		var sph []SparseEntry
+		if len(w.hdr.SparseHoles) > 0 {
+			sph = alignSparseEntries(slices.Clone(w.hdr.SparseHoles), w.hdr.Size)
+			sph = invertSparseEntries(invertSparseEntries(sph, w.hdr.Size), w.hdr.Size)
+		}
+		if !slices.Equal(g.hdr.SparseHoles, sph) {
+			t.Errorf("%s: got holes %v, want %v", g.hdr.Name, g.hdr.SparseHoles, sph)
+		}
+		if !bytes.Equal(g.data, w.data) {
+			t.Errorf("%s: content mismatch", g.hdr.Name)
+		}
+	}
+}
+
 // failOnceWriter fails exactly once and then always reports success.
 type failOnceWriter bool
 
@@ -0,0 +1,67 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tar
+
+import (
+	"strings"
+	"syscall"
+)
+
+func init() {
+	sysXattrs = xattrsLinux
+}
+
+func xattrsLinux(name string, h *Header) error {
+	list, err := xattrCall(func(b []byte) (int, error) {
+		return syscall.Listxattr(name, b)
+	})
+	if err == syscall.ENOTSUP {
+		return nil // The file system does not support extended attributes
+	}
+	if err != nil {
+		return err
+	}
+	for _, key := range strings.Split(list, "\x00") {
+		if key == "" {
+			continue
+		}
+		val, err := xattrCall(func(b []byte) (int, error) {
+			return syscall.Getxattr(name, key, b)
+		})
+		if err == syscall.ENODATA {
+			continue // Removed since the list was read
+		}
+		if err != nil {
+			return err
+		}
+		if h.PAXRecords == nil {
+			h.PAXRecords = make(map[string]string)
+		}
+		h.PAXRecords[paxSchilyXattr+key] = val
+	}
+	return nil
+}
+
+// xattrCall calls f, which fills its argument as Listxattr and Getxattr do,
+// with a large enough buffer and returns the result.
+func xattrCall(f func([]byte) (int, error)) (string, error) {
+	b := make([]byte, 256)
+	for {
+		n, err := f(b)
+		if err == syscall.ERANGE {
+			// The buffer is too small. Ask for the size, which may
+			// grow again before the next call.
+			if n, err = f(nil); err != nil {
+				return "", err
+			}
+			b = make([]byte, max(n, 2*len(b)))
+			continue
+		}
+		if err != nil {
+			return "", err
+		}
+		return string(b[:n]), nil
+	}
+}
@@ -0,0 +1,67 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tar
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"syscall"
+	"testing"
+)
+
+func TestDetectXattrs(t *testing.T) {
+	name := filepath.Join(t.TempDir(), "file")
+	if err := os.WriteFile(name, []byte("hello"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	// The value is longer than the initial buffer of xattrCall.
+	value := string(bytes.Repeat([]byte{0, 1, 2, 0xff}, 100))
+	for _, key := range []string{"user.a", "user.b"} {
+		if err := syscall.Setxattr(name, key, []byte(value+key), 0); err != nil {
+			t.Skipf("setting extended attributes: %v", err)
+		}
+	}
+
+	fi, err := os.Lstat(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	h, err := FileInfoHeader(fi, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(h.PAXRecords) != 0 {
+		t.Errorf("FileInfoHeader recorded %q", h.PAXRecords)
+	}
+	if err := h.DetectXattrs(name); err != nil {
+		t.Fatal(err)
+	}
+	for _, key := range []string{"user.a", "user.b"} {
+		if got := h.PAXRecords["SCHILY.xattr."+key]; got != value+key {
+			t.Errorf("record for %s = %q, want %q", key, got, value+key)
+		}
+	}
+
+	// The attributes survive a round trip through an archive.
+	var b bytes.Buffer
+	tw := NewWriter(&b)
+	if err := tw.WriteHeader(h); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := tw.Write([]byte("hello")); err != nil {
+		t.Fatal(err)
+	}
+	if err := tw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	rh, err := NewReader(&b).Next()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rh.Format != FormatPAX || rh.PAXRecords["SCHILY.xattr.user.b"] != value+"user.b" {
+		t.Errorf("got format %v, records %q", rh.Format, rh.PAXRecords)
+	}
+}
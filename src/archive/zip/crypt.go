@@ -0,0 +1,305 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package zip
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"errors"
+	"hash"
+	"hash/crc32"
+	"internal/pbkdf2"
+	"io"
+)
+
+// ErrPassword is returned when opening an encrypted file without a
+// password or with an incorrect one.
+var ErrPassword = errors.New("zip: invalid password")
+
+// The WinZip AES format is described in
+// https://www.winzip.com/en/support/aes-encryption/.
+const (
+	aesMethod      = 99 // compression method recorded for AES encrypted files
+	aesVendorID    = 0x4541
+	aesVerifierLen = 2
+	aesMACLen      = 10
+	aesIterations  = 1000
+
+	zipCryptoHeaderLen = 12
+)
+
+// isAES reports whether e is one of the AES encryption methods.
+func (e Encryption) isAES() bool {
+	return e >= AES128 && e <= AES256
+}
+
+// aesKeyLen returns the key length in bytes of AES encryption method e.
+// The salt is half as long.
+func (e Encryption) aesKeyLen() int {
+	return 8 + 8*int(e-AES128+1)
+}
+
+// storedMethod returns the method that the archive records for h.
+func (h *FileHeader) storedMethod() uint16 {
+	if h.Encryption.isAES() {
+		return aesMethod
+	}
+	return h.Method
+}
+
+// appendAESExtra appends the AES extra field for h, of the given
+// vendor version, to b.
+func appendAESExtra(b []byte, h *FileHeader, version uint16) []byte {
+	var buf [11]byte
+	eb := writeBuf(buf[:])
+	eb.uint16(aesExtraID)
+	eb.uint16(7) // size
+	eb.uint16(version)
+	eb.uint16(aesVendorID)
+	eb.uint8(uint8(h.Encryption - AES128 + 1))
+	eb.uint16(h.Method)
+	return append(b, buf[:]...)
+}
+
+// removeExtra returns a copy of extra without the fields with the given tag.
+func removeExtra(extra []byte, tag uint16) []byte {
+	out := extra[:0:0]
+	for b := readBuf(extra); len(b) >= 4; {
+		field := b
+		fieldTag := b.uint16()
+		size := min(int(b.uint16()), len(b))
+		b = b[size:]
+		if fieldTag != tag {
+			out = append(out, field[:4+size]...)
+		}
+	}
+	return out
+}
+
+// aesKeys derives the encryption key, the authentication key and the
+// password verification value for the given password and salt.
+func aesKeys(password, salt []byte, keyLen int) (key, macKey, verifier []byte) {
+	dk := pbkdf2.Key(sha1.New, password, salt, aesIterations, 2*keyLen+aesVerifierLen)
+	return dk[:keyLen], dk[keyLen : 2*keyLen], dk[2*keyLen:]
+}
+
+// aesCTR is AES in counter mode with a little-endian counter that
+// starts at one, as WinZip uses it.
+type aesCTR struct {
+	block   cipher.Block
+	counter [aes.BlockSize]byte
+	stream  [aes.BlockSize]byte
+	used    int // bytes of stream used
+}
+
+func newAESCTR(key []byte) *aesCTR {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		panic(err) // key length is always valid
+	}
+	return &aesCTR{block: block, used: aes.BlockSize}
+}
+
+// xor sets dst to src XOR the key stream. dst and src may overlap
+// exactly.
+func (c *aesCTR) xor(dst, src []byte) {
+	for i := range src {
+		if c.used == aes.BlockSize {
+			for j := range c.counter {
+				c.counter[j]++
+				if c.counter[j] != 0 {
+					break
+				}
+			}
+			c.block.Encrypt(c.stream[:], c.counter[:])
+			c.used = 0
+		}
+		dst[i] = src[i] ^ c.stream[c.used]
+		c.used++
+	}
+}
+
+// aesReader decrypts the data of an AES encrypted file and
+// authenticates it.
+type aesReader struct {
+	data *io.SectionReader
+	mac  hash.Hash
+	ctr  *aesCTR
+	want []byte // expected authentication code
+	err  error  // result of verify, once done
+	done bool
+}
+
+// newAESReader returns a reader of the decrypted content of the AES
+// encrypted file data, which is read from r and has the given size.
+func newAESReader(r io.ReaderAt, off, size int64, e Encryption, password []byte) (*aesReader, error) {
+	saltLen := e.aesKeyLen() / 2
+	overhead := int64(saltLen + aesVerifierLen + aesMACLen)
+	if size < overhead {
+		return nil, ErrFormat
+	}
+	buf := make([]byte, saltLen+aesVerifierLen+aesMACLen)
+	if _, err := r.ReadAt(buf[:saltLen+aesVerifierLen], off); err != nil {
+		return nil, err
+	}
+	if _, err := r.ReadAt(buf[saltLen+aesVerifierLen:], off+size-aesMACLen); err != nil {
+		return nil, err
+	}
+	key, macKey, verifier := aesKeys(password, buf[:saltLen], e.aesKeyLen())
+	if !hmac.Equal(verifier, buf[saltLen:saltLen+aesVerifierLen]) {
+		return nil, ErrPassword
+	}
+	return &aesReader{
+		data: io.NewSectionReader(r, off+int64(saltLen+aesVerifierLen), size-overhead),
+		mac:  hmac.New(sha1.New, macKey),
+		ctr:  newAESCTR(key),
+		want: buf[saltLen+aesVerifierLen:],
+	}, nil
+}
+
+func (r *aesReader) Read(p []byte) (int, error) {
+	n, err := r.data.Read(p)
+	r.mac.Write(p[:n])
+	r.ctr.xor(p[:n], p[:n])
+	if err == io.EOF {
+		if verr := r.verify(); verr != nil {
+			err = verr
+		}
+	}
+	return n, err
+}
+
+// verify reads the rest of the encrypted data and checks the
+// authentication code. It returns ErrChecksum if it does not match.
+func (r *aesReader) verify() error {
+	if r.done {
+		return r.err
+	}
+	r.done = true
+	if _, err := io.Copy(r.mac, r.data); err != nil {
+		r.err = err
+	} else if !hmac.Equal(r.mac.Sum(nil)[:aesMACLen], r.want) {
+		r.err = ErrChecksum
+	}
+	return r.err
+}
+
+// aesWriter encrypts the content of a file with AES. The salt and
+// password verification value are written before the first byte of
+// data, and the authentication code by Close.
+type aesWriter struct {
+	w      io.Writer
+	mac    hash.Hash
+	ctr    *aesCTR
+	header []byte // salt and password verification value, until written
+	buf    []byte
+}
+
+func newAESWriter(w io.Writer, e Encryption, password []byte) (*aesWriter, error) {
+	salt := make([]byte, e.aesKeyLen()/2)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, err
+	}
+	key, macKey, verifier := aesKeys(password, salt, e.aesKeyLen())
+	return &aesWriter{
+		w:      w,
+		mac:    hmac.New(sha1.New, macKey),
+		ctr:    newAESCTR(key),
+		header: append(salt, verifier...),
+	}, nil
+}
+
+func (w *aesWriter) writeHeader() error {
+	if w.header == nil {
+		return nil
+	}
+	_, err := w.w.Write(w.header)
+	w.header = nil
+	return err
+}
+
+func (w *aesWriter) Write(p []byte) (int, error) {
+	if err := w.writeHeader(); err != nil {
+		return 0, err
+	}
+	w.buf = append(w.buf[:0], p...)
+	w.ctr.xor(w.buf, w.buf)
+	w.mac.Write(w.buf)
+	return w.w.Write(w.buf)
+}
+
+// Close writes the authentication code. It does not close the
+// underlying writer.
+func (w *aesWriter) Close() error {
+	if err := w.writeHeader(); err != nil {
+		return err
+	}
+	_, err := w.w.Write(w.mac.Sum(nil)[:aesMACLen])
+	return err
+}
+
+// zipCryptoReader decrypts data encrypted with the traditional PKWARE
+// encryption.
+type zipCryptoReader struct {
+	r    io.Reader
+	keys [3]uint32
+}
+
+// newZipCryptoReader returns a reader of the decrypted content of r.
+// It reads the encryption header, and returns ErrPassword if its last
+// byte does not match check.
+func newZipCryptoReader(r io.Reader, password []byte, check byte) (*zipCryptoReader, error) {
+	z := &zipCryptoReader{
+		r:    r,
+		keys: [3]uint32{0x12345678, 0x23456789, 0x34567890},
+	}
+	for _, b := range password {
+		z.update(b)
+	}
+	var header [zipCryptoHeaderLen]byte
+	if _, err := io.ReadFull(z, header[:]); err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return nil, err
+	}
+	if header[zipCryptoHeaderLen-1] != check {
+		return nil, ErrPassword
+	}
+	return z, nil
+}
+
+func (z *zipCryptoReader) update(b byte) {
+	z.keys[0] = crc32.IEEETable[byte(z.keys[0])^b] ^ z.keys[0]>>8
+	z.keys[1] = (z.keys[1]+z.keys[0]&0xff)*134775813 + 1
+	z.keys[2] = crc32.IEEETable[byte(z.keys[2])^byte(z.keys[1]>>24)] ^ z.keys[2]>>8
+}
+
+func (z *zipCryptoReader) Read(p []byte) (int, error) {
+	n, err := z.r.Read(p)
+	for i, c := range p[:n] {
+		t := z.keys[2] | 2
+		b := c ^ byte((t*(t^1))>>8)
+		z.update(b)
+		p[i] = b
+	}
+	return n, err
+}
+
+// hasExtra reports whether extra contains a field with the given tag.
+func hasExtra(extra []byte, tag uint16) bool {
+	for b := readBuf(extra); len(b) >= 4; {
+		fieldTag := b.uint16()
+		size := min(int(b.uint16()), len(b))
+		if fieldTag == tag {
+			return true
+		}
+		b = b[size:]
+	}
+	return false
+}
@@ -0,0 +1,223 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package zip
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+)
+
+const cryptTestContent = "Hello, encrypted world!\nHello, encrypted world!\n"
+
+func passwordFunc(password string) func(*File) (string, error) {
+	return func(*File) (string, error) { return password, nil }
+}
+
+func readAll(f *File) ([]byte, error) {
+	rc, err := f.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+	return io.ReadAll(rc)
+}
+
+func TestReaderEncrypted(t *testing.T) {
+	for _, tt := range []struct {
+		file       string
+		name       string
+		method     uint16
+		encryption Encryption
+	}{
+		// Written by libarchive, as AE-1 with a data descriptor.
+		{"crypto-aes256.zip", "hello.txt", Deflate, AES256},
+		{"crypto-aes128.zip", "hello.txt", Store, AES128},
+		// Written by Info-ZIP.
+		{"crypto-zipcrypto.zip", "hello.txt", Deflate, ZipCrypto},
+		{"crypto-zipcrypto-dd.zip", "-", Deflate, ZipCrypto},
+	} {
+		t.Run(tt.file, func(t *testing.T) {
+			r, err := OpenReader("testdata/" + tt.file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer r.Close()
+			f := r.File[0]
+			if f.Name != tt.name || f.Method != tt.method || f.Encryption != tt.encryption {
+				t.Fatalf("got %q, method %d, encryption %d; want %q, %d, %d",
+					f.Name, f.Method, f.Encryption, tt.name, tt.method, tt.encryption)
+			}
+
+			if _, err := f.Open(); err != ErrPassword {
+				t.Errorf("Open without a password: %v, want ErrPassword", err)
+			}
+			r.SetPasswordFunc(passwordFunc("wrong"))
+			if _, err := f.Open(); err != ErrPassword {
+				t.Errorf("Open with a wrong password: %v, want ErrPassword", err)
+			}
+			errAbort := errors.New("abort")
+			r.SetPasswordFunc(func(*File) (string, error) { return "", errAbort })
+			if _, err := f.Open(); err != errAbort {
+				t.Errorf("Open with a failing password function: %v, want %v", err, errAbort)
+			}
+
+			r.SetPasswordFunc(func(g *File) (string, error) {
+				if g != f {
+					t.Errorf("password function called with %q", g.Name)
+				}
+				return "secret", nil
+			})
+			got, err := readAll(f)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != cryptTestContent {
+				t.Errorf("got %q, want %q", got, cryptTestContent)
+			}
+
+			// The password is also used through the fs.FS interface.
+			rc, err := r.Open(tt.name)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err = io.ReadAll(rc)
+			rc.Close()
+			if err != nil || string(got) != cryptTestContent {
+				t.Errorf("reading through Reader.Open: %q, %v", got, err)
+			}
+		})
+	}
+}
+
+func TestWriterEncrypted(t *testing.T) {
+	content := []byte(strings.Repeat(cryptTestContent, 100))
+	for _, enc := range []Encryption{AES128, AES192, AES256} {
+		for _, method := range []uint16{Store, Deflate} {
+			var buf bytes.Buffer
+			w := NewWriter(&buf)
+			w.SetPassword("secret")
+			for _, fh := range []*FileHeader{
+				{Name: "a.txt", Method: method, Encryption: enc},
+				{Name: "empty", Method: method, Encryption: enc},
+				{Name: "dir/", Encryption: enc},
+				{Name: "plain.txt", Method: method},
+			} {
+				fw, err := w.CreateHeader(fh)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if strings.HasSuffix(fh.Name, ".txt") {
+					fw.Write(content)
+				}
+			}
+			if err := w.Close(); err != nil {
+				t.Fatal(err)
+			}
+
+			r, err := NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+			if err != nil {
+				t.Fatal(err)
+			}
+			r.SetPasswordFunc(passwordFunc("secret"))
+			for i, want := range []Encryption{enc, enc, NoEncryption, NoEncryption} {
+				f := r.File[i]
+				if f.Encryption != want || f.Method != []uint16{method, method, Store, method}[i] {
+					t.Errorf("%d %d %s: got encryption %d, method %d", enc, method, f.Name, f.Encryption, f.Method)
+				}
+				if want != NoEncryption && (f.Flags&0x1 == 0 || f.CRC32 != 0 || f.ReaderVersion != zipVersion51) {
+					t.Errorf("%d %d %s: got flags %#x, CRC %#x, version %d", enc, method, f.Name, f.Flags, f.CRC32, f.ReaderVersion)
+				}
+				got, err := readAll(f)
+				if err != nil {
+					t.Fatalf("%d %d %s: %v", enc, method, f.Name, err)
+				}
+				if strings.HasSuffix(f.Name, ".txt") != (len(got) > 0) || len(got) > 0 && !bytes.Equal(got, content) {
+					t.Errorf("%d %d %s: content mismatch", enc, method, f.Name)
+				}
+			}
+		}
+	}
+}
+
+func TestWriterEncryptedErrors(t *testing.T) {
+	w := NewWriter(io.Discard)
+	if _, err := w.CreateHeader(&FileHeader{Name: "a", Encryption: AES256}); err == nil {
+		t.Errorf("CreateHeader without a password succeeded")
+	}
+	w.SetPassword("secret")
+	if _, err := w.CreateHeader(&FileHeader{Name: "a", Encryption: ZipCrypto}); err == nil {
+		t.Errorf("CreateHeader with ZipCrypto succeeded")
+	}
+	if _, err := w.CreateHeader(&FileHeader{Name: "a", Encryption: AES256 + 1}); err == nil {
+		t.Errorf("CreateHeader with an unknown encryption succeeded")
+	}
+}
+
+func TestEncryptedTampering(t *testing.T) {
+	var buf bytes.Buffer
+	w := NewWriter(&buf)
+	w.SetPassword("secret")
+	fw, err := w.CreateHeader(&FileHeader{Name: "a.txt", Method: Store, Encryption: AES256})
+	if err != nil {
+		t.Fatal(err)
+	}
+	io.WriteString(fw, cryptTestContent)
+	w.Close()
+
+	b := buf.Bytes()
+	r, err := NewReader(bytes.NewReader(b), int64(len(b)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	off, err := r.File[0].DataOffset()
+	if err != nil {
+		t.Fatal(err)
+	}
+	b[off+16+2+5] ^= 1 // past the salt and password verifier
+	r.SetPasswordFunc(passwordFunc("secret"))
+	if _, err := readAll(r.File[0]); err != ErrChecksum {
+		t.Errorf("reading tampered data: %v, want ErrChecksum", err)
+	}
+}
+
+func TestWriterCopyEncrypted(t *testing.T) {
+	for _, file := range []string{"crypto-aes256.zip", "crypto-zipcrypto.zip"} {
+		src, err := OpenReader("testdata/" + file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer src.Close()
+		var buf bytes.Buffer
+		w := NewWriter(&buf)
+		if err := w.Copy(src.File[0]); err != nil {
+			t.Fatal(err)
+		}
+		w.Close()
+
+		r, err := NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+		if err != nil {
+			t.Fatal(err)
+		}
+		r.SetPasswordFunc(passwordFunc("secret"))
+		got, err := readAll(r.File[0])
+		if err != nil || string(got) != cryptTestContent {
+			t.Errorf("%s: copy reads %q, %v", file, got, err)
+		}
+	}
+}
+
+func TestRemoveExtra(t *testing.T) {
+	extra := []byte{0x55, 0x54, 1, 0, 9, 0x01, 0x99, 2, 0, 7, 7, 0x0a, 0, 0, 0}
+	got := removeExtra(extra, aesExtraID)
+	if want := []byte{0x55, 0x54, 1, 0, 9, 0x0a, 0, 0, 0}; !bytes.Equal(got, want) {
+		t.Errorf("removeExtra = %x, want %x", got, want)
+	}
+	if !hasExtra(extra, aesExtraID) || hasExtra(got, aesExtraID) {
+		t.Errorf("hasExtra is wrong")
+	}
+}
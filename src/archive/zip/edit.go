@@ -0,0 +1,219 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package zip
+
+import (
+	"bufio"
+	"cmp"
+	"errors"
+	"io"
+	"os"
+	"slices"
+)
+
+// An Editor modifies a ZIP archive in place. It can remove entries
+// and add new ones without rewriting the entries that precede the
+// changes.
+//
+// New entries are written after the data of the last entry, over the
+// old central directory. Removing entries moves the data of the entries
+// that follow them, so the cost of removing an entry depends on the
+// size of the archive after it. Removals take effect when the first
+// entry is added, or at [Editor.Close], and must come before any
+// entries are added.
+type Editor struct {
+	f        *os.File
+	r        *Reader
+	dirStart int64 // offset of the original central directory
+	removed  map[*File]bool
+	w        *Writer
+	started  bool
+}
+
+// NewEditor returns an [Editor] of the ZIP archive in f, which must be
+// open for reading and writing. The caller must call [Editor.Close]
+// to write the new central directory; until then, the archive is
+// not valid if any entries have been added.
+func NewEditor(f *os.File) (*Editor, error) {
+	fi, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+	r, err := NewReader(f, fi.Size())
+	if err != nil && err != ErrInsecurePath {
+		return nil, err
+	}
+	end, baseOffset, err := readDirectoryEnd(f, fi.Size())
+	if err != nil {
+		return nil, err
+	}
+	e := &Editor{
+		f:        f,
+		r:        r,
+		dirStart: baseOffset + int64(end.directoryOffset),
+		removed:  make(map[*File]bool),
+		w:        NewWriter(nil),
+	}
+	e.w.comment = r.Comment
+	return e, nil
+}
+
+// Files returns the entries of the original archive that have not
+// been removed, in the order of the central directory. Their content
+// remains readable while the archive is edited.
+func (e *Editor) Files() []*File {
+	var files []*File
+	for _, f := range e.r.File {
+		if !e.removed[f] {
+			files = append(files, f)
+		}
+	}
+	return files
+}
+
+// Remove removes the entry f, which must be one of the entries
+// returned by [Editor.Files]. It must be called before any entries
+// are added.
+func (e *Editor) Remove(f *File) error {
+	if e.started {
+		return errors.New("zip: Editor.Remove called after entries were added")
+	}
+	if f.zip != e.r || e.removed[f] {
+		return errors.New("zip: Editor.Remove of an unknown entry")
+	}
+	e.removed[f] = true
+	return nil
+}
+
+// SetComment sets the end-of-central-directory comment field, which
+// is initially that of the original archive.
+func (e *Editor) SetComment(comment string) error {
+	return e.w.SetComment(comment)
+}
+
+// SetPassword sets the password used to encrypt the added files;
+// see [Writer.SetPassword].
+func (e *Editor) SetPassword(password string) {
+	e.w.SetPassword(password)
+}
+
+// RegisterCompressor registers or overrides a custom compressor for a
+// specific method ID; see [Writer.RegisterCompressor].
+func (e *Editor) RegisterCompressor(method uint16, comp Compressor) {
+	e.w.RegisterCompressor(method, comp)
+}
+
+// Create adds a file to the archive; see [Writer.Create].
+func (e *Editor) Create(name string) (io.Writer, error) {
+	if err := e.start(); err != nil {
+		return nil, err
+	}
+	return e.w.Create(name)
+}
+
+// CreateHeader adds a file to the archive; see [Writer.CreateHeader].
+func (e *Editor) CreateHeader(fh *FileHeader) (io.Writer, error) {
+	if err := e.start(); err != nil {
+		return nil, err
+	}
+	return e.w.CreateHeader(fh)
+}
+
+// CreateRaw adds a file to the archive without compressing its
+// content; see [Writer.CreateRaw].
+func (e *Editor) CreateRaw(fh *FileHeader) (io.Writer, error) {
+	if err := e.start(); err != nil {
+		return nil, err
+	}
+	return e.w.CreateRaw(fh)
+}
+
+// Copy copies the file f into the archive; see [Writer.Copy].
+// The file may be one of the entries of the archive being edited,
+// but not one passed to [Editor.Remove], whose data is overwritten.
+func (e *Editor) Copy(f *File) error {
+	if e.removed[f] {
+		return errors.New("zip: Editor.Copy of a removed entry")
+	}
+	if err := e.start(); err != nil {
+		return err
+	}
+	return e.w.Copy(f)
+}
+
+// Close finishes editing the archive by writing the central directory
+// and truncating the file after it. It does not close the file.
+func (e *Editor) Close() error {
+	if err := e.start(); err != nil {
+		return err
+	}
+	if err := e.w.Close(); err != nil {
+		return err
+	}
+	return e.f.Truncate(e.w.cw.count)
+}
+
+// start removes the entries to be removed, moving the data of the
+// entries after them, and prepares e.w to write after the last entry.
+func (e *Editor) start() error {
+	if e.started {
+		return nil
+	}
+	e.started = true
+
+	// Each entry extends to the next one, or to the central directory.
+	// Any data between entries moves with the entry before it.
+	all := slices.Clone(e.r.File)
+	slices.SortStableFunc(all, func(a, b *File) int {
+		return cmp.Compare(a.headerOffset, b.headerOffset)
+	})
+	pos := e.dirStart
+	for _, f := range all {
+		if e.removed[f] {
+			pos = f.headerOffset
+			break
+		}
+	}
+	buf := make([]byte, 32<<10)
+	for i, f := range all {
+		if e.removed[f] || f.headerOffset < pos {
+			continue
+		}
+		end := e.dirStart
+		if i+1 < len(all) {
+			end = all[i+1].headerOffset
+		}
+		n := end - f.headerOffset
+		if f.headerOffset != pos {
+			src := io.NewSectionReader(e.f, f.headerOffset, n)
+			dst := io.NewOffsetWriter(e.f, pos)
+			if _, err := io.CopyBuffer(dst, src, buf); err != nil {
+				return err
+			}
+			f.headerOffset = pos
+		}
+		pos += n
+	}
+
+	// The central directory lists the remaining entries in their
+	// original order, followed by the new ones.
+	for _, f := range e.r.File {
+		if e.removed[f] {
+			continue
+		}
+		// Writer.Close adds a zip64 field and sets the 32-bit sizes
+		// to their maximum if needed.
+		fh := f.FileHeader
+		fh.Extra = removeExtra(fh.Extra, zip64ExtraID)
+		fh.CompressedSize = uint32(min(fh.CompressedSize64, uint32max))
+		fh.UncompressedSize = uint32(min(fh.UncompressedSize64, uint32max))
+		e.w.dir = append(e.w.dir, &header{FileHeader: &fh, offset: uint64(f.headerOffset)})
+	}
+	e.w.cw = &countWriter{
+		w:     bufio.NewWriter(io.NewOffsetWriter(e.f, pos)),
+		count: pos,
+	}
+	return nil
+}
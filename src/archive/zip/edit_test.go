@@ -0,0 +1,296 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package zip
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// editTestFile writes an archive with the named files to a temporary
+// file and returns it, open for reading and writing. The content of
+// each file is its name repeated.
+func editTestFile(t *testing.T, names ...string) *os.File {
+	t.Helper()
+	f, err := os.Create(filepath.Join(t.TempDir(), "test.zip"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { f.Close() })
+	w := NewWriter(f)
+	for i, name := range names {
+		method := Deflate
+		if i%2 == 1 {
+			method = Store
+		}
+		fw, err := w.CreateHeader(&FileHeader{Name: name, Method: method})
+		if err != nil {
+			t.Fatal(err)
+		}
+		io.WriteString(fw, strings.Repeat(name, 100))
+	}
+	w.SetComment("comment")
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return f
+}
+
+// checkEdited checks that the archive in f has the files with the
+// given names, the content of each being its name repeated.
+func checkEdited(t *testing.T, f *os.File, comment string, names ...string) {
+	t.Helper()
+	fi, err := f.Stat()
+	if err != nil {
+		t.Fatal(err)
+	}
+	r, err := NewReader(f, fi.Size())
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, zf := range r.File {
+		got = append(got, zf.Name)
+		b, err := readAll(zf)
+		if err != nil {
+			t.Errorf("%s: %v", zf.Name, err)
+		} else if string(b) != strings.Repeat(zf.Name, 100) {
+			t.Errorf("%s: wrong content", zf.Name)
+		}
+	}
+	if strings.Join(got, ",") != strings.Join(names, ",") {
+		t.Errorf("got files %q, want %q", got, names)
+	}
+	if r.Comment != comment {
+		t.Errorf("got comment %q, want %q", r.Comment, comment)
+	}
+}
+
+func TestEditorAppend(t *testing.T) {
+	f := editTestFile(t, "a", "b", "c")
+	before, err := os.ReadFile(f.Name())
+	if err != nil {
+		t.Fatal(err)
+	}
+	e, err := NewEditor(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{"d", "e"} {
+		fw, err := e.Create(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		io.WriteString(fw, strings.Repeat(name, 100))
+	}
+	// Copy an entry of the archive itself.
+	if err := e.Copy(e.Files()[0]); err != nil {
+		t.Fatal(err)
+	}
+	if err := e.Close(); err != nil {
+		t.Fatal(err)
+	}
+	checkEdited(t, f, "comment", "a", "b", "c", "d", "e", "a")
+
+	// The existing entries are untouched.
+	after, err := os.ReadFile(f.Name())
+	if err != nil {
+		t.Fatal(err)
+	}
+	dirStart := e.dirStart
+	if !bytes.Equal(before[:dirStart], after[:dirStart]) {
+		t.Errorf("the existing entries changed")
+	}
+}
+
+func TestEditorRemove(t *testing.T) {
+	names := []string{"a", "b", "c", "d", "e"}
+	for _, tt := range []struct {
+		remove []string
+		add    []string
+	}{
+		{remove: []string{"c"}},
+		{remove: []string{"a"}, add: []string{"f"}},
+		{remove: []string{"e"}, add: []string{"f", "g"}},
+		{remove: []string{"b", "d"}, add: []string{"f"}},
+		{remove: []string{"a", "b", "c", "d", "e"}},
+		{remove: []string{"a", "b", "c", "d", "e"}, add: []string{"f"}},
+	} {
+		t.Run(strings.Join(tt.remove, ","), func(t *testing.T) {
+			f := editTestFile(t, names...)
+			before, err := os.ReadFile(f.Name())
+			if err != nil {
+				t.Fatal(err)
+			}
+			e, err := NewEditor(f)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var want []string
+			firstRemoved := int64(-1)
+			for _, zf := range e.Files() {
+				if strings.Contains(strings.Join(tt.remove, ","), zf.Name) {
+					if firstRemoved < 0 {
+						firstRemoved = zf.headerOffset
+					}
+					if err := e.Remove(zf); err != nil {
+						t.Fatal(err)
+					}
+				} else {
+					want = append(want, zf.Name)
+				}
+			}
+			if n := len(e.Files()); n != len(want) {
+				t.Errorf("Files returns %d entries, want %d", n, len(want))
+			}
+			for _, name := range tt.add {
+				fw, err := e.Create(name)
+				if err != nil {
+					t.Fatal(err)
+				}
+				io.WriteString(fw, strings.Repeat(name, 100))
+				want = append(want, name)
+			}
+			e.SetComment("new comment")
+			if err := e.Close(); err != nil {
+				t.Fatal(err)
+			}
+			checkEdited(t, f, "new comment", want...)
+
+			after, err := os.ReadFile(f.Name())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(before[:firstRemoved], after[:firstRemoved]) {
+				t.Errorf("the entries before the first removed one changed")
+			}
+			if len(tt.add) == 0 && len(after) >= len(before) {
+				t.Errorf("archive grew from %d to %d bytes", len(before), len(after))
+			}
+		})
+	}
+}
+
+func TestEditorErrors(t *testing.T) {
+	f := editTestFile(t, "a", "b")
+	e, err := NewEditor(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	a := e.Files()[0]
+	if err := e.Remove(a); err != nil {
+		t.Fatal(err)
+	}
+	if err := e.Remove(a); err == nil {
+		t.Errorf("removing an entry twice succeeded")
+	}
+	if err := e.Copy(a); err == nil {
+		t.Errorf("copying a removed entry succeeded")
+	}
+	other, err := NewEditor(editTestFile(t, "a"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := e.Remove(other.Files()[0]); err == nil {
+		t.Errorf("removing an entry of another archive succeeded")
+	}
+	if _, err := e.Create("c"); err != nil {
+		t.Fatal(err)
+	}
+	if err := e.Remove(e.Files()[0]); err == nil {
+		t.Errorf("Remove after Create succeeded")
+	}
+	if err := e.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := e.Close(); err == nil {
+		t.Errorf("second Close succeeded")
+	}
+
+	notZip, err := os.Create(filepath.Join(t.TempDir(), "not.zip"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer notZip.Close()
+	if _, err := NewEditor(notZip); err == nil {
+		t.Errorf("NewEditor of an empty file succeeded")
+	}
+}
+
+func TestEditorTestdata(t *testing.T) {
+	// Archives with a zip64 directory, and with data before the
+	// archive proper.
+	for _, name := range []string{"zip64.zip", "test-prefix.zip"} {
+		t.Run(name, func(t *testing.T) {
+			b, err := os.ReadFile(filepath.Join("testdata", name))
+			if err != nil {
+				t.Fatal(err)
+			}
+			path := filepath.Join(t.TempDir(), name)
+			if err := os.WriteFile(path, b, 0o666); err != nil {
+				t.Fatal(err)
+			}
+			f, err := os.OpenFile(path, os.O_RDWR, 0)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer f.Close()
+			e, err := NewEditor(f)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var want []string
+			for _, zf := range e.Files() {
+				b, err := readAll(zf)
+				if err != nil {
+					t.Fatal(err)
+				}
+				want = append(want, fmt.Sprintf("%s:%d", zf.Name, len(b)))
+			}
+			fw, err := e.Create("new.txt")
+			if err != nil {
+				t.Fatal(err)
+			}
+			io.WriteString(fw, "new file")
+			if err := e.Close(); err != nil {
+				t.Fatal(err)
+			}
+			want = append(want, "new.txt:8")
+
+			fi, _ := f.Stat()
+			r, err := NewReader(f, fi.Size())
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got []string
+			for _, zf := range r.File {
+				n := 0
+				for b := readBuf(zf.Extra); len(b) >= 4; {
+					tag := b.uint16()
+					b = b[min(int(b.uint16()), len(b)):]
+					if tag == zip64ExtraID {
+						n++
+					}
+				}
+				if n > 1 {
+					t.Errorf("%s: %d zip64 extra fields", zf.Name, n)
+				}
+				b, err := readAll(zf)
+				if err != nil {
+					t.Fatalf("%s: %v", zf.Name, err)
+				}
+				got = append(got, fmt.Sprintf("%s:%d", zf.Name, len(b)))
+			}
+			if strings.Join(got, " ") != strings.Join(want, " ") {
+				t.Errorf("got %v, want %v", got, want)
+			}
+		})
+	}
+}
@@ -91,3 +91,42 @@ func ExampleWriter_RegisterCompressor() {
 
 	// Proceed to add files to w.
 }
+
+func ExampleWriter_SetPassword() {
+	// Create an archive with a file encrypted with AES-256.
+	buf := new(bytes.Buffer)
+	w := zip.NewWriter(buf)
+	w.SetPassword("correct horse battery staple")
+	f, err := w.CreateHeader(&zip.FileHeader{
+		Name:       "secret.txt",
+		Method:     zip.Deflate,
+		Encryption: zip.AES256,
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	if _, err := io.WriteString(f, "The gopher is in the library."); err != nil {
+		log.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		log.Fatal(err)
+	}
+
+	// Read it back, supplying the password when needed.
+	r, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		log.Fatal(err)
+	}
+	r.SetPasswordFunc(func(f *zip.File) (string, error) {
+		return "correct horse battery staple", nil
+	})
+	rc, err := r.File[0].Open()
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer rc.Close()
+	if _, err := io.Copy(os.Stdout, rc); err != nil {
+		log.Fatal(err)
+	}
+	// Output: The gopher is in the library.
+}
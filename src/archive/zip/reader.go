@@ -37,6 +37,7 @@ type Reader struct {
 	File          []*File
 	Comment       string
 	decompressors map[uint16]Decompressor
+	password      func(*File) (string, error)
 
 	// Some JAR files are zip files with a prefix that is a bash script.
 	// The baseOffset field is the start of the zip file proper.
@@ -61,8 +62,9 @@ type File struct {
 	FileHeader
 	zip          *Reader
 	zipr         io.ReaderAt
-	headerOffset int64 // includes overall ZIP archive baseOffset
-	zip64        bool  // zip64 extended information extra field presence
+	headerOffset int64  // includes overall ZIP archive baseOffset
+	zip64        bool   // zip64 extended information extra field presence
+	aesVersion   uint16 // WinZip AES vendor version, if encrypted with AES
 }
 
 // OpenReader will open the Zip file specified by name and return a ReadCloser.
@@ -194,6 +196,17 @@ func (r *Reader) decompressor(method uint16) Decompressor {
 	return dcomp
 }
 
+// SetPasswordFunc sets the function that supplies the password for
+// opening an encrypted file. [File.Open] calls password with the file
+// each time it opens an encrypted file; if password returns an error,
+// Open returns that error.
+//
+// Without a password function, opening an encrypted file returns
+// [ErrPassword].
+func (r *Reader) SetPasswordFunc(password func(f *File) (string, error)) {
+	r.password = password
+}
+
 // Close closes the Zip file, rendering it unusable for I/O.
 func (rc *ReadCloser) Close() error {
 	return rc.f.Close()
@@ -236,11 +249,22 @@ func (f *File) Open() (io.ReadCloser, error) {
 		}
 	}
 	size := int64(f.CompressedSize64)
-	r := io.NewSectionReader(f.zipr, f.headerOffset+bodyOffset, size)
 	dcomp := f.zip.decompressor(f.Method)
 	if dcomp == nil {
 		return nil, ErrAlgorithm
 	}
+	var r io.Reader = io.NewSectionReader(f.zipr, f.headerOffset+bodyOffset, size)
+	var auth func() error
+	if f.Flags&0x1 != 0 {
+		dr, err := f.decrypt(r, f.headerOffset+bodyOffset, size)
+		if err != nil {
+			return nil, err
+		}
+		r = dr
+		if ar, ok := dr.(*aesReader); ok {
+			auth = ar.verify
+		}
+	}
 	var rc io.ReadCloser = dcomp(r)
 	var desr io.Reader
 	if f.hasDataDescriptor() {
@@ -251,10 +275,37 @@ func (f *File) Open() (io.ReadCloser, error) {
 		hash: crc32.NewIEEE(),
 		f:    f,
 		desr: desr,
+		auth: auth,
 	}
 	return rc, nil
 }
 
+// decrypt returns a reader of the decrypted content of the encrypted
+// file, of the given size at off, whose data r reads.
+func (f *File) decrypt(r io.Reader, off, size int64) (io.Reader, error) {
+	if f.Encryption == NoEncryption {
+		// Strong encryption, or an unknown method.
+		return nil, ErrAlgorithm
+	}
+	if f.zip.password == nil {
+		return nil, ErrPassword
+	}
+	password, err := f.zip.password(f)
+	if err != nil {
+		return nil, err
+	}
+	if f.Encryption.isAES() {
+		return newAESReader(f.zipr, off, size, f.Encryption, []byte(password))
+	}
+	// The last byte of the encryption header is the high byte of the
+	// CRC, or of the modification time if the CRC follows the data.
+	check := byte(f.CRC32 >> 24)
+	if f.hasDataDescriptor() {
+		check = byte(f.ModifiedTime >> 8)
+	}
+	return newZipCryptoReader(r, []byte(password), check)
+}
+
 // OpenRaw returns a [Reader] that provides access to the [File]'s contents without
 // decompression.
 func (f *File) OpenRaw() (io.Reader, error) {
@@ -283,8 +334,9 @@ type checksumReader struct {
 	hash  hash.Hash32
 	nread uint64 // number of bytes read so far
 	f     *File
-	desr  io.Reader // if non-nil, where to read the data descriptor
-	err   error     // sticky error
+	desr  io.Reader    // if non-nil, where to read the data descriptor
+	auth  func() error // if non-nil, authenticates the encrypted data
+	err   error        // sticky error
 }
 
 func (r *checksumReader) Stat() (fs.FileInfo, error) {
@@ -308,7 +360,16 @@ func (r *checksumReader) Read(b []byte) (n int, err error) {
 		if r.nread != r.f.UncompressedSize64 {
 			return 0, io.ErrUnexpectedEOF
 		}
-		if r.desr != nil {
+		if r.auth != nil {
+			if err1 := r.auth(); err1 != nil {
+				r.err = err1
+				return n, err1
+			}
+		}
+		if r.f.aesVersion == 2 {
+			// AE-2 leaves out the CRC, relying on the
+			// authentication code instead.
+		} else if r.desr != nil {
 			if err1 := readDataDescriptor(r.desr, r.f); err1 != nil {
 				if err1 == io.EOF {
 					err = io.ErrUnexpectedEOF
@@ -487,8 +548,25 @@ parseExtras:
 			}
 			ts := int64(fieldBuf.uint32()) // ModTime since Unix epoch
 			modified = time.Unix(ts, 0)
+		case aesExtraID:
+			if len(fieldBuf) < 7 || f.Method != aesMethod {
+				continue parseExtras
+			}
+			version := fieldBuf.uint16()
+			fieldBuf.uint16() // vendor ID (ignored)
+			strength := fieldBuf.uint8()
+			if strength < 1 || strength > 3 {
+				continue parseExtras
+			}
+			f.aesVersion = version
+			f.Encryption = AES128 + Encryption(strength-1)
+			f.Method = fieldBuf.uint16()
 		}
 	}
+	if f.Flags&0x41 == 0x1 && f.Method != aesMethod && f.Encryption == NoEncryption {
+		// Bit 6 would indicate strong encryption.
+		f.Encryption = ZipCrypto
+	}
 
 	msdosModified := msDosTimeToTime(f.ModifiedDate, f.ModifiedTime)
 	f.Modified = msdosModified
@@ -6,6 +6,7 @@ package zip
 
 import (
 	"compress/flate"
+	"compress/zstd"
 	"errors"
 	"io"
 	"sync"
@@ -112,10 +113,14 @@ func init() {
 
 	decompressors.Store(Store, Decompressor(io.NopCloser))
 	decompressors.Store(Deflate, Decompressor(newFlateReader))
+
+	compressors.Store(Zstd, Compressor(func(w io.Writer) (io.WriteCloser, error) { return zstd.NewWriter(w), nil }))
+	decompressors.Store(Zstd, Decompressor(func(r io.Reader) io.ReadCloser { return io.NopCloser(zstd.NewReader(r)) }))
 }
 
 // RegisterDecompressor allows custom decompressors for a specified method ID.
-// The common methods [Store] and [Deflate] are built in.
+// The common methods [Store], [Deflate] and [Zstd] are built in.
+// Other methods, such as [XZ], must be registered by the caller.
 func RegisterDecompressor(method uint16, dcomp Decompressor) {
 	if _, dup := decompressors.LoadOrStore(method, dcomp); dup {
 		panic("decompressor already registered")
@@ -123,7 +128,8 @@ func RegisterDecompressor(method uint16, dcomp Decompressor) {
 }
 
 // RegisterCompressor registers custom compressors for a specified method ID.
-// The common methods [Store] and [Deflate] are built in.
+// The common methods [Store], [Deflate] and [Zstd] are built in.
+// Other methods, such as [XZ], must be registered by the caller.
 func RegisterCompressor(method uint16, comp Compressor) {
 	if _, dup := compressors.LoadOrStore(method, comp); dup {
 		panic("compressor already registered")
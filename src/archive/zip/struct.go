@@ -29,8 +29,22 @@ import (
 
 // Compression methods.
 const (
-	Store   uint16 = 0 // no compression
-	Deflate uint16 = 8 // DEFLATE compressed
+	Store   uint16 = 0  // no compression
+	Deflate uint16 = 8  // DEFLATE compressed
+	Zstd    uint16 = 93 // Zstandard compressed
+	XZ      uint16 = 95 // xz compressed
+)
+
+// Encryption is the encryption method of a file in a ZIP archive.
+type Encryption uint8
+
+// Encryption methods.
+const (
+	NoEncryption Encryption = iota
+	ZipCrypto               // traditional PKWARE encryption; can only be read
+	AES128                  // WinZip AES encryption with a 128-bit key
+	AES192                  // WinZip AES encryption with a 192-bit key
+	AES256                  // WinZip AES encryption with a 256-bit key
 )
 
 const (
@@ -58,6 +72,8 @@ const (
 	// Version numbers.
 	zipVersion20 = 20 // 2.0
 	zipVersion45 = 45 // 4.5 (reads and writes zip64 archives)
+	zipVersion51 = 51 // 5.1 (AES encryption)
+	zipVersion63 = 63 // 6.3 (Zstandard and xz compression)
 
 	// Limits for non zip64 files.
 	uint16max = (1 << 16) - 1
@@ -77,6 +93,7 @@ const (
 	unixExtraID        = 0x000d // UNIX
 	extTimeExtraID     = 0x5455 // Extended timestamp
 	infoZipUnixExtraID = 0x5855 // Info-ZIP Unix extension
+	aesExtraID         = 0x9901 // WinZip AES encryption
 )
 
 // FileHeader describes a file within a ZIP file.
@@ -110,8 +127,23 @@ type FileHeader struct {
 	Flags          uint16
 
 	// Method is the compression method. If zero, Store is used.
+	//
+	// For a file encrypted with AES, the archive records the AES
+	// method, 99, and Method is the compression method of the data
+	// before encryption.
 	Method uint16
 
+	// Encryption is the encryption method of the file.
+	//
+	// When reading, the content of an encrypted file is decrypted with
+	// the password supplied by the function set with
+	// [Reader.SetPasswordFunc].
+	//
+	// When writing, a file is encrypted with the password set with
+	// [Writer.SetPassword] if Encryption is [AES128], [AES192] or
+	// [AES256]. Directories are not encrypted.
+	Encryption Encryption
+
 	// Modified is the modified time of the file.
 	//
 	// When reading, an extended timestamp is preferred over the legacy MS-DOS
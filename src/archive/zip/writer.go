@@ -29,6 +29,7 @@ type Writer struct {
 	closed      bool
 	compressors map[uint16]Compressor
 	comment     string
+	password    string
 
 	// testHookCloseSizeOffset if non-nil is called with the size
 	// of offset of the central directory at Close.
@@ -73,6 +74,12 @@ func (w *Writer) SetComment(comment string) error {
 	return nil
 }
 
+// SetPassword sets the password used to encrypt the files subsequently
+// added with [Writer.CreateHeader] whose Encryption field is set.
+func (w *Writer) SetPassword(password string) {
+	w.password = password
+}
+
 // Close finishes writing the zip file by writing the central directory.
 // It does not close the underlying writer.
 func (w *Writer) Close() error {
@@ -96,7 +103,7 @@ func (w *Writer) Close() error {
 		b.uint16(h.CreatorVersion)
 		b.uint16(h.ReaderVersion)
 		b.uint16(h.Flags)
-		b.uint16(h.Method)
+		b.uint16(h.storedMethod())
 		b.uint16(h.ModifiedTime)
 		b.uint16(h.ModifiedDate)
 		b.uint32(h.CRC32)
@@ -302,6 +309,30 @@ func (w *Writer) CreateHeader(fh *FileHeader) (io.Writer, error) {
 
 	fh.CreatorVersion = fh.CreatorVersion&0xff00 | zipVersion20 // preserve compatibility byte
 	fh.ReaderVersion = zipVersion20
+	if fh.Method == Zstd || fh.Method == XZ {
+		fh.ReaderVersion = zipVersion63
+	}
+
+	// Any encryption extra field is stale.
+	fh.Extra = removeExtra(fh.Extra, aesExtraID)
+	fh.Flags &^= 0x1
+	if strings.HasSuffix(fh.Name, "/") {
+		fh.Encryption = NoEncryption
+	}
+	switch {
+	case fh.Encryption == NoEncryption:
+	case fh.Encryption == ZipCrypto:
+		return nil, errors.New("zip: cannot write ZipCrypto encrypted files")
+	case !fh.Encryption.isAES():
+		return nil, errors.New("zip: unknown encryption method")
+	case w.password == "":
+		return nil, errors.New("zip: no password set for encrypted file")
+	default:
+		// Write AE-2, which leaves out the CRC of the content.
+		fh.Flags |= 0x1
+		fh.ReaderVersion = max(fh.ReaderVersion, zipVersion51)
+		fh.Extra = appendAESExtra(fh.Extra, fh, 2)
+	}
 
 	// If Modified is set, this takes precedence over MS-DOS timestamp fields.
 	if !fh.Modified.IsZero() {
@@ -367,8 +398,17 @@ func (w *Writer) CreateHeader(fh *FileHeader) (io.Writer, error) {
 		if comp == nil {
 			return nil, ErrAlgorithm
 		}
+		var cw io.Writer = fw.compCount
+		if fh.Encryption != NoEncryption {
+			enc, err := newAESWriter(fw.compCount, fh.Encryption, []byte(w.password))
+			if err != nil {
+				return nil, err
+			}
+			fw.enc = enc
+			cw = enc
+		}
 		var err error
-		fw.comp, err = comp(fw.compCount)
+		fw.comp, err = comp(cw)
 		if err != nil {
 			return nil, err
 		}
@@ -399,7 +439,7 @@ func writeHeader(w io.Writer, h *header) error {
 	b.uint32(uint32(fileHeaderSignature))
 	b.uint16(h.ReaderVersion)
 	b.uint16(h.Flags)
-	b.uint16(h.Method)
+	b.uint16(h.storedMethod())
 	b.uint16(h.ModifiedTime)
 	b.uint16(h.ModifiedDate)
 	// In raw mode (caller does the compression), the values are either
@@ -445,6 +485,13 @@ func (w *Writer) CreateRaw(fh *FileHeader) (io.Writer, error) {
 
 	fh.CompressedSize = uint32(min(fh.CompressedSize64, uint32max))
 	fh.UncompressedSize = uint32(min(fh.UncompressedSize64, uint32max))
+	if fh.Encryption.isAES() && !hasExtra(fh.Extra, aesExtraID) {
+		version := uint16(2)
+		if fh.CRC32 != 0 {
+			version = 1
+		}
+		fh.Extra = appendAESExtra(fh.Extra, fh, version)
+	}
 
 	h := &header{
 		FileHeader: fh,
@@ -557,6 +604,7 @@ type fileWriter struct {
 	zipw      io.Writer
 	rawCount  *countWriter
 	comp      io.WriteCloser
+	enc       io.WriteCloser // if non-nil, encrypts the compressed data
 	compCount *countWriter
 	crc32     hash.Hash32
 	closed    bool
@@ -584,17 +632,25 @@ func (w *fileWriter) close() error {
 	if err := w.comp.Close(); err != nil {
 		return err
 	}
+	if w.enc != nil {
+		if err := w.enc.Close(); err != nil {
+			return err
+		}
+	}
 
 	// update FileHeader
 	fh := w.header.FileHeader
 	fh.CRC32 = w.crc32.Sum32()
+	if fh.Encryption != NoEncryption {
+		fh.CRC32 = 0 // AE-2
+	}
 	fh.CompressedSize64 = uint64(w.compCount.count)
 	fh.UncompressedSize64 = uint64(w.rawCount.count)
 
 	if fh.isZip64() {
 		fh.CompressedSize = uint32max
 		fh.UncompressedSize = uint32max
-		fh.ReaderVersion = zipVersion45 // requires 4.5 - File uses ZIP64 format extensions
+		fh.ReaderVersion = max(fh.ReaderVersion, zipVersion45) // requires 4.5 - File uses ZIP64 format extensions
 	} else {
 		fh.CompressedSize = uint32(fh.CompressedSize64)
 		fh.UncompressedSize = uint32(fh.UncompressedSize64)
@@ -72,6 +72,12 @@ var writeTests = []WriteTest{
 		Method: Deflate,
 		Mode:   0755 | fs.ModeDevice | fs.ModeCharDevice,
 	},
+	{
+		Name:   "zstd",
+		Data:   []byte("Zstandard compressed file, Zstandard compressed file"),
+		Method: Zstd,
+		Mode:   0644,
+	},
 }
 
 func TestWriter(t *testing.T) {
@@ -0,0 +1,100 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package brotli
+
+import (
+	"bufio"
+	"io"
+)
+
+// bitReader reads the bits of a stream, starting with the least
+// significant bit of each byte. Like the bit reader of compress/bzip2,
+// its methods don't return errors: any error is kept in err, after
+// which the methods return zero.
+//
+// The reader never reads a byte from r before one of its bits is
+// needed, so it does not read past the end of a Brotli stream.
+type bitReader struct {
+	r     io.ByteReader
+	buf   *bufio.Reader // reused to buffer readers without ReadByte
+	val   uint64        // unread bits, in the low nbits bits
+	nbits uint
+	err   error
+}
+
+func (br *bitReader) reset(r io.Reader) {
+	byter, ok := r.(io.ByteReader)
+	if !ok {
+		if br.buf == nil {
+			br.buf = bufio.NewReader(r)
+		} else {
+			br.buf.Reset(r)
+		}
+		byter = br.buf
+	}
+	*br = bitReader{r: byter, buf: br.buf}
+}
+
+// fill reads another byte into br.val.
+func (br *bitReader) fill() bool {
+	if br.err != nil {
+		return false
+	}
+	b, err := br.r.ReadByte()
+	if err != nil {
+		br.err = noEOF(err)
+		return false
+	}
+	br.val |= uint64(b) << br.nbits
+	br.nbits += 8
+	return true
+}
+
+// readBits reads n bits, where n is at most 24.
+func (br *bitReader) readBits(n uint) uint32 {
+	for br.nbits < n {
+		if !br.fill() {
+			return 0
+		}
+	}
+	v := uint32(br.val) & (1<<n - 1)
+	br.val >>= n
+	br.nbits -= n
+	return v
+}
+
+// readBit reads a single bit.
+func (br *bitReader) readBit() bool {
+	return br.readBits(1) != 0
+}
+
+// align discards the bits up to the next byte boundary and reports
+// whether they were all zero.
+func (br *bitReader) align() bool {
+	n := br.nbits % 8
+	v := br.val & (1<<n - 1)
+	br.val >>= n
+	br.nbits -= n
+	return v == 0
+}
+
+// readSymbol reads a symbol encoded with the prefix code h.
+func (br *bitReader) readSymbol(h huffmanCode) int {
+	for {
+		e := h[br.val&rootMask]
+		if e&subtableFlag != 0 && br.nbits >= rootBits {
+			sub := h[e&0xFFFF:]
+			e = sub[br.val>>rootBits&(1<<(e>>16&0xFF)-1)]
+		}
+		if n := uint(e >> 16 & 0xFF); e&subtableFlag == 0 && n <= br.nbits {
+			br.val >>= n
+			br.nbits -= n
+			return int(e & 0xFFFF)
+		}
+		if !br.fill() {
+			return 0
+		}
+	}
+}
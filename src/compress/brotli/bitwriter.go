@@ -0,0 +1,65 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package brotli
+
+// bitWriter writes a bit stream, starting with the least significant
+// bit of each byte. Complete bytes are appended to out; up to 31 bits
+// may be pending between calls.
+type bitWriter struct {
+	out  []byte
+	bits uint64 // pending bits
+	n    uint   // number of pending bits
+}
+
+func (bw *bitWriter) reset(out []byte) {
+	bw.out = out
+	bw.bits = 0
+	bw.n = 0
+}
+
+// addBits adds the low nb bits of v to the stream. nb must be at most 32.
+func (bw *bitWriter) addBits(v uint32, nb uint8) {
+	bw.bits |= uint64(v&(1<<nb-1)) << bw.n
+	bw.n += uint(nb)
+	if bw.n >= 32 {
+		bw.out = append(bw.out, byte(bw.bits), byte(bw.bits>>8), byte(bw.bits>>16), byte(bw.bits>>24))
+		bw.bits >>= 32
+		bw.n -= 32
+	}
+}
+
+// align pads the stream with zero bits to a byte boundary
+// and appends all pending bits to out.
+func (bw *bitWriter) align() {
+	for bw.n > 0 {
+		bw.out = append(bw.out, byte(bw.bits))
+		bw.bits >>= 8
+		bw.n -= min(bw.n, 8)
+	}
+	bw.bits = 0
+}
+
+// A bitMark records the state of a bitWriter.
+type bitMark struct {
+	len  int
+	bits uint64
+	n    uint
+}
+
+func (bw *bitWriter) mark() bitMark {
+	return bitMark{len(bw.out), bw.bits, bw.n}
+}
+
+// rewind discards everything written since m.
+func (bw *bitWriter) rewind(m bitMark) {
+	bw.out = bw.out[:m.len]
+	bw.bits = m.bits
+	bw.n = m.n
+}
+
+// size returns the number of bytes written since m, rounded down.
+func (bw *bitWriter) size(m bitMark) int {
+	return (8*(len(bw.out)-m.len) + int(bw.n) - int(m.n)) / 8
+}
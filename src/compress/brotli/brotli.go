@@ -0,0 +1,101 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package brotli implements reading and writing of Brotli compressed
+// data, as specified in RFC 7932.
+//
+// A Brotli stream has no header identifying it as such and no
+// checksum; it is usually carried by a protocol or container that
+// supplies both, such as HTTP with "Content-Encoding: br".
+//
+// The [Reader] decompresses any valid stream. The [Writer] does not
+// refer to the static dictionary and uses one block type per
+// meta-block, so at its highest levels it compresses somewhat less
+// well than the reference implementation.
+package brotli
+
+import "io"
+
+// A StructuralError is returned when the Brotli data is found to be
+// syntactically invalid.
+type StructuralError string
+
+func (s StructuralError) Error() string {
+	return "brotli data invalid: " + string(s)
+}
+
+const (
+	minWindowBits = 10
+	maxWindowBits = 24
+	windowGap     = 16 // bytes of the window that cannot be referenced
+
+	numLiteralSymbols  = 256
+	numCommandSymbols  = 704
+	numBlockLenSymbols = 26
+	maxContextMapRLE   = 16
+	numDistShortCodes  = 16
+	maxCodeLength      = 15
+	numCodeLengthCodes = 18
+
+	literalContextBits  = 6
+	distanceContextBits = 2
+)
+
+// Context modes for literals.
+const (
+	contextLSB6 = iota
+	contextMSB6
+	contextUTF8
+	contextSigned
+)
+
+// Insert-and-copy length codes, in RFC 7932, Section 5.
+var (
+	insertCellBase = [11]uint8{0, 0, 0, 0, 8, 8, 0, 16, 8, 16, 16}
+	copyCellBase   = [11]uint8{0, 8, 0, 8, 0, 8, 16, 0, 16, 8, 16}
+
+	insertBase = [24]uint32{
+		0, 1, 2, 3, 4, 5, 6, 8, 10, 14, 18, 26,
+		34, 50, 66, 98, 130, 194, 322, 578, 1090, 2114, 6210, 22594,
+	}
+	insertExtra = [24]uint8{
+		0, 0, 0, 0, 0, 0, 1, 1, 2, 2, 3, 3,
+		4, 4, 5, 5, 6, 7, 8, 9, 10, 12, 14, 24,
+	}
+	copyBase = [24]uint32{
+		2, 3, 4, 5, 6, 7, 8, 9, 10, 12, 14, 18,
+		22, 30, 38, 54, 70, 102, 134, 198, 326, 582, 1094, 2118,
+	}
+	copyExtra = [24]uint8{
+		0, 0, 0, 0, 0, 0, 0, 0, 1, 1, 2, 2,
+		3, 3, 4, 4, 5, 5, 6, 7, 8, 9, 10, 24,
+	}
+)
+
+// Block count codes, in RFC 7932, Section 6.
+var (
+	blockLenBase = [numBlockLenSymbols]uint32{
+		1, 5, 9, 13, 17, 25, 33, 41, 49, 65, 81, 97, 113,
+		145, 177, 209, 241, 305, 369, 497, 753, 1265, 2289, 4337, 8433, 16625,
+	}
+	blockLenExtra = [numBlockLenSymbols]uint8{
+		2, 2, 2, 2, 3, 3, 3, 3, 4, 4, 4, 4, 5,
+		5, 5, 5, 6, 6, 7, 8, 9, 10, 11, 12, 13, 24,
+	}
+)
+
+// Short distance codes 0 to 15 refer to the last four distances,
+// counting back from the last one, plus a small delta.
+var (
+	distShortIndex = [numDistShortCodes]uint8{0, 1, 2, 3, 0, 0, 0, 0, 0, 0, 1, 1, 1, 1, 1, 1}
+	distShortDelta = [numDistShortCodes]int8{0, 0, 0, 0, -1, 1, -2, 2, -3, 3, -1, 1, -2, 2, -3, 3}
+)
+
+// noEOF converts io.EOF to io.ErrUnexpectedEOF.
+func noEOF(err error) error {
+	if err == io.EOF {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
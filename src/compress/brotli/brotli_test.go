@@ -0,0 +1,168 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package brotli
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"os"
+	"testing"
+	"testing/iotest"
+)
+
+func mustDecodeHex(s string) []byte {
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+func mustLoadFile(f string) []byte {
+	b, err := os.ReadFile(f)
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+var gettysburg = mustLoadFile("../testdata/gettysburg.txt")
+
+func TestDictionary(t *testing.T) {
+	if len(dictionary) != 122784 {
+		t.Fatalf("dictionary has %d bytes, want 122784", len(dictionary))
+	}
+	sum := sha256.Sum256([]byte(dictionary))
+	const want = "20e42eb1b511c21806d4d227d07e5dd06877d8ce7b3a817f378f313653f35c70"
+	if got := hex.EncodeToString(sum[:]); got != want {
+		t.Errorf("dictionary SHA-256 is %s, want %s", got, want)
+	}
+	if len(transforms) != 121 {
+		t.Errorf("got %d transforms, want 121", len(transforms))
+	}
+}
+
+func TestReader(t *testing.T) {
+	const helloWorld = "8b058068656c6c6f20776f726c640a03"
+	var vectors = []struct {
+		desc   string
+		input  []byte
+		output []byte
+		err    error
+	}{{
+		desc:  "empty stream",
+		input: mustDecodeHex("3b"),
+	}, {
+		desc:   "uncompressed meta-block",
+		input:  mustDecodeHex(helloWorld),
+		output: []byte("hello world\n"),
+	}, {
+		desc:   "smallest window",
+		input:  mustDecodeHex("212c000468656c6c6f20776f726c640a03"),
+		output: []byte("hello world\n"),
+	}, {
+		desc:   "largest window",
+		input:  mustDecodeHex("8f058068656c6c6f20776f726c640a03"),
+		output: []byte("hello world\n"),
+	}, {
+		desc:   "1000 zeros",
+		input:  mustDecodeHex("e27c0080044034164868"),
+		output: make([]byte, 1000),
+	}, {
+		desc: "static dictionary words and transforms",
+		input: mustDecodeHex("" +
+			"1b5800e81da9519fbb983ac8aced4dbbb8c9e9e990b0b334b001074e69e8894d" +
+			"3c78c8b66f7251655570906fb46282c4b5b3b09e64b8385601",
+		),
+		output: []byte("Amazing! The government of the people, by the people, for the people. WEBSITE Information"),
+	}, {
+		desc:   "compressed text",
+		input:  mustLoadFile("testdata/gettysburg.txt.br"),
+		output: gettysburg,
+	}, {
+		desc:   "nonzero padding",
+		input:  mustDecodeHex(helloWorld[:len(helloWorld)-2] + "07"),
+		output: []byte("hello world\n"),
+		err:    StructuralError(""),
+	}, {
+		desc:   "truncated",
+		input:  mustDecodeHex(helloWorld[:20]),
+		output: []byte("hello w"),
+		err:    io.ErrUnexpectedEOF,
+	}}
+
+	for _, v := range vectors {
+		out, err := io.ReadAll(NewReader(bytes.NewReader(v.input)))
+		if _, ok := v.err.(StructuralError); ok {
+			var se StructuralError
+			if !errors.As(err, &se) {
+				t.Errorf("%s: got error %v, want a StructuralError", v.desc, err)
+			}
+		} else if !errors.Is(err, v.err) {
+			t.Errorf("%s: got error %v, want %v", v.desc, err, v.err)
+		}
+		if !bytes.Equal(out, v.output) {
+			t.Errorf("%s: got %q, want %q", v.desc, out, v.output)
+		}
+	}
+}
+
+func TestReaderOneByte(t *testing.T) {
+	// The reader must not read past the end of the stream.
+	in := append(mustLoadFile("testdata/gettysburg.txt.br"), "trailing data"...)
+	r := bytes.NewReader(in)
+	out, err := io.ReadAll(NewReader(iotest.OneByteReader(r)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(out, gettysburg) {
+		t.Fatal("output mismatch")
+	}
+	rest, _ := io.ReadAll(r)
+	if string(rest) != "trailing data" {
+		t.Errorf("left %q unread, want %q", rest, "trailing data")
+	}
+}
+
+func TestReaderReset(t *testing.T) {
+	in := mustLoadFile("testdata/gettysburg.txt.br")
+	z := NewReader(bytes.NewReader(in[:len(in)/2]))
+	if _, err := io.ReadAll(z); err != io.ErrUnexpectedEOF {
+		t.Fatalf("got %v, want io.ErrUnexpectedEOF", err)
+	}
+	z.Reset(bytes.NewReader(in))
+	out, err := io.ReadAll(z)
+	if err != nil || !bytes.Equal(out, gettysburg) {
+		t.Fatalf("after Reset: %v", err)
+	}
+}
+
+func TestTruncatedStreams(t *testing.T) {
+	in := mustLoadFile("testdata/gettysburg.txt.br")
+	for i := 0; i < len(in); i++ {
+		_, err := io.Copy(io.Discard, NewReader(bytes.NewReader(in[:i])))
+		if err != io.ErrUnexpectedEOF {
+			t.Errorf("Read(%d bytes): got %v, want io.ErrUnexpectedEOF", i, err)
+		}
+	}
+}
+
+func TestCorruptStreams(t *testing.T) {
+	// Corrupt data must not cause a panic or output beyond
+	// the original length.
+	in := mustLoadFile("testdata/gettysburg.txt.br")
+	corrupt := make([]byte, len(in))
+	for i := 0; i < len(in)*8; i++ {
+		copy(corrupt, in)
+		corrupt[i/8] ^= 1 << (i % 8)
+		out, _ := io.ReadAll(NewReader(bytes.NewReader(corrupt)))
+		if len(out) > 1<<24 {
+			t.Errorf("flipped bit %d: %d bytes of output", i, len(out))
+		}
+	}
+}
@@ -0,0 +1,1925 @@
+// Code generated by gen_dict.go; DO NOT EDIT.
+
+package brotli
+
+// dictionary is the static dictionary of RFC 7932, Appendix A.
+const dictionary = "" +
+	"timedownlifeleftbackcodedatashowonlysitecityopenjustlikefreework" +
+	"textyearoverbodyloveformbookplaylivelinehelphomesidemorewordlong" +
+	"themviewfindpagedaysfullheadtermeachareafromtruemarkableuponhigh" +
+	"datelandnewsevennextcasebothpostusedmadehandherewhatnameLinkblog" +
+	"sizebaseheldmakemainuser') +holdendswithNewsreadweresigntakehave" +
+	"gameseencallpathwellplusmenufilmpartjointhislistgoodneedwayswest" +
+	"jobsmindalsologorichuseslastteamarmyfoodkingwilleastwardbestfire" +
+	"Pageknowaway.pngmovethanloadgiveselfnotemuchfeedmanyrockicononce" +
+	"lookhidediedHomerulehostajaxinfoclublawslesshalfsomesuchzone100%" +
+	"onescareTimeracebluefourweekfacehopegavehardlostwhenparkkeptpass" +
+	"shiproomHTMLplanTypedonesavekeepflaglinksoldfivetookratetownjump" +
+	"thusdarkcardfilefearstaykillthatfallautoever.comtalkshopvotedeep" +
+	"moderestturnbornbandfellroseurl(skinrolecomeactsagesmeetgold.jpg" +
+	"itemvaryfeltthensenddropViewcopy1.0\"</a>stopelseliestourpack.gif" +
+	"pastcss?graymean&gt;rideshotlatesaidroadvar feeljohnrickportfast" +
+	"'UA-dead</b>poorbilltypeU.S.woodmust2px;Inforankwidewantwalllead" +
+	"[0];paulwavesure$('#waitmassarmsgoesgainlangpaid!-- lockunitroot" +
+	"walkfirmwifexml\"songtest20pxkindrowstoolfontmailsafestarmapscore" +
+	"rainflowbabyspansays4px;6px;artsfootrealwikiheatsteptriporg/lake" +
+	"weaktoldFormcastfansbankveryrunsjulytask1px;goalgrewslowedgeid=\"" +
+	"sets5px;.js?40pxif (soonseatnonetubezerosentreedfactintogiftharm" +
+	"18pxcamehillboldzoomvoideasyringfillpeakinitcost3px;jacktagsbits" +
+	"rolleditknewnear<!--growJSONdutyNamesaleyou lotspainjazzcoldeyes" +
+	"fishwww.risktabsprev10pxrise25pxBlueding300,ballfordearnwildbox." +
+	"fairlackverspairjunetechif(!pickevil$(\"#warmlorddoespull,000idea" +
+	"drawhugespotfundburnhrefcellkeystickhourlossfuel12pxsuitdealRSS\"" +
+	"agedgreyGET\"easeaimsgirlaids8px;navygridtips#999warsladycars); }" +
+	"php?helltallwhomzh:\xe5*/\r\n 100hall.\n\nA7px;pushchat0px;crew*/</hash" +
+	"75pxflatrare && tellcampontolaidmissskiptentfinemalegetsplot400," +
+	"\r\n\r\ncoolfeet.php<br>ericmostguidbelldeschairmathatom/img&#82luck" +
+	"cent000;tinygonehtmlselldrugFREEnodenick?id=losenullvastwindRSS " +
+	"wearrelybeensamedukenasacapewishgulfT23:hitsslotgatekickblurthey" +
+	"15px''););\">msiewinsbirdsortbetaseekT18:ordstreemall60pxfarm\u2019s" +
+	"boys[0].');\"POSTbearkids);}}marytend(UK)quadzh:\xe6-siz----prop');\r" +
+	"liftT19:viceandydebt>RSSpoolneckblowT16:doorevalT17:letsfailoral" +
+	"pollnovacolsgene \u2014softrometillross<h3>pourfadepink<tr>mini)|!(" +
+	"minezh:\xe8barshear00);milk -->ironfreddiskwentsoilputs/js/holyT22:" +
+	"ISBNT20:adamsees<h2>json', 'contT21: RSSloopasiamoon</p>soulLINE" +
+	"fortcartT14:<h1>80px!--<9px;T04:mike:46ZniceinchYorkricezh:\xe4'));" +
+	"puremageparatonebond:37Z_of_']);000,zh:\xe7tankyardbowlbush:56ZJava" +
+	"30px\n|}\n%C3%:34ZjeffEXPIcashvisagolfsnowzh:\xe9quer.csssickmeatmin." +
+	"binddellhirepicsrent:36ZHTTP-201fotowolfEND xbox:54ZBODYdick;\n}\n" +
+	"exit:35Zvarsbeat'});diet999;anne}}</[i].Langkm\u00b2wiretoysaddsseal" +
+	"alex;\n\t}echonine.org005)tonyjewssandlegsroof000) 200winegeardogs" +
+	"bootgarycutstyletemption.xmlcockgang$('.50pxPh.Dmiscalanloandesk" +
+	"mileryanunixdisc);}\ndustclip).\n\n70px-200DVDs7]><tapedemoi++)wage" +
+	"europhiloptsholeFAQsasin-26TlabspetsURL bulkcook;}\r\nHEAD[0])abbr" +
+	"juan(198leshtwin</i>sonyguysfuckpipe|-\n!002)ndow[1];[];\nLog salt" +
+	"\r\n\t\tbangtrimbath){\r\n00px\n});ko:\xecfeesad>\rs:// [];tollplug(){\n{\r\n " +
+	".js'200pdualboat.JPG);\n}quot);\n\n');\n\r\n}\r201420152016201720182019" +
+	"2020202120222023202420252026202720282029203020312032203320342035" +
+	"2036203720132012201120102009200820072006200520042003200220012000" +
+	"1999199819971996199519941993199219911990198919881987198619851984" +
+	"1983198219811980197919781977197619751974197319721971197019691968" +
+	"1967196619651964196319621961196019591958195719561955195419531952" +
+	"1951195010001024139400009999comom\u00e1sesteestaperotodohacecadaa\u00f1o" +
+	"biend\u00edaas\u00edvidacasootroforosolootracualdijosidograntipotemadebe" +
+	"algoqu\u00e9estonadatrespococasabajotodasinoaguapuesunosantediceluis" +
+	"ellamayozonaamorpisoobraclicellodioshoracasi\u0437\u0430\u043d\u0430\u043e\u043c\u0440\u0430\u0440\u0443" +
+	"\u0442\u0430\u043d\u0435\u043f\u043e\u043e\u0442\u0438\u0437\u043d\u043e\u0434\u043e\u0442\u043e\u0436\u0435\u043e\u043d\u0438\u0445\u041d\u0430\u0435\u0435\u0431\u044b\u043c\u044b\u0412\u044b" +
+	"\u0441\u043e\u0432\u044b\u0432\u043e\u041d\u043e\u043e\u0431\u041f\u043e\u043b\u0438\u043d\u0438\u0420\u0424\u041d\u0435\u041c\u044b\u0442\u044b\u041e\u043d\u0438\u043c\u0434\u0430\u0417\u0430" +
+	"\u0414\u0430\u041d\u0443\u041e\u0431\u0442\u0435\u0418\u0437\u0435\u0439\u043d\u0443\u043c\u043c\u0422\u044b\u0443\u0436\u0641\u064a\u0623\u0646\u0645\u0627\u0645\u0639\u0643\u0644\u0623\u0648" +
+	"\u0631\u062f\u064a\u0627\u0641\u0649\u0647\u0648\u0644\u0645\u0644\u0643\u0627\u0648\u0644\u0647\u0628\u0633\u0627\u0644\u0625\u0646\u0647\u064a\u0623\u064a\u0642\u062f\u0647\u0644\u062b\u0645" +
+	"\u0628\u0647\u0644\u0648\u0644\u064a\u0628\u0644\u0627\u064a\u0628\u0643\u0634\u064a\u0627\u0645\u0623\u0645\u0646\u062a\u0628\u064a\u0644\u0646\u062d\u0628\u0647\u0645\u0645\u0634\u0648\u0634" +
+	"firstvideolightworldmediawhitecloseblackrightsmallbooksplacemusi" +
+	"cfieldorderpointvalueleveltableboardhousegroupworksyearsstatetod" +
+	"aywaterstartstyledeathpowerphonenighterrorinputabouttermstitleto" +
+	"olseventlocaltimeslargewordsgamesshortspacefocusclearmodelblockg" +
+	"uideradiosharewomenagainmoneyimagenamesyounglineslatercolorgreen" +
+	"front&amp;watchforcepricerulesbeginaftervisitissueareasbelowinde" +
+	"xtotalhourslabelprintpressbuiltlinksspeedstudytradefoundsenseund" +
+	"ershownformsrangeaddedstillmovedtakenaboveflashfixedoftenothervi" +
+	"ewschecklegalriveritemsquickshapehumanexistgoingmoviethirdbasicp" +
+	"eacestagewidthloginideaswrotepagesusersdrivestorebreaksouthvoice" +
+	"sitesmonthwherebuildwhichearthforumthreesportpartyClicklowerlive" +
+	"sclasslayerentrystoryusagesoundcourtyour birthpopuptypesapplyIma" +
+	"gebeinguppernoteseveryshowsmeansextramatchtrackknownearlybegansu" +
+	"perpapernorthlearngivennamedendedTermspartsGroupbrandusingwomanf" +
+	"alsereadyaudiotakeswhile.com/livedcasesdailychildgreatjudgethose" +
+	"unitsneverbroadcoastcoverapplefilescyclesceneplansclickwritequee" +
+	"npieceemailframeolderphotolimitcachecivilscaleenterthemetheretou" +
+	"chboundroyalaskedwholesincestock namefaithheartemptyofferscopeow" +
+	"nedmightalbumthinkbloodarraymajortrustcanonunioncountvalidstoneS" +
+	"tyleLoginhappyoccurleft:freshquitefilmsgradeneedsurbanfightbasis" +
+	"hoverauto;route.htmlmixedfinalYour slidetopicbrownalonedrawnspli" +
+	"treachRightdatesmarchquotegoodsLinksdoubtasyncthumballowchiefyou" +
+	"thnovel10px;serveuntilhandsCheckSpacequeryjamesequaltwice0,000St" +
+	"artpanelsongsroundeightshiftworthpostsleadsweeksavoidthesemilesp" +
+	"lanesmartalphaplantmarksratesplaysclaimsalestextsstarswrong</h3>" +
+	"thing.org/multiheardPowerstandtokensolid(thisbringshipsstafftrie" +
+	"dcallsfullyfactsagentThis //-->adminegyptEvent15px;Emailtrue\"cro" +
+	"ssspentblogsbox\">notedleavechinasizesguest</h4>robotheavytrue,se" +
+	"vengrandcrimesignsawaredancephase><!--en_US&#39;200px_namelatine" +
+	"njoyajax.ationsmithU.S. holdspeterindianav\">chainscorecomesdoing" +
+	"priorShare1990sromanlistsjapanfallstrialowneragree</h2>abusealer" +
+	"topera\"-//WcardshillsteamsPhototruthclean.php?saintmetallouismea" +
+	"ntproofbriefrow\">genretrucklooksValueFrame.net/-->\n<try {\nvar ma" +
+	"kescostsplainadultquesttrainlaborhelpscausemagicmotortheir250pxl" +
+	"eaststepsCountcouldglasssidesfundshotelawardmouthmovesparisgives" +
+	"dutchtexasfruitnull,||[];top\">\n<!--POST\"ocean<br/>floorspeakdept" +
+	"h sizebankscatchchart20px;aligndealswould50px;url=\"parksmouseMos" +
+	"t ...</amongbrainbody none;basedcarrydraftreferpage_home.meterde" +
+	"laydreamprovejoint</tr>drugs<!-- aprilidealallenexactforthcodesl" +
+	"ogicView seemsblankports (200saved_linkgoalsgrantgreekhomesrings" +
+	"rated30px;whoseparse();\" Blocklinuxjonespixel');\">);if(-leftdavi" +
+	"dhorseFocusraiseboxesTrackement</em>bar\">.src=toweralt=\"cablehen" +
+	"ry24px;setupitalysharpminortastewantsthis.resetwheelgirls/css/10" +
+	"0%;clubsstuffbiblevotes 1000korea});\r\nbandsqueue= {};80px;cking{" +
+	"\r\n\t\taheadclockirishlike ratiostatsForm\"yahoo)[0];Aboutfinds</h1>" +
+	"debugtasksURL =cells})();12px;primetellsturns0x600.jpg\"spainbeac" +
+	"htaxesmicroangel--></giftssteve-linkbody.});\n\tmount (199FAQ</rog" +
+	"erfrankClass28px;feeds<h1><scotttests22px;drink) || lewisshall#0" +
+	"39; for lovedwaste00px;ja:\xe3\x82simon<fontreplymeetsuntercheaptightB" +
+	"rand) != dressclipsroomsonkeymobilmain.Name platefunnytreescom/\"" +
+	"1.jpgwmodeparamSTARTleft idden, 201);\n}\nform.viruschairtranswors" +
+	"tPagesitionpatch<!--\no-cacfirmstours,000 asiani++){adobe')[0]id=" +
+	"10both;menu .2.mi.png\"kevincoachChildbruce2.jpgURL)+.jpg|suitesl" +
+	"iceharry120\" sweettr>\r\nname=diegopage swiss-->\n\n#fff;\">Log.com\"t" +
+	"reatsheet) && 14px;sleepntentfiledja:\xe3\x83id=\"cName\"worseshots-box-" +
+	"delta\n&lt;bears:48Z<data-rural</a> spendbakershops= \"\";php\">ctio" +
+	"n13px;brianhellosize=o=%2F joinmaybe<img img\">, fjsimg\" \")[0]MTo" +
+	"pBType\"newlyDanskczechtrailknows</h5>faq\">zh-cn10);\n-1\");type=bl" +
+	"uestrulydavis.js';>\r\n<!steel you h2>\r\nform jesus100% menu.\r\n\t\r\nw" +
+	"alesrisksumentddingb-likteachgif\" vegasdanskeestishqipsuomisobre" +
+	"desdeentretodospuedea\u00f1osest\u00e1tienehastaotrospartedondenuevohace" +
+	"rformamismomejormundoaqu\u00edd\u00edass\u00f3loayudafechatodastantomenosdat" +
+	"osotrassitiomuchoahoralugarmayorestoshorastenerantesfotosestaspa" +
+	"\u00edsnuevasaludforosmedioquienmesespoderchileser\u00e1vecesdecirjos\u00e9e" +
+	"starventagrupohechoellostengoamigocosasnivelgentemismaairesjulio" +
+	"temashaciafavorjuniolibrepuntobuenoautorabrilbuenatextomarzosabe" +
+	"rlistaluegoc\u00f3moenerojuegoper\u00fahaberestoynuncamujervalorfueralib" +
+	"rogustaigualvotoscasosgu\u00edapuedosomosavisousteddebennochebuscafa" +
+	"ltaeurosseriedichocursoclavecasasle\u00f3nplazolargoobrasvistaapoyoj" +
+	"untotratavistocrearcampohemoscincocargopisosordenhacen\u00e1readisco" +
+	"pedrocercapuedapapelmenor\u00fatilclarojorgecalleponertardenadiemarc" +
+	"asigueellassiglocochemotosmadreclaserestoni\u00f1oquedapasarbancohij" +
+	"osviajepablo\u00e9stevienereinodejarfondocanalnorteletracausatomarma" +
+	"noslunesautosvillavendopesartipostengamarcollevapadreunidovamosz" +
+	"onasambosbandamariaabusomuchasubirriojavivirgradochicaall\u00edjoven" +
+	"dichaestantalessalirsuelopesosfinesllamabusco\u00e9stalleganegroplaz" +
+	"ahumorpagarjuntadobleislasbolsaba\u00f1ohablalucha\u00c1readicenjugarnot" +
+	"asvalleall\u00e1cargadolorabajoest\u00e9gustomentemariofirmacostofichapl" +
+	"atahogarartesleyesaquelmuseobasespocosmitadcielochicomiedoganars" +
+	"antoetapadebesplayaredessietecortecoreadudasdeseoviejodeseaaguas" +
+	"&quot;domaincommonstatuseventsmastersystemactionbannerremovescro" +
+	"llupdateglobalmediumfilternumberchangeresultpublicscreenchooseno" +
+	"rmaltravelissuessourcetargetspringmodulemobileswitchphotosborder" +
+	"regionitselfsocialactivecolumnrecordfollowtitle>eitherlengthfami" +
+	"lyfriendlayoutauthorcreatereviewsummerserverplayedplayerexpandpo" +
+	"licyformatdoublepointsseriespersonlivingdesignmonthsforcesunique" +
+	"weightpeopleenergynaturesearchfigurehavingcustomoffsetletterwind" +
+	"owsubmitrendergroupsuploadhealthmethodvideosschoolfutureshadowde" +
+	"batevaluesObjectothersrightsleaguechromesimplenoticesharedending" +
+	"seasonreportonlinesquarebuttonimagesenablemovinglatestwinterFran" +
+	"ceperiodstrongrepeatLondondetailformeddemandsecurepassedtogglepl" +
+	"acesdevicestaticcitiesstreamyellowattackstreetflighthiddeninfo\">" +
+	"openedusefulvalleycausesleadersecretseconddamagesportsexceptrati" +
+	"ngsignedthingseffectfieldsstatesofficevisualeditorvolumeReportmu" +
+	"seummoviesparentaccessmostlymother\" id=\"marketgroundchancesurvey" +
+	"beforesymbolmomentspeechmotioninsidematterCenterobjectexistsmidd" +
+	"leEuropegrowthlegacymannerenoughcareeransweroriginportalclientse" +
+	"lectrandomclosedtopicscomingfatheroptionsimplyraisedescapechosen" +
+	"churchdefinereasoncorneroutputmemoryiframepolicemodelsNumberduri" +
+	"ngoffersstyleskilledlistedcalledsilvermargindeletebetterbrowseli" +
+	"mitsGlobalsinglewidgetcenterbudgetnowrapcreditclaimsenginesafety" +
+	"choicespirit-stylespreadmakingneededrussiapleaseextentScriptbrok" +
+	"enallowschargedividefactormember-basedtheoryconfigaroundworkedhe" +
+	"lpedChurchimpactshouldalwayslogo\" bottomlist\">){var prefixorange" +
+	"Header.push(couplegardenbridgelaunchReviewtakingvisionlittledati" +
+	"ngButtonbeautythemesforgotSearchanchoralmostloadedChangereturnst" +
+	"ringreloadMobileincomesupplySourceordersviewed&nbsp;courseAbout " +
+	"island<html cookiename=\"amazonmodernadvicein</a>: The dialoghous" +
+	"esBEGIN MexicostartscentreheightaddingIslandassetsEmpireSchoolef" +
+	"fortdirectnearlymanualSelect.\n\nOnejoinedmenu\">Philipawardshandle" +
+	"importOfficeregardskillsnationSportsdegreeweekly (e.g.behinddoct" +
+	"orloggedunited</b></beginsplantsassistartistissued300px|canadaag" +
+	"encyschemeremainBrazilsamplelogo\">beyond-scaleacceptservedmarine" +
+	"Footercamera</h1>\n_form\"leavesstress\" />\r\n.gif\" onloadloaderOxfo" +
+	"rdsistersurvivlistenfemaleDesignsize=\"appealtext\">levelsthankshi" +
+	"gherforcedanimalanyoneAfricaagreedrecentPeople<br />wonderprices" +
+	"turned|| {};main\">inlinesundaywrap\">failedcensusminutebeaconquot" +
+	"es150px|estateremoteemail\"linkedright;signalformal1.htmlsignuppr" +
+	"incefloat:.png\" forum.AccesspaperssoundsextendHeightsliderUTF-8\"" +
+	"&amp; Before. WithstudioownersmanageprofitjQueryannualparamsboug" +
+	"htfamousgooglelongeri++) {israelsayingdecidehome\">headerensurebr" +
+	"anchpiecesblock;statedtop\"><racingresize--&gt;pacitysexualbureau" +
+	".jpg\" 10,000obtaintitlesamount, Inc.comedymenu\" lyricstoday.inde" +
+	"edcounty_logo.FamilylookedMarketlse ifPlayerturkey);var forestgi" +
+	"vingerrorsDomain}else{insertBlog</footerlogin.fasteragents<body " +
+	"10px 0pragmafridayjuniordollarplacedcoversplugin5,000 page\">bost" +
+	"on.test(avatartested_countforumsschemaindex,filledsharesreaderal" +
+	"ert(appearSubmitline\">body\">\n* TheThoughseeingjerseyNews</verify" +
+	"expertinjurywidth=CookieSTART across_imagethreadnativepocketbox\"" +
+	">\nSystem DavidcancertablesprovedApril reallydriveritem\">more\">bo" +
+	"ardscolorscampusfirst || [];media.guitarfinishwidth:showedOther " +
+	".php\" assumelayerswilsonstoresreliefswedenCustomeasily your Stri" +
+	"ng\n\nWhiltaylorclear:resortfrenchthough\") + \"<body>buyingbrandsMe" +
+	"mbername\">oppingsector5px;\">vspacepostermajor coffeemartinmature" +
+	"happen</nav>kansaslink\">Images=falsewhile hspace0&amp; \n\nIn  pow" +
+	"erPolski-colorjordanBottomStart -count2.htmlnews\">01.jpgOnline-r" +
+	"ightmillerseniorISBN 00,000 guidesvalue)ectionrepair.xml\"  right" +
+	"s.html-blockregExp:hoverwithinvirginphones</tr>\rusing \n\tvar >');" +
+	"\n\t</td>\n</tr>\nbahasabrasilgalegomagyarpolskisrpski\u0631\u062f\u0648\u4e2d\u6587\xe7\xae" +
+	"\x80\u4f53\u7e41\u9ad4\u4fe1\u606f\u4e2d\u56fd\u6211\u4eec\u4e00\u4e2a\u516c\u53f8\u7ba1\u7406\u8bba\u575b\u53ef\u4ee5\u670d\u52a1" +
+	"\u65f6\u95f4\u4e2a\u4eba\u4ea7\u54c1\u81ea\u5df1\u4f01\u4e1a\u67e5\u770b\u5de5\u4f5c\u8054\u7cfb\u6ca1\u6709\u7f51\u7ad9\u6240\xe6" +
+	"\x9c\x89\u8bc4\u8bba\u4e2d\u5fc3\u6587\u7ae0\u7528\u6237\u9996\u9875\u4f5c\u8005\u6280\u672f\u95ee\u9898\u76f8\u5173\u4e0b\u8f7d\xe6\x90" +
+	"\x9c\u7d22\u4f7f\u7528\u8f6f\u4ef6\u5728\u7ebf\u4e3b\u9898\u8d44\u6599\u89c6\u9891\u56de\u590d\u6ce8\u518c\u7f51\u7edc\u6536\u85cf" +
+	"\u5185\u5bb9\u63a8\u8350\u5e02\u573a\u6d88\u606f\u7a7a\u95f4\u53d1\u5e03\u4ec0\u4e48\u597d\u53cb\u751f\u6d3b\u56fe\u7247\u53d1\xe5" +
+	"\xb1\x95\u5982\u679c\u624b\u673a\u65b0\u95fb\u6700\u65b0\u65b9\u5f0f\u5317\u4eac\u63d0\u4f9b\u5173\u4e8e\u66f4\u591a\u8fd9\u4e2a\xe7\xb3" +
+	"\xbb\u7edf\u77e5\u9053\u6e38\u620f\u5e7f\u544a\u5176\u4ed6\u53d1\u8868\u5b89\u5168\u7b2c\u4e00\u4f1a\u5458\u8fdb\u884c\u70b9\u51fb" +
+	"\u7248\u6743\u7535\u5b50\u4e16\u754c\u8bbe\u8ba1\u514d\u8d39\u6559\u80b2\u52a0\u5165\u6d3b\u52a8\u4ed6\u4eec\u5546\u54c1\u535a\xe5" +
+	"\xae\xa2\u73b0\u5728\u4e0a\u6d77\u5982\u4f55\u5df2\u7ecf\u7559\u8a00\u8be6\u7ec6\u793e\u533a\u767b\u5f55\u672c\u7ad9\u9700\u8981\xe4\xbb" +
+	"\xb7\u683c\u652f\u6301\u56fd\u9645\u94fe\u63a5\u56fd\u5bb6\u5efa\u8bbe\u670b\u53cb\u9605\u8bfb\u6cd5\u5f8b\u4f4d\u7f6e\u7ecf\u6d4e" +
+	"\u9009\u62e9\u8fd9\u6837\u5f53\u524d\u5206\u7c7b\u6392\u884c\u56e0\u4e3a\u4ea4\u6613\u6700\u540e\u97f3\u4e50\u4e0d\u80fd\u901a\xe8" +
+	"\xbf\x87\u884c\u4e1a\u79d1\u6280\u53ef\u80fd\u8bbe\u5907\u5408\u4f5c\u5927\u5bb6\u793e\u4f1a\u7814\u7a76\u4e13\u4e1a\u5168\u90e8\xe9\xa1" +
+	"\xb9\u76ee\u8fd9\u91cc\u8fd8\u662f\u5f00\u59cb\u60c5\u51b5\u7535\u8111\u6587\u4ef6\u54c1\u724c\u5e2e\u52a9\u6587\u5316\u8d44\u6e90" +
+	"\u5927\u5b66\u5b66\u4e60\u5730\u5740\u6d4f\u89c8\u6295\u8d44\u5de5\u7a0b\u8981\u6c42\u600e\u4e48\u65f6\u5019\u529f\u80fd\u4e3b\xe8" +
+	"\xa6\x81\u76ee\u524d\u8d44\u8baf\u57ce\u5e02\u65b9\u6cd5\u7535\u5f71\u62db\u8058\u58f0\u660e\u4efb\u4f55\u5065\u5eb7\u6570\u636e\xe7\xbe" +
+	"\x8e\u56fd\u6c7d\u8f66\u4ecb\u7ecd\u4f46\u662f\u4ea4\u6d41\u751f\u4ea7\u6240\u4ee5\u7535\u8bdd\u663e\u793a\u4e00\u4e9b\u5355\u4f4d" +
+	"\u4eba\u5458\u5206\u6790\u5730\u56fe\u65c5\u6e38\u5de5\u5177\u5b66\u751f\u7cfb\u5217\u7f51\u53cb\u5e16\u5b50\u5bc6\u7801\u9891\xe9" +
+	"\x81\x93\u63a7\u5236\u5730\u533a\u57fa\u672c\u5168\u56fd\u7f51\u4e0a\u91cd\u8981\u7b2c\u4e8c\u559c\u6b22\u8fdb\u5165\u53cb\u60c5\xe8\xbf" +
+	"\x99\u4e9b\u8003\u8bd5\u53d1\u73b0\u57f9\u8bad\u4ee5\u4e0a\u653f\u5e9c\u6210\u4e3a\u73af\u5883\u9999\u6e2f\u540c\u65f6\u5a31\u4e50" +
+	"\u53d1\u9001\u4e00\u5b9a\u5f00\u53d1\u4f5c\u54c1\u6807\u51c6\u6b22\u8fce\u89e3\u51b3\u5730\u65b9\u4e00\u4e0b\u4ee5\u53ca\u8d23\xe4" +
+	"\xbb\xbb\u6216\u8005\u5ba2\u6237\u4ee3\u8868\u79ef\u5206\u5973\u4eba\u6570\u7801\u9500\u552e\u51fa\u73b0\u79bb\u7ebf\u5e94\u7528\xe5\x88" +
+	"\x97\u8868\u4e0d\u540c\u7f16\u8f91\u7edf\u8ba1\u67e5\u8be2\u4e0d\u8981\u6709\u5173\u673a\u6784\u5f88\u591a\u64ad\u653e\u7ec4\u7ec7" +
+	"\u653f\u7b56\u76f4\u63a5\u80fd\u529b\u6765\u6e90\u6642\u9593\u770b\u5230\u70ed\u95e8\u5173\u952e\u4e13\u533a\u975e\u5e38\u82f1\xe8" +
+	"\xaf\xad\u767e\u5ea6\u5e0c\u671b\u7f8e\u5973\u6bd4\u8f83\u77e5\u8bc6\u89c4\u5b9a\u5efa\u8bae\u90e8\u95e8\u610f\u89c1\u7cbe\u5f69\xe6\x97" +
+	"\xa5\u672c\u63d0\u9ad8\u53d1\u8a00\u65b9\u9762\u57fa\u91d1\u5904\u7406\u6743\u9650\u5f71\u7247\u94f6\u884c\u8fd8\u6709\u5206\u4eab" +
+	"\u7269\u54c1\u7ecf\u8425\u6dfb\u52a0\u4e13\u5bb6\u8fd9\u79cd\u8bdd\u9898\u8d77\u6765\u4e1a\u52a1\u516c\u544a\u8bb0\u5f55\u7b80\xe4" +
+	"\xbb\x8b\u8d28\u91cf\u7537\u4eba\u5f71\u54cd\u5f15\u7528\u62a5\u544a\u90e8\u5206\u5feb\u901f\u54a8\u8be2\u65f6\u5c1a\u6ce8\u610f\xe7\x94" +
+	"\xb3\u8bf7\u5b66\u6821\u5e94\u8be5\u5386\u53f2\u53ea\u662f\u8fd4\u56de\u8d2d\u4e70\u540d\u79f0\u4e3a\u4e86\u6210\u529f\u8bf4\u660e" +
+	"\u4f9b\u5e94\u5b69\u5b50\u4e13\u9898\u7a0b\u5e8f\u4e00\u822c\u6703\u54e1\u53ea\u6709\u5176\u5b83\u4fdd\u62a4\u800c\u4e14\u4eca\xe5" +
+	"\xa4\xa9\u7a97\u53e3\u52a8\u6001\u72b6\u6001\u7279\u522b\u8ba4\u4e3a\u5fc5\u987b\u66f4\u65b0\u5c0f\u8bf4\u6211\u5011\u4f5c\u4e3a\xe5\xaa" +
+	"\x92\u4f53\u5305\u62ec\u90a3\u4e48\u4e00\u6837\u56fd\u5185\u662f\u5426\u6839\u636e\u7535\u89c6\u5b66\u9662\u5177\u6709\u8fc7\u7a0b" +
+	"\u7531\u4e8e\u4eba\u624d\u51fa\u6765\u4e0d\u8fc7\u6b63\u5728\u660e\u661f\u6545\u4e8b\u5173\u7cfb\u6807\u9898\u5546\u52a1\u8f93\xe5" +
+	"\x85\xa5\u4e00\u76f4\u57fa\u7840\u6559\u5b66\u4e86\u89e3\u5efa\u7b51\u7ed3\u679c\u5168\u7403\u901a\u77e5\u8ba1\u5212\u5bf9\u4e8e\xe8\x89" +
+	"\xba\u672f\u76f8\u518c\u53d1\u751f\u771f\u7684\u5efa\u7acb\u7b49\u7ea7\u7c7b\u578b\u7ecf\u9a8c\u5b9e\u73b0\u5236\u4f5c\u6765\u81ea" +
+	"\u6807\u7b7e\u4ee5\u4e0b\u539f\u521b\u65e0\u6cd5\u5176\u4e2d\u500b\u4eba\u4e00\u5207\u6307\u5357\u5173\u95ed\u96c6\u56e2\u7b2c\xe4" +
+	"\xb8\x89\u5173\u6ce8\u56e0\u6b64\u7167\u7247\u6df1\u5733\u5546\u4e1a\u5e7f\u5dde\u65e5\u671f\u9ad8\u7ea7\u6700\u8fd1\u7efc\u5408\xe8\xa1" +
+	"\xa8\u793a\u4e13\u8f91\u884c\u4e3a\u4ea4\u901a\u8bc4\u4ef7\u89c9\u5f97\u7cbe\u534e\u5bb6\u5ead\u5b8c\u6210\u611f\u89c9\u5b89\u88c5" +
+	"\u5f97\u5230\u90ae\u4ef6\u5236\u5ea6\u98df\u54c1\u867d\u7136\u8f6c\u8f7d\u62a5\u4ef7\u8bb0\u8005\u65b9\u6848\u884c\u653f\u4eba\xe6" +
+	"\xb0\x91\u7528\u54c1\u4e1c\u897f\u63d0\u51fa\u9152\u5e97\u7136\u540e\u4ed8\u6b3e\u70ed\u70b9\u4ee5\u524d\u5b8c\u5168\u53d1\u5e16\xe8\xae" +
+	"\xbe\u7f6e\u9886\u5bfc\u5de5\u4e1a\u533b\u9662\u770b\u770b\u7ecf\u5178\u539f\u56e0\u5e73\u53f0\u5404\u79cd\u589e\u52a0\u6750\u6599" +
+	"\u65b0\u589e\u4e4b\u540e\u804c\u4e1a\u6548\u679c\u4eca\u5e74\u8bba\u6587\u6211\u56fd\u544a\u8bc9\u7248\u4e3b\u4fee\u6539\u53c2\xe4" +
+	"\xb8\x8e\u6253\u5370\u5feb\u4e50\u673a\u68b0\u89c2\u70b9\u5b58\u5728\u7cbe\u795e\u83b7\u5f97\u5229\u7528\u7ee7\u7eed\u4f60\u4eec\xe8\xbf" +
+	"\x99\u4e48\u6a21\u5f0f\u8bed\u8a00\u80fd\u591f\u96c5\u864e\u64cd\u4f5c\u98ce\u683c\u4e00\u8d77\u79d1\u5b66\u4f53\u80b2\u77ed\u4fe1" +
+	"\u6761\u4ef6\u6cbb\u7597\u8fd0\u52a8\u4ea7\u4e1a\u4f1a\u8bae\u5bfc\u822a\u5148\u751f\u8054\u76df\u53ef\u662f\u554f\u984c\u7ed3\xe6" +
+	"\x9e\x84\u4f5c\u7528\u8c03\u67e5\u8cc7\u6599\u81ea\u52a8\u8d1f\u8d23\u519c\u4e1a\u8bbf\u95ee\u5b9e\u65bd\u63a5\u53d7\u8ba8\u8bba\xe9\x82" +
+	"\xa3\u4e2a\u53cd\u9988\u52a0\u5f3a\u5973\u6027\u8303\u56f4\u670d\u52d9\u4f11\u95f2\u4eca\u65e5\u5ba2\u670d\u89c0\u770b\u53c2\u52a0" +
+	"\u7684\u8bdd\u4e00\u70b9\u4fdd\u8bc1\u56fe\u4e66\u6709\u6548\u6d4b\u8bd5\u79fb\u52a8\u624d\u80fd\u51b3\u5b9a\u80a1\u7968\u4e0d\xe6" +
+	"\x96\xad\u9700\u6c42\u4e0d\u5f97\u529e\u6cd5\u4e4b\u95f4\u91c7\u7528\u8425\u9500\u6295\u8bc9\u76ee\u6807\u7231\u60c5\u6444\u5f71\xe6\x9c" +
+	"\x89\u4e9b\u8907\u88fd\u6587\u5b66\u673a\u4f1a\u6570\u5b57\u88c5\u4fee\u8d2d\u7269\u519c\u6751\u5168\u9762\u7cbe\u54c1\u5176\u5b9e" +
+	"\u4e8b\u60c5\u6c34\u5e73\u63d0\u793a\u4e0a\u5e02\u8c22\u8c22\u666e\u901a\u6559\u5e08\u4e0a\u4f20\u7c7b\u522b\u6b4c\u66f2\u62e5\xe6" +
+	"\x9c\x89\u521b\u65b0\u914d\u4ef6\u53ea\u8981\u65f6\u4ee3\u8cc7\u8a0a\u8fbe\u5230\u4eba\u751f\u8ba2\u9605\u8001\u5e08\u5c55\u793a\xe5\xbf" +
+	"\x83\u7406\u8d34\u5b50\u7db2\u7ad9\u4e3b\u984c\u81ea\u7136\u7ea7\u522b\u7b80\u5355\u6539\u9769\u90a3\u4e9b\u6765\u8bf4\u6253\u5f00" +
+	"\u4ee3\u7801\u5220\u9664\u8bc1\u5238\u8282\u76ee\u91cd\u70b9\u6b21\u6578\u591a\u5c11\u89c4\u5212\u8d44\u91d1\u627e\u5230\u4ee5\xe5" +
+	"\x90\x8e\u5927\u5168\u4e3b\u9875\u6700\u4f73\u56de\u7b54\u5929\u4e0b\u4fdd\u969c\u73b0\u4ee3\u68c0\u67e5\u6295\u7968\u5c0f\u65f6\xe6\xb2" +
+	"\x92\u6709\u6b63\u5e38\u751a\u81f3\u4ee3\u7406\u76ee\u5f55\u516c\u5f00\u590d\u5236\u91d1\u878d\u5e78\u798f\u7248\u672c\u5f62\u6210" +
+	"\u51c6\u5907\u884c\u60c5\u56de\u5230\u601d\u60f3\u600e\u6837\u534f\u8bae\u8ba4\u8bc1\u6700\u597d\u4ea7\u751f\u6309\u7167\u670d\xe8" +
+	"\xa3\x85\u5e7f\u4e1c\u52a8\u6f2b\u91c7\u8d2d\u65b0\u624b\u7ec4\u56fe\u9762\u677f\u53c2\u8003\u653f\u6cbb\u5bb9\u6613\u5929\u5730\xe5\x8a" +
+	"\xaa\u529b\u4eba\u4eec\u5347\u7ea7\u901f\u5ea6\u4eba\u7269\u8c03\u6574\u6d41\u884c\u9020\u6210\u6587\u5b57\u97e9\u56fd\u8d38\u6613" +
+	"\u5f00\u5c55\u76f8\u95dc\u8868\u73b0\u5f71\u89c6\u5982\u6b64\u7f8e\u5bb9\u5927\u5c0f\u62a5\u9053\u6761\u6b3e\u5fc3\u60c5\u8bb8\xe5" +
+	"\xa4\x9a\u6cd5\u89c4\u5bb6\u5c45\u4e66\u5e97\u8fde\u63a5\u7acb\u5373\u4e3e\u62a5\u6280\u5de7\u5965\u8fd0\u767b\u5165\u4ee5\u6765\xe7\x90" +
+	"\x86\u8bba\u4e8b\u4ef6\u81ea\u7531\u4e2d\u534e\u529e\u516c\u5988\u5988\u771f\u6b63\u4e0d\u9519\u5168\u6587\u5408\u540c\u4ef7\u503c" +
+	"\u522b\u4eba\u76d1\u7763\u5177\u4f53\u4e16\u7eaa\u56e2\u961f\u521b\u4e1a\u627f\u62c5\u589e\u957f\u6709\u4eba\u4fdd\u6301\u5546\xe5" +
+	"\xae\xb6\u7ef4\u4fee\u53f0\u6e7e\u5de6\u53f3\u80a1\u4efd\u7b54\u6848\u5b9e\u9645\u7535\u4fe1\u7ecf\u7406\u751f\u547d\u5ba3\u4f20\xe4\xbb" +
+	"\xbb\u52a1\u6b63\u5f0f\u7279\u8272\u4e0b\u6765\u534f\u4f1a\u53ea\u80fd\u5f53\u7136\u91cd\u65b0\u5167\u5bb9\u6307\u5bfc\u8fd0\u884c" +
+	"\u65e5\u5fd7\u8ce3\u5bb6\u8d85\u8fc7\u571f\u5730\u6d59\u6c5f\u652f\u4ed8\u63a8\u51fa\u7ad9\u957f\u676d\u5dde\u6267\u884c\u5236\xe9" +
+	"\x80\xa0\u4e4b\u4e00\u63a8\u5e7f\u73b0\u573a\u63cf\u8ff0\u53d8\u5316\u4f20\u7edf\u6b4c\u624b\u4fdd\u9669\u8bfe\u7a0b\u533b\u7597\xe7\xbb" +
+	"\x8f\u8fc7\u8fc7\u53bb\u4e4b\u524d\u6536\u5165\u5e74\u5ea6\u6742\u5fd7\u7f8e\u4e3d\u6700\u9ad8\u767b\u9646\u672a\u6765\u52a0\u5de5" +
+	"\u514d\u8d23\u6559\u7a0b\u7248\u5757\u8eab\u4f53\u91cd\u5e86\u51fa\u552e\u6210\u672c\u5f62\u5f0f\u571f\u8c46\u51fa\u50f9\u4e1c\xe6" +
+	"\x96\xb9\u90ae\u7bb1\u5357\u4eac\u6c42\u804c\u53d6\u5f97\u804c\u4f4d\u76f8\u4fe1\u9875\u9762\u5206\u949f\u7f51\u9875\u786e\u5b9a\xe5\x9b" +
+	"\xbe\u4f8b\u7f51\u5740\u79ef\u6781\u9519\u8bef\u76ee\u7684\u5b9d\u8d1d\u673a\u5173\u98ce\u9669\u6388\u6743\u75c5\u6bd2\u5ba0\u7269" +
+	"\u9664\u4e86\u8a55\u8ad6\u75be\u75c5\u53ca\u65f6\u6c42\u8d2d\u7ad9\u70b9\u513f\u7ae5\u6bcf\u5929\u4e2d\u592e\u8ba4\u8bc6\u6bcf\xe4" +
+	"\xb8\xaa\u5929\u6d25\u5b57\u4f53\u53f0\u7063\u7ef4\u62a4\u672c\u9875\u4e2a\u6027\u5b98\u65b9\u5e38\u89c1\u76f8\u673a\u6218\u7565\xe5\xba" +
+	"\x94\u5f53\u5f8b\u5e08\u65b9\u4fbf\u6821\u56ed\u80a1\u5e02\u623f\u5c4b\u680f\u76ee\u5458\u5de5\u5bfc\u81f4\u7a81\u7136\u9053\u5177" +
+	"\u672c\u7f51\u7ed3\u5408\u6863\u6848\u52b3\u52a8\u53e6\u5916\u7f8e\u5143\u5f15\u8d77\u6539\u53d8\u7b2c\u56db\u4f1a\u8ba1\u8aaa\xe6" +
+	"\x98\x8e\u9690\u79c1\u5b9d\u5b9d\u89c4\u8303\u6d88\u8d39\u5171\u540c\u5fd8\u8bb0\u4f53\u7cfb\u5e26\u6765\u540d\u5b57\u767c\u8868\xe5\xbc" +
+	"\x80\u653e\u52a0\u76df\u53d7\u5230\u4e8c\u624b\u5927\u91cf\u6210\u4eba\u6570\u91cf\u5171\u4eab\u533a\u57df\u5973\u5b69\u539f\u5219" +
+	"\u6240\u5728\u7ed3\u675f\u901a\u4fe1\u8d85\u7ea7\u914d\u7f6e\u5f53\u65f6\u4f18\u79c0\u6027\u611f\u623f\u4ea7\u904a\u6232\u51fa\xe5" +
+	"\x8f\xa3\u63d0\u4ea4\u5c31\u4e1a\u4fdd\u5065\u7a0b\u5ea6\u53c2\u6570\u4e8b\u4e1a\u6574\u4e2a\u5c71\u4e1c\u60c5\u611f\u7279\u6b8a\xe5\x88" +
+	"\x86\u985e\u641c\u5c0b\u5c5e\u4e8e\u95e8\u6237\u8d22\u52a1\u58f0\u97f3\u53ca\u5176\u8d22\u7ecf\u575a\u6301\u5e72\u90e8\u6210\u7acb" +
+	"\u5229\u76ca\u8003\u8651\u6210\u90fd\u5305\u88c5\u7528\u6236\u6bd4\u8d5b\u6587\u660e\u62db\u5546\u5b8c\u6574\u771f\u662f\u773c\xe7" +
+	"\x9d\x9b\u4f19\u4f34\u5a01\u671b\u9886\u57df\u536b\u751f\u4f18\u60e0\u8ad6\u58c7\u516c\u5171\u826f\u597d\u5145\u5206\u7b26\u5408\xe9\x99" +
+	"\x84\u4ef6\u7279\u70b9\u4e0d\u53ef\u82f1\u6587\u8d44\u4ea7\u6839\u672c\u660e\u663e\u5bc6\u78bc\u516c\u4f17\u6c11\u65cf\u66f4\u52a0" +
+	"\u4eab\u53d7\u540c\u5b66\u542f\u52a8\u9002\u5408\u539f\u6765\u95ee\u7b54\u672c\u6587\u7f8e\u98df\u7eff\u8272\u7a33\u5b9a\u7ec8\xe4" +
+	"\xba\x8e\u751f\u7269\u4f9b\u6c42\u641c\u72d0\u529b\u91cf\u4e25\u91cd\u6c38\u8fdc\u5199\u771f\u6709\u9650\u7ade\u4e89\u5bf9\u8c61\xe8\xb4" +
+	"\xb9\u7528\u4e0d\u597d\u7edd\u5bf9\u5341\u5206\u4fc3\u8fdb\u70b9\u8bc4\u5f71\u97f3\u4f18\u52bf\u4e0d\u5c11\u6b23\u8d4f\u5e76\u4e14" +
+	"\u6709\u70b9\u65b9\u5411\u5168\u65b0\u4fe1\u7528\u8bbe\u65bd\u5f62\u8c61\u8d44\u683c\u7a81\u7834\u968f\u7740\u91cd\u5927\u4e8e\xe6" +
+	"\x98\xaf\u6bd5\u4e1a\u667a\u80fd\u5316\u5de5\u5b8c\u7f8e\u5546\u57ce\u7edf\u4e00\u51fa\u7248\u6253\u9020\u7522\u54c1\u6982\u51b5\xe7\x94" +
+	"\xa8\u4e8e\u4fdd\u7559\u56e0\u7d20\u4e2d\u570b\u5b58\u50a8\u8d34\u56fe\u6700\u611b\u957f\u671f\u53e3\u4ef7\u7406\u8d22\u57fa\u5730" +
+	"\u5b89\u6392\u6b66\u6c49\u91cc\u9762\u521b\u5efa\u5929\u7a7a\u9996\u5148\u5b8c\u5584\u9a71\u52a8\u4e0b\u9762\u4e0d\u518d\u8bda\xe4" +
+	"\xbf\xa1\u610f\u4e49\u9633\u5149\u82f1\u56fd\u6f02\u4eae\u519b\u4e8b\u73a9\u5bb6\u7fa4\u4f17\u519c\u6c11\u5373\u53ef\u540d\u7a31\xe5\xae" +
+	"\xb6\u5177\u52a8\u753b\u60f3\u5230\u6ce8\u660e\u5c0f\u5b66\u6027\u80fd\u8003\u7814\u786c\u4ef6\u89c2\u770b\u6e05\u695a\u641e\u7b11" +
+	"\u9996\u9801\u9ec4\u91d1\u9002\u7528\u6c5f\u82cf\u771f\u5b9e\u4e3b\u7ba1\u9636\u6bb5\u8a3b\u518a\u7ffb\u8bd1\u6743\u5229\u505a\xe5" +
+	"\xa5\xbd\u4f3c\u4e4e\u901a\u8baf\u65bd\u5de5\u72c0\u614b\u4e5f\u8bb8\u73af\u4fdd\u57f9\u517b\u6982\u5ff5\u5927\u578b\u673a\u7968\xe7\x90" +
+	"\x86\u89e3\u533f\u540dcuandoenviarmadridbuscariniciotiempoporquecuentaestado" +
+	"puedenjuegoscontraest\u00e1nnombretienenperfilmaneraamigosciudadcent" +
+	"roaunquepuedesdentroprimerprecioseg\u00fanbuenosvolverpuntossemanaha" +
+	"b\u00edaagostonuevosunidoscarlosequiponi\u00f1osmuchosalgunacorreoimagen" +
+	"partirarribamar\u00edahombreempleoverdadcambiomuchasfueronpasadol\u00edn" +
+	"eaparecenuevascursosestabaquierolibroscuantoaccesomiguelvarioscu" +
+	"atrotienesgruposser\u00e1neuropamediosfrenteacercadem\u00e1sofertacoches" +
+	"modeloitalialetrasalg\u00fancompracualesexistecuerposiendoprensalleg" +
+	"arviajesdineromurciapodr\u00e1puestodiariopuebloquieremanuelpropiocr" +
+	"isisciertoseguromuertefuentecerrargrandeefectopartesmedidapropia" +
+	"ofrecetierrae-mailvariasformasfuturoobjetoseguirriesgonormasmism" +
+	"os\u00fanicocaminositiosraz\u00f3ndebidopruebatoledoten\u00edajes\u00fasesperoco" +
+	"cinaorigentiendacientoc\u00e1dizhablarser\u00edalatinafuerzaestiloguerra" +
+	"entrar\u00e9xitol\u00f3pezagendav\u00eddeoevitarpaginametrosjavierpadresf\u00e1c" +
+	"ilcabeza\u00e1reassalidaenv\u00edojap\u00f3nabusosbienestextosllevarpuedanfu" +
+	"ertecom\u00fanclaseshumanotenidobilbaounidadest\u00e1seditarcreado\u0434\u043b\u044f" +
+	"\u0447\u0442\u043e\u043a\u0430\u043a\u0438\u043b\u0438\u044d\u0442\u043e\u0432\u0441\u0435\u0435\u0433\u043e\u043f\u0440\u0438\u0442\u0430\u043a\u0435\u0449\u0435\u0443\u0436\u0435\u041a\u0430" +
+	"\u043a\u0431\u0435\u0437\u0431\u044b\u043b\u043e\u043d\u0438\u0412\u0441\u0435\u043f\u043e\u0434\u042d\u0442\u043e\u0442\u043e\u043c\u0447\u0435\u043c\u043d\u0435\u0442\u043b\u0435\u0442\u0440" +
+	"\u0430\u0437\u043e\u043d\u0430\u0433\u0434\u0435\u043c\u043d\u0435\u0414\u043b\u044f\u041f\u0440\u0438\u043d\u0430\u0441\u043d\u0438\u0445\u0442\u0435\u043c\u043a\u0442\u043e\u0433\u043e\u0434" +
+	"\u0432\u043e\u0442\u0442\u0430\u043c\u0421\u0428\u0410\u043c\u0430\u044f\u0427\u0442\u043e\u0432\u0430\u0441\u0432\u0430\u043c\u0435\u043c\u0443\u0422\u0430\u043a\u0434\u0432\u0430\u043d\u0430" +
+	"\u043c\u044d\u0442\u0438\u044d\u0442\u0443\u0412\u0430\u043c\u0442\u0435\u0445\u043f\u0440\u043e\u0442\u0443\u0442\u043d\u0430\u0434\u0434\u043d\u044f\u0412\u043e\u0442\u0442\u0440\u0438\u043d" +
+	"\u0435\u0439\u0412\u0430\u0441\u043d\u0438\u043c\u0441\u0430\u043c\u0442\u043e\u0442\u0440\u0443\u0431\u041e\u043d\u0438\u043c\u0438\u0440\u043d\u0435\u0435\u041e\u041e\u041e\u043b\u0438\u0446" +
+	"\u044d\u0442\u0430\u041e\u043d\u0430\u043d\u0435\u043c\u0434\u043e\u043c\u043c\u043e\u0439\u0434\u0432\u0435\u043e\u043d\u043e\u0441\u0443\u0434\u0915\u0947\u0939\u0948\u0915\xe0" +
+	"\xa5\x80\u0938\u0947\u0915\u093e\u0915\u094b\u0914\u0930\u092a\u0930\u0928\u0947\u090f\u0915\u0915\u093f\u092d\u0940\u0907\u0938\xe0\xa4" +
+	"\x95\u0930\u0924\u094b\u0939\u094b\u0906\u092a\u0939\u0940\u092f\u0939\u092f\u093e\u0924\u0915\u0925\u093ejagran\u0906\u091c" +
+	"\u091c\u094b\u0905\u092c\u0926\u094b\u0917\u0908\u091c\u093e\u0917\u090f\u0939\u092e\u0907\u0928\u0935\u0939\u092f\u0947\u0925\xe0" +
+	"\xa5\x87\u0925\u0940\u0918\u0930\u091c\u092c\u0926\u0940\u0915\u0908\u091c\u0940\u0935\u0947\u0928\u0908\u0928\u090f\u0939\u0930\xe0\xa4" +
+	"\x89\u0938\u092e\u0947\u0915\u092e\u0935\u094b\u0932\u0947\u0938\u092c\u092e\u0908\u0926\u0947\u0913\u0930\u0906\u092e\u092c\u0938" +
+	"\u092d\u0930\u092c\u0928\u091a\u0932\u092e\u0928\u0906\u0917\u0938\u0940\u0932\u0940\u0639\u0644\u0649\u0625\u0644\u0649\u0647\u0630\u0627\u0622\u062e" +
+	"\u0631\u0639\u062f\u062f\u0627\u0644\u0649\u0647\u0630\u0647\u0635\u0648\u0631\u063a\u064a\u0631\u0643\u0627\u0646\u0648\u0644\u0627\u0628\u064a\u0646\u0639\u0631\u0636\u0630\u0644\u0643\u0647" +
+	"\u0646\u0627\u064a\u0648\u0645\u0642\u0627\u0644\u0639\u0644\u064a\u0627\u0646\u0627\u0644\u0643\u0646\u062d\u062a\u0649\u0642\u0628\u0644\u0648\u062d\u0629\u0627\u062e\u0631\u0641\u0642\u0637" +
+	"\u0639\u0628\u062f\u0631\u0643\u0646\u0625\u0630\u0627\u0643\u0645\u0627\u0627\u062d\u062f\u0625\u0644\u0627\u0641\u064a\u0647\u0628\u0639\u0636\u0643\u064a\u0641\u0628\u062d\u062b\u0648\u0645" +
+	"\u0646\u0648\u0647\u0648\u0623\u0646\u0627\u062c\u062f\u0627\u0644\u0647\u0627\u0633\u0644\u0645\u0639\u0646\u062f\u0644\u064a\u0633\u0639\u0628\u0631\u0635\u0644\u0649\u0645\u0646\u0630\u0628" +
+	"\u0647\u0627\u0623\u0646\u0647\u0645\u062b\u0644\u0643\u0646\u062a\u0627\u0644\u0627\u062d\u064a\u062b\u0645\u0635\u0631\u0634\u0631\u062d\u062d\u0648\u0644\u0648\u0641\u064a\u0627\u0630\u0627" +
+	"\u0644\u0643\u0644\u0645\u0631\u0629\u0627\u0646\u062a\u0627\u0644\u0641\u0623\u0628\u0648\u062e\u0627\u0635\u0623\u0646\u062a\u0627\u0646\u0647\u0627\u0644\u064a\u0639\u0636\u0648\u0648\u0642" +
+	"\u062f\u0627\u0628\u0646\u062e\u064a\u0631\u0628\u0646\u062a\u0644\u0643\u0645\u0634\u0627\u0621\u0648\u0647\u064a\u0627\u0628\u0648\u0642\u0635\u0635\u0648\u0645\u0627\u0631\u0642\u0645\u0623" +
+	"\u062d\u062f\u0646\u062d\u0646\u0639\u062f\u0645\u0631\u0623\u064a\u0627\u062d\u0629\u0643\u062a\u0628\u062f\u0648\u0646\u064a\u062c\u0628\u0645\u0646\u0647\u062a\u062d\u062a\u062c\u0647\u0629" +
+	"\u0633\u0646\u0629\u064a\u062a\u0645\u0643\u0631\u0629\u063a\u0632\u0629\u0646\u0641\u0633\u0628\u064a\u062a\u0644\u0644\u0647\u0644\u0646\u0627\u062a\u0644\u0643\u0642\u0644\u0628\u0644\u0645" +
+	"\u0627\u0639\u0646\u0647\u0623\u0648\u0644\u0634\u064a\u0621\u0646\u0648\u0631\u0623\u0645\u0627\u0641\u064a\u0643\u0628\u0643\u0644\u0630\u0627\u062a\u0631\u062a\u0628\u0628\u0623\u0646\u0647" +
+	"\u0645\u0633\u0627\u0646\u0643\u0628\u064a\u0639\u0641\u0642\u062f\u062d\u0633\u0646\u0644\u0647\u0645\u0634\u0639\u0631\u0623\u0647\u0644\u0634\u0647\u0631\u0642\u0637\u0631\u0637\u0644\u0628" +
+	"profileservicedefaulthimselfdetailscontentsupportstartedmessages" +
+	"uccessfashion<title>countryaccountcreatedstoriesresultsrunningpr" +
+	"ocesswritingobjectsvisiblewelcomearticleunknownnetworkcompanydyn" +
+	"amicbrowserprivacyproblemServicerespectdisplayrequestreservewebs" +
+	"itehistoryfriendsoptionsworkingversionmillionchannelwindow.addre" +
+	"ssvisitedweathercorrectproductedirectforwardyou canremovedsubjec" +
+	"tcontrolarchivecurrentreadinglibrarylimitedmanagerfurthersummary" +
+	"machineminutesprivatecontextprogramsocietynumberswrittenenabledt" +
+	"riggersourcesloadingelementpartnerfinallyperfectmeaningsystemske" +
+	"epingculture&quot;,journalprojectsurfaces&quot;expiresreviewsbal" +
+	"anceEnglishContentthroughPlease opinioncontactaverageprimaryvill" +
+	"ageSpanishgallerydeclinemeetingmissionpopularqualitymeasuregener" +
+	"alspeciessessionsectionwriterscounterinitialreportsfiguresmember" +
+	"sholdingdisputeearlierexpressdigitalpictureAnothermarriedtraffic" +
+	"leadingchangedcentralvictoryimages/reasonsstudiesfeaturelistingm" +
+	"ust beschoolsVersionusuallyepisodeplayinggrowingobviousoverlaypr" +
+	"esentactions</ul>\r\nwrapperalreadycertainrealitystorageanotherdes" +
+	"ktopofferedpatternunusualDigitalcapitalWebsitefailureconnectredu" +
+	"cedAndroiddecadesregular &amp; animalsreleaseAutomatgettingmetho" +
+	"dsnothingPopularcaptionletterscapturesciencelicensechangesEnglan" +
+	"d=1&amp;History = new CentralupdatedSpecialNetworkrequirecomment" +
+	"warningCollegetoolbarremainsbecauseelectedDeutschfinanceworkersq" +
+	"uicklybetweenexactlysettingdiseaseSocietyweaponsexhibit&lt;!--Co" +
+	"ntrolclassescoveredoutlineattacksdevices(windowpurposetitle=\"Mob" +
+	"ile killingshowingItaliandroppedheavilyeffects-1']);\nconfirmCurr" +
+	"entadvancesharingopeningdrawingbillionorderedGermanyrelated</for" +
+	"m>includewhetherdefinedSciencecatalogArticlebuttonslargestunifor" +
+	"mjourneysidebarChicagoholidayGeneralpassage,&quot;animatefeeling" +
+	"arrivedpassingnaturalroughly.\n\nThe but notdensityBritainChinesel" +
+	"ack oftributeIreland\" data-factorsreceivethat isLibraryhusbandin" +
+	" factaffairsCharlesradicalbroughtfindinglanding:lang=\"return lea" +
+	"dersplannedpremiumpackageAmericaEdition]&quot;Messageneed tovalu" +
+	"e=\"complexlookingstationbelievesmaller-mobilerecordswant tokind " +
+	"ofFirefoxyou aresimilarstudiedmaximumheadingrapidlyclimatekingdo" +
+	"memergedamountsfoundedpioneerformuladynastyhow to Supportrevenue" +
+	"economyResultsbrothersoldierlargelycalling.&quot;AccountEdward s" +
+	"egmentRobert effortsPacificlearnedup withheight:we haveAngelesna" +
+	"tions_searchappliedacquiremassivegranted: falsetreatedbiggestben" +
+	"efitdrivingStudiesminimumperhapsmorningsellingis usedreversevari" +
+	"ant role=\"missingachievepromotestudentsomeoneextremerestorebotto" +
+	"m:evolvedall thesitemapenglishway to  AugustsymbolsCompanymatter" +
+	"smusicalagainstserving})();\r\npaymenttroubleconceptcompareparents" +
+	"playersregionsmonitor ''The winningexploreadaptedGalleryproducea" +
+	"bilityenhancecareers). The collectSearch ancientexistedfooter ha" +
+	"ndlerprintedconsoleEasternexportswindowsChannelillegalneutralsug" +
+	"gest_headersigning.html\">settledwesterncausing-webkitclaimedJust" +
+	"icechaptervictimsThomas mozillapromisepartieseditionoutside:fals" +
+	"e,hundredOlympic_buttonauthorsreachedchronicdemandssecondsprotec" +
+	"tadoptedprepareneithergreatlygreateroverallimprovecommandspecial" +
+	"search.worshipfundingthoughthighestinsteadutilityquarterCulturet" +
+	"estingclearlyexposedBrowserliberal} catchProjectexamplehide();Fl" +
+	"oridaanswersallowedEmperordefenseseriousfreedomSeveral-buttonFur" +
+	"therout of != nulltrainedDenmarkvoid(0)/all.jspreventRequestStep" +
+	"hen\n\nWhen observe</h2>\r\nModern provide\" alt=\"borders.\n\nFor \n\nMan" +
+	"y artistspoweredperformfictiontype ofmedicalticketsopposedCounci" +
+	"lwitnessjusticeGeorge Belgium...</a>twitternotablywaitingwarfare" +
+	" Other rankingphrasesmentionsurvivescholar</p>\r\n Countryignoredl" +
+	"oss ofjust asGeorgiastrange<head><stopped1']);\r\nislandsnotablebo" +
+	"rder:list ofcarried100,000</h3>\n severalbecomesselect wedding00." +
+	"htmlmonarchoff theteacherhighly biologylife ofor evenrise of&raq" +
+	"uo;plusonehunting(thoughDouglasjoiningcirclesFor theAncientVietn" +
+	"amvehiclesuch ascrystalvalue =Windowsenjoyeda smallassumed<a id=" +
+	"\"foreign All rihow theDisplayretiredhoweverhidden;battlesseeking" +
+	"cabinetwas notlook atconductget theJanuaryhappensturninga:hoverO" +
+	"nline French lackingtypicalextractenemieseven ifgeneratdecidedar" +
+	"e not/searchbeliefs-image:locatedstatic.login\">convertviolentent" +
+	"eredfirst\">circuitFinlandchemistshe was10px;\">as suchdivided</sp" +
+	"an>will beline ofa greatmystery/index.fallingdue to railwaycolle" +
+	"gemonsterdescentit withnuclearJewish protestBritishflowerspredic" +
+	"treformsbutton who waslectureinstantsuicidegenericperiodsmarkets" +
+	"Social fishingcombinegraphicwinners<br /><by the NaturalPrivacyc" +
+	"ookiesoutcomeresolveSwedishbrieflyPersianso muchCenturydepictsco" +
+	"lumnshousingscriptsnext tobearingmappingrevisedjQuery(-width:tit" +
+	"le\">tooltipSectiondesignsTurkishyounger.match(})();\n\nburningoper" +
+	"atedegreessource=Richardcloselyplasticentries</tr>\r\ncolor:#ul id" +
+	"=\"possessrollingphysicsfailingexecutecontestlink toDefault<br />" +
+	"\n: true,chartertourismclassicproceedexplain</h1>\r\nonline.?xml ve" +
+	"helpingdiamonduse theairlineend -->).attr(readershosting#ffffffr" +
+	"ealizeVincentsignals src=\"/ProductdespitediversetellingPublic he" +
+	"ld inJoseph theatreaffects<style>a largedoesn'tlater, Elementfav" +
+	"iconcreatorHungaryAirportsee theso thatMichaelSystemsPrograms, a" +
+	"nd  width=e&quot;tradingleft\">\npersonsGolden Affairsgrammarformi" +
+	"ngdestroyidea ofcase ofoldest this is.src = cartoonregistrCommon" +
+	"sMuslimsWhat isin manymarkingrevealsIndeed,equally/show_aoutdoor" +
+	"escape(Austriageneticsystem,In the sittingHe alsoIslandsAcademy\n" +
+	"\t\t<!--Daniel bindingblock\">imposedutilizeAbraham(except{width:pu" +
+	"tting).html(|| [];\nDATA[ *kitchenmountedactual dialectmainly _bl" +
+	"ank'installexpertsif(typeIt also&copy; \">Termsborn inOptionseast" +
+	"erntalkingconcerngained ongoingjustifycriticsfactoryits ownassau" +
+	"ltinvitedlastinghis ownhref=\"/\" rel=\"developconcertdiagramdollar" +
+	"sclusterphp?id=alcohol);})();using a><span>vesselsrevivalAddress" +
+	"amateurandroidallegedillnesswalkingcentersqualifymatchesunifiede" +
+	"xtinctDefensedied in\n\t<!-- customslinkingLittle Book ofeveningmi" +
+	"n.js?are thekontakttoday's.html\" target=wearingAll Rig;\n})();rai" +
+	"sing Also, crucialabout\">declare-->\n<scfirefoxas muchappliesinde" +
+	"x, s, but type = \n\r\n<!--towardsRecordsPrivateForeignPremierchoic" +
+	"esVirtualreturnsCommentPoweredinline;povertychamberLiving volume" +
+	"sAnthonylogin\" RelatedEconomyreachescuttinggravitylife inChapter" +
+	"-shadowNotable</td>\r\n returnstadiumwidgetsvaryingtravelsheld byw" +
+	"ho arework infacultyangularwho hadairporttown of\n\nSome 'click'ch" +
+	"argeskeywordit willcity of(this);Andrew unique checkedor more300" +
+	"px; return;rsion=\"pluginswithin herselfStationFederalventurepubl" +
+	"ishsent totensionactresscome tofingersDuke ofpeople,exploitwhat " +
+	"isharmonya major\":\"httpin his menu\">\nmonthlyofficercouncilgainin" +
+	"geven inSummarydate ofloyaltyfitnessand wasemperorsupremeSecond " +
+	"hearingRussianlongestAlbertalateralset of small\">.appenddo withf" +
+	"ederalbank ofbeneathDespiteCapitalgrounds), and percentit fromcl" +
+	"osingcontainInsteadfifteenas well.yahoo.respondfighterobscureref" +
+	"lectorganic= Math.editingonline paddinga wholeonerroryear ofend " +
+	"of barrierwhen itheader home ofresumedrenamedstrong>heatingretai" +
+	"nscloudfrway of March 1knowingin partBetweenlessonsclosestvirtua" +
+	"llinks\">crossedEND -->famous awardedLicenseHealth fairly wealthy" +
+	"minimalAfricancompetelabel\">singingfarmersBrasil)discussreplaceG" +
+	"regoryfont copursuedappearsmake uproundedboth ofblockedsaw theof" +
+	"ficescoloursif(docuwhen heenforcepush(fuAugust UTF-8\">Fantasyin " +
+	"mostinjuredUsuallyfarmingclosureobject defenceuse of Medical<bod" +
+	"y>\nevidentbe usedkeyCodesixteenIslamic#000000entire widely activ" +
+	"e (typeofone cancolor =speakerextendsPhysicsterrain<tbody>funera" +
+	"lviewingmiddle cricketprophetshifteddoctorsRussell targetcompact" +
+	"algebrasocial-bulk ofman and</td>\n he left).val()false);logicalb" +
+	"ankinghome tonaming Arizonacredits);\n});\nfounderin turnCollinsbe" +
+	"fore But thechargedTitle\">CaptainspelledgoddessTag -->Adding:but" +
+	" wasRecent patientback in=false&Lincolnwe knowCounterJudaismscri" +
+	"pt altered']);\n  has theunclearEvent',both innot all\n\n<!-- placi" +
+	"nghard to centersort ofclientsstreetsBernardassertstend tofantas" +
+	"ydown inharbourFreedomjewelry/about..searchlegendsis mademodern " +
+	"only ononly toimage\" linear painterand notrarely acronymdelivers" +
+	"horter00&amp;as manywidth=\"/* <![Ctitle =of the lowest picked es" +
+	"capeduses ofpeoples PublicMatthewtacticsdamagedway forlaws ofeas" +
+	"y to windowstrong  simple}catch(seventhinfoboxwent topaintedciti" +
+	"zenI don'tretreat. Some ww.\");\nbombingmailto:made in. Many carri" +
+	"es||{};wiwork ofsynonymdefeatsfavoredopticalpageTraunless sendin" +
+	"gleft\"><comScorAll thejQuery.touristClassicfalse\" Wilhelmsuburbs" +
+	"genuinebishops.split(global followsbody ofnominalContactsecularl" +
+	"eft tochiefly-hidden-banner</li>\n\n. When in bothdismissExploreal" +
+	"ways via thespa\u00f1olwelfareruling arrangecaptainhis sonrule ofhe " +
+	"tookitself,=0&amp;(calledsamplesto makecom/pagMartin Kennedyacce" +
+	"ptsfull ofhandledBesides//--></able totargetsessencehim to its b" +
+	"y common.mineralto takeways tos.org/ladvisedpenaltysimple:if the" +
+	"yLettersa shortHerbertstrikes groups.lengthflightsoverlapslowly " +
+	"lesser social </p>\n\t\tit intoranked rate oful>\r\n  attemptpair ofm" +
+	"ake itKontaktAntoniohaving ratings activestreamstrapped\").css(ho" +
+	"stilelead tolittle groups,Picture-->\r\n\r\n rows=\" objectinverse<fo" +
+	"oterCustomV><\\/scrsolvingChamberslaverywoundedwhereas!= 'undfor " +
+	"allpartly -right:Arabianbacked centuryunit ofmobile-Europe,is ho" +
+	"merisk ofdesiredClintoncost ofage of become none ofp&quot;Middle" +
+	" ead')[0Criticsstudios>&copy;group\">assemblmaking pressedwidget." +
+	"ps:\" ? rebuiltby someFormer editorsdelayedCanonichad thepushingc" +
+	"lass=\"but arepartialBabylonbottom carrierCommandits useAs withco" +
+	"ursesa thirddenotesalso inHouston20px;\">accuseddouble goal ofFam" +
+	"ous ).bind(priests Onlinein Julyst + \"gconsultdecimalhelpfulrevi" +
+	"vedis veryr'+'iptlosing femalesis alsostringsdays ofarrivalfutur" +
+	"e <objectforcingString(\" />\n\t\there isencoded.  The balloondone b" +
+	"y/commonbgcolorlaw of Indianaavoidedbut the2px 3pxjquery.after a" +
+	"policy.men andfooter-= true;for usescreen.Indian image =family,h" +
+	"ttp:// &nbsp;driverseternalsame asnoticedviewers})();\n is morese" +
+	"asonsformer the newis justconsent Searchwas thewhy theshippedbr>" +
+	"<br>width: height=made ofcuisineis thata very Admiral fixed;norm" +
+	"al MissionPress, ontariocharsettry to invaded=\"true\"spacingis mo" +
+	"sta more totallyfall of});\r\n  immensetime inset outsatisfyto fin" +
+	"ddown tolot of Playersin Junequantumnot thetime todistantFinnish" +
+	"src = (single help ofGerman law andlabeledforestscookingspace\">h" +
+	"eader-well asStanleybridges/globalCroatia About [0];\n  it, andgr" +
+	"oupedbeing a){throwhe madelighterethicalFFFFFF\"bottom\"like a emp" +
+	"loyslive inas seenprintermost ofub-linkrejectsand useimage\">succ" +
+	"eedfeedingNuclearinformato helpWomen'sNeitherMexicanprotein<tabl" +
+	"e by manyhealthylawsuitdevised.push({sellerssimply Through.cooki" +
+	"e Image(older\">us.js\"> Since universlarger open to!-- endlies in" +
+	"']);\r\n  marketwho is (\"DOMComanagedone fortypeof Kingdomprofitsp" +
+	"roposeto showcenter;made itdressedwere inmixtureprecisearisingsr" +
+	"c = 'make a securedBaptistvoting \n\t\tvar March 2grew upClimate.re" +
+	"moveskilledway the</head>face ofacting right\">to workreduceshas " +
+	"haderectedshow();action=book ofan area== \"htt<header\n<html>confo" +
+	"rmfacing cookie.rely onhosted .customhe wentbut forspread Family" +
+	" a meansout theforums.footage\">MobilClements\" id=\"as highintense" +
+	"--><!--female is seenimpliedset thea stateand hisfastestbesidesb" +
+	"utton_bounded\"><img Infoboxevents,a youngand areNative cheaperTi" +
+	"meoutand hasengineswon the(mostlyright: find a -bottomPrince are" +
+	"a ofmore ofsearch_nature,legallyperiod,land ofor withinducedprov" +
+	"ingmissilelocallyAgainstthe wayk&quot;px;\">\r\npushed abandonnumer" +
+	"alCertainIn thismore inor somename isand, incrownedISBN 0-create" +
+	"sOctobermay notcenter late inDefenceenactedwish tobroadlycooling" +
+	"onload=it. TherecoverMembersheight assumes<html>\npeople.in one =" +
+	"windowfooter_a good reklamaothers,to this_cookiepanel\">London,de" +
+	"finescrushedbaptismcoastalstatus title\" move tolost inbetter imp" +
+	"liesrivalryservers SystemPerhapses and contendflowinglasted rise" +
+	" inGenesisview ofrising seem tobut in backinghe willgiven agivin" +
+	"g cities.flow of Later all butHighwayonly bysign ofhe doesdiffer" +
+	"sbattery&amp;lasinglesthreatsintegertake onrefusedcalled =US&amp" +
+	"See thenativesby thissystem.head of:hover,lesbiansurnameand allc" +
+	"ommon/header__paramsHarvard/pixel.removalso longrole ofjointlysk" +
+	"yscraUnicodebr />\r\nAtlantanucleusCounty,purely count\">easily bui" +
+	"ld aonclicka givenpointerh&quot;events else {\nditionsnow the, wi" +
+	"th man whoorg/Webone andcavalryHe diedseattle00,000 {windowhave " +
+	"toif(windand itssolely m&quot;renewedDetroitamongsteither them i" +
+	"nSenatorUs</a><King ofFrancis-produche usedart andhim andused by" +
+	"scoringat hometo haverelatesibilityfactionBuffalolink\"><what hef" +
+	"ree toCity ofcome insectorscountedone daynervoussquare };if(goin" +
+	" whatimg\" alis onlysearch/tuesdaylooselySolomonsexual - <a hrmed" +
+	"ium\"DO NOT France,with a war andsecond take a >\r\n\r\n\r\nmarket.high" +
+	"waydone inctivity\"last\">obligedrise to\"undefimade to Early prais" +
+	"edin its for hisathleteJupiterYahoo! termed so manyreally s. The" +
+	" a woman?value=direct right\" bicycleacing=\"day andstatingRather," +
+	"higher Office are nowtimes, when a pay foron this-link\">;bordera" +
+	"round annual the Newput the.com\" takin toa brief(in thegroups.; " +
+	"widthenzymessimple in late{returntherapya pointbanninginks\">\n();" +
+	"\" rea place\\u003Caabout atr>\r\n\t\tccount gives a<SCRIPTRailwaythem" +
+	"es/toolboxById(\"xhumans,watchesin some if (wicoming formats Unde" +
+	"r but hashanded made bythan infear ofdenoted/iframeleft involtag" +
+	"ein eacha&quot;base ofIn manyundergoregimesaction </p>\r\n<ustomVa" +
+	";&gt;</importsor thatmostly &amp;re size=\"</a></ha classpassiveH" +
+	"ost = WhetherfertileVarious=[];(fucameras/></td>acts asIn some>\r" +
+	"\n\r\n<!organis <br />Beijingcatal\u00e0deutscheuropeueuskaragaeilgesve" +
+	"nskaespa\u00f1amensajeusuariotrabajom\u00e9xicop\u00e1ginasiempresistemaoctu" +
+	"bredurantea\u00f1adirempresamomentonuestroprimeratrav\u00e9sgraciasnuest" +
+	"raprocesoestadoscalidadpersonan\u00fameroacuerdom\u00fasicamiembrooferta" +
+	"salgunospa\u00edsesejemploderechoadem\u00e1sprivadoagregarenlacesposible" +
+	"hotelessevillaprimero\u00faltimoeventosarchivoculturamujeresentradaa" +
+	"nuncioembargomercadograndesestudiomejoresfebrerodise\u00f1oturismoc\xc3" +
+	"\xb3digoportadaespaciofamiliaantoniopermiteguardaralgunaspreciosalg" +
+	"uiensentidovisitast\u00edtuloconocersegundoconsejofranciaminutossegu" +
+	"ndatenemosefectosm\u00e1lagasesi\u00f3nrevistagranadacompraringresogarc\xc3" +
+	"\xadaacci\u00f3necuadorquienesinclusodeber\u00e1materiahombresmuestrapodr\u00ed" +
+	"ama\u00f1ana\u00faltimaestamosoficialtambienning\u00fansaludospodemosmejorar" +
+	"positionbusinesshomepagesecuritylanguagestandardcampaignfeatures" +
+	"categoryexternalchildrenreservedresearchexchangefavoritetemplate" +
+	"militaryindustryservicesmaterialproductsz-index:commentssoftware" +
+	"completecalendarplatformarticlesrequiredmovementquestionbuilding" +
+	"politicspossiblereligionphysicalfeedbackregisterpicturesdisabled" +
+	"protocolaudiencesettingsactivityelementslearninganythingabstract" +
+	"progressoverviewmagazineeconomictrainingpressurevarious <strong>" +
+	"propertyshoppingtogetheradvancedbehaviordownloadfeaturedfootball" +
+	"selectedLanguagedistanceremembertrackingpasswordmodifiedstudents" +
+	"directlyfightingnortherndatabasefestivalbreakinglocationinternet" +
+	"dropdownpracticeevidencefunctionmarriageresponseproblemsnegative" +
+	"programsanalysisreleasedbanner\">purchasepoliciesregionalcreative" +
+	"argumentbookmarkreferrerchemicaldivisioncallbackseparateprojects" +
+	"conflicthardwareinterestdeliverymountainobtained= false;for(var " +
+	"acceptedcapacitycomputeridentityaircraftemployedproposeddomestic" +
+	"includesprovidedhospitalverticalcollapseapproachpartnerslogo\"><a" +
+	"daughterauthor\" culturalfamilies/images/assemblypowerfulteaching" +
+	"finisheddistrictcriticalcgi-bin/purposesrequireselectionbecoming" +
+	"providesacademicexerciseactuallymedicineconstantaccidentMagazine" +
+	"documentstartingbottom\">observed: &quot;extendedpreviousSoftware" +
+	"customerdecisionstrengthdetailedslightlyplanningtextareacurrency" +
+	"everyonestraighttransferpositiveproducedheritageshippingabsolute" +
+	"receivedrelevantbutton\" violenceanywherebenefitslaunchedrecently" +
+	"alliancefollowedmultiplebulletinincludedoccurredinternal$(this)." +
+	"republic><tr><tdcongressrecordedultimatesolution<ul id=\"discover" +
+	"Home</a>websitesnetworksalthoughentirelymemorialmessagescontinue" +
+	"active\">somewhatvictoriaWestern  title=\"Locationcontractvisitors" +
+	"Downloadwithout right\">\nmeasureswidth = variableinvolvedvirginia" +
+	"normallyhappenedaccountsstandingnationalRegisterpreparedcontrols" +
+	"accuratebirthdaystrategyofficialgraphicscriminalpossiblyconsumer" +
+	"Personalspeakingvalidateachieved.jpg\" />machines</h2>\n  keywords" +
+	"friendlybrotherscombinedoriginalcomposedexpectedadequatepakistan" +
+	"follow\" valuable</label>relativebringingincreasegovernorplugins/" +
+	"List of Header\">\" name=\" (&quot;graduate</head>\ncommercemalaysia" +
+	"directormaintain;height:schedulechangingback to catholicpatterns" +
+	"color: #greatestsuppliesreliable</ul>\n\t\t<select citizensclothing" +
+	"watching<li id=\"specificcarryingsentence<center>contrastthinking" +
+	"catch(e)southernMichael merchantcarouselpadding:interior.split(\"" +
+	"lizationOctober ){returnimproved--&gt;\n\ncoveragechairman.png\" />" +
+	"subjectsRichard whateverprobablyrecoverybaseballjudgmentconnect." +
+	".css\" /> websitereporteddefault\"/></a>\r\nelectricscotlandcreation" +
+	"quantity. ISBN 0did not instance-search-\" lang=\"speakersComputer" +
+	"containsarchivesministerreactiondiscountItalianocriteriastrongly" +
+	": 'http:'script'coveringofferingappearedBritish identifyFacebook" +
+	"numerousvehiclesconcernsAmericanhandlingdiv id=\"William provider" +
+	"_contentaccuracysection andersonflexibleCategorylawrence<script>" +
+	"layout=\"approved maximumheader\"></table>Serviceshamiltoncurrent " +
+	"canadianchannels/themes//articleoptionalportugalvalue=\"\"interval" +
+	"wirelessentitledagenciesSearch\" measuredthousandspending&hellip;" +
+	"new Date\" size=\"pageNamemiddle\" \" /></a>hidden\">sequencepersonal" +
+	"overflowopinionsillinoislinks\">\n\t<title>versionssaturdayterminal" +
+	"itempropengineersectionsdesignerproposal=\"false\"Espa\u00f1olreleases" +
+	"submit\" er&quot;additionsymptomsorientedresourceright\"><pleasure" +
+	"stationshistory.leaving  border=contentscenter\">.\n\nSome directed" +
+	"suitablebulgaria.show();designedGeneral conceptsExampleswilliams" +
+	"Original\"><span>search\">operatorrequestsa &quot;allowingDocument" +
+	"revision. \n\nThe yourselfContact michiganEnglish columbiapriority" +
+	"printingdrinkingfacilityreturnedContent officersRussian generate" +
+	"-8859-1\"indicatefamiliar qualitymargin:0 contentviewportcontacts" +
+	"-title\">portable.length eligibleinvolvesatlanticonload=\"default." +
+	"suppliedpaymentsglossary\n\nAfter guidance</td><tdencodingmiddle\">" +
+	"came to displaysscottishjonathanmajoritywidgets.clinicalthailand" +
+	"teachers<head>\n\taffectedsupportspointer;toString</small>oklahoma" +
+	"will be investor0\" alt=\"holidaysResourcelicensed (which . After " +
+	"considervisitingexplorerprimary search\" android\"quickly meetings" +
+	"estimate;return ;color:# height=approval, &quot; checked.min.js\"" +
+	"magnetic></a></hforecast. While thursdaydvertise&eacute;hasClass" +
+	"evaluateorderingexistingpatients Online coloradoOptions\"campbell" +
+	"<!-- end</span><<br />\r\n_popups|sciences,&quot; quality Windows " +
+	"assignedheight: <b classle&quot; value=\" Companyexamples<iframe " +
+	"believespresentsmarshallpart of properly).\n\nThe taxonomymuch of " +
+	"</span>\n\" data-srtugu\u00easscrollTo project<head>\r\nattorneyemphasis" +
+	"sponsorsfancyboxworld's wildlifechecked=sessionsprogrammpx;font-" +
+	" Projectjournalsbelievedvacationthompsonlightingand the special " +
+	"border=0checking</tbody><button Completeclearfix\n<head>\narticle " +
+	"<sectionfindingsrole in popular  Octoberwebsite exposureused to " +
+	" changesoperatedclickingenteringcommandsinformed numbers  </div>" +
+	"creatingonSubmitmarylandcollegesanalyticlistingscontact.loggedIn" +
+	"advisorysiblingscontent\"s&quot;)s. This packagescheckboxsuggests" +
+	"pregnanttomorrowspacing=icon.pngjapanesecodebasebutton\">gambling" +
+	"such as , while </span> missourisportingtop:1px .</span>tensions" +
+	"width=\"2lazyloadnovemberused in height=\"cript\">\n&nbsp;</<tr><td " +
+	"height:2/productcountry include footer\" &lt;!-- title\"></jquery." +
+	"</form>\n(\u7b80\u4f53)(\u7e41\u9ad4)hrvatskiitalianorom\u00e2n\u0103t\u00fcrk\u00e7e\u0627\u0631\u062f\u0648" +
+	"tambi\u00e9nnoticiasmensajespersonasderechosnacionalserviciocontacto" +
+	"usuariosprogramagobiernoempresasanunciosvalenciacolombiadespu\u00e9s" +
+	"deportesproyectoproductop\u00fabliconosotroshistoriapresentemillones" +
+	"mediantepreguntaanteriorrecursosproblemasantiagonuestrosopini\u00f3n" +
+	"imprimirmientrasam\u00e9ricavendedorsociedadrespectorealizarregistro" +
+	"palabrasinter\u00e9sentoncesespecialmiembrosrealidadc\u00f3rdobazaragoza" +
+	"p\u00e1ginassocialesbloqueargesti\u00f3nalquilersistemascienciascompleto" +
+	"versi\u00f3ncompletaestudiosp\u00fablicaobjetivoalicantebuscadorcantidad" +
+	"entradasaccionesarchivossuperiormayor\u00edaalemaniafunci\u00f3n\u00faltimos" +
+	"haciendoaquellosedici\u00f3nfernandoambientefacebooknuestrasclientes" +
+	"procesosbastantepresentareportarcongresopublicarcomerciocontrato" +
+	"j\u00f3venesdistritot\u00e9cnicaconjuntoenerg\u00edatrabajarasturiasreciente" +
+	"utilizarbolet\u00ednsalvadorcorrectatrabajosprimerosnegocioslibertad" +
+	"detallespantallapr\u00f3ximoalmer\u00edaanimalesqui\u00e9nescoraz\u00f3nsecci\u00f3n" +
+	"buscandoopcionesexteriorconceptotodav\u00edagaler\u00edaescribirmedicina" +
+	"licenciaconsultaaspectoscr\u00edticad\u00f3laresjusticiadeber\u00e1nper\u00edodo" +
+	"necesitamantenerpeque\u00f1orecibidatribunaltenerifecanci\u00f3ncanarias" +
+	"descargadiversosmallorcarequieret\u00e9cnicodeber\u00edaviviendafinanzas" +
+	"adelantefuncionaconsejosdif\u00edcilciudadesantiguasavanzadat\u00e9rmino" +
+	"unidadess\u00e1nchezcampa\u00f1asoftonicrevistascontienesectoresmomentos" +
+	"facultadcr\u00e9ditodiversassupuestofactoressegundospeque\u00f1a\u0433\u043e\u0434\u0430" +
+	"\u0435\u0441\u043b\u0438\u0435\u0441\u0442\u044c\u0431\u044b\u043b\u043e\u0431\u044b\u0442\u044c\u044d\u0442\u043e\u043c\u0415\u0441\u043b\u0438\u0442\u043e\u0433\u043e\u043c\u0435\u043d\u044f" +
+	"\u0432\u0441\u0435\u0445\u044d\u0442\u043e\u0439\u0434\u0430\u0436\u0435\u0431\u044b\u043b\u0438\u0433\u043e\u0434\u0443\u0434\u0435\u043d\u044c\u044d\u0442\u043e\u0442\u0431\u044b\u043b\u0430" +
+	"\u0441\u0435\u0431\u044f\u043e\u0434\u0438\u043d\u0441\u0435\u0431\u0435\u043d\u0430\u0434\u043e\u0441\u0430\u0439\u0442\u0444\u043e\u0442\u043e\u043d\u0435\u0433\u043e\u0441\u0432\u043e\u0438" +
+	"\u0441\u0432\u043e\u0439\u0438\u0433\u0440\u044b\u0442\u043e\u0436\u0435\u0432\u0441\u0435\u043c\u0441\u0432\u043e\u044e\u043b\u0438\u0448\u044c\u044d\u0442\u0438\u0445\u043f\u043e\u043a\u0430" +
+	"\u0434\u043d\u0435\u0439\u0434\u043e\u043c\u0430\u043c\u0438\u0440\u0430\u043b\u0438\u0431\u043e\u0442\u0435\u043c\u0443\u0445\u043e\u0442\u044f\u0434\u0432\u0443\u0445\u0441\u0435\u0442\u0438" +
+	"\u043b\u044e\u0434\u0438\u0434\u0435\u043b\u043e\u043c\u0438\u0440\u0435\u0442\u0435\u0431\u044f\u0441\u0432\u043e\u0435\u0432\u0438\u0434\u0435\u0447\u0435\u0433\u043e\u044d\u0442\u0438\u043c" +
+	"\u0441\u0447\u0435\u0442\u0442\u0435\u043c\u044b\u0446\u0435\u043d\u044b\u0441\u0442\u0430\u043b\u0432\u0435\u0434\u044c\u0442\u0435\u043c\u0435\u0432\u043e\u0434\u044b\u0442\u0435\u0431\u0435" +
+	"\u0432\u044b\u0448\u0435\u043d\u0430\u043c\u0438\u0442\u0438\u043f\u0430\u0442\u043e\u043c\u0443\u043f\u0440\u0430\u0432\u043b\u0438\u0446\u0430\u043e\u0434\u043d\u0430\u0433\u043e\u0434\u044b" +
+	"\u0437\u043d\u0430\u044e\u043c\u043e\u0433\u0443\u0434\u0440\u0443\u0433\u0432\u0441\u0435\u0439\u0438\u0434\u0435\u0442\u043a\u0438\u043d\u043e\u043e\u0434\u043d\u043e\u0434\u0435\u043b\u0430" +
+	"\u0434\u0435\u043b\u0435\u0441\u0440\u043e\u043a\u0438\u044e\u043d\u044f\u0432\u0435\u0441\u044c\u0415\u0441\u0442\u044c\u0440\u0430\u0437\u0430\u043d\u0430\u0448\u0438\u0627\u0644\u0644\u0647" +
+	"\u0627\u0644\u062a\u064a\u062c\u0645\u064a\u0639\u062e\u0627\u0635\u0629\u0627\u0644\u0630\u064a\u0639\u0644\u064a\u0647\u062c\u062f\u064a\u062f\u0627\u0644\u0622\u0646\u0627\u0644\u0631\u062f" +
+	"\u062a\u062d\u0643\u0645\u0635\u0641\u062d\u0629\u0643\u0627\u0646\u062a\u0627\u0644\u0644\u064a\u064a\u0643\u0648\u0646\u0634\u0628\u0643\u0629\u0641\u064a\u0647\u0627\u0628\u0646\u0627\u062a" +
+	"\u062d\u0648\u0627\u0621\u0623\u0643\u062b\u0631\u062e\u0644\u0627\u0644\u0627\u0644\u062d\u0628\u062f\u0644\u064a\u0644\u062f\u0631\u0648\u0633\u0627\u0636\u063a\u0637\u062a\u0643\u0648\u0646" +
+	"\u0647\u0646\u0627\u0643\u0633\u0627\u062d\u0629\u0646\u0627\u062f\u064a\u0627\u0644\u0637\u0628\u0639\u0644\u064a\u0643\u0634\u0643\u0631\u0627\u064a\u0645\u0643\u0646\u0645\u0646\u0647\u0627" +
+	"\u0634\u0631\u0643\u0629\u0631\u0626\u064a\u0633\u0646\u0634\u064a\u0637\u0645\u0627\u0630\u0627\u0627\u0644\u0641\u0646\u0634\u0628\u0627\u0628\u062a\u0639\u0628\u0631\u0631\u062d\u0645\u0629" +
+	"\u0643\u0627\u0641\u0629\u064a\u0642\u0648\u0644\u0645\u0631\u0643\u0632\u0643\u0644\u0645\u0629\u0623\u062d\u0645\u062f\u0642\u0644\u0628\u064a\u064a\u0639\u0646\u064a\u0635\u0648\u0631\u0629" +
+	"\u0637\u0631\u064a\u0642\u0634\u0627\u0631\u0643\u062c\u0648\u0627\u0644\u0623\u062e\u0631\u0649\u0645\u0639\u0646\u0627\u0627\u0628\u062d\u062b\u0639\u0631\u0648\u0636\u0628\u0634\u0643\u0644" +
+	"\u0645\u0633\u062c\u0644\u0628\u0646\u0627\u0646\u062e\u0627\u0644\u062f\u0643\u062a\u0627\u0628\u0643\u0644\u064a\u0629\u0628\u062f\u0648\u0646\u0623\u064a\u0636\u0627\u064a\u0648\u062c\u062f" +
+	"\u0641\u0631\u064a\u0642\u0643\u062a\u0628\u062a\u0623\u0641\u0636\u0644\u0645\u0637\u0628\u062e\u0627\u0643\u062b\u0631\u0628\u0627\u0631\u0643\u0627\u0641\u0636\u0644\u0627\u062d\u0644\u0649" +
+	"\u0646\u0641\u0633\u0647\u0623\u064a\u0627\u0645\u0631\u062f\u0648\u062f\u0623\u0646\u0647\u0627\u062f\u064a\u0646\u0627\u0627\u0644\u0627\u0646\u0645\u0639\u0631\u0636\u062a\u0639\u0644\u0645" +
+	"\u062f\u0627\u062e\u0644\u0645\u0645\u0643\u0646\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00\x01\x00\x01\x00\x01\x00\x02\x00\x02\x00\x02\x00\x02\x00\x04\x00\x04\x00\x04\x00\x04\x00\x00\x01\x02\x03\x04\x05\x06\a\a\x06\x05\x04\x03\x02\x01\x00" +
+	"\b\t\n\v\f\r\x0e\x0f\x0f\x0e\r\f\v\n\t\b\x10\x11\x12\x13\x14\x15\x16\x17\x17\x16\x15\x14\x13\x12\x11\x10\x18\x19\x1a\x1b\x1c\x1d\x1e\x1f\x1f\x1e\x1d\x1c\x1b\x1a\x19\x18\xff\xff\xff\xff\x00\x00\x00\x00\x00\x00\x00\x00\xff\xff\xff\xff" +
+	"\x01\x00\x00\x00\x02\x00\x00\x00\x02\x00\x00\x00\x01\x00\x00\x00\x01\x00\x00\x00\x03\x00\x00\x00\xff\xff\x00\x01\x00\x00\x00\x01\x00\x00\xff\xff\x00\x01\x00\x00\x00\b\x00\b\x00\b\x00\b\x00\x00\x00\x01\x00\x02\x00\x03\x00\x04\x00\x05\x00\x06\x00\a" +
+	"resourcescountriesquestionsequipmentcommunityavailablehighlightD" +
+	"TD/xhtmlmarketingknowledgesomethingcontainerdirectionsubscribead" +
+	"vertisecharacter\" value=\"</select>Australia\" class=\"situationaut" +
+	"horityfollowingprimarilyoperationchallengedevelopedanonymousfunc" +
+	"tion functionscompaniesstructureagreement\" title=\"potentialeduca" +
+	"tionargumentssecondarycopyrightlanguagesexclusivecondition</form" +
+	">\r\nstatementattentionBiography} else {\nsolutionswhen the Analyti" +
+	"cstemplatesdangeroussatellitedocumentspublisherimportantprototyp" +
+	"einfluence&raquo;</effectivegenerallytransformbeautifultransport" +
+	"organizedpublishedprominentuntil thethumbnailNational .focus();o" +
+	"ver the migrationannouncedfooter\">\nexceptionless thanexpensivefo" +
+	"rmationframeworkterritoryndicationcurrentlyclassNamecriticismtra" +
+	"ditionelsewhereAlexanderappointedmaterialsbroadcastmentionedaffi" +
+	"liate</option>treatmentdifferent/default.Presidentonclick=\"biogr" +
+	"aphyotherwisepermanentFran\u00e7aisHollywoodexpansionstandards</styl" +
+	"e>\nreductionDecember preferredCambridgeopponentsBusiness confusi" +
+	"on>\n<title>presentedexplaineddoes not worldwideinterfaceposition" +
+	"snewspaper</table>\nmountainslike the essentialfinancialselection" +
+	"action=\"/abandonedEducationparseInt(stabilityunable to</title>\nr" +
+	"elationsNote thatefficientperformedtwo yearsSince thethereforewr" +
+	"apper\">alternateincreasedBattle ofperceivedtrying tonecessarypor" +
+	"trayedelectionsElizabeth</iframe>discoveryinsurances.length;lege" +
+	"ndaryGeographycandidatecorporatesometimesservices.inherited</str" +
+	"ong>CommunityreligiouslocationsCommitteebuildingsthe worldno lon" +
+	"gerbeginningreferencecannot befrequencytypicallyinto the relativ" +
+	"e;recordingpresidentinitiallytechniquethe otherit can beexistenc" +
+	"eunderlinethis timetelephoneitemscopepracticesadvantage);return " +
+	"For otherprovidingdemocracyboth the extensivesufferingsupportedc" +
+	"omputers functionpracticalsaid thatit may beEnglish</from the sc" +
+	"heduleddownloads</label>\nsuspectedmargin: 0spiritual</head>\n\nmic" +
+	"rosoftgraduallydiscussedhe becameexecutivejquery.jshouseholdconf" +
+	"irmedpurchasedliterallydestroyedup to thevariationremainingit is" +
+	" notcenturiesJapanese among thecompletedalgorithminterestsrebell" +
+	"ionundefinedencourageresizableinvolvingsensitiveuniversalprovisi" +
+	"on(althoughfeaturingconducted), which continued-header\">February" +
+	" numerous overflow:componentfragmentsexcellentcolspan=\"technical" +
+	"near the Advanced source ofexpressedHong Kong Facebookmultiple m" +
+	"echanismelevationoffensive</form>\n\tsponsoreddocument.or &quot;th" +
+	"ere arethose whomovementsprocessesdifficultsubmittedrecommendcon" +
+	"vincedpromoting\" width=\".replace(classicalcoalitionhis firstdeci" +
+	"sionsassistantindicatedevolution-wrapper\"enough toalong thedeliv" +
+	"ered-->\r\n<!--American protectedNovember </style><furnitureIntern" +
+	"et  onblur=\"suspendedrecipientbased on Moreover,abolishedcollect" +
+	"edwere madeemotionalemergencynarrativeadvocatespx;bordercommitte" +
+	"ddir=\"ltr\"employeesresearch. selectedsuccessorcustomersdisplayed" +
+	"SeptemberaddClass(Facebook suggestedand lateroperatingelaborateS" +
+	"ometimesInstitutecertainlyinstalledfollowersJerusalemthey haveco" +
+	"mputinggeneratedprovincesguaranteearbitraryrecognizewanted topx;" +
+	"width:theory ofbehaviourWhile theestimatedbegan to it becamemagn" +
+	"itudemust havemore thanDirectoryextensionsecretarynaturallyoccur" +
+	"ringvariablesgiven theplatform.</label><failed tocompoundskinds " +
+	"of societiesalongside --&gt;\n\nsouthwestthe rightradiationmay hav" +
+	"e unescape(spoken in\" href=\"/programmeonly the come fromdirector" +
+	"yburied ina similarthey were</font></Norwegianspecifiedproducing" +
+	"passenger(new DatetemporaryfictionalAfter theequationsdownload.r" +
+	"egularlydeveloperabove thelinked tophenomenaperiod oftooltip\">su" +
+	"bstanceautomaticaspect ofAmong theconnectedestimatesAir Forcesys" +
+	"tem ofobjectiveimmediatemaking itpaintingsconqueredare stillproc" +
+	"eduregrowth ofheaded byEuropean divisionsmoleculesfranchiseinten" +
+	"tionattractedchildhoodalso useddedicatedsingaporedegree offather" +
+	" ofconflicts</a></p>\ncame fromwere usednote thatreceivingExecuti" +
+	"veeven moreaccess tocommanderPoliticalmusiciansdeliciousprisoner" +
+	"sadvent ofUTF-8\" /><![CDATA[\">ContactSouthern bgcolor=\"series of" +
+	". It was in Europepermittedvalidate.appearingofficialsseriously-" +
+	"languageinitiatedextendinglong-terminflationsuch thatgetCookiema" +
+	"rked by</button>implementbut it isincreasesdown the requiringdep" +
+	"endent-->\n<!-- interviewWith the copies ofconsensuswas builtVene" +
+	"zuela(formerlythe statepersonnelstrategicfavour ofinventionWikip" +
+	"ediacontinentvirtuallywhich wasprincipleComplete identicalshow t" +
+	"hatprimitiveaway frommolecularpreciselydissolvedUnder theversion" +
+	"=\">&nbsp;</It is the This is will haveorganismssome timeFriedric" +
+	"hwas firstthe only fact thatform id=\"precedingTechnicalphysicist" +
+	"occurs innavigatorsection\">span id=\"sought tobelow thesurviving}" +
+	"</style>his deathas in thecaused bypartiallyexisting using thewa" +
+	"s givena list oflevels ofnotion ofOfficial dismissedscientistres" +
+	"emblesduplicateexplosiverecoveredall othergalleries{padding:peop" +
+	"le ofregion ofaddressesassociateimg alt=\"in modernshould bemetho" +
+	"d ofreportingtimestampneeded tothe Greatregardingseemed toviewed" +
+	" asimpact onidea thatthe Worldheight ofexpandingThese arecurrent" +
+	"\">carefullymaintainscharge ofClassicaladdressedpredictedownershi" +
+	"p<div id=\"right\">\r\nresidenceleave thecontent\">are often  })();\r\n" +
+	"probably Professor-button\" respondedsays thathad to beplaced inH" +
+	"ungarianstatus ofserves asUniversalexecutionaggregatefor whichin" +
+	"fectionagreed tohowever, popular\">placed onconstructelectoralsym" +
+	"bol ofincludingreturn toarchitectChristianprevious living ineasi" +
+	"er toprofessor\n&lt;!-- effect ofanalyticswas takenwhere thetook " +
+	"overbelief inAfrikaansas far aspreventedwork witha special<field" +
+	"setChristmasRetrieved\n\nIn the back intonortheastmagazines><stron" +
+	"g>committeegoverninggroups ofstored inestablisha generalits firs" +
+	"ttheir ownpopulatedan objectCaribbeanallow thedistrictswisconsin" +
+	"location.; width: inhabitedSocialistJanuary 1</footer>similarlyc" +
+	"hoice ofthe same specific business The first.length; desire tode" +
+	"al withsince theuserAgentconceivedindex.phpas &quot;engage inrec" +
+	"ently,few yearswere also\n<head>\n<edited byare knowncities inacce" +
+	"sskeycondemnedalso haveservices,family ofSchool ofconvertednatur" +
+	"e of languageministers</object>there is a popularsequencesadvoca" +
+	"tedThey wereany otherlocation=enter themuch morereflectedwas nam" +
+	"edoriginal a typicalwhen theyengineerscould notresidentswednesda" +
+	"ythe third productsJanuary 2what theya certainreactionsprocessor" +
+	"after histhe last contained\"></div>\n</a></td>depend onsearch\">\np" +
+	"ieces ofcompetingReferencetennesseewhich has version=</span> <</" +
+	"header>gives thehistorianvalue=\"\">padding:0view thattogether,the" +
+	" most was foundsubset ofattack onchildren,points ofpersonal posi" +
+	"tion:allegedlyClevelandwas laterand afterare givenwas stillscrol" +
+	"lingdesign ofmakes themuch lessAmericans.\n\nAfter , but theMuseum" +
+	" oflouisiana(from theminnesotaparticlesa processDominicanvolume " +
+	"ofreturningdefensive00px|righmade frommouseover\" style=\"states o" +
+	"f(which iscontinuesFranciscobuilding without awith somewho would" +
+	"a form ofa part ofbefore itknown as  Serviceslocation and oftenm" +
+	"easuringand it ispaperbackvalues of\r\n<title>= window.determineer" +
+	"&quot; played byand early</center>from thisthe threepower andof " +
+	"&quot;innerHTML<a href=\"y:inline;Church ofthe eventvery highoffi" +
+	"cial -height: content=\"/cgi-bin/to createafrikaansesperantofran\xc3" +
+	"\xa7aislatvie\u0161ulietuvi\u0173\u010ce\u0161tina\u010de\u0161tina\u0e44\u0e17\u0e22\u65e5\u672c\u8a9e\u7b80\u4f53" +
+	"\u5b57\u7e41\u9ad4\u5b57\ud55c\uad6d\uc5b4\u4e3a\u4ec0\u4e48\u8ba1\u7b97\u673a\u7b14\u8bb0\u672c\u8a0e\u8ad6\u5340\u670d\u52a1\xe5" +
+	"\x99\xa8\u4e92\u8054\u7f51\u623f\u5730\u4ea7\u4ff1\u4e50\u90e8\u51fa\u7248\u793e\u6392\u884c\u699c\u90e8\u843d\u683c\u8fdb\u4e00\xe6\xad" +
+	"\xa5\u652f\u4ed8\u5b9d\u9a8c\u8bc1\u7801\u59d4\u5458\u4f1a\u6570\u636e\u5e93\u6d88\u8d39\u8005\u529e\u516c\u5ba4\u8ba8\u8bba\u533a" +
+	"\u6df1\u5733\u5e02\u64ad\u653e\u5668\u5317\u4eac\u5e02\u5927\u5b66\u751f\u8d8a\u6765\u8d8a\u7ba1\u7406\u5458\u4fe1\u606f\u7f51s" +
+	"erviciosart\u00edculoargentinabarcelonacualquierpublicadoproductospo" +
+	"l\u00edticarespuestawikipediasiguienteb\u00fasquedacomunidadseguridadpri" +
+	"ncipalpreguntascontenidorespondervenezuelaproblemasdiciembrerela" +
+	"ci\u00f3nnoviembresimilaresproyectosprogramasinstitutoactividadencue" +
+	"ntraeconom\u00edaim\u00e1genescontactardescargarnecesarioatenci\u00f3ntel\u00e9f" +
+	"onocomisi\u00f3ncancionescapacidadencontraran\u00e1lisisfavoritost\u00e9rmin" +
+	"osprovinciaetiquetaselementosfuncionesresultadocar\u00e1cterpropieda" +
+	"dprincipionecesidadmunicipalcreaci\u00f3ndescargaspresenciacomercial" +
+	"opinionesejercicioeditorialsalamancagonz\u00e1lezdocumentopel\u00edcular" +
+	"ecientesgeneralestarragonapr\u00e1cticanovedadespropuestapacientest\xc3" +
+	"\xa9cnicasobjetivoscontactos\u092e\u0947\u0902\u0932\u093f\u090f\u0939\u0948\u0902\u0917\u092f\u093e\u0938" +
+	"\u093e\u0925\u090f\u0935\u0902\u0930\u0939\u0947\u0915\u094b\u0908\u0915\u0941\u091b\u0930\u0939\u093e\u092c\u093e\u0926\u0915\xe0" +
+	"\xa4\xb9\u093e\u0938\u092d\u0940\u0939\u0941\u090f\u0930\u0939\u0940\u092e\u0948\u0902\u0926\u093f\u0928\u092c\u093e\u0924diplo" +
+	"docs\u0938\u092e\u092f\u0930\u0942\u092a\u0928\u093e\u092e\u092a\u0924\u093e\u092b\u093f\u0930\u0914\u0938\u0924\u0924\u0930" +
+	"\u0939\u0932\u094b\u0917\u0939\u0941\u0906\u092c\u093e\u0930\u0926\u0947\u0936\u0939\u0941\u0908\u0916\u0947\u0932\u092f\u0926\xe0" +
+	"\xa4\xbf\u0915\u093e\u092e\u0935\u0947\u092c\u0924\u0940\u0928\u092c\u0940\u091a\u092e\u094c\u0924\u0938\u093e\u0932\u0932\u0947\xe0\xa4" +
+	"\x96\u091c\u0949\u092c\u092e\u0926\u0926\u0924\u0925\u093e\u0928\u0939\u0940\u0936\u0939\u0930\u0905\u0932\u0917\u0915\u092d\u0940" +
+	"\u0928\u0917\u0930\u092a\u093e\u0938\u0930\u093e\u0924\u0915\u093f\u090f\u0909\u0938\u0947\u0917\u092f\u0940\u0939\u0942\u0901\xe0" +
+	"\xa4\x86\u0917\u0947\u091f\u0940\u092e\u0916\u094b\u091c\u0915\u093e\u0930\u0905\u092d\u0940\u0917\u092f\u0947\u0924\u0941\u092e\xe0\xa4" +
+	"\xb5\u094b\u091f\u0926\u0947\u0902\u0905\u0917\u0930\u0910\u0938\u0947\u092e\u0947\u0932\u0932\u0917\u093e\u0939\u093e\u0932\u090a" +
+	"\u092a\u0930\u091a\u093e\u0930\u0910\u0938\u093e\u0926\u0947\u0930\u091c\u093f\u0938\u0926\u093f\u0932\u092c\u0902\u0926\u092c\xe0" +
+	"\xa4\xa8\u093e\u0939\u0942\u0902\u0932\u093e\u0916\u091c\u0940\u0924\u092c\u091f\u0928\u092e\u093f\u0932\u0907\u0938\u0947\u0906\xe0\xa4" +
+	"\xa8\u0947\u0928\u092f\u093e\u0915\u0941\u0932\u0932\u0949\u0917\u092d\u093e\u0917\u0930\u0947\u0932\u091c\u0917\u0939\u0930\u093e" +
+	"\u092e\u0932\u0917\u0947\u092a\u0947\u091c\u0939\u093e\u0925\u0907\u0938\u0940\u0938\u0939\u0940\u0915\u0932\u093e\u0920\u0940\xe0" +
+	"\xa4\x95\u0939\u093e\u0901\u0926\u0942\u0930\u0924\u0939\u0924\u0938\u093e\u0924\u092f\u093e\u0926\u0906\u092f\u093e\u092a\u093e\xe0\xa4" +
+	"\x95\u0915\u094c\u0928\u0936\u093e\u092e\u0926\u0947\u0916\u092f\u0939\u0940\u0930\u093e\u092f\u0916\u0941\u0926\u0932\u0917\u0940" +
+	"categoriesexperience</title>\r\nCopyright javascriptconditionsever" +
+	"ything<p class=\"technologybackground<a class=\"management&copy; 2" +
+	"01javaScriptcharactersbreadcrumbthemselveshorizontalgovernmentCa" +
+	"liforniaactivitiesdiscoveredNavigationtransitionconnectionnaviga" +
+	"tionappearance</title><mcheckbox\" techniquesprotectionapparently" +
+	"as well asunt', 'UA-resolutionoperationstelevisiontranslatedWash" +
+	"ingtonnavigator. = window.impression&lt;br&gt;literaturepopulati" +
+	"onbgcolor=\"#especially content=\"productionnewsletterpropertiesde" +
+	"finitionleadershipTechnologyParliamentcomparisonul class=\".index" +
+	"Of(\"conclusiondiscussioncomponentsbiologicalRevolution_container" +
+	"understoodnoscript><permissioneach otheratmosphere onfocus=\"<for" +
+	"m id=\"processingthis.valuegenerationConferencesubsequentwell-kno" +
+	"wnvariationsreputationphenomenondisciplinelogo.png\" (document,bo" +
+	"undariesexpressionsettlementBackgroundout of theenterprise(\"http" +
+	"s:\" unescape(\"password\" democratic<a href=\"/wrapper\">\nmembership" +
+	"linguisticpx;paddingphilosophyassistanceuniversityfacilitiesreco" +
+	"gnizedpreferenceif (typeofmaintainedvocabularyhypothesis.submit(" +
+	");&amp;nbsp;annotationbehind theFoundationpublisher\"assumptionin" +
+	"troducedcorruptionscientistsexplicitlyinstead ofdimensions onCli" +
+	"ck=\"considereddepartmentoccupationsoon afterinvestmentpronounced" +
+	"identifiedexperimentManagementgeographic\" height=\"link rel=\".rep" +
+	"lace(/depressionconferencepunishmenteliminatedresistanceadaptati" +
+	"onoppositionwell knownsupplementdeterminedh1 class=\"0px;marginme" +
+	"chanicalstatisticscelebratedGovernment\n\nDuring tdevelopersartifi" +
+	"cialequivalentoriginatedCommissionattachment<span id=\"there were" +
+	"Nederlandsbeyond theregisteredjournalistfrequentlyall of thelang" +
+	"=\"en\" </style>\r\nabsolute; supportingextremely mainstream</strong" +
+	"> popularityemployment</table>\r\n colspan=\"</form>\n  conversionab" +
+	"out the </p></div>integrated\" lang=\"enPortuguesesubstituteindivi" +
+	"dualimpossiblemultimediaalmost allpx solid #apart fromsubject to" +
+	"in Englishcriticizedexcept forguidelinesoriginallyremarkablethe " +
+	"secondh2 class=\"<a title=\"(includingparametersprohibited= \"http:" +
+	"//dictionaryperceptionrevolutionfoundationpx;height:successfulsu" +
+	"pportersmillenniumhis fatherthe &quot;no-repeat;commercialindust" +
+	"rialencouragedamount of unofficialefficiencyReferencescoordinate" +
+	"disclaimerexpeditiondevelopingcalculatedsimplifiedlegitimatesubs" +
+	"tring(0\" class=\"completelyillustratefive yearsinstrumentPublishi" +
+	"ng1\" class=\"psychologyconfidencenumber of absence offocused onjo" +
+	"ined thestructurespreviously></iframe>once againbut ratherimmigr" +
+	"antsof course,a group ofLiteratureUnlike the</a>&nbsp;\nfunction " +
+	"it was theConventionautomobileProtestantaggressiveafter the Simi" +
+	"larly,\" /></div>collection\r\nfunctionvisibilitythe use ofvoluntee" +
+	"rsattractionunder the threatened*<![CDATA[importancein generalth" +
+	"e latter</form>\n</.indexOf('i = 0; i <differencedevoted totradit" +
+	"ionssearch forultimatelytournamentattributesso-called }\n</style>" +
+	"evaluationemphasizedaccessible</section>successionalong withMean" +
+	"while,industries</a><br />has becomeaspects ofTelevisionsufficie" +
+	"ntbasketballboth sidescontinuingan article<img alt=\"adventureshi" +
+	"s mothermanchesterprinciplesparticularcommentaryeffects ofdecide" +
+	"d to\"><strong>publishersJournal ofdifficultyfacilitateacceptable" +
+	"style.css\"\tfunction innovation>Copyrightsituationswould havebusi" +
+	"nessesDictionarystatementsoften usedpersistentin Januarycomprisi" +
+	"ng</title>\n\tdiplomaticcontainingperformingextensionsmay not beco" +
+	"ncept of onclick=\"It is alsofinancial making theLuxembourgadditi" +
+	"onalare calledengaged in\"script\");but it waselectroniconsubmit=\"" +
+	"\n<!-- End electricalofficiallysuggestiontop of theunlike theAust" +
+	"ralianOriginallyreferences\n</head>\r\nrecognisedinitializelimited " +
+	"toAlexandriaretirementAdventuresfour years\n\n&lt;!-- increasingde" +
+	"corationh3 class=\"origins ofobligationregulationclassified(funct" +
+	"ion(advantagesbeing the historians<base hrefrepeatedlywilling to" +
+	"comparabledesignatednominationfunctionalinside therevelationend " +
+	"of thes for the authorizedrefused totake placeautonomouscompromi" +
+	"sepolitical restauranttwo of theFebruary 2quality ofswfobject.un" +
+	"derstandnearly allwritten byinterviews\" width=\"1withdrawalfloat:" +
+	"leftis usuallycandidatesnewspapersmysteriousDepartmentbest known" +
+	"parliamentsuppressedconvenientremembereddifferent systematichas " +
+	"led topropagandacontrolledinfluencesceremonialproclaimedProtecti" +
+	"onli class=\"Scientificclass=\"no-trademarksmore than widespreadLi" +
+	"berationtook placeday of theas long asimprisonedAdditional\n<head" +
+	">\n<mLaboratoryNovember 2exceptionsIndustrialvariety offloat: lef" +
+	"During theassessmenthave been deals withStatisticsoccurrence/ul>" +
+	"</div>clearfix\">the publicmany yearswhich wereover time,synonymo" +
+	"uscontent\">\npresumablyhis familyuserAgent.unexpectedincluding ch" +
+	"allengeda minorityundefined\"belongs totaken fromin Octoberpositi" +
+	"on: said to bereligious Federation rowspan=\"only a fewmeant that" +
+	"led to the-->\r\n<div <fieldset>Archbishop class=\"nobeing usedappr" +
+	"oachesprivilegesnoscript>\nresults inmay be theEaster eggmechanis" +
+	"msreasonablePopulationCollectionselected\">noscript>\r/index.phpar" +
+	"rival of-jssdk'));managed toincompletecasualtiescompletionChrist" +
+	"iansSeptember arithmeticproceduresmight haveProductionit appears" +
+	"Philosophyfriendshipleading togiving thetoward theguaranteeddocu" +
+	"mentedcolor:#000video gamecommissionreflectingchange theassociat" +
+	"edsans-serifonkeypress; padding:He was theunderlyingtypically , " +
+	"and the srcElementsuccessivesince the should be networkingaccoun" +
+	"tinguse of thelower thanshows that</span>\n\t\tcomplaintscontinuous" +
+	"quantitiesastronomerhe did notdue to itsapplied toan averageeffo" +
+	"rts tothe futureattempt toTherefore,capabilityRepublicanwas form" +
+	"edElectronickilometerschallengespublishingthe formerindigenousdi" +
+	"rectionssubsidiaryconspiracydetails ofand in theaffordablesubsta" +
+	"ncesreason forconventionitemtype=\"absolutelysupposedlyremained a" +
+	"attractivetravellingseparatelyfocuses onelementaryapplicablefoun" +
+	"d thatstylesheetmanuscriptstands for no-repeat(sometimesCommerci" +
+	"alin Americaundertakenquarter ofan examplepersonallyindex.php?</" +
+	"button>\npercentagebest-knowncreating a\" dir=\"ltrLieutenant\n<div " +
+	"id=\"they wouldability ofmade up ofnoted thatclear thatargue that" +
+	"to anotherchildren'spurpose offormulatedbased uponthe regionsubj" +
+	"ect ofpassengerspossession.\n\nIn the Before theafterwardscurrentl" +
+	"y across thescientificcommunity.capitalismin Germanyright-wingth" +
+	"e systemSociety ofpoliticiandirection:went on toremoval of New Y" +
+	"ork apartmentsindicationduring theunless thehistoricalhad been a" +
+	"definitiveingredientattendanceCenter forprominencereadyStatestra" +
+	"tegiesbut in theas part ofconstituteclaim thatlaboratorycompatib" +
+	"lefailure of, such as began withusing the to providefeature offr" +
+	"om which/\" class=\"geologicalseveral ofdeliberateimportant holds " +
+	"thating&quot; valign=topthe Germanoutside ofnegotiatedhis career" +
+	"separationid=\"searchwas calledthe fourthrecreationother thanprev" +
+	"entionwhile the education,connectingaccuratelywere builtwas kill" +
+	"edagreementsmuch more Due to thewidth: 100some otherKingdom ofth" +
+	"e entirefamous forto connectobjectivesthe Frenchpeople andfeatur" +
+	"ed\">is said tostructuralreferendummost oftena separate->\n<div id" +
+	" Official worldwide.aria-labelthe planetand it wasd\" value=\"look" +
+	"ing atbeneficialare in themonitoringreportedlythe modernworking " +
+	"onallowed towhere the innovative</a></div>soundtracksearchFormte" +
+	"nd to beinput id=\"opening ofrestrictedadopted byaddressingtheolo" +
+	"gianmethods ofvariant ofChristian very largeautomotiveby far the" +
+	"range frompursuit offollow thebrought toin Englandagree thataccu" +
+	"sed ofcomes frompreventingdiv style=his or hertremendousfreedom " +
+	"ofconcerning0 1em 1em;Basketball/style.cssan earliereven after/\"" +
+	" title=\".com/indextaking thepittsburghcontent\">\r<script>(fturned" +
+	" outhaving the</span>\r\n occasionalbecause itstarted tophysically" +
+	"></div>\n  created byCurrently, bgcolor=\"tabindex=\"disastrousAnal" +
+	"ytics also has a><div id=\"</style>\n<called forsinger and.src = \"" +
+	"//violationsthis pointconstantlyis locatedrecordingsd from thene" +
+	"derlandsportugu\u00eas\u05e2\u05d1\u05e8\u05d9\u05ea\u0641\u0627\u0631\u0633\u06ccdesarrollocomentarioeducac" +
+	"i\u00f3nseptiembreregistradodirecci\u00f3nubicaci\u00f3npublicidadrespuestas" +
+	"resultadosimportantereservadosart\u00edculosdiferentessiguientesrep\xc3" +
+	"\xbablicasituaci\u00f3nministerioprivacidaddirectorioformaci\u00f3npoblaci\xc3" +
+	"\xb3npresidentecontenidosaccesoriostechnoratipersonalescategor\u00edaes" +
+	"pecialesdisponibleactualidadreferenciavalladolidbibliotecarelaci" +
+	"onescalendariopol\u00edticasanterioresdocumentosnaturalezamateriales" +
+	"diferenciaecon\u00f3micatransporterodr\u00edguezparticiparencuentrandisc" +
+	"usi\u00f3nestructurafundaci\u00f3nfrecuentespermanentetotalmente\u043c\u043e\u0436\u043d" +
+	"\u043e\u0431\u0443\u0434\u0435\u0442\u043c\u043e\u0436\u0435\u0442\u0432\u0440\u0435\u043c\u044f\u0442\u0430\u043a\u0436\u0435\u0447\u0442\u043e\u0431\u044b\u0431\u043e\u043b\u0435\u0435\u043e" +
+	"\u0447\u0435\u043d\u044c\u044d\u0442\u043e\u0433\u043e\u043a\u043e\u0433\u0434\u0430\u043f\u043e\u0441\u043b\u0435\u0432\u0441\u0435\u0433\u043e\u0441\u0430\u0439\u0442\u0435\u0447\u0435\u0440" +
+	"\u0435\u0437\u043c\u043e\u0433\u0443\u0442\u0441\u0430\u0439\u0442\u0430\u0436\u0438\u0437\u043d\u0438\u043c\u0435\u0436\u0434\u0443\u0431\u0443\u0434\u0443\u0442\u041f\u043e\u0438\u0441\u043a" +
+	"\u0437\u0434\u0435\u0441\u044c\u0432\u0438\u0434\u0435\u043e\u0441\u0432\u044f\u0437\u0438\u043d\u0443\u0436\u043d\u043e\u0441\u0432\u043e\u0435\u0439\u043b\u044e\u0434\u0435\u0439\u043f\u043e" +
+	"\u0440\u043d\u043e\u043c\u043d\u043e\u0433\u043e\u0434\u0435\u0442\u0435\u0439\u0441\u0432\u043e\u0438\u0445\u043f\u0440\u0430\u0432\u0430\u0442\u0430\u043a\u043e\u0439\u043c\u0435\u0441\u0442" +
+	"\u043e\u0438\u043c\u0435\u0435\u0442\u0436\u0438\u0437\u043d\u044c\u043e\u0434\u043d\u043e\u0439\u043b\u0443\u0447\u0448\u0435\u043f\u0435\u0440\u0435\u0434\u0447\u0430\u0441\u0442\u0438\u0447" +
+	"\u0430\u0441\u0442\u044c\u0440\u0430\u0431\u043e\u0442\u043d\u043e\u0432\u044b\u0445\u043f\u0440\u0430\u0432\u043e\u0441\u043e\u0431\u043e\u0439\u043f\u043e\u0442\u043e\u043c\u043c\u0435\u043d" +
+	"\u0435\u0435\u0447\u0438\u0441\u043b\u0435\u043d\u043e\u0432\u044b\u0435\u0443\u0441\u043b\u0443\u0433\u043e\u043a\u043e\u043b\u043e\u043d\u0430\u0437\u0430\u0434\u0442\u0430\u043a\u043e\u0435" +
+	"\u0442\u043e\u0433\u0434\u0430\u043f\u043e\u0447\u0442\u0438\u041f\u043e\u0441\u043b\u0435\u0442\u0430\u043a\u0438\u0435\u043d\u043e\u0432\u044b\u0439\u0441\u0442\u043e\u0438\u0442\u0442\u0430" +
+	"\u043a\u0438\u0445\u0441\u0440\u0430\u0437\u0443\u0421\u0430\u043d\u043a\u0442\u0444\u043e\u0440\u0443\u043c\u041a\u043e\u0433\u0434\u0430\u043a\u043d\u0438\u0433\u0438\u0441\u043b\u043e\u0432" +
+	"\u0430\u043d\u0430\u0448\u0435\u0439\u043d\u0430\u0439\u0442\u0438\u0441\u0432\u043e\u0438\u043c\u0441\u0432\u044f\u0437\u044c\u043b\u044e\u0431\u043e\u0439\u0447\u0430\u0441\u0442\u043e\u0441" +
+	"\u0440\u0435\u0434\u0438\u041a\u0440\u043e\u043c\u0435\u0424\u043e\u0440\u0443\u043c\u0440\u044b\u043d\u043a\u0435\u0441\u0442\u0430\u043b\u0438\u043f\u043e\u0438\u0441\u043a\u0442\u044b\u0441" +
+	"\u044f\u0447\u043c\u0435\u0441\u044f\u0446\u0446\u0435\u043d\u0442\u0440\u0442\u0440\u0443\u0434\u0430\u0441\u0430\u043c\u044b\u0445\u0440\u044b\u043d\u043a\u0430\u041d\u043e\u0432\u044b\u0439" +
+	"\u0447\u0430\u0441\u043e\u0432\u043c\u0435\u0441\u0442\u0430\u0444\u0438\u043b\u044c\u043c\u043c\u0430\u0440\u0442\u0430\u0441\u0442\u0440\u0430\u043d\u043c\u0435\u0441\u0442\u0435\u0442\u0435" +
+	"\u043a\u0441\u0442\u043d\u0430\u0448\u0438\u0445\u043c\u0438\u043d\u0443\u0442\u0438\u043c\u0435\u043d\u0438\u0438\u043c\u0435\u044e\u0442\u043d\u043e\u043c\u0435\u0440\u0433\u043e\u0440\u043e" +
+	"\u0434\u0441\u0430\u043c\u043e\u043c\u044d\u0442\u043e\u043c\u0443\u043a\u043e\u043d\u0446\u0435\u0441\u0432\u043e\u0435\u043c\u043a\u0430\u043a\u043e\u0439\u0410\u0440\u0445\u0438\u0432\u0645" +
+	"\u0646\u062a\u062f\u0649\u0625\u0631\u0633\u0627\u0644\u0631\u0633\u0627\u0644\u0629\u0627\u0644\u0639\u0627\u0645\u0643\u062a\u0628\u0647\u0627\u0628\u0631\u0627\u0645\u062c\u0627\u0644\u064a" +
+	"\u0648\u0645\u0627\u0644\u0635\u0648\u0631\u062c\u062f\u064a\u062f\u0629\u0627\u0644\u0639\u0636\u0648\u0625\u0636\u0627\u0641\u0629\u0627\u0644\u0642\u0633\u0645\u0627\u0644\u0639\u0627\u0628" +
+	"\u062a\u062d\u0645\u064a\u0644\u0645\u0644\u0641\u0627\u062a\u0645\u0644\u062a\u0642\u0649\u062a\u0639\u062f\u064a\u0644\u0627\u0644\u0634\u0639\u0631\u0623\u062e\u0628\u0627\u0631\u062a\u0637" +
+	"\u0648\u064a\u0631\u0639\u0644\u064a\u0643\u0645\u0625\u0631\u0641\u0627\u0642\u0637\u0644\u0628\u0627\u062a\u0627\u0644\u0644\u063a\u0629\u062a\u0631\u062a\u064a\u0628\u0627\u0644\u0646\u0627" +
+	"\u0633\u0627\u0644\u0634\u064a\u062e\u0645\u0646\u062a\u062f\u064a\u0627\u0644\u0639\u0631\u0628\u0627\u0644\u0642\u0635\u0635\u0627\u0641\u0644\u0627\u0645\u0639\u0644\u064a\u0647\u0627\u062a" +
+	"\u062d\u062f\u064a\u062b\u0627\u0644\u0644\u0647\u0645\u0627\u0644\u0639\u0645\u0644\u0645\u0643\u062a\u0628\u0629\u064a\u0645\u0643\u0646\u0643\u0627\u0644\u0637\u0641\u0644\u0641\u064a\u062f" +
+	"\u064a\u0648\u0625\u062f\u0627\u0631\u0629\u062a\u0627\u0631\u064a\u062e\u0627\u0644\u0635\u062d\u0629\u062a\u0633\u062c\u064a\u0644\u0627\u0644\u0648\u0642\u062a\u0639\u0646\u062f\u0645\u0627" +
+	"\u0645\u062f\u064a\u0646\u0629\u062a\u0635\u0645\u064a\u0645\u0623\u0631\u0634\u064a\u0641\u0627\u0644\u0630\u064a\u0646\u0639\u0631\u0628\u064a\u0629\u0628\u0648\u0627\u0628\u0629\u0623\u0644" +
+	"\u0639\u0627\u0628\u0627\u0644\u0633\u0641\u0631\u0645\u0634\u0627\u0643\u0644\u062a\u0639\u0627\u0644\u0649\u0627\u0644\u0623\u0648\u0644\u0627\u0644\u0633\u0646\u0629\u062c\u0627\u0645\u0639" +
+	"\u0629\u0627\u0644\u0635\u062d\u0641\u0627\u0644\u062f\u064a\u0646\u0643\u0644\u0645\u0627\u062a\u0627\u0644\u062e\u0627\u0635\u0627\u0644\u0645\u0644\u0641\u0623\u0639\u0636\u0627\u0621\u0643" +
+	"\u062a\u0627\u0628\u0629\u0627\u0644\u062e\u064a\u0631\u0631\u0633\u0627\u0626\u0644\u0627\u0644\u0642\u0644\u0628\u0627\u0644\u0623\u062f\u0628\u0645\u0642\u0627\u0637\u0639\u0645\u0631\u0627" +
+	"\u0633\u0644\u0645\u0646\u0637\u0642\u0629\u0627\u0644\u0643\u062a\u0628\u0627\u0644\u0631\u062c\u0644\u0627\u0634\u062a\u0631\u0643\u0627\u0644\u0642\u062f\u0645\u064a\u0639\u0637\u064a\u0643" +
+	"sByTagName(.jpg\" alt=\"1px solid #.gif\" alt=\"transparentinformati" +
+	"onapplication\" onclick=\"establishedadvertising.png\" alt=\"environ" +
+	"mentperformanceappropriate&amp;mdash;immediately</strong></rathe" +
+	"r thantemperaturedevelopmentcompetitionplaceholdervisibility:cop" +
+	"yright\">0\" height=\"even thoughreplacementdestinationCorporation<" +
+	"ul class=\"AssociationindividualsperspectivesetTimeout(url(http:/" +
+	"/mathematicsmargin-top:eventually description) no-repeatcollecti" +
+	"ons.JPG|thumb|participate/head><bodyfloat:left;<li class=\"hundre" +
+	"ds of\n\nHowever, compositionclear:both;cooperationwithin the labe" +
+	"l for=\"border-top:New Zealandrecommendedphotographyinteresting&l" +
+	"t;sup&gt;controversyNetherlandsalternativemaxlength=\"switzerland" +
+	"Developmentessentially\n\nAlthough </textarea>thunderbirdrepresent" +
+	"ed&amp;ndash;speculationcommunitieslegislationelectronics\n\t<div " +
+	"id=\"illustratedengineeringterritoriesauthoritiesdistributed6\" he" +
+	"ight=\"sans-serif;capable of disappearedinteractivelooking forit " +
+	"would beAfghanistanwas createdMath.floor(surroundingcan also beo" +
+	"bservationmaintenanceencountered<h2 class=\"more recentit has bee" +
+	"ninvasion of).getTime()fundamentalDespite the\"><div id=\"inspirat" +
+	"ionexaminationpreparationexplanation<input id=\"</a></span>versio" +
+	"ns ofinstrumentsbefore the  = 'http://Descriptionrelatively .sub" +
+	"string(each of theexperimentsinfluentialintegrationmany peopledu" +
+	"e to the combinationdo not haveMiddle East<noscript><copyright\" " +
+	"perhaps theinstitutionin Decemberarrangementmost famouspersonali" +
+	"tycreation oflimitationsexclusivelysovereignty-content\">\n<td cla" +
+	"ss=\"undergroundparallel todoctrine ofoccupied byterminologyRenai" +
+	"ssancea number ofsupport forexplorationrecognitionpredecessor<im" +
+	"g src=\"/<h1 class=\"publicationmay also bespecialized</fieldset>p" +
+	"rogressivemillions ofstates thatenforcementaround the one anothe" +
+	"r.parentNodeagricultureAlternativeresearcherstowards theMost of " +
+	"themany other (especially<td width=\";width:100%independent<h3 cl" +
+	"ass=\" onchange=\").addClass(interactionOne of the daughter ofacce" +
+	"ssoriesbranches of\r\n<div id=\"the largestdeclarationregulationsIn" +
+	"formationtranslationdocumentaryin order to\">\n<head>\n<\" height=\"1" +
+	"across the orientation);</script>implementedcan be seenthere was" +
+	" ademonstratecontainer\">connectionsthe Britishwas written!import" +
+	"ant;px; margin-followed byability to complicatedduring the immig" +
+	"rationalso called<h4 class=\"distinctionreplaced bygovernmentsloc" +
+	"ation ofin Novemberwhether the</p>\n</div>acquisitioncalled the p" +
+	"ersecutiondesignation{font-size:appeared ininvestigateexperience" +
+	"dmost likelywidely useddiscussionspresence of (document.extensiv" +
+	"elyIt has beenit does notcontrary toinhabitantsimprovementschola" +
+	"rshipconsumptioninstructionfor exampleone or morepx; paddingthe " +
+	"currenta series ofare usuallyrole in thepreviously derivativesev" +
+	"idence ofexperiencescolorschemestated thatcertificate</a></div>\n" +
+	" selected=\"high schoolresponse tocomfortableadoption ofthree yea" +
+	"rsthe countryin Februaryso that thepeople who provided by<param " +
+	"nameaffected byin terms ofappointmentISO-8859-1\"was born inhisto" +
+	"rical regarded asmeasurementis based on and other : function(sig" +
+	"nificantcelebrationtransmitted/js/jquery.is known astheoretical " +
+	"tabindex=\"it could be<noscript>\nhaving been\r\n<head>\r\n< &quot;The" +
+	" compilationhe had beenproduced byphilosopherconstructedintended" +
+	" toamong othercompared toto say thatEngineeringa differentreferr" +
+	"ed todifferencesbelief thatphotographsidentifyingHistory of Repu" +
+	"blic ofnecessarilyprobabilitytechnicallyleaving thespectacularfr" +
+	"action ofelectricityhead of therestaurantspartnershipemphasis on" +
+	"most recentshare with saying thatfilled withdesigned toit is oft" +
+	"en\"></iframe>as follows:merged withthrough thecommercial pointed" +
+	" outopportunityview of therequirementdivision ofprogramminghe re" +
+	"ceivedsetInterval\"></span></in New Yorkadditional compression\n\n<" +
+	"div id=\"incorporate;</script><attachEventbecame the \" target=\"_c" +
+	"arried outSome of thescience andthe time ofContainer\">maintainin" +
+	"gChristopherMuch of thewritings of\" height=\"2size of theversion " +
+	"of mixture of between theExamples ofeducationalcompetitive onsub" +
+	"mit=\"director ofdistinctive/DTD XHTML relating totendency toprov" +
+	"ince ofwhich woulddespite thescientific legislature.innerHTML al" +
+	"legationsAgriculturewas used inapproach tointelligentyears later" +
+	",sans-serifdeterminingPerformanceappearances, which is foundatio" +
+	"nsabbreviatedhigher thans from the individual composed ofsuppose" +
+	"d toclaims thatattributionfont-size:1elements ofHistorical his b" +
+	"rotherat the timeanniversarygoverned byrelated to ultimately inn" +
+	"ovationsit is stillcan only bedefinitionstoGMTStringA number ofi" +
+	"mg class=\"Eventually,was changedoccurred inneighboringdistinguis" +
+	"hwhen he wasintroducingterrestrialMany of theargues thatan Ameri" +
+	"canconquest ofwidespread were killedscreen and In order toexpect" +
+	"ed todescendantsare locatedlegislativegenerations backgroundmost" +
+	" peopleyears afterthere is nothe highestfrequently they do notar" +
+	"gued thatshowed thatpredominanttheologicalby the timeconsidering" +
+	"short-lived</span></a>can be usedvery littleone of the had alrea" +
+	"dyinterpretedcommunicatefeatures ofgovernment,</noscript>entered" +
+	" the\" height=\"3Independentpopulationslarge-scale. Although used " +
+	"in thedestructionpossibilitystarting intwo or moreexpressionssub" +
+	"ordinatelarger thanhistory and</option>\r\nContinentaleliminatingw" +
+	"ill not bepractice ofin front ofsite of theensure thatto create " +
+	"amississippipotentiallyoutstandingbetter thanwhat is nowsituated" +
+	" inmeta name=\"TraditionalsuggestionsTranslationthe form ofatmosp" +
+	"hericideologicalenterprisescalculatingeast of theremnants ofplug" +
+	"inspage/index.php?remained intransformedHe was alsowas alreadyst" +
+	"atisticalin favor ofMinistry ofmovement offormulationis required" +
+	"<link rel=\"This is the <a href=\"/popularizedinvolved inare used " +
+	"toand severalmade by theseems to belikely thatPalestiniannamed a" +
+	"fterit had beenmost commonto refer tobut this isconsecutivetempo" +
+	"rarilyIn general,conventionstakes placesubdivisionterritorialope" +
+	"rationalpermanentlywas largelyoutbreak ofin the pastfollowing a " +
+	"xmlns:og=\"><a class=\"class=\"textConversion may be usedmanufactur" +
+	"eafter beingclearfix\">\nquestion ofwas electedto become abecause " +
+	"of some peopleinspired bysuccessful a time whenmore commonamongs" +
+	"t thean officialwidth:100%;technology,was adoptedto keep thesett" +
+	"lementslive birthsindex.html\"Connecticutassigned to&amp;times;ac" +
+	"count foralign=rightthe companyalways beenreturned toinvolvement" +
+	"Because thethis period\" name=\"q\" confined toa result ofvalue=\"\" " +
+	"/>is actuallyEnvironment\r\n</head>\r\nConversely,>\n<div id=\"0\" widt" +
+	"h=\"1is probablyhave becomecontrollingthe problemcitizens ofpolit" +
+	"iciansreached theas early as:none; over<table cellvalidity ofdir" +
+	"ectly toonmousedownwhere it iswhen it wasmembers of relation toa" +
+	"ccommodatealong with In the latethe Englishdelicious\">this is no" +
+	"tthe presentif they areand finallya matter of\r\n\t</div>\r\n\r\n</scri" +
+	"pt>faster thanmajority ofafter whichcomparativeto maintainimprov" +
+	"e theawarded theer\" class=\"frameborderrestorationin the sameanal" +
+	"ysis oftheir firstDuring the continentalsequence offunction(){fo" +
+	"nt-size: work on the</script>\n<begins withjavascript:constituent" +
+	"was foundedequilibriumassume thatis given byneeds to becoordinat" +
+	"esthe variousare part ofonly in thesections ofis a commontheorie" +
+	"s ofdiscoveriesassociationedge of thestrength ofposition inprese" +
+	"nt-dayuniversallyto form thebut insteadcorporationattached tois " +
+	"commonlyreasons for &quot;the can be madewas able towhich meansb" +
+	"ut did notonMouseOveras possibleoperated bycoming fromthe primar" +
+	"yaddition offor severaltransferreda period ofare able tohowever," +
+	" itshould havemuch larger\n\t</script>adopted theproperty ofdirect" +
+	"ed byeffectivelywas broughtchildren ofProgramminglonger thanmanu" +
+	"scriptswar againstby means ofand most ofsimilar to proprietaryor" +
+	"iginatingprestigiousgrammaticalexperience.to make theIt was also" +
+	"is found incompetitorsin the U.S.replace thebrought thecalculati" +
+	"onfall of thethe generalpracticallyin honor ofreleased inresiden" +
+	"tialand some ofking of thereaction to1st Earl ofculture andprinc" +
+	"ipally</title>\n  they can beback to thesome of hisexposure toare" +
+	" similarform of theaddFavoritecitizenshippart in thepeople withi" +
+	"n practiceto continue&amp;minus;approved by the first allowed th" +
+	"eand for thefunctioningplaying thesolution toheight=\"0\" in his b" +
+	"ookmore than afollows thecreated thepresence in&nbsp;</td>nation" +
+	"alistthe idea ofa characterwere forced class=\"btndays of thefeat" +
+	"ured inshowing theinterest inin place ofturn of thethe head ofLo" +
+	"rd of thepoliticallyhas its ownEducationalapproval ofsome of the" +
+	"each other,behavior ofand becauseand anotherappeared onrecorded " +
+	"inblack&quot;may includethe world'scan lead torefers to aborder=" +
+	"\"0\" government winning theresulted in while the Washington,the s" +
+	"ubjectcity in the></div>\r\n\t\treflect theto completebecame morerad" +
+	"ioactiverejected bywithout anyhis father,which couldcopy of thet" +
+	"o indicatea politicalaccounts ofconstitutesworked wither</a></li" +
+	">of his lifeaccompaniedclientWidthprevent theLegislativedifferen" +
+	"tlytogether inhas severalfor anothertext of thefounded thee with" +
+	" the is used forchanged theusually theplace wherewhereas the> <a" +
+	" href=\"\"><a href=\"themselves,although hethat can betraditionalro" +
+	"le of theas a resultremoveChilddesigned bywest of theSome people" +
+	"production,side of thenewslettersused by thedown to theaccepted " +
+	"bylive in theattempts tooutside thefrequenciesHowever, inprogram" +
+	"mersat least inapproximatealthough itwas part ofand variousGover" +
+	"nor ofthe articleturned into><a href=\"/the economyis the mostmos" +
+	"t widelywould laterand perhapsrise to theoccurs whenunder whichc" +
+	"onditions.the westerntheory thatis producedthe city ofin which h" +
+	"eseen in thethe centralbuilding ofmany of hisarea of theis the o" +
+	"nlymost of themany of thethe WesternThere is noextended toStatis" +
+	"ticalcolspan=2 |short storypossible totopologicalcritical ofrepo" +
+	"rted toa Christiandecision tois equal toproblems ofThis can beme" +
+	"rchandisefor most ofno evidenceeditions ofelements in&quot;. The" +
+	"com/images/which makesthe processremains theliterature,is a memb" +
+	"erthe popularthe ancientproblems intime of thedefeated bybody of" +
+	" thea few yearsmuch of thethe work ofCalifornia,served as agover" +
+	"nment.concepts ofmovement in\t\t<div id=\"it\" value=\"language ofas " +
+	"they areproduced inis that theexplain thediv></div>\nHowever thel" +
+	"ead to the\t<a href=\"/was grantedpeople havecontinuallywas seen a" +
+	"sand relatedthe role ofproposed byof the besteach other.Constant" +
+	"inepeople fromdialects ofto revisionwas renameda source ofthe in" +
+	"itiallaunched inprovide theto the westwhere thereand similarbetw" +
+	"een twois also theEnglish andconditions,that it wasentitled toth" +
+	"emselves.quantity ofransparencythe same asto join thecountry and" +
+	"this is theThis led toa statementcontrast tolastIndexOfthrough h" +
+	"isis designedthe term isis providedprotect theng</a></li>The cur" +
+	"rentthe site ofsubstantialexperience,in the Westthey shouldslove" +
+	"n\u010dinacomentariosuniversidadcondicionesactividadesexperienciatec" +
+	"nolog\u00edaproducci\u00f3npuntuaci\u00f3naplicaci\u00f3ncontrase\u00f1acategor\u00edasr" +
+	"egistrarseprofesionaltratamientoreg\u00edstratesecretar\u00edaprincipale" +
+	"sprotecci\u00f3nimportantesimportanciaposibilidadinteresantecrecimie" +
+	"ntonecesidadessuscribirseasociaci\u00f3ndisponiblesevaluaci\u00f3nestudi" +
+	"antesresponsableresoluci\u00f3nguadalajararegistradosoportunidadcome" +
+	"rcialesfotograf\u00edaautoridadesingenier\u00edatelevisi\u00f3ncompetenciaop" +
+	"eracionesestablecidosimplementeactualmentenavegaci\u00f3nconformidad" +
+	"line-height:font-family:\" : \"http://applicationslink\" href=\"spec" +
+	"ifically//<![CDATA[\nOrganizationdistribution0px; height:relation" +
+	"shipdevice-width<div class=\"<label for=\"registration</noscript>\n" +
+	"/index.html\"window.open( !important;application/independence//ww" +
+	"w.googleorganizationautocompleterequirementsconservative<form na" +
+	"me=\"intellectualmargin-left:18th centuryan importantinstitutions" +
+	"abbreviation<img class=\"organisationcivilization19th centuryarch" +
+	"itectureincorporated20th century-container\">most notably/></a></" +
+	"div>notification'undefined')Furthermore,believe thatinnerHTML = " +
+	"prior to thedramaticallyreferring tonegotiationsheadquartersSout" +
+	"h AfricaunsuccessfulPennsylvaniaAs a result,<html lang=\"&lt;/sup" +
+	"&gt;dealing withphiladelphiahistorically);</script>\npadding-top:" +
+	"experimentalgetAttributeinstructionstechnologiespart of the =fun" +
+	"ction(){subscriptionl.dtd\">\r\n<htgeographicalConstitution', funct" +
+	"ion(supported byagriculturalconstructionpublicationsfont-size: 1" +
+	"a variety of<div style=\"Encyclopediaiframe src=\"demonstratedacco" +
+	"mplisheduniversitiesDemographics);</script><dedicated toknowledg" +
+	"e ofsatisfactionparticularly</div></div>English (US)appendChild(" +
+	"transmissions. However, intelligence\" tabindex=\"float:right;Comm" +
+	"onwealthranging fromin which theat least onereproductionencyclop" +
+	"edia;font-size:1jurisdictionat that time\"><a class=\"In addition," +
+	"description+conversationcontact withis generallyr\" content=\"repr" +
+	"esenting&lt;math&gt;presentationoccasionally<img width=\"navigati" +
+	"on\">compensationchampionshipmedia=\"all\" violation ofreference to" +
+	"return true;Strict//EN\" transactionsinterventionverificationInfo" +
+	"rmation difficultiesChampionshipcapabilities<![endif]-->}\n</scri" +
+	"pt>\nChristianityfor example,Professionalrestrictionssuggest that" +
+	"was released(such as theremoveClass(unemploymentthe Americanstru" +
+	"cture of/index.html published inspan class=\"\"><a href=\"/introduc" +
+	"tionbelonging toclaimed thatconsequences<meta name=\"Guide to the" +
+	"overwhelmingagainst the concentrated,\n.nontouch observations</a>" +
+	"\n</div>\nf (document.border: 1px {font-size:1treatment of0\" heigh" +
+	"t=\"1modificationIndependencedivided intogreater thanachievements" +
+	"establishingJavaScript\" neverthelesssignificanceBroadcasting>&nb" +
+	"sp;</td>container\">\nsuch as the influence ofa particularsrc='htt" +
+	"p://navigation\" half of the substantial &nbsp;</div>advantage of" +
+	"discovery offundamental metropolitanthe opposite\" xml:lang=\"deli" +
+	"beratelyalign=centerevolution ofpreservationimprovementsbeginnin" +
+	"g inJesus ChristPublicationsdisagreementtext-align:r, function()" +
+	"similaritiesbody></html>is currentlyalphabeticalis sometimestype" +
+	"=\"image/many of the flow:hidden;available indescribe theexistenc" +
+	"e ofall over thethe Internet\t<ul class=\"installationneighborhood" +
+	"armed forcesreducing thecontinues toNonetheless,temperatures\n\t\t<" +
+	"a href=\"close to theexamples of is about the(see below).\" id=\"se" +
+	"archprofessionalis availablethe official\t\t</script>\n\n\t\t<div id=\"" +
+	"accelerationthrough the Hall of Famedescriptionstranslationsinte" +
+	"rference type='text/recent yearsin the worldvery popular{backgro" +
+	"und:traditional some of the connected toexploitationemergence of" +
+	"constitutionA History ofsignificant manufacturedexpectations><no" +
+	"script><can be foundbecause the has not beenneighbouringwithout " +
+	"the added to the\t<li class=\"instrumentalSoviet Unionacknowledged" +
+	"which can bename for theattention toattempts to developmentsIn f" +
+	"act, the<li class=\"aimplicationssuitable formuch of the coloniza" +
+	"tionpresidentialcancelBubble Informationmost of the is described" +
+	"rest of the more or lessin SeptemberIntelligencesrc=\"http://px; " +
+	"height: available tomanufacturerhuman rightslink href=\"/availabi" +
+	"lityproportionaloutside the astronomicalhuman beingsname of the " +
+	"are found inare based onsmaller thana person whoexpansion ofargu" +
+	"ing thatnow known asIn the earlyintermediatederived fromScandina" +
+	"vian</a></div>\r\nconsider thean estimatedthe National<div id=\"pag" +
+	"resulting incommissionedanalogous toare required/ul>\n</div>\nwas " +
+	"based onand became a&nbsp;&nbsp;t\" value=\"\" was capturedno more " +
+	"thanrespectivelycontinue to >\r\n<head>\r\n<were createdmore general" +
+	"information used for theindependent the Imperialcomponent ofto t" +
+	"he northinclude the Constructionside of the would not befor inst" +
+	"anceinvention ofmore complexcollectivelybackground: text-align: " +
+	"its originalinto accountthis processan extensivehowever, thethey" +
+	" are notrejected thecriticism ofduring whichprobably thethis art" +
+	"icle(function(){It should bean agreementaccidentallydiffers from" +
+	"Architecturebetter knownarrangementsinfluence onattended theiden" +
+	"tical tosouth of thepass throughxml\" title=\"weight:bold;creating" +
+	" thedisplay:nonereplaced the<img src=\"/ihttps://www.World War II" +
+	"testimonialsfound in therequired to and that thebetween the was " +
+	"designedconsists of considerablypublished bythe languageConserva" +
+	"tionconsisted ofrefer to theback to the css\" media=\"People from " +
+	"available onproved to besuggestions\"was known asvarieties oflike" +
+	"ly to becomprised ofsupport the hands of thecoupled withconnect " +
+	"and border:none;performancesbefore beinglater becamecalculations" +
+	"often calledresidents ofmeaning that><li class=\"evidence forexpl" +
+	"anationsenvironments\"></a></div>which allowsIntroductiondevelope" +
+	"d bya wide rangeon behalf ofvalign=\"top\"principle ofat the time," +
+	"</noscript>\rsaid to havein the firstwhile othershypotheticalphil" +
+	"osopherspower of thecontained inperformed byinability towere wri" +
+	"ttenspan style=\"input name=\"the questionintended forrejection of" +
+	"implies thatinvented thethe standardwas probablylink betweenprof" +
+	"essor ofinteractionschanging theIndian Ocean class=\"lastworking " +
+	"with'http://www.years beforeThis was therecreationalentering the" +
+	"measurementsan extremelyvalue of thestart of the\n</script>\n\nan e" +
+	"ffort toincrease theto the southspacing=\"0\">sufficientlythe Euro" +
+	"peanconverted toclearTimeoutdid not haveconsequentlyfor the next" +
+	"extension ofeconomic andalthough theare producedand with theinsu" +
+	"fficientgiven by thestating thatexpenditures</span></a>\nthought " +
+	"thaton the basiscellpadding=image of thereturning toinformation," +
+	"separated byassassinateds\" content=\"authority ofnorthwestern</di" +
+	"v>\n<div \"></div>\r\n  consultationcommunity ofthe nationalit shoul" +
+	"d beparticipants align=\"leftthe greatestselection ofsupernatural" +
+	"dependent onis mentionedallowing thewas inventedaccompanyinghis " +
+	"personalavailable atstudy of theon the otherexecution ofHuman Ri" +
+	"ghtsterms of theassociationsresearch andsucceeded bydefeated the" +
+	"and from thebut they arecommander ofstate of theyears of agethe " +
+	"study of<ul class=\"splace in thewhere he was<li class=\"fthere ar" +
+	"e nowhich becamehe publishedexpressed into which thecommissioner" +
+	"font-weight:territory ofextensions\">Roman Empireequal to theIn c" +
+	"ontrast,however, andis typicallyand his wife(also called><ul cla" +
+	"ss=\"effectively evolved intoseem to havewhich is thethere was no" +
+	"an excellentall of thesedescribed byIn practice,broadcastingchar" +
+	"ged withreflected insubjected tomilitary andto the pointeconomic" +
+	"allysetTargetingare actuallyvictory over();</script>continuously" +
+	"required forevolutionaryan effectivenorth of the, which was fron" +
+	"t of theor otherwisesome form ofhad not beengenerated byinformat" +
+	"ion.permitted toincludes thedevelopment,entered intothe previous" +
+	"consistentlyare known asthe field ofthis type ofgiven to thethe " +
+	"title ofcontains theinstances ofin the northdue to theirare desi" +
+	"gnedcorporationswas that theone of thesemore popularsucceeded in" +
+	"support fromin differentdominated bydesigned forownership ofand " +
+	"possiblystandardizedresponseTextwas intendedreceived theassumed " +
+	"thatareas of theprimarily inthe basis ofin the senseaccounts for" +
+	"destroyed byat least twowas declaredcould not beSecretary ofappe" +
+	"ar to bemargin-top:1/^\\s+|\\s+$/ge){throw e};the start oftwo sepa" +
+	"ratelanguage andwho had beenoperation ofdeath of thereal numbers" +
+	"\t<link rel=\"provided thethe story ofcompetitionsenglish (UK)engl" +
+	"ish (US)\u041c\u043e\u043d\u0433\u043e\u043b\u0421\u0440\u043f\u0441\u043a\u0438\u0441\u0440\u043f\u0441\u043a\u0438\u0441\u0440\u043f\u0441\u043a\u043e\u0644\u0639\u0631\u0628" +
+	"\u064a\u0629\u6b63\u9ad4\u4e2d\u6587\u7b80\u4f53\u4e2d\u6587\u7e41\u4f53\u4e2d\u6587\u6709\u9650\u516c\u53f8\u4eba\u6c11\u653f\u5e9c" +
+	"\u963f\u91cc\u5df4\u5df4\u793e\u4f1a\u4e3b\u4e49\u64cd\u4f5c\u7cfb\u7edf\u653f\u7b56\u6cd5\u89c4informaci\u00f3nherr" +
+	"amientaselectr\u00f3nicodescripci\u00f3nclasificadosconocimientopublicac" +
+	"i\u00f3nrelacionadasinform\u00e1ticarelacionadosdepartamentotrabajadores" +
+	"directamenteayuntamientomercadoLibrecont\u00e1ctenoshabitacionescump" +
+	"limientorestaurantesdisposici\u00f3nconsecuenciaelectr\u00f3nicaaplicaci" +
+	"onesdesconectadoinstalaci\u00f3nrealizaci\u00f3nutilizaci\u00f3nenciclopedia" +
+	"enfermedadesinstrumentosexperienciasinstituci\u00f3nparticularessubc" +
+	"ategoria\u0442\u043e\u043b\u044c\u043a\u043e\u0420\u043e\u0441\u0441\u0438\u0438\u0440\u0430\u0431\u043e\u0442\u044b\u0431\u043e\u043b\u044c\u0448\u0435\u043f\u0440\u043e\u0441" +
+	"\u0442\u043e\u043c\u043e\u0436\u0435\u0442\u0435\u0434\u0440\u0443\u0433\u0438\u0445\u0441\u043b\u0443\u0447\u0430\u0435\u0441\u0435\u0439\u0447\u0430\u0441\u0432\u0441\u0435\u0433\u0434\u0430" +
+	"\u0420\u043e\u0441\u0441\u0438\u044f\u041c\u043e\u0441\u043a\u0432\u0435\u0434\u0440\u0443\u0433\u0438\u0435\u0433\u043e\u0440\u043e\u0434\u0430\u0432\u043e\u043f\u0440\u043e\u0441\u0434\u0430" +
+	"\u043d\u043d\u044b\u0445\u0434\u043e\u043b\u0436\u043d\u044b\u0438\u043c\u0435\u043d\u043d\u043e\u041c\u043e\u0441\u043a\u0432\u044b\u0440\u0443\u0431\u043b\u0435\u0439\u041c\u043e\u0441\u043a" +
+	"\u0432\u0430\u0441\u0442\u0440\u0430\u043d\u044b\u043d\u0438\u0447\u0435\u0433\u043e\u0440\u0430\u0431\u043e\u0442\u0435\u0434\u043e\u043b\u0436\u0435\u043d\u0443\u0441\u043b\u0443\u0433\u0438" +
+	"\u0442\u0435\u043f\u0435\u0440\u044c\u041e\u0434\u043d\u0430\u043a\u043e\u043f\u043e\u0442\u043e\u043c\u0443\u0440\u0430\u0431\u043e\u0442\u0443\u0430\u043f\u0440\u0435\u043b\u044f\u0432\u043e" +
+	"\u043e\u0431\u0449\u0435\u043e\u0434\u043d\u043e\u0433\u043e\u0441\u0432\u043e\u0435\u0433\u043e\u0441\u0442\u0430\u0442\u044c\u0438\u0434\u0440\u0443\u0433\u043e\u0439\u0444\u043e\u0440\u0443" +
+	"\u043c\u0435\u0445\u043e\u0440\u043e\u0448\u043e\u043f\u0440\u043e\u0442\u0438\u0432\u0441\u0441\u044b\u043b\u043a\u0430\u043a\u0430\u0436\u0434\u044b\u0439\u0432\u043b\u0430\u0441\u0442\u0438" +
+	"\u0433\u0440\u0443\u043f\u043f\u044b\u0432\u043c\u0435\u0441\u0442\u0435\u0440\u0430\u0431\u043e\u0442\u0430\u0441\u043a\u0430\u0437\u0430\u043b\u043f\u0435\u0440\u0432\u044b\u0439\u0434\u0435" +
+	"\u043b\u0430\u0442\u044c\u0434\u0435\u043d\u044c\u0433\u0438\u043f\u0435\u0440\u0438\u043e\u0434\u0431\u0438\u0437\u043d\u0435\u0441\u043e\u0441\u043d\u043e\u0432\u0435\u043c\u043e\u043c\u0435" +
+	"\u043d\u0442\u043a\u0443\u043f\u0438\u0442\u044c\u0434\u043e\u043b\u0436\u043d\u0430\u0440\u0430\u043c\u043a\u0430\u0445\u043d\u0430\u0447\u0430\u043b\u043e\u0420\u0430\u0431\u043e\u0442\u0430" +
+	"\u0422\u043e\u043b\u044c\u043a\u043e\u0441\u043e\u0432\u0441\u0435\u043c\u0432\u0442\u043e\u0440\u043e\u0439\u043d\u0430\u0447\u0430\u043b\u0430\u0441\u043f\u0438\u0441\u043e\u043a\u0441\u043b" +
+	"\u0443\u0436\u0431\u044b\u0441\u0438\u0441\u0442\u0435\u043c\u043f\u0435\u0447\u0430\u0442\u0438\u043d\u043e\u0432\u043e\u0433\u043e\u043f\u043e\u043c\u043e\u0449\u0438\u0441\u0430\u0439\u0442" +
+	"\u043e\u0432\u043f\u043e\u0447\u0435\u043c\u0443\u043f\u043e\u043c\u043e\u0449\u044c\u0434\u043e\u043b\u0436\u043d\u043e\u0441\u0441\u044b\u043b\u043a\u0438\u0431\u044b\u0441\u0442\u0440\u043e" +
+	"\u0434\u0430\u043d\u043d\u044b\u0435\u043c\u043d\u043e\u0433\u0438\u0435\u043f\u0440\u043e\u0435\u043a\u0442\u0421\u0435\u0439\u0447\u0430\u0441\u043c\u043e\u0434\u0435\u043b\u0438\u0442\u0430" +
+	"\u043a\u043e\u0433\u043e\u043e\u043d\u043b\u0430\u0439\u043d\u0433\u043e\u0440\u043e\u0434\u0435\u0432\u0435\u0440\u0441\u0438\u044f\u0441\u0442\u0440\u0430\u043d\u0435\u0444\u0438\u043b\u044c" +
+	"\u043c\u044b\u0443\u0440\u043e\u0432\u043d\u044f\u0440\u0430\u0437\u043d\u044b\u0445\u0438\u0441\u043a\u0430\u0442\u044c\u043d\u0435\u0434\u0435\u043b\u044e\u044f\u043d\u0432\u0430\u0440\u044f" +
+	"\u043c\u0435\u043d\u044c\u0448\u0435\u043c\u043d\u043e\u0433\u0438\u0445\u0434\u0430\u043d\u043d\u043e\u0439\u0437\u043d\u0430\u0447\u0438\u0442\u043d\u0435\u043b\u044c\u0437\u044f\u0444\u043e" +
+	"\u0440\u0443\u043c\u0430\u0422\u0435\u043f\u0435\u0440\u044c\u043c\u0435\u0441\u044f\u0446\u0430\u0437\u0430\u0449\u0438\u0442\u044b\u041b\u0443\u0447\u0448\u0438\u0435\u0928\u0939\xe0\xa5" +
+	"\x80\u0902\u0915\u0930\u0928\u0947\u0905\u092a\u0928\u0947\u0915\u093f\u092f\u093e\u0915\u0930\u0947\u0902\u0905\u0928\u094d\u092f" +
+	"\u0915\u094d\u092f\u093e\u0917\u093e\u0907\u0921\u092c\u093e\u0930\u0947\u0915\u093f\u0938\u0940\u0926\u093f\u092f\u093e\u092a\xe0" +
+	"\xa4\xb9\u0932\u0947\u0938\u093f\u0902\u0939\u092d\u093e\u0930\u0924\u0905\u092a\u0928\u0940\u0935\u093e\u0932\u0947\u0938\u0947\xe0\xa4" +
+	"\xb5\u093e\u0915\u0930\u0924\u0947\u092e\u0947\u0930\u0947\u0939\u094b\u0928\u0947\u0938\u0915\u0924\u0947\u092c\u0939\u0941\u0924" +
+	"\u0938\u093e\u0907\u091f\u0939\u094b\u0917\u093e\u091c\u093e\u0928\u0947\u092e\u093f\u0928\u091f\u0915\u0930\u0924\u093e\u0915\xe0" +
+	"\xa4\xb0\u0928\u093e\u0909\u0928\u0915\u0947\u092f\u0939\u093e\u0901\u0938\u092c\u0938\u0947\u092d\u093e\u0937\u093e\u0906\u092a\xe0\xa4" +
+	"\x95\u0947\u0932\u093f\u092f\u0947\u0936\u0941\u0930\u0942\u0907\u0938\u0915\u0947\u0918\u0902\u091f\u0947\u092e\u0947\u0930\u0940" +
+	"\u0938\u0915\u0924\u093e\u092e\u0947\u0930\u093e\u0932\u0947\u0915\u0930\u0905\u0927\u093f\u0915\u0905\u092a\u0928\u093e\u0938\xe0" +
+	"\xa4\xae\u093e\u091c\u092e\u0941\u091d\u0947\u0915\u093e\u0930\u0923\u0939\u094b\u0924\u093e\u0915\u0921\u093c\u0940\u092f\u0939\xe0\xa4" +
+	"\xbe\u0902\u0939\u094b\u091f\u0932\u0936\u092c\u094d\u0926\u0932\u093f\u092f\u093e\u091c\u0940\u0935\u0928\u091c\u093e\u0924\u093e" +
+	"\u0915\u0948\u0938\u0947\u0906\u092a\u0915\u093e\u0935\u093e\u0932\u0940\u0926\u0947\u0928\u0947\u092a\u0942\u0930\u0940\u092a\xe0" +
+	"\xa4\xbe\u0928\u0940\u0909\u0938\u0915\u0947\u0939\u094b\u0917\u0940\u092c\u0948\u0920\u0915\u0906\u092a\u0915\u0940\u0935\u0930\xe0\xa5" +
+	"\x8d\u0937\u0917\u093e\u0902\u0935\u0906\u092a\u0915\u094b\u091c\u093f\u0932\u093e\u091c\u093e\u0928\u093e\u0938\u0939\u092e\u0924" +
+	"\u0939\u092e\u0947\u0902\u0909\u0928\u0915\u0940\u092f\u093e\u0939\u0942\u0926\u0930\u094d\u091c\u0938\u0942\u091a\u0940\u092a\xe0" +
+	"\xa4\xb8\u0902\u0926\u0938\u0935\u093e\u0932\u0939\u094b\u0928\u093e\u0939\u094b\u0924\u0940\u091c\u0948\u0938\u0947\u0935\u093e\xe0\xa4" +
+	"\xaa\u0938\u091c\u0928\u0924\u093e\u0928\u0947\u0924\u093e\u091c\u093e\u0930\u0940\u0918\u093e\u092f\u0932\u091c\u093f\u0932\u0947" +
+	"\u0928\u0940\u091a\u0947\u091c\u093e\u0902\u091a\u092a\u0924\u094d\u0930\u0917\u0942\u0917\u0932\u091c\u093e\u0924\u0947\u092c\xe0" +
+	"\xa4\xbe\u0939\u0930\u0906\u092a\u0928\u0947\u0935\u093e\u0939\u0928\u0907\u0938\u0915\u093e\u0938\u0941\u092c\u0939\u0930\u0939\xe0\xa4" +
+	"\xa8\u0947\u0907\u0938\u0938\u0947\u0938\u0939\u093f\u0924\u092c\u0921\u093c\u0947\u0918\u091f\u0928\u093e\u0924\u0932\u093e\u0936" +
+	"\u092a\u093e\u0902\u091a\u0936\u094d\u0930\u0940\u092c\u0921\u093c\u0940\u0939\u094b\u0924\u0947\u0938\u093e\u0908\u091f\u0936\xe0" +
+	"\xa4\xbe\u092f\u0926\u0938\u0915\u0924\u0940\u091c\u093e\u0924\u0940\u0935\u093e\u0932\u093e\u0939\u091c\u093e\u0930\u092a\u091f\xe0\xa4" +
+	"\xa8\u093e\u0930\u0916\u0928\u0947\u0938\u0921\u093c\u0915\u092e\u093f\u0932\u093e\u0909\u0938\u0915\u0940\u0915\u0947\u0935\u0932" +
+	"\u0932\u0917\u0924\u093e\u0916\u093e\u0928\u093e\u0905\u0930\u094d\u0925\u091c\u0939\u093e\u0902\u0926\u0947\u0916\u093e\u092a\xe0" +
+	"\xa4\xb9\u0932\u0940\u0928\u093f\u092f\u092e\u092c\u093f\u0928\u093e\u092c\u0948\u0902\u0915\u0915\u0939\u0940\u0902\u0915\u0939\xe0\xa4" +
+	"\xa8\u093e\u0926\u0947\u0924\u093e\u0939\u092e\u0932\u0947\u0915\u093e\u092b\u0940\u091c\u092c\u0915\u093f\u0924\u0941\u0930\u0924" +
+	"\u092e\u093e\u0902\u0917\u0935\u0939\u0940\u0902\u0930\u094b\u091c\u093c\u092e\u093f\u0932\u0940\u0906\u0930\u094b\u092a\u0938\xe0" +
+	"\xa5\x87\u0928\u093e\u092f\u093e\u0926\u0935\u0932\u0947\u0928\u0947\u0916\u093e\u0924\u093e\u0915\u0930\u0940\u092c\u0909\u0928\xe0\xa4" +
+	"\x95\u093e\u091c\u0935\u093e\u092c\u092a\u0942\u0930\u093e\u092c\u0921\u093c\u093e\u0938\u094c\u0926\u093e\u0936\u0947\u092f\u0930" +
+	"\u0915\u093f\u092f\u0947\u0915\u0939\u093e\u0902\u0905\u0915\u0938\u0930\u092c\u0928\u093e\u090f\u0935\u0939\u093e\u0902\u0938\xe0" +
+	"\xa5\x8d\u0925\u0932\u092e\u093f\u0932\u0947\u0932\u0947\u0916\u0915\u0935\u093f\u0937\u092f\u0915\u094d\u0930\u0902\u0938\u092e\xe0\xa5" +
+	"\x82\u0939\u0925\u093e\u0928\u093e\u062a\u0633\u062a\u0637\u064a\u0639\u0645\u0634\u0627\u0631\u0643\u0629\u0628\u0648\u0627\u0633\u0637\u0629\u0627\u0644\u0635\u0641\u062d\u0629" +
+	"\u0645\u0648\u0627\u0636\u064a\u0639\u0627\u0644\u062e\u0627\u0635\u0629\u0627\u0644\u0645\u0632\u064a\u062f\u0627\u0644\u0639\u0627\u0645\u0629\u0627\u0644\u0643\u0627\u062a\u0628\u0627\u0644" +
+	"\u0631\u062f\u0648\u062f\u0628\u0631\u0646\u0627\u0645\u062c\u0627\u0644\u062f\u0648\u0644\u0629\u0627\u0644\u0639\u0627\u0644\u0645\u0627\u0644\u0645\u0648\u0642\u0639\u0627\u0644\u0639\u0631" +
+	"\u0628\u064a\u0627\u0644\u0633\u0631\u064a\u0639\u0627\u0644\u062c\u0648\u0627\u0644\u0627\u0644\u0630\u0647\u0627\u0628\u0627\u0644\u062d\u064a\u0627\u0629\u0627\u0644\u062d\u0642\u0648\u0642" +
+	"\u0627\u0644\u0643\u0631\u064a\u0645\u0627\u0644\u0639\u0631\u0627\u0642\u0645\u062d\u0641\u0648\u0638\u0629\u0627\u0644\u062b\u0627\u0646\u064a\u0645\u0634\u0627\u0647\u062f\u0629\u0627\u0644" +
+	"\u0645\u0631\u0623\u0629\u0627\u0644\u0642\u0631\u0622\u0646\u0627\u0644\u0634\u0628\u0627\u0628\u0627\u0644\u062d\u0648\u0627\u0631\u0627\u0644\u062c\u062f\u064a\u062f\u0627\u0644\u0623\u0633" +
+	"\u0631\u0629\u0627\u0644\u0639\u0644\u0648\u0645\u0645\u062c\u0645\u0648\u0639\u0629\u0627\u0644\u0631\u062d\u0645\u0646\u0627\u0644\u0646\u0642\u0627\u0637\u0641\u0644\u0633\u0637\u064a\u0646" +
+	"\u0627\u0644\u0643\u0648\u064a\u062a\u0627\u0644\u062f\u0646\u064a\u0627\u0628\u0631\u0643\u0627\u062a\u0647\u0627\u0644\u0631\u064a\u0627\u0636\u062a\u062d\u064a\u0627\u062a\u064a\u0628\u062a" +
+	"\u0648\u0642\u064a\u062a\u0627\u0644\u0623\u0648\u0644\u0649\u0627\u0644\u0628\u0631\u064a\u062f\u0627\u0644\u0643\u0644\u0627\u0645\u0627\u0644\u0631\u0627\u0628\u0637\u0627\u0644\u0634\u062e" +
+	"\u0635\u064a\u0633\u064a\u0627\u0631\u0627\u062a\u0627\u0644\u062b\u0627\u0644\u062b\u0627\u0644\u0635\u0644\u0627\u0629\u0627\u0644\u062d\u062f\u064a\u062b\u0627\u0644\u0632\u0648\u0627\u0631" +
+	"\u0627\u0644\u062e\u0644\u064a\u062c\u0627\u0644\u062c\u0645\u064a\u0639\u0627\u0644\u0639\u0627\u0645\u0647\u0627\u0644\u062c\u0645\u0627\u0644\u0627\u0644\u0633\u0627\u0639\u0629\u0645\u0634" +
+	"\u0627\u0647\u062f\u0647\u0627\u0644\u0631\u0626\u064a\u0633\u0627\u0644\u062f\u062e\u0648\u0644\u0627\u0644\u0641\u0646\u064a\u0629\u0627\u0644\u0643\u062a\u0627\u0628\u0627\u0644\u062f\u0648" +
+	"\u0631\u064a\u0627\u0644\u062f\u0631\u0648\u0633\u0627\u0633\u062a\u063a\u0631\u0642\u062a\u0635\u0627\u0645\u064a\u0645\u0627\u0644\u0628\u0646\u0627\u062a\u0627\u0644\u0639\u0638\u064a\u0645" +
+	"entertainmentunderstanding = function().jpg\" width=\"configuratio" +
+	"n.png\" width=\"<body class=\"Math.random()contemporary United Stat" +
+	"escircumstances.appendChild(organizations<span class=\"\"><img src" +
+	"=\"/distinguishedthousands of communicationclear\"></div>investiga" +
+	"tionfavicon.ico\" margin-right:based on the Massachusettstable bo" +
+	"rder=internationalalso known aspronunciationbackground:#fpadding" +
+	"-left:For example, miscellaneous&lt;/math&gt;psychologicalin par" +
+	"ticularearch\" type=\"form method=\"as opposed toSupreme Courtoccas" +
+	"ionally Additionally,North Americapx;backgroundopportunitiesEnte" +
+	"rtainment.toLowerCase(manufacturingprofessional combined withFor" +
+	" instance,consisting of\" maxlength=\"return false;consciousnessMe" +
+	"diterraneanextraordinaryassassinationsubsequently button type=\"t" +
+	"he number ofthe original comprehensiverefers to the</ul>\n</div>\n" +
+	"philosophicallocation.hrefwas publishedSan Francisco(function(){" +
+	"\n<div id=\"mainsophisticatedmathematical /head>\r\n<bodysuggests th" +
+	"atdocumentationconcentrationrelationshipsmay have been(for examp" +
+	"le,This article in some casesparts of the definition ofGreat Bri" +
+	"tain cellpadding=equivalent toplaceholder=\"; font-size: justific" +
+	"ationbelieved thatsuffered fromattempted to leader of thecript\" " +
+	"src=\"/(function() {are available\n\t<link rel=\" src='http://intere" +
+	"sted inconventional \" alt=\"\" /></are generallyhas also beenmost " +
+	"popular correspondingcredited withtyle=\"border:</a></span></.gif" +
+	"\" width=\"<iframe src=\"table class=\"inline-block;according to tog" +
+	"ether withapproximatelyparliamentarymore and moredisplay:none;tr" +
+	"aditionallypredominantly&nbsp;|&nbsp;&nbsp;</span> cellspacing=<" +
+	"input name=\"or\" content=\"controversialproperty=\"og:/x-shockwave-" +
+	"demonstrationsurrounded byNevertheless,was the firstconsiderable" +
+	" Although the collaborationshould not beproportion of<span style" +
+	"=\"known as the shortly afterfor instance,described as /head>\n<bo" +
+	"dy starting withincreasingly the fact thatdiscussion ofmiddle of" +
+	" thean individualdifficult to point of viewhomosexualityacceptan" +
+	"ce of</span></div>manufacturersorigin of thecommonly usedimporta" +
+	"nce ofdenominationsbackground: #length of thedeterminationa sign" +
+	"ificant\" border=\"0\">revolutionaryprinciples ofis consideredwas d" +
+	"evelopedIndo-Europeanvulnerable toproponents ofare sometimesclos" +
+	"er to theNew York City name=\"searchattributed tocourse of themat" +
+	"hematicianby the end ofat the end of\" border=\"0\" technological.r" +
+	"emoveClass(branch of theevidence that![endif]-->\r\nInstitute of i" +
+	"nto a singlerespectively.and thereforeproperties ofis located in" +
+	"some of whichThere is alsocontinued to appearance of &amp;ndash;" +
+	" describes theconsiderationauthor of theindependentlyequipped wi" +
+	"thdoes not have</a><a href=\"confused with<link href=\"/at the age" +
+	" ofappear in theThese includeregardless ofcould be used style=&q" +
+	"uot;several timesrepresent thebody>\n</html>thought to bepopulati" +
+	"on ofpossibilitiespercentage ofaccess to thean attempt toproduct" +
+	"ion ofjquery/jquerytwo differentbelong to theestablishmentreplac" +
+	"ing thedescription\" determine theavailable forAccording to wide " +
+	"range of\t<div class=\"more commonlyorganisationsfunctionalitywas " +
+	"completed &amp;mdash; participationthe characteran additionalapp" +
+	"ears to befact that thean example ofsignificantlyonmouseover=\"be" +
+	"cause they async = true;problems withseems to havethe result of " +
+	"src=\"http://familiar withpossession offunction () {took place in" +
+	"and sometimessubstantially<span></span>is often usedin an attemp" +
+	"tgreat deal ofEnvironmentalsuccessfully virtually all20th centur" +
+	"y,professionalsnecessary to determined bycompatibilitybecause it" +
+	" isDictionary ofmodificationsThe followingmay refer to:Consequen" +
+	"tly,Internationalalthough somethat would beworld's firstclassifi" +
+	"ed asbottom of the(particularlyalign=\"left\" most commonlybasis f" +
+	"or thefoundation ofcontributionspopularity ofcenter of theto red" +
+	"uce thejurisdictionsapproximation onmouseout=\"New Testamentcolle" +
+	"ction of</span></a></in the Unitedfilm director-strict.dtd\">has " +
+	"been usedreturn to thealthough thischange in theseveral otherbut" +
+	" there areunprecedentedis similar toespecially inweight: bold;is" +
+	" called thecomputationalindicate thatrestricted to\t<meta name=\"a" +
+	"re typicallyconflict withHowever, the An example ofcompared with" +
+	"quantities ofrather than aconstellationnecessary forreported tha" +
+	"tspecificationpolitical and&nbsp;&nbsp;<references tothe same ye" +
+	"arGovernment ofgeneration ofhave not beenseveral yearscommitment" +
+	" to\t\t<ul class=\"visualization19th century,practitionersthat he w" +
+	"ouldand continuedoccupation ofis defined ascentre of thethe amou" +
+	"nt of><div style=\"equivalent ofdifferentiatebrought aboutmargin-" +
+	"left: automaticallythought of asSome of these\n<div class=\"input " +
+	"class=\"replaced withis one of theeducation andinfluenced byreput" +
+	"ation as\n<meta name=\"accommodation</div>\n</div>large part ofInst" +
+	"itute forthe so-called against the In this case,was appointedcla" +
+	"imed to beHowever, thisDepartment ofthe remainingeffect on thepa" +
+	"rticularly deal with the\n<div style=\"almost alwaysare currentlye" +
+	"xpression ofphilosophy offor more thancivilizationson the island" +
+	"selectedIndexcan result in\" value=\"\" />the structure /></a></div" +
+	">Many of thesecaused by theof the Unitedspan class=\"mcan be trac" +
+	"edis related tobecame one ofis frequentlyliving in thetheoretica" +
+	"llyFollowing theRevolutionarygovernment inis determinedthe polit" +
+	"icalintroduced insufficient todescription\">short storiesseparati" +
+	"on ofas to whetherknown for itswas initiallydisplay:blockis an e" +
+	"xamplethe principalconsists of arecognized as/body></html>a subs" +
+	"tantialreconstructedhead of stateresistance toundergraduateThere" +
+	" are twogravitationalare describedintentionallyserved as theclas" +
+	"s=\"headeropposition tofundamentallydominated theand the otherall" +
+	"iance withwas forced torespectively,and politicalin support ofpe" +
+	"ople in the20th century.and publishedloadChartbeatto understandm" +
+	"ember statesenvironmentalfirst half ofcountries andarchitectural" +
+	"be consideredcharacterizedclearIntervalauthoritativeFederation o" +
+	"fwas succeededand there area consequencethe Presidentalso includ" +
+	"edfree softwaresuccession ofdeveloped thewas destroyedaway from " +
+	"the;\n</script>\n<although theyfollowed by amore powerfulresulted " +
+	"in aUniversity ofHowever, manythe presidentHowever, someis thoug" +
+	"ht tountil the endwas announcedare importantalso includes><input" +
+	" type=the center of DO NOT ALTERused to referthemes/?sort=that h" +
+	"ad beenthe basis forhas developedin the summercomparativelydescr" +
+	"ibed thesuch as thosethe resultingis impossiblevarious otherSout" +
+	"h Africanhave the sameeffectivenessin which case; text-align:str" +
+	"ucture and; background:regarding thesupported theis also knownst" +
+	"yle=\"marginincluding thebahasa Melayunorsk bokm\u00e5lnorsk nynorsks" +
+	"loven\u0161\u010dinainternacionalcalificaci\u00f3ncomunicaci\u00f3nconstrucci\u00f3n" +
+	"\"><div class=\"disambiguationDomainName', 'administrationsimultan" +
+	"eouslytransportationInternational margin-bottom:responsibility<!" +
+	"[endif]-->\n</><meta name=\"implementationinfrastructurerepresenta" +
+	"tionborder-bottom:</head>\n<body>=http%3A%2F%2F<form method=\"meth" +
+	"od=\"post\" /favicon.ico\" });\n</script>\n.setAttribute(Administrati" +
+	"on= new Array();<![endif]-->\r\ndisplay:block;Unfortunately,\">&nbs" +
+	"p;</div>/favicon.ico\">='stylesheet' identification, for example," +
+	"<li><a href=\"/an alternativeas a result ofpt\"></script>\ntype=\"su" +
+	"bmit\" \n(function() {recommendationform action=\"/transformationre" +
+	"construction.style.display According to hidden\" name=\"along with" +
+	" thedocument.body.approximately Communicationspost\" action=\"mean" +
+	"ing &quot;--<![endif]-->Prime Ministercharacteristic</a> <a clas" +
+	"s=the history of onmouseover=\"the governmenthref=\"https://was or" +
+	"iginallywas introducedclassificationrepresentativeare considered" +
+	"<![endif]-->\n\ndepends on theUniversity of in contrast to placeho" +
+	"lder=\"in the case ofinternational constitutionalstyle=\"border-: " +
+	"function() {Because of the-strict.dtd\">\n<table class=\"accompanie" +
+	"d byaccount of the<script src=\"/nature of the the people in in a" +
+	"ddition tos); js.id = id\" width=\"100%\"regarding the Roman Cathol" +
+	"ican independentfollowing the .gif\" width=\"1the following discri" +
+	"minationarchaeologicalprime minister.js\"></script>combination of" +
+	" marginwidth=\"createElement(w.attachEvent(</a></td></tr>src=\"htt" +
+	"ps://aIn particular, align=\"left\" Czech RepublicUnited Kingdomco" +
+	"rrespondenceconcluded that.html\" title=\"(function () {comes from" +
+	" theapplication of<span class=\"sbelieved to beement('script'</a>" +
+	"\n</li>\n<livery different><span class=\"option value=\"(also known " +
+	"as\t<li><a href=\"><input name=\"separated fromreferred to as valig" +
+	"n=\"top\">founder of theattempting to carbon dioxide\n\n<div class=\"" +
+	"class=\"search-/body>\n</html>opportunity tocommunications</head>\r" +
+	"\n<body style=\"width:Ti\u1ebfng Vi\u1ec7tchanges in theborder-color:#0\"" +
+	" border=\"0\" </span></div><was discovered\" type=\"text\" );\n</scrip" +
+	"t>\n\nDepartment of ecclesiasticalthere has beenresulting from</bo" +
+	"dy></html>has never beenthe first timein response toautomaticall" +
+	"y </div>\n\n<div iwas consideredpercent of the\" /></a></div>collec" +
+	"tion of descended fromsection of theaccept-charsetto be confused" +
+	"member of the padding-right:translation ofinterpretation href='h" +
+	"ttp://whether or notThere are alsothere are manya small numberot" +
+	"her parts ofimpossible to  class=\"buttonlocated in the. However," +
+	" theand eventuallyAt the end of because of itsrepresents the<for" +
+	"m action=\" method=\"post\"it is possiblemore likely toan increase " +
+	"inhave also beencorresponds toannounced thatalign=\"right\">many c" +
+	"ountriesfor many yearsearliest knownbecause it waspt\"></script>\r" +
+	" valign=\"top\" inhabitants offollowing year\r\n<div class=\"million " +
+	"peoplecontroversial concerning theargue that thegovernment anda " +
+	"reference totransferred todescribing the style=\"color:although t" +
+	"herebest known forsubmit\" name=\"multiplicationmore than one reco" +
+	"gnition ofCouncil of theedition of the  <meta name=\"Entertainmen" +
+	"t away from the ;margin-right:at the time ofinvestigationsconnec" +
+	"ted withand many otheralthough it isbeginning with <span class=\"" +
+	"descendants of<span class=\"i align=\"right\"</head>\n<body aspects " +
+	"of thehas since beenEuropean Unionreminiscent ofmore difficultVi" +
+	"ce Presidentcomposition ofpassed throughmore importantfont-size:" +
+	"11pxexplanation ofthe concept ofwritten in the\t<span class=\"is o" +
+	"ne of the resemblance toon the groundswhich containsincluding th" +
+	"e defined by thepublication ofmeans that theoutside of thesuppor" +
+	"t of the<input class=\"<span class=\"t(Math.random()most prominent" +
+	"description ofConstantinoplewere published<div class=\"seappears " +
+	"in the1\" height=\"1\" most importantwhich includeswhich had beende" +
+	"struction ofthe population\n\t<div class=\"possibility ofsometimes " +
+	"usedappear to havesuccess of theintended to bepresent in thestyl" +
+	"e=\"clear:b\r\n</script>\r\n<was founded ininterview with_id\" content" +
+	"=\"capital of the\r\n<link rel=\"srelease of thepoint out thatxMLHtt" +
+	"pRequestand subsequentsecond largestvery importantspecifications" +
+	"surface of theapplied to theforeign policy_setDomainNameestablis" +
+	"hed inis believed toIn addition tomeaning of theis named afterto" +
+	" protect theis representedDeclaration ofmore efficientClassifica" +
+	"tionother forms ofhe returned to<span class=\"cperformance of(fun" +
+	"ction() {\rif and only ifregions of theleading to therelations wi" +
+	"thUnited Nationsstyle=\"height:other than theype\" content=\"Associ" +
+	"ation of\n</head>\n<bodylocated on theis referred to(including the" +
+	"concentrationsthe individualamong the mostthan any other/>\n<link" +
+	" rel=\" return false;the purpose ofthe ability to;color:#fff}\n.\n<" +
+	"span class=\"the subject ofdefinitions of>\r\n<link rel=\"claim that" +
+	" thehave developed<table width=\"celebration ofFollowing the to d" +
+	"istinguish<span class=\"btakes place inunder the namenoted that t" +
+	"he><![endif]-->\nstyle=\"margin-instead of theintroduced thethe pr" +
+	"ocess ofincreasing thedifferences inestimated thatespecially the" +
+	"/div><div id=\"was eventuallythroughout histhe differencesomethin" +
+	"g thatspan></span></significantly ></script>\r\n\r\nenvironmental to" +
+	" prevent thehave been usedespecially forunderstand theis essenti" +
+	"allywere the firstis the largesthave been made\" src=\"http://inte" +
+	"rpreted assecond half ofcrolling=\"no\" is composed ofII, Holy Rom" +
+	"anis expected tohave their owndefined as thetraditionally have d" +
+	"ifferentare often usedto ensure thatagreement withcontaining the" +
+	"are frequentlyinformation onexample is theresulting in a</a></li" +
+	"></ul> class=\"footerand especiallytype=\"button\" </span></span>wh" +
+	"ich included>\n<meta name=\"considered thecarried out byHowever, i" +
+	"t isbecame part ofin relation topopular in thethe capital ofwas " +
+	"officiallywhich has beenthe History ofalternative todifferent fr" +
+	"omto support thesuggested thatin the process  <div class=\"the fo" +
+	"undationbecause of hisconcerned withthe universityopposed to the" +
+	"the context of<span class=\"ptext\" name=\"q\"\t\t<div class=\"the scie" +
+	"ntificrepresented bymathematicianselected by thethat have been><" +
+	"div class=\"cdiv id=\"headerin particular,converted into);\n</scrip" +
+	"t>\n<philosophical srpskohrvatskiti\u1ebfng Vi\u1ec7t\u0420\u0443\u0441\u0441\u043a\u0438\u0439\u0440\u0443" +
+	"\u0441\u0441\u043a\u0438\u0439investigaci\u00f3nparticipaci\u00f3n\u043a\u043e\u0442\u043e\u0440\u044b\u0435\u043e\u0431\u043b\u0430\u0441\u0442" +
+	"\u0438\u043a\u043e\u0442\u043e\u0440\u044b\u0439\u0447\u0435\u043b\u043e\u0432\u0435\u043a\u0441\u0438\u0441\u0442\u0435\u043c\u044b\u041d\u043e\u0432\u043e\u0441\u0442\u0438\u043a\u043e\u0442" +
+	"\u043e\u0440\u044b\u0445\u043e\u0431\u043b\u0430\u0441\u0442\u044c\u0432\u0440\u0435\u043c\u0435\u043d\u0438\u043a\u043e\u0442\u043e\u0440\u0430\u044f\u0441\u0435\u0433\u043e\u0434\u043d\u044f" +
+	"\u0441\u043a\u0430\u0447\u0430\u0442\u044c\u043d\u043e\u0432\u043e\u0441\u0442\u0438\u0423\u043a\u0440\u0430\u0438\u043d\u044b\u0432\u043e\u043f\u0440\u043e\u0441\u044b\u043a\u043e\u0442\u043e" +
+	"\u0440\u043e\u0439\u0441\u0434\u0435\u043b\u0430\u0442\u044c\u043f\u043e\u043c\u043e\u0449\u044c\u044e\u0441\u0440\u0435\u0434\u0441\u0442\u0432\u043e\u0431\u0440\u0430\u0437\u043e\u043c\u0441" +
+	"\u0442\u043e\u0440\u043e\u043d\u044b\u0443\u0447\u0430\u0441\u0442\u0438\u0435\u0442\u0435\u0447\u0435\u043d\u0438\u0435\u0413\u043b\u0430\u0432\u043d\u0430\u044f\u0438\u0441\u0442\u043e\u0440" +
+	"\u0438\u0438\u0441\u0438\u0441\u0442\u0435\u043c\u0430\u0440\u0435\u0448\u0435\u043d\u0438\u044f\u0421\u043a\u0430\u0447\u0430\u0442\u044c\u043f\u043e\u044d\u0442\u043e\u043c\u0443\u0441\u043b" +
+	"\u0435\u0434\u0443\u0435\u0442\u0441\u043a\u0430\u0437\u0430\u0442\u044c\u0442\u043e\u0432\u0430\u0440\u043e\u0432\u043a\u043e\u043d\u0435\u0447\u043d\u043e\u0440\u0435\u0448\u0435\u043d\u0438" +
+	"\u0435\u043a\u043e\u0442\u043e\u0440\u043e\u0435\u043e\u0440\u0433\u0430\u043d\u043e\u0432\u043a\u043e\u0442\u043e\u0440\u043e\u043c\u0420\u0435\u043a\u043b\u0430\u043c\u0430\u0627\u0644\u0645" +
+	"\u0646\u062a\u062f\u0649\u0645\u0646\u062a\u062f\u064a\u0627\u062a\u0627\u0644\u0645\u0648\u0636\u0648\u0639\u0627\u0644\u0628\u0631\u0627\u0645\u062c\u0627\u0644\u0645\u0648\u0627\u0642\u0639" +
+	"\u0627\u0644\u0631\u0633\u0627\u0626\u0644\u0645\u0634\u0627\u0631\u0643\u0627\u062a\u0627\u0644\u0623\u0639\u0636\u0627\u0621\u0627\u0644\u0631\u064a\u0627\u0636\u0629\u0627\u0644\u062a\u0635" +
+	"\u0645\u064a\u0645\u0627\u0644\u0627\u0639\u0636\u0627\u0621\u0627\u0644\u0646\u062a\u0627\u0626\u062c\u0627\u0644\u0623\u0644\u0639\u0627\u0628\u0627\u0644\u062a\u0633\u062c\u064a\u0644\u0627" +
+	"\u0644\u0623\u0642\u0633\u0627\u0645\u0627\u0644\u0636\u063a\u0637\u0627\u062a\u0627\u0644\u0641\u064a\u062f\u064a\u0648\u0627\u0644\u062a\u0631\u062d\u064a\u0628\u0627\u0644\u062c\u062f\u064a" +
+	"\u062f\u0629\u0627\u0644\u062a\u0639\u0644\u064a\u0645\u0627\u0644\u0623\u062e\u0628\u0627\u0631\u0627\u0644\u0627\u0641\u0644\u0627\u0645\u0627\u0644\u0623\u0641\u0644\u0627\u0645\u0627\u0644" +
+	"\u062a\u0627\u0631\u064a\u062e\u0627\u0644\u062a\u0642\u0646\u064a\u0629\u0627\u0644\u0627\u0644\u0639\u0627\u0628\u0627\u0644\u062e\u0648\u0627\u0637\u0631\u0627\u0644\u0645\u062c\u062a\u0645" +
+	"\u0639\u0627\u0644\u062f\u064a\u0643\u0648\u0631\u0627\u0644\u0633\u064a\u0627\u062d\u0629\u0639\u0628\u062f\u0627\u0644\u0644\u0647\u0627\u0644\u062a\u0631\u0628\u064a\u0629\u0627\u0644\u0631" +
+	"\u0648\u0627\u0628\u0637\u0627\u0644\u0623\u062f\u0628\u064a\u0629\u0627\u0644\u0627\u062e\u0628\u0627\u0631\u0627\u0644\u0645\u062a\u062d\u062f\u0629\u0627\u0644\u0627\u063a\u0627\u0646\u064a" +
+	"cursor:pointer;</title>\n<meta \" href=\"http://\"><span class=\"memb" +
+	"ers of the window.locationvertical-align:/a> | <a href=\"<!doctyp" +
+	"e html>media=\"screen\" <option value=\"favicon.ico\" />\n\t\t<div clas" +
+	"s=\"characteristics\" method=\"get\" /body>\n</html>\nshortcut icon\" d" +
+	"ocument.write(padding-bottom:representativessubmit\" value=\"align" +
+	"=\"center\" throughout the science fiction\n  <div class=\"submit\" c" +
+	"lass=\"one of the most valign=\"top\"><was established);\r\n</script>" +
+	"\r\nreturn false;\">).style.displaybecause of the document.cookie<f" +
+	"orm action=\"/}body{margin:0;Encyclopedia ofversion of the .creat" +
+	"eElement(name\" content=\"</div>\n</div>\n\nadministrative </body>\n</" +
+	"html>history of the \"><input type=\"portion of the as part of the" +
+	" &nbsp;<a href=\"other countries\">\n<div class=\"</span></span><In " +
+	"other words,display: block;control of the introduction of/>\n<met" +
+	"a name=\"as well as the in recent years\r\n\t<div class=\"</div>\n\t</d" +
+	"iv>\ninspired by thethe end of the compatible withbecame known as" +
+	" style=\"margin:.js\"></script>< International there have beenGerm" +
+	"an language style=\"color:#Communist Partyconsistent withborder=\"" +
+	"0\" cell marginheight=\"the majority of\" align=\"centerrelated to t" +
+	"he many different Orthodox Churchsimilar to the />\n<link rel=\"sw" +
+	"as one of the until his death})();\n</script>other languagescompa" +
+	"red to theportions of thethe Netherlandsthe most commonbackgroun" +
+	"d:url(argued that thescrolling=\"no\" included in theNorth America" +
+	"n the name of theinterpretationsthe traditionaldevelopment of fr" +
+	"equently useda collection ofvery similar tosurrounding theexampl" +
+	"e of thisalign=\"center\">would have beenimage_caption =attached t" +
+	"o thesuggesting thatin the form of involved in theis derived fro" +
+	"mnamed after theIntroduction torestrictions on style=\"width: can" +
+	" be used to the creation ofmost important information andresulte" +
+	"d in thecollapse of theThis means thatelements of thewas replace" +
+	"d byanalysis of theinspiration forregarded as themost successful" +
+	"known as &quot;a comprehensiveHistory of the were consideredretu" +
+	"rned to theare referred toUnsourced image>\n\t<div class=\"consists" +
+	" of thestopPropagationinterest in theavailability ofappears to h" +
+	"aveelectromagneticenableServices(function of theIt is important<" +
+	"/script></div>function(){var relative to theas a result of the p" +
+	"osition ofFor example, in method=\"post\" was followed by&amp;mdas" +
+	"h; thethe applicationjs\"></script>\r\nul></div></div>after the dea" +
+	"thwith respect tostyle=\"padding:is particularlydisplay:inline; t" +
+	"ype=\"submit\" is divided into\u4e2d\u6587 (\u7b80\u4f53)responsabilidadadmini" +
+	"straci\u00f3ninternacionalescorrespondiente\u0909\u092a\u092f\u094b\u0917\u092a\u0942\u0930\xe0" +
+	"\xa5\x8d\u0935\u0939\u092e\u093e\u0930\u0947\u0932\u094b\u0917\u094b\u0902\u091a\u0941\u0928\u093e\u0935\u0932\u0947\u0915\u093f\xe0\xa4" +
+	"\xa8\u0938\u0930\u0915\u093e\u0930\u092a\u0941\u0932\u093f\u0938\u0916\u094b\u091c\u0947\u0902\u091a\u093e\u0939\u093f\u090f\u092d" +
+	"\u0947\u091c\u0947\u0902\u0936\u093e\u092e\u093f\u0932\u0939\u092e\u093e\u0930\u0940\u091c\u093e\u0917\u0930\u0923\u092c\u0928\xe0" +
+	"\xa4\xbe\u0928\u0947\u0915\u0941\u092e\u093e\u0930\u092c\u094d\u0932\u0949\u0917\u092e\u093e\u0932\u093f\u0915\u092e\u0939\u093f\xe0\xa4" +
+	"\xb2\u093e\u092a\u0943\u0937\u094d\u0920\u092c\u0922\u093c\u0924\u0947\u092d\u093e\u091c\u092a\u093e\u0915\u094d\u0932\u093f\u0915" +
+	"\u091f\u094d\u0930\u0947\u0928\u0916\u093f\u0932\u093e\u092b\u0926\u094c\u0930\u093e\u0928\u092e\u093e\u092e\u0932\u0947\u092e\xe0" +
+	"\xa4\xa4\u0926\u093e\u0928\u092c\u093e\u091c\u093e\u0930\u0935\u093f\u0915\u093e\u0938\u0915\u094d\u092f\u094b\u0902\u091a\u093e\xe0\xa4" +
+	"\xb9\u0924\u0947\u092a\u0939\u0941\u0901\u091a\u092c\u0924\u093e\u092f\u093e\u0938\u0902\u0935\u093e\u0926\u0926\u0947\u0916\u0928" +
+	"\u0947\u092a\u093f\u091b\u0932\u0947\u0935\u093f\u0936\u0947\u0937\u0930\u093e\u091c\u094d\u092f\u0909\u0924\u094d\u0924\u0930\xe0" +
+	"\xa4\xae\u0941\u0902\u092c\u0908\u0926\u094b\u0928\u094b\u0902\u0909\u092a\u0915\u0930\u0923\u092a\u0922\u093c\u0947\u0902\u0938\xe0\xa5" +
+	"\x8d\u0925\u093f\u0924\u092b\u093f\u0932\u094d\u092e\u092e\u0941\u0916\u094d\u092f\u0905\u091a\u094d\u091b\u093e\u091b\u0942\u091f" +
+	"\u0924\u0940\u0938\u0902\u0917\u0940\u0924\u091c\u093e\u090f\u0917\u093e\u0935\u093f\u092d\u093e\u0917\u0918\u0923\u094d\u091f\xe0" +
+	"\xa5\x87\u0926\u0942\u0938\u0930\u0947\u0926\u093f\u0928\u094b\u0902\u0939\u0924\u094d\u092f\u093e\u0938\u0947\u0915\u094d\u0938\xe0\xa4" +
+	"\x97\u093e\u0902\u0927\u0940\u0935\u093f\u0936\u094d\u0935\u0930\u093e\u0924\u0947\u0902\u0926\u0948\u091f\u094d\u0938\u0928\u0915" +
+	"\u094d\u0936\u093e\u0938\u093e\u092e\u0928\u0947\u0905\u0926\u093e\u0932\u0924\u092c\u093f\u091c\u0932\u0940\u092a\u0941\u0930\xe0" +
+	"\xa5\x82\u0937\u0939\u093f\u0902\u0926\u0940\u092e\u093f\u0924\u094d\u0930\u0915\u0935\u093f\u0924\u093e\u0930\u0941\u092a\u092f\xe0\xa5" +
+	"\x87\u0938\u094d\u0925\u093e\u0928\u0915\u0930\u094b\u0921\u093c\u092e\u0941\u0915\u094d\u0924\u092f\u094b\u091c\u0928\u093e\u0915" +
+	"\u0943\u092a\u092f\u093e\u092a\u094b\u0938\u094d\u091f\u0918\u0930\u0947\u0932\u0942\u0915\u093e\u0930\u094d\u092f\u0935\u093f\xe0" +
+	"\xa4\x9a\u093e\u0930\u0938\u0942\u091a\u0928\u093e\u092e\u0942\u0932\u094d\u092f\u0926\u0947\u0916\u0947\u0902\u0939\u092e\u0947\xe0\xa4" +
+	"\xb6\u093e\u0938\u094d\u0915\u0942\u0932\u092e\u0948\u0902\u0928\u0947\u0924\u0948\u092f\u093e\u0930\u091c\u093f\u0938\u0915\u0947" +
+	"rss+xml\" title=\"-type\" content=\"title\" content=\"at the same time" +
+	".js\"></script>\n<\" method=\"post\" </span></a></li>vertical-align:t" +
+	"/jquery.min.js\">.click(function( style=\"padding-})();\n</script>\n" +
+	"</span><a href=\"<a href=\"http://); return false;text-decoration:" +
+	" scrolling=\"no\" border-collapse:associated with Bahasa Indonesia" +
+	"English language<text xml:space=.gif\" border=\"0\"</body>\n</html>\n" +
+	"overflow:hidden;img src=\"http://addEventListenerresponsible for " +
+	"s.js\"></script>\n/favicon.ico\" />operating system\" style=\"width:1" +
+	"target=\"_blank\">State Universitytext-align:left;\ndocument.write(" +
+	", including the around the world);\r\n</script>\r\n<\" style=\"height:" +
+	";overflow:hiddenmore informationan internationala member of the " +
+	"one of the firstcan be found in </div>\n\t\t</div>\ndisplay: none;\">" +
+	"\" />\n<link rel=\"\n  (function() {the 15th century.preventDefault(" +
+	"large number of Byzantine Empire.jpg|thumb|left|vast majority of" +
+	"majority of the  align=\"center\">University Pressdominated by the" +
+	"Second World Wardistribution of style=\"position:the rest of the " +
+	"characterized by rel=\"nofollow\">derives from therather than the " +
+	"a combination ofstyle=\"width:100English-speakingcomputer science" +
+	"border=\"0\" alt=\"the existence ofDemocratic Party\" style=\"margin-" +
+	"For this reason,.js\"></script>\n\tsByTagName(s)[0]js\"></script>\r\n<" +
+	".js\"></script>\r\nlink rel=\"icon\" ' alt='' class='formation of the" +
+	"versions of the </a></div></div>/page>\n  <page>\n<div class=\"cont" +
+	"became the firstbahasa Indonesiaenglish (simple)\u0395\u03bb\u03bb\u03b7\u03bd\u03b9\u03ba\u03ac" +
+	"\u0445\u0440\u0432\u0430\u0442\u0441\u043a\u0438\u043a\u043e\u043c\u043f\u0430\u043d\u0438\u0438\u044f\u0432\u043b\u044f\u0435\u0442\u0441\u044f\u0414\u043e\u0431\u0430\u0432\u0438\u0442\u044c" +
+	"\u0447\u0435\u043b\u043e\u0432\u0435\u043a\u0430\u0440\u0430\u0437\u0432\u0438\u0442\u0438\u044f\u0418\u043d\u0442\u0435\u0440\u043d\u0435\u0442\u041e\u0442\u0432\u0435\u0442\u0438\u0442\u044c" +
+	"\u043d\u0430\u043f\u0440\u0438\u043c\u0435\u0440\u0438\u043d\u0442\u0435\u0440\u043d\u0435\u0442\u043a\u043e\u0442\u043e\u0440\u043e\u0433\u043e\u0441\u0442\u0440\u0430\u043d\u0438\u0446\u044b" +
+	"\u043a\u0430\u0447\u0435\u0441\u0442\u0432\u0435\u0443\u0441\u043b\u043e\u0432\u0438\u044f\u0445\u043f\u0440\u043e\u0431\u043b\u0435\u043c\u044b\u043f\u043e\u043b\u0443\u0447\u0438\u0442\u044c" +
+	"\u044f\u0432\u043b\u044f\u044e\u0442\u0441\u044f\u043d\u0430\u0438\u0431\u043e\u043b\u0435\u0435\u043a\u043e\u043c\u043f\u0430\u043d\u0438\u044f\u0432\u043d\u0438\u043c\u0430\u043d\u0438\u0435" +
+	"\u0441\u0440\u0435\u0434\u0441\u0442\u0432\u0430\u0627\u0644\u0645\u0648\u0627\u0636\u064a\u0639\u0627\u0644\u0631\u0626\u064a\u0633\u064a\u0629\u0627\u0644\u0627\u0646\u062a\u0642\u0627\u0644" +
+	"\u0645\u0634\u0627\u0631\u0643\u0627\u062a\u0643\u0627\u0644\u0633\u064a\u0627\u0631\u0627\u062a\u0627\u0644\u0645\u0643\u062a\u0648\u0628\u0629\u0627\u0644\u0633\u0639\u0648\u062f\u064a\u0629" +
+	"\u0627\u062d\u0635\u0627\u0626\u064a\u0627\u062a\u0627\u0644\u0639\u0627\u0644\u0645\u064a\u0629\u0627\u0644\u0635\u0648\u062a\u064a\u0627\u062a\u0627\u0644\u0627\u0646\u062a\u0631\u0646\u062a" +
+	"\u0627\u0644\u062a\u0635\u0627\u0645\u064a\u0645\u0627\u0644\u0625\u0633\u0644\u0627\u0645\u064a\u0627\u0644\u0645\u0634\u0627\u0631\u0643\u0629\u0627\u0644\u0645\u0631\u0626\u064a\u0627\u062a" +
+	"robots\" content=\"<div id=\"footer\">the United States<img src=\"htt" +
+	"p://.jpg|right|thumb|.js\"></script>\r\n<location.protocolframebord" +
+	"er=\"0\" s\" />\n<meta name=\"</a></div></div><font-weight:bold;&quot" +
+	"; and &quot;depending on the margin:0;padding:\" rel=\"nofollow\" P" +
+	"resident of the twentieth centuryevision>\n  </pageInternet Explo" +
+	"rera.async = true;\r\ninformation about<div id=\"header\">\" action=\"" +
+	"http://<a href=\"https://<div id=\"content\"</div>\r\n</div>\r\n<derive" +
+	"d from the <img src='http://according to the \n</body>\n</html>\nst" +
+	"yle=\"font-size:script language=\"Arial, Helvetica,</a><span class" +
+	"=\"</script><script political partiestd></tr></table><href=\"http:" +
+	"//www.interpretation ofrel=\"stylesheet\" document.write('<charset" +
+	"=\"utf-8\">\nbeginning of the revealed that thetelevision series\" r" +
+	"el=\"nofollow\"> target=\"_blank\">claiming that thehttp%3A%2F%2Fwww" +
+	".manifestations ofPrime Minister ofinfluenced by theclass=\"clear" +
+	"fix\">/div>\r\n</div>\r\n\r\nthree-dimensionalChurch of Englandof North" +
+	" Carolinasquare kilometres.addEventListenerdistinct from thecomm" +
+	"only known asPhonetic Alphabetdeclared that thecontrolled by the" +
+	"Benjamin Franklinrole-playing gamethe University ofin Western Eu" +
+	"ropepersonal computerProject Gutenbergregardless of thehas been " +
+	"proposedtogether with the></li><li class=\"in some countriesmin.j" +
+	"s\"></script>of the populationofficial language<img src=\"images/i" +
+	"dentified by thenatural resourcesclassification ofcan be conside" +
+	"redquantum mechanicsNevertheless, themillion years ago</body>\r\n<" +
+	"/html>\r\u0395\u03bb\u03bb\u03b7\u03bd\u03b9\u03ba\u03ac\ntake advantage ofand, according toattrib" +
+	"uted to theMicrosoft Windowsthe first centuryunder the controldi" +
+	"v class=\"headershortly after thenotable exceptiontens of thousan" +
+	"dsseveral differentaround the world.reaching militaryisolated fr" +
+	"om theopposition to thethe Old TestamentAfrican Americansinserte" +
+	"d into theseparate from themetropolitan areamakes it possibleack" +
+	"nowledged thatarguably the mosttype=\"text/css\">\nthe Internationa" +
+	"lAccording to the pe=\"text/css\" />\ncoincide with thetwo-thirds o" +
+	"f theDuring this time,during the periodannounced that hethe inte" +
+	"rnationaland more recentlybelieved that theconsciousness andform" +
+	"erly known assurrounded by thefirst appeared inoccasionally used" +
+	"position:absolute;\" target=\"_blank\" position:relative;text-align" +
+	":center;jax/libs/jquery/1.background-color:#type=\"application/an" +
+	"guage\" content=\"<meta http-equiv=\"Privacy Policy</a>e(\"%3Cscript" +
+	" src='\" target=\"_blank\">On the other hand,.jpg|thumb|right|2</di" +
+	"v><div class=\"<div style=\"float:nineteenth century</body>\r\n</htm" +
+	"l>\r\n<img src=\"http://s;text-align:centerfont-weight: bold; Accor" +
+	"ding to the difference between\" frameborder=\"0\" \" style=\"positio" +
+	"n:link href=\"http://html4/loose.dtd\">\nduring this period</td></t" +
+	"r></table>closely related tofor the first time;font-weight:bold;" +
+	"input type=\"text\" <span style=\"font-onreadystatechange\t<div clas" +
+	"s=\"cleardocument.location. For example, the a wide variety of <!" +
+	"DOCTYPE html>\r\n<&nbsp;&nbsp;&nbsp;\"><a href=\"http://style=\"float" +
+	":left;concerned with the=http%3A%2F%2Fwww.in popular culturetype" +
+	"=\"text/css\" />it is possible to Harvard Universitytylesheet\" hre" +
+	"f=\"/the main characterOxford University  name=\"keywords\" cstyle=" +
+	"\"text-align:the United Kingdomfederal government<div style=\"marg" +
+	"in depending on the description of the<div class=\"header.min.js\"" +
+	"></script>destruction of theslightly differentin accordance with" +
+	"telecommunicationsindicates that theshortly thereafterespecially" +
+	" in the European countriesHowever, there aresrc=\"http://staticsu" +
+	"ggested that the\" src=\"http://www.a large number of Telecommunic" +
+	"ations\" rel=\"nofollow\" tHoly Roman Emperoralmost exclusively\" bo" +
+	"rder=\"0\" alt=\"Secretary of Stateculminating in theCIA World Fact" +
+	"bookthe most importantanniversary of thestyle=\"background-<li><e" +
+	"m><a href=\"/the Atlantic Oceanstrictly speaking,shortly before t" +
+	"hedifferent types ofthe Ottoman Empire><img src=\"http://An Intro" +
+	"duction toconsequence of thedeparture from theConfederate States" +
+	"indigenous peoplesProceedings of theinformation on thetheories h" +
+	"ave beeninvolvement in thedivided into threeadjacent countriesis" +
+	" responsible fordissolution of thecollaboration withwidely regar" +
+	"ded ashis contemporariesfounding member ofDominican Republicgene" +
+	"rally acceptedthe possibility ofare also availableunder construc" +
+	"tionrestoration of thethe general publicis almost entirelypasses" +
+	" through thehas been suggestedcomputer and videoGermanic languag" +
+	"es according to the different from theshortly afterwardshref=\"ht" +
+	"tps://www.recent developmentBoard of Directors<div class=\"search" +
+	"| <a href=\"http://In particular, theMultiple footnotesor other s" +
+	"ubstancethousands of yearstranslation of the</div>\r\n</div>\r\n\r\n<a" +
+	" href=\"index.phpwas established inmin.js\"></script>\nparticipate " +
+	"in thea strong influencestyle=\"margin-top:represented by thegrad" +
+	"uated from theTraditionally, theElement(\"script\");However, since" +
+	" the/div>\n</div>\n<div left; margin-left:protection against0; ver" +
+	"tical-align:Unfortunately, thetype=\"image/x-icon/div>\n<div class" +
+	"=\" class=\"clearfix\"><div class=\"footer\t\t</div>\n\t\t</div>\nthe moti" +
+	"on picture\u0411\u044a\u043b\u0433\u0430\u0440\u0441\u043a\u0438\u0431\u044a\u043b\u0433\u0430\u0440\u0441\u043a\u0438\u0424\u0435\u0434\u0435\u0440\u0430\u0446\u0438\u0438" +
+	"\u043d\u0435\u0441\u043a\u043e\u043b\u044c\u043a\u043e\u0441\u043e\u043e\u0431\u0449\u0435\u043d\u0438\u0435\u0441\u043e\u043e\u0431\u0449\u0435\u043d\u0438\u044f\u043f\u0440\u043e\u0433\u0440" +
+	"\u0430\u043c\u043c\u044b\u041e\u0442\u043f\u0440\u0430\u0432\u0438\u0442\u044c\u0431\u0435\u0441\u043f\u043b\u0430\u0442\u043d\u043e\u043c\u0430\u0442\u0435\u0440\u0438\u0430\u043b\u044b\u043f" +
+	"\u043e\u0437\u0432\u043e\u043b\u044f\u0435\u0442\u043f\u043e\u0441\u043b\u0435\u0434\u043d\u0438\u0435\u0440\u0430\u0437\u043b\u0438\u0447\u043d\u044b\u0445\u043f\u0440\u043e\u0434\u0443\u043a" +
+	"\u0446\u0438\u0438\u043f\u0440\u043e\u0433\u0440\u0430\u043c\u043c\u0430\u043f\u043e\u043b\u043d\u043e\u0441\u0442\u044c\u044e\u043d\u0430\u0445\u043e\u0434\u0438\u0442\u0441\u044f\u0438\u0437" +
+	"\u0431\u0440\u0430\u043d\u043d\u043e\u0435\u043d\u0430\u0441\u0435\u043b\u0435\u043d\u0438\u044f\u0438\u0437\u043c\u0435\u043d\u0435\u043d\u0438\u044f\u043a\u0430\u0442\u0435\u0433\u043e\u0440" +
+	"\u0438\u0438\u0410\u043b\u0435\u043a\u0441\u0430\u043d\u0434\u0440\u0926\u094d\u0935\u093e\u0930\u093e\u092e\u0948\u0928\u0941\u0905\u0932\u092a\u094d" +
+	"\u0930\u0926\u093e\u0928\u092d\u093e\u0930\u0924\u0940\u092f\u0905\u0928\u0941\u0926\u0947\u0936\u0939\u093f\u0928\u094d\u0926\xe0" +
+	"\xa5\x80\u0907\u0902\u0921\u093f\u092f\u093e\u0926\u093f\u0932\u094d\u0932\u0940\u0905\u0927\u093f\u0915\u093e\u0930\u0935\u0940\xe0\xa4" +
+	"\xa1\u093f\u092f\u094b\u091a\u093f\u091f\u094d\u0920\u0947\u0938\u092e\u093e\u091a\u093e\u0930\u091c\u0902\u0915\u094d\u0936\u0928" +
+	"\u0926\u0941\u0928\u093f\u092f\u093e\u092a\u094d\u0930\u092f\u094b\u0917\u0905\u0928\u0941\u0938\u093e\u0930\u0911\u0928\u0932\xe0" +
+	"\xa4\xbe\u0907\u0928\u092a\u093e\u0930\u094d\u091f\u0940\u0936\u0930\u094d\u0924\u094b\u0902\u0932\u094b\u0915\u0938\u092d\u093e\xe0\xa4" +
+	"\xab\u093c\u094d\u0932\u0948\u0936\u0936\u0930\u094d\u0924\u0947\u0902\u092a\u094d\u0930\u0926\u0947\u0936\u092a\u094d\u0932\u0947" +
+	"\u092f\u0930\u0915\u0947\u0902\u0926\u094d\u0930\u0938\u094d\u0925\u093f\u0924\u093f\u0909\u0924\u094d\u092a\u093e\u0926\u0909\xe0" +
+	"\xa4\xa8\u094d\u0939\u0947\u0902\u091a\u093f\u091f\u094d\u0920\u093e\u092f\u093e\u0924\u094d\u0930\u093e\u091c\u094d\u092f\u093e\xe0\xa4" +
+	"\xa6\u093e\u092a\u0941\u0930\u093e\u0928\u0947\u091c\u094b\u0921\u093c\u0947\u0902\u0905\u0928\u0941\u0935\u093e\u0926\u0936\u094d" +
+	"\u0930\u0947\u0923\u0940\u0936\u093f\u0915\u094d\u0937\u093e\u0938\u0930\u0915\u093e\u0930\u0940\u0938\u0902\u0917\u094d\u0930\xe0" +
+	"\xa4\xb9\u092a\u0930\u093f\u0923\u093e\u092e\u092c\u094d\u0930\u093e\u0902\u0921\u092c\u091a\u094d\u091a\u094b\u0902\u0909\u092a\xe0\xa4" +
+	"\xb2\u092c\u094d\u0927\u092e\u0902\u0924\u094d\u0930\u0940\u0938\u0902\u092a\u0930\u094d\u0915\u0909\u092e\u094d\u092e\u0940\u0926" +
+	"\u092e\u093e\u0927\u094d\u092f\u092e\u0938\u0939\u093e\u092f\u0924\u093e\u0936\u092c\u094d\u0926\u094b\u0902\u092e\u0940\u0921\xe0" +
+	"\xa4\xbf\u092f\u093e\u0906\u0908\u092a\u0940\u090f\u0932\u092e\u094b\u092c\u093e\u0907\u0932\u0938\u0902\u0916\u094d\u092f\u093e\xe0\xa4" +
+	"\x86\u092a\u0930\u0947\u0936\u0928\u0905\u0928\u0941\u092c\u0902\u0927\u092c\u093e\u091c\u093c\u093e\u0930\u0928\u0935\u0940\u0928" +
+	"\u0924\u092e\u092a\u094d\u0930\u092e\u0941\u0916\u092a\u094d\u0930\u0936\u094d\u0928\u092a\u0930\u093f\u0935\u093e\u0930\u0928\xe0" +
+	"\xa5\x81\u0915\u0938\u093e\u0928\u0938\u092e\u0930\u094d\u0925\u0928\u0906\u092f\u094b\u091c\u093f\u0924\u0938\u094b\u092e\u0935\xe0\xa4" +
+	"\xbe\u0930\u0627\u0644\u0645\u0634\u0627\u0631\u0643\u0627\u062a\u0627\u0644\u0645\u0646\u062a\u062f\u064a\u0627\u062a\u0627\u0644\u0643\u0645\u0628\u064a\u0648\u062a\u0631\u0627\u0644\u0645" +
+	"\u0634\u0627\u0647\u062f\u0627\u062a\u0639\u062f\u062f\u0627\u0644\u0632\u0648\u0627\u0631\u0639\u062f\u062f\u0627\u0644\u0631\u062f\u0648\u062f\u0627\u0644\u0625\u0633\u0644\u0627\u0645\u064a" +
+	"\u0629\u0627\u0644\u0641\u0648\u062a\u0648\u0634\u0648\u0628\u0627\u0644\u0645\u0633\u0627\u0628\u0642\u0627\u062a\u0627\u0644\u0645\u0639\u0644\u0648\u0645\u0627\u062a\u0627\u0644\u0645\u0633" +
+	"\u0644\u0633\u0644\u0627\u062a\u0627\u0644\u062c\u0631\u0627\u0641\u064a\u0643\u0633\u0627\u0644\u0627\u0633\u0644\u0627\u0645\u064a\u0629\u0627\u0644\u0627\u062a\u0635\u0627\u0644\u0627\u062a" +
+	"keywords\" content=\"w3.org/1999/xhtml\"><a target=\"_blank\" text/ht" +
+	"ml; charset=\" target=\"_blank\"><table cellpadding=\"autocomplete=\"" +
+	"off\" text-align: center;to last version by background-color: #\" " +
+	"href=\"http://www./div></div><div id=<a href=\"#\" class=\"\"><img sr" +
+	"c=\"http://cript\" src=\"http://\n<script language=\"//EN\" \"http://ww" +
+	"w.wencodeURIComponent(\" href=\"javascript:<div class=\"contentdocu" +
+	"ment.write('<scposition: absolute;script src=\"http:// style=\"mar" +
+	"gin-top:.min.js\"></script>\n</div>\n<div class=\"w3.org/1999/xhtml\"" +
+	" \n\r\n</body>\r\n</html>distinction between/\" target=\"_blank\"><link " +
+	"href=\"http://encoding=\"utf-8\"?>\nw.addEventListener?action=\"http:" +
+	"//www.icon\" href=\"http:// style=\"background:type=\"text/css\" />\nm" +
+	"eta property=\"og:t<input type=\"text\"  style=\"text-align:the deve" +
+	"lopment of tylesheet\" type=\"tehtml; charset=utf-8is considered t" +
+	"o betable width=\"100%\" In addition to the contributed to the dif" +
+	"ferences betweendevelopment of the It is important to </script>\n" +
+	"\n<script  style=\"font-size:1></span><span id=gbLibrary of Congre" +
+	"ss<img src=\"http://imEnglish translationAcademy of Sciencesdiv s" +
+	"tyle=\"display:construction of the.getElementById(id)in conjuncti" +
+	"on withElement('script'); <meta property=\"og:\u0411\u044a\u043b\u0433\u0430\u0440\u0441\u043a\u0438\n" +
+	" type=\"text\" name=\">Privacy Policy</a>administered by theenableS" +
+	"ingleRequeststyle=&quot;margin:</div></div></div><><img src=\"htt" +
+	"p://i style=&quot;float:referred to as the total population ofin" +
+	" Washington, D.C. style=\"background-among other things,organizat" +
+	"ion of theparticipated in thethe introduction ofidentified with " +
+	"thefictional character Oxford University misunderstanding ofTher" +
+	"e are, however,stylesheet\" href=\"/Columbia Universityexpanded to" +
+	" includeusually referred toindicating that thehave suggested tha" +
+	"taffiliated with thecorrelation betweennumber of different></td>" +
+	"</tr></table>Republic of Ireland\n</script>\n<script under the inf" +
+	"luencecontribution to theOfficial website ofheadquarters of thec" +
+	"entered around theimplications of thehave been developedFederal " +
+	"Republic ofbecame increasinglycontinuation of theNote, however, " +
+	"thatsimilar to that of capabilities of theaccordance with thepar" +
+	"ticipants in thefurther developmentunder the directionis often c" +
+	"onsideredhis younger brother</td></tr></table><a http-equiv=\"X-U" +
+	"A-physical propertiesof British Columbiahas been criticized(with" +
+	" the exceptionquestions about thepassing through the0\" cellpaddi" +
+	"ng=\"0\" thousands of peopleredirects here. Forhave children under" +
+	"%3E%3C/script%3E\"));<a href=\"http://www.<li><a href=\"http://site" +
+	"_name\" content=\"text-decoration:nonestyle=\"display: none<meta ht" +
+	"tp-equiv=\"X-new Date().getTime() type=\"image/x-icon\"</span><span" +
+	" class=\"language=\"javascriptwindow.location.href<a href=\"javascr" +
+	"ipt:-->\r\n<script type=\"t<a href='http://www.hortcut icon\" href=\"" +
+	"</div>\r\n<div class=\"<script src=\"http://\" rel=\"stylesheet\" t</di" +
+	"v>\n<script type=/a> <a href=\"http:// allowTransparency=\"X-UA-Com" +
+	"patible\" conrelationship between\n</script>\r\n<script </a></li></u" +
+	"l></div>associated with the programming language</a><a href=\"htt" +
+	"p://</a></li><li class=\"form action=\"http://<div style=\"display:" +
+	"type=\"text\" name=\"q\"<table width=\"100%\" background-position:\" bo" +
+	"rder=\"0\" width=\"rel=\"shortcut icon\" h6><ul><li><a href=\"  <meta " +
+	"http-equiv=\"css\" media=\"screen\" responsible for the \" type=\"appl" +
+	"ication/\" style=\"background-html; charset=utf-8\" allowtransparen" +
+	"cy=\"stylesheet\" type=\"te\r\n<meta http-equiv=\"></span><span class=" +
+	"\"0\" cellspacing=\"0\">;\n</script>\n<script sometimes called thedoes" +
+	" not necessarilyFor more informationat the beginning of <!DOCTYP" +
+	"E html><htmlparticularly in the type=\"hidden\" name=\"javascript:v" +
+	"oid(0);\"effectiveness of the autocomplete=\"off\" generally consid" +
+	"ered><input type=\"text\" \"></script>\r\n<scriptthroughout the world" +
+	"common misconceptionassociation with the</div>\n</div>\n<div cduri" +
+	"ng his lifetime,corresponding to thetype=\"image/x-icon\" an incre" +
+	"asing numberdiplomatic relationsare often consideredmeta charset" +
+	"=\"utf-8\" <input type=\"text\" examples include the\"><img src=\"http" +
+	"://iparticipation in thethe establishment of\n</div>\n<div class=\"" +
+	"&amp;nbsp;&amp;nbsp;to determine whetherquite different frommark" +
+	"ed the beginningdistance between thecontributions to theconflict" +
+	" between thewidely considered towas one of the firstwith varying" +
+	" degreeshave speculated that(document.getElementparticipating in" +
+	" theoriginally developedeta charset=\"utf-8\"> type=\"text/css\" />\n" +
+	"interchangeably withmore closely relatedsocial and politicalthat" +
+	" would otherwiseperpendicular to thestyle type=\"text/csstype=\"su" +
+	"bmit\" name=\"families residing indeveloping countriescomputer pro" +
+	"grammingeconomic developmentdetermination of thefor more informa" +
+	"tionon several occasionsportugu\u00eas (Europeu)\u0423\u043a\u0440\u0430\u0457\u043d\u0441\u044c\u043a\u0430" +
+	"\u0443\u043a\u0440\u0430\u0457\u043d\u0441\u044c\u043a\u0430\u0420\u043e\u0441\u0441\u0438\u0439\u0441\u043a\u043e\u0439\u043c\u0430\u0442\u0435\u0440\u0438\u0430\u043b\u043e\u0432\u0438\u043d" +
+	"\u0444\u043e\u0440\u043c\u0430\u0446\u0438\u0438\u0443\u043f\u0440\u0430\u0432\u043b\u0435\u043d\u0438\u044f\u043d\u0435\u043e\u0431\u0445\u043e\u0434\u0438\u043c\u043e\u0438\u043d\u0444\u043e" +
+	"\u0440\u043c\u0430\u0446\u0438\u044f\u0418\u043d\u0444\u043e\u0440\u043c\u0430\u0446\u0438\u044f\u0420\u0435\u0441\u043f\u0443\u0431\u043b\u0438\u043a\u0438\u043a\u043e\u043b\u0438\u0447\u0435" +
+	"\u0441\u0442\u0432\u043e\u0438\u043d\u0444\u043e\u0440\u043c\u0430\u0446\u0438\u044e\u0442\u0435\u0440\u0440\u0438\u0442\u043e\u0440\u0438\u0438\u0434\u043e\u0441\u0442\u0430\u0442\u043e\u0447" +
+	"\u043d\u043e\u0627\u0644\u0645\u062a\u0648\u0627\u062c\u062f\u0648\u0646\u0627\u0644\u0627\u0634\u062a\u0631\u0627\u0643\u0627\u062a\u0627\u0644\u0627\u0642\u062a\u0631\u0627\u062d\u0627\u062a" +
+	"html; charset=UTF-8\" setTimeout(function()display:inline-block;<" +
+	"input type=\"submit\" type = 'text/javascri<img src=\"http://www.\" " +
+	"\"http://www.w3.org/shortcut icon\" href=\"\" autocomplete=\"off\" </a" +
+	"></div><div class=</a></li>\n<li class=\"css\" type=\"text/css\" <for" +
+	"m action=\"http://xt/css\" href=\"http://link rel=\"alternate\" \r\n<sc" +
+	"ript type=\"text/ onclick=\"javascript:(new Date).getTime()}height" +
+	"=\"1\" width=\"1\" People's Republic of  <a href=\"http://www.text-de" +
+	"coration:underthe beginning of the </div>\n</div>\n</div>\nestablis" +
+	"hment of the </div></div></div></d#viewport{min-height:\n<script " +
+	"src=\"http://option><option value=often referred to as /option>\n<" +
+	"option valu<!DOCTYPE html>\n<!--[International Airport>\n<a href=\"" +
+	"http://www</a><a href=\"http://w\u0e20\u0e32\u0e29\u0e32\u0e44\u0e17\u0e22\u10e5\u10d0\u10e0\u10d7" +
+	"\u10e3\u10da\u10d8\u6b63\u9ad4\u4e2d\u6587 (\u7e41\u9ad4)\u0928\u093f\u0930\u094d\u0926\u0947\u0936\u0921\u093e\u0909\u0928\xe0" +
+	"\xa4\xb2\u094b\u0921\u0915\u094d\u0937\u0947\u0924\u094d\u0930\u091c\u093e\u0928\u0915\u093e\u0930\u0940\u0938\u0902\u092c\u0902\xe0\xa4" +
+	"\xa7\u093f\u0924\u0938\u094d\u0925\u093e\u092a\u0928\u093e\u0938\u094d\u0935\u0940\u0915\u093e\u0930\u0938\u0902\u0938\u094d\u0915" +
+	"\u0930\u0923\u0938\u093e\u092e\u0917\u094d\u0930\u0940\u091a\u093f\u091f\u094d\u0920\u094b\u0902\u0935\u093f\u091c\u094d\u091e\xe0" +
+	"\xa4\xbe\u0928\u0905\u092e\u0947\u0930\u093f\u0915\u093e\u0935\u093f\u092d\u093f\u0928\u094d\u0928\u0917\u093e\u0921\u093f\u092f\xe0\xa4" +
+	"\xbe\u0901\u0915\u094d\u092f\u094b\u0902\u0915\u093f\u0938\u0941\u0930\u0915\u094d\u0937\u093e\u092a\u0939\u0941\u0901\u091a\u0924" +
+	"\u0940\u092a\u094d\u0930\u092c\u0902\u0927\u0928\u091f\u093f\u092a\u094d\u092a\u0923\u0940\u0915\u094d\u0930\u093f\u0915\u0947\xe0" +
+	"\xa4\x9f\u092a\u094d\u0930\u093e\u0930\u0902\u092d\u092a\u094d\u0930\u093e\u092a\u094d\u0924\u092e\u093e\u0932\u093f\u0915\u094b\xe0\xa4" +
+	"\x82\u0930\u092b\u093c\u094d\u0924\u093e\u0930\u0928\u093f\u0930\u094d\u092e\u093e\u0923\u0932\u093f\u092e\u093f\u091f\u0947\u0921" +
+	"description\" content=\"document.location.prot.getElementsByTagNam" +
+	"e(<!DOCTYPE html>\n<html <meta charset=\"utf-8\">:url\" content=\"htt" +
+	"p://.css\" rel=\"stylesheet\"style type=\"text/css\">type=\"text/css\" " +
+	"href=\"w3.org/1999/xhtml\" xmltype=\"text/javascript\" method=\"get\" " +
+	"action=\"link rel=\"stylesheet\"  = document.getElementtype=\"image/" +
+	"x-icon\" />cellpadding=\"0\" cellsp.css\" type=\"text/css\" </a></li><" +
+	"li><a href=\"\" width=\"1\" height=\"1\"\"><a href=\"http://www.style=\"d" +
+	"isplay:none;\">alternate\" type=\"appli-//W3C//DTD XHTML 1.0 ellspa" +
+	"cing=\"0\" cellpad type=\"hidden\" value=\"/a>&nbsp;<span role=\"s\n<in" +
+	"put type=\"hidden\" language=\"JavaScript\"  document.getElementsBg=" +
+	"\"0\" cellspacing=\"0\" ype=\"text/css\" media=\"type='text/javascript'" +
+	"with the exception of ype=\"text/css\" rel=\"st height=\"1\" width=\"1" +
+	"\" ='+encodeURIComponent(<link rel=\"alternate\" \nbody, tr, input, " +
+	"textmeta name=\"robots\" conmethod=\"post\" action=\">\n<a href=\"http:" +
+	"//www.css\" rel=\"stylesheet\" </div></div><div classlanguage=\"java" +
+	"script\">aria-hidden=\"true\">\u00b7<ript\" type=\"text/javasl=0;})();\n(f" +
+	"unction(){background-image: url(/a></li><li><a href=\"h\t\t<li><a h" +
+	"ref=\"http://ator\" aria-hidden=\"tru> <a href=\"http://www.language" +
+	"=\"javascript\" /option>\n<option value/div></div><div class=rator\"" +
+	" aria-hidden=\"tre=(new Date).getTime()portugu\u00eas (do Brasil)\u043e\u0440" +
+	"\u0433\u0430\u043d\u0438\u0437\u0430\u0446\u0438\u0438\u0432\u043e\u0437\u043c\u043e\u0436\u043d\u043e\u0441\u0442\u044c\u043e\u0431\u0440\u0430\u0437\u043e\u0432\u0430\u043d\u0438\u044f\u0440" +
+	"\u0435\u0433\u0438\u0441\u0442\u0440\u0430\u0446\u0438\u0438\u0432\u043e\u0437\u043c\u043e\u0436\u043d\u043e\u0441\u0442\u0438\u043e\u0431\u044f\u0437\u0430\u0442\u0435\u043b\u044c\u043d\u0430" +
+	"<!DOCTYPE html PUBLIC \"nt-Type\" content=\"text/<meta http-equiv=\"" +
+	"Conteransitional//EN\" \"http:<html xmlns=\"http://www-//W3C//DTD X" +
+	"HTML 1.0 TDTD/xhtml1-transitional//www.w3.org/TR/xhtml1/pe = 'te" +
+	"xt/javascript';<meta name=\"descriptionparentNode.insertBefore<in" +
+	"put type=\"hidden\" najs\" type=\"text/javascri(document).ready(func" +
+	"tiscript type=\"text/javasimage\" content=\"http://UA-Compatible\" c" +
+	"ontent=tml; charset=utf-8\" />\nlink rel=\"shortcut icon<link rel=\"" +
+	"stylesheet\" </script>\n<script type== document.createElemen<a tar" +
+	"get=\"_blank\" href= document.getElementsBinput type=\"text\" name=a" +
+	".type = 'text/javascrinput type=\"hidden\" namehtml; charset=utf-8" +
+	"\" />dtd\">\n<html xmlns=\"http-//W3C//DTD HTML 4.01 TentsByTagName(" +
+	"'script')input type=\"hidden\" nam<script type=\"text/javas\" style=" +
+	"\"display:none;\">document.getElementById(=document.createElement(" +
+	"' type='text/javascript'input type=\"text\" name=\"d.getElementsByT" +
+	"agName(snical\" href=\"http://www.C//DTD HTML 4.01 Transit<style t" +
+	"ype=\"text/css\">\n\n<style type=\"text/css\">ional.dtd\">\n<html xmlns=" +
+	"http-equiv=\"Content-Typeding=\"0\" cellspacing=\"0\"html; charset=ut" +
+	"f-8\" />\n style=\"display:none;\"><<li><a href=\"http://www. type='t" +
+	"ext/javascript'>\u0434\u0435\u044f\u0442\u0435\u043b\u044c\u043d\u043e\u0441\u0442\u0438\u0441\u043e\u043e\u0442\u0432\u0435\u0442\u0441\u0442\u0432\u0438\u0438" +
+	"\u043f\u0440\u043e\u0438\u0437\u0432\u043e\u0434\u0441\u0442\u0432\u0430\u0431\u0435\u0437\u043e\u043f\u0430\u0441\u043d\u043e\u0441\u0442\u0438\u092a\u0941\u0938\u094d\u0924\xe0" +
+	"\xa4\xbf\u0915\u093e\u0915\u093e\u0902\u0917\u094d\u0930\u0947\u0938\u0909\u0928\u094d\u0939\u094b\u0902\u0928\u0947\u0935\u093f\xe0\xa4" +
+	"\xa7\u093e\u0928\u0938\u092d\u093e\u092b\u093f\u0915\u094d\u0938\u093f\u0902\u0917\u0938\u0941\u0930\u0915\u094d\u0937\u093f\u0924" +
+	"\u0915\u0949\u092a\u0940\u0930\u093e\u0907\u091f\u0935\u093f\u091c\u094d\u091e\u093e\u092a\u0928\u0915\u093e\u0930\u094d\u0930\xe0" +
+	"\xa4\xb5\u093e\u0908\u0938\u0915\u094d\u0930\u093f\u092f\u0924\u093e"
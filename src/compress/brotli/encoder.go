@@ -0,0 +1,400 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package brotli
+
+import (
+	"math/bits"
+)
+
+// levelParams are the parameters of a compression level.
+type levelParams struct {
+	windowLog uint8 // log of the window size
+	hashLog   uint8 // log of the hash table size
+	chainLog  uint8 // log of the hash chain size, 0 for none
+	depth     int   // number of candidates to examine
+	lazy      int   // number of following positions to try for a better match
+	nice      int   // stop searching after a match of this length
+	skip      bool  // search less often in incompressible data
+}
+
+var levels = [BestCompression + 1]levelParams{
+	NoCompression: {windowLog: 16},
+	1:             {windowLog: 18, hashLog: 15, depth: 1, nice: 32, skip: true},
+	2:             {windowLog: 20, hashLog: 16, chainLog: 16, depth: 4, nice: 32, skip: true},
+	3:             {windowLog: 20, hashLog: 17, chainLog: 17, depth: 8, lazy: 1, nice: 64},
+	4:             {windowLog: 21, hashLog: 17, chainLog: 18, depth: 16, lazy: 1, nice: 64},
+	5:             {windowLog: 22, hashLog: 18, chainLog: 19, depth: 32, lazy: 1, nice: 128},
+	6:             {windowLog: 22, hashLog: 18, chainLog: 20, depth: 64, lazy: 2, nice: 128},
+	7:             {windowLog: 22, hashLog: 19, chainLog: 21, depth: 128, lazy: 2, nice: 256},
+	8:             {windowLog: 22, hashLog: 20, chainLog: 22, depth: 256, lazy: 2, nice: 256},
+	9:             {windowLog: 22, hashLog: 20, chainLog: 22, depth: 1024, lazy: 2, nice: 1024},
+}
+
+// maxMetaBlockSize is the largest amount of data the encoder puts
+// in a meta-block. The format allows up to 1<<24 bytes.
+const maxMetaBlockSize = 256 << 10
+
+// numDistSymbols is the size of the distance alphabet the encoder
+// uses, with no postfix bits and no direct distance codes.
+const numDistSymbols = numDistShortCodes + 48
+
+// A command is an insert-and-copy command of a meta-block.
+type command struct {
+	insert  uint32
+	copyLen uint32 // 0 for the literals at the end of the meta-block
+	sym     uint16 // insert-and-copy symbol
+	dsym    int16  // distance symbol, or -1 for none
+	dextra  uint32 // extra bits of the distance
+	dbits   uint8
+}
+
+// A metaBlockEncoder turns history and new data into compressed
+// meta-blocks. The history buffer is passed to each call. Positions
+// in it are stable from call to call, except as adjusted by
+// matcher.shift.
+//
+// Each meta-block uses a single prefix code for each of literals,
+// commands and distances; the encoder does not use block switches,
+// context modeling or the static dictionary.
+type metaBlockEncoder struct {
+	p       *levelParams
+	maxDist int
+	m       matcher
+
+	// The last distances, as a decoder will see them after
+	// the meta-blocks encoded so far.
+	dist    [4]int
+	distIdx int
+
+	cmds     []command
+	litCount [numLiteralSymbols]uint32
+	cmdCount [numCommandSymbols]uint32
+	dCount   [numDistSymbols]uint32
+	lit      prefixEncoder
+	cmd      prefixEncoder
+	d        prefixEncoder
+}
+
+// reset prepares e to compress a new stream. sizeHint is the
+// amount of data that will be seen, or 0 if unknown.
+func (e *metaBlockEncoder) reset(p *levelParams, sizeHint int) {
+	e.p = p
+	e.maxDist = 1<<p.windowLog - windowGap
+	e.dist = [4]int{16, 15, 11, 4}
+	e.distIdx = 0
+	if p.hashLog == 0 {
+		return
+	}
+	hashLog, chainLog := p.hashLog, p.chainLog
+	if sizeHint > 0 {
+		// Don't use tables much larger than the input.
+		need := uint8(bits.Len(uint(sizeHint)))
+		hashLog = min(hashLog, max(need, 10))
+		if chainLog > 0 {
+			chainLog = min(chainLog, max(need, 10))
+		}
+	}
+	e.m.reset(hashLog, chainLog)
+}
+
+// encode writes meta-blocks holding src[start:end] to bw.
+// The data before start is history that matches may refer to,
+// no further back than low.
+func (e *metaBlockEncoder) encode(bw *bitWriter, src []byte, low, start, end int) {
+	for start < end {
+		n := min(end-start, maxMetaBlockSize)
+		e.encodeMetaBlock(bw, src[:start+n], low, start)
+		start += n
+	}
+}
+
+// encodeMetaBlock writes a single meta-block holding src[start:].
+func (e *metaBlockEncoder) encodeMetaBlock(bw *bitWriter, src []byte, low, start int) {
+	data := src[start:]
+	if e.p.hashLog == 0 {
+		appendUncompressed(bw, data)
+		return
+	}
+
+	dist, distIdx := e.dist, e.distIdx
+	e.parse(src, low, start)
+
+	mark := bw.mark()
+	appendMetaBlockHeader(bw, len(data), false)
+	e.appendCommands(bw, src[start:])
+	if bw.size(mark) >= len(data)+8 {
+		// The decoder will not see the commands,
+		// so forget their effect on the last distances.
+		e.dist, e.distIdx = dist, distIdx
+		bw.rewind(mark)
+		appendUncompressed(bw, data)
+	}
+}
+
+// parse finds the commands for src[start:], storing them in e.cmds.
+func (e *metaBlockEncoder) parse(src []byte, low, start int) {
+	p := e.p
+	e.cmds = e.cmds[:0]
+
+	anchor := start
+	i := start
+	limit := len(src) - minMatch
+	for i <= limit {
+		lo := max(low, i-e.maxDist)
+		length, pos := e.best(src, i, lo)
+		if length < minMatch {
+			if p.skip {
+				// Skip ahead faster the longer we go without
+				// a match, without indexing the skipped data.
+				i += 1 + (i-anchor)>>6
+				e.m.skip(i)
+			} else {
+				i++
+			}
+			continue
+		}
+
+		// Lazy matching: prefer a match that starts later if it
+		// saves more than the cost of the extra literals.
+		for l := 0; l < p.lazy && i+1 <= limit; l++ {
+			lo := max(low, i+1-e.maxDist)
+			length2, pos2 := e.best(src, i+1, lo)
+			if length2 < minMatch || e.matchGain(length2, i+1-pos2) <= e.matchGain(length, i-pos)+6 {
+				break
+			}
+			i++
+			length, pos = length2, pos2
+		}
+
+		// Extend the match backward.
+		for i > anchor && pos > low && src[i-1] == src[pos-1] {
+			i--
+			pos--
+			length++
+		}
+
+		e.addCommand(i-anchor, length, i-pos)
+		i += length
+		anchor = i
+		if p.skip {
+			// Insert a few positions at the end of the match only.
+			e.m.skip(i - 2)
+		}
+		e.m.insert(src, i)
+	}
+	if anchor < len(src) {
+		e.addCommand(len(src)-anchor, 0, 0)
+	}
+}
+
+// matchGain estimates the number of bits saved by a match.
+func (e *metaBlockEncoder) matchGain(length, d int) int {
+	if length < minMatch {
+		return 0
+	}
+	cost := bits.Len(uint(d))
+	switch d {
+	case e.lastDist(0):
+		cost = 0
+	case e.lastDist(1), e.lastDist(2), e.lastDist(3):
+		cost = 4
+	}
+	return 6*length - cost
+}
+
+// best returns the best match at i, considering the last distance
+// and the matcher candidates at positions no lower than low.
+func (e *metaBlockEncoder) best(src []byte, i, low int) (length, pos int) {
+	length, pos = e.m.find(src, i, low, e.p.depth, e.p.nice)
+	if d := e.lastDist(0); d <= i-low {
+		cand := i - d
+		n := matchLen(src[cand:], src[i:])
+		if e.matchGain(n, d) > e.matchGain(length, i-pos) {
+			length, pos = n, cand
+		}
+	}
+	return length, pos
+}
+
+// lastDist returns the distance used i commands before the last one.
+func (e *metaBlockEncoder) lastDist(i int) int {
+	return e.dist[(e.distIdx-1-i)&3]
+}
+
+// addCommand records a command inserting n literals and copying
+// length bytes from distance d, and updates the last distances the
+// way a decoder does. A length of zero ends the meta-block after the
+// literals.
+func (e *metaBlockEncoder) addCommand(n, length, d int) {
+	c := command{insert: uint32(n), copyLen: uint32(length), dsym: -1}
+	ins := insertCode(n)
+	cp := 0
+	if length > 0 {
+		cp = copyCode(length)
+		c.dsym, c.dextra, c.dbits = e.distanceCode(d)
+	}
+	if c.dsym <= 0 && ins < 8 && cp < 16 {
+		// Use the last distance implicitly.
+		c.sym = uint16(cp>>3<<6 | ins&7<<3 | cp&7)
+		c.dsym = -1
+	} else {
+		cell := explicitCell[ins>>3][cp>>3]
+		c.sym = uint16(cell<<6 | ins&7<<3 | cp&7)
+	}
+	e.cmds = append(e.cmds, c)
+}
+
+// explicitCell holds the insert-and-copy cells that read a distance,
+// indexed by the insert length code and copy length code over 8.
+var explicitCell = [3][3]int{
+	{2, 3, 6},
+	{4, 5, 8},
+	{7, 9, 10},
+}
+
+func insertCode(n int) int {
+	c := len(insertBase) - 1
+	for int(insertBase[c]) > n {
+		c--
+	}
+	return c
+}
+
+func copyCode(n int) int {
+	c := len(copyBase) - 1
+	for int(copyBase[c]) > n {
+		c--
+	}
+	return c
+}
+
+// distanceCode returns the distance symbol for d with its extra bits,
+// using the last distances if possible, and updates them.
+func (e *metaBlockEncoder) distanceCode(d int) (sym int16, extra uint32, nbits uint8) {
+	sym = -1
+	for c := range numDistShortCodes {
+		if e.lastDist(int(distShortIndex[c]))+int(distShortDelta[c]) == d {
+			sym = int16(c)
+			break
+		}
+	}
+	if sym < 0 {
+		x := uint32(d + 3)
+		nbits = uint8(bits.Len32(x) - 2)
+		sym = int16(numDistShortCodes + 2*(nbits-1) + uint8(x>>nbits&1))
+		extra = x & (1<<nbits - 1)
+	}
+	if sym != 0 {
+		e.dist[e.distIdx&3] = d
+		e.distIdx++
+	}
+	return sym, extra, nbits
+}
+
+// appendCommands writes the prefix codes and the commands of a
+// compressed meta-block for data, following its header.
+func (e *metaBlockEncoder) appendCommands(bw *bitWriter, data []byte) {
+	clear(e.litCount[:])
+	clear(e.cmdCount[:])
+	clear(e.dCount[:])
+	p := 0
+	for _, c := range e.cmds {
+		for _, b := range data[p : p+int(c.insert)] {
+			e.litCount[b]++
+		}
+		p += int(c.insert) + int(c.copyLen)
+		e.cmdCount[c.sym]++
+		if c.dsym >= 0 {
+			e.dCount[c.dsym]++
+		}
+	}
+
+	// A single block type for each category, no postfix bits,
+	// no direct distance codes, and a single prefix code for
+	// each category, so no context maps.
+	bw.addBits(0, 3)   // NBLTYPESL, NBLTYPESI, NBLTYPESD
+	bw.addBits(0, 2+4) // NPOSTFIX, NDIRECT
+	bw.addBits(0, 2)   // context mode
+	bw.addBits(0, 1+1) // NTREESL, NTREESD
+	e.lit.write(bw, e.litCount[:])
+	e.cmd.write(bw, e.cmdCount[:])
+	e.d.write(bw, e.dCount[:])
+
+	p = 0
+	for _, c := range e.cmds {
+		e.cmd.writeSymbol(bw, int(c.sym))
+		cell := c.sym >> 6
+		ins := int(insertCellBase[cell]) + int(c.sym>>3&7)
+		cp := int(copyCellBase[cell]) + int(c.sym&7)
+		bw.addBits(c.insert-insertBase[ins], insertExtra[ins])
+		if c.copyLen > 0 {
+			bw.addBits(c.copyLen-copyBase[cp], copyExtra[cp])
+		}
+		for _, b := range data[p : p+int(c.insert)] {
+			e.lit.writeSymbol(bw, int(b))
+		}
+		p += int(c.insert) + int(c.copyLen)
+		if c.dsym >= 0 {
+			e.d.writeSymbol(bw, int(c.dsym))
+			bw.addBits(c.dextra, c.dbits)
+		}
+	}
+}
+
+// appendStreamHeader writes the window size.
+func appendStreamHeader(bw *bitWriter, windowLog uint8) {
+	switch {
+	case windowLog == 16:
+		bw.addBits(0, 1)
+	case windowLog == 17:
+		bw.addBits(1, 7)
+	case windowLog > 17:
+		bw.addBits(uint32(windowLog-17)<<1|1, 4)
+	default:
+		bw.addBits(uint32(windowLog-8)<<4|1, 7)
+	}
+}
+
+// appendMetaBlockHeader writes the header of a meta-block holding
+// n bytes, up to and including the ISUNCOMPRESSED bit.
+func appendMetaBlockHeader(bw *bitWriter, n int, uncompressed bool) {
+	nibbles := uint8(4)
+	for n-1 >= 1<<(4*nibbles) {
+		nibbles++
+	}
+	bw.addBits(0, 1) // ISLAST
+	bw.addBits(uint32(nibbles-4), 2)
+	bw.addBits(uint32(n-1), 4*nibbles)
+	if uncompressed {
+		bw.addBits(1, 1)
+	} else {
+		bw.addBits(0, 1)
+	}
+}
+
+// appendUncompressed writes an uncompressed meta-block holding data.
+func appendUncompressed(bw *bitWriter, data []byte) {
+	appendMetaBlockHeader(bw, len(data), true)
+	bw.align()
+	bw.out = append(bw.out, data...)
+}
+
+// appendLastMetaBlock writes an empty last meta-block, which ends
+// the stream, and the padding to the next byte boundary.
+func appendLastMetaBlock(bw *bitWriter) {
+	bw.addBits(1|1<<1, 2) // ISLAST, ISLASTEMPTY
+	bw.align()
+}
+
+// appendEmptyMetadata writes an empty metadata meta-block and the
+// padding to the next byte boundary, so that all data written so
+// far can be decompressed.
+func appendEmptyMetadata(bw *bitWriter) {
+	if bw.n%8 != 0 {
+		// ISLAST = 0, MNIBBLES = 0, reserved bit, MSKIPBYTES = 0.
+		bw.addBits(3<<1, 6)
+	}
+	bw.align()
+}
@@ -0,0 +1,35 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package brotli_test
+
+import (
+	"bytes"
+	"compress/brotli"
+	"io"
+	"log"
+	"os"
+)
+
+func Example_writerReader() {
+	var buf bytes.Buffer
+	zw := brotli.NewWriter(&buf)
+
+	_, err := zw.Write([]byte("A long time ago in a galaxy far, far away..."))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err := zw.Close(); err != nil {
+		log.Fatal(err)
+	}
+
+	zr := brotli.NewReader(&buf)
+	if _, err := io.Copy(os.Stdout, zr); err != nil {
+		log.Fatal(err)
+	}
+
+	// Output:
+	// A long time ago in a galaxy far, far away...
+}
@@ -0,0 +1,73 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build ignore
+
+// This program generates dict.go, which holds the static dictionary
+// of RFC 7932, Appendix A. The input is the binary form of the
+// dictionary, 122,784 bytes long, as distributed with the reference
+// implementation as dictionary.bin.
+//
+// Usage:
+//
+//	go run gen_dict.go -input dictionary.bin
+
+package main
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"strconv"
+)
+
+// dictSHA256 is the SHA-256 hash of the dictionary.
+const dictSHA256 = "20e42eb1b511c21806d4d227d07e5dd06877d8ce7b3a817f378f313653f35c70"
+
+var (
+	input  = flag.String("input", "dictionary.bin", "binary dictionary file")
+	output = flag.String("output", "dict.go", "output file")
+)
+
+func main() {
+	flag.Parse()
+	dict, err := os.ReadFile(*input)
+	if err != nil {
+		log.Fatal(err)
+	}
+	sum := sha256.Sum256(dict)
+	if hex.EncodeToString(sum[:]) != dictSHA256 {
+		log.Fatalf("%s is not the brotli dictionary", *input)
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintln(&buf, "// Code generated by gen_dict.go; DO NOT EDIT.")
+	fmt.Fprintln(&buf)
+	fmt.Fprintln(&buf, "package brotli")
+	fmt.Fprintln(&buf)
+	fmt.Fprintln(&buf, "// dictionary is the static dictionary of RFC 7932, Appendix A.")
+	fmt.Fprintln(&buf, `const dictionary = "" +`)
+	for len(dict) > 0 {
+		n := min(len(dict), 64)
+		fmt.Fprintf(&buf, "\t%s", strconv.QuoteToASCII(string(dict[:n])))
+		dict = dict[n:]
+		if len(dict) > 0 {
+			fmt.Fprint(&buf, " +")
+		}
+		fmt.Fprintln(&buf)
+	}
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(*output, src, 0666); err != nil {
+		log.Fatal(err)
+	}
+}
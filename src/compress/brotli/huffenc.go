@@ -0,0 +1,336 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package brotli
+
+import (
+	"cmp"
+	"math/bits"
+	"slices"
+)
+
+// A prefixEncoder is a prefix code for the symbols of an alphabet.
+type prefixEncoder struct {
+	lens  [numCommandSymbols]uint8
+	codes [numCommandSymbols]uint16 // bit-reversed, to be written as is
+
+	// Scratch space.
+	nodes []huffNode
+	rle   []uint8 // code lengths, with runs replaced by codes 16 and 17
+	extra []uint8 // extra bits of codes 16 and 17
+}
+
+type huffNode struct {
+	count  uint64
+	parent int32
+	sym    int16
+	depth  uint8
+}
+
+// buildLengths sets e.lens to the code lengths of a complete
+// Huffman code for the symbols with the given counts, limited to
+// maxBits. There must be at least two distinct symbols.
+func (e *prefixEncoder) buildLengths(counts []uint32, maxBits uint8) {
+	e.nodes = e.nodes[:0]
+	for s, c := range counts {
+		e.lens[s] = 0
+		if c > 0 {
+			e.nodes = append(e.nodes, huffNode{count: uint64(c), sym: int16(s)})
+		}
+	}
+	n := len(e.nodes)
+	slices.SortFunc(e.nodes, func(a, b huffNode) int {
+		if c := cmp.Compare(a.count, b.count); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.sym, b.sym)
+	})
+
+	// Build the tree with the two queue method: the leaves are
+	// sorted, and internal nodes are created in increasing order
+	// of count after the leaves.
+	leaf, inner := 0, n
+	pick := func() int {
+		if leaf < n && (inner >= len(e.nodes) || e.nodes[leaf].count <= e.nodes[inner].count) {
+			leaf++
+			return leaf - 1
+		}
+		inner++
+		return inner - 1
+	}
+	for len(e.nodes) < 2*n-1 {
+		a, b := pick(), pick()
+		parent := int32(len(e.nodes))
+		e.nodes[a].parent = parent
+		e.nodes[b].parent = parent
+		e.nodes = append(e.nodes, huffNode{count: e.nodes[a].count + e.nodes[b].count, sym: -1})
+	}
+	e.nodes[len(e.nodes)-1].depth = 0
+	for i := len(e.nodes) - 2; i >= 0; i-- {
+		e.nodes[i].depth = min(e.nodes[e.nodes[i].parent].depth+1, 255)
+	}
+
+	// Limit the code lengths, then adjust them so that
+	// the code is complete, as the format requires.
+	// The leaves are still sorted by increasing count.
+	leaves := e.nodes[:n]
+	kraft := 0
+	for i := range leaves {
+		leaves[i].depth = min(leaves[i].depth, maxBits)
+		kraft += 1 << (maxBits - leaves[i].depth)
+	}
+	for kraft > 1<<maxBits {
+		// Lengthen the least frequent code among the longest
+		// codes that can still be lengthened.
+		best := -1
+		for i := range leaves {
+			d := leaves[i].depth
+			if d < maxBits && (best < 0 || d > leaves[best].depth) {
+				best = i
+			}
+		}
+		kraft -= 1 << (maxBits - leaves[best].depth - 1)
+		leaves[best].depth++
+	}
+	for kraft < 1<<maxBits {
+		// Shorten the most frequent code that fits in the gap.
+		gap := 1<<maxBits - kraft
+		for i := n - 1; i >= 0; i-- {
+			d := leaves[i].depth
+			if d > 1 && 1<<(maxBits-d) <= gap {
+				kraft += 1 << (maxBits - d)
+				leaves[i].depth--
+				break
+			}
+		}
+	}
+	for _, l := range leaves {
+		e.lens[l.sym] = l.depth
+	}
+}
+
+// assignCodes assigns the canonical codes for e.lens[:n],
+// in the order used by decoders.
+func (e *prefixEncoder) assignCodes(n int) {
+	var count [maxCodeLength + 1]uint16
+	for _, l := range e.lens[:n] {
+		count[l]++
+	}
+	count[0] = 0
+	var next [maxCodeLength + 1]uint16
+	code := uint16(0)
+	for l := 1; l <= maxCodeLength; l++ {
+		code = (code + count[l-1]) << 1
+		next[l] = code
+	}
+	for s, l := range e.lens[:n] {
+		if l > 0 {
+			e.codes[s] = bits.Reverse16(next[l]) >> (16 - l)
+			next[l]++
+		}
+	}
+}
+
+// writeSymbol writes the code for sym.
+func (e *prefixEncoder) writeSymbol(bw *bitWriter, sym int) {
+	bw.addBits(uint32(e.codes[sym]), e.lens[sym])
+}
+
+// write builds a prefix code for the symbols with the given counts
+// and writes its description.
+func (e *prefixEncoder) write(bw *bitWriter, counts []uint32) {
+	alphabetSize := len(counts)
+	var syms [4]int
+	nsym := 0
+	for s, c := range counts {
+		if c > 0 {
+			if nsym < len(syms) {
+				syms[nsym] = s
+			}
+			nsym++
+		}
+	}
+	if nsym <= 4 {
+		e.writeSimple(bw, counts, syms[:max(nsym, 1)])
+		return
+	}
+	e.buildLengths(counts, maxCodeLength)
+	e.assignCodes(alphabetSize)
+
+	// Run-length encode the code lengths, as the reference
+	// encoder does. Trailing zeros are left out.
+	n := alphabetSize
+	for e.lens[n-1] == 0 {
+		n--
+	}
+	e.rle = e.rle[:0]
+	e.extra = e.extra[:0]
+	prev := uint8(8)
+	for i := 0; i < n; {
+		l := e.lens[i]
+		reps := 1
+		for i+reps < n && e.lens[i+reps] == l {
+			reps++
+		}
+		i += reps
+		if l == 0 {
+			e.appendZeros(reps)
+		} else {
+			e.appendRepeats(prev, l, reps)
+			prev = l
+		}
+	}
+
+	// The code length code.
+	var clCounts [numCodeLengthCodes]uint32
+	for _, l := range e.rle {
+		clCounts[l]++
+	}
+	var cl prefixEncoder
+	numCodes := 0
+	for _, c := range clCounts {
+		if c > 0 {
+			numCodes++
+		}
+	}
+	if numCodes == 1 {
+		// A single code length code takes no bits, but the
+		// lengths of all code length codes must be sent.
+		for s, c := range clCounts {
+			if c > 0 {
+				cl.lens[s] = 1
+			}
+		}
+	} else {
+		cl.buildLengths(clCounts[:], 5)
+		cl.assignCodes(numCodeLengthCodes)
+	}
+	hskip := 0
+	if cl.lens[codeLengthOrder[0]] == 0 && cl.lens[codeLengthOrder[1]] == 0 {
+		hskip = 2
+		if cl.lens[codeLengthOrder[2]] == 0 {
+			hskip = 3
+		}
+	}
+	last := numCodeLengthCodes
+	if numCodes > 1 {
+		for cl.lens[codeLengthOrder[last-1]] == 0 {
+			last--
+		}
+	}
+	bw.addBits(uint32(hskip), 2)
+	for _, s := range codeLengthOrder[hskip:last] {
+		l := cl.lens[s]
+		bw.addBits(uint32(codeLengthPrefixCode[l]), codeLengthPrefixLen[codeLengthPrefixCode[l]])
+	}
+	if numCodes == 1 {
+		clear(cl.lens[:])
+	}
+	for i, l := range e.rle {
+		cl.writeSymbol(bw, int(l))
+		switch l {
+		case repeatPrevious:
+			bw.addBits(uint32(e.extra[i]), 2)
+		case repeatPrevious + 1:
+			bw.addBits(uint32(e.extra[i]), 3)
+		}
+	}
+}
+
+// codeLengthPrefixCode holds the bits that encode each code length
+// of the code length code, of the lengths in codeLengthPrefixLen.
+var codeLengthPrefixCode = [6]uint8{0, 7, 3, 2, 1, 15}
+
+// writeSimple writes a simple prefix code for the given symbols,
+// of which there are at most four, and sets their code lengths.
+func (e *prefixEncoder) writeSimple(bw *bitWriter, counts []uint32, syms []int) {
+	alphabetSize := len(counts)
+	clear(e.lens[:alphabetSize])
+	if len(syms) > 1 {
+		e.buildLengths(counts, 3)
+		// List the symbols by increasing code length.
+		slices.SortStableFunc(syms, func(a, b int) int {
+			return cmp.Compare(e.lens[a], e.lens[b])
+		})
+	}
+	bw.addBits(1, 2) // HSKIP
+	bw.addBits(uint32(len(syms)-1), 2)
+	nbits := uint8(bits.Len(uint(alphabetSize - 1)))
+	for _, s := range syms {
+		bw.addBits(uint32(s), nbits)
+	}
+	if len(syms) == 4 {
+		// Tree-select: lengths 1, 2, 3, 3 rather than 2, 2, 2, 2.
+		if e.lens[syms[0]] == 1 {
+			bw.addBits(1, 1)
+		} else {
+			bw.addBits(0, 1)
+		}
+	}
+	e.assignCodes(alphabetSize)
+}
+
+// appendZeros appends a run of reps zero code lengths.
+func (e *prefixEncoder) appendZeros(reps int) {
+	if reps == 11 {
+		e.rle = append(e.rle, 0)
+		e.extra = append(e.extra, 0)
+		reps--
+	}
+	if reps < 3 {
+		for range reps {
+			e.rle = append(e.rle, 0)
+			e.extra = append(e.extra, 0)
+		}
+		return
+	}
+	start := len(e.rle)
+	reps -= 3
+	for {
+		e.rle = append(e.rle, repeatPrevious+1)
+		e.extra = append(e.extra, uint8(reps&7))
+		reps >>= 3
+		if reps == 0 {
+			break
+		}
+		reps--
+	}
+	slices.Reverse(e.rle[start:])
+	slices.Reverse(e.extra[start:])
+}
+
+// appendRepeats appends a run of reps code lengths l, following
+// a nonzero code length prev.
+func (e *prefixEncoder) appendRepeats(prev, l uint8, reps int) {
+	if prev != l {
+		e.rle = append(e.rle, l)
+		e.extra = append(e.extra, 0)
+		reps--
+	}
+	if reps == 7 {
+		e.rle = append(e.rle, l)
+		e.extra = append(e.extra, 0)
+		reps--
+	}
+	if reps < 3 {
+		for range reps {
+			e.rle = append(e.rle, l)
+			e.extra = append(e.extra, 0)
+		}
+		return
+	}
+	start := len(e.rle)
+	reps -= 3
+	for {
+		e.rle = append(e.rle, repeatPrevious)
+		e.extra = append(e.extra, uint8(reps&3))
+		reps >>= 2
+		if reps == 0 {
+			break
+		}
+		reps--
+	}
+	slices.Reverse(e.rle[start:])
+	slices.Reverse(e.extra[start:])
+}
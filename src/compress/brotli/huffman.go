@@ -0,0 +1,259 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package brotli
+
+import "math/bits"
+
+// A huffmanCode is a decoding table for a prefix code. The first
+// 1<<rootBits entries are indexed by the next bits of the input.
+// An entry holds a symbol in its low 16 bits and the length of its
+// code in the next 8 bits, or, with subtableFlag set, the offset
+// of a subtable indexed by the following bits and the number of
+// those bits. Subtable entries hold a symbol and its full length.
+type huffmanCode []uint32
+
+const (
+	rootBits     = 8
+	rootMask     = 1<<rootBits - 1
+	subtableFlag = 1 << 31
+)
+
+// appendHuffman appends to dst the decoding table for the complete
+// canonical prefix code with the given code lengths, none of which
+// exceeds maxCodeLength, and returns the extended slice and the table.
+func appendHuffman(dst []uint32, lens []uint8) ([]uint32, huffmanCode) {
+	var count [maxCodeLength + 1]uint32
+	for _, l := range lens {
+		count[l]++
+	}
+	count[0] = 0
+	var next [maxCodeLength + 1]uint32
+	code := uint32(0)
+	for l := 1; l <= maxCodeLength; l++ {
+		code = (code + count[l-1]) << 1
+		next[l] = code
+	}
+
+	start := len(dst)
+	dst = append(dst, make([]uint32, 1<<rootBits)...)
+
+	// Codes are sent starting with their most significant bit,
+	// which is the first bit read, so the table is indexed by
+	// the reversed codes.
+	var codes [numCommandSymbols]uint16
+	var subBits [1 << rootBits]uint8
+	for sym, l := range lens {
+		if l == 0 {
+			continue
+		}
+		c := bits.Reverse16(uint16(next[l])) >> (16 - l)
+		next[l]++
+		codes[sym] = c
+		if l > rootBits {
+			i := c & rootMask
+			subBits[i] = max(subBits[i], l-rootBits)
+		}
+	}
+	for i, n := range subBits {
+		if n > 0 {
+			off := len(dst) - start
+			dst = append(dst, make([]uint32, 1<<n)...)
+			dst[start+i] = subtableFlag | uint32(n)<<16 | uint32(off)
+		}
+	}
+	h := huffmanCode(dst[start:])
+	for sym, l := range lens {
+		if l == 0 {
+			continue
+		}
+		c := uint32(codes[sym])
+		e := uint32(sym) | uint32(l)<<16
+		if l <= rootBits {
+			for i := c; i < 1<<rootBits; i += 1 << l {
+				h[i] = e
+			}
+			continue
+		}
+		root := h[c&rootMask]
+		sub := h[root&0xFFFF:]
+		n := root >> 16 & 0xFF
+		for i := c >> rootBits; i < 1<<n; i += 1 << (uint32(l) - rootBits) {
+			sub[i] = e
+		}
+	}
+	return dst, h
+}
+
+// appendSingleSymbol appends to dst the decoding table for a code
+// holding only sym, which takes no bits.
+func appendSingleSymbol(dst []uint32, sym int) ([]uint32, huffmanCode) {
+	start := len(dst)
+	for range 1 << rootBits {
+		dst = append(dst, uint32(sym))
+	}
+	return dst, huffmanCode(dst[start:])
+}
+
+// Code length codes, in RFC 7932, Section 3.5.
+var (
+	codeLengthOrder = [numCodeLengthCodes]uint8{1, 2, 3, 4, 0, 5, 17, 6, 16, 7, 8, 9, 10, 11, 12, 13, 14, 15}
+
+	// The code lengths of the code length code are themselves
+	// encoded with a fixed prefix code, indexed here by 4 bits.
+	codeLengthPrefixLen   = [16]uint8{2, 2, 2, 3, 2, 2, 2, 4, 2, 2, 2, 3, 2, 2, 2, 4}
+	codeLengthPrefixValue = [16]uint8{0, 4, 3, 2, 0, 4, 3, 1, 0, 4, 3, 2, 0, 4, 3, 5}
+)
+
+// Code length 16 repeats the previous nonzero code length;
+// code length 17 repeats a zero code length.
+const repeatPrevious = 16
+
+// readPrefixCode reads the description of a prefix code over
+// alphabetSize symbols and appends its decoding table to z.tables.
+func (z *Reader) readPrefixCode(alphabetSize int) (huffmanCode, error) {
+	br := &z.br
+	var h huffmanCode
+	lens := z.lens[:alphabetSize]
+	clear(lens)
+
+	hskip := br.readBits(2)
+	if hskip == 1 {
+		// A simple prefix code, listing up to four symbols.
+		nsym := int(br.readBits(2)) + 1
+		nbits := uint(bits.Len(uint(alphabetSize - 1)))
+		var syms [4]int
+		for i := range nsym {
+			syms[i] = int(br.readBits(nbits))
+			if syms[i] >= alphabetSize {
+				return nil, StructuralError("symbol out of range in simple prefix code")
+			}
+			for j := range i {
+				if syms[i] == syms[j] {
+					return nil, StructuralError("repeated symbol in simple prefix code")
+				}
+			}
+		}
+		switch nsym {
+		case 1:
+			if br.err != nil {
+				return nil, br.err
+			}
+			z.tables, h = appendSingleSymbol(z.tables, syms[0])
+			return h, nil
+		case 2:
+			lens[syms[0]], lens[syms[1]] = 1, 1
+		case 3:
+			lens[syms[0]], lens[syms[1]], lens[syms[2]] = 1, 2, 2
+		case 4:
+			if br.readBit() {
+				lens[syms[0]], lens[syms[1]], lens[syms[2]], lens[syms[3]] = 1, 2, 3, 3
+			} else {
+				lens[syms[0]], lens[syms[1]], lens[syms[2]], lens[syms[3]] = 2, 2, 2, 2
+			}
+		}
+		if br.err != nil {
+			return nil, br.err
+		}
+		z.tables, h = appendHuffman(z.tables, lens)
+		return h, nil
+	}
+
+	// A complex prefix code. First come the lengths of the code
+	// length code, skipping the first hskip ones.
+	var clens [numCodeLengthCodes]uint8
+	space := 32
+	numCodes := 0
+	for _, sym := range codeLengthOrder[hskip:] {
+		i := br.val & 15
+		for uint(codeLengthPrefixLen[i]) > br.nbits {
+			if !br.fill() {
+				return nil, br.err
+			}
+			i = br.val & 15
+		}
+		br.readBits(uint(codeLengthPrefixLen[i]))
+		v := codeLengthPrefixValue[i]
+		clens[sym] = v
+		if v != 0 {
+			space -= 32 >> v
+			numCodes++
+			if space <= 0 {
+				break
+			}
+		}
+	}
+	if numCodes != 1 && space != 0 {
+		return nil, StructuralError("invalid code length code")
+	}
+	var clcode huffmanCode
+	start := len(z.tables)
+	if numCodes == 1 {
+		for sym, l := range clens {
+			if l != 0 {
+				z.tables, clcode = appendSingleSymbol(z.tables, sym)
+			}
+		}
+	} else {
+		z.tables, clcode = appendHuffman(z.tables, clens[:])
+	}
+
+	// Then the code lengths of the symbols.
+	sym := 0
+	prevLen := uint8(8)
+	repeat := 0
+	repeatLen := uint8(0)
+	space = 1 << maxCodeLength
+	for sym < alphabetSize && space > 0 {
+		l := uint8(br.readSymbol(clcode))
+		if br.err != nil {
+			return nil, br.err
+		}
+		if l < repeatPrevious {
+			repeat = 0
+			lens[sym] = l
+			sym++
+			if l != 0 {
+				prevLen = l
+				space -= 1 << maxCodeLength >> l
+			}
+			continue
+		}
+		extraBits, newLen := uint(3), uint8(0)
+		if l == repeatPrevious {
+			extraBits, newLen = 2, prevLen
+		}
+		if repeatLen != newLen {
+			repeat = 0
+			repeatLen = newLen
+		}
+		old := repeat
+		if repeat > 0 {
+			repeat = (repeat - 2) << extraBits
+		}
+		repeat += int(br.readBits(extraBits)) + 3
+		n := repeat - old
+		if n > alphabetSize-sym {
+			return nil, StructuralError("code length repeated past the alphabet")
+		}
+		for range n {
+			lens[sym] = repeatLen
+			sym++
+		}
+		if repeatLen != 0 {
+			space -= n << (maxCodeLength - repeatLen)
+		}
+	}
+	if space != 0 {
+		return nil, StructuralError("invalid prefix code")
+	}
+	if br.err != nil {
+		return nil, br.err
+	}
+
+	// The code length code is no longer needed.
+	z.tables = z.tables[:start]
+	z.tables, h = appendHuffman(z.tables, lens)
+	return h, nil
+}
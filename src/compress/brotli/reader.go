@@ -0,0 +1,643 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package brotli
+
+import "io"
+
+// Reader states, between meta-blocks and between commands.
+const (
+	stateStreamHeader = iota
+	stateMetaBlock    // at the start of a meta-block
+	stateUncompressed // copying an uncompressed meta-block
+	stateCommand      // at the start of a command
+	stateInsert       // inserting literals
+	stateCopy         // copying from the window
+	stateWord         // copying a dictionary word
+	stateDone
+)
+
+// blockState tracks the block types of one of the three categories
+// of symbols: literals, insert-and-copy commands and distances.
+type blockState struct {
+	n       int // number of block types
+	typ     int // current block type
+	prev    int // previous block type
+	count   int // symbols left in the current block
+	types   huffmanCode
+	lengths huffmanCode
+}
+
+// A Reader is an [io.Reader] that decompresses a Brotli stream.
+type Reader struct {
+	br    bitReader
+	err   error
+	state int
+	last  bool // the current meta-block is the last one
+
+	// ring holds the window, and the decompressed data that has not
+	// been read yet. It grows up to windowSize bytes, after which
+	// byte i of the output is stored at ring[i&(windowSize-1)].
+	ring       []byte
+	windowSize int
+	maxDist    int   // largest distance in the window
+	pos        int64 // bytes decompressed
+	rd         int64 // bytes read
+	p1, p2     byte  // the last two bytes decompressed
+
+	dist    [4]int // last distances, a ring indexed by distIdx
+	distIdx int
+
+	remaining int // bytes left in the current meta-block
+
+	blocks       [3]blockState // literals, commands and distances
+	contextModes []uint8
+	literalMap   []uint8
+	distanceMap  []uint8
+	literals     []huffmanCode
+	commands     []huffmanCode
+	distances    []huffmanCode
+	npostfix     uint
+	ndirect      int
+
+	insert   int // literals left to insert
+	copyLen  int // bytes left to copy
+	copyDist int
+	implicit bool   // the command reuses the last distance
+	word     []byte // dictionary word left to copy
+	wordBuf  [64]byte
+	tables   []uint32 // backing store for the prefix codes
+	lens     [numCommandSymbols]uint8
+	mtf      [256]uint8
+}
+
+// NewReader returns a new [Reader] decompressing r.
+// If r does not also implement [io.ByteReader],
+// the decompressor may read more data than necessary from r.
+func NewReader(r io.Reader) *Reader {
+	z := new(Reader)
+	z.Reset(r)
+	return z
+}
+
+// Reset discards the [Reader] z's state and makes it equivalent to the
+// result of its original state from [NewReader], but reading from r
+// instead. This permits reusing a Reader rather than allocating a new one.
+func (z *Reader) Reset(r io.Reader) {
+	z.br.reset(r)
+	z.err = nil
+	z.state = stateStreamHeader
+	z.pos = 0
+	z.rd = 0
+}
+
+// Read reads decompressed data into p.
+func (z *Reader) Read(p []byte) (int, error) {
+	for {
+		if z.rd < z.pos {
+			mask := len(z.ring) - 1
+			start := int(z.rd) & mask
+			n := copy(p, z.ring[start:min(len(z.ring), start+int(z.pos-z.rd))])
+			z.rd += int64(n)
+			return n, nil
+		}
+		if z.err != nil || len(p) == 0 {
+			return 0, z.err
+		}
+		z.err = z.decode()
+		if z.br.err != nil {
+			// Report truncated input as such, rather than
+			// whatever the bits read as zero made of it.
+			z.err = z.br.err
+		}
+	}
+}
+
+// decode decompresses data into the window until it holds
+// windowSize bytes that have not been read, or an error occurs.
+// It returns [io.EOF] at the end of the stream.
+func (z *Reader) decode() error {
+	br := &z.br
+	for z.free() > 0 {
+		switch z.state {
+		case stateStreamHeader:
+			if err := z.readStreamHeader(); err != nil {
+				return err
+			}
+			z.state = stateMetaBlock
+
+		case stateMetaBlock:
+			if z.last {
+				if !br.align() {
+					return StructuralError("nonzero padding at end of stream")
+				}
+				z.state = stateDone
+				continue
+			}
+			if err := z.readMetaBlockHeader(); err != nil {
+				return err
+			}
+
+		case stateUncompressed:
+			n := min(z.remaining, z.free())
+			z.reserve(n)
+			for range n {
+				b := byte(br.readBits(8))
+				if br.err != nil {
+					return br.err
+				}
+				z.put(b)
+			}
+			z.remaining -= n
+			if z.remaining == 0 {
+				z.state = stateMetaBlock
+			}
+
+		case stateCommand:
+			if z.remaining == 0 {
+				z.state = stateMetaBlock
+				continue
+			}
+			if err := z.readCommand(); err != nil {
+				return err
+			}
+			z.state = stateInsert
+
+		case stateInsert:
+			if err := z.insertLiterals(); err != nil {
+				return err
+			}
+			if z.insert > 0 {
+				continue
+			}
+			if z.remaining == 0 {
+				// The meta-block ends with the literals,
+				// and the copy length is ignored.
+				z.state = stateMetaBlock
+				continue
+			}
+			if err := z.readDistance(); err != nil {
+				return err
+			}
+
+		case stateCopy:
+			n := min(z.copyLen, z.free())
+			z.copy(n)
+			z.copyLen -= n
+			if z.copyLen == 0 {
+				z.state = stateCommand
+			}
+
+		case stateWord:
+			n := min(len(z.word), z.free())
+			z.reserve(n)
+			for _, b := range z.word[:n] {
+				z.put(b)
+			}
+			z.word = z.word[n:]
+			if len(z.word) == 0 {
+				z.state = stateCommand
+			}
+
+		case stateDone:
+			return io.EOF
+		}
+	}
+	return nil
+}
+
+// free returns the number of bytes that can be decompressed into
+// the window before it must be read.
+func (z *Reader) free() int {
+	if z.state == stateStreamHeader {
+		return 1
+	}
+	return z.windowSize - int(z.pos-z.rd)
+}
+
+// reserve grows the window, if needed, to hold the next n bytes
+// without wrapping around before windowSize bytes.
+func (z *Reader) reserve(n int) {
+	if len(z.ring) == z.windowSize {
+		return
+	}
+	need := int(z.pos) + n
+	if need <= len(z.ring) {
+		return
+	}
+	size := max(len(z.ring), 1<<16)
+	for size < need {
+		size *= 2
+	}
+	size = min(size, z.windowSize)
+	if cap(z.ring) >= size {
+		z.ring = z.ring[:size]
+		return
+	}
+	ring := make([]byte, size)
+	copy(ring, z.ring[:z.pos])
+	z.ring = ring
+}
+
+// put appends b to the window, which must have room for it.
+func (z *Reader) put(b byte) {
+	z.ring[int(z.pos)&(len(z.ring)-1)] = b
+	z.pos++
+	z.p2, z.p1 = z.p1, b
+}
+
+// copy copies n bytes from z.copyDist bytes back in the window.
+func (z *Reader) copy(n int) {
+	z.reserve(n)
+	mask := len(z.ring) - 1
+	dst := int(z.pos) & mask
+	src := (dst - z.copyDist) & mask
+	for range n {
+		z.ring[dst] = z.ring[src]
+		dst = (dst + 1) & mask
+		src = (src + 1) & mask
+	}
+	z.pos += int64(n)
+	last := int(z.pos) - 1
+	z.p1 = z.ring[last&mask]
+	z.p2 = z.ring[(last-1)&mask]
+}
+
+// readStreamHeader reads the window size.
+func (z *Reader) readStreamHeader() error {
+	br := &z.br
+	wbits := 16
+	if br.readBit() {
+		if n := br.readBits(3); n != 0 {
+			wbits = 17 + int(n)
+		} else if n := br.readBits(3); n == 1 {
+			return StructuralError("invalid window size")
+		} else if n != 0 {
+			wbits = 8 + int(n)
+		} else {
+			wbits = 17
+		}
+	}
+	if br.err != nil {
+		return br.err
+	}
+	z.windowSize = 1 << wbits
+	z.maxDist = z.windowSize - windowGap
+	if len(z.ring) > z.windowSize {
+		z.ring = z.ring[:z.windowSize]
+	} else {
+		z.ring = z.ring[:0]
+	}
+	z.dist = [4]int{16, 15, 11, 4}
+	z.distIdx = 0
+	z.p1, z.p2 = 0, 0
+	z.last = false
+	return nil
+}
+
+// readMetaBlockHeader reads the header of a meta-block, and for a
+// compressed meta-block its prefix codes and context maps.
+func (z *Reader) readMetaBlockHeader() error {
+	br := &z.br
+	z.last = br.readBit()
+	if z.last && br.readBit() {
+		// The last meta-block is empty.
+		return br.err
+	}
+	nibbles := br.readBits(2) + 4
+	if nibbles == 7 {
+		// Metadata, which is skipped.
+		if br.readBit() {
+			return StructuralError("reserved bit set")
+		}
+		nbytes := br.readBits(2)
+		skip := 0
+		for i := range nbytes {
+			b := br.readBits(8)
+			if i+1 == nbytes && nbytes > 1 && b == 0 {
+				return StructuralError("metadata length with a leading zero")
+			}
+			skip |= int(b) << (8 * i)
+		}
+		if nbytes > 0 {
+			skip++
+		}
+		if !br.align() {
+			return StructuralError("nonzero padding before metadata")
+		}
+		for range skip {
+			br.readBits(8)
+		}
+		return br.err
+	}
+	mlen := 0
+	for i := range nibbles {
+		n := br.readBits(4)
+		if i+1 == nibbles && nibbles > 4 && n == 0 {
+			return StructuralError("meta-block length with a leading zero")
+		}
+		mlen |= int(n) << (4 * i)
+	}
+	z.remaining = mlen + 1
+	if !z.last && br.readBit() {
+		if !br.align() {
+			return StructuralError("nonzero padding before uncompressed data")
+		}
+		z.state = stateUncompressed
+		return br.err
+	}
+	if br.err != nil {
+		return br.err
+	}
+
+	z.tables = z.tables[:0]
+	for i := range z.blocks {
+		if err := z.readBlockSwitchCodes(&z.blocks[i]); err != nil {
+			return err
+		}
+	}
+	z.npostfix = uint(br.readBits(2))
+	z.ndirect = int(br.readBits(4)) << z.npostfix
+	nlit := z.blocks[0].n
+	z.contextModes = z.contextModes[:0]
+	for range nlit {
+		z.contextModes = append(z.contextModes, uint8(br.readBits(2)))
+	}
+
+	var err error
+	var ntreesL, ntreesD int
+	z.literalMap, ntreesL, err = z.readContextMap(z.literalMap, nlit<<literalContextBits)
+	if err != nil {
+		return err
+	}
+	z.distanceMap, ntreesD, err = z.readContextMap(z.distanceMap, z.blocks[2].n<<distanceContextBits)
+	if err != nil {
+		return err
+	}
+	z.literals, err = z.readPrefixCodes(z.literals, ntreesL, numLiteralSymbols)
+	if err != nil {
+		return err
+	}
+	z.commands, err = z.readPrefixCodes(z.commands, z.blocks[1].n, numCommandSymbols)
+	if err != nil {
+		return err
+	}
+	ndist := numDistShortCodes + z.ndirect + 48<<z.npostfix
+	z.distances, err = z.readPrefixCodes(z.distances, ntreesD, ndist)
+	if err != nil {
+		return err
+	}
+	z.state = stateCommand
+	return nil
+}
+
+// readBlockSwitchCodes reads the number of block types of a category
+// and, if there are several, the prefix codes for block switches and
+// the length of the first block.
+func (z *Reader) readBlockSwitchCodes(b *blockState) error {
+	b.n = z.readVarLenUint8() + 1
+	b.typ, b.prev = 0, 1
+	if b.n == 1 {
+		b.count = 1 << 30
+		return z.br.err
+	}
+	var err error
+	if b.types, err = z.readPrefixCode(b.n + 2); err != nil {
+		return err
+	}
+	if b.lengths, err = z.readPrefixCode(numBlockLenSymbols); err != nil {
+		return err
+	}
+	b.count = z.readBlockLength(b)
+	return z.br.err
+}
+
+// readVarLenUint8 reads a number from 0 to 255.
+func (z *Reader) readVarLenUint8() int {
+	br := &z.br
+	if !br.readBit() {
+		return 0
+	}
+	n := uint(br.readBits(3))
+	if n == 0 {
+		return 1
+	}
+	return 1<<n + int(br.readBits(n))
+}
+
+func (z *Reader) readBlockLength(b *blockState) int {
+	sym := z.br.readSymbol(b.lengths)
+	return int(blockLenBase[sym] + z.br.readBits(uint(blockLenExtra[sym])))
+}
+
+// switchBlock reads a block switch command, which starts the next
+// block of b.
+func (z *Reader) switchBlock(b *blockState) {
+	t := z.br.readSymbol(b.types)
+	switch t {
+	case 0:
+		t = b.prev
+	case 1:
+		t = b.typ + 1
+	default:
+		t -= 2
+	}
+	if t >= b.n {
+		t -= b.n
+	}
+	b.prev, b.typ = b.typ, t
+	b.count = z.readBlockLength(b)
+}
+
+// readContextMap reads a context map of size n into m,
+// and returns it with the number of prefix codes it refers to.
+func (z *Reader) readContextMap(m []uint8, n int) ([]uint8, int, error) {
+	br := &z.br
+	m = append(m[:0], make([]uint8, n)...)
+	ntrees := z.readVarLenUint8() + 1
+	if ntrees == 1 {
+		return m, ntrees, br.err
+	}
+	rlemax := 0
+	if br.readBit() {
+		rlemax = int(br.readBits(4)) + 1
+	}
+	code, err := z.readPrefixCode(ntrees + rlemax)
+	if err != nil {
+		return nil, 0, err
+	}
+	for i := 0; i < n; {
+		sym := br.readSymbol(code)
+		switch {
+		case sym == 0:
+			i++
+		case sym <= rlemax:
+			run := 1<<sym + int(br.readBits(uint(sym)))
+			if run > n-i {
+				return nil, 0, StructuralError("context map run too long")
+			}
+			i += run
+		default:
+			m[i] = uint8(sym - rlemax)
+			i++
+		}
+		if br.err != nil {
+			return nil, 0, br.err
+		}
+	}
+	if br.readBit() {
+		// Undo the move-to-front transform.
+		for i := range z.mtf {
+			z.mtf[i] = uint8(i)
+		}
+		for i, v := range m {
+			b := z.mtf[v]
+			m[i] = b
+			copy(z.mtf[1:v+1], z.mtf[:v])
+			z.mtf[0] = b
+		}
+	}
+	return m, ntrees, br.err
+}
+
+// readPrefixCodes reads n prefix codes over alphabetSize symbols
+// into codes.
+func (z *Reader) readPrefixCodes(codes []huffmanCode, n, alphabetSize int) ([]huffmanCode, error) {
+	codes = codes[:0]
+	for range n {
+		h, err := z.readPrefixCode(alphabetSize)
+		if err != nil {
+			return nil, err
+		}
+		codes = append(codes, h)
+	}
+	return codes, nil
+}
+
+// readCommand reads an insert-and-copy command.
+func (z *Reader) readCommand() error {
+	br := &z.br
+	b := &z.blocks[1]
+	if b.count == 0 {
+		z.switchBlock(b)
+	}
+	b.count--
+	cmd := br.readSymbol(z.commands[b.typ])
+	cell := cmd >> 6
+	ins := insertCellBase[cell] + uint8(cmd>>3&7)
+	cp := copyCellBase[cell] + uint8(cmd&7)
+	z.insert = int(insertBase[ins] + br.readBits(uint(insertExtra[ins])))
+	z.copyLen = int(copyBase[cp] + br.readBits(uint(copyExtra[cp])))
+	z.implicit = cmd < 128
+	if br.err != nil {
+		return br.err
+	}
+	if z.insert > z.remaining {
+		return StructuralError("insert length exceeds the meta-block")
+	}
+	return nil
+}
+
+// insertLiterals decompresses the literals of a command,
+// as many as fit in the window.
+func (z *Reader) insertLiterals() error {
+	br := &z.br
+	b := &z.blocks[0]
+	n := min(z.insert, z.free())
+	z.reserve(n)
+	for range n {
+		if b.count == 0 {
+			z.switchBlock(b)
+		}
+		b.count--
+		ctx := literalContext(z.contextModes[b.typ], z.p1, z.p2)
+		lit := br.readSymbol(z.literals[z.literalMap[b.typ<<literalContextBits+ctx]])
+		if br.err != nil {
+			return br.err
+		}
+		z.put(byte(lit))
+	}
+	z.insert -= n
+	z.remaining -= n
+	return nil
+}
+
+// readDistance reads the distance of a command and starts copying,
+// either from the window or from the static dictionary.
+func (z *Reader) readDistance() error {
+	br := &z.br
+	d := z.dist[(z.distIdx-1)&3]
+	push := false
+	if !z.implicit {
+		b := &z.blocks[2]
+		if b.count == 0 {
+			z.switchBlock(b)
+		}
+		b.count--
+		ctx := min(z.copyLen, 5) - 2
+		sym := br.readSymbol(z.distances[z.distanceMap[b.typ<<distanceContextBits+ctx]])
+		switch {
+		case sym < numDistShortCodes:
+			d = z.dist[(z.distIdx-1-int(distShortIndex[sym]))&3] + int(distShortDelta[sym])
+			push = sym != 0
+			if d <= 0 {
+				return StructuralError("invalid distance")
+			}
+		case sym < numDistShortCodes+z.ndirect:
+			d = sym - numDistShortCodes + 1
+			push = true
+		default:
+			sym -= numDistShortCodes + z.ndirect
+			postfix := sym & (1<<z.npostfix - 1)
+			sym >>= z.npostfix
+			nbits := uint(sym>>1) + 1
+			offset := (2+sym&1)<<nbits - 4
+			extra := int(br.readBits(nbits))
+			d = (offset+extra)<<z.npostfix + postfix + z.ndirect + 1
+			push = true
+		}
+		if br.err != nil {
+			return br.err
+		}
+	}
+
+	maxDist := int(min(z.pos, int64(z.maxDist)))
+	if d > maxDist {
+		return z.dictionaryWord(d - maxDist - 1)
+	}
+	if z.copyLen > z.remaining {
+		return StructuralError("copy length exceeds the meta-block")
+	}
+	if push {
+		z.dist[z.distIdx&3] = d
+		z.distIdx++
+	}
+	z.copyDist = d
+	z.remaining -= z.copyLen
+	z.state = stateCopy
+	return nil
+}
+
+// dictionaryWord starts copying the static dictionary word
+// with the given ID, whose length is the copy length.
+func (z *Reader) dictionaryWord(id int) error {
+	n := z.copyLen
+	if n < minWordLen || n > maxWordLen {
+		return StructuralError("invalid dictionary word length")
+	}
+	bits := dictSizeBits[n]
+	t := id >> bits
+	if t >= len(transforms) {
+		return StructuralError("invalid dictionary word transform")
+	}
+	i := dictOffset[n] + id&(1<<bits-1)*n
+	z.word = appendWord(z.wordBuf[:0], dictionary[i:i+n], &transforms[t])
+	if len(z.word) > z.remaining {
+		return StructuralError("dictionary word exceeds the meta-block")
+	}
+	z.remaining -= len(z.word)
+	z.copyLen = 0
+	z.state = stateWord
+	return nil
+}
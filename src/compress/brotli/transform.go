@@ -0,0 +1,215 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package brotli
+
+// The static dictionary holds words of 4 to 24 bytes, grouped by
+// length. There are 1<<dictSizeBits[n] words of length n, starting
+// at dictOffset[n].
+const (
+	minWordLen = 4
+	maxWordLen = 24
+)
+
+var dictSizeBits = [maxWordLen + 1]uint8{
+	0, 0, 0, 0, 10, 10, 11, 11, 10, 10, 10, 10, 10,
+	9, 9, 8, 7, 7, 8, 7, 7, 6, 6, 5, 5,
+}
+
+var dictOffset = func() (off [maxWordLen + 1]int) {
+	n := 0
+	for l := minWordLen; l <= maxWordLen; l++ {
+		off[l] = n
+		n += l << dictSizeBits[l]
+	}
+	return off
+}()
+
+// Word transformations, in RFC 7932, Appendix B. Identity leaves
+// the word alone, omitFirst+n and omitLast+n drop its first or last
+// n bytes, for n from 1 to 9, and uppercaseFirst and uppercaseAll
+// convert its first character or all of it to upper case.
+const (
+	identity       = 0
+	omitLast       = 0
+	uppercaseFirst = 10
+	uppercaseAll   = 11
+	omitFirst      = 11
+)
+
+// A transform adds a prefix and a suffix to a transformed word.
+type transform struct {
+	prefix string
+	op     uint8
+	suffix string
+}
+
+var transforms = [...]transform{
+	{"", identity, ""},
+	{"", identity, " "},
+	{" ", identity, " "},
+	{"", omitFirst + 1, ""},
+	{"", uppercaseFirst, " "},
+	{"", identity, " the "},
+	{" ", identity, ""},
+	{"s ", identity, " "},
+	{"", identity, " of "},
+	{"", uppercaseFirst, ""},
+	{"", identity, " and "},
+	{"", omitFirst + 2, ""},
+	{"", omitLast + 1, ""},
+	{", ", identity, " "},
+	{"", identity, ", "},
+	{" ", uppercaseFirst, " "},
+	{"", identity, " in "},
+	{"", identity, " to "},
+	{"e ", identity, " "},
+	{"", identity, "\""},
+	{"", identity, "."},
+	{"", identity, "\">"},
+	{"", identity, "\x0a"},
+	{"", omitLast + 3, ""},
+	{"", identity, "]"},
+	{"", identity, " for "},
+	{"", omitFirst + 3, ""},
+	{"", omitLast + 2, ""},
+	{"", identity, " a "},
+	{"", identity, " that "},
+	{" ", uppercaseFirst, ""},
+	{"", identity, ". "},
+	{".", identity, ""},
+	{" ", identity, ", "},
+	{"", omitFirst + 4, ""},
+	{"", identity, " with "},
+	{"", identity, "'"},
+	{"", identity, " from "},
+	{"", identity, " by "},
+	{"", omitFirst + 5, ""},
+	{"", omitFirst + 6, ""},
+	{" the ", identity, ""},
+	{"", omitLast + 4, ""},
+	{"", identity, ". The "},
+	{"", uppercaseAll, ""},
+	{"", identity, " on "},
+	{"", identity, " as "},
+	{"", identity, " is "},
+	{"", omitLast + 7, ""},
+	{"", omitLast + 1, "ing "},
+	{"", identity, "\x0a\x09"},
+	{"", identity, ":"},
+	{" ", identity, ". "},
+	{"", identity, "ed "},
+	{"", omitFirst + 9, ""},
+	{"", omitFirst + 7, ""},
+	{"", omitLast + 6, ""},
+	{"", identity, "("},
+	{"", uppercaseFirst, ", "},
+	{"", omitLast + 8, ""},
+	{"", identity, " at "},
+	{"", identity, "ly "},
+	{" the ", identity, " of "},
+	{"", omitLast + 5, ""},
+	{"", omitLast + 9, ""},
+	{" ", uppercaseFirst, ", "},
+	{"", uppercaseFirst, "\""},
+	{".", identity, "("},
+	{"", uppercaseAll, " "},
+	{"", uppercaseFirst, "\">"},
+	{"", identity, "=\""},
+	{" ", identity, "."},
+	{".com/", identity, ""},
+	{" the ", identity, " of the "},
+	{"", uppercaseFirst, "'"},
+	{"", identity, ". This "},
+	{"", identity, ","},
+	{".", identity, " "},
+	{"", uppercaseFirst, "("},
+	{"", uppercaseFirst, "."},
+	{"", identity, " not "},
+	{" ", identity, "=\""},
+	{"", identity, "er "},
+	{" ", uppercaseAll, " "},
+	{"", identity, "al "},
+	{" ", uppercaseAll, ""},
+	{"", identity, "='"},
+	{"", uppercaseAll, "\""},
+	{"", uppercaseFirst, ". "},
+	{" ", identity, "("},
+	{"", identity, "ful "},
+	{" ", uppercaseFirst, ". "},
+	{"", identity, "ive "},
+	{"", identity, "less "},
+	{"", uppercaseAll, "'"},
+	{"", identity, "est "},
+	{" ", uppercaseFirst, "."},
+	{"", uppercaseAll, "\">"},
+	{" ", identity, "='"},
+	{"", uppercaseFirst, ","},
+	{"", identity, "ize "},
+	{"", uppercaseAll, "."},
+	{"\xc2\xa0", identity, ""},
+	{" ", identity, ","},
+	{"", uppercaseFirst, "=\""},
+	{"", uppercaseAll, "=\""},
+	{"", identity, "ous "},
+	{"", uppercaseAll, ", "},
+	{"", uppercaseFirst, "='"},
+	{" ", uppercaseFirst, ","},
+	{" ", uppercaseAll, "=\""},
+	{" ", uppercaseAll, ", "},
+	{"", uppercaseAll, ","},
+	{"", uppercaseAll, "("},
+	{"", uppercaseAll, ". "},
+	{" ", uppercaseAll, "."},
+	{"", uppercaseAll, "='"},
+	{" ", uppercaseAll, ". "},
+	{" ", uppercaseFirst, "=\""},
+	{" ", uppercaseAll, "='"},
+	{" ", uppercaseFirst, "='"},
+}
+
+// appendWord appends word, transformed by transform t, to dst.
+func appendWord(dst []byte, word string, t *transform) []byte {
+	dst = append(dst, t.prefix...)
+	switch op := t.op; {
+	case op <= omitLast+9:
+		word = word[:len(word)-min(int(op-omitLast), len(word))]
+	case op >= omitFirst+1:
+		word = word[min(int(op-omitFirst), len(word)):]
+	}
+	start := len(dst)
+	dst = append(dst, word...)
+	switch t.op {
+	case uppercaseFirst:
+		toUpper(dst[start:])
+	case uppercaseAll:
+		for w := dst[start:]; len(w) > 0; {
+			w = w[toUpper(w):]
+		}
+	}
+	return append(dst, t.suffix...)
+}
+
+// toUpper converts the first character of w to upper case and
+// returns its length. It is not a real case mapping: it only flips
+// a bit of the encoding, as RFC 7932 specifies.
+func toUpper(w []byte) int {
+	switch c := w[0]; {
+	case c < 0xC0:
+		if 'a' <= c && c <= 'z' {
+			w[0] ^= 0x20
+		}
+		return 1
+	case c < 0xE0:
+		if len(w) > 1 {
+			w[1] ^= 0x20
+		}
+		return min(2, len(w))
+	default:
+		if len(w) > 2 {
+			w[2] ^= 0x05
+		}
+		return min(3, len(w))
+	}
+}
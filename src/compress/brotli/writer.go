@@ -0,0 +1,202 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package brotli
+
+import (
+	"errors"
+	"fmt"
+	"io"
+)
+
+// Compression levels, following the conventions of [compress/flate].
+// Levels range from 1 ([BestSpeed]) to 9 ([BestCompression]);
+// higher levels typically run slower but compress more. Level 0
+// ([NoCompression]) does not attempt any compression; it only adds
+// the necessary framing. Level -1 ([DefaultCompression]) uses the
+// default compression level, which is 6.
+//
+// The levels do not correspond to the quality settings of the brotli
+// command.
+const (
+	NoCompression      = 0
+	BestSpeed          = 1
+	BestCompression    = 9
+	DefaultCompression = -1
+)
+
+var errWriterClosed = errors.New("brotli: closed writer")
+
+// A Writer is an [io.WriteCloser] that compresses data written to it
+// into a Brotli stream, written to an underlying writer.
+type Writer struct {
+	w      io.Writer
+	level  int
+	p      *levelParams
+	window int // size of the history buffer kept for matches
+
+	// buf holds history, followed by the data from buf[start:]
+	// that has not been compressed yet.
+	buf   []byte
+	start int
+
+	wroteHeader bool
+	enc         metaBlockEncoder
+	bw          bitWriter
+	err         error
+}
+
+// NewWriter returns a new [Writer] compressing data at the default level.
+// Writes to the returned writer are compressed and written to w.
+//
+// It is the caller's responsibility to call Close on the [Writer] when done.
+// Writes may be buffered and not flushed until Close.
+func NewWriter(w io.Writer) *Writer {
+	z, _ := NewWriterLevel(w, DefaultCompression)
+	return z
+}
+
+// NewWriterLevel is like [NewWriter] but specifies the compression level
+// instead of assuming [DefaultCompression].
+//
+// The compression level can be [DefaultCompression], [NoCompression],
+// or any integer value between [BestSpeed] and [BestCompression] inclusive.
+// The error returned will be nil if the level is valid.
+func NewWriterLevel(w io.Writer, level int) (*Writer, error) {
+	if level < DefaultCompression || level > BestCompression {
+		return nil, fmt.Errorf("brotli: invalid compression level: %d", level)
+	}
+	if level == DefaultCompression {
+		level = 6
+	}
+	z := &Writer{
+		level: level,
+		p:     &levels[level],
+	}
+	z.window = 1 << z.p.windowLog
+	z.Reset(w)
+	return z, nil
+}
+
+// Reset discards the [Writer] z's state and makes it equivalent to the
+// result of its original state from [NewWriter] or [NewWriterLevel],
+// but writing to w instead. This permits reusing a Writer rather than
+// allocating a new one.
+func (z *Writer) Reset(w io.Writer) {
+	z.w = w
+	z.buf = z.buf[:0]
+	z.start = 0
+	z.wroteHeader = false
+	z.bw.reset(z.bw.out[:0])
+	z.err = nil
+}
+
+// Write writes a compressed form of p to the underlying [io.Writer].
+// The compressed bytes are not necessarily flushed until the Writer
+// is closed or flushed.
+func (z *Writer) Write(p []byte) (int, error) {
+	if z.err != nil {
+		return 0, z.err
+	}
+	n := 0
+	for n < len(p) {
+		k := min(len(p)-n, maxMetaBlockSize-(len(z.buf)-z.start))
+		z.buf = append(z.buf, p[n:n+k]...)
+		n += k
+		if len(z.buf)-z.start == maxMetaBlockSize {
+			if err := z.compress(len(z.buf), false); err != nil {
+				return n, err
+			}
+		}
+	}
+	return n, nil
+}
+
+// Flush compresses any pending data and writes it to the underlying
+// writer. It is useful mainly in network protocols, to ensure that a
+// remote reader has enough data to reconstruct a packet. Flush does
+// not return until the data has been written. If the underlying
+// writer returns an error, Flush returns that error.
+//
+// To end the output on a byte boundary, Flush may write an empty
+// metadata meta-block, as the reference encoder does.
+func (z *Writer) Flush() error {
+	if z.err != nil {
+		return z.err
+	}
+	if err := z.compress(len(z.buf), false); err != nil {
+		return err
+	}
+	z.bw.out = z.bw.out[:0]
+	appendEmptyMetadata(&z.bw)
+	return z.write()
+}
+
+// Close closes the [Writer] by compressing any pending data and
+// writing the end of the stream to the underlying writer.
+// It does not close the underlying writer.
+func (z *Writer) Close() error {
+	if z.err != nil {
+		if z.err == errWriterClosed {
+			return nil
+		}
+		return z.err
+	}
+	if err := z.compress(len(z.buf), true); err != nil {
+		return err
+	}
+	z.err = errWriterClosed
+	return nil
+}
+
+// compress compresses z.buf[z.start:end] and writes it out,
+// except for the bits of an incomplete final byte.
+// If last is set, it ends the stream.
+func (z *Writer) compress(end int, last bool) error {
+	bw := &z.bw
+	bw.out = bw.out[:0]
+	if !z.wroteHeader {
+		sizeHint := 0
+		if last {
+			// We have seen all the data.
+			sizeHint = end
+		}
+		z.enc.reset(z.p, sizeHint)
+		appendStreamHeader(bw, z.p.windowLog)
+		z.wroteHeader = true
+	}
+	z.enc.encode(bw, z.buf, 0, z.start, end)
+	z.start = end
+	if last {
+		appendLastMetaBlock(bw)
+	}
+	if err := z.write(); err != nil {
+		return err
+	}
+
+	// Discard history that is out of reach, in multiples of
+	// the window size so that the hash chain stays aligned.
+	if z.start >= 2*z.window {
+		d := (z.start - z.window) &^ (z.window - 1)
+		n := copy(z.buf, z.buf[d:])
+		z.buf = z.buf[:n]
+		z.start -= d
+		if z.p.hashLog > 0 {
+			z.enc.m.shift(d)
+		}
+	}
+	return nil
+}
+
+// write writes the complete bytes of z.bw to the underlying writer.
+func (z *Writer) write() error {
+	if len(z.bw.out) == 0 {
+		return nil
+	}
+	if _, err := z.w.Write(z.bw.out); err != nil {
+		z.err = err
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,147 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package brotli
+
+import (
+	"bytes"
+	"io"
+	"math/rand"
+	"testing"
+)
+
+func roundTrip(t *testing.T, level int, in []byte) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	w, err := NewWriterLevel(&buf, level)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := w.Write(in); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	compressed := bytes.Clone(buf.Bytes())
+	out, err := io.ReadAll(NewReader(&buf))
+	if err != nil {
+		t.Fatalf("level %d: %v", level, err)
+	}
+	if !bytes.Equal(out, in) {
+		t.Fatalf("level %d: round trip mismatch for %d bytes", level, len(in))
+	}
+	return compressed
+}
+
+func TestRoundTrip(t *testing.T) {
+	random := make([]byte, 200<<10)
+	rand.New(rand.NewSource(1)).Read(random)
+	// Several meta-blocks with long-range matches.
+	long := bytes.Repeat(gettysburg, 3<<20/len(gettysburg))
+	copy(long[1<<20:], random)
+	inputs := map[string][]byte{
+		"empty":      nil,
+		"one byte":   {'x'},
+		"gettysburg": gettysburg,
+		"random":     random,
+		"zeros":      make([]byte, 3<<20),
+		"long":       long,
+	}
+	for name, in := range inputs {
+		for level := DefaultCompression; level <= BestCompression; level++ {
+			if testing.Short() && len(in) > 1<<20 && level > BestSpeed {
+				continue
+			}
+			out := roundTrip(t, level, in)
+			if level != NoCompression && name == "gettysburg" && len(out) > len(in)*3/5 {
+				t.Errorf("%s at level %d: compressed %d bytes to %d", name, level, len(in), len(out))
+			}
+			if name == "random" && len(out) > len(in)+len(in)/64 {
+				t.Errorf("%s at level %d: compressed %d bytes to %d", name, level, len(in), len(out))
+			}
+		}
+	}
+}
+
+func TestWriterFlush(t *testing.T) {
+	var buf bytes.Buffer
+	w := NewWriter(&buf)
+	var want []byte
+	for i := 1; i < 5; i++ {
+		w.Write(gettysburg[:100*i])
+		want = append(want, gettysburg[:100*i]...)
+		if err := w.Flush(); err != nil {
+			t.Fatal(err)
+		}
+
+		// Everything written so far can be read back.
+		r := NewReader(bytes.NewReader(buf.Bytes()))
+		got := make([]byte, len(want))
+		if _, err := io.ReadFull(r, got); err != nil {
+			t.Fatalf("after flush %d: %v", i, err)
+		}
+		if !bytes.Equal(got, want) {
+			t.Fatalf("after flush %d: mismatch", i)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	got, err := io.ReadAll(NewReader(&buf))
+	if err != nil || !bytes.Equal(got, want) {
+		t.Fatalf("after close: %v", err)
+	}
+}
+
+func TestWriterReset(t *testing.T) {
+	var buf1, buf2 bytes.Buffer
+	w := NewWriter(&buf1)
+	w.Write(gettysburg)
+	w.Close()
+	w.Reset(&buf2)
+	w.Write(gettysburg)
+	w.Close()
+	if !bytes.Equal(buf1.Bytes(), buf2.Bytes()) {
+		t.Errorf("output after Reset differs")
+	}
+	if err := w.Close(); err != nil {
+		t.Errorf("second Close: %v", err)
+	}
+	if _, err := w.Write(gettysburg); err == nil {
+		t.Errorf("Write after Close succeeded")
+	}
+}
+
+func TestInvalidLevel(t *testing.T) {
+	for _, level := range []int{-2, 10} {
+		if _, err := NewWriterLevel(io.Discard, level); err == nil {
+			t.Errorf("NewWriterLevel(%d) succeeded", level)
+		}
+	}
+}
+
+func BenchmarkEncode(b *testing.B) {
+	in := bytes.Repeat(gettysburg, 1<<20/len(gettysburg))
+	b.SetBytes(int64(len(in)))
+	for i := 0; i < b.N; i++ {
+		w := NewWriter(io.Discard)
+		w.Write(in)
+		w.Close()
+	}
+}
+
+func BenchmarkDecode(b *testing.B) {
+	in := bytes.Repeat(gettysburg, 1<<20/len(gettysburg))
+	var buf bytes.Buffer
+	w := NewWriter(&buf)
+	w.Write(in)
+	w.Close()
+	b.SetBytes(int64(len(in)))
+	r := new(Reader)
+	for i := 0; i < b.N; i++ {
+		r.Reset(bytes.NewReader(buf.Bytes()))
+		io.Copy(io.Discard, r)
+	}
+}
@@ -0,0 +1,44 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package bzip2
+
+// bitWriter accumulates values, most significant bit first, in a byte
+// slice. It is the counterpart of bitReader. Complete bytes are kept
+// in out until the caller consumes them; the bits of an incomplete
+// final byte are kept in n.
+type bitWriter struct {
+	out  []byte
+	n    uint64
+	bits uint
+}
+
+// WriteBits64 writes the low bits bits of v. bits is at most 56.
+func (bw *bitWriter) WriteBits64(bits uint, v uint64) {
+	bw.n = bw.n<<bits | v&(1<<bits-1)
+	bw.bits += bits
+	for bw.bits >= 8 {
+		bw.bits -= 8
+		bw.out = append(bw.out, byte(bw.n>>bw.bits))
+	}
+}
+
+func (bw *bitWriter) WriteBits(bits uint, v int) {
+	bw.WriteBits64(bits, uint64(v))
+}
+
+func (bw *bitWriter) WriteBit(b bool) {
+	if b {
+		bw.WriteBits64(1, 1)
+	} else {
+		bw.WriteBits64(1, 0)
+	}
+}
+
+// Align pads the output with zero bits up to a byte boundary.
+func (bw *bitWriter) Align() {
+	if bw.bits > 0 {
+		bw.WriteBits64(8-bw.bits, 0)
+	}
+}
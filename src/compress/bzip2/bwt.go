@@ -0,0 +1,156 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package bzip2
+
+import "slices"
+
+// A bwtSorter computes the Burrows-Wheeler transform of a block. It
+// keeps its scratch space so that it can be reused for many blocks.
+type bwtSorter struct {
+	sa, tmp []int32 // rotations, in sorted order
+	rank    []int32
+	count   []int32
+	groups  []bwtGroup
+	next    []bwtGroup
+	keys    []uint64
+}
+
+// A bwtGroup is a range of sa holding rotations that have not been
+// told apart yet.
+type bwtGroup struct {
+	start, end int32
+}
+
+// transform sets dst to the last column of the sorted rotations of
+// src and returns the position of src itself among the rotations,
+// which the format calls origPtr. dst must be as long as src.
+//
+// The rotations are sorted by prefix doubling, as in the algorithm of
+// Larsson and Sadakane. A radix sort orders them by their first four
+// bytes, then the pass for k orders them by their first 2k bytes.
+// The rank of each rotation is the index in sa of the first rotation
+// that shares its prefix, so a group of rotations with the same prefix
+// is sorted by the ranks of the rotations k bytes later. Groups that
+// hold a single rotation are done and are skipped by later passes.
+func (s *bwtSorter) transform(dst, src []byte) int {
+	n := len(src)
+	if cap(s.sa) < n {
+		s.sa = make([]int32, n)
+		s.tmp = make([]int32, n)
+		s.rank = make([]int32, n)
+	}
+	sa, tmp, rank := s.sa[:n], s.tmp[:n], s.rank[:n]
+
+	// Sort by the first four bytes with two passes of a radix sort,
+	// on the last two bytes and then, stably, on the first two.
+	key := func(i, off int) int {
+		i += off
+		if i >= n {
+			i -= n
+		}
+		j := i + 1
+		if j >= n {
+			j -= n
+		}
+		return int(src[i])<<8 | int(src[j])
+	}
+	if s.count == nil {
+		s.count = make([]int32, 1<<16)
+	}
+	count := s.count
+	for pass, off := range [2]int{2 % n, 0} {
+		clear(count)
+		for i := range n {
+			count[key(i, off)]++
+		}
+		sum := int32(0)
+		for b, c := range count {
+			count[b] = sum
+			sum += c
+		}
+		if pass == 0 {
+			for i := range n {
+				k := key(i, off)
+				tmp[count[k]] = int32(i)
+				count[k]++
+			}
+		} else {
+			for _, i := range tmp {
+				k := key(int(i), 0)
+				sa[count[k]] = i
+				count[k]++
+			}
+		}
+	}
+	groups := s.groups[:0]
+	start := 0
+	for i := 1; i <= n; i++ {
+		if i < n && key(int(sa[i-1]), 0) == key(int(sa[i]), 0) && key(int(sa[i-1]), 2%n) == key(int(sa[i]), 2%n) {
+			continue
+		}
+		for _, j := range sa[start:i] {
+			rank[j] = int32(start)
+		}
+		if i-start > 1 {
+			groups = append(groups, bwtGroup{int32(start), int32(i)})
+		}
+		start = i
+	}
+
+	for k := 4; k < n && len(groups) > 0; k *= 2 {
+		// Ranks of rotations in other groups may be refined while
+		// the pass runs. That only makes the keys more precise.
+		next := s.next[:0]
+		split := false
+		for _, g := range groups {
+			keys := s.keys[:0]
+			for _, i := range sa[g.start:g.end] {
+				j := int(i) + k
+				if j >= n {
+					j -= n
+				}
+				keys = append(keys, uint64(rank[j])<<32|uint64(i))
+			}
+			slices.Sort(keys)
+			s.keys = keys
+
+			start := 0
+			for i := 1; i <= len(keys); i++ {
+				if i < len(keys) && keys[i]>>32 == keys[i-1]>>32 {
+					continue
+				}
+				r := g.start + int32(start)
+				for _, key := range keys[start:i] {
+					sa[r] = int32(key)
+					rank[int32(key)] = g.start + int32(start)
+					r++
+				}
+				if i-start > 1 {
+					next = append(next, bwtGroup{g.start + int32(start), g.start + int32(i)})
+				}
+				split = split || start > 0
+				start = i
+			}
+		}
+		groups, s.next = next, groups
+		if !split {
+			// Rotations that agree on their first k bytes also
+			// agree on the next k, so they are equal, as happens
+			// when the block is periodic.
+			break
+		}
+	}
+	s.groups = groups
+
+	origPtr := 0
+	for i, j := range sa {
+		if j == 0 {
+			origPtr = i
+			j = int32(n)
+		}
+		dst[i] = src[j-1]
+	}
+	return origPtr
+}
@@ -232,3 +232,97 @@ func buildHuffmanNode(t *huffmanTree, codes []huffmanCode, level uint32) (nodeIn
 
 	return
 }
+
+// huffmanCodeLengths sets lengths to the code lengths of a Huffman
+// code for symbols with the given frequencies, none of which is longer
+// than maxLen. Like the bzip2 program, it gives every symbol a code,
+// even one with a zero frequency, and when the tree is too deep it
+// flattens the frequencies and tries again.
+func huffmanCodeLengths(lengths []uint8, freqs []int32, maxLen uint8) {
+	type node struct {
+		weight uint32
+		parent int32
+		depth  uint8
+	}
+	n := len(freqs)
+	nodes := make([]node, n, 2*n-1)
+	order := make([]int32, n)
+	weights := make([]uint32, n)
+	for i, f := range freqs {
+		weights[i] = uint32(max(f, 1))
+	}
+	for {
+		nodes = nodes[:n]
+		for i := range order {
+			order[i] = int32(i)
+			nodes[i] = node{weight: weights[i]}
+		}
+		slices.SortFunc(order, func(a, b int32) int {
+			return cmp.Compare(nodes[a].weight, nodes[b].weight)
+		})
+
+		// The leaves are sorted by weight, and internal nodes are
+		// created in order of increasing weight, so the two lightest
+		// nodes are always at the front of one of the two queues.
+		leaf, inner := 0, n
+		pick := func() int32 {
+			if leaf < n && (inner >= len(nodes) || nodes[order[leaf]].weight <= nodes[inner].weight) {
+				leaf++
+				return order[leaf-1]
+			}
+			inner++
+			return int32(inner - 1)
+		}
+		for len(nodes) < 2*n-1 {
+			a, b := pick(), pick()
+			parent := int32(len(nodes))
+			nodes[a].parent = parent
+			nodes[b].parent = parent
+			nodes = append(nodes, node{weight: nodes[a].weight + nodes[b].weight})
+		}
+
+		tooLong := false
+		for i := len(nodes) - 2; i >= 0; i-- {
+			d := nodes[nodes[i].parent].depth + 1
+			nodes[i].depth = d
+			if i < n {
+				lengths[i] = d
+				tooLong = tooLong || d > maxLen
+			}
+		}
+		if !tooLong {
+			return
+		}
+		for i := range weights {
+			weights[i] = 1 + weights[i]/2
+		}
+	}
+}
+
+// huffmanEncoder holds the codes of a canonical Huffman code, as
+// decoded by newHuffmanTree, indexed by symbol.
+type huffmanEncoder []huffmanCode
+
+// newHuffmanEncoder returns the canonical codes for the given code
+// lengths. Shorter codes come first, and codes of the same length are
+// ordered by symbol value.
+func newHuffmanEncoder(lengths []uint8) huffmanEncoder {
+	e := make(huffmanEncoder, len(lengths))
+	code := uint32(0)
+	for length := uint8(1); length <= 32; length++ {
+		for i, l := range lengths {
+			if l == length {
+				e[i] = huffmanCode{code: code, codeLen: l, value: uint16(i)}
+				code++
+			}
+		}
+		code <<= 1
+	}
+	return e
+}
+
+// Encode writes the code for v.
+func (e huffmanEncoder) Encode(bw *bitWriter, v uint16) {
+	c := &e[v]
+	bw.WriteBits64(uint(c.codeLen), uint64(c.code))
+}
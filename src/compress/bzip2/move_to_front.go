@@ -4,6 +4,8 @@
 
 package bzip2
 
+import "bytes"
+
 // moveToFrontDecoder implements a move-to-front list. Such a list is an
 // efficient way to transform a string with repeating elements into one with
 // many small valued numbers, which is suitable for entropy encoding. It works
@@ -51,3 +53,31 @@ func (m moveToFrontDecoder) Decode(n int) (b byte) {
 func (m moveToFrontDecoder) First() byte {
 	return m[0]
 }
+
+// moveToFrontEncoder is the inverse of moveToFrontDecoder: it replaces
+// each symbol by its index in the list, then moves it to the front.
+type moveToFrontEncoder []byte
+
+// newMTFEncoder creates a move-to-front encoder with an explicit initial
+// list of symbols.
+func newMTFEncoder(symbols []byte) moveToFrontEncoder {
+	if len(symbols) > 256 {
+		panic("too many symbols")
+	}
+	return moveToFrontEncoder(symbols)
+}
+
+// newMTFEncoderWithRange creates a move-to-front encoder with an initial
+// symbol list of 0...n-1.
+func newMTFEncoderWithRange(n int) moveToFrontEncoder {
+	return moveToFrontEncoder(newMTFDecoderWithRange(n))
+}
+
+// Encode returns the index of b, which must be in the list, and
+// moves b to the front of the list.
+func (m moveToFrontEncoder) Encode(b byte) int {
+	n := bytes.IndexByte(m, b)
+	copy(m[1:n+1], m[:n])
+	m[0] = b
+	return n
+}
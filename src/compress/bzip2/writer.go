@@ -0,0 +1,394 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package bzip2
+
+import (
+	"errors"
+	"fmt"
+	"io"
+)
+
+// Compression levels. The level sets the block size, in units of
+// 100,000 bytes; larger blocks usually compress better but need more
+// memory to compress and decompress.
+const (
+	BestSpeed          = 1
+	BestCompression    = 9
+	DefaultCompression = -1 // the same as BestCompression, like the bzip2 program
+)
+
+// Parameters of the entropy coder. They match those of the bzip2
+// program.
+const (
+	groupSize       = 50 // symbols coded with the same Huffman tree
+	maxCodeLen      = 17 // longest Huffman code written
+	numTableRefines = 4  // iterations improving the Huffman trees
+)
+
+var errWriterClosed = errors.New("bzip2: write to closed Writer")
+
+// A Writer is an [io.WriteCloser].
+// Writes to a Writer are compressed and written to w.
+type Writer struct {
+	w         io.Writer
+	level     int
+	maxBlock  int // limit on len(block)
+	block     []byte
+	blockCRC  uint32 // CRC of the data in block
+	fileCRC   uint32
+	runByte   byte // runLen copies of runByte have been written but not added to block
+	runLen    int
+	wroteHead bool
+	closed    bool
+	err       error
+
+	// Scratch space for compressing a block.
+	bw     bitWriter
+	sorter bwtSorter
+	bwt    []byte
+	mtfv   []uint16
+	sels   []uint8
+}
+
+// NewWriter returns a new [Writer] compressing data at the default level.
+// Writes to the returned writer are compressed and written to w.
+//
+// It is the caller's responsibility to call Close on the [Writer] when done.
+// Writes may be buffered and not flushed until Close.
+func NewWriter(w io.Writer) *Writer {
+	z, _ := NewWriterLevel(w, DefaultCompression)
+	return z
+}
+
+// NewWriterLevel is like [NewWriter] but specifies the compression level
+// instead of assuming [DefaultCompression].
+//
+// The compression level can be [DefaultCompression] or any integer value
+// between [BestSpeed] and [BestCompression] inclusive.
+// The error returned will be nil if the level is valid.
+func NewWriterLevel(w io.Writer, level int) (*Writer, error) {
+	if level == DefaultCompression {
+		level = BestCompression
+	}
+	if level < BestSpeed || level > BestCompression {
+		return nil, fmt.Errorf("bzip2: invalid compression level: %d", level)
+	}
+	z := &Writer{level: level}
+	z.Reset(w)
+	return z, nil
+}
+
+// Reset discards the [Writer] z's state and makes it equivalent to the
+// result of its original state from [NewWriter] or [NewWriterLevel], but
+// writing to w instead. This permits reusing a [Writer] rather than
+// allocating a new one.
+func (z *Writer) Reset(w io.Writer) {
+	z.w = w
+	// Leave room for the run in progress, which adds up to 5 bytes,
+	// as the reader rejects blocks of more than level*100000 bytes.
+	// The bzip2 program uses the same margin.
+	z.maxBlock = z.level*100000 - 19
+	z.block = z.block[:0]
+	z.blockCRC = 0
+	z.fileCRC = 0
+	z.runLen = 0
+	z.wroteHead = false
+	z.closed = false
+	z.err = nil
+	z.bw = bitWriter{out: z.bw.out[:0]}
+}
+
+// Write writes a compressed form of p to the underlying [io.Writer]. The
+// compressed bytes are not necessarily flushed until the [Writer] is closed.
+func (z *Writer) Write(p []byte) (int, error) {
+	if z.err != nil {
+		return 0, z.err
+	}
+	if z.closed {
+		return 0, errWriterClosed
+	}
+	for i, b := range p {
+		// Runs of 4 to 255 bytes are replaced by 4 bytes and a count.
+		if b == z.runByte && z.runLen > 0 && z.runLen < 255 {
+			z.runLen++
+			continue
+		}
+		if z.runLen > 0 {
+			z.endRun()
+			if len(z.block) >= z.maxBlock {
+				if err := z.writeBlock(); err != nil {
+					return i, err
+				}
+			}
+		}
+		z.runByte = b
+		z.runLen = 1
+	}
+	return len(p), nil
+}
+
+// endRun adds the current run of bytes to the block.
+func (z *Writer) endRun() {
+	b := z.runByte
+	crc := ^z.blockCRC
+	for range z.runLen {
+		crc = crctab[byte(crc>>24)^b] ^ crc<<8
+	}
+	z.blockCRC = ^crc
+	if z.runLen < 4 {
+		for range z.runLen {
+			z.block = append(z.block, b)
+		}
+	} else {
+		z.block = append(z.block, b, b, b, b, byte(z.runLen-4))
+	}
+	z.runLen = 0
+}
+
+// Close closes the [Writer] by compressing any unwritten data and
+// writing the end of the stream to the underlying [io.Writer].
+// It does not close the underlying writer.
+func (z *Writer) Close() error {
+	if z.err != nil {
+		return z.err
+	}
+	if z.closed {
+		return nil
+	}
+	z.closed = true
+	if z.runLen > 0 {
+		z.endRun()
+	}
+	if err := z.writeBlock(); err != nil {
+		return err
+	}
+	z.writeHeader()
+	bw := &z.bw
+	bw.WriteBits64(48, bzip2FinalMagic)
+	bw.WriteBits64(32, uint64(z.fileCRC))
+	bw.Align()
+	return z.flush()
+}
+
+func (z *Writer) writeHeader() {
+	if !z.wroteHead {
+		z.bw.out = append(z.bw.out, 'B', 'Z', 'h', byte('0'+z.level))
+		z.wroteHead = true
+	}
+}
+
+// flush writes the complete bytes of z.bw to the underlying writer.
+func (z *Writer) flush() error {
+	if len(z.bw.out) == 0 {
+		return nil
+	}
+	_, z.err = z.w.Write(z.bw.out)
+	z.bw.out = z.bw.out[:0]
+	return z.err
+}
+
+// writeBlock compresses the data in z.block and writes it out.
+func (z *Writer) writeBlock() error {
+	if len(z.block) == 0 {
+		return nil
+	}
+	z.writeHeader()
+
+	crc := z.blockCRC
+	z.blockCRC = 0
+	z.fileCRC = (z.fileCRC<<1 | z.fileCRC>>31) ^ crc
+
+	if cap(z.bwt) < len(z.block) {
+		z.bwt = make([]byte, len(z.block), z.maxBlock+4)
+	}
+	z.bwt = z.bwt[:len(z.block)]
+	origPtr := z.sorter.transform(z.bwt, z.block)
+	z.block = z.block[:0]
+
+	// The symbol set is reduced to the bytes used in the block.
+	var inUse [256]bool
+	for _, b := range z.bwt {
+		inUse[b] = true
+	}
+	var symbols []byte
+	for i, used := range inUse {
+		if used {
+			symbols = append(symbols, byte(i))
+		}
+	}
+	numSymbols := len(symbols) + 2 // RUNA, RUNB, MTF indexes 1 to len(symbols)-1 and EOF
+	eof := uint16(numSymbols - 1)
+
+	// Move-to-front and run-length encode the output of the BWT. A run
+	// of n references to the front of the list is written as n in
+	// bijective base 2, least significant digit first, with RUNA and
+	// RUNB as the digits 1 and 2.
+	var freqs [258]int32
+	mtf := newMTFEncoder(symbols)
+	z.mtfv = z.mtfv[:0]
+	emit := func(v uint16) {
+		z.mtfv = append(z.mtfv, v)
+		freqs[v]++
+	}
+	emitRun := func(run int) {
+		for run > 0 {
+			run--
+			emit(uint16(run & 1))
+			run >>= 1
+		}
+	}
+	run := 0
+	for _, b := range z.bwt {
+		n := mtf.Encode(b)
+		if n == 0 {
+			run++
+			continue
+		}
+		emitRun(run)
+		run = 0
+		emit(uint16(n + 1))
+	}
+	emitRun(run)
+	emit(eof)
+
+	lengths, numTrees := z.huffmanTables(freqs[:numSymbols])
+
+	bw := &z.bw
+	bw.WriteBits64(48, bzip2BlockMagic)
+	bw.WriteBits64(32, uint64(crc))
+	bw.WriteBit(false) // not randomized
+	bw.WriteBits(24, origPtr)
+
+	var ranges int
+	for r := range 16 {
+		for _, used := range inUse[16*r : 16*r+16] {
+			if used {
+				ranges |= 1 << (15 - r)
+				break
+			}
+		}
+	}
+	bw.WriteBits(16, ranges)
+	for r := range 16 {
+		if ranges&(1<<(15-r)) != 0 {
+			bits := 0
+			for i, used := range inUse[16*r : 16*r+16] {
+				if used {
+					bits |= 1 << (15 - i)
+				}
+			}
+			bw.WriteBits(16, bits)
+		}
+	}
+
+	bw.WriteBits(3, numTrees)
+	bw.WriteBits(15, len(z.sels))
+	treeMTF := newMTFEncoderWithRange(numTrees)
+	for _, s := range z.sels {
+		for range treeMTF.Encode(s) {
+			bw.WriteBit(true)
+		}
+		bw.WriteBit(false)
+	}
+
+	// The code lengths are delta encoded from a 5-bit base value.
+	encoders := make([]huffmanEncoder, numTrees)
+	for t := range encoders {
+		length := lengths[t][0]
+		bw.WriteBits(5, int(length))
+		for _, l := range lengths[t][:numSymbols] {
+			for ; length < l; length++ {
+				bw.WriteBits(2, 2)
+			}
+			for ; length > l; length-- {
+				bw.WriteBits(2, 3)
+			}
+			bw.WriteBit(false)
+		}
+		encoders[t] = newHuffmanEncoder(lengths[t][:numSymbols])
+	}
+
+	for i, v := range z.mtfv {
+		encoders[z.sels[i/groupSize]].Encode(bw, v)
+	}
+	return z.flush()
+}
+
+// huffmanTables chooses the Huffman trees for z.mtfv and sets z.sels to
+// the tree used for each group of symbols. It follows the bzip2
+// program: the symbols are first split into ranges of similar total
+// frequency, with a tree favoring each range, and the trees are then
+// refined by choosing the best tree for each group and rebuilding the
+// trees from the symbols of the groups using them.
+func (z *Writer) huffmanTables(freqs []int32) (lengths [6][258]uint8, numTrees int) {
+	n := len(z.mtfv)
+	switch {
+	case n < 200:
+		numTrees = 2
+	case n < 600:
+		numTrees = 3
+	case n < 1200:
+		numTrees = 4
+	case n < 2400:
+		numTrees = 5
+	default:
+		numTrees = 6
+	}
+	numSymbols := len(freqs)
+
+	remaining := n
+	start := 0
+	for part := numTrees; part > 0; part-- {
+		target := remaining / part
+		end := start - 1
+		sum := 0
+		for sum < target && end < numSymbols-1 {
+			end++
+			sum += int(freqs[end])
+		}
+		if end > start && part != numTrees && part != 1 && (numTrees-part)%2 == 1 {
+			sum -= int(freqs[end])
+			end--
+		}
+		for v := range numSymbols {
+			if v < start || v > end {
+				lengths[part-1][v] = 15
+			}
+		}
+		start = end + 1
+		remaining -= sum
+	}
+
+	numGroups := (n + groupSize - 1) / groupSize
+	if cap(z.sels) < numGroups {
+		z.sels = make([]uint8, numGroups)
+	}
+	z.sels = z.sels[:numGroups]
+	var treeFreqs [6][258]int32
+	for range numTableRefines {
+		treeFreqs = [6][258]int32{}
+		for g := range numGroups {
+			group := z.mtfv[g*groupSize : min(g*groupSize+groupSize, n)]
+			best, bestCost := 0, -1
+			for t := range numTrees {
+				cost := 0
+				for _, v := range group {
+					cost += int(lengths[t][v])
+				}
+				if bestCost < 0 || cost < bestCost {
+					best, bestCost = t, cost
+				}
+			}
+			z.sels[g] = uint8(best)
+			for _, v := range group {
+				treeFreqs[best][v]++
+			}
+		}
+		for t := range numTrees {
+			huffmanCodeLengths(lengths[t][:numSymbols], treeFreqs[t][:numSymbols], maxCodeLen)
+		}
+	}
+	return lengths, numTrees
+}
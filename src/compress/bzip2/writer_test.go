@@ -0,0 +1,266 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package bzip2
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+// mustDecompress returns the contents of the bzip2 file f.
+func mustDecompress(f string) []byte {
+	b, err := io.ReadAll(NewReader(bytes.NewReader(mustLoadFile(f))))
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// maxSize returns the largest acceptable output size at BestCompression
+// for the file f, which was written by the bzip2 program at level 9.
+func maxSize(f string) int {
+	n := len(mustLoadFile(f))
+	return n + n/100 + 16
+}
+
+func TestWriter(t *testing.T) {
+	type vector struct {
+		desc   string
+		level  int
+		input  []byte
+		output []byte // Exact output, if non-nil
+		max    int    // Maximum output size, if non-zero
+	}
+	var vectors = []vector{{
+		desc:   "empty",
+		level:  DefaultCompression,
+		output: mustDecodeHex("425a683917724538509000000000"),
+	}, {
+		desc:  "hello world",
+		level: DefaultCompression,
+		input: []byte("hello world\n"),
+	}, {
+		desc:  "digits of e",
+		level: BestCompression,
+		input: mustDecompress("testdata/e.txt.bz2"),
+		max:   maxSize("testdata/e.txt.bz2"),
+	}, {
+		desc:  "digits of e at BestSpeed",
+		level: BestSpeed,
+		input: mustDecompress("testdata/e.txt.bz2"),
+	}, {
+		desc:  "Newton's Opticks",
+		level: BestCompression,
+		input: mustDecompress("testdata/Isaac.Newton-Opticks.txt.bz2"),
+		max:   maxSize("testdata/Isaac.Newton-Opticks.txt.bz2"),
+	}, {
+		desc:  "random data",
+		level: BestCompression,
+		input: mustDecompress("testdata/random.data.bz2"),
+		max:   maxSize("testdata/random.data.bz2"),
+	}, {
+		desc:  "random1",
+		level: 5,
+		input: mustLoadFile("testdata/pass-random1.bin"),
+	}, {
+		desc:  "1MiB sawtooth",
+		level: BestCompression,
+		input: mustDecompress("testdata/pass-sawtooth.bz2"),
+		max:   maxSize("testdata/pass-sawtooth.bz2"),
+	}, {
+		desc:  "1MiB of zeros",
+		level: BestSpeed,
+		input: make([]byte, 1<<20),
+	}, {
+		// Blocks must end at run boundaries.
+		desc:  "runs across blocks",
+		level: BestSpeed,
+		input: func() []byte {
+			var b []byte
+			for i := 0; len(b) < 350000; i++ {
+				b = append(b, bytes.Repeat([]byte{byte(i)}, i%9)...)
+			}
+			return b
+		}(),
+	}, {
+		// No block may expand beyond the size the reader allows.
+		desc:  "all byte values",
+		level: BestSpeed,
+		input: func() []byte {
+			b := make([]byte, 4096)
+			for i := range b {
+				b[i] = byte(i * 7)
+			}
+			return bytes.Repeat(b, 100)
+		}(),
+	}}
+
+	// The run-length encoding before the BWT handles runs of 4 to
+	// 255 bytes; check the lengths around its limits.
+	for _, n := range []int{1, 3, 4, 5, 254, 255, 256, 259, 260, 510, 1000} {
+		run := bytes.Repeat([]byte{'a'}, n)
+		vectors = append(vectors,
+			vector{desc: fmt.Sprintf("run of %d", n), level: BestSpeed, input: run},
+			vector{desc: fmt.Sprintf("bracketed run of %d", n), level: BestSpeed, input: []byte("x" + string(run) + "y")},
+		)
+	}
+
+	for i, v := range vectors {
+		var buf bytes.Buffer
+		w, err := NewWriterLevel(&buf, v.level)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := w.Write(v.input); err != nil {
+			t.Errorf("test %d (%s), unexpected Write failure: %v", i, v.desc, err)
+			continue
+		}
+		if err := w.Close(); err != nil {
+			t.Errorf("test %d (%s), unexpected Close failure: %v", i, v.desc, err)
+			continue
+		}
+		if v.output != nil && !bytes.Equal(buf.Bytes(), v.output) {
+			t.Errorf("test %d (%s), output mismatch: got %x, want %x", i, v.desc, buf.Bytes(), v.output)
+		}
+		if v.max != 0 && buf.Len() > v.max {
+			t.Errorf("test %d (%s), compressed to %d bytes, want at most %d", i, v.desc, buf.Len(), v.max)
+		}
+		got, err := io.ReadAll(NewReader(&buf))
+		if err != nil {
+			t.Errorf("test %d (%s), unexpected failure: %v", i, v.desc, err)
+			continue
+		}
+		if !bytes.Equal(got, v.input) {
+			t.Errorf("test %d (%s), round trip mismatch:\ngot  %s\nwant %s", i, v.desc, trim(got), trim(v.input))
+		}
+	}
+}
+
+func TestWriterSmallWrites(t *testing.T) {
+	in := append(mustLoadFile("testdata/pass-random1.bin"), make([]byte, 300)...)
+	var buf1, buf2 bytes.Buffer
+	w := NewWriter(&buf1)
+	w.Write(in)
+	w.Close()
+	w = NewWriter(&buf2)
+	for i := range in {
+		w.Write(in[i : i+1])
+	}
+	w.Close()
+	if !bytes.Equal(buf1.Bytes(), buf2.Bytes()) {
+		t.Errorf("output of byte-at-a-time writes differs")
+	}
+}
+
+func TestWriterReset(t *testing.T) {
+	in := mustLoadFile("testdata/pass-random2.bin")
+	var buf1, buf2 bytes.Buffer
+	w := NewWriter(&buf1)
+	w.Write(in)
+	w.Close()
+	w.Reset(&buf2)
+	w.Write(in)
+	w.Close()
+	if !bytes.Equal(buf1.Bytes(), buf2.Bytes()) {
+		t.Errorf("output after Reset differs")
+	}
+	if err := w.Close(); err != nil {
+		t.Errorf("second Close: %v", err)
+	}
+	if _, err := w.Write(in); err == nil {
+		t.Errorf("Write after Close succeeded")
+	}
+}
+
+func TestInvalidLevel(t *testing.T) {
+	for _, level := range []int{-2, 0, 10} {
+		if _, err := NewWriterLevel(io.Discard, level); err == nil {
+			t.Errorf("NewWriterLevel(%d) succeeded", level)
+		}
+	}
+}
+
+func TestBWT(t *testing.T) {
+	// Compare with sorting the rotations directly, on inputs with
+	// few distinct bytes and so many long repeats.
+	r := rand.New(rand.NewSource(1))
+	var s bwtSorter
+	for i := 0; i < 1000; i++ {
+		src := make([]byte, 1+r.Intn(100))
+		for j := range src {
+			src[j] = 'a' + byte(r.Intn(1+i%3))
+		}
+		if i%10 == 0 {
+			src = bytes.Repeat(src[:1+len(src)/10], 8)
+		}
+		n := len(src)
+		double := append(slices.Clone(src), src...)
+		rotations := make([]int, n)
+		for j := range rotations {
+			rotations[j] = j
+		}
+		slices.SortStableFunc(rotations, func(a, b int) int {
+			return bytes.Compare(double[a:a+n], double[b:b+n])
+		})
+		want := make([]byte, n)
+		for j, rot := range rotations {
+			want[j] = double[rot+n-1]
+		}
+
+		got := make([]byte, n)
+		origPtr := s.transform(got, src)
+		if !bytes.Equal(got, want) {
+			t.Fatalf("test %d, transform(%q) = %q, want %q", i, src, got, want)
+		}
+		if !bytes.Equal(double[rotations[origPtr]:rotations[origPtr]+n], src) {
+			t.Fatalf("test %d, transform(%q): origPtr %d is not the original", i, src, origPtr)
+		}
+	}
+}
+
+func TestMTFEncoder(t *testing.T) {
+	var vectors = []struct {
+		sym uint8 // Input symbol
+		idx int   // Expected output index
+	}{
+		{sym: 1, idx: 1}, // [1 0 2 3 4]
+		{sym: 1, idx: 0}, // [1 0 2 3 4]
+		{sym: 0, idx: 1}, // [0 1 2 3 4]
+		{sym: 4, idx: 4}, // [4 0 1 2 3]
+		{sym: 0, idx: 1}, // [0 4 1 2 3]
+	}
+
+	mtf := newMTFEncoderWithRange(5)
+	for i, v := range vectors {
+		if idx := mtf.Encode(v.sym); idx != v.idx {
+			t.Errorf("test %d, index mismatch: Encode(%d) = %d, want %d", i, v.sym, idx, v.idx)
+		}
+	}
+}
+
+func benchmarkEncode(b *testing.B, compressed []byte) {
+	in, err := io.ReadAll(NewReader(bytes.NewReader(compressed)))
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.SetBytes(int64(len(in)))
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		w := NewWriter(io.Discard)
+		w.Write(in)
+		w.Close()
+	}
+}
+
+func BenchmarkEncodeDigits(b *testing.B) { benchmarkEncode(b, digits) }
+func BenchmarkEncodeNewton(b *testing.B) { benchmarkEncode(b, newton) }
+func BenchmarkEncodeRand(b *testing.B)   { benchmarkEncode(b, random) }
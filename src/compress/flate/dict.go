@@ -0,0 +1,224 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package flate
+
+import "encoding/binary"
+
+// Parameters of the dictionary trainer.
+const (
+	trainDmerLen   = 8   // length of the substrings whose frequencies are counted
+	trainSegment   = 256 // maximum length of the segments copied into the dictionary
+	trainHashBits  = 20
+	trainHashShift = 64 - trainHashBits
+)
+
+// TrainDict returns a preset dictionary of at most size bytes for
+// compressing data that resembles the samples. The dictionary is made of
+// segments of the samples that contain the substrings common to the most
+// samples, with the most valuable segments at its end, where matches are
+// cheapest to refer to. The result may be shorter than size, or empty,
+// if the samples have little in common.
+//
+// The dictionary can be passed to [NewWriterDict] and [NewReaderDict],
+// to the corresponding functions of compress/zlib, and as raw content
+// to those of compress/zstd. Deflate only refers to the last 32 KiB of
+// a dictionary, so sizes beyond that are only useful for zstd.
+//
+// Training works best with many samples, each of which is like the
+// data to be compressed in one stream. Use [MeasureDict] to check the
+// effect of the dictionary on a different set of samples.
+func TrainDict(samples [][]byte, size int) []byte {
+	if size <= 0 {
+		return nil
+	}
+
+	// Lay out the samples in one buffer, and count in how many samples
+	// each substring of trainDmerLen bytes (identified by its hash)
+	// occurs. Substrings that do not cross sample boundaries are
+	// marked as valid.
+	var data []byte
+	for _, s := range samples {
+		data = append(data, s...)
+	}
+	if len(data) < trainDmerLen {
+		return nil
+	}
+	hashes := make([]uint32, len(data))
+	valid := make([]bool, len(data))
+	freq := make([]uint32, 1<<trainHashBits)
+	last := make([]int32, 1<<trainHashBits) // last sample containing each hash, plus one
+	start := 0
+	for i, s := range samples {
+		for j := 0; j+trainDmerLen <= len(s); j++ {
+			h := trainHash(s[j:])
+			hashes[start+j] = h
+			valid[start+j] = true
+			if last[h] != int32(i+1) {
+				last[h] = int32(i + 1)
+				freq[h]++
+			}
+		}
+		start += len(s)
+	}
+	// A substring that occurs in a single sample cannot help
+	// compress other data.
+	for h, f := range freq {
+		if f < 2 {
+			freq[h] = 0
+		}
+	}
+
+	// Divide the data into epochs and pick the best segment of each in
+	// turn, cycling through the epochs until the dictionary is full or
+	// no epoch has anything left to offer.
+	segment := max(min(trainSegment, size), trainDmerLen)
+	epochs := max(size/segment, 1)
+	epochLen := len(data) / epochs
+	if epochLen < segment {
+		epochLen = min(segment, len(data))
+		epochs = len(data) / epochLen
+	}
+	dict := make([]byte, size)
+	tail := size
+	active := make([]uint16, 1<<trainHashBits)
+	for epoch, barren := 0, 0; tail > 0 && barren < epochs; epoch = (epoch + 1) % epochs {
+		begin := epoch * epochLen
+		lo, hi := bestSegment(hashes[begin:begin+epochLen], valid[begin:begin+epochLen], freq, active, segment)
+		if lo == hi {
+			barren++
+			continue
+		}
+		barren = 0
+		lo += begin
+		hi += begin
+		for i := lo; i < hi; i++ {
+			if valid[i] {
+				freq[hashes[i]] = 0
+			}
+		}
+		n := min(hi-lo+trainDmerLen-1, tail)
+		tail -= n
+		copy(dict[tail:], data[lo:lo+n])
+	}
+	return dict[tail:]
+}
+
+// trainHash returns the hash of the first trainDmerLen bytes of b.
+func trainHash(b []byte) uint32 {
+	return uint32((binary.LittleEndian.Uint64(b) * 0xcf1bbcdcb7a56463) >> trainHashShift)
+}
+
+// bestSegment returns the positions [lo, hi) of the substrings that
+// make up the segment of segment bytes with the highest total
+// frequency of distinct substrings, trimmed of substrings with a
+// frequency of zero. It returns lo == hi if there is no such segment.
+// The active table must be zero and is left zero.
+func bestSegment(hashes []uint32, valid []bool, freq []uint32, active []uint16, segment int) (lo, hi int) {
+	width := segment - trainDmerLen + 1
+	var score, best uint64
+	bestStart, bestEnd := 0, 0
+	for i := range hashes {
+		if valid[i] {
+			h := hashes[i]
+			if active[h] == 0 {
+				score += uint64(freq[h])
+			}
+			active[h]++
+		}
+		if j := i - width; j >= 0 && valid[j] {
+			h := hashes[j]
+			active[h]--
+			if active[h] == 0 {
+				score -= uint64(freq[h])
+			}
+		}
+		if score > best {
+			best = score
+			bestStart, bestEnd = max(i-width+1, 0), i+1
+		}
+	}
+	for i := max(len(hashes)-width, 0); i < len(hashes); i++ {
+		if valid[i] {
+			active[hashes[i]] = 0
+		}
+	}
+	if best == 0 {
+		return 0, 0
+	}
+
+	lo, hi = bestStart, bestEnd
+	for !valid[lo] || freq[hashes[lo]] == 0 {
+		lo++
+	}
+	for !valid[hi-1] || freq[hashes[hi-1]] == 0 {
+		hi--
+	}
+	return lo, hi
+}
+
+// DictStats describes the effect of a preset dictionary on the
+// compression of a set of samples, each compressed as a separate stream.
+type DictStats struct {
+	Samples        int   // number of samples
+	Size           int64 // total size of the samples
+	Compressed     int64 // total compressed size without the dictionary
+	CompressedDict int64 // total compressed size with the dictionary
+}
+
+// Gain returns the fraction of the compressed size saved by the
+// dictionary. It is negative if the dictionary makes the output larger.
+func (s DictStats) Gain() float64 {
+	if s.Compressed == 0 {
+		return 0
+	}
+	return 1 - float64(s.CompressedDict)/float64(s.Compressed)
+}
+
+// MeasureDict compresses each of the samples at the given level with
+// and without the dictionary dict and reports the resulting sizes.
+// The samples should not be the ones the dictionary was trained on,
+// as the dictionary is bound to compress those well.
+//
+// At [BestSpeed], [NoCompression] and [HuffmanOnly], the compressor
+// does not use the dictionary.
+func MeasureDict(samples [][]byte, dict []byte, level int) (DictStats, error) {
+	var c countWriter
+	plain, err := NewWriter(&c, level)
+	if err != nil {
+		return DictStats{}, err
+	}
+	withDict, err := NewWriterDict(&c, level, dict)
+	if err != nil {
+		return DictStats{}, err
+	}
+	st := DictStats{Samples: len(samples)}
+	for _, s := range samples {
+		st.Size += int64(len(s))
+		for _, w := range []*Writer{plain, withDict} {
+			c = 0
+			w.Reset(&c)
+			if _, err := w.Write(s); err != nil {
+				return DictStats{}, err
+			}
+			if err := w.Close(); err != nil {
+				return DictStats{}, err
+			}
+			if w == plain {
+				st.Compressed += int64(c)
+			} else {
+				st.CompressedDict += int64(c)
+			}
+		}
+	}
+	return st, nil
+}
+
+// countWriter counts the bytes written to it.
+type countWriter int64
+
+func (c *countWriter) Write(p []byte) (int, error) {
+	*c += countWriter(len(p))
+	return len(p), nil
+}
@@ -0,0 +1,121 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package flate
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"math/rand"
+	"testing"
+)
+
+// jsonRecords returns n small JSON records with a common structure.
+func jsonRecords(r *rand.Rand, n int) [][]byte {
+	names := []string{"alice", "bob", "carol", "dave", "erin", "frank", "grace", "heidi"}
+	states := []string{"active", "suspended", "pending_verification", "deleted"}
+	var recs [][]byte
+	for i := 0; i < n; i++ {
+		recs = append(recs, fmt.Appendf(nil,
+			`{"id":%d,"user":{"name":%q,"email":"%s%d@example.com","created_at":"2024-%02d-%02dT%02d:%02d:00Z"},`+
+				`"status":%q,"preferences":{"theme":"dark","notifications":{"email":%t,"push":%t}},"score":%d}`,
+			r.Intn(1e6), names[r.Intn(len(names))], names[r.Intn(len(names))], r.Intn(1000),
+			1+r.Intn(12), 1+r.Intn(28), r.Intn(24), r.Intn(60),
+			states[r.Intn(len(states))], r.Intn(2) == 0, r.Intn(2) == 0, r.Intn(100)))
+	}
+	return recs
+}
+
+func TestTrainDict(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	train, test := jsonRecords(r, 2000), jsonRecords(r, 200)
+	for _, tt := range []struct {
+		size    int
+		minGain float64
+	}{
+		{100, 0.2},
+		{1 << 10, 0.6},
+		{4 << 10, 0.6},
+		{32 << 10, 0.6},
+	} {
+		size := tt.size
+		dict := TrainDict(train, size)
+		if len(dict) == 0 || len(dict) > size {
+			t.Fatalf("TrainDict(%d) returned %d bytes", size, len(dict))
+		}
+		if again := TrainDict(train, size); !bytes.Equal(dict, again) {
+			t.Errorf("TrainDict(%d) is not deterministic", size)
+		}
+		st, err := MeasureDict(test, dict, DefaultCompression)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if st.Samples != len(test) || st.Compressed == 0 || st.CompressedDict == 0 {
+			t.Fatalf("MeasureDict = %+v", st)
+		}
+		if g := st.Gain(); g < tt.minGain {
+			t.Errorf("%d-byte dictionary: gain %.2f, want at least %.2f (%+v)", size, g, tt.minGain, st)
+		}
+
+		// Check that the samples decompress.
+		var buf bytes.Buffer
+		w, _ := NewWriterDict(&buf, DefaultCompression, dict)
+		w.Write(test[0])
+		w.Close()
+		got, err := io.ReadAll(NewReaderDict(&buf, dict))
+		if err != nil || !bytes.Equal(got, test[0]) {
+			t.Errorf("round trip: %q, %v", got, err)
+		}
+	}
+}
+
+func TestTrainDictDegenerate(t *testing.T) {
+	for _, samples := range [][][]byte{
+		nil,
+		{[]byte("short")},
+		{[]byte("a single sample has nothing in common with others")},
+		{[]byte("abcdefghijklmnop"), []byte("qrstuvwxyz012345")},
+	} {
+		if dict := TrainDict(samples, 1024); len(dict) != 0 {
+			t.Errorf("TrainDict(%q) = %q, want empty", samples, dict)
+		}
+	}
+	if dict := TrainDict([][]byte{[]byte("common text"), []byte("common text")}, 0); dict != nil {
+		t.Errorf("TrainDict with size 0 = %q", dict)
+	}
+	dict := TrainDict([][]byte{[]byte("x common text y"), []byte("z common text w")}, 1024)
+	if !bytes.Contains(dict, []byte("common text")) {
+		t.Errorf("TrainDict = %q, want common text", dict)
+	}
+}
+
+func TestMeasureDict(t *testing.T) {
+	if _, err := MeasureDict(nil, nil, 10); err == nil {
+		t.Errorf("MeasureDict with invalid level succeeded")
+	}
+	samples := [][]byte{[]byte("hello, world"), []byte("hello, gopher")}
+	st, err := MeasureDict(samples, []byte("hello, "), BestSpeed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if st.Size != 25 || st.Compressed != st.CompressedDict || st.Gain() != 0 {
+		t.Errorf("MeasureDict at BestSpeed = %+v, gain %v", st, st.Gain())
+	}
+	if st, _ := MeasureDict(nil, nil, DefaultCompression); st.Gain() != 0 {
+		t.Errorf("MeasureDict with no samples = %+v", st)
+	}
+}
+
+func BenchmarkTrainDict(b *testing.B) {
+	samples := jsonRecords(rand.New(rand.NewSource(1)), 5000)
+	n := 0
+	for _, s := range samples {
+		n += len(s)
+	}
+	b.SetBytes(int64(n))
+	for i := 0; i < b.N; i++ {
+		TrainDict(samples, 16<<10)
+	}
+}
@@ -241,3 +241,29 @@ func Example_synchronization() {
 	// Received 3 bytes: far
 	// Received 7 bytes: away...
 }
+
+// A preset dictionary can be trained from samples of the data to be
+// compressed. This pays off when compressing many small, similar
+// messages, each as a separate stream.
+func ExampleTrainDict() {
+	record := func(i int) []byte {
+		return fmt.Appendf(nil, `{"id":%d,"kind":"order","status":%q,"customer":{"name":"customer-%d","country":"NL"},"total":%d.%02d}`,
+			i, []string{"placed", "shipped", "delivered"}[i%3], i*7919%1000, i*31%500, i%100)
+	}
+	var train, test [][]byte
+	for i := 0; i < 1000; i++ {
+		train = append(train, record(i))
+		test = append(test, record(1000+i))
+	}
+
+	dict := flate.TrainDict(train, 1024)
+	stats, err := flate.MeasureDict(test, dict, flate.DefaultCompression)
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Printf("%d bytes compress to %d bytes, or %d bytes with the dictionary\n",
+		stats.Size, stats.Compressed, stats.CompressedDict)
+
+	// Output:
+	// 110003 bytes compress to 98546 bytes, or 24706 bytes with the dictionary
+}
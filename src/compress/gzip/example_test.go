@@ -130,6 +130,36 @@ func ExampleReader_Multistream() {
 	// Hello Gophers - 2
 }
 
+func ExampleSeekReader() {
+	// Compress 4 members of up to 16 bytes at a time.
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	zw.SetConcurrency(16, 4)
+	for i := range 10 {
+		fmt.Fprintf(zw, "line %d\n", i)
+	}
+	if err := zw.Close(); err != nil {
+		log.Fatal(err)
+	}
+
+	// The index can be stored next to the compressed file,
+	// or rebuilt from it with gzip.BuildIndex.
+	index := zw.Index()
+	fmt.Printf("%d bytes in %d members\n", index.Size(), index.Len())
+
+	// Reading starts at the member holding the offset.
+	zr := gzip.NewSeekReader(bytes.NewReader(buf.Bytes()), index)
+	line := make([]byte, 7)
+	if _, err := zr.ReadAt(line, 49); err != nil {
+		log.Fatal(err)
+	}
+	fmt.Printf("%q\n", line)
+
+	// Output:
+	// 70 bytes in 5 members
+	// "line 7\n"
+}
+
 func Example_compressingReader() {
 	// This is an example of writing a compressing reader.
 	// This can be useful for an HTTP client body, as shown.
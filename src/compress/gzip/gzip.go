@@ -5,11 +5,14 @@
 package gzip
 
 import (
+	"bytes"
 	"compress/flate"
 	"errors"
 	"fmt"
 	"hash/crc32"
 	"io"
+	"slices"
+	"sync"
 	"time"
 )
 
@@ -23,6 +26,8 @@ const (
 	HuffmanOnly        = flate.HuffmanOnly
 )
 
+var errWriterClosed = errors.New("gzip: closed writer")
+
 // A Writer is an io.WriteCloser.
 // Writes to a Writer are compressed and written to w.
 type Writer struct {
@@ -36,6 +41,24 @@ type Writer struct {
 	digest      uint32 // CRC-32, IEEE polynomial (section 8)
 	size        uint32 // Uncompressed size (section 2.3.1)
 	err         error
+
+	// Members written so far, for Index.
+	index   []indexMember
+	offset  int64 // compressed bytes written
+	rawSize int64 // uncompressed bytes written
+
+	// Concurrent compression, enabled by SetConcurrency.
+	blockSize int
+	blocks    int
+	pending   []byte // data not compressed yet
+	workers   []*memberWriter
+}
+
+// A memberWriter compresses a member of a multistream file.
+type memberWriter struct {
+	z   *Writer
+	out bytes.Buffer
+	raw int
 }
 
 // NewWriter returns a new [Writer].
@@ -78,9 +101,45 @@ func (z *Writer) init(w io.Writer, level int) {
 		w:          w,
 		level:      level,
 		compressor: compressor,
+		index:      z.index[:0],
+		blockSize:  z.blockSize,
+		blocks:     z.blocks,
+		pending:    z.pending[:0],
+		workers:    z.workers,
 	}
 }
 
+// SetConcurrency makes the [Writer] write a multistream file, as
+// described in [Reader.Multistream], compressing up to blocks members
+// of blockSize bytes each at the same time on separate goroutines.
+// This makes compressing large inputs faster, at the cost of buffering
+// blockSize*blocks bytes and of a slightly worse compression ratio,
+// as each member is compressed independently. Because the members are
+// independent, a [SeekReader] can start reading the data at any of
+// them; see [Writer.Index].
+//
+// Only the first member carries the Header fields, except for ModTime
+// and OS. Flush ends the current member. A blockSize less than 1
+// means 1 MiB, and a value of blocks less than 1 means 1.
+//
+// SetConcurrency must be called before the first call to Write,
+// Flush, or Close. The setting survives Reset.
+func (z *Writer) SetConcurrency(blockSize, blocks int) {
+	if blockSize < 1 {
+		blockSize = 1 << 20
+	}
+	z.blockSize = blockSize
+	z.blocks = max(blocks, 1)
+}
+
+// Index returns an [Index] of the members that z has written so far,
+// which is complete after Close. Without [Writer.SetConcurrency] the
+// output is a single member, whose data can only be read from the
+// start.
+func (z *Writer) Index() *Index {
+	return &Index{members: slices.Clone(z.index), size: z.rawSize}
+}
+
 // Reset discards the [Writer] z's state and makes it equivalent to the
 // result of its original state from [NewWriter] or [NewWriterLevel], but
 // writing to w instead. This permits reusing a [Writer] rather than
@@ -140,10 +199,14 @@ func (z *Writer) Write(p []byte) (int, error) {
 	if z.err != nil {
 		return 0, z.err
 	}
+	if z.blocks > 0 {
+		return z.writeConcurrent(p)
+	}
 	var n int
 	// Write the GZIP header lazily.
 	if !z.wroteHeader {
 		z.wroteHeader = true
+		z.index = append(z.index, indexMember{})
 		z.buf = [10]byte{0: gzipID1, 1: gzipID2, 2: gzipDeflate}
 		if z.Extra != nil {
 			z.buf[3] |= 0x04
@@ -194,9 +257,74 @@ func (z *Writer) Write(p []byte) (int, error) {
 	z.size += uint32(len(p))
 	z.digest = crc32.Update(z.digest, crc32.IEEETable, p)
 	n, z.err = z.compressor.Write(p)
+	z.rawSize += int64(n)
 	return n, z.err
 }
 
+func (z *Writer) writeConcurrent(p []byte) (int, error) {
+	if z.closed {
+		return 0, errWriterClosed
+	}
+	n := 0
+	for n < len(p) {
+		k := min(len(p)-n, z.blockSize*z.blocks-len(z.pending))
+		z.pending = append(z.pending, p[n:n+k]...)
+		n += k
+		if len(z.pending) == z.blockSize*z.blocks {
+			if err := z.writeMembers(); err != nil {
+				return n, err
+			}
+		}
+	}
+	return n, nil
+}
+
+// writeMembers compresses z.pending as members of up to z.blockSize
+// bytes each, concurrently, and writes them out in order.
+func (z *Writer) writeMembers() error {
+	n := (len(z.pending) + z.blockSize - 1) / z.blockSize
+	if n == 0 && len(z.index) == 0 {
+		// The output needs at least one member.
+		n = 1
+	}
+	for len(z.workers) < n {
+		mw := new(memberWriter)
+		mw.z, _ = NewWriterLevel(&mw.out, z.level)
+		z.workers = append(z.workers, mw)
+	}
+	var wg sync.WaitGroup
+	for i, mw := range z.workers[:n] {
+		data := z.pending[min(i*z.blockSize, len(z.pending)):min((i+1)*z.blockSize, len(z.pending))]
+		mw.out.Reset()
+		mw.z.Reset(&mw.out)
+		mw.z.ModTime = z.ModTime
+		mw.z.OS = z.OS
+		if i == 0 && len(z.index) == 0 {
+			mw.z.Header = z.Header
+		}
+		mw.raw = len(data)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			mw.z.Write(data)
+			mw.z.Close()
+		}()
+	}
+	wg.Wait()
+	z.pending = z.pending[:0]
+
+	for _, mw := range z.workers[:n] {
+		z.index = append(z.index, indexMember{offset: z.offset, rawOffset: z.rawSize})
+		if _, err := z.w.Write(mw.out.Bytes()); err != nil {
+			z.err = err
+			return err
+		}
+		z.offset += int64(mw.out.Len())
+		z.rawSize += int64(mw.raw)
+	}
+	return nil
+}
+
 // Flush flushes any pending compressed data to the underlying writer.
 //
 // It is useful mainly in compressed network protocols, to ensure that
@@ -212,6 +340,12 @@ func (z *Writer) Flush() error {
 	if z.closed {
 		return nil
 	}
+	if z.blocks > 0 {
+		if len(z.pending) == 0 {
+			return nil
+		}
+		return z.writeMembers()
+	}
 	if !z.wroteHeader {
 		z.Write(nil)
 		if z.err != nil {
@@ -233,6 +367,12 @@ func (z *Writer) Close() error {
 		return nil
 	}
 	z.closed = true
+	if z.blocks > 0 {
+		if len(z.pending) == 0 && len(z.index) > 0 {
+			return nil
+		}
+		return z.writeMembers()
+	}
 	if !z.wroteHeader {
 		z.Write(nil)
 		if z.err != nil {
@@ -0,0 +1,264 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gzip
+
+import (
+	"bufio"
+	"cmp"
+	"encoding/binary"
+	"errors"
+	"io"
+	"slices"
+)
+
+var errIndex = errors.New("gzip: invalid index")
+
+// indexMagic starts the binary form of an Index.
+const indexMagic = "gzix"
+
+// An Index records where each member of a multistream gzip file
+// starts, both in the file and in its uncompressed data. With it, a
+// [SeekReader] can start decompressing at the member holding a given
+// offset rather than at the start of the file.
+//
+// A [Writer] builds the Index of its output as it goes; see
+// [Writer.Index]. [BuildIndex] builds one for an existing file.
+// An Index can be stored alongside the file it describes using its
+// MarshalBinary method.
+type Index struct {
+	members []indexMember // in increasing order of offset
+	size    int64         // of the uncompressed data
+}
+
+type indexMember struct {
+	offset    int64 // in the file
+	rawOffset int64 // in the uncompressed data
+}
+
+// BuildIndex builds an [Index] of the gzip file read from r, which it
+// reads, and decompresses, to the end.
+func BuildIndex(r io.Reader) (*Index, error) {
+	cr := &countingReader{r: r}
+	br := bufio.NewReader(cr)
+	z, err := NewReader(br)
+	if err != nil {
+		return nil, err
+	}
+	x := new(Index)
+	var offset int64
+	for {
+		z.Multistream(false)
+		x.members = append(x.members, indexMember{offset: offset, rawOffset: x.size})
+		n, err := io.Copy(io.Discard, z)
+		x.size += n
+		if err != nil {
+			return nil, err
+		}
+		offset = cr.n - int64(br.Buffered())
+		if err := z.Reset(br); err != nil {
+			if err == io.EOF {
+				return x, nil
+			}
+			return nil, err
+		}
+	}
+}
+
+type countingReader struct {
+	r io.Reader
+	n int64
+}
+
+func (r *countingReader) Read(p []byte) (int, error) {
+	n, err := r.r.Read(p)
+	r.n += int64(n)
+	return n, err
+}
+
+// Size returns the size of the uncompressed data.
+func (x *Index) Size() int64 {
+	return x.size
+}
+
+// Len returns the number of members in the file.
+func (x *Index) Len() int {
+	return len(x.members)
+}
+
+// find returns the index of the member holding the uncompressed data
+// at offset off.
+func (x *Index) find(off int64) int {
+	i, found := slices.BinarySearchFunc(x.members, off, func(m indexMember, off int64) int {
+		return cmp.Compare(m.rawOffset, off)
+	})
+	if found {
+		// Skip empty members.
+		for i+1 < len(x.members) && x.members[i+1].rawOffset == off {
+			i++
+		}
+		return i
+	}
+	return max(i-1, 0)
+}
+
+// MarshalBinary implements [encoding.BinaryMarshaler].
+func (x *Index) MarshalBinary() ([]byte, error) {
+	b := []byte(indexMagic)
+	b = binary.AppendUvarint(b, uint64(x.size))
+	b = binary.AppendUvarint(b, uint64(len(x.members)))
+	var prev indexMember
+	for _, m := range x.members {
+		b = binary.AppendUvarint(b, uint64(m.offset-prev.offset))
+		b = binary.AppendUvarint(b, uint64(m.rawOffset-prev.rawOffset))
+		prev = m
+	}
+	return b, nil
+}
+
+// UnmarshalBinary implements [encoding.BinaryUnmarshaler].
+func (x *Index) UnmarshalBinary(b []byte) error {
+	if len(b) < len(indexMagic) || string(b[:len(indexMagic)]) != indexMagic {
+		return errIndex
+	}
+	b = b[len(indexMagic):]
+	next := func() (int64, bool) {
+		v, n := binary.Uvarint(b)
+		if n <= 0 || v > 1<<62 {
+			return 0, false
+		}
+		b = b[n:]
+		return int64(v), true
+	}
+	size, ok1 := next()
+	count, ok2 := next()
+	if !ok1 || !ok2 || count > int64(len(b)) {
+		return errIndex
+	}
+	members := make([]indexMember, count)
+	var prev indexMember
+	for i := range members {
+		offset, ok1 := next()
+		rawOffset, ok2 := next()
+		m := indexMember{prev.offset + offset, prev.rawOffset + rawOffset}
+		if !ok1 || !ok2 || m.offset < 0 || m.rawOffset < 0 || m.rawOffset > size {
+			return errIndex
+		}
+		members[i] = m
+		prev = m
+	}
+	if len(b) != 0 {
+		return errIndex
+	}
+	x.members = members
+	x.size = size
+	return nil
+}
+
+// A SeekReader reads the uncompressed data of a gzip file at arbitrary
+// offsets. It implements [io.ReadSeeker] and [io.ReaderAt]. Reading at
+// an offset decompresses the data from the start of the member that
+// holds it, as recorded in an [Index].
+//
+// A SeekReader checks the checksum of each member that it reads to the
+// end; data from a member that is only partly read is not verified.
+type SeekReader struct {
+	r   io.ReaderAt
+	x   *Index
+	off int64 // of the next Read
+
+	z    *Reader // decompressing at zoff, if not nil
+	zoff int64
+}
+
+// NewSeekReader returns a [SeekReader] reading the gzip file r,
+// described by x.
+func NewSeekReader(r io.ReaderAt, x *Index) *SeekReader {
+	return &SeekReader{r: r, x: x}
+}
+
+// open returns a [Reader] positioned at the uncompressed offset off,
+// which must be less than the size of the data.
+func (s *SeekReader) open(off int64) (*Reader, error) {
+	if len(s.x.members) == 0 {
+		return nil, errIndex
+	}
+	m := s.x.members[s.x.find(off)]
+	z, err := NewReader(io.NewSectionReader(s.r, m.offset, 1<<63-1-m.offset))
+	if err != nil {
+		return nil, err
+	}
+	if _, err := io.CopyN(io.Discard, z, off-m.rawOffset); err != nil {
+		return nil, noEOF(err)
+	}
+	return z, nil
+}
+
+// Read implements [io.Reader].
+func (s *SeekReader) Read(p []byte) (int, error) {
+	if s.off >= s.x.size {
+		return 0, io.EOF
+	}
+	// Decompress from the member holding s.off unless the current
+	// Reader is in that member or just before it.
+	if s.z == nil || s.zoff > s.off || s.x.find(s.zoff) < s.x.find(s.off) {
+		z, err := s.open(s.off)
+		if err != nil {
+			return 0, err
+		}
+		s.z, s.zoff = z, s.off
+	} else if s.zoff < s.off {
+		n, err := io.CopyN(io.Discard, s.z, s.off-s.zoff)
+		s.zoff += n
+		if err != nil {
+			return 0, noEOF(err)
+		}
+	}
+	n, err := s.z.Read(p)
+	s.zoff += int64(n)
+	s.off += int64(n)
+	if err == io.EOF && s.off < s.x.size {
+		err = io.ErrUnexpectedEOF
+	}
+	return n, err
+}
+
+// Seek implements [io.Seeker].
+func (s *SeekReader) Seek(offset int64, whence int) (int64, error) {
+	switch whence {
+	case io.SeekStart:
+	case io.SeekCurrent:
+		offset += s.off
+	case io.SeekEnd:
+		offset += s.x.size
+	default:
+		return 0, errors.New("gzip.SeekReader.Seek: invalid whence")
+	}
+	if offset < 0 {
+		return 0, errors.New("gzip.SeekReader.Seek: negative position")
+	}
+	s.off = offset
+	return offset, nil
+}
+
+// ReadAt implements [io.ReaderAt]. It does not change the offset used
+// by Read and Seek, and it can be called concurrently with other calls
+// to ReadAt if the underlying [io.ReaderAt] allows it.
+func (s *SeekReader) ReadAt(p []byte, off int64) (int, error) {
+	if off < 0 {
+		return 0, errors.New("gzip.SeekReader.ReadAt: negative offset")
+	}
+	if off >= s.x.size {
+		return 0, io.EOF
+	}
+	z, err := s.open(off)
+	if err != nil {
+		return 0, err
+	}
+	n, err := io.ReadFull(z, p)
+	if err == io.ErrUnexpectedEOF && off+int64(n) == s.x.size {
+		err = io.EOF
+	}
+	return n, err
+}
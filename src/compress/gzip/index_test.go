@@ -0,0 +1,276 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gzip
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"math/rand"
+	"strings"
+	"testing"
+	"time"
+)
+
+// indexTestData returns compressible data in which every 8-byte
+// word names its own offset.
+func indexTestData(n int) []byte {
+	var b bytes.Buffer
+	for i := 0; b.Len() < n; i += 8 {
+		fmt.Fprintf(&b, "%7x\n", i)
+	}
+	return b.Bytes()[:n]
+}
+
+func writeConcurrent(t *testing.T, data []byte, blockSize, blocks int, chunk int) ([]byte, *Index) {
+	t.Helper()
+	var buf bytes.Buffer
+	w := NewWriter(&buf)
+	w.SetConcurrency(blockSize, blocks)
+	for len(data) > 0 {
+		n := min(chunk, len(data))
+		if _, err := w.Write(data[:n]); err != nil {
+			t.Fatal(err)
+		}
+		data = data[n:]
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes(), w.Index()
+}
+
+func TestWriterConcurrency(t *testing.T) {
+	data := indexTestData(1 << 20)
+	for _, tt := range []struct {
+		blockSize, blocks, chunk int
+	}{
+		{100 << 10, 4, 1 << 20},
+		{100 << 10, 4, 1000},
+		{1 << 10, 1, 4096},
+		{1 << 20, 8, 1 << 20},
+		{3 << 20, 2, 1 << 20},
+	} {
+		out, x := writeConcurrent(t, data, tt.blockSize, tt.blocks, tt.chunk)
+		if want := (len(data) + tt.blockSize - 1) / tt.blockSize; x.Len() != want {
+			t.Errorf("%+v: %d members, want %d", tt, x.Len(), want)
+		}
+		z, err := NewReader(bytes.NewReader(out))
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := io.ReadAll(z)
+		if err != nil {
+			t.Fatalf("%+v: %v", tt, err)
+		}
+		if !bytes.Equal(got, data) {
+			t.Fatalf("%+v: round trip mismatch", tt)
+		}
+	}
+}
+
+func TestWriterConcurrencyHeader(t *testing.T) {
+	var buf bytes.Buffer
+	w := NewWriter(&buf)
+	w.SetConcurrency(10, 2)
+	w.Name = "name"
+	w.Comment = "comment"
+	w.ModTime = time.Unix(1e8, 0)
+	w.Write([]byte("hello, world, hello, world"))
+	w.Close()
+
+	z, err := NewReader(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if z.Name != "name" || z.Comment != "comment" || !z.ModTime.Equal(w.ModTime) {
+		t.Errorf("got header %+v", z.Header)
+	}
+	var members []string
+	for {
+		z.Multistream(false)
+		b, err := io.ReadAll(z)
+		if err != nil {
+			t.Fatal(err)
+		}
+		members = append(members, string(b))
+		if i := len(members); i > 1 && (z.Name != "" || !z.ModTime.Equal(w.ModTime)) {
+			t.Errorf("member %d: got header %+v", i, z.Header)
+		}
+		if err := z.Reset(&buf); err == io.EOF {
+			break
+		} else if err != nil {
+			t.Fatal(err)
+		}
+	}
+	want := []string{"hello, wor", "ld, hello,", " world"}
+	if strings.Join(members, "|") != strings.Join(want, "|") {
+		t.Errorf("got members %q, want %q", members, want)
+	}
+}
+
+func TestWriterConcurrencyEmpty(t *testing.T) {
+	for _, flush := range []bool{false, true} {
+		var buf bytes.Buffer
+		w := NewWriter(&buf)
+		w.SetConcurrency(0, 4)
+		if flush {
+			w.Flush()
+		}
+		w.Close()
+		z, err := NewReader(&buf)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if b, err := io.ReadAll(z); len(b) != 0 || err != nil {
+			t.Errorf("ReadAll = %q, %v, want \"\", nil", b, err)
+		}
+		if x := w.Index(); x.Len() != 1 || x.Size() != 0 {
+			t.Errorf("index has %d members of %d bytes, want 1 and 0", x.Len(), x.Size())
+		}
+	}
+}
+
+func TestWriterConcurrencyFlush(t *testing.T) {
+	var buf bytes.Buffer
+	w := NewWriter(&buf)
+	w.SetConcurrency(1<<20, 2)
+	w.Write([]byte("hello, "))
+	if err := w.Flush(); err != nil {
+		t.Fatal(err)
+	}
+	z, err := NewReader(bytes.NewReader(buf.Bytes()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if b, err := io.ReadAll(z); string(b) != "hello, " || err != nil {
+		t.Errorf("after Flush: ReadAll = %q, %v", b, err)
+	}
+	w.Write([]byte("world"))
+	w.Close()
+	if _, err := w.Write([]byte("!")); err == nil {
+		t.Errorf("Write after Close succeeded")
+	}
+	z, err = NewReader(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if b, err := io.ReadAll(z); string(b) != "hello, world" || err != nil {
+		t.Errorf("after Close: ReadAll = %q, %v", b, err)
+	}
+}
+
+func TestWriterConcurrencyReset(t *testing.T) {
+	data := indexTestData(100 << 10)
+	var buf1, buf2 bytes.Buffer
+	w := NewWriter(&buf1)
+	w.SetConcurrency(16<<10, 3)
+	w.Write(data)
+	w.Close()
+	w.Reset(&buf2)
+	w.Write(data)
+	w.Close()
+	if !bytes.Equal(buf1.Bytes(), buf2.Bytes()) {
+		t.Errorf("output after Reset differs")
+	}
+	if x := w.Index(); x.Len() != 7 {
+		t.Errorf("index after Reset has %d members, want 7", x.Len())
+	}
+}
+
+func TestSeekReader(t *testing.T) {
+	data := indexTestData(300 << 10)
+	out, x := writeConcurrent(t, data, 32<<10, 4, 5000)
+	built, err := BuildIndex(bytes.NewReader(out))
+	if err != nil {
+		t.Fatal(err)
+	}
+	b1, _ := x.MarshalBinary()
+	b2, _ := built.MarshalBinary()
+	if !bytes.Equal(b1, b2) {
+		t.Errorf("BuildIndex differs from Writer.Index:\n%x\n%x", b1, b2)
+	}
+	var decoded Index
+	if err := decoded.UnmarshalBinary(b1); err != nil {
+		t.Fatal(err)
+	}
+	if decoded.Size() != int64(len(data)) || decoded.Len() != 10 {
+		t.Fatalf("decoded index: size %d, %d members", decoded.Size(), decoded.Len())
+	}
+
+	s := NewSeekReader(bytes.NewReader(out), &decoded)
+	r := rand.New(rand.NewSource(1))
+	buf := make([]byte, 40<<10)
+	for i := 0; i < 200; i++ {
+		off := r.Int63n(int64(len(data)) + 10)
+		p := buf[:r.Intn(len(buf))]
+		want := data[min(off, int64(len(data))):min(off+int64(len(p)), int64(len(data)))]
+
+		n, err := s.ReadAt(p, off)
+		if !bytes.Equal(p[:n], want) || (n < len(p)) != (err == io.EOF) {
+			t.Fatalf("ReadAt(%d bytes, %d) = %d, %v", len(p), off, n, err)
+		}
+
+		if _, err := s.Seek(off, io.SeekStart); err != nil {
+			t.Fatal(err)
+		}
+		n, err = io.ReadFull(s, p)
+		if !bytes.Equal(p[:n], want) {
+			t.Fatalf("Read(%d bytes) at %d = %d, %v", len(p), off, n, err)
+		}
+	}
+
+	// Sequential reads keep using the same decompressor.
+	s.Seek(0, io.SeekStart)
+	all, err := io.ReadAll(s)
+	if err != nil || !bytes.Equal(all, data) {
+		t.Fatalf("ReadAll: %v", err)
+	}
+	if pos, _ := s.Seek(-8, io.SeekEnd); pos != int64(len(data)-8) {
+		t.Errorf("Seek(-8, io.SeekEnd) = %d", pos)
+	}
+	if _, err := s.Seek(-1, io.SeekStart); err == nil {
+		t.Errorf("Seek to a negative position succeeded")
+	}
+}
+
+func TestSeekReaderSingleMember(t *testing.T) {
+	data := indexTestData(50 << 10)
+	var buf bytes.Buffer
+	w := NewWriter(&buf)
+	w.Write(data)
+	w.Close()
+	x := w.Index()
+	if x.Len() != 1 || x.Size() != int64(len(data)) {
+		t.Fatalf("index has %d members of %d bytes", x.Len(), x.Size())
+	}
+	s := NewSeekReader(bytes.NewReader(buf.Bytes()), x)
+	p := make([]byte, 16)
+	if _, err := s.ReadAt(p, 40000); err != nil || !bytes.Equal(p, data[40000:40016]) {
+		t.Errorf("ReadAt = %q, %v", p, err)
+	}
+}
+
+func TestIndexErrors(t *testing.T) {
+	out, x := writeConcurrent(t, indexTestData(10000), 1000, 2, 10000)
+	b, _ := x.MarshalBinary()
+	for i := 0; i < len(b); i++ {
+		var y Index
+		if err := y.UnmarshalBinary(b[:i]); err == nil {
+			t.Errorf("UnmarshalBinary of %d of %d bytes succeeded", i, len(b))
+		}
+	}
+	if err := new(Index).UnmarshalBinary(append(b, 0)); err == nil {
+		t.Errorf("UnmarshalBinary with trailing data succeeded")
+	}
+
+	// A corrupt file is reported by the SeekReader.
+	out = bytes.Clone(out)
+	out[x.members[3].offset+12] ^= 0xff
+	s := NewSeekReader(bytes.NewReader(out), x)
+	if _, err := s.ReadAt(make([]byte, 2000), 3000); err == nil {
+		t.Errorf("ReadAt of corrupt data succeeded")
+	}
+}
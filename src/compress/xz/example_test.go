@@ -0,0 +1,124 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package xz_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"compress/xz"
+	"fmt"
+	"io"
+	"log"
+	"os"
+)
+
+func Example_writerReader() {
+	var buf bytes.Buffer
+	zw := xz.NewWriter(&buf)
+
+	_, err := zw.Write([]byte("A long time ago in a galaxy far, far away..."))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err := zw.Close(); err != nil {
+		log.Fatal(err)
+	}
+
+	zr, err := xz.NewReader(&buf)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if _, err := io.Copy(os.Stdout, zr); err != nil {
+		log.Fatal(err)
+	}
+
+	// Output:
+	// A long time ago in a galaxy far, far away...
+}
+
+func ExampleReader_Multistream() {
+	// Concatenate the output of two Writers.
+	var buf bytes.Buffer
+	for _, s := range []string{"Hello, ", "Gophers!"} {
+		zw := xz.NewWriter(&buf)
+		if _, err := io.WriteString(zw, s); err != nil {
+			log.Fatal(err)
+		}
+		if err := zw.Close(); err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	zr, err := xz.NewReader(&buf)
+	if err != nil {
+		log.Fatal(err)
+	}
+	for {
+		zr.Multistream(false)
+		b, err := io.ReadAll(zr)
+		if err != nil {
+			log.Fatal(err)
+		}
+		fmt.Printf("%q\n", b)
+
+		err = zr.Reset(&buf)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	// Output:
+	// "Hello, "
+	// "Gophers!"
+}
+
+func Example_zip() {
+	// archive/zip does not build in the xz method.
+	// Register it with a zip.Writer and a zip.Reader to use it.
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+	w.RegisterCompressor(zip.XZ, func(out io.Writer) (io.WriteCloser, error) {
+		return xz.NewWriter(out), nil
+	})
+	f, err := w.CreateHeader(&zip.FileHeader{Name: "gopher.txt", Method: zip.XZ})
+	if err != nil {
+		log.Fatal(err)
+	}
+	if _, err := io.WriteString(f, "Hello, Gophers!\n"); err != nil {
+		log.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		log.Fatal(err)
+	}
+
+	r, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		log.Fatal(err)
+	}
+	r.RegisterDecompressor(zip.XZ, func(in io.Reader) io.ReadCloser {
+		zr, err := xz.NewReader(in)
+		if err != nil {
+			pr, pw := io.Pipe()
+			pw.CloseWithError(err)
+			return pr
+		}
+		return io.NopCloser(zr)
+	})
+	rc, err := r.File[0].Open()
+	if err != nil {
+		log.Fatal(err)
+	}
+	if _, err := io.Copy(os.Stdout, rc); err != nil {
+		log.Fatal(err)
+	}
+	rc.Close()
+
+	// Output:
+	// Hello, Gophers!
+}
@@ -0,0 +1,322 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package xz
+
+import (
+	"encoding/binary"
+	"io"
+)
+
+// A deltaReader decodes the delta filter: each byte is stored as
+// the difference from the byte dist bytes before it.
+type deltaReader struct {
+	r       io.Reader
+	dist    int
+	pos     byte
+	history [256]byte
+}
+
+func newDeltaReader(r io.Reader, dist int) *deltaReader {
+	return &deltaReader{r: r, dist: dist}
+}
+
+func (d *deltaReader) Read(p []byte) (int, error) {
+	n, err := d.r.Read(p)
+	for i := range p[:n] {
+		p[i] += d.history[byte(d.dist+int(d.pos))]
+		d.history[d.pos] = p[i]
+		d.pos--
+	}
+	return n, err
+}
+
+// A bcjReader decodes a branch/call/jump filter, which converts the
+// absolute addresses in branch instructions, stored to improve
+// compression, back to relative ones.
+type bcjReader struct {
+	r   io.Reader
+	err error
+
+	// buf[start:conv] has been converted and not read yet;
+	// buf[conv:] has not been converted.
+	buf   []byte
+	start int
+	conv  int
+
+	id       uint64
+	pos      uint32 // position of buf[conv] in the uncompressed data
+	prevMask uint32 // state of the x86 filter
+}
+
+func newBCJReader(r io.Reader, id uint64, props []byte) (*bcjReader, error) {
+	b := &bcjReader{r: r, id: id, buf: make([]byte, 0, 4096)}
+	switch len(props) {
+	case 0:
+	case 4:
+		// Start offset.
+		b.pos = le.Uint32(props)
+	default:
+		return nil, ErrHeader
+	}
+	return b, nil
+}
+
+func (b *bcjReader) Read(p []byte) (int, error) {
+	for b.start == b.conv {
+		if b.err != nil {
+			if b.err == io.EOF && b.conv < len(b.buf) {
+				// The last few bytes are never converted.
+				b.conv = len(b.buf)
+				continue
+			}
+			return 0, b.err
+		}
+		n := copy(b.buf[:cap(b.buf)], b.buf[b.start:])
+		b.buf = b.buf[:n]
+		b.conv -= b.start
+		b.start = 0
+		n, b.err = b.r.Read(b.buf[n:cap(b.buf)])
+		b.buf = b.buf[:len(b.buf)+n]
+		c := b.convert(b.buf[b.conv:])
+		b.conv += c
+		b.pos += uint32(c)
+	}
+	n := copy(p, b.buf[b.start:b.conv])
+	b.start += n
+	return n, nil
+}
+
+// convert converts the addresses in buf, which starts at b.pos,
+// and returns the number of bytes converted.
+func (b *bcjReader) convert(buf []byte) int {
+	switch b.id {
+	case filterX86:
+		return b.x86(buf)
+	case filterPowerPC:
+		return bcjPowerPC(buf, b.pos)
+	case filterIA64:
+		return bcjIA64(buf, b.pos)
+	case filterARM:
+		return bcjARM(buf, b.pos)
+	case filterARMThumb:
+		return bcjARMThumb(buf, b.pos)
+	case filterSPARC:
+		return bcjSPARC(buf, b.pos)
+	case filterARM64:
+		return bcjARM64(buf, b.pos)
+	}
+	panic("unreachable")
+}
+
+// x86 converts the targets of the E8 (CALL) and E9 (JMP)
+// instructions of x86 code. The state in b.prevMask records which
+// of the previous three bytes were E8 or E9, as a conversion is
+// skipped when such a byte is part of the address.
+func (b *bcjReader) x86(buf []byte) int {
+	allowed := [8]bool{true, true, true, false, true, false, false, false}
+	bitNum := [8]uint32{0, 1, 2, 2, 3, 3, 3, 3}
+	test := func(b byte) bool { return b == 0x00 || b == 0xFF }
+
+	if len(buf) <= 4 {
+		return 0
+	}
+	size := len(buf) - 4
+	prevPos := -1
+	prevMask := b.prevMask
+	i := 0
+	for ; i < size; i++ {
+		if buf[i]&0xFE != 0xE8 {
+			continue
+		}
+		d := i - prevPos
+		if d > 3 {
+			prevMask = 0
+		} else {
+			prevMask = (prevMask << (d - 1)) & 7
+			if prevMask != 0 {
+				c := buf[i+4-int(bitNum[prevMask])]
+				if !allowed[prevMask] || test(c) {
+					prevPos = i
+					prevMask = prevMask<<1 | 1
+					continue
+				}
+			}
+		}
+		prevPos = i
+		if !test(buf[i+4]) {
+			prevMask = prevMask<<1 | 1
+			continue
+		}
+		src := le.Uint32(buf[i+1:])
+		var dest uint32
+		for {
+			dest = src - (b.pos + uint32(i) + 5)
+			if prevMask == 0 {
+				break
+			}
+			j := bitNum[prevMask] * 8
+			if !test(byte(dest >> (24 - j))) {
+				break
+			}
+			src = dest ^ (1<<(32-j) - 1)
+		}
+		dest &= 0x01FFFFFF
+		dest |= 0 - dest&0x01000000
+		le.PutUint32(buf[i+1:], dest)
+		i += 4
+	}
+	if d := i - prevPos; d > 3 {
+		b.prevMask = 0
+	} else {
+		b.prevMask = prevMask << (d - 1)
+	}
+	return i
+}
+
+// bcjPowerPC converts the targets of big-endian PowerPC
+// branch-and-link instructions.
+func bcjPowerPC(buf []byte, pos uint32) int {
+	be := binary.BigEndian
+	i := 0
+	for ; i+4 <= len(buf); i += 4 {
+		instr := be.Uint32(buf[i:])
+		if instr&0xFC000003 == 0x48000001 {
+			instr &= 0x03FFFFFC
+			instr -= pos + uint32(i)
+			instr &= 0x03FFFFFC
+			instr |= 0x48000001
+			be.PutUint32(buf[i:], instr)
+		}
+	}
+	return i
+}
+
+// bcjIA64 converts the targets of IA-64 branch instructions
+// in each 16-byte instruction bundle.
+func bcjIA64(buf []byte, pos uint32) int {
+	branchTable := [32]uint32{
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		4, 4, 6, 6, 0, 0, 7, 7,
+		4, 4, 0, 0, 4, 4, 0, 0,
+	}
+	i := 0
+	for ; i+16 <= len(buf); i += 16 {
+		mask := branchTable[buf[i]&0x1F]
+		for slot, bitPos := 0, 5; slot < 3; slot, bitPos = slot+1, bitPos+41 {
+			if mask>>slot&1 == 0 {
+				continue
+			}
+			bytePos := i + bitPos>>3
+			bitRes := uint(bitPos & 7)
+			var instr uint64
+			for j := 0; j < 6; j++ {
+				instr |= uint64(buf[bytePos+j]) << (8 * j)
+			}
+			norm := instr >> bitRes
+			if norm>>37&0x0F != 0x05 || norm>>9&0x07 != 0 {
+				continue
+			}
+			addr := uint32(norm>>13) & 0x0FFFFF
+			addr |= uint32(norm>>36) & 1 << 20
+			addr <<= 4
+			addr -= pos + uint32(i)
+			addr >>= 4
+			norm &^= 0x8FFFFF << 13
+			norm |= uint64(addr&0x0FFFFF) << 13
+			norm |= uint64(addr&0x100000) << (36 - 20)
+			instr &= 1<<bitRes - 1
+			instr |= norm << bitRes
+			for j := 0; j < 6; j++ {
+				buf[bytePos+j] = byte(instr >> (8 * j))
+			}
+		}
+	}
+	return i
+}
+
+// bcjARM converts the targets of ARM BL instructions.
+func bcjARM(buf []byte, pos uint32) int {
+	i := 0
+	for ; i+4 <= len(buf); i += 4 {
+		if buf[i+3] != 0xEB {
+			continue
+		}
+		addr := uint32(buf[i]) | uint32(buf[i+1])<<8 | uint32(buf[i+2])<<16
+		addr <<= 2
+		addr -= pos + uint32(i) + 8
+		addr >>= 2
+		buf[i] = byte(addr)
+		buf[i+1] = byte(addr >> 8)
+		buf[i+2] = byte(addr >> 16)
+	}
+	return i
+}
+
+// bcjARMThumb converts the targets of ARM-Thumb BL instructions.
+func bcjARMThumb(buf []byte, pos uint32) int {
+	i := 0
+	for ; i+4 <= len(buf); i += 2 {
+		if buf[i+1]&0xF8 != 0xF0 || buf[i+3]&0xF8 != 0xF8 {
+			continue
+		}
+		addr := uint32(buf[i+1]&0x07)<<19 | uint32(buf[i])<<11 |
+			uint32(buf[i+3]&0x07)<<8 | uint32(buf[i+2])
+		addr <<= 1
+		addr -= pos + uint32(i) + 4
+		addr >>= 1
+		buf[i+1] = byte(0xF0 | addr>>19&0x07)
+		buf[i] = byte(addr >> 11)
+		buf[i+3] = byte(0xF8 | addr>>8&0x07)
+		buf[i+2] = byte(addr)
+		i += 2
+	}
+	return i
+}
+
+// bcjSPARC converts the targets of SPARC CALL instructions.
+func bcjSPARC(buf []byte, pos uint32) int {
+	be := binary.BigEndian
+	i := 0
+	for ; i+4 <= len(buf); i += 4 {
+		instr := be.Uint32(buf[i:])
+		if instr>>22 != 0x100 && instr>>22 != 0x1FF {
+			continue
+		}
+		instr <<= 2
+		instr -= pos + uint32(i)
+		instr >>= 2
+		instr = (0x40000000 - instr&0x400000) | 0x40000000 | instr&0x3FFFFF
+		be.PutUint32(buf[i:], instr)
+	}
+	return i
+}
+
+// bcjARM64 converts the targets of ARM64 BL and ADRP instructions.
+func bcjARM64(buf []byte, pos uint32) int {
+	i := 0
+	for ; i+4 <= len(buf); i += 4 {
+		instr := le.Uint32(buf[i:])
+		pc := pos + uint32(i)
+		if instr>>26 == 0x25 {
+			// BL
+			addr := instr - pc>>2
+			le.PutUint32(buf[i:], 0x94000000|addr&0x03FFFFFF)
+		} else if instr&0x9F000000 == 0x90000000 {
+			// ADRP
+			addr := instr>>29&3 | instr>>3&0x001FFFFC
+			if (addr+0x00020000)&0x001C0000 != 0 {
+				continue
+			}
+			addr -= pc >> 12
+			instr &= 0x9000001F
+			instr |= (addr & 3) << 29
+			instr |= (addr & 0x0003FFFC) << 3
+			instr |= (0 - addr&0x00020000) & 0x00E00000
+			le.PutUint32(buf[i:], instr)
+		}
+	}
+	return i
+}
@@ -0,0 +1,368 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package xz
+
+// This file implements the LZMA decoder used by LZMA2 chunks.
+// The names follow the LZMA specification that comes with the
+// LZMA SDK (lzma-specification.txt).
+
+const (
+	numStates          = 12
+	maxPosBits         = 4
+	numLenToPosStates  = 4
+	numAlignBits       = 4
+	startPosModelIndex = 4
+	endPosModelIndex   = 14
+	numFullDistances   = 1 << (endPosModelIndex >> 1)
+	matchMinLen        = 2
+	probInit           = 1 << 10
+)
+
+// rangePadding is the number of bytes a range decoder may read past
+// the end of its input while decoding a symbol. The decoder checks
+// for overreads between symbols.
+const rangePadding = 64
+
+// A rangeDecoder decodes bits from a range coded chunk.
+type rangeDecoder struct {
+	in   []byte // the chunk followed by rangePadding bytes
+	end  int    // length of the chunk
+	pos  int
+	rng  uint32
+	code uint32
+}
+
+// init starts decoding in, which must have a capacity of at least
+// len(in)+rangePadding.
+func (rc *rangeDecoder) init(in []byte) bool {
+	if len(in) < 5 || in[0] != 0 {
+		return false
+	}
+	rc.in = in[:len(in)+rangePadding]
+	rc.end = len(in)
+	rc.pos = 5
+	rc.rng = 0xFFFFFFFF
+	rc.code = uint32(in[1])<<24 | uint32(in[2])<<16 | uint32(in[3])<<8 | uint32(in[4])
+	return true
+}
+
+// overread reports whether the decoder has read past the end of
+// the chunk.
+func (rc *rangeDecoder) overread() bool {
+	return rc.pos > rc.end
+}
+
+// finished reports whether the chunk has been decoded exactly.
+func (rc *rangeDecoder) finished() bool {
+	return rc.code == 0 && rc.pos == rc.end
+}
+
+func (rc *rangeDecoder) normalize() {
+	if rc.rng < 1<<24 {
+		rc.rng <<= 8
+		rc.code = rc.code<<8 | uint32(rc.in[rc.pos])
+		rc.pos++
+	}
+}
+
+// bit decodes a bit using the probability p.
+func (rc *rangeDecoder) bit(p *uint16) uint32 {
+	bound := (rc.rng >> 11) * uint32(*p)
+	var b uint32
+	if rc.code < bound {
+		rc.rng = bound
+		*p += (1<<11 - *p) >> 5
+	} else {
+		rc.rng -= bound
+		rc.code -= bound
+		*p -= *p >> 5
+		b = 1
+	}
+	rc.normalize()
+	return b
+}
+
+// direct decodes n bits with fixed probabilities.
+func (rc *rangeDecoder) direct(n int) uint32 {
+	var v uint32
+	for ; n > 0; n-- {
+		rc.rng >>= 1
+		rc.code -= rc.rng
+		t := 0 - (rc.code >> 31)
+		rc.code += rc.rng & t
+		v = v<<1 + t + 1
+		rc.normalize()
+	}
+	return v
+}
+
+// tree decodes n bits, most significant first, using the bit tree probs.
+func (rc *rangeDecoder) tree(probs []uint16, n int) uint32 {
+	m := uint32(1)
+	for i := 0; i < n; i++ {
+		m = m<<1 | rc.bit(&probs[m])
+	}
+	return m - 1<<n
+}
+
+// reverseTree decodes n bits, least significant first,
+// using the bit tree probs.
+func (rc *rangeDecoder) reverseTree(probs []uint16, n int) uint32 {
+	m := uint32(1)
+	var v uint32
+	for i := 0; i < n; i++ {
+		b := rc.bit(&probs[m])
+		m = m<<1 | b
+		v |= b << i
+	}
+	return v
+}
+
+// A lenCoder holds the probabilities used to code match lengths.
+type lenCoder struct {
+	choice  uint16
+	choice2 uint16
+	low     [1 << maxPosBits][1 << 3]uint16
+	mid     [1 << maxPosBits][1 << 3]uint16
+	high    [1 << 8]uint16
+}
+
+func (d *lenCoder) reset() {
+	d.choice = probInit
+	d.choice2 = probInit
+	for i := range d.low {
+		initProbs(d.low[i][:])
+		initProbs(d.mid[i][:])
+	}
+	initProbs(d.high[:])
+}
+
+// decode returns a match length minus matchMinLen.
+func (d *lenCoder) decode(rc *rangeDecoder, posState uint32) uint32 {
+	if rc.bit(&d.choice) == 0 {
+		return rc.tree(d.low[posState][:], 3)
+	}
+	if rc.bit(&d.choice2) == 0 {
+		return 8 + rc.tree(d.mid[posState][:], 3)
+	}
+	return 16 + rc.tree(d.high[:], 8)
+}
+
+func initProbs(p []uint16) {
+	for i := range p {
+		p[i] = probInit
+	}
+}
+
+// An lzmaState holds the state and probabilities of the LZMA coder,
+// which carry over from chunk to chunk.
+type lzmaState struct {
+	lc, lp, pb uint
+
+	state uint32
+	reps  [4]uint32
+
+	literal    []uint16
+	isMatch    [numStates][1 << maxPosBits]uint16
+	isRep      [numStates]uint16
+	isRepG0    [numStates]uint16
+	isRepG1    [numStates]uint16
+	isRepG2    [numStates]uint16
+	isRep0Long [numStates][1 << maxPosBits]uint16
+	posSlot    [numLenToPosStates][1 << 6]uint16
+	posSpecial [1 + numFullDistances - endPosModelIndex]uint16
+	align      [1 << numAlignBits]uint16
+	lenDec     lenCoder
+	repLenDec  lenCoder
+}
+
+// An lzmaDecoder decodes LZMA chunks.
+type lzmaDecoder struct {
+	lzmaState
+}
+
+// setProps sets the literal context, literal position and
+// position bits from an LZMA properties byte.
+func (d *lzmaDecoder) setProps(props byte) bool {
+	if props >= 9*5*5 {
+		return false
+	}
+	lc := uint(props % 9)
+	props /= 9
+	lp := uint(props % 5)
+	pb := uint(props / 5)
+	if lc+lp > 4 {
+		// LZMA2 limit.
+		return false
+	}
+	d.lc, d.lp, d.pb = lc, lp, pb
+	return true
+}
+
+// reset resets the state and probabilities.
+func (d *lzmaState) reset() {
+	d.state = 0
+	d.reps = [4]uint32{}
+	n := 0x300 << (d.lc + d.lp)
+	if cap(d.literal) < n {
+		d.literal = make([]uint16, n)
+	}
+	d.literal = d.literal[:n]
+	initProbs(d.literal)
+	for i := range d.isMatch {
+		initProbs(d.isMatch[i][:])
+		initProbs(d.isRep0Long[i][:])
+	}
+	initProbs(d.isRep[:])
+	initProbs(d.isRepG0[:])
+	initProbs(d.isRepG1[:])
+	initProbs(d.isRepG2[:])
+	for i := range d.posSlot {
+		initProbs(d.posSlot[i][:])
+	}
+	initProbs(d.posSpecial[:])
+	initProbs(d.align[:])
+	d.lenDec.reset()
+	d.repLenDec.reset()
+}
+
+// decode decodes a chunk of n bytes from the range coded data in,
+// appending them to the window w.
+func (d *lzmaDecoder) decode(w *window, in []byte, n int) error {
+	var rc rangeDecoder
+	if !rc.init(in) {
+		return ErrCorrupt
+	}
+	end := len(w.buf) + n
+	pbMask := uint32(1)<<d.pb - 1
+	lpMask := uint32(1)<<d.lp - 1
+	state := d.state
+	reps := d.reps
+	for len(w.buf) < end {
+		if rc.overread() {
+			return ErrCorrupt
+		}
+		pos := w.pos()
+		posState := pos & pbMask
+		if rc.bit(&d.isMatch[state][posState]) == 0 {
+			prev := uint32(w.last(0))
+			probs := d.literal[0x300*((pos&lpMask)<<d.lc+prev>>(8-d.lc)):][:0x300]
+			sym := uint32(1)
+			if state >= 7 {
+				// Matched literal: use the byte at the
+				// last distance as extra context.
+				match := uint32(w.last(int(reps[0])))
+				for sym < 0x100 {
+					matchBit := match >> 7 & 1
+					match <<= 1
+					b := rc.bit(&probs[(1+matchBit)<<8+sym])
+					sym = sym<<1 | b
+					if matchBit != b {
+						break
+					}
+				}
+			}
+			for sym < 0x100 {
+				sym = sym<<1 | rc.bit(&probs[sym])
+			}
+			w.buf = append(w.buf, byte(sym))
+			switch {
+			case state < 4:
+				state = 0
+			case state < 10:
+				state -= 3
+			default:
+				state -= 6
+			}
+			continue
+		}
+
+		var length uint32
+		if rc.bit(&d.isRep[state]) == 0 {
+			// Simple match.
+			length = d.lenDec.decode(&rc, posState)
+			if state < 7 {
+				state = 7
+			} else {
+				state = 10
+			}
+			dist := d.decodeDist(&rc, length)
+			if dist == 0xFFFFFFFF {
+				// End marker, not allowed in LZMA2.
+				return ErrCorrupt
+			}
+			reps[3], reps[2], reps[1], reps[0] = reps[2], reps[1], reps[0], dist
+		} else {
+			if rc.bit(&d.isRepG0[state]) == 0 {
+				if rc.bit(&d.isRep0Long[state][posState]) == 0 {
+					// Short rep: a single byte at the last distance.
+					if state < 7 {
+						state = 9
+					} else {
+						state = 11
+					}
+					if !w.valid(reps[0]) {
+						return ErrCorrupt
+					}
+					w.buf = append(w.buf, w.last(int(reps[0])))
+					continue
+				}
+			} else {
+				var dist uint32
+				if rc.bit(&d.isRepG1[state]) == 0 {
+					dist = reps[1]
+				} else {
+					if rc.bit(&d.isRepG2[state]) == 0 {
+						dist = reps[2]
+					} else {
+						dist = reps[3]
+						reps[3] = reps[2]
+					}
+					reps[2] = reps[1]
+				}
+				reps[1] = reps[0]
+				reps[0] = dist
+			}
+			length = d.repLenDec.decode(&rc, posState)
+			if state < 7 {
+				state = 8
+			} else {
+				state = 11
+			}
+		}
+
+		if !w.valid(reps[0]) {
+			return ErrCorrupt
+		}
+		length += matchMinLen
+		if int(length) > end-len(w.buf) {
+			return ErrCorrupt
+		}
+		w.copy(int(reps[0])+1, int(length))
+	}
+	if !rc.finished() {
+		return ErrCorrupt
+	}
+	d.state = state
+	d.reps = reps
+	return nil
+}
+
+// decodeDist decodes the distance of a simple match
+// with the given length code.
+func (d *lzmaDecoder) decodeDist(rc *rangeDecoder, length uint32) uint32 {
+	lenState := min(length, numLenToPosStates-1)
+	slot := rc.tree(d.posSlot[lenState][:], 6)
+	if slot < startPosModelIndex {
+		return slot
+	}
+	numDirectBits := int(slot>>1) - 1
+	dist := (2 | slot&1) << numDirectBits
+	if slot < endPosModelIndex {
+		return dist + rc.reverseTree(d.posSpecial[dist-slot:], numDirectBits)
+	}
+	dist += rc.direct(numDirectBits-numAlignBits) << numAlignBits
+	return dist + rc.reverseTree(d.align[:], numAlignBits)
+}
@@ -0,0 +1,184 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package xz
+
+import "io"
+
+// A window holds the decompressed data: the history that matches may
+// refer to, followed by the data that has not been read yet.
+type window struct {
+	buf  []byte
+	rd   int   // start of the unread data
+	low  int   // start of the history since the last dictionary reset
+	base int64 // position of buf[0] since the last dictionary reset
+	size int   // dictionary size
+}
+
+// reset discards the history, as at the start of a stream or a
+// dictionary reset, keeping any unread data.
+func (w *window) reset(size int) {
+	w.size = size
+	w.low = len(w.buf)
+	w.base = -int64(len(w.buf))
+}
+
+// pos returns the number of bytes written since the last
+// dictionary reset, modulo 2³².
+func (w *window) pos() uint32 {
+	return uint32(w.base + int64(len(w.buf)))
+}
+
+// last returns the byte dist+1 bytes back, or 0 if there is none.
+func (w *window) last(dist int) byte {
+	i := len(w.buf) - 1 - dist
+	if i < w.low {
+		return 0
+	}
+	return w.buf[i]
+}
+
+// valid reports whether a match may refer to the byte dist+1 bytes back.
+func (w *window) valid(dist uint32) bool {
+	return int64(dist) < int64(min(w.size, len(w.buf)-w.low))
+}
+
+// copy appends n bytes copied from dist bytes back.
+func (w *window) copy(dist, n int) {
+	start := len(w.buf) - dist
+	if n <= dist {
+		w.buf = append(w.buf, w.buf[start:start+n]...)
+		return
+	}
+	for i := 0; i < n; i++ {
+		w.buf = append(w.buf, w.buf[start+i])
+	}
+}
+
+// read copies unread data to p.
+func (w *window) read(p []byte) int {
+	n := copy(p, w.buf[w.rd:])
+	w.rd += n
+
+	// Discard data that has been read and is out of reach of
+	// matches, once that is at least half of the buffer.
+	if d := min(w.rd, len(w.buf)-w.size); d > 0 && d >= len(w.buf)/2 {
+		w.buf = w.buf[:copy(w.buf, w.buf[d:])]
+		w.rd -= d
+		w.low = max(w.low-d, 0)
+		w.base += int64(d)
+	}
+	return n
+}
+
+// An lzma2Reader decompresses an LZMA2 stream.
+type lzma2Reader struct {
+	r        *countReader
+	w        window
+	dictSize int
+	lzma     lzmaDecoder
+	chunk    []byte
+	err      error
+
+	needDictReset bool
+	needProps     bool
+}
+
+// reset prepares z to decompress the LZMA2 stream with the given
+// dictionary size read from r, reusing its buffers.
+func (z *lzma2Reader) reset(r *countReader, dictSize int) {
+	*z = lzma2Reader{
+		r:             r,
+		w:             window{buf: z.w.buf[:0]},
+		dictSize:      dictSize,
+		lzma:          lzmaDecoder{lzmaState{literal: z.lzma.literal}},
+		chunk:         z.chunk,
+		needDictReset: true,
+		needProps:     true,
+	}
+}
+
+func (z *lzma2Reader) Read(p []byte) (int, error) {
+	for z.w.rd == len(z.w.buf) {
+		if z.err != nil {
+			return 0, z.err
+		}
+		z.err = z.nextChunk()
+	}
+	return z.w.read(p), nil
+}
+
+// nextChunk decodes the next chunk into the window.
+// It returns io.EOF at the end of the stream.
+func (z *lzma2Reader) nextChunk() error {
+	var hdr [5]byte
+	if _, err := io.ReadFull(z.r, hdr[:1]); err != nil {
+		return noEOF(err)
+	}
+	control := hdr[0]
+	if control == 0x00 {
+		return io.EOF
+	}
+	if control == 0x01 || control >= 0xE0 {
+		z.w.reset(z.dictSize)
+		z.needDictReset = false
+		z.needProps = true
+	} else if z.needDictReset {
+		return ErrCorrupt
+	}
+
+	if control < 0x80 {
+		// Uncompressed chunk.
+		if control > 0x02 {
+			return ErrCorrupt
+		}
+		if _, err := io.ReadFull(z.r, hdr[:2]); err != nil {
+			return noEOF(err)
+		}
+		n := int(hdr[0])<<8 | int(hdr[1]) + 1
+		l := len(z.w.buf)
+		z.w.buf = append(z.w.buf, make([]byte, n)...)
+		if _, err := io.ReadFull(z.r, z.w.buf[l:]); err != nil {
+			z.w.buf = z.w.buf[:l]
+			return noEOF(err)
+		}
+		return nil
+	}
+
+	// LZMA chunk.
+	hasProps := control >= 0xC0
+	n := 4
+	if hasProps {
+		n++
+	}
+	if _, err := io.ReadFull(z.r, hdr[:n]); err != nil {
+		return noEOF(err)
+	}
+	size := int(control&0x1F)<<16 | int(hdr[0])<<8 | int(hdr[1]) + 1
+	csize := int(hdr[2])<<8 | int(hdr[3]) + 1
+	if hasProps {
+		if !z.lzma.setProps(hdr[4]) {
+			return ErrCorrupt
+		}
+		z.needProps = false
+	} else if z.needProps {
+		return ErrCorrupt
+	}
+	if control >= 0xA0 {
+		z.lzma.reset()
+	}
+	if cap(z.chunk) < csize+rangePadding {
+		z.chunk = make([]byte, csize, 1<<16+rangePadding)
+	}
+	z.chunk = z.chunk[:csize]
+	if _, err := io.ReadFull(z.r, z.chunk); err != nil {
+		return noEOF(err)
+	}
+	l := len(z.w.buf)
+	if err := z.lzma.decode(&z.w, z.chunk, size); err != nil {
+		z.w.buf = z.w.buf[:l]
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,519 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package xz
+
+import (
+	"encoding/binary"
+	"math/bits"
+)
+
+// levelParams are the parameters of a compression level.
+type levelParams struct {
+	dictLog  uint8 // log of the dictionary size
+	hashLog  uint8 // log of the hash table size
+	chainLog uint8 // log of the hash chain size
+	depth    int   // number of candidates to examine
+	nice     int   // stop searching after a match of this length
+}
+
+var levels = [BestCompression + 1]levelParams{
+	NoCompression: {dictLog: 16},
+	1:             {dictLog: 20, hashLog: 16, chainLog: 16, depth: 4, nice: 32},
+	2:             {dictLog: 21, hashLog: 17, chainLog: 17, depth: 8, nice: 32},
+	3:             {dictLog: 22, hashLog: 17, chainLog: 18, depth: 16, nice: 48},
+	4:             {dictLog: 22, hashLog: 18, chainLog: 19, depth: 24, nice: 64},
+	5:             {dictLog: 23, hashLog: 18, chainLog: 20, depth: 32, nice: 96},
+	6:             {dictLog: 23, hashLog: 18, chainLog: 21, depth: 48, nice: 128},
+	7:             {dictLog: 23, hashLog: 19, chainLog: 22, depth: 96, nice: 192},
+	8:             {dictLog: 23, hashLog: 20, chainLog: 23, depth: 192, nice: matchMaxLen},
+	9:             {dictLog: 23, hashLog: 20, chainLog: 23, depth: 512, nice: matchMaxLen},
+}
+
+const (
+	matchMaxLen = matchMinLen + 16 + 1<<8 - 1 // 273
+	hashMinLen  = 3                           // shortest match the matcher finds
+
+	// Limits of an LZMA2 chunk.
+	maxChunkSize           = 1 << 21
+	maxChunkCompressedSize = 1 << 16
+
+	// Properties used by the encoder: lc=3, lp=0, pb=2.
+	encLC    = 3
+	encLP    = 0
+	encPB    = 2
+	encProps = (encPB*5+encLP)*9 + encLC
+)
+
+// A rangeEncoder range codes bits.
+type rangeEncoder struct {
+	low       uint64
+	rng       uint32
+	cache     byte
+	cacheSize int
+	out       []byte
+}
+
+func (rc *rangeEncoder) reset(out []byte) {
+	*rc = rangeEncoder{rng: 0xFFFFFFFF, cacheSize: 1, out: out}
+}
+
+func (rc *rangeEncoder) shiftLow() {
+	if uint32(rc.low) < 0xFF000000 || rc.low>>32 != 0 {
+		carry := byte(rc.low >> 32)
+		b := rc.cache
+		for ; rc.cacheSize > 0; rc.cacheSize-- {
+			rc.out = append(rc.out, b+carry)
+			b = 0xFF
+		}
+		rc.cache = byte(rc.low >> 24)
+	}
+	rc.cacheSize++
+	rc.low = rc.low & 0x00FFFFFF << 8
+}
+
+// size returns the size of the output after a flush.
+func (rc *rangeEncoder) size() int {
+	return len(rc.out) + rc.cacheSize + 4
+}
+
+// flush writes out the remaining state.
+func (rc *rangeEncoder) flush() {
+	for i := 0; i < 5; i++ {
+		rc.shiftLow()
+	}
+}
+
+// bit encodes the bit b using the probability p.
+func (rc *rangeEncoder) bit(p *uint16, b uint32) {
+	bound := (rc.rng >> 11) * uint32(*p)
+	if b == 0 {
+		rc.rng = bound
+		*p += (1<<11 - *p) >> 5
+	} else {
+		rc.low += uint64(bound)
+		rc.rng -= bound
+		*p -= *p >> 5
+	}
+	for rc.rng < 1<<24 {
+		rc.rng <<= 8
+		rc.shiftLow()
+	}
+}
+
+// direct encodes the n low bits of v with fixed probabilities.
+func (rc *rangeEncoder) direct(v uint32, n int) {
+	for n--; n >= 0; n-- {
+		rc.rng >>= 1
+		if v>>n&1 != 0 {
+			rc.low += uint64(rc.rng)
+		}
+		for rc.rng < 1<<24 {
+			rc.rng <<= 8
+			rc.shiftLow()
+		}
+	}
+}
+
+// tree encodes the n low bits of v, most significant first,
+// using the bit tree probs.
+func (rc *rangeEncoder) tree(probs []uint16, v uint32, n int) {
+	m := uint32(1)
+	for n--; n >= 0; n-- {
+		b := v >> n & 1
+		rc.bit(&probs[m], b)
+		m = m<<1 | b
+	}
+}
+
+// reverseTree encodes the n low bits of v, least significant first,
+// using the bit tree probs.
+func (rc *rangeEncoder) reverseTree(probs []uint16, v uint32, n int) {
+	m := uint32(1)
+	for ; n > 0; n-- {
+		b := v & 1
+		v >>= 1
+		rc.bit(&probs[m], b)
+		m = m<<1 | b
+	}
+}
+
+// encodeLen encodes the match length code l (the length minus
+// matchMinLen) with the probabilities of d.
+func (rc *rangeEncoder) encodeLen(d *lenCoder, l, posState uint32) {
+	switch {
+	case l < 8:
+		rc.bit(&d.choice, 0)
+		rc.tree(d.low[posState][:], l, 3)
+	case l < 16:
+		rc.bit(&d.choice, 1)
+		rc.bit(&d.choice2, 0)
+		rc.tree(d.mid[posState][:], l-8, 3)
+	default:
+		rc.bit(&d.choice, 1)
+		rc.bit(&d.choice2, 1)
+		rc.tree(d.high[:], l-16, 8)
+	}
+}
+
+// A matcher finds earlier occurrences of the data at a position
+// using a hash table of the most recent position of each hash
+// and a chain of earlier positions with the same hash.
+// Positions are indexes into the history buffer plus one,
+// so that zero means none.
+type matcher struct {
+	hashShift uint
+	table     []int32
+	chain     []int32
+	chainMask int
+
+	// next is the next position to insert.
+	next int
+}
+
+// reset prepares m for use with the given table sizes,
+// forgetting all positions.
+func (m *matcher) reset(hashLog, chainLog uint8) {
+	m.hashShift = 32 - uint(hashLog)
+	if cap(m.table) < 1<<hashLog {
+		m.table = make([]int32, 1<<hashLog)
+	} else {
+		m.table = m.table[:1<<hashLog]
+		clear(m.table)
+	}
+	if cap(m.chain) < 1<<chainLog {
+		m.chain = make([]int32, 1<<chainLog)
+	} else {
+		m.chain = m.chain[:1<<chainLog]
+		clear(m.chain)
+	}
+	m.chainMask = len(m.chain) - 1
+	m.next = 0
+}
+
+func (m *matcher) hash(src []byte) uint32 {
+	v := uint32(src[0]) | uint32(src[1])<<8 | uint32(src[2])<<16
+	return (v * 2654435761) >> m.hashShift
+}
+
+// insert adds the positions from m.next up to but not including end,
+// as far as src holds enough bytes to hash them.
+func (m *matcher) insert(src []byte, end int) {
+	end = min(end, len(src)-hashMinLen+1)
+	for p := m.next; p < end; p++ {
+		h := m.hash(src[p:])
+		m.chain[p&m.chainMask] = m.table[h]
+		m.table[h] = int32(p + 1)
+	}
+	m.next = max(m.next, end)
+}
+
+// find returns the longest match for src[p:end], up to matchMaxLen
+// bytes long, among up to depth
+// earlier positions no lower than low. It inserts the positions up to
+// and including p.
+func (m *matcher) find(src []byte, p, end, low, depth, nice int) (length, pos int) {
+	if end-p < hashMinLen {
+		return 0, 0
+	}
+	m.insert(src, p+1)
+	end = min(end, p+matchMaxLen)
+	cand := int(m.chain[p&m.chainMask]) - 1
+	for ; depth > 0 && cand >= low; depth-- {
+		if src[cand+length] == src[p+length] {
+			if n := matchLen(src[cand:], src[p:end]); n > length {
+				length, pos = n, cand
+				if n >= nice || p+n == end {
+					break
+				}
+			}
+		}
+		next := int(m.chain[cand&m.chainMask]) - 1
+		if next >= cand {
+			// The chain entry has been overwritten by
+			// a later position.
+			break
+		}
+		cand = next
+	}
+	if length < hashMinLen {
+		return 0, 0
+	}
+	return length, pos
+}
+
+// shift adjusts all positions after the first d bytes of the
+// history buffer have been discarded. d must be a multiple
+// of the chain size.
+func (m *matcher) shift(d int) {
+	for _, t := range [][]int32{m.table, m.chain} {
+		for i, v := range t {
+			t[i] = max(v-int32(d), 0)
+		}
+	}
+	m.next = max(m.next-d, 0)
+}
+
+// matchLen returns the length of the common prefix of a and b.
+// a must be at least as long as b.
+func matchLen(a, b []byte) int {
+	n := 0
+	for len(b)-n >= 8 {
+		x := binary.LittleEndian.Uint64(a[n:]) ^ binary.LittleEndian.Uint64(b[n:])
+		if x != 0 {
+			return n + bits.TrailingZeros64(x)/8
+		}
+		n += 8
+	}
+	for n < len(b) && a[n] == b[n] {
+		n++
+	}
+	return n
+}
+
+// An lzmaEncoder encodes LZMA2 chunks. It uses the same probability
+// model as lzmaDecoder and chooses between literals and matches
+// greedily, looking one position ahead for a better match.
+type lzmaEncoder struct {
+	p    *levelParams
+	dict int // dictionary size
+	m    matcher
+	rc   rangeEncoder
+
+	lzmaState
+
+	// base is the position of the history buffer's
+	// first byte since the dictionary reset.
+	base int64
+}
+
+// reset prepares e for a new block.
+func (e *lzmaEncoder) reset(p *levelParams) {
+	e.p = p
+	e.dict = 1 << p.dictLog
+	e.m.reset(p.hashLog, p.chainLog)
+	e.base = 0
+	e.lc, e.lp, e.pb = encLC, encLP, encPB
+	e.lzmaState.reset()
+}
+
+// shift adjusts the positions after the first d bytes of the
+// history buffer have been discarded.
+func (e *lzmaEncoder) shift(d int) {
+	e.m.shift(d)
+	e.base += int64(d)
+}
+
+// encodeChunk encodes an LZMA chunk of src starting at p, where the
+// data before p is the history, and appends it to dst. It stops at
+// end or when the chunk is full, and returns the end of the chunk.
+func (e *lzmaEncoder) encodeChunk(dst, src []byte, p, end int) ([]byte, int) {
+	e.rc.reset(dst)
+	end = min(end, p+maxChunkSize)
+	low := max(0, p-e.dict)
+
+	// The longest match at the position after p, found while
+	// looking ahead.
+	nextLen, nextPos := 0, 0
+	for p < end && e.rc.size()-len(dst) < maxChunkCompressedSize-32 {
+		low = max(low, p-e.dict)
+		var mainLen, mainPos int
+		if nextLen > 0 {
+			mainLen, mainPos = nextLen, nextPos
+			nextLen = 0
+		} else {
+			mainLen, mainPos = e.m.find(src, p, end, low, e.p.depth, e.p.nice)
+		}
+		mainDist := p - mainPos
+
+		repLen, repIdx := 0, 0
+		for i, r := range e.reps {
+			d := int(r) + 1
+			if d > p || d > e.dict || p+2 > end || src[p] != src[p-d] || src[p+1] != src[p+1-d] {
+				continue
+			}
+			if n := matchLen(src[p-d:], src[p:min(end, p+matchMaxLen)]); n > repLen {
+				repLen, repIdx = n, i
+			}
+		}
+		if mainLen == hashMinLen && mainDist >= 1<<14 {
+			// Not worth the cost of the distance.
+			mainLen = 0
+		}
+
+		switch {
+		case repLen >= e.p.nice || repLen >= 2 && (repLen+1 >= mainLen ||
+			repLen+2 >= mainLen && mainDist >= 1<<9 ||
+			repLen+3 >= mainLen && mainDist >= 1<<15):
+			e.encodeRep(src, p, repIdx, repLen)
+			e.m.insert(src, p+repLen)
+			p += repLen
+			continue
+		case mainLen == 0:
+			e.encodeLiteral(src, p)
+			p++
+			continue
+		}
+
+		if mainLen < e.p.nice && p+1 < end {
+			// Prefer a literal if the next position
+			// starts a better match.
+			n, pos := e.m.find(src, p+1, end, max(low, p+1-e.dict), e.p.depth, e.p.nice)
+			d := p + 1 - pos
+			if n > 0 && (n >= mainLen && d < mainDist ||
+				n == mainLen+1 && d>>7 <= mainDist ||
+				n > mainLen+1 ||
+				n+1 >= mainLen && mainLen >= 3 && mainDist>>7 > d) {
+				e.encodeLiteral(src, p)
+				p++
+				nextLen, nextPos = n, pos
+				continue
+			}
+		}
+		e.encodeMatch(src, p, mainDist, mainLen)
+		e.m.insert(src, p+mainLen)
+		p += mainLen
+	}
+	e.rc.flush()
+	return e.rc.out, p
+}
+
+// pos returns the position of src[p] since the dictionary reset.
+func (e *lzmaEncoder) pos(p int) uint32 {
+	return uint32(e.base + int64(p))
+}
+
+// encodeLiteral encodes src[p] as a literal, or as a short rep if
+// it equals the byte at the last distance.
+func (e *lzmaEncoder) encodeLiteral(src []byte, p int) {
+	rc := &e.rc
+	pos := e.pos(p)
+	posState := pos & (1<<e.pb - 1)
+	state := e.state
+	d := int(e.reps[0]) + 1
+	if d <= p && src[p-d] == src[p] {
+		rc.bit(&e.isMatch[state][posState], 1)
+		rc.bit(&e.isRep[state], 1)
+		rc.bit(&e.isRepG0[state], 0)
+		rc.bit(&e.isRep0Long[state][posState], 0)
+		if state < 7 {
+			e.state = 9
+		} else {
+			e.state = 11
+		}
+		return
+	}
+
+	rc.bit(&e.isMatch[state][posState], 0)
+	var prev uint32
+	if p > 0 {
+		prev = uint32(src[p-1])
+	}
+	probs := e.literal[0x300*((pos&(1<<e.lp-1))<<e.lc+prev>>(8-e.lc)):][:0x300]
+	lit := uint32(src[p])
+	sym := uint32(1)
+	i := 7
+	if state >= 7 {
+		// The decoder has the byte at the last distance: see
+		// lzmaDecoder.decode. It lies within the history, as
+		// the state can only be this high after a match.
+		match := uint32(src[p-d])
+		for ; i >= 0; i-- {
+			matchBit := match >> i & 1
+			b := lit >> i & 1
+			rc.bit(&probs[(1+matchBit)<<8+sym], b)
+			sym = sym<<1 | b
+			if matchBit != b {
+				i--
+				break
+			}
+		}
+	}
+	for ; i >= 0; i-- {
+		b := lit >> i & 1
+		rc.bit(&probs[sym], b)
+		sym = sym<<1 | b
+	}
+	switch {
+	case state < 4:
+		e.state = 0
+	case state < 10:
+		e.state -= 3
+	default:
+		e.state -= 6
+	}
+}
+
+// encodeMatch encodes a match of length n at distance dist.
+func (e *lzmaEncoder) encodeMatch(src []byte, p, dist, n int) {
+	rc := &e.rc
+	posState := e.pos(p) & (1<<e.pb - 1)
+	rc.bit(&e.isMatch[e.state][posState], 1)
+	rc.bit(&e.isRep[e.state], 0)
+	l := uint32(n - matchMinLen)
+	rc.encodeLen(&e.lenDec, l, posState)
+
+	dist--
+	d := uint32(dist)
+	var slot uint32
+	if d < startPosModelIndex {
+		slot = d
+	} else {
+		k := uint32(bits.Len32(d)) - 1
+		slot = 2*k + (d>>(k-1))&1
+	}
+	rc.tree(e.posSlot[min(l, numLenToPosStates-1)][:], slot, 6)
+	if slot >= startPosModelIndex {
+		numDirectBits := int(slot>>1) - 1
+		base := (2 | slot&1) << numDirectBits
+		rem := d - base
+		if slot < endPosModelIndex {
+			rc.reverseTree(e.posSpecial[base-slot:], rem, numDirectBits)
+		} else {
+			rc.direct(rem>>numAlignBits, numDirectBits-numAlignBits)
+			rc.reverseTree(e.align[:], rem&(1<<numAlignBits-1), numAlignBits)
+		}
+	}
+	e.reps[3], e.reps[2], e.reps[1], e.reps[0] = e.reps[2], e.reps[1], e.reps[0], d
+	if e.state < 7 {
+		e.state = 7
+	} else {
+		e.state = 10
+	}
+}
+
+// encodeRep encodes a match of length n at the distance
+// e.reps[idx].
+func (e *lzmaEncoder) encodeRep(src []byte, p, idx, n int) {
+	rc := &e.rc
+	posState := e.pos(p) & (1<<e.pb - 1)
+	state := e.state
+	rc.bit(&e.isMatch[state][posState], 1)
+	rc.bit(&e.isRep[state], 1)
+	if idx == 0 {
+		rc.bit(&e.isRepG0[state], 0)
+		rc.bit(&e.isRep0Long[state][posState], 1)
+	} else {
+		rc.bit(&e.isRepG0[state], 1)
+		dist := e.reps[idx]
+		if idx == 1 {
+			rc.bit(&e.isRepG1[state], 0)
+		} else {
+			rc.bit(&e.isRepG1[state], 1)
+			rc.bit(&e.isRepG2[state], uint32(idx-2))
+			if idx == 3 {
+				e.reps[3] = e.reps[2]
+			}
+			e.reps[2] = e.reps[1]
+		}
+		e.reps[1] = e.reps[0]
+		e.reps[0] = dist
+	}
+	rc.encodeLen(&e.repLenDec, uint32(n-matchMinLen), posState)
+	if state < 7 {
+		e.state = 8
+	} else {
+		e.state = 11
+	}
+}
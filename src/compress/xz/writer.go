@@ -0,0 +1,332 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package xz
+
+import (
+	"errors"
+	"fmt"
+	"hash"
+	"hash/crc32"
+	"hash/crc64"
+	"io"
+)
+
+// Compression levels, following the conventions of [compress/flate].
+// Levels range from 1 ([BestSpeed]) to 9 ([BestCompression]);
+// higher levels typically run slower but compress more. Level 0
+// ([NoCompression]) does not attempt any compression; it only adds
+// the necessary framing. Level -1 ([DefaultCompression]) uses the
+// default compression level, which is 6.
+//
+// The levels roughly correspond to the presets of the xz command,
+// except that the dictionary size is at most 8 MiB.
+const (
+	NoCompression      = 0
+	BestSpeed          = 1
+	BestCompression    = 9
+	DefaultCompression = -1
+)
+
+var errWriterClosed = errors.New("xz: closed writer")
+
+// A Writer is an [io.WriteCloser] that compresses data written to it
+// into a single xz stream, written to an underlying writer.
+// The stream holds a single block, compressed with LZMA2 and checked
+// with CRC-64.
+type Writer struct {
+	w     io.Writer
+	level int
+	p     *levelParams
+	dict  int // dictionary size
+
+	// buf holds history, followed by the data from buf[start:]
+	// that has not been compressed yet.
+	buf   []byte
+	start int
+
+	wroteHeader   bool
+	inBlock       bool
+	needDictReset bool // the next chunk must reset the dictionary
+	needProps     bool // the next LZMA chunk must set the properties
+	needReset     bool // the next LZMA chunk must reset the state
+
+	enc          lzmaEncoder
+	compressed   int64 // size of the block's compressed data so far
+	uncompressed int64
+	check        hash.Hash64
+	chunk        []byte
+	out          []byte
+	err          error
+}
+
+// NewWriter returns a new [Writer] compressing data at the default level.
+// Writes to the returned writer are compressed and written to w.
+//
+// It is the caller's responsibility to call Close on the [Writer] when done.
+// Writes may be buffered and not flushed until Close.
+func NewWriter(w io.Writer) *Writer {
+	z, _ := NewWriterLevel(w, DefaultCompression)
+	return z
+}
+
+// NewWriterLevel is like [NewWriter] but specifies the compression level
+// instead of assuming [DefaultCompression].
+//
+// The compression level can be [DefaultCompression], [NoCompression],
+// or any integer value between [BestSpeed] and [BestCompression] inclusive.
+// The error returned will be nil if the level is valid.
+func NewWriterLevel(w io.Writer, level int) (*Writer, error) {
+	if level < DefaultCompression || level > BestCompression {
+		return nil, fmt.Errorf("xz: invalid compression level: %d", level)
+	}
+	if level == DefaultCompression {
+		level = 6
+	}
+	z := &Writer{
+		level: level,
+		p:     &levels[level],
+		check: crc64.New(crc64Table),
+	}
+	z.dict = 1 << z.p.dictLog
+	z.Reset(w)
+	return z, nil
+}
+
+// Reset discards the [Writer] z's state and makes it equivalent to the
+// result of its original state from [NewWriter] or [NewWriterLevel],
+// but writing to w instead. This permits reusing a Writer rather than
+// allocating a new one.
+func (z *Writer) Reset(w io.Writer) {
+	z.w = w
+	z.buf = z.buf[:0]
+	z.start = 0
+	z.wroteHeader = false
+	z.inBlock = false
+	z.compressed = 0
+	z.uncompressed = 0
+	z.check.Reset()
+	z.err = nil
+}
+
+// Write writes a compressed form of p to the underlying [io.Writer].
+// The compressed bytes are not necessarily flushed until the Writer
+// is closed or flushed.
+func (z *Writer) Write(p []byte) (int, error) {
+	if z.err != nil {
+		return 0, z.err
+	}
+	z.check.Write(p)
+	z.uncompressed += int64(len(p))
+	n := 0
+	for n < len(p) {
+		k := min(len(p)-n, maxChunkSize-(len(z.buf)-z.start))
+		z.buf = append(z.buf, p[n:n+k]...)
+		n += k
+		if len(z.buf)-z.start == maxChunkSize {
+			if err := z.compress(len(z.buf)); err != nil {
+				return n, err
+			}
+		}
+	}
+	return n, nil
+}
+
+// Flush compresses any pending data and writes it to the underlying
+// writer. It is useful mainly in network protocols, to ensure that a
+// remote reader has enough data to reconstruct a packet. Flush does
+// not return until the data has been written. If the underlying
+// writer returns an error, Flush returns that error.
+func (z *Writer) Flush() error {
+	if z.err != nil {
+		return z.err
+	}
+	return z.compress(len(z.buf))
+}
+
+// Close closes the [Writer] by compressing any pending data and
+// writing the end of the stream to the underlying writer.
+// It does not close the underlying writer.
+func (z *Writer) Close() error {
+	if z.err != nil {
+		if z.err == errWriterClosed {
+			return nil
+		}
+		return z.err
+	}
+	if err := z.compress(len(z.buf)); err != nil {
+		return err
+	}
+	out := z.out[:0]
+	if !z.wroteHeader {
+		out = appendStreamHeader(out)
+	}
+	var records []record
+	if z.inBlock {
+		// End of the LZMA2 data, block padding and check.
+		out = append(out, 0)
+		z.compressed++
+		unpadded := blockHeaderSize + z.compressed + int64(checkSize(checkCRC64))
+		for z.compressed%4 != 0 {
+			out = append(out, 0)
+			z.compressed++
+		}
+		out = checkSum(out, z.check)
+		records = append(records, record{
+			unpaddedSize:     uint64(unpadded),
+			uncompressedSize: uint64(z.uncompressed),
+		})
+	}
+	out = appendIndex(out, records)
+	z.out = out
+	if _, err := z.w.Write(out); err != nil {
+		z.err = err
+		return err
+	}
+	z.err = errWriterClosed
+	return nil
+}
+
+// blockHeaderSize is the size of the block headers written by a Writer.
+const blockHeaderSize = 12
+
+// appendStreamHeader appends a stream header using CRC-64 checks.
+func appendStreamHeader(dst []byte) []byte {
+	dst = append(dst, headerMagic...)
+	flags := []byte{0, checkCRC64}
+	dst = append(dst, flags...)
+	return le.AppendUint32(dst, crc32.ChecksumIEEE(flags))
+}
+
+// appendBlockHeader appends a block header with a single LZMA2 filter
+// using the dictionary size dict and no sizes.
+func appendBlockHeader(dst []byte, dict int) []byte {
+	b := byte(0)
+	for lzma2DictSize(b) < dict {
+		b++
+	}
+	start := len(dst)
+	dst = append(dst, blockHeaderSize/4-1, 0, filterLZMA2, 1, b, 0, 0, 0)
+	return le.AppendUint32(dst, crc32.ChecksumIEEE(dst[start:]))
+}
+
+// appendIndex appends the index of the blocks and the stream footer.
+func appendIndex(dst []byte, records []record) []byte {
+	start := len(dst)
+	dst = append(dst, 0)
+	dst = appendVLI(dst, uint64(len(records)))
+	for _, r := range records {
+		dst = appendVLI(dst, r.unpaddedSize)
+		dst = appendVLI(dst, r.uncompressedSize)
+	}
+	for (len(dst)-start)%4 != 0 {
+		dst = append(dst, 0)
+	}
+	dst = le.AppendUint32(dst, crc32.ChecksumIEEE(dst[start:]))
+
+	footer := len(dst)
+	dst = append(dst, 0, 0, 0, 0)
+	dst = le.AppendUint32(dst, uint32((len(dst)-4-start)/4-1))
+	dst = append(dst, 0, checkCRC64)
+	le.PutUint32(dst[footer:], crc32.ChecksumIEEE(dst[footer+4:]))
+	return append(dst, footerMagic...)
+}
+
+// compress compresses z.buf[z.start:end] into LZMA2 chunks
+// and writes them out.
+func (z *Writer) compress(end int) error {
+	if z.start == end {
+		return nil
+	}
+	out := z.out[:0]
+	if !z.wroteHeader {
+		out = appendStreamHeader(out)
+		z.wroteHeader = true
+	}
+	if !z.inBlock {
+		out = appendBlockHeader(out, z.dict)
+		z.inBlock = true
+		z.needDictReset = true
+		z.needProps = true
+		z.needReset = true
+		if z.level != NoCompression {
+			z.enc.reset(z.p)
+		}
+	}
+	n := len(out)
+	for z.start < end {
+		out = z.appendChunk(out, end)
+	}
+	z.compressed += int64(len(out) - n)
+	z.out = out
+
+	if _, err := z.w.Write(out); err != nil {
+		z.err = err
+		return err
+	}
+
+	// Discard history that is out of reach, in multiples of
+	// the dictionary size so that the hash chain stays aligned.
+	if z.start >= 2*z.dict {
+		d := (z.start - z.dict) &^ (z.dict - 1)
+		n := copy(z.buf, z.buf[d:])
+		z.buf = z.buf[:n]
+		z.start -= d
+		if z.level != NoCompression {
+			z.enc.shift(d)
+		}
+	}
+	return nil
+}
+
+// appendChunk appends an LZMA2 chunk holding data from
+// z.buf[z.start:end] and advances z.start. It falls back to
+// uncompressed chunks if the data does not compress.
+func (z *Writer) appendChunk(dst []byte, end int) []byte {
+	if z.level != NoCompression {
+		var e int
+		z.chunk, e = z.enc.encodeChunk(z.chunk[:0], z.buf, z.start, end)
+		size, csize := e-z.start, len(z.chunk)
+		if csize < size {
+			control := byte(0x80)
+			switch {
+			case z.needDictReset:
+				control = 0xE0 // dictionary reset, properties and state reset
+			case z.needProps:
+				control = 0xC0 // properties and state reset
+			case z.needReset:
+				control = 0xA0 // state reset
+			}
+			dst = append(dst, control|byte((size-1)>>16), byte((size-1)>>8), byte(size-1),
+				byte((csize-1)>>8), byte(csize-1))
+			if control >= 0xC0 {
+				dst = append(dst, encProps)
+			}
+			dst = append(dst, z.chunk...)
+			z.needDictReset = false
+			z.needProps = false
+			z.needReset = false
+			z.start = e
+			return dst
+		}
+
+		// Store the data instead. The decoder's state does not
+		// change, so the encoder's state must be reset to match.
+		end = e
+		z.enc.lzmaState.reset()
+		z.needReset = true
+	}
+	for z.start < end {
+		n := min(end-z.start, maxChunkCompressedSize)
+		control := byte(0x02)
+		if z.needDictReset {
+			control = 0x01 // dictionary reset
+			z.needDictReset = false
+		}
+		dst = append(dst, control, byte((n-1)>>8), byte(n-1))
+		dst = append(dst, z.buf[z.start:z.start+n]...)
+		z.start += n
+	}
+	return dst
+}
@@ -0,0 +1,597 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package xz implements reading and writing of xz format compressed data,
+// as specified in "The .xz File Format" version 1.2.0, distributed
+// with XZ Utils.
+//
+// An xz stream is a sequence of blocks, each of which is compressed
+// by a chain of filters ending in LZMA2 and protected by an optional
+// integrity check, followed by an index of the blocks. The [Reader]
+// supports the LZMA2 filter together with the delta filter and the
+// branch/call/jump (BCJ) filters for x86, PowerPC, IA-64, ARM,
+// ARM-Thumb, SPARC and ARM64 executables, and verifies CRC-32,
+// CRC-64 and SHA-256 checks. The [Writer] uses LZMA2 alone.
+package xz
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash"
+	"hash/crc32"
+	"hash/crc64"
+	"io"
+)
+
+var (
+	// ErrChecksum is returned when reading xz data that has an invalid checksum.
+	ErrChecksum = errors.New("xz: invalid checksum")
+	// ErrHeader is returned when reading xz data that has an invalid header.
+	ErrHeader = errors.New("xz: invalid header")
+	// ErrCorrupt is returned when reading xz data that is malformed.
+	ErrCorrupt = errors.New("xz: corrupt data")
+)
+
+var le = binary.LittleEndian
+
+const (
+	headerMagic = "\xfd7zXZ\x00"
+	footerMagic = "YZ"
+
+	headerSize = 12
+	footerSize = 12
+)
+
+// Integrity check types.
+const (
+	checkNone   = 0x00
+	checkCRC32  = 0x01
+	checkCRC64  = 0x04
+	checkSHA256 = 0x0A
+)
+
+// Filter IDs.
+const (
+	filterDelta    = 0x03
+	filterX86      = 0x04
+	filterPowerPC  = 0x05
+	filterIA64     = 0x06
+	filterARM      = 0x07
+	filterARMThumb = 0x08
+	filterSPARC    = 0x09
+	filterARM64    = 0x0A
+	filterLZMA2    = 0x21
+)
+
+const maxFilters = 4
+
+var crc64Table = crc64.MakeTable(crc64.ECMA)
+
+// checkSize returns the size of the integrity check of the given type.
+func checkSize(check byte) int {
+	if check == checkNone {
+		return 0
+	}
+	return 4 << ((check - 1) / 3)
+}
+
+// newCheck returns a hash computing the integrity check of the
+// given type, or nil if the check is not computed.
+func newCheck(check byte) hash.Hash {
+	switch check {
+	case checkCRC32:
+		return crc32.NewIEEE()
+	case checkCRC64:
+		return crc64.New(crc64Table)
+	case checkSHA256:
+		return sha256.New()
+	}
+	return nil
+}
+
+// checkSum appends the integrity check computed by h to b,
+// in the byte order of the xz format.
+func checkSum(b []byte, h hash.Hash) []byte {
+	switch h := h.(type) {
+	case hash.Hash32:
+		return le.AppendUint32(b, h.Sum32())
+	case hash.Hash64:
+		return le.AppendUint64(b, h.Sum64())
+	}
+	return h.Sum(b)
+}
+
+// noEOF converts io.EOF to io.ErrUnexpectedEOF.
+func noEOF(err error) error {
+	if err == io.EOF {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
+
+// byteReader is the interface required of the underlying reader.
+type byteReader interface {
+	io.Reader
+	io.ByteReader
+}
+
+// A countReader counts the bytes read from the underlying reader.
+type countReader struct {
+	r byteReader
+	n int64
+}
+
+func (r *countReader) Read(p []byte) (int, error) {
+	n, err := r.r.Read(p)
+	r.n += int64(n)
+	return n, err
+}
+
+func (r *countReader) ReadByte() (byte, error) {
+	b, err := r.r.ReadByte()
+	if err == nil {
+		r.n++
+	}
+	return b, err
+}
+
+// A crcReader computes the CRC-32 of the bytes read.
+type crcReader struct {
+	r   io.ByteReader
+	crc uint32
+}
+
+func (r *crcReader) ReadByte() (byte, error) {
+	b, err := r.r.ReadByte()
+	if err == nil {
+		r.crc = crc32.Update(r.crc, crc32.IEEETable, []byte{b})
+	}
+	return b, err
+}
+
+// readVLI reads a variable-length integer.
+func readVLI(r io.ByteReader) (uint64, error) {
+	var v uint64
+	for i := 0; i < 9; i++ {
+		b, err := r.ReadByte()
+		if err != nil {
+			return 0, noEOF(err)
+		}
+		v |= uint64(b&0x7F) << (7 * i)
+		if b < 0x80 {
+			if b == 0 && i > 0 {
+				// Not the shortest encoding.
+				return 0, ErrCorrupt
+			}
+			return v, nil
+		}
+	}
+	return 0, ErrCorrupt
+}
+
+// appendVLI appends the variable-length encoding of v to b.
+func appendVLI(b []byte, v uint64) []byte {
+	for v >= 0x80 {
+		b = append(b, byte(v)|0x80)
+		v >>= 7
+	}
+	return append(b, byte(v))
+}
+
+// A record describes a block in the index.
+type record struct {
+	unpaddedSize     uint64
+	uncompressedSize uint64
+}
+
+// A filter is an entry of the filter chain of a block.
+type filter struct {
+	id    uint64
+	props []byte
+}
+
+// A Reader is an [io.Reader] that can be read to retrieve
+// uncompressed data from an xz-format compressed file.
+//
+// An xz file can be a concatenation of xz streams.
+// Reads from the Reader return the concatenation of the
+// uncompressed data of each.
+//
+// The Reader verifies the integrity check of each block, and the
+// index at the end of each stream. It returns an [ErrChecksum] when
+// a check does not match, and an [ErrCorrupt] when the index does
+// not describe the blocks read. Clients should treat data returned
+// by [Reader.Read] as tentative until they receive the [io.EOF]
+// marking the end of the data.
+type Reader struct {
+	r           countReader
+	flags       [2]byte // stream flags of the current stream
+	multistream bool
+	err         error
+
+	block        io.Reader // nil between blocks
+	blockStart   int64     // offset of the block's compressed data
+	headerSize   int
+	compressed   int64 // from the block header, or -1
+	uncompressed int64 // from the block header, or -1
+	written      int64 // uncompressed bytes of the block read so far
+	check        hash.Hash
+	records      []record
+
+	lzma2 *lzma2Reader
+	buf   [1024]byte
+}
+
+// NewReader creates a new [Reader] reading the given reader.
+// If r does not also implement [io.ByteReader],
+// the decompressor may read more data than necessary from r.
+//
+// NewReader reads and checks the stream header.
+func NewReader(r io.Reader) (*Reader, error) {
+	z := new(Reader)
+	if err := z.Reset(r); err != nil {
+		return nil, err
+	}
+	return z, nil
+}
+
+// Reset discards the [Reader] z's state and makes it equivalent to the
+// result of its original state from [NewReader], but reading from r instead.
+// This permits reusing a [Reader] rather than allocating a new one.
+func (z *Reader) Reset(r io.Reader) error {
+	*z = Reader{
+		lzma2:       z.lzma2,
+		records:     z.records[:0],
+		multistream: true,
+	}
+	if br, ok := r.(byteReader); ok {
+		z.r.r = br
+	} else {
+		z.r.r = bufio.NewReader(r)
+	}
+	z.err = z.readStreamHeader()
+	return z.err
+}
+
+// Multistream controls whether the reader supports multistream files.
+//
+// If enabled (the default), the [Reader] expects the input to be a
+// sequence of xz streams, possibly separated by stream padding.
+// When the end of a stream has been reached, the Reader checks for
+// more streams and continues with the next one if present.
+//
+// If disabled, [Reader.Read] returns [io.EOF] at the end of the
+// first stream, leaving the underlying reader positioned just after
+// it, provided it implements [io.ByteReader].
+func (z *Reader) Multistream(ok bool) {
+	z.multistream = ok
+}
+
+// readStreamHeader reads the header of a stream.
+// It returns io.EOF if there is no data.
+func (z *Reader) readStreamHeader() error {
+	hdr := z.buf[:headerSize]
+	if _, err := io.ReadFull(&z.r, hdr); err != nil {
+		return err
+	}
+	return z.parseStreamHeader(hdr)
+}
+
+// parseStreamHeader checks the stream header hdr
+// and starts a new stream.
+func (z *Reader) parseStreamHeader(hdr []byte) error {
+	if string(hdr[:6]) != headerMagic || hdr[6] != 0 || hdr[7] > 0x0F ||
+		crc32.ChecksumIEEE(hdr[6:8]) != le.Uint32(hdr[8:]) {
+		return ErrHeader
+	}
+	z.flags = [2]byte{hdr[6], hdr[7]}
+	z.records = z.records[:0]
+	return nil
+}
+
+// Read implements [io.Reader], reading uncompressed bytes from its
+// underlying reader.
+func (z *Reader) Read(p []byte) (n int, err error) {
+	if z.err != nil {
+		return 0, z.err
+	}
+	if len(p) == 0 {
+		return 0, nil
+	}
+	for n == 0 {
+		if z.block == nil {
+			if z.err = z.nextBlock(); z.err != nil {
+				return 0, z.err
+			}
+			continue
+		}
+		n, err = z.block.Read(p)
+		z.written += int64(n)
+		if z.check != nil {
+			z.check.Write(p[:n])
+		}
+		if z.uncompressed >= 0 && z.written > z.uncompressed {
+			z.err = ErrCorrupt
+			return n, z.err
+		}
+		switch err {
+		case nil:
+		case io.EOF:
+			if z.err = z.endBlock(); z.err != nil {
+				return n, z.err
+			}
+		default:
+			z.err = err
+			return n, err
+		}
+	}
+	return n, nil
+}
+
+// nextBlock reads the next block header and sets up the filter chain
+// to decompress the block. At the end of a stream it reads the index
+// and the stream footer, and continues with the next stream if any.
+// It returns io.EOF at the end of the data.
+func (z *Reader) nextBlock() error {
+	for {
+		b, err := z.r.ReadByte()
+		if err != nil {
+			return noEOF(err)
+		}
+		if b != 0 {
+			return z.readBlockHeader(b)
+		}
+		if err := z.readIndex(); err != nil {
+			return err
+		}
+		if !z.multistream {
+			return io.EOF
+		}
+		if err := z.nextStream(); err != nil {
+			return err
+		}
+	}
+}
+
+// nextStream skips stream padding and reads the header of the next
+// stream. It returns io.EOF if there is none.
+func (z *Reader) nextStream() error {
+	padding := 0
+	for {
+		b, err := z.r.ReadByte()
+		if err == io.EOF {
+			if padding%4 != 0 {
+				return ErrCorrupt
+			}
+			return io.EOF
+		}
+		if err != nil {
+			return err
+		}
+		if b == 0 {
+			padding++
+			continue
+		}
+		if padding%4 != 0 || b != headerMagic[0] {
+			return ErrHeader
+		}
+		z.buf[0] = b
+		hdr := z.buf[:headerSize]
+		if _, err := io.ReadFull(&z.r, hdr[1:]); err != nil {
+			return noEOF(err)
+		}
+		return z.parseStreamHeader(hdr)
+	}
+}
+
+// readBlockHeader reads the header of a block, whose first byte is b.
+func (z *Reader) readBlockHeader(b byte) error {
+	n := (int(b) + 1) * 4
+	hdr := z.buf[:n]
+	hdr[0] = b
+	if _, err := io.ReadFull(&z.r, hdr[1:]); err != nil {
+		return noEOF(err)
+	}
+	if crc32.ChecksumIEEE(hdr[:n-4]) != le.Uint32(hdr[n-4:]) {
+		return ErrHeader
+	}
+	flags := hdr[1]
+	if flags&0x3C != 0 {
+		return ErrHeader
+	}
+	br := bytes.NewReader(hdr[2 : n-4])
+	z.compressed, z.uncompressed = -1, -1
+	if flags&0x40 != 0 {
+		v, err := readVLI(br)
+		if err != nil || v == 0 || v > 1<<63-1 {
+			return ErrHeader
+		}
+		z.compressed = int64(v)
+	}
+	if flags&0x80 != 0 {
+		v, err := readVLI(br)
+		if err != nil || v > 1<<63-1 {
+			return ErrHeader
+		}
+		z.uncompressed = int64(v)
+	}
+	var filters [maxFilters]filter
+	numFilters := int(flags&0x03) + 1
+	for i := range numFilters {
+		id, err := readVLI(br)
+		if err != nil {
+			return ErrHeader
+		}
+		size, err := readVLI(br)
+		if err != nil || size > uint64(br.Len()) {
+			return ErrHeader
+		}
+		props := make([]byte, size)
+		br.Read(props)
+		filters[i] = filter{id, props}
+	}
+	for br.Len() > 0 {
+		if b, _ := br.ReadByte(); b != 0 {
+			return ErrHeader
+		}
+	}
+
+	r, err := z.newFilterChain(filters[:numFilters])
+	if err != nil {
+		return err
+	}
+	z.block = r
+	z.headerSize = n
+	z.blockStart = z.r.n
+	z.written = 0
+	z.check = newCheck(z.flags[1])
+	return nil
+}
+
+// newFilterChain returns a reader decompressing block data
+// with the given filters.
+func (z *Reader) newFilterChain(filters []filter) (io.Reader, error) {
+	last := filters[len(filters)-1]
+	if last.id != filterLZMA2 {
+		return nil, fmt.Errorf("xz: unsupported filter chain ending in filter %#x", last.id)
+	}
+	if len(last.props) != 1 || last.props[0] > 40 {
+		return nil, ErrHeader
+	}
+	dictSize := lzma2DictSize(last.props[0])
+	if z.lzma2 == nil {
+		z.lzma2 = new(lzma2Reader)
+	}
+	z.lzma2.reset(&z.r, dictSize)
+	var r io.Reader = z.lzma2
+	for i := len(filters) - 2; i >= 0; i-- {
+		f := filters[i]
+		var err error
+		switch f.id {
+		case filterDelta:
+			if len(f.props) != 1 {
+				return nil, ErrHeader
+			}
+			r = newDeltaReader(r, int(f.props[0])+1)
+		case filterX86, filterPowerPC, filterIA64, filterARM,
+			filterARMThumb, filterSPARC, filterARM64:
+			r, err = newBCJReader(r, f.id, f.props)
+		case filterLZMA2:
+			// LZMA2 must be the last filter.
+			return nil, ErrHeader
+		default:
+			return nil, fmt.Errorf("xz: unsupported filter %#x", f.id)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return r, nil
+}
+
+// lzma2DictSize returns the dictionary size encoded in the
+// LZMA2 properties byte b.
+func lzma2DictSize(b byte) int {
+	if b == 40 {
+		return 0xFFFFFFFF
+	}
+	return (2 | int(b)&1) << (b/2 + 11)
+}
+
+// endBlock checks the sizes, padding and integrity check of the
+// block that has just been decompressed.
+func (z *Reader) endBlock() error {
+	z.block = nil
+	compressed := z.r.n - z.blockStart
+	if z.compressed >= 0 && compressed != z.compressed {
+		return ErrCorrupt
+	}
+	if z.uncompressed >= 0 && z.written != z.uncompressed {
+		return ErrCorrupt
+	}
+	for pad := (4 - compressed%4) % 4; pad > 0; pad-- {
+		b, err := z.r.ReadByte()
+		if err != nil {
+			return noEOF(err)
+		}
+		if b != 0 {
+			return ErrCorrupt
+		}
+	}
+	unpadded := int64(z.headerSize) + compressed
+	n := checkSize(z.flags[1])
+	sum := z.buf[:n]
+	if _, err := io.ReadFull(&z.r, sum); err != nil {
+		return noEOF(err)
+	}
+	if z.check != nil && !bytes.Equal(sum, checkSum(z.buf[n:n], z.check)) {
+		return ErrChecksum
+	}
+	z.records = append(z.records, record{
+		unpaddedSize:     uint64(unpadded) + uint64(n),
+		uncompressedSize: uint64(z.written),
+	})
+	return nil
+}
+
+// readIndex reads the index and the stream footer, following the
+// index indicator, and checks them against the blocks read.
+func (z *Reader) readIndex() error {
+	start := z.r.n - 1
+	r := &crcReader{r: &z.r, crc: crc32.ChecksumIEEE([]byte{0})}
+	count, err := readVLI(r)
+	if err != nil {
+		return err
+	}
+	if count != uint64(len(z.records)) {
+		return ErrCorrupt
+	}
+	for _, rec := range z.records {
+		unpadded, err := readVLI(r)
+		if err != nil {
+			return err
+		}
+		uncompressed, err := readVLI(r)
+		if err != nil {
+			return err
+		}
+		if unpadded != rec.unpaddedSize || uncompressed != rec.uncompressedSize {
+			return ErrCorrupt
+		}
+	}
+	for (z.r.n-start)%4 != 0 {
+		b, err := r.ReadByte()
+		if err != nil {
+			return noEOF(err)
+		}
+		if b != 0 {
+			return ErrCorrupt
+		}
+	}
+	indexSize := z.r.n - start + 4 // including the CRC-32
+	crc := r.crc
+	buf := z.buf[:4+footerSize]
+	if _, err := io.ReadFull(&z.r, buf); err != nil {
+		return noEOF(err)
+	}
+	if le.Uint32(buf) != crc {
+		return ErrChecksum
+	}
+	footer := buf[4:]
+	if string(footer[10:]) != footerMagic ||
+		crc32.ChecksumIEEE(footer[4:10]) != le.Uint32(footer) {
+		return ErrHeader
+	}
+	if footer[8] != z.flags[0] || footer[9] != z.flags[1] {
+		return ErrCorrupt
+	}
+	if (int64(le.Uint32(footer[4:]))+1)*4 != indexSize {
+		return ErrCorrupt
+	}
+	return nil
+}
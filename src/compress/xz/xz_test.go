@@ -0,0 +1,295 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package xz
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"hash/crc32"
+	"io"
+	"os"
+	"strings"
+	"testing"
+)
+
+func mustDecodeHex(s string) []byte {
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+func mustLoadFile(f string) []byte {
+	b, err := os.ReadFile(f)
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// branchData returns data resembling machine code for several
+// architectures, with many branch instructions for the BCJ filters
+// to convert. The testdata/branch.*.xz files hold it compressed
+// by the xz command with each BCJ filter.
+func branchData() []byte {
+	b := make([]byte, 4<<10)
+	x := uint32(1)
+	for i := 0; i+5 <= len(b); i += 4 {
+		x = x*1664525 + 1013904223
+		v := x >> 8
+		switch x >> 29 {
+		case 0: // x86 CALL with a small offset
+			b[i] = 0xE8
+			binary.LittleEndian.PutUint32(b[i+1:], v&0xFFFF)
+			i++
+			continue
+		case 1: // ARM BL
+			v = v&0xFFFF | 0xEB000000
+		case 2: // ARM-Thumb BL
+			v = 0xF800F000 | v&0x07FF07FF
+		case 3: // PowerPC BL
+			binary.BigEndian.PutUint32(b[i:], 0x48000001|v&0xFFFC)
+			continue
+		case 4: // SPARC CALL
+			binary.BigEndian.PutUint32(b[i:], 0x40000000|v&0xFFFF)
+			continue
+		case 5: // ARM64 BL and ADRP
+			if v&1 == 0 {
+				v = 0x94000000 | v&0xFFFF
+			} else {
+				v = 0x90000000 | v&0x00FFFFE0
+			}
+		}
+		binary.LittleEndian.PutUint32(b[i:], v)
+	}
+	return b
+}
+
+const (
+	helloCRC64 = "fd377a585a000004e6d6b44604c0100c2101160000000000000000007bb05428" +
+		"01000b68656c6c6f20776f726c640a00a1f2ffc46a7fbfcf00012c0cae920110" +
+		"1fb6f37d010000000004595a"
+	emptyCRC64 = "fd377a585a000004e6d6b446000000001cdf44211fb6f37d010000000004595a"
+)
+
+var gettysburg = mustLoadFile("../testdata/gettysburg.txt")
+
+func TestReader(t *testing.T) {
+	var vectors = []struct {
+		desc   string
+		input  []byte
+		output []byte
+		err    error
+	}{{
+		desc:  "empty stream",
+		input: mustDecodeHex(emptyCRC64),
+	}, {
+		desc: "hello world, no check",
+		input: mustDecodeHex("" +
+			"fd377a585a000000ff12d94104c0100c2101160000000000000000007bb05428" +
+			"01000b68656c6c6f20776f726c640a000001240ca618d8d806729e7a01000000" +
+			"0000595a",
+		),
+		output: []byte("hello world\n"),
+	}, {
+		desc: "hello world, CRC-32",
+		input: mustDecodeHex("" +
+			"fd377a585a0000016922de3604c0100c2101160000000000000000007bb05428" +
+			"01000b68656c6c6f20776f726c640a002d3b08af0001280caa576d749042990d" +
+			"010000000001595a",
+		),
+		output: []byte("hello world\n"),
+	}, {
+		desc:   "hello world, CRC-64",
+		input:  mustDecodeHex(helloCRC64),
+		output: []byte("hello world\n"),
+	}, {
+		desc: "hello world, SHA-256",
+		input: mustDecodeHex("" +
+			"fd377a585a00000ae1fb0ca104c0100c2101160000000000000000007bb05428" +
+			"01000b68656c6c6f20776f726c640a00a948904f2f0f479b8f8197694b30184b" +
+			"0d2ed1c1cd2a1ec0fb85d299a192a4470001440c017325bd189b4b9a01000000" +
+			"000a595a",
+		),
+		output: []byte("hello world\n"),
+	}, {
+		desc: "1000 zeros",
+		input: mustDecodeHex("" +
+			"fd377a585a000004e6d6b44604c013e8072101160000000000000000da502d51" +
+			"e003e7000b5d00006ffdffffa3b75a0d5e0000004f258aec2be22a3700012fe8" +
+			"0700000099d7dcb0b1c467fb020000000004595a",
+		),
+		output: make([]byte, 1000),
+	}, {
+		desc: "repeated text",
+		input: mustDecodeHex("" +
+			"fd377a585a000004e6d6b44604c03e8803210116000000000000000078b94626" +
+			"e0018700365d00239bc00693d666d15b97b6284afa5e0ff9e57b9c25dfc94710" +
+			"94c8801555bd80da4e821193d499face2ac2efd0379f86c12eaf770000000000" +
+			"03f881e51aae616d00015a8803000000f1ab63fdb1c467fb020000000004595a",
+		),
+		output: []byte(strings.Repeat("Go is expressive, concise, clean, and efficient. ", 8)),
+	}, {
+		desc:   "concatenated streams with padding",
+		input:  mustDecodeHex(helloCRC64 + "00000000" + emptyCRC64 + helloCRC64 + "0000000000000000"),
+		output: []byte("hello world\nhello world\n"),
+	}, {
+		desc:   "multiple blocks",
+		input:  mustLoadFile("testdata/gettysburg.blocks.xz"),
+		output: gettysburg,
+	}, {
+		desc:   "delta filter",
+		input:  mustLoadFile("testdata/gettysburg.delta.xz"),
+		output: gettysburg,
+	}, {
+		desc:  "bad magic",
+		input: mustDecodeHex("fd377a585a010004e6d6b446000000001cdf44211fb6f37d010000000004595a"),
+		err:   ErrHeader,
+	}, {
+		desc:  "bad stream padding",
+		input: mustDecodeHex(emptyCRC64 + "000000"),
+		err:   ErrCorrupt,
+	}, {
+		desc:   "bad check",
+		input:  mustDecodeHex(strings.Replace(helloCRC64, "a1f2ffc46a7fbfcf", "a1f2ffc46a7fbfce", 1)),
+		output: []byte("hello world\n"),
+		err:    ErrChecksum,
+	}, {
+		desc:  "bad block header CRC",
+		input: mustDecodeHex(strings.Replace(helloCRC64, "7bb05428", "7bb05429", 1)),
+		err:   ErrHeader,
+	}, {
+		desc: "index does not match",
+		input: mustDecodeHex("" +
+			"fd377a585a000004e6d6b44604c0100c2101160000000000000000007bb05428" +
+			"01000b68656c6c6f20776f726c640a00a1f2ffc46a7fbfcf00012c0dd9952187" +
+			"1fb6f37d010000000004595a",
+		),
+		output: []byte("hello world\n"),
+		err:    ErrCorrupt,
+	}, {
+		desc: "truncated",
+		input: mustDecodeHex("" +
+			"fd377a585a000004e6d6b44604c0100c2101160000000000000000007bb05428" +
+			"01000b68656c6c6f",
+		),
+		err: io.ErrUnexpectedEOF,
+	}}
+
+	for _, v := range vectors {
+		z, err := NewReader(bytes.NewReader(v.input))
+		var out []byte
+		if err == nil {
+			out, err = io.ReadAll(z)
+		}
+		if !errors.Is(err, v.err) {
+			t.Errorf("%s: got error %v, want %v", v.desc, err, v.err)
+		}
+		if !bytes.Equal(out, v.output) {
+			t.Errorf("%s: got %q, want %q", v.desc, out, v.output)
+		}
+	}
+}
+
+func TestBCJ(t *testing.T) {
+	want := branchData()
+	for _, arch := range []string{"x86", "powerpc", "ia64", "arm", "armthumb", "sparc", "arm64"} {
+		in := mustLoadFile("testdata/branch." + arch + ".xz")
+		z, err := NewReader(bytes.NewReader(in))
+		if err != nil {
+			t.Fatalf("%s: %v", arch, err)
+		}
+		got, err := io.ReadAll(z)
+		if err != nil {
+			t.Errorf("%s: %v", arch, err)
+			continue
+		}
+		if !bytes.Equal(got, want) {
+			t.Errorf("%s: output mismatch", arch)
+		}
+	}
+}
+
+func TestUnsupportedFilter(t *testing.T) {
+	// A block header with a filter ID of 0x0B (RISC-V) before LZMA2.
+	hdr := []byte{0x03, 0x01, 0x0B, 0x00, 0x21, 0x01, 0x16, 0x00, 0x00, 0x00, 0x00, 0x00}
+	hdr = binary.LittleEndian.AppendUint32(hdr, crc32.ChecksumIEEE(hdr))
+	in := append(mustDecodeHex(emptyCRC64[:24]), hdr...)
+	z, err := NewReader(bytes.NewReader(in))
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = io.ReadAll(z)
+	if err == nil || !strings.Contains(err.Error(), "unsupported filter 0xb") {
+		t.Errorf("got error %v, want unsupported filter", err)
+	}
+}
+
+func TestMultistreamFalse(t *testing.T) {
+	in := mustDecodeHex(helloCRC64 + emptyCRC64 + helloCRC64)
+	r := bytes.NewReader(in)
+	z, err := NewReader(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 3; i++ {
+		z.Multistream(false)
+		got, err := io.ReadAll(z)
+		if err != nil {
+			t.Fatalf("stream %d: %v", i, err)
+		}
+		want := "hello world\n"
+		if i == 1 {
+			want = ""
+		}
+		if string(got) != want {
+			t.Errorf("stream %d: got %q, want %q", i, got, want)
+		}
+		err = z.Reset(r)
+		if i < 2 && err != nil {
+			t.Fatalf("stream %d: Reset: %v", i+1, err)
+		}
+		if i == 2 && err != io.EOF {
+			t.Fatalf("Reset at end: got %v, want io.EOF", err)
+		}
+	}
+}
+
+func TestTruncatedStreams(t *testing.T) {
+	in := mustLoadFile("testdata/gettysburg.blocks.xz")
+	for i := 1; i < len(in); i++ {
+		z, err := NewReader(bytes.NewReader(in[:i]))
+		if err != nil {
+			if err != io.ErrUnexpectedEOF {
+				t.Errorf("NewReader(%d bytes): got %v, want io.ErrUnexpectedEOF", i, err)
+			}
+			continue
+		}
+		_, err = io.Copy(io.Discard, z)
+		if err != io.ErrUnexpectedEOF {
+			t.Errorf("Read(%d bytes): got %v, want io.ErrUnexpectedEOF", i, err)
+		}
+	}
+}
+
+func TestCorruptStreams(t *testing.T) {
+	// Flipping any bit must not go unnoticed or cause a panic.
+	in := mustLoadFile("testdata/gettysburg.delta.xz")
+	corrupt := make([]byte, len(in))
+	for i := 0; i < len(in); i++ {
+		copy(corrupt, in)
+		corrupt[i] ^= 1 << (i % 8)
+		z, err := NewReader(bytes.NewReader(corrupt))
+		if err != nil {
+			continue
+		}
+		if _, err := io.Copy(io.Discard, z); err == nil {
+			t.Errorf("flipped bit in byte %d: no error", i)
+		}
+	}
+}
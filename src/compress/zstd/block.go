@@ -0,0 +1,357 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package zstd
+
+import (
+	"encoding/binary"
+	"math"
+	"math/bits"
+)
+
+// A seq is a sequence: a run of literals followed by a match.
+type seq struct {
+	litLen   uint32
+	matchLen uint32
+	offBase  uint32 // repeat code 1-3, or offset+3
+}
+
+// Baselines and extra bits of the literal length and
+// match length codes. RFC 3.1.1.3.2.1.1.
+var (
+	llBase = [36]uint32{
+		0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15,
+		16, 18, 20, 22, 24, 28, 32, 40, 48, 64, 128, 256, 512,
+		1024, 2048, 4096, 8192, 16384, 32768, 65536,
+	}
+	llBits = [36]uint8{
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		1, 1, 1, 1, 2, 2, 3, 3, 4, 6, 7, 8, 9,
+		10, 11, 12, 13, 14, 15, 16,
+	}
+	mlBase = [53]uint32{
+		3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18,
+		19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31, 32, 33, 34,
+		35, 37, 39, 41, 43, 47, 51, 59, 67, 83, 99, 131, 259, 515,
+		1027, 2051, 4099, 8195, 16387, 32771, 65539,
+	}
+	mlBits = [53]uint8{
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		1, 1, 1, 1, 2, 2, 3, 3, 4, 4, 5, 7, 8, 9,
+		10, 11, 12, 13, 14, 15, 16,
+	}
+)
+
+// Predefined distributions of the sequence codes. RFC 3.1.1.3.2.2.
+var (
+	predefinedLLNorm = []int16{
+		4, 3, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 1, 1, 1,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 3, 2, 1, 1, 1, 1, 1,
+		-1, -1, -1, -1,
+	}
+	predefinedMLNorm = []int16{
+		1, 4, 3, 2, 2, 2, 2, 2, 2, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, -1, -1,
+		-1, -1, -1, -1, -1,
+	}
+	predefinedOFNorm = []int16{
+		1, 1, 1, 1, 1, 1, 2, 2, 2, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, -1, -1, -1, -1, -1,
+	}
+)
+
+// seqKind describes one of the three kinds of sequence codes.
+type seqKind struct {
+	maxLog      uint8 // largest table log a decoder accepts
+	predefNorm  []int16
+	predefLog   uint8
+	predefTable fseEncoder
+}
+
+const (
+	kindLL = iota
+	kindOF
+	kindML
+)
+
+var seqKinds = func() *[3]seqKind {
+	k := &[3]seqKind{
+		kindLL: {maxLog: 9, predefNorm: predefinedLLNorm, predefLog: 6},
+		kindOF: {maxLog: 8, predefNorm: predefinedOFNorm, predefLog: 5},
+		kindML: {maxLog: 9, predefNorm: predefinedMLNorm, predefLog: 6},
+	}
+	for i := range k {
+		k[i].predefTable.build(k[i].predefNorm, k[i].predefLog)
+	}
+	return k
+}()
+
+// llCode returns the literal length code for n.
+func llCode(n uint32) uint8 {
+	if n < 16 {
+		return uint8(n)
+	}
+	if n >= 64 {
+		return uint8(bits.Len32(n) - 1 + 19)
+	}
+	c := 16
+	for llBase[c+1] <= n {
+		c++
+	}
+	return uint8(c)
+}
+
+// mlCode returns the match length code for a match of length n.
+func mlCode(n uint32) uint8 {
+	n -= 3
+	if n < 32 {
+		return uint8(n)
+	}
+	if n >= 128 {
+		return uint8(bits.Len32(n) - 1 + 36)
+	}
+	c := 32
+	for mlBase[c+1]-3 <= n {
+		c++
+	}
+	return uint8(c)
+}
+
+// ofCode returns the offset code for offBase.
+func ofCode(offBase uint32) uint8 {
+	return uint8(bits.Len32(offBase) - 1)
+}
+
+// Block types. RFC 3.1.1.2.2.
+const (
+	blockRaw        = 0
+	blockRLE        = 1
+	blockCompressed = 2
+)
+
+// appendBlockHeader appends a block header. RFC 3.1.1.2.
+func appendBlockHeader(dst []byte, last bool, typ, size int) []byte {
+	h := uint32(typ)<<1 | uint32(size)<<3
+	if last {
+		h |= 1
+	}
+	return append(dst, byte(h), byte(h>>8), byte(h>>16))
+}
+
+// appendRawBlock appends src as a raw block.
+func appendRawBlock(dst []byte, last bool, src []byte) []byte {
+	dst = appendBlockHeader(dst, last, blockRaw, len(src))
+	return append(dst, src...)
+}
+
+// isRLE reports whether src consists of a single repeated byte.
+func isRLE(src []byte) bool {
+	for _, b := range src[1:] {
+		if b != src[0] {
+			return false
+		}
+	}
+	return true
+}
+
+// appendLiterals appends the literals section for lits. RFC 3.1.1.3.1.
+func (e *blockEncoder) appendLiterals(dst, lits []byte) []byte {
+	n := len(lits)
+	if n > 1 && isRLE(lits) {
+		return append(appendLiteralsHeader(dst, blockRLE, n), lits[0])
+	}
+	if n < 64 {
+		return append(appendLiteralsHeader(dst, blockRaw, n), lits...)
+	}
+
+	var counts [256]uint32
+	for _, b := range lits {
+		counts[b]++
+	}
+	maxSym := 255
+	for counts[maxSym] == 0 {
+		maxSym--
+	}
+	h := &e.huff
+	h.build(&counts, maxSym)
+	if h.estimate(&counts) >= n-n/16 {
+		return append(appendLiteralsHeader(dst, blockRaw, n), lits...)
+	}
+
+	// Leave room for the largest header, and move the
+	// data down if the header turns out to be smaller.
+	start := len(dst)
+	dst = append(dst, 0, 0, 0, 0, 0)
+	dst, ok := h.appendTable(dst)
+	if !ok {
+		return append(appendLiteralsHeader(dst[:start], blockRaw, n), lits...)
+	}
+	streams := 1
+	if n <= 1023 {
+		dst = h.appendStream(dst, lits)
+	} else {
+		streams = 4
+		jump := len(dst)
+		dst = append(dst, 0, 0, 0, 0, 0, 0)
+		seg := (n + 3) / 4
+		for i := 0; i < 4; i++ {
+			s := lits[min(i*seg, n):min((i+1)*seg, n)]
+			prev := len(dst)
+			dst = h.appendStream(dst, s)
+			if i < 3 {
+				binary.LittleEndian.PutUint16(dst[jump+2*i:], uint16(len(dst)-prev))
+			}
+		}
+	}
+	compressed := len(dst) - start - 5
+	if compressed >= n-n/32 {
+		return append(appendLiteralsHeader(dst[:start], blockRaw, n), lits...)
+	}
+
+	// Compressed_Literals_Block header. RFC 3.1.1.3.1.1.
+	var hdr uint64
+	var hdrLen int
+	switch {
+	case streams == 1:
+		hdr = blockCompressed | uint64(n)<<4 | uint64(compressed)<<14
+		hdrLen = 3
+	case n <= 16383 && compressed <= 16383:
+		hdr = blockCompressed | 2<<2 | uint64(n)<<4 | uint64(compressed)<<18
+		hdrLen = 4
+	default:
+		hdr = blockCompressed | 3<<2 | uint64(n)<<4 | uint64(compressed)<<22
+		hdrLen = 5
+	}
+	for i := 0; i < hdrLen; i++ {
+		dst[start+i] = byte(hdr >> (8 * i))
+	}
+	if hdrLen < 5 {
+		copy(dst[start+hdrLen:], dst[start+5:])
+		dst = dst[:len(dst)-(5-hdrLen)]
+	}
+	return dst
+}
+
+// appendLiteralsHeader appends the header of a raw or RLE literals section.
+func appendLiteralsHeader(dst []byte, typ, n int) []byte {
+	switch {
+	case n < 32:
+		return append(dst, byte(typ|n<<3))
+	case n < 4096:
+		h := typ | 1<<2 | n<<4
+		return append(dst, byte(h), byte(h>>8))
+	default:
+		h := typ | 3<<2 | n<<4
+		return append(dst, byte(h), byte(h>>8), byte(h>>16))
+	}
+}
+
+// Symbol compression modes. RFC 3.1.1.3.2.1.
+const (
+	modePredefined = 0
+	modeRLE        = 1
+	modeFSE        = 2
+)
+
+// appendSequences appends the sequences section. RFC 3.1.1.3.2.
+func (e *blockEncoder) appendSequences(dst []byte) []byte {
+	seqs := e.seqs
+	n := len(seqs)
+	switch {
+	case n < 128:
+		dst = append(dst, byte(n))
+	case n < 0x7f00:
+		dst = append(dst, byte(n>>8+0x80), byte(n))
+	default:
+		dst = append(dst, 0xff, byte(n-0x7f00), byte((n-0x7f00)>>8))
+	}
+	if n == 0 {
+		return dst
+	}
+
+	codes := &e.codes
+	for k := range codes {
+		codes[k] = codes[k][:0]
+	}
+	for _, s := range seqs {
+		codes[kindLL] = append(codes[kindLL], llCode(s.litLen))
+		codes[kindOF] = append(codes[kindOF], ofCode(s.offBase))
+		codes[kindML] = append(codes[kindML], mlCode(s.matchLen))
+	}
+
+	modePos := len(dst)
+	dst = append(dst, 0)
+	var modes byte
+	var tables [3]*fseEncoder
+	for k := range codes {
+		var mode byte
+		mode, tables[k], dst = e.chooseTable(dst, k, codes[k])
+		modes |= mode << (6 - 2*k)
+	}
+	dst[modePos] = modes
+
+	// The sequences are written last to first, so that
+	// a decoder reads them in order. RFC 3.1.1.3.2.2.
+	bw := &e.bw
+	bw.reset(dst)
+	var llState, ofState, mlState fseState
+	for i := n - 1; i >= 0; i-- {
+		s := &seqs[i]
+		ll, of, ml := codes[kindLL][i], codes[kindOF][i], codes[kindML][i]
+		if i == n-1 {
+			mlState.init(tables[kindML], ml)
+			ofState.init(tables[kindOF], of)
+			llState.init(tables[kindLL], ll)
+		} else {
+			ofState.encode(bw, of)
+			mlState.encode(bw, ml)
+			llState.encode(bw, ll)
+		}
+		bw.addBits(s.litLen-llBase[ll], llBits[ll])
+		bw.addBits(s.matchLen-mlBase[ml], mlBits[ml])
+		bw.addBits(s.offBase, of)
+	}
+	mlState.flush(bw)
+	ofState.flush(bw)
+	llState.flush(bw)
+	return bw.close()
+}
+
+// chooseTable picks the cheapest way to encode codes, which are codes
+// of kind k, appends the table description, if any, and returns the
+// compression mode and table to use.
+func (e *blockEncoder) chooseTable(dst []byte, k int, codes []uint8) (byte, *fseEncoder, []byte) {
+	var counts [53]uint32
+	maxSym := 0
+	for _, c := range codes {
+		counts[c]++
+		maxSym = max(maxSym, int(c))
+	}
+	if counts[maxSym] == uint32(len(codes)) {
+		e.tables[k].buildRLE(uint8(maxSym))
+		return modeRLE, &e.tables[k], append(dst, uint8(maxSym))
+	}
+
+	kind := &seqKinds[k]
+	predefCost, predefOK := fseCost(counts[:maxSym+1], kind.predefNorm, kind.predefLog)
+	if !predefOK {
+		predefCost = math.Inf(1)
+	}
+
+	tableLog := optimalTableLog(kind.maxLog, len(codes), maxSym)
+	norm := e.norm[:maxSym+1]
+	normalizeCounts(norm, counts[:maxSym+1], uint32(len(codes)), tableLog, 1<<tableLog)
+	cost, _ := fseCost(counts[:maxSym+1], norm, tableLog)
+	start := len(dst)
+	dst = appendNormCounts(dst, norm, tableLog)
+	cost += float64(8 * (len(dst) - start))
+
+	if predefCost <= cost {
+		return modePredefined, &kind.predefTable, dst[:start]
+	}
+	e.tables[k].build(norm, tableLog)
+	return modeFSE, &e.tables[k], dst
+}
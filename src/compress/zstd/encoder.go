@@ -0,0 +1,272 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package zstd
+
+import (
+	"math/bits"
+)
+
+// levelParams are the parameters of a compression level.
+type levelParams struct {
+	windowLog uint8 // log of the window size
+	hashLog   uint8 // log of the hash table size
+	chainLog  uint8 // log of the hash chain size, 0 for none
+	depth     int   // number of candidates to examine
+	lazy      int   // number of following positions to try for a better match
+	nice      int   // stop searching after a match of this length
+	skip      bool  // search less often in incompressible data
+}
+
+var levels = [BestCompression + 1]levelParams{
+	NoCompression: {windowLog: 17},
+	1:             {windowLog: 19, hashLog: 15, depth: 1, nice: 32, skip: true},
+	2:             {windowLog: 20, hashLog: 16, chainLog: 16, depth: 4, nice: 32, skip: true},
+	3:             {windowLog: 21, hashLog: 17, chainLog: 17, depth: 8, lazy: 1, nice: 64},
+	4:             {windowLog: 21, hashLog: 17, chainLog: 18, depth: 16, lazy: 1, nice: 64},
+	5:             {windowLog: 22, hashLog: 18, chainLog: 19, depth: 32, lazy: 1, nice: 128},
+	6:             {windowLog: 22, hashLog: 18, chainLog: 20, depth: 64, lazy: 2, nice: 128},
+	7:             {windowLog: 23, hashLog: 19, chainLog: 21, depth: 128, lazy: 2, nice: 256},
+	8:             {windowLog: 23, hashLog: 20, chainLog: 22, depth: 256, lazy: 2, nice: 256},
+	9:             {windowLog: 23, hashLog: 20, chainLog: 22, depth: 1024, lazy: 2, nice: 1024},
+}
+
+// maxBlockSize is the largest amount of data in a block. RFC 3.1.1.2.4.
+const maxBlockSize = 128 << 10
+
+// A blockEncoder turns history and new data into compressed blocks.
+// The history buffer is passed to each call. Positions in it are
+// stable from call to call, except as adjusted by matcher.shift.
+type blockEncoder struct {
+	p         *levelParams
+	blockSize int
+	m         matcher
+
+	// The repeated offsets, as a decoder will see them at the start
+	// of the next block. Zero means unknown: the encoder may be
+	// encoding blocks in parallel and not know what earlier blocks
+	// left in the decoder's state.
+	reps [3]uint32
+
+	seqs  []seq
+	lits  []byte
+	codes [3][]uint8
+
+	huff   huffEncoder
+	tables [3]fseEncoder
+	norm   [53]int16
+	bw     bitWriter
+
+	// Output of concurrent compression.
+	out []byte
+}
+
+// reset prepares e to compress a new frame, or a part of a frame
+// encoded independently of what precedes it. sizeHint is the
+// amount of data that will be seen, or 0 if unknown.
+func (e *blockEncoder) reset(p *levelParams, blockSize, sizeHint int, reps [3]uint32) {
+	e.p = p
+	e.blockSize = blockSize
+	e.reps = reps
+	if p.hashLog == 0 {
+		return
+	}
+	hashLog, chainLog := p.hashLog, p.chainLog
+	if sizeHint > 0 {
+		// Don't use tables much larger than the input.
+		need := uint8(bits.Len(uint(sizeHint)))
+		hashLog = min(hashLog, max(need, 10))
+		if chainLog > 0 {
+			chainLog = min(chainLog, max(need, 10))
+		}
+	}
+	e.m.reset(hashLog, chainLog)
+}
+
+// encode appends blocks holding src[start:end] to dst.
+// The data before start is history that matches may refer to,
+// no further back than low. If last is set, the final block
+// is marked as the last block of the frame.
+func (e *blockEncoder) encode(dst, src []byte, low, start, end int, last bool) []byte {
+	for start < end {
+		n := min(end-start, e.blockSize)
+		dst = e.encodeBlock(dst, src[:start+n], low, start, last && start+n == end)
+		start += n
+	}
+	return dst
+}
+
+// encodeBlock appends a single block holding src[start:].
+func (e *blockEncoder) encodeBlock(dst, src []byte, low, start int, last bool) []byte {
+	data := src[start:]
+	if e.p.hashLog == 0 {
+		return appendRawBlock(dst, last, data)
+	}
+	if len(data) > 1 && isRLE(data) {
+		e.m.skip(len(src))
+		dst = appendBlockHeader(dst, last, blockRLE, len(data))
+		return append(dst, data[0])
+	}
+
+	reps := e.reps
+	e.parse(src, low, start)
+
+	hdr := len(dst)
+	dst = appendBlockHeader(dst, last, blockCompressed, 0)
+	dst = e.appendLiterals(dst, e.lits)
+	dst = e.appendSequences(dst)
+	size := len(dst) - hdr - 3
+	if size >= len(data) {
+		// The decoder will not see the sequences,
+		// so forget their effect on the repeated offsets.
+		e.reps = reps
+		return appendRawBlock(dst[:hdr], last, data)
+	}
+	appendBlockHeader(dst[:hdr], last, blockCompressed, size)
+	return dst
+}
+
+// parse finds the sequences and literals for src[start:],
+// storing them in e.seqs and e.lits.
+func (e *blockEncoder) parse(src []byte, low, start int) {
+	p := e.p
+	e.seqs = e.seqs[:0]
+	e.lits = e.lits[:0]
+	window := 1 << p.windowLog
+
+	anchor := start
+	i := start
+	limit := len(src) - minMatch
+	for i <= limit {
+		lo := max(low, i-window)
+		length, pos, offBase := e.best(src, i, lo, anchor)
+		if length < minMatch {
+			if p.skip {
+				// Skip ahead faster the longer we go without
+				// a match, without indexing the skipped data.
+				i += 1 + (i-anchor)>>6
+				e.m.skip(i)
+			} else {
+				i++
+			}
+			continue
+		}
+
+		// Lazy matching: prefer a match that starts later if it
+		// saves more than the cost of the extra literals.
+		for l := 0; l < p.lazy && i+1 <= limit; l++ {
+			lo := max(low, i+1-window)
+			length2, pos2, offBase2 := e.best(src, i+1, lo, anchor)
+			if length2 < minMatch || matchGain(length2, offBase2) <= matchGain(length, offBase)+4 {
+				break
+			}
+			i++
+			length, pos, offBase = length2, pos2, offBase2
+		}
+
+		// Extend the match backward.
+		for i > anchor && pos > low && src[i-1] == src[pos-1] {
+			i--
+			pos--
+			length++
+		}
+
+		e.addSeq(src, anchor, i, uint32(length), uint32(i-pos))
+		i += length
+		anchor = i
+		if p.skip {
+			// Insert a few positions at the end of the match only.
+			e.m.skip(i - 2)
+		}
+		e.m.insert(src, i)
+	}
+	e.lits = append(e.lits, src[anchor:]...)
+}
+
+// matchGain estimates the number of bits saved by a match.
+func matchGain(length int, offBase uint32) int {
+	if length < minMatch {
+		return 0
+	}
+	return 4*length - bits.Len32(offBase)
+}
+
+// best returns the best match at i, considering the repeated offsets
+// and the matcher candidates at positions no lower than low. The
+// literals since anchor determine how repeated offsets are coded.
+func (e *blockEncoder) best(src []byte, i, low, anchor int) (length, pos int, offBase uint32) {
+	length, pos = e.m.find(src, i, low, e.p.depth, e.p.nice)
+	if length >= minMatch {
+		offBase = e.offBase(uint32(i-pos), i > anchor)
+	}
+	for _, r := range e.reps {
+		if r == 0 || int(r) > i-low {
+			continue
+		}
+		cand := i - int(r)
+		n := matchLen(src[cand:], src[i:])
+		if n < minMatch {
+			continue
+		}
+		ob := e.offBase(r, i > anchor)
+		if matchGain(n, ob) > matchGain(length, offBase) {
+			length, pos, offBase = n, cand, ob
+		}
+	}
+	return length, pos, offBase
+}
+
+// offBase returns the Offset_Value that codes offset,
+// using a repeat code if possible. RFC 3.1.1.5.
+func (e *blockEncoder) offBase(offset uint32, haveLits bool) uint32 {
+	r := &e.reps
+	if haveLits {
+		switch offset {
+		case r[0]:
+			return 1
+		case r[1]:
+			return 2
+		case r[2]:
+			return 3
+		}
+	} else {
+		switch {
+		case offset == r[1]:
+			return 1
+		case offset == r[2]:
+			return 2
+		case r[0] > 1 && offset == r[0]-1:
+			return 3
+		}
+	}
+	return offset + 3
+}
+
+// addSeq records a sequence of the literals src[anchor:i]
+// followed by a match, and updates the repeated offsets the
+// way a decoder does.
+func (e *blockEncoder) addSeq(src []byte, anchor, i int, length, offset uint32) {
+	litLen := uint32(i - anchor)
+	offBase := e.offBase(offset, litLen > 0)
+	e.lits = append(e.lits, src[anchor:i]...)
+	e.seqs = append(e.seqs, seq{litLen: litLen, matchLen: length, offBase: offBase})
+
+	r := &e.reps
+	if offBase > 3 {
+		r[0], r[1], r[2] = offset, r[0], r[1]
+		return
+	}
+	code := offBase
+	if litLen == 0 {
+		code++
+	}
+	switch code {
+	case 2:
+		r[0], r[1] = r[1], r[0]
+	case 3:
+		r[0], r[1], r[2] = r[2], r[0], r[1]
+	case 4:
+		r[0], r[1], r[2] = r[0]-1, r[0], r[1]
+	}
+}
@@ -0,0 +1,66 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package zstd_test
+
+import (
+	"bytes"
+	"compress/zstd"
+	"fmt"
+	"io"
+	"log"
+	"os"
+)
+
+func Example_writerReader() {
+	var buf bytes.Buffer
+	zw := zstd.NewWriter(&buf)
+
+	_, err := zw.Write([]byte("A long time ago in a galaxy far, far away..."))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err := zw.Close(); err != nil {
+		log.Fatal(err)
+	}
+
+	zr := zstd.NewReader(&buf)
+	if _, err := io.Copy(os.Stdout, zr); err != nil {
+		log.Fatal(err)
+	}
+
+	// Output:
+	// A long time ago in a galaxy far, far away...
+}
+
+func ExampleNewWriterDict() {
+	// A raw content dictionary holding data
+	// that is likely to appear in the input.
+	dict := []byte(`{"name": "", "email": "@example.com", "active": true}`)
+
+	var buf bytes.Buffer
+	zw, err := zstd.NewWriterDict(&buf, zstd.BestCompression, dict)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if _, err := io.WriteString(zw, `{"name": "gopher", "email": "gopher@example.com", "active": true}`); err != nil {
+		log.Fatal(err)
+	}
+	if err := zw.Close(); err != nil {
+		log.Fatal(err)
+	}
+
+	zr, err := zstd.NewReaderDict(&buf, dict)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if _, err := io.Copy(os.Stdout, zr); err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println()
+
+	// Output:
+	// {"name": "gopher", "email": "gopher@example.com", "active": true}
+}
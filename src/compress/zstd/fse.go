@@ -0,0 +1,349 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package zstd
+
+import (
+	"math"
+	"math/bits"
+)
+
+// bitWriter writes a bit stream from the least significant bit up.
+// zstd decoders read such streams backward, starting from the
+// marker bit written by close. RFC 4.1.
+type bitWriter struct {
+	out  []byte
+	bits uint64 // pending bits
+	n    uint   // number of pending bits, always less than 32 between calls
+}
+
+func (bw *bitWriter) reset(out []byte) {
+	bw.out = out
+	bw.bits = 0
+	bw.n = 0
+}
+
+// addBits adds the low nb bits of v to the stream. nb must be at most 32.
+func (bw *bitWriter) addBits(v uint32, nb uint8) {
+	bw.bits |= uint64(v&(1<<nb-1)) << bw.n
+	bw.n += uint(nb)
+	if bw.n >= 32 {
+		bw.out = append(bw.out, byte(bw.bits), byte(bw.bits>>8), byte(bw.bits>>16), byte(bw.bits>>24))
+		bw.bits >>= 32
+		bw.n -= 32
+	}
+}
+
+// close adds the end marker bit, flushes all pending bits
+// and returns the output.
+func (bw *bitWriter) close() []byte {
+	bw.addBits(1, 1)
+	for bw.n > 0 {
+		bw.out = append(bw.out, byte(bw.bits))
+		bw.bits >>= 8
+		if bw.n < 8 {
+			break
+		}
+		bw.n -= 8
+	}
+	bw.n = 0
+	return bw.out
+}
+
+// fseSymbolTransform describes how to encode a symbol from any state.
+type fseSymbolTransform struct {
+	deltaNbBits    uint32
+	deltaFindState int32
+}
+
+// fseEncoder is an FSE compression table. RFC 4.1.
+type fseEncoder struct {
+	tableLog   uint8
+	stateTable []uint16
+	symbolTT   []fseSymbolTransform
+}
+
+// build builds the compression table for the normalized counts norm,
+// which must sum to 1<<tableLog, with -1 meaning a "less than 1"
+// probability. The symbols are spread exactly as a decoder does.
+func (e *fseEncoder) build(norm []int16, tableLog uint8) {
+	tableSize := 1 << tableLog
+	mask := tableSize - 1
+	highThreshold := tableSize - 1
+
+	var tableSymbol [1 << maxFSELog]uint8
+	var cumul [256 + 1]int
+	for s, n := range norm {
+		if n == -1 {
+			cumul[s+1] = cumul[s] + 1
+			tableSymbol[highThreshold] = uint8(s)
+			highThreshold--
+		} else {
+			cumul[s+1] = cumul[s] + int(n)
+		}
+	}
+
+	pos := 0
+	step := (tableSize >> 1) + (tableSize >> 3) + 3
+	for s, n := range norm {
+		for i := 0; i < int(n); i++ {
+			tableSymbol[pos] = uint8(s)
+			pos = (pos + step) & mask
+			for pos > highThreshold {
+				pos = (pos + step) & mask
+			}
+		}
+	}
+
+	e.tableLog = tableLog
+	if cap(e.stateTable) < tableSize {
+		e.stateTable = make([]uint16, 1<<maxFSELog)
+	}
+	e.stateTable = e.stateTable[:tableSize]
+	for u := 0; u < tableSize; u++ {
+		s := tableSymbol[u]
+		e.stateTable[cumul[s]] = uint16(tableSize + u)
+		cumul[s]++
+	}
+
+	if cap(e.symbolTT) < 256 {
+		e.symbolTT = make([]fseSymbolTransform, 256)
+	}
+	e.symbolTT = e.symbolTT[:len(norm)]
+	total := int32(0)
+	for s, n := range norm {
+		tt := &e.symbolTT[s]
+		switch n {
+		case 0:
+			tt.deltaNbBits = (uint32(tableLog)+1)<<16 - uint32(tableSize)
+			tt.deltaFindState = 0
+		case -1, 1:
+			tt.deltaNbBits = uint32(tableLog)<<16 - uint32(tableSize)
+			tt.deltaFindState = total - 1
+			total++
+		default:
+			maxBitsOut := uint32(tableLog) - uint32(bits.Len16(uint16(n-1))-1)
+			minStatePlus := uint32(n) << maxBitsOut
+			tt.deltaNbBits = maxBitsOut<<16 - minStatePlus
+			tt.deltaFindState = total - int32(n)
+			total += int32(n)
+		}
+	}
+}
+
+// buildRLE builds a table for a stream that only contains sym.
+// Encoding with it writes no bits.
+func (e *fseEncoder) buildRLE(sym uint8) {
+	e.tableLog = 0
+	e.stateTable = append(e.stateTable[:0], 0)
+	if cap(e.symbolTT) < 256 {
+		e.symbolTT = make([]fseSymbolTransform, 256)
+	}
+	e.symbolTT = e.symbolTT[:int(sym)+1]
+	e.symbolTT[sym] = fseSymbolTransform{}
+}
+
+// fseState is the state of an FSE encoder while writing a stream.
+type fseState struct {
+	e     *fseEncoder
+	value uint32
+}
+
+// init sets the initial state to one that decodes sym.
+func (st *fseState) init(e *fseEncoder, sym uint8) {
+	st.e = e
+	tt := e.symbolTT[sym]
+	nbBitsOut := (tt.deltaNbBits + 1<<15) >> 16
+	v := nbBitsOut<<16 - tt.deltaNbBits
+	st.value = uint32(e.stateTable[int32(v>>nbBitsOut)+tt.deltaFindState])
+}
+
+// encode writes the bits that lead from sym to the current state,
+// and moves to a state that decodes sym.
+func (st *fseState) encode(bw *bitWriter, sym uint8) {
+	tt := st.e.symbolTT[sym]
+	nbBitsOut := (st.value + tt.deltaNbBits) >> 16
+	bw.addBits(st.value, uint8(nbBitsOut))
+	st.value = uint32(st.e.stateTable[int32(st.value>>nbBitsOut)+tt.deltaFindState])
+}
+
+// flush writes the final state.
+func (st *fseState) flush(bw *bitWriter) {
+	bw.addBits(st.value, st.e.tableLog)
+}
+
+// maxFSELog is the largest table log used by any FSE table.
+const maxFSELog = 9
+
+// optimalTableLog picks a table log for total symbols whose
+// largest value is maxSym, following the reference implementation.
+func optimalTableLog(maxLog uint8, total, maxSym int) uint8 {
+	maxBitsSrc := bits.Len(uint(total-1)) - 3
+	minBits := min(bits.Len(uint(total)), bits.Len(uint(maxSym))+1)
+	tableLog := int(maxLog)
+	if maxBitsSrc < tableLog {
+		tableLog = maxBitsSrc
+	}
+	if minBits > tableLog {
+		tableLog = minBits
+	}
+	return uint8(max(5, min(tableLog, int(maxLog))))
+}
+
+// normalizeCounts sets norm to probabilities proportional to counts,
+// which sum to total, scaled to sum to 1<<tableLog.
+// Every present symbol gets a probability of at least 1
+// and no probability exceeds maxNorm.
+func normalizeCounts(norm []int16, counts []uint32, total uint32, tableLog uint8, maxNorm int) {
+	size := 1 << tableLog
+	sum := 0
+	for s, c := range counts {
+		if c == 0 {
+			norm[s] = 0
+			continue
+		}
+		n := int(uint64(c) << tableLog / uint64(total))
+		n = min(max(n, 1), maxNorm)
+		norm[s] = int16(n)
+		sum += n
+	}
+
+	// Hand out the remaining probability to the symbols that
+	// were rounded down the most, or take back the excess from
+	// the symbols that were rounded up the most.
+	for sum != size {
+		best := -1
+		var bestErr int64
+		for s, c := range counts {
+			if c == 0 {
+				continue
+			}
+			n := int64(norm[s])
+			var err int64
+			if sum < size {
+				if n >= int64(maxNorm) {
+					continue
+				}
+				err = int64(c)<<tableLog - n*int64(total)
+			} else {
+				if n <= 1 {
+					continue
+				}
+				err = n*int64(total) - int64(c)<<tableLog
+			}
+			if best < 0 || err > bestErr {
+				best, bestErr = s, err
+			}
+		}
+		if sum < size {
+			norm[best]++
+			sum++
+		} else {
+			norm[best]--
+			sum--
+		}
+	}
+}
+
+// fseCost estimates the number of bits needed to encode
+// symbols with the given counts using the normalized counts norm.
+// It reports false if a symbol cannot be encoded.
+func fseCost(counts []uint32, norm []int16, tableLog uint8) (float64, bool) {
+	cost := 0.0
+	for s, c := range counts {
+		if c == 0 {
+			continue
+		}
+		if s >= len(norm) || norm[s] == 0 {
+			return 0, false
+		}
+		n := float64(norm[s])
+		if n < 0 {
+			n = 1
+		}
+		cost += float64(c) * (float64(tableLog) - math.Log2(n))
+	}
+	return cost, true
+}
+
+// appendNormCounts appends the FSE table description of norm,
+// in the format read by decoders. RFC 4.1.1.
+func appendNormCounts(dst []byte, norm []int16, tableLog uint8) []byte {
+	tableSize := 1 << tableLog
+	remaining := tableSize + 1
+	threshold := tableSize
+	nbBits := uint(tableLog) + 1
+	bitStream := uint32(tableLog - 5)
+	bitCount := uint(4)
+	previous0 := false
+
+	sym := 0
+	for sym < len(norm) && remaining > 1 {
+		if previous0 {
+			start := sym
+			for sym < len(norm) && norm[sym] == 0 {
+				sym++
+			}
+			if sym == len(norm) {
+				break
+			}
+			for sym >= start+24 {
+				start += 24
+				bitStream += 0xffff << bitCount
+				dst = append(dst, byte(bitStream), byte(bitStream>>8))
+				bitStream >>= 16
+			}
+			for sym >= start+3 {
+				start += 3
+				bitStream += 3 << bitCount
+				bitCount += 2
+			}
+			bitStream += uint32(sym-start) << bitCount
+			bitCount += 2
+			if bitCount > 16 {
+				dst = append(dst, byte(bitStream), byte(bitStream>>8))
+				bitStream >>= 16
+				bitCount -= 16
+			}
+		}
+
+		count := int(norm[sym])
+		sym++
+		max := (2*threshold - 1) - remaining
+		if count < 0 {
+			remaining += count
+		} else {
+			remaining -= count
+		}
+		count++ // +1 for extra accuracy
+		if count >= threshold {
+			count += max
+		}
+		bitStream += uint32(count) << bitCount
+		bitCount += nbBits
+		if count < max {
+			bitCount--
+		}
+		previous0 = count == 1
+		for remaining < threshold {
+			nbBits--
+			threshold >>= 1
+		}
+		if bitCount > 16 {
+			dst = append(dst, byte(bitStream), byte(bitStream>>8))
+			bitStream >>= 16
+			bitCount -= 16
+		}
+	}
+
+	for bitCount > 0 {
+		dst = append(dst, byte(bitStream))
+		bitStream >>= 8
+		if bitCount < 8 {
+			break
+		}
+		bitCount -= 8
+	}
+	return dst
+}
@@ -0,0 +1,236 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package zstd
+
+import (
+	"cmp"
+	"slices"
+)
+
+// maxHuffmanBits is the longest Huffman code a decoder accepts.
+const maxHuffmanBits = 11
+
+// huffEncoder is a Huffman code for literals. RFC 4.2.
+type huffEncoder struct {
+	maxSym   int
+	tableLog uint8
+	codes    [256]uint16
+	lens     [256]uint8
+	weights  [256]uint8
+
+	// Scratch space.
+	nodes    []huffNode
+	fse      fseEncoder
+	norm     [16]int16
+	bw       bitWriter
+	fseBytes []byte
+}
+
+type huffNode struct {
+	count  uint64
+	parent int32
+	sym    int16
+	depth  uint8
+}
+
+// build builds a Huffman code for the symbols with the given counts.
+// There must be at least two distinct symbols, and maxSym must be
+// the largest symbol with a non-zero count.
+func (h *huffEncoder) build(counts *[256]uint32, maxSym int) {
+	h.maxSym = maxSym
+	h.nodes = h.nodes[:0]
+	for s, c := range counts[:maxSym+1] {
+		h.lens[s] = 0
+		if c > 0 {
+			h.nodes = append(h.nodes, huffNode{count: uint64(c), sym: int16(s)})
+		}
+	}
+	n := len(h.nodes)
+	slices.SortFunc(h.nodes, func(a, b huffNode) int {
+		if c := cmp.Compare(a.count, b.count); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.sym, b.sym)
+	})
+
+	// Build the tree with the two queue method: the leaves are
+	// sorted, and internal nodes are created in increasing order
+	// of count after the leaves.
+	leaf, inner := 0, n
+	pick := func() int {
+		if leaf < n && (inner >= len(h.nodes) || h.nodes[leaf].count <= h.nodes[inner].count) {
+			leaf++
+			return leaf - 1
+		}
+		inner++
+		return inner - 1
+	}
+	for len(h.nodes) < 2*n-1 {
+		a, b := pick(), pick()
+		parent := int32(len(h.nodes))
+		h.nodes[a].parent = parent
+		h.nodes[b].parent = parent
+		h.nodes = append(h.nodes, huffNode{count: h.nodes[a].count + h.nodes[b].count, sym: -1})
+	}
+	h.nodes[len(h.nodes)-1].depth = 0
+	for i := len(h.nodes) - 2; i >= 0; i-- {
+		h.nodes[i].depth = min(h.nodes[h.nodes[i].parent].depth+1, 255)
+	}
+
+	// Limit the code lengths, then adjust them so that
+	// the code is complete, as the format requires.
+	// The leaves are still sorted by increasing count.
+	leaves := h.nodes[:n]
+	kraft := 0
+	for i := range leaves {
+		leaves[i].depth = min(leaves[i].depth, maxHuffmanBits)
+		kraft += 1 << (maxHuffmanBits - leaves[i].depth)
+	}
+	for kraft > 1<<maxHuffmanBits {
+		// Lengthen the least frequent code among the longest
+		// codes that can still be lengthened.
+		best := -1
+		for i := range leaves {
+			d := leaves[i].depth
+			if d < maxHuffmanBits && (best < 0 || d > leaves[best].depth) {
+				best = i
+			}
+		}
+		kraft -= 1 << (maxHuffmanBits - leaves[best].depth - 1)
+		leaves[best].depth++
+	}
+	for kraft < 1<<maxHuffmanBits {
+		// Shorten the most frequent code that fits in the gap.
+		gap := 1<<maxHuffmanBits - kraft
+		for i := n - 1; i >= 0; i-- {
+			d := leaves[i].depth
+			if d > 1 && 1<<(maxHuffmanBits-d) <= gap {
+				kraft += 1 << (maxHuffmanBits - d)
+				leaves[i].depth--
+				break
+			}
+		}
+	}
+
+	tableLog := uint8(0)
+	for _, l := range leaves {
+		h.lens[l.sym] = l.depth
+		tableLog = max(tableLog, l.depth)
+	}
+	h.tableLog = tableLog
+
+	// Assign codes in the order used by decoders:
+	// by increasing weight, then by symbol.
+	var rankStart [maxHuffmanBits + 2]uint32
+	for s := 0; s <= maxSym; s++ {
+		w := uint8(0)
+		if h.lens[s] > 0 {
+			w = tableLog + 1 - h.lens[s]
+			rankStart[w+1] += 1 << (w - 1)
+		}
+		h.weights[s] = w
+	}
+	for w := 2; w < len(rankStart); w++ {
+		rankStart[w] += rankStart[w-1]
+	}
+	for s := 0; s <= maxSym; s++ {
+		if w := h.weights[s]; w > 0 {
+			h.codes[s] = uint16(rankStart[w] >> (w - 1))
+			rankStart[w] += 1 << (w - 1)
+		}
+	}
+}
+
+// estimate returns the number of bytes needed to encode
+// symbols with the given counts.
+func (h *huffEncoder) estimate(counts *[256]uint32) int {
+	n := 0
+	for s, c := range counts[:h.maxSym+1] {
+		n += int(c) * int(h.lens[s])
+	}
+	return (n + 7) / 8
+}
+
+// appendTable appends the description of the Huffman code,
+// choosing between directly stored and FSE compressed weights.
+// It reports false if the code cannot be described. RFC 4.2.1.
+func (h *huffEncoder) appendTable(dst []byte) ([]byte, bool) {
+	// The weight of the last symbol is implied.
+	weights := h.weights[:h.maxSym]
+
+	fse, fseOK := h.compressWeights(weights)
+	if fseOK && (len(weights) > 128 || len(fse) < (len(weights)+1)/2) {
+		dst = append(dst, byte(len(fse)))
+		return append(dst, fse...), true
+	}
+	if len(weights) > 128 {
+		return dst, false
+	}
+	dst = append(dst, byte(127+len(weights)))
+	for i := 0; i < len(weights); i += 2 {
+		b := weights[i] << 4
+		if i+1 < len(weights) {
+			b |= weights[i+1]
+		}
+		dst = append(dst, b)
+	}
+	return dst, true
+}
+
+// compressWeights compresses the Huffman weights with FSE,
+// using two interleaved states. RFC 4.2.1.2.
+func (h *huffEncoder) compressWeights(weights []uint8) ([]byte, bool) {
+	var counts [maxHuffmanBits + 2]uint32
+	maxW := 0
+	distinct := 0
+	for _, w := range weights {
+		if counts[w] == 0 {
+			distinct++
+		}
+		counts[w]++
+		maxW = max(maxW, int(w))
+	}
+	if distinct < 2 {
+		return nil, false
+	}
+
+	// A decoder finds the end of the stream by running out of bits
+	// when it updates the state of the second to last weight.
+	// Limiting probabilities to half of the table guarantees
+	// that every state update reads at least one bit.
+	tableLog := optimalTableLog(6, len(weights), maxW)
+	norm := h.norm[:maxW+1]
+	normalizeCounts(norm, counts[:maxW+1], uint32(len(weights)), tableLog, 1<<(tableLog-1))
+	h.fse.build(norm, tableLog)
+
+	out := appendNormCounts(h.fseBytes[:0], norm, tableLog)
+	h.bw.reset(out)
+	var states [2]fseState
+	for i := len(weights) - 1; i >= 0; i-- {
+		st := &states[i&1]
+		if i >= len(weights)-2 {
+			st.init(&h.fse, weights[i])
+		} else {
+			st.encode(&h.bw, weights[i])
+		}
+	}
+	states[1].flush(&h.bw)
+	states[0].flush(&h.bw)
+	h.fseBytes = h.bw.close()
+	if len(h.fseBytes) >= 128 {
+		return nil, false
+	}
+	return h.fseBytes, true
+}
+
+// appendStream appends the Huffman coded src as a single stream.
+func (h *huffEncoder) appendStream(dst, src []byte) []byte {
+	h.bw.reset(dst)
+	for i := len(src) - 1; i >= 0; i-- {
+		s := src[i]
+		h.bw.addBits(uint32(h.codes[s]), h.lens[s])
+	}
+	return h.bw.close()
+}
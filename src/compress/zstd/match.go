@@ -0,0 +1,136 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package zstd
+
+import (
+	"encoding/binary"
+	"math/bits"
+)
+
+// minMatch is the shortest match the encoder looks for.
+const minMatch = 4
+
+// A matcher finds earlier occurrences of the data at a position
+// using a hash table of the most recent position of each hash,
+// and optionally a chain of earlier positions with the same hash.
+// Positions are indexes into the history buffer plus one,
+// so that zero means none.
+type matcher struct {
+	hashShift uint
+	table     []int32
+	chain     []int32
+	chainMask int
+
+	// next is the next position to insert.
+	next int
+}
+
+// reset prepares m for use with the given table sizes,
+// forgetting all positions.
+func (m *matcher) reset(hashLog, chainLog uint8) {
+	m.hashShift = 32 - uint(hashLog)
+	if cap(m.table) < 1<<hashLog {
+		m.table = make([]int32, 1<<hashLog)
+	} else {
+		m.table = m.table[:1<<hashLog]
+		clear(m.table)
+	}
+	if chainLog == 0 {
+		m.chain = nil
+	} else if cap(m.chain) < 1<<chainLog {
+		m.chain = make([]int32, 1<<chainLog)
+	} else {
+		m.chain = m.chain[:1<<chainLog]
+		clear(m.chain)
+	}
+	m.chainMask = len(m.chain) - 1
+	m.next = 0
+}
+
+func (m *matcher) hash(v uint32) uint32 {
+	return (v * 2654435761) >> m.hashShift
+}
+
+// insert adds the positions from m.next up to but not including end,
+// as far as src holds enough bytes to hash them.
+func (m *matcher) insert(src []byte, end int) {
+	end = min(end, len(src)-minMatch+1)
+	for p := m.next; p < end; p++ {
+		h := m.hash(binary.LittleEndian.Uint32(src[p:]))
+		if m.chain != nil {
+			m.chain[p&m.chainMask] = m.table[h]
+		}
+		m.table[h] = int32(p + 1)
+	}
+	m.next = max(m.next, end)
+}
+
+// skip moves m.next to p without inserting the skipped positions.
+func (m *matcher) skip(p int) {
+	m.next = max(m.next, p)
+}
+
+// find returns the longest match for the data at p among up to depth
+// earlier positions no lower than low. The match may not extend
+// past the end of src. It inserts the positions up to and including p.
+func (m *matcher) find(src []byte, p, low, depth, nice int) (length, pos int) {
+	m.insert(src, p)
+	h := m.hash(binary.LittleEndian.Uint32(src[p:]))
+	cand := int(m.table[h]) - 1
+	if m.chain != nil {
+		m.chain[p&m.chainMask] = m.table[h]
+	}
+	m.table[h] = int32(p + 1)
+	m.next = p + 1
+
+	for ; depth > 0 && cand >= low; depth-- {
+		if n := matchLen(src[cand:], src[p:]); n > length {
+			length, pos = n, cand
+			if n >= nice {
+				break
+			}
+		}
+		if m.chain == nil {
+			break
+		}
+		next := int(m.chain[cand&m.chainMask]) - 1
+		if next >= cand {
+			// The chain entry has been overwritten by
+			// a later position.
+			break
+		}
+		cand = next
+	}
+	return length, pos
+}
+
+// shift adjusts all positions after the first d bytes of the
+// history buffer have been discarded. d must be a multiple
+// of the chain size.
+func (m *matcher) shift(d int) {
+	for _, t := range [][]int32{m.table, m.chain} {
+		for i, v := range t {
+			t[i] = max(v-int32(d), 0)
+		}
+	}
+	m.next = max(m.next-d, 0)
+}
+
+// matchLen returns the length of the common prefix of a and b.
+// a must be at least as long as b.
+func matchLen(a, b []byte) int {
+	n := 0
+	for len(b)-n >= 8 {
+		x := binary.LittleEndian.Uint64(a[n:]) ^ binary.LittleEndian.Uint64(b[n:])
+		if x != 0 {
+			return n + bits.TrailingZeros64(x)/8
+		}
+		n += 8
+	}
+	for n < len(b) && a[n] == b[n] {
+		n++
+	}
+	return n
+}
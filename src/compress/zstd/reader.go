@@ -0,0 +1,67 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package zstd
+
+import (
+	izstd "internal/zstd"
+	"io"
+)
+
+// A Reader is an [io.Reader] that decompresses a zstd stream.
+// The stream may consist of several frames, which are decompressed
+// as one stream of data. Skippable frames are ignored.
+//
+// Data read from a Reader is verified against the frame checksums,
+// if present; an error is returned before the end of a frame if
+// the data is corrupt.
+type Reader struct {
+	d *izstd.Reader
+}
+
+// NewReader returns a new [Reader] decompressing r.
+// The Reader may read more data than necessary from r.
+func NewReader(r io.Reader) *Reader {
+	return &Reader{d: izstd.NewReader(r)}
+}
+
+// NewReaderDict is like [NewReader] but makes the dictionaries available
+// to decompress frames. A frame that records a dictionary ID uses the
+// formatted dictionary with that ID. A frame without a dictionary ID
+// uses the first dictionary, which is how frames compressed with a
+// raw content dictionary are read.
+//
+// The Reader retains the dictionaries, which must not be modified.
+func NewReaderDict(r io.Reader, dicts ...[]byte) (*Reader, error) {
+	ds := make([]*izstd.Dict, 0, len(dicts))
+	for _, b := range dicts {
+		d, err := izstd.ParseDict(b)
+		if err != nil {
+			return nil, err
+		}
+		ds = append(ds, d)
+	}
+	z := NewReader(r)
+	z.d.SetDicts(ds...)
+	return z, nil
+}
+
+// Reset discards the [Reader] z's state and makes it equivalent to the
+// result of its original state from [NewReader] or [NewReaderDict],
+// but reading from r instead. This permits reusing a Reader rather
+// than allocating a new one.
+func (z *Reader) Reset(r io.Reader) {
+	z.d.Reset(r)
+}
+
+// Read implements [io.Reader], reading decompressed bytes from
+// its underlying reader.
+func (z *Reader) Read(p []byte) (int, error) {
+	return z.d.Read(p)
+}
+
+// ReadByte implements [io.ByteReader].
+func (z *Reader) ReadByte() (byte, error) {
+	return z.d.ReadByte()
+}
@@ -0,0 +1,350 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package zstd
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	izstd "internal/zstd"
+	"io"
+	"sync"
+)
+
+// segmentSize is the amount of data each goroutine compresses
+// when compressing concurrently.
+const segmentSize = 8 * maxBlockSize
+
+var errWriterClosed = errors.New("zstd: closed writer")
+
+// A Writer is an [io.WriteCloser] that compresses data written to it
+// into a single zstd frame, written to an underlying writer.
+// The frame ends with a checksum of the uncompressed data.
+//
+// If all the data is written before the first call to Flush or Close
+// and fits in the Writer's buffer, the frame header records the
+// size of the uncompressed data.
+type Writer struct {
+	w           io.Writer
+	level       int
+	p           *levelParams
+	dict        *izstd.Dict
+	concurrency int
+
+	blockSize int // largest block
+	window    int // largest offset of a match
+	threshold int // amount of data compressed at once
+
+	// buf holds history, followed by the data from buf[start:]
+	// that has not been compressed yet.
+	buf   []byte
+	start int
+
+	wroteHeader bool
+	encReady    bool
+	enc         blockEncoder
+	workers     []*blockEncoder
+	out         []byte
+	checksum    izstd.XXHash64
+	err         error
+}
+
+// NewWriter returns a new [Writer] compressing data at the default level.
+// Writes to the returned writer are compressed and written to w.
+//
+// It is the caller's responsibility to call Close on the [Writer] when done.
+// Writes may be buffered and not flushed until Close.
+func NewWriter(w io.Writer) *Writer {
+	z, _ := NewWriterLevel(w, DefaultCompression)
+	return z
+}
+
+// NewWriterLevel is like [NewWriter] but specifies the compression level
+// instead of assuming [DefaultCompression].
+//
+// The compression level can be [DefaultCompression], [NoCompression],
+// or any integer value between [BestSpeed] and [BestCompression] inclusive.
+// The error returned will be nil if the level is valid.
+func NewWriterLevel(w io.Writer, level int) (*Writer, error) {
+	return NewWriterDict(w, level, nil)
+}
+
+// NewWriterDict is like [NewWriterLevel] but compresses using a
+// dictionary, which is either raw content or a formatted dictionary.
+// The compressed data can only be decompressed by a [Reader] that
+// has the same dictionary. If dict is a formatted dictionary, the
+// frame records its ID.
+//
+// The Writer retains dict, which must not be modified.
+func NewWriterDict(w io.Writer, level int, dict []byte) (*Writer, error) {
+	if level < DefaultCompression || level > BestCompression {
+		return nil, fmt.Errorf("zstd: invalid compression level: %d", level)
+	}
+	if level == DefaultCompression {
+		level = 3
+	}
+	z := &Writer{
+		level:       level,
+		p:           &levels[level],
+		concurrency: 1,
+	}
+	if len(dict) > 0 {
+		d, err := izstd.ParseDict(dict)
+		if err != nil {
+			return nil, err
+		}
+		z.dict = d
+	}
+	z.window = 1 << z.p.windowLog
+	z.blockSize = min(maxBlockSize, z.window)
+	z.setThreshold()
+	z.Reset(w)
+	return z, nil
+}
+
+// SetConcurrency sets the number of goroutines used to compress data.
+// With n greater than 1, the Writer buffers up to n MiB of input and
+// compresses parts of it concurrently. The parts are compressed
+// independently of each other, except that matches may still refer
+// to earlier data. This makes compressing large inputs faster at the
+// cost of memory and a slightly worse compression ratio. The output
+// depends on n, but any output can be read by any [Reader].
+// Values of n less than 1 are treated as 1, the default.
+//
+// SetConcurrency must be called before the first call to Write.
+func (z *Writer) SetConcurrency(n int) {
+	z.concurrency = max(n, 1)
+	z.setThreshold()
+}
+
+func (z *Writer) setThreshold() {
+	if z.concurrency > 1 && z.p.hashLog > 0 {
+		z.threshold = z.concurrency * segmentSize
+	} else {
+		z.threshold = z.blockSize
+	}
+}
+
+// Reset discards the [Writer] z's state and makes it equivalent to the
+// result of its original state from [NewWriter], [NewWriterLevel] or
+// [NewWriterDict], but writing to w instead. This permits reusing a
+// Writer rather than allocating a new one.
+func (z *Writer) Reset(w io.Writer) {
+	z.w = w
+	z.buf = z.buf[:0]
+	if z.dict != nil {
+		content := z.dict.Content()
+		z.buf = append(z.buf, content[max(0, len(content)-z.window):]...)
+	}
+	z.start = len(z.buf)
+	z.wroteHeader = false
+	z.encReady = false
+	z.checksum.Reset()
+	z.err = nil
+}
+
+// Write writes a compressed form of p to the underlying [io.Writer].
+// The compressed bytes are not necessarily flushed until the Writer
+// is closed or flushed.
+func (z *Writer) Write(p []byte) (int, error) {
+	if z.err != nil {
+		return 0, z.err
+	}
+	z.checksum.Write(p)
+	n := 0
+	for n < len(p) {
+		// Keep at least one byte pending, so that
+		// Close always has a last block to write.
+		k := min(len(p)-n, z.threshold+1-(len(z.buf)-z.start))
+		z.buf = append(z.buf, p[n:n+k]...)
+		n += k
+		if len(z.buf)-z.start > z.threshold {
+			if err := z.compress(z.start+z.threshold, false); err != nil {
+				return n, err
+			}
+		}
+	}
+	return n, nil
+}
+
+// Flush compresses any pending data and writes it to the underlying
+// writer. It is useful mainly in network protocols, to ensure that a
+// remote reader has enough data to reconstruct a packet. Flush does
+// not return until the data has been written. If the underlying
+// writer returns an error, Flush returns that error.
+func (z *Writer) Flush() error {
+	if z.err != nil {
+		return z.err
+	}
+	return z.compress(len(z.buf), false)
+}
+
+// Close closes the [Writer] by compressing any pending data and
+// writing the end of the frame to the underlying writer.
+// It does not close the underlying writer.
+func (z *Writer) Close() error {
+	if z.err != nil {
+		if z.err == errWriterClosed {
+			return nil
+		}
+		return z.err
+	}
+	if err := z.compress(len(z.buf), true); err != nil {
+		return err
+	}
+	z.err = errWriterClosed
+	return nil
+}
+
+// compress compresses z.buf[z.start:end] and writes it out.
+func (z *Writer) compress(end int, last bool) error {
+	out := z.out[:0]
+	sizeHint := 0
+	if !z.wroteHeader {
+		contentSize := int64(-1)
+		if last {
+			// We have seen all the data.
+			contentSize = int64(end - z.start)
+			sizeHint = end
+		}
+		out = z.appendHeader(out, contentSize)
+		z.wroteHeader = true
+	}
+
+	if z.concurrency > 1 && z.p.hashLog > 0 {
+		out = z.compressConcurrent(out, end, last)
+	} else {
+		if !z.encReady {
+			reps := [3]uint32{1, 4, 8}
+			if z.dict != nil {
+				reps = z.dict.RepeatedOffsets()
+			}
+			z.enc.reset(z.p, z.blockSize, sizeHint, reps)
+			z.encReady = true
+		}
+		out = z.enc.encode(out, z.buf, 0, z.start, end, last)
+	}
+	if last {
+		if z.start == end {
+			out = appendRawBlock(out, true, nil)
+		}
+		out = binary.LittleEndian.AppendUint32(out, uint32(z.checksum.Sum64()))
+	}
+	z.out = out
+	z.start = end
+
+	if _, err := z.w.Write(out); err != nil {
+		z.err = err
+		return err
+	}
+
+	// Discard history that is out of reach, in multiples of
+	// the window size so that the hash chain stays aligned.
+	if z.start >= 2*z.window {
+		d := (z.start - z.window) &^ (z.window - 1)
+		n := copy(z.buf, z.buf[d:])
+		z.buf = z.buf[:n]
+		z.start -= d
+		if z.encReady {
+			z.enc.m.shift(d)
+		}
+	}
+	return nil
+}
+
+// compressConcurrent compresses z.buf[z.start:end] in segments,
+// each on its own goroutine, and appends the result to out.
+// Each segment primes its matcher with the data just before it.
+func (z *Writer) compressConcurrent(out []byte, end int, last bool) []byte {
+	pending := end - z.start
+	if pending == 0 {
+		return out
+	}
+	n := min(z.concurrency, (pending+segmentSize-1)/segmentSize)
+	per := (pending + n - 1) / n
+	per = (per + z.blockSize - 1) / z.blockSize * z.blockSize
+	lookback := min(z.window, segmentSize)
+
+	for len(z.workers) < n {
+		z.workers = append(z.workers, new(blockEncoder))
+	}
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		s := z.start + i*per
+		e := min(s+per, end)
+		if s >= e {
+			break
+		}
+		wg.Add(1)
+		go func(enc *blockEncoder, s, e int) {
+			defer wg.Done()
+			low := max(0, s-lookback)
+			enc.reset(z.p, z.blockSize, e-low, [3]uint32{})
+			enc.m.skip(low)
+			enc.m.insert(z.buf[:e], s)
+			enc.out = enc.encode(enc.out[:0], z.buf[:e], low, s, e, last && e == end)
+		}(z.workers[i], s, e)
+	}
+	wg.Wait()
+	for i := 0; i < n; i++ {
+		out = append(out, z.workers[i].out...)
+		z.workers[i].out = z.workers[i].out[:0]
+	}
+	return out
+}
+
+// appendHeader appends the frame header. contentSize is the size of
+// the uncompressed data, or -1 if not known. RFC 3.1.1.1.
+func (z *Writer) appendHeader(dst []byte, contentSize int64) []byte {
+	dst = binary.LittleEndian.AppendUint32(dst, 0xfd2fb528)
+
+	fhd := byte(1 << 2) // Content_Checksum_Flag
+	var id uint32
+	if z.dict != nil {
+		id = z.dict.ID()
+	}
+	idLen := 0
+	switch {
+	case id == 0:
+	case id < 1<<8:
+		fhd |= 1
+		idLen = 1
+	case id < 1<<16:
+		fhd |= 2
+		idLen = 2
+	default:
+		fhd |= 3
+		idLen = 4
+	}
+	fcsLen := 0
+	if contentSize >= 0 {
+		fhd |= 1 << 5 // Single_Segment_Flag
+		switch {
+		case contentSize < 1<<8:
+			fcsLen = 1
+		case contentSize < 1<<16+256:
+			fhd |= 1 << 6
+			fcsLen = 2
+			contentSize -= 256
+		case contentSize < 1<<32:
+			fhd |= 2 << 6
+			fcsLen = 4
+		default:
+			fhd |= 3 << 6
+			fcsLen = 8
+		}
+	}
+	dst = append(dst, fhd)
+	if contentSize < 0 {
+		dst = append(dst, (z.p.windowLog-10)<<3)
+	}
+	for i := 0; i < idLen; i++ {
+		dst = append(dst, byte(id>>(8*i)))
+	}
+	for i := 0; i < fcsLen; i++ {
+		dst = append(dst, byte(contentSize>>(8*i)))
+	}
+	return dst
+}
@@ -0,0 +1,38 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package zstd implements reading and writing of zstd compressed data,
+// as specified in RFC 8878.
+//
+// A compressed stream is a sequence of frames, each of which holds
+// blocks of compressed data and ends with a checksum. A [Reader]
+// decompresses all the frames of a stream as a single stream of data.
+// A [Writer] produces a single frame.
+//
+// # Dictionaries
+//
+// A dictionary primes the compressor and decompressor with data
+// that is likely to appear in the input, which greatly improves
+// the compression of small inputs. A dictionary is either raw
+// content or a formatted dictionary, as produced by
+// "zstd --train", which starts with a magic number and a dictionary
+// ID followed by entropy tables and the content. Frames compressed
+// with a formatted dictionary record its ID, so that a [Reader]
+// given several dictionaries can pick the right one.
+package zstd
+
+// Compression levels, following the conventions of [compress/flate].
+// Levels range from 1 ([BestSpeed]) to 9 ([BestCompression]);
+// higher levels typically run slower but compress more. Level 0
+// ([NoCompression]) does not attempt any compression; it only adds
+// the necessary framing. Level -1 ([DefaultCompression]) uses the
+// default compression level, which is 3.
+//
+// The levels do not correspond to the levels of the zstd command.
+const (
+	NoCompression      = 0
+	BestSpeed          = 1
+	BestCompression    = 9
+	DefaultCompression = -1
+)
@@ -0,0 +1,434 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package zstd
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"internal/testenv"
+	"io"
+	"math/rand/v2"
+	"os"
+	"os/exec"
+	"strings"
+	"sync"
+	"testing"
+)
+
+var (
+	testDataOnce sync.Once
+	testData     map[string][]byte
+)
+
+// testInputs returns a set of inputs of different kinds.
+func testInputs(t testing.TB) map[string][]byte {
+	testDataOnce.Do(func() {
+		testData = map[string][]byte{
+			"empty":      {},
+			"one":        {'a'},
+			"short":      []byte("hello, world\n"),
+			"zeros":      make([]byte, 300<<10),
+			"repeat":     bytes.Repeat([]byte("0123456789abcdef"), 20000),
+			"alternate":  bytes.Repeat([]byte{0, 0xff}, 70000),
+			"random":     randomBytes(200<<10, 256),
+			"lowentropy": randomBytes(200<<10, 4),
+		}
+		for _, name := range []string{"../testdata/e.txt", "../testdata/gettysburg.txt", "../../testdata/Isaac.Newton-Opticks.txt"} {
+			b, err := os.ReadFile(name)
+			if err != nil {
+				panic(err)
+			}
+			testData[name[strings.LastIndex(name, "/")+1:]] = b
+		}
+
+		// Text interspersed with binary data,
+		// so that blocks differ in their statistics.
+		var mixed bytes.Buffer
+		opticks := testData["Isaac.Newton-Opticks.txt"]
+		r := rand.New(rand.NewPCG(1, 2))
+		for mixed.Len() < 1<<20 {
+			off := r.IntN(len(opticks) - 5000)
+			mixed.Write(opticks[off : off+r.IntN(5000)])
+			mixed.Write(randomBytes(r.IntN(2000), 256))
+		}
+		testData["mixed"] = mixed.Bytes()
+	})
+	return testData
+}
+
+// randomBytes returns n pseudo-random bytes less than max.
+func randomBytes(n, max int) []byte {
+	r := rand.New(rand.NewPCG(uint64(n), uint64(max)))
+	b := make([]byte, n)
+	for i := range b {
+		b[i] = byte(r.IntN(max))
+	}
+	return b
+}
+
+func compress(t testing.TB, in []byte, level int, dict []byte, concurrency int) []byte {
+	var buf bytes.Buffer
+	w, err := NewWriterDict(&buf, level, dict)
+	if err != nil {
+		t.Fatal(err)
+	}
+	w.SetConcurrency(concurrency)
+	if _, err := w.Write(in); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func decompress(t testing.TB, in []byte, dicts ...[]byte) []byte {
+	r, err := NewReaderDict(bytes.NewReader(in), dicts...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	out, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return out
+}
+
+func TestRoundTrip(t *testing.T) {
+	for name, in := range testInputs(t) {
+		for level := DefaultCompression; level <= BestCompression; level++ {
+			if testing.Short() && level > 3 && len(in) > 1<<20 {
+				continue
+			}
+			t.Run(fmt.Sprintf("%s/%d", name, level), func(t *testing.T) {
+				c := compress(t, in, level, nil, 1)
+				if out := decompress(t, c); !bytes.Equal(out, in) {
+					t.Fatalf("round trip of %d bytes returned %d different bytes", len(in), len(out))
+				}
+				if level != NoCompression && len(in) > 10000 && name != "random" && len(c) > len(in)/2 {
+					t.Errorf("compressed %d bytes to %d bytes", len(in), len(c))
+				}
+			})
+		}
+	}
+}
+
+func TestLevels(t *testing.T) {
+	in := testInputs(t)["Isaac.Newton-Opticks.txt"]
+	prev := len(in)
+	for _, level := range []int{BestSpeed, 3, 6, BestCompression} {
+		n := len(compress(t, in, level, nil, 1))
+		t.Logf("level %d: %d bytes", level, n)
+		if n >= prev {
+			t.Errorf("level %d: compressed to %d bytes, previous level %d bytes", level, n, prev)
+		}
+		prev = n
+	}
+}
+
+func TestInvalidLevel(t *testing.T) {
+	for _, level := range []int{-2, 10} {
+		if _, err := NewWriterLevel(io.Discard, level); err == nil {
+			t.Errorf("NewWriterLevel(%d) succeeded", level)
+		}
+	}
+}
+
+func TestConcurrency(t *testing.T) {
+	in := testInputs(t)["mixed"]
+	in = append(bytes.Repeat(in, 3), testInputs(t)["Isaac.Newton-Opticks.txt"]...)
+	seq := compress(t, in, DefaultCompression, nil, 1)
+	for _, n := range []int{2, 4, 7} {
+		c := compress(t, in, DefaultCompression, nil, n)
+		if out := decompress(t, c); !bytes.Equal(out, in) {
+			t.Fatalf("concurrency %d: round trip returned different bytes", n)
+		}
+		t.Logf("concurrency %d: %d bytes, sequential %d bytes", n, len(c), len(seq))
+		if len(c) > len(seq)+len(seq)/10 {
+			t.Errorf("concurrency %d: compressed to %d bytes, sequential %d bytes", n, len(c), len(seq))
+		}
+	}
+}
+
+func TestWriteSizes(t *testing.T) {
+	// Write in pieces of various sizes, with flushes,
+	// and check that the data decompresses the same.
+	in := testInputs(t)["mixed"]
+	for _, concurrency := range []int{1, 3} {
+		var buf bytes.Buffer
+		w := NewWriter(&buf)
+		w.SetConcurrency(concurrency)
+		r := rand.New(rand.NewPCG(3, 4))
+		for rest := in; len(rest) > 0; {
+			n := min(len(rest), r.IntN(300<<10))
+			if _, err := w.Write(rest[:n]); err != nil {
+				t.Fatal(err)
+			}
+			rest = rest[n:]
+			if r.IntN(4) == 0 {
+				if err := w.Flush(); err != nil {
+					t.Fatal(err)
+				}
+			}
+		}
+		if err := w.Close(); err != nil {
+			t.Fatal(err)
+		}
+		if out := decompress(t, buf.Bytes()); !bytes.Equal(out, in) {
+			t.Fatalf("concurrency %d: round trip returned different bytes", concurrency)
+		}
+	}
+}
+
+func TestFlush(t *testing.T) {
+	pr, pw := io.Pipe()
+	w := NewWriter(pw)
+	r := NewReader(pr)
+	for _, msg := range []string{"hello, ", "world", "\n"} {
+		done := make(chan error)
+		go func() {
+			w.Write([]byte(msg))
+			done <- w.Flush()
+		}()
+		buf := make([]byte, len(msg))
+		if _, err := io.ReadFull(r, buf); err != nil {
+			t.Fatal(err)
+		}
+		if string(buf) != msg {
+			t.Fatalf("read %q, want %q", buf, msg)
+		}
+		if err := <-done; err != nil {
+			t.Fatal(err)
+		}
+	}
+	go func() {
+		w.Close()
+		pw.Close()
+	}()
+	if b, err := io.ReadAll(r); err != nil || len(b) != 0 {
+		t.Fatalf("read %q, %v at end", b, err)
+	}
+}
+
+func TestContentSize(t *testing.T) {
+	// A stream written before any flush records its size.
+	for _, n := range []int{0, 255, 256, 65791, 65792, 1 << 20} {
+		in := testInputs(t)["mixed"][:n]
+		var buf bytes.Buffer
+		w, _ := NewWriterLevel(&buf, BestSpeed)
+		w.SetConcurrency(2)
+		w.Write(in)
+		w.Close()
+		c := buf.Bytes()
+		if c[4]&(1<<5) == 0 {
+			t.Errorf("%d bytes: single segment flag not set", n)
+		}
+		if out := decompress(t, c); !bytes.Equal(out, in) {
+			t.Fatalf("%d bytes: round trip returned different bytes", n)
+		}
+	}
+}
+
+func TestResetAndClose(t *testing.T) {
+	in := testInputs(t)["gettysburg.txt"]
+	var buf1, buf2 bytes.Buffer
+	w := NewWriter(&buf1)
+	w.Write(in)
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Errorf("second Close: %v", err)
+	}
+	if _, err := w.Write(in); err == nil {
+		t.Errorf("Write after Close succeeded")
+	}
+	w.Reset(&buf2)
+	w.Write(in)
+	w.Close()
+	if !bytes.Equal(buf1.Bytes(), buf2.Bytes()) {
+		t.Errorf("output differs after Reset")
+	}
+
+	r := NewReader(bytes.NewReader(buf1.Bytes()))
+	io.ReadAll(r)
+	r.Reset(bytes.NewReader(buf2.Bytes()))
+	if out, err := io.ReadAll(r); err != nil || !bytes.Equal(out, in) {
+		t.Errorf("after Reset: read %d bytes, %v", len(out), err)
+	}
+}
+
+type errWriter struct{ n int }
+
+func (w *errWriter) Write(b []byte) (int, error) {
+	if len(b) > w.n {
+		return 0, errors.New("write failed")
+	}
+	w.n -= len(b)
+	return len(b), nil
+}
+
+func TestWriterError(t *testing.T) {
+	w := NewWriter(&errWriter{n: 1000})
+	in := testInputs(t)["random"]
+	if _, err := w.Write(in); err == nil {
+		t.Errorf("Write succeeded")
+	}
+	if err := w.Close(); err == nil {
+		t.Errorf("Close succeeded")
+	}
+}
+
+func TestCorrupt(t *testing.T) {
+	in := testInputs(t)["gettysburg.txt"]
+	c := compress(t, in, DefaultCompression, nil, 1)
+	c[len(c)-1] ^= 1
+	if _, err := io.ReadAll(NewReader(bytes.NewReader(c))); err == nil {
+		t.Errorf("bad checksum not detected")
+	}
+}
+
+func TestRawDict(t *testing.T) {
+	dict := testInputs(t)["gettysburg.txt"]
+	in := []byte("Four score and seven years ago our fathers brought forth on this continent, a new nation.")
+	with := compress(t, in, DefaultCompression, dict, 1)
+	without := compress(t, in, DefaultCompression, nil, 1)
+	if len(with) >= len(without)/2 {
+		t.Errorf("compressed with dictionary to %d bytes, without to %d bytes", len(with), len(without))
+	}
+	if out := decompress(t, with, dict); !bytes.Equal(out, in) {
+		t.Fatalf("round trip returned %q", out)
+	}
+	if _, err := io.ReadAll(NewReader(bytes.NewReader(with))); err == nil {
+		t.Errorf("decompressed without dictionary")
+	}
+}
+
+func TestFormattedDict(t *testing.T) {
+	dict, err := os.ReadFile("testdata/records.dict")
+	if err != nil {
+		t.Fatal(err)
+	}
+	rec := []byte(`{"id": 7, "name": "frank", "email": "grace@example.com", "city": "Lima", "active": true, "score": 601, "tags": ["staff", "ops"]}` + "\n")
+
+	// Read a frame written by the zstd command.
+	c, err := os.ReadFile("testdata/record.json.zst")
+	if err != nil {
+		t.Fatal(err)
+	}
+	other := testInputs(t)["e.txt"]
+	if out := decompress(t, c, other, dict); !bytes.Equal(out, rec) {
+		t.Fatalf("decompressed %q, want %q", out, rec)
+	}
+
+	for _, concurrency := range []int{1, 2} {
+		c = compress(t, rec, DefaultCompression, dict, concurrency)
+		if len(c) >= 60 {
+			t.Errorf("compressed %d bytes to %d bytes", len(rec), len(c))
+		}
+		if out := decompress(t, c, other, dict); !bytes.Equal(out, rec) {
+			t.Fatalf("decompressed %q, want %q", out, rec)
+		}
+	}
+
+	r := NewReader(bytes.NewReader(c))
+	if _, err := io.ReadAll(r); err == nil || !strings.Contains(err.Error(), "dictionary") {
+		t.Errorf("read without dictionary: got error %v", err)
+	}
+
+	bad := bytes.Clone(dict)
+	bad[8] = 0xff
+	if _, err := NewWriterDict(io.Discard, DefaultCompression, bad); err == nil {
+		t.Errorf("NewWriterDict accepted corrupt dictionary")
+	}
+}
+
+// TestZstdCommand checks that the zstd command can decompress
+// our output, and that we can decompress its output.
+func TestZstdCommand(t *testing.T) {
+	testenv.MustHaveExec(t)
+	zstd, err := exec.LookPath("zstd")
+	if err != nil {
+		t.Skip("skipping because zstd not found")
+	}
+	dict, err := os.ReadFile("testdata/records.dict")
+	if err != nil {
+		t.Fatal(err)
+	}
+	dictFile := t.TempDir() + "/dict"
+	if err := os.WriteFile(dictFile, dict, 0o666); err != nil {
+		t.Fatal(err)
+	}
+
+	run := func(in []byte, args ...string) []byte {
+		cmd := testenv.Command(t, zstd, args...)
+		cmd.Stdin = bytes.NewReader(in)
+		var out bytes.Buffer
+		cmd.Stdout = &out
+		cmd.Stderr = os.Stderr
+		if err := cmd.Run(); err != nil {
+			t.Fatalf("zstd %v: %v", args, err)
+		}
+		return out.Bytes()
+	}
+
+	for name, in := range testInputs(t) {
+		for _, level := range []int{NoCompression, BestSpeed, DefaultCompression, BestCompression} {
+			concurrency := 1
+			if level == BestSpeed {
+				concurrency = 3
+			}
+			c := compress(t, in, level, nil, concurrency)
+			if out := run(c, "-d"); !bytes.Equal(out, in) {
+				t.Errorf("%s: level %d: zstd -d returned different bytes", name, level)
+			}
+		}
+		if out := decompress(t, run(in, "-19")); !bytes.Equal(out, in) {
+			t.Errorf("%s: decompressing zstd -19 output returned different bytes", name)
+		}
+		c := compress(t, in, DefaultCompression, dict, 1)
+		if out := run(c, "-d", "-D", dictFile); !bytes.Equal(out, in) {
+			t.Errorf("%s: zstd -d -D returned different bytes", name)
+		}
+	}
+}
+
+func benchmarkWriter(b *testing.B, level, concurrency int) {
+	in := testInputs(b)["Isaac.Newton-Opticks.txt"]
+	in = bytes.Repeat(in, 4)
+	w, _ := NewWriterLevel(io.Discard, level)
+	w.SetConcurrency(concurrency)
+	b.SetBytes(int64(len(in)))
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		w.Reset(io.Discard)
+		w.Write(in)
+		w.Close()
+	}
+}
+
+func BenchmarkWriter(b *testing.B) {
+	for _, level := range []int{BestSpeed, DefaultCompression, BestCompression} {
+		b.Run(fmt.Sprintf("level=%d", level), func(b *testing.B) {
+			benchmarkWriter(b, level, 1)
+		})
+	}
+	b.Run("concurrency=4", func(b *testing.B) {
+		benchmarkWriter(b, DefaultCompression, 4)
+	})
+}
+
+func BenchmarkReader(b *testing.B) {
+	in := testInputs(b)["Isaac.Newton-Opticks.txt"]
+	c := compress(b, in, DefaultCompression, nil, 1)
+	r := NewReader(nil)
+	b.SetBytes(int64(len(in)))
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		r.Reset(bytes.NewReader(c))
+		io.Copy(io.Discard, r)
+	}
+}
@@ -3,7 +3,7 @@
 // license that can be found in the LICENSE file.
 
 // Package ecdh implements Elliptic Curve Diffie-Hellman over
-// NIST curves and Curve25519.
+// NIST curves, Curve25519, and Curve448.
 package ecdh
 
 import (
@@ -31,7 +31,7 @@ type Curve interface {
 	// private key is also rejected, as the encoding of the corresponding public
 	// key would be irregular.
 	//
-	// For X25519, this only checks the scalar length.
+	// For X25519 and X448, this only checks the scalar length.
 	NewPrivateKey(key []byte) (*PrivateKey, error)
 
 	// NewPublicKey checks that key is valid and returns a PublicKey.
@@ -40,8 +40,8 @@ type Curve interface {
 	// Version 2.0, Section 2.3.4. Compressed encodings and the point at
 	// infinity are rejected.
 	//
-	// For X25519, this only checks the u-coordinate length. Adversarially
-	// selected public keys can cause ECDH to return an error.
+	// For X25519 and X448, this only checks the u-coordinate length.
+	// Adversarially selected public keys can cause ECDH to return an error.
 	NewPublicKey(key []byte) (*PublicKey, error)
 
 	// ecdh performs an ECDH exchange and returns the shared secret. It's exposed
@@ -54,7 +54,7 @@ type Curve interface {
 	// privateKeyToPublicKey converts a PrivateKey to a PublicKey. It's exposed
 	// as the PrivateKey.PublicKey method.
 	//
-	// This method always succeeds: for X25519, the zero key can't be
+	// This method always succeeds: for X25519 and X448, the zero key can't be
 	// constructed due to clamping; for NIST curves, it is rejected by
 	// NewPrivateKey.
 	privateKeyToPublicKey(*PrivateKey) *PublicKey
@@ -121,8 +121,9 @@ type PrivateKey struct {
 // Section 3.3.1, and returns the x-coordinate encoded according to SEC 1,
 // Version 2.0, Section 2.3.5. The result is never the point at infinity.
 //
-// For [X25519], this performs ECDH as specified in RFC 7748, Section 6.1. If
-// the result is the all-zero value, ECDH returns an error.
+// For [X25519] and [X448], this performs ECDH as specified in RFC 7748,
+// Sections 6.1 and 6.2. If the result is the all-zero value, ECDH returns an
+// error.
 func (k *PrivateKey) ECDH(remote *PublicKey) ([]byte, error) {
 	if k.curve != remote.curve {
 		return nil, errors.New("crypto/ecdh: private key and public key curves do not match")
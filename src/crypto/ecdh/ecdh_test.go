@@ -153,6 +153,13 @@ var vectors = map[ecdh.Curve]struct {
 		PeerPublicKey: "de9edb7d7b7dc1b4d35b61c2ece435373f8343c85b78674dadfc7e146f882b4f",
 		SharedSecret:  "4a5d9d5ba4ce2de1728e3bf480350f25e07e21c947d19e3376f09b3c1e161742",
 	},
+	// X448 test vector from RFC 7748, Section 6.2.
+	ecdh.X448(): {
+		PrivateKey:    "9a8f4925d1519f5775cf46b04b5800d4ee9ee8bae8bc5565d498c28dd9c9baf574a9419744897391006382a6f127ab1d9ac2d8c0a598726b",
+		PublicKey:     "9b08f7cc31b7e3e67d22d5aea121074a273bd2b83de09c63faa73d2c22c5d9bbc836647241d953d40c5b12da88120d53177f80e532c41fa0",
+		PeerPublicKey: "3eb7a829b0cd20f5bcfc0b599b6feccf6da4627107bdb0d4f345b43027d8b972fc3e34fb4232a13ca706dcb57aec3dae07bdc1c67bf33609",
+		SharedSecret:  "07fff4181ac6cc95ec1c16a94a0f74d12da232ce40a77552281d282bb60c0b56fd2464c335543936521c24403085d59a449a5037514a879d",
+	},
 }
 
 func TestVectors(t *testing.T) {
@@ -203,16 +210,58 @@ func TestX25519Failure(t *testing.T) {
 	randomScalar := make([]byte, 32)
 	rand.Read(randomScalar)
 
-	t.Run("identity point", func(t *testing.T) { testX25519Failure(t, randomScalar, identity) })
-	t.Run("low order point", func(t *testing.T) { testX25519Failure(t, randomScalar, lowOrderPoint) })
+	t.Run("identity point", func(t *testing.T) { testECDHFailure(t, ecdh.X25519(), randomScalar, identity) })
+	t.Run("low order point", func(t *testing.T) { testECDHFailure(t, ecdh.X25519(), randomScalar, lowOrderPoint) })
+}
+
+func TestX448Iterations(t *testing.T) {
+	// Iterated test vectors from RFC 7748, Section 5.2.
+	want := map[int]string{
+		1:    "3f482c8a9f19b01e6c46ee9711d9dc14fd4bf67af30765c2ae2b846a4d23a8cd0db897086239492caf350b51f833868b9bc2b3bca9cf4113",
+		1000: "aa3b4749d55b9daf1e5b00288826c467274ce3ebbdd5c17b975e09d4af6c67cf10d087202db88286e2b79fceea3ec353ef54faa26e219f38",
+	}
+	n := 1
+	if !testing.Short() {
+		n = 1000
+	}
+	k := append([]byte{5}, make([]byte, 55)...)
+	u := append([]byte{5}, make([]byte, 55)...)
+	for i := 1; i <= n; i++ {
+		priv, err := ecdh.X448().NewPrivateKey(k)
+		if err != nil {
+			t.Fatal(err)
+		}
+		pub, err := ecdh.X448().NewPublicKey(u)
+		if err != nil {
+			t.Fatal(err)
+		}
+		out, err := priv.ECDH(pub)
+		if err != nil {
+			t.Fatal(err)
+		}
+		u, k = k, out
+		if w, ok := want[i]; ok && hex.EncodeToString(k) != w {
+			t.Errorf("after %d iterations: got %x, want %s", i, k, w)
+		}
+	}
+}
+
+func TestX448Failure(t *testing.T) {
+	identity := make([]byte, 56)
+	lowOrderPoint := append([]byte{1}, make([]byte, 55)...)
+	randomScalar := make([]byte, 56)
+	rand.Read(randomScalar)
+
+	t.Run("identity point", func(t *testing.T) { testECDHFailure(t, ecdh.X448(), randomScalar, identity) })
+	t.Run("low order point", func(t *testing.T) { testECDHFailure(t, ecdh.X448(), randomScalar, lowOrderPoint) })
 }
 
-func testX25519Failure(t *testing.T, private, public []byte) {
-	priv, err := ecdh.X25519().NewPrivateKey(private)
+func testECDHFailure(t *testing.T, curve ecdh.Curve, private, public []byte) {
+	priv, err := curve.NewPrivateKey(private)
 	if err != nil {
 		t.Fatal(err)
 	}
-	pub, err := ecdh.X25519().NewPublicKey(public)
+	pub, err := curve.NewPublicKey(public)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -277,6 +326,14 @@ var invalidPrivateKeys = map[ecdh.Curve][]string{
 		"000101010101010101010101010101010101010101010101010101010101010101",
 		strings.Repeat("01", 200),
 	},
+	ecdh.X448(): {
+		// X448 only rejects bad lengths.
+		"",
+		"01",
+		strings.Repeat("01", 55),
+		strings.Repeat("01", 57),
+		strings.Repeat("01", 200),
+	},
 }
 
 func TestNewPrivateKey(t *testing.T) {
@@ -338,6 +395,7 @@ var invalidPublicKeys = map[ecdh.Curve][]string{
 		"04000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000",
 	},
 	ecdh.X25519(): {},
+	ecdh.X448():   {},
 }
 
 func TestNewPublicKey(t *testing.T) {
@@ -360,6 +418,7 @@ func testAllCurves(t *testing.T, f func(t *testing.T, curve ecdh.Curve)) {
 	t.Run("P384", func(t *testing.T) { f(t, ecdh.P384()) })
 	t.Run("P521", func(t *testing.T) { f(t, ecdh.P521()) })
 	t.Run("X25519", func(t *testing.T) { f(t, ecdh.X25519()) })
+	t.Run("X448", func(t *testing.T) { f(t, ecdh.X448()) })
 }
 
 func BenchmarkECDH(b *testing.B) {
@@ -406,6 +465,7 @@ func benchmarkAllCurves(b *testing.B, f func(b *testing.B, curve ecdh.Curve)) {
 	b.Run("P384", func(b *testing.B) { f(b, ecdh.P384()) })
 	b.Run("P521", func(b *testing.B) { f(b, ecdh.P521()) })
 	b.Run("X25519", func(b *testing.B) { f(b, ecdh.X25519()) })
+	b.Run("X448", func(b *testing.B) { f(b, ecdh.X448()) })
 }
 
 type zr struct{}
@@ -495,6 +555,7 @@ func TestMismatchedCurves(t *testing.T) {
 		{"P384", ecdh.P384()},
 		{"P521", ecdh.P521()},
 		{"X25519", ecdh.X25519()},
+		{"X448", ecdh.X448()},
 	}
 
 	for _, privCurve := range curves {
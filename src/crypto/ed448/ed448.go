@@ -0,0 +1,333 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package ed448 implements the Ed448 signature algorithm, as defined in
+// RFC 8032, Section 5.2.
+//
+// Like crypto/ed25519, and unlike RFC 8032's formulation, this package's
+// private key representation includes a public key suffix to make multiple
+// signing operations with the same key more efficient. This package refers to
+// the RFC 8032 private key as the “seed”.
+//
+// Operations involving private keys are implemented using constant-time
+// algorithms.
+package ed448
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/internal/edwards448"
+	cryptorand "crypto/rand"
+	"crypto/subtle"
+	"errors"
+	"io"
+	"strconv"
+
+	"golang.org/x/crypto/sha3"
+)
+
+const (
+	// PublicKeySize is the size, in bytes, of public keys as used in this package.
+	PublicKeySize = 57
+	// PrivateKeySize is the size, in bytes, of private keys as used in this package.
+	PrivateKeySize = 114
+	// SignatureSize is the size, in bytes, of signatures generated and verified by this package.
+	SignatureSize = 114
+	// SeedSize is the size, in bytes, of private key seeds. These are the private key representations used by RFC 8032.
+	SeedSize = 57
+	// PrehashSize is the size, in bytes, of the SHAKE256 message hash used by Ed448ph.
+	PrehashSize = 64
+)
+
+// PublicKey is the type of Ed448 public keys.
+type PublicKey []byte
+
+// Any methods implemented on PublicKey might need to also be implemented on
+// PrivateKey, as the latter embeds the former and will expose its methods.
+
+// Equal reports whether pub and x have the same value.
+func (pub PublicKey) Equal(x crypto.PublicKey) bool {
+	xx, ok := x.(PublicKey)
+	if !ok {
+		return false
+	}
+	return subtle.ConstantTimeCompare(pub, xx) == 1
+}
+
+// PrivateKey is the type of Ed448 private keys. It implements [crypto.Signer].
+type PrivateKey []byte
+
+// Public returns the [PublicKey] corresponding to priv.
+func (priv PrivateKey) Public() crypto.PublicKey {
+	publicKey := make([]byte, PublicKeySize)
+	copy(publicKey, priv[SeedSize:])
+	return PublicKey(publicKey)
+}
+
+// Equal reports whether priv and x have the same value.
+func (priv PrivateKey) Equal(x crypto.PrivateKey) bool {
+	xx, ok := x.(PrivateKey)
+	if !ok {
+		return false
+	}
+	return subtle.ConstantTimeCompare(priv, xx) == 1
+}
+
+// Seed returns the private key seed corresponding to priv. It is provided for
+// interoperability with RFC 8032. RFC 8032's private keys correspond to seeds
+// in this package.
+func (priv PrivateKey) Seed() []byte {
+	return bytes.Clone(priv[:SeedSize])
+}
+
+// Sign signs the given message with priv. rand is ignored and can be nil.
+//
+// opts.HashFunc() must be [crypto.Hash](0), as there is no [crypto.Hash] value
+// for SHAKE256. By default the message must not be hashed, as Ed448 performs
+// two passes over messages to be signed. A value of type [Options] can be used
+// as opts to select the pre-hashed variant Ed448ph, in which case message is
+// expected to be a [PrehashSize]-byte SHAKE256 hash, or to provide a context
+// string.
+func (priv PrivateKey) Sign(rand io.Reader, message []byte, opts crypto.SignerOpts) (signature []byte, err error) {
+	if opts.HashFunc() != crypto.Hash(0) {
+		return nil, errors.New("ed448: expected opts.HashFunc() zero (unhashed message, for standard Ed448, or SHAKE256 hash, for Ed448ph)")
+	}
+	var phflag byte
+	context := ""
+	if opts, ok := opts.(*Options); ok {
+		context = opts.Context
+		if opts.Prehashed {
+			phflag = 1
+		}
+	}
+	if err := checkOptions(message, phflag, context); err != nil {
+		return nil, err
+	}
+	signature = make([]byte, SignatureSize)
+	sign(signature, priv, message, phflag, context)
+	return signature, nil
+}
+
+// Options can be used with [PrivateKey.Sign] or [VerifyWithOptions]
+// to select Ed448 variants.
+type Options struct {
+	// Prehashed selects Ed448ph, in which case the message must be the
+	// [PrehashSize]-byte SHAKE256 hash of the message to be signed.
+	Prehashed bool
+
+	// Context, if not empty, provides the context string for Ed448 or Ed448ph.
+	// It can be at most 255 bytes in length.
+	Context string
+}
+
+// HashFunc returns zero, as Ed448ph uses SHAKE256 which has no [crypto.Hash]
+// value. Use Prehashed to select Ed448ph.
+func (o *Options) HashFunc() crypto.Hash { return crypto.Hash(0) }
+
+func checkOptions(message []byte, phflag byte, context string) error {
+	if l := len(message); phflag == 1 && l != PrehashSize {
+		return errors.New("ed448: bad Ed448ph message hash length: " + strconv.Itoa(l))
+	}
+	if l := len(context); l > 255 {
+		return errors.New("ed448: bad context length: " + strconv.Itoa(l))
+	}
+	return nil
+}
+
+// GenerateKey generates a public/private key pair using entropy from rand.
+// If rand is nil, [crypto/rand.Reader] will be used.
+//
+// The output of this function is deterministic, and equivalent to reading
+// [SeedSize] bytes from rand, and passing them to [NewKeyFromSeed].
+func GenerateKey(rand io.Reader) (PublicKey, PrivateKey, error) {
+	if rand == nil {
+		rand = cryptorand.Reader
+	}
+
+	seed := make([]byte, SeedSize)
+	if _, err := io.ReadFull(rand, seed); err != nil {
+		return nil, nil, err
+	}
+
+	privateKey := NewKeyFromSeed(seed)
+	publicKey := make([]byte, PublicKeySize)
+	copy(publicKey, privateKey[SeedSize:])
+
+	return publicKey, privateKey, nil
+}
+
+// NewKeyFromSeed calculates a private key from a seed. It will panic if
+// len(seed) is not [SeedSize]. This function is provided for interoperability
+// with RFC 8032. RFC 8032's private keys correspond to seeds in this
+// package.
+func NewKeyFromSeed(seed []byte) PrivateKey {
+	// Outline the function body so that the returned key can be stack-allocated.
+	privateKey := make([]byte, PrivateKeySize)
+	newKeyFromSeed(privateKey, seed)
+	return privateKey
+}
+
+func newKeyFromSeed(privateKey, seed []byte) {
+	if l := len(seed); l != SeedSize {
+		panic("ed448: bad seed length: " + strconv.Itoa(l))
+	}
+
+	var h [114]byte
+	sha3.ShakeSum256(h[:], seed)
+	s, err := edwards448.NewScalar().SetBytesWithClamping(h[:57])
+	if err != nil {
+		panic("ed448: internal error: setting scalar failed")
+	}
+	A := (&edwards448.Point{}).ScalarBaseMult(s)
+
+	publicKey := A.Bytes()
+
+	copy(privateKey, seed)
+	copy(privateKey[SeedSize:], publicKey)
+}
+
+// Sign signs the message with privateKey and returns a signature. It will
+// panic if len(privateKey) is not [PrivateKeySize].
+func Sign(privateKey PrivateKey, message []byte) []byte {
+	// Outline the function body so that the returned signature can be
+	// stack-allocated.
+	signature := make([]byte, SignatureSize)
+	sign(signature, privateKey, message, 0, "")
+	return signature
+}
+
+// domPrefix is the prefix of dom4, the domain separation string used by all
+// Ed448 variants. See RFC 8032, Section 2 and Section 5.2.
+const domPrefix = "SigEd448"
+
+// writeDom4 writes dom4(phflag, context) to h.
+func writeDom4(h sha3.ShakeHash, phflag byte, context string) {
+	h.Write([]byte(domPrefix))
+	h.Write([]byte{phflag, byte(len(context))})
+	h.Write([]byte(context))
+}
+
+func sign(signature, privateKey, message []byte, phflag byte, context string) {
+	if l := len(privateKey); l != PrivateKeySize {
+		panic("ed448: bad private key length: " + strconv.Itoa(l))
+	}
+	seed, publicKey := privateKey[:SeedSize], privateKey[SeedSize:]
+
+	var h [114]byte
+	sha3.ShakeSum256(h[:], seed)
+	s, err := edwards448.NewScalar().SetBytesWithClamping(h[:57])
+	if err != nil {
+		panic("ed448: internal error: setting scalar failed")
+	}
+	prefix := h[57:]
+
+	mh := sha3.NewShake256()
+	writeDom4(mh, phflag, context)
+	mh.Write(prefix)
+	mh.Write(message)
+	var messageDigest [114]byte
+	mh.Read(messageDigest[:])
+	r, err := edwards448.NewScalar().SetUniformBytes(messageDigest[:])
+	if err != nil {
+		panic("ed448: internal error: setting scalar failed")
+	}
+
+	R := (&edwards448.Point{}).ScalarBaseMult(r)
+
+	kh := sha3.NewShake256()
+	writeDom4(kh, phflag, context)
+	kh.Write(R.Bytes())
+	kh.Write(publicKey)
+	kh.Write(message)
+	var hramDigest [114]byte
+	kh.Read(hramDigest[:])
+	k, err := edwards448.NewScalar().SetUniformBytes(hramDigest[:])
+	if err != nil {
+		panic("ed448: internal error: setting scalar failed")
+	}
+
+	S := edwards448.NewScalar().MultiplyAdd(k, s, r)
+
+	copy(signature[:57], R.Bytes())
+	copy(signature[57:], S.Bytes())
+}
+
+// Verify reports whether sig is a valid signature of message by publicKey. It
+// will panic if len(publicKey) is not [PublicKeySize].
+//
+// The inputs are not considered confidential, and may leak through timing side
+// channels, or if an attacker has control of part of the inputs.
+func Verify(publicKey PublicKey, message, sig []byte) bool {
+	return verify(publicKey, message, sig, 0, "")
+}
+
+// VerifyWithOptions reports whether sig is a valid signature of message by
+// publicKey. A valid signature is indicated by returning a nil error. It will
+// panic if len(publicKey) is not [PublicKeySize].
+//
+// If opts.Prehashed is true, the pre-hashed variant Ed448ph is used and
+// message is expected to be a [PrehashSize]-byte SHAKE256 hash, otherwise the
+// message must not be hashed, as Ed448 performs two passes over messages to be
+// signed.
+//
+// The inputs are not considered confidential, and may leak through timing side
+// channels, or if an attacker has control of part of the inputs.
+func VerifyWithOptions(publicKey PublicKey, message, sig []byte, opts *Options) error {
+	var phflag byte
+	if opts.Prehashed {
+		phflag = 1
+	}
+	if err := checkOptions(message, phflag, opts.Context); err != nil {
+		return err
+	}
+	if !verify(publicKey, message, sig, phflag, opts.Context) {
+		return errors.New("ed448: invalid signature")
+	}
+	return nil
+}
+
+func verify(publicKey PublicKey, message, sig []byte, phflag byte, context string) bool {
+	if l := len(publicKey); l != PublicKeySize {
+		panic("ed448: bad public key length: " + strconv.Itoa(l))
+	}
+
+	if len(sig) != SignatureSize {
+		return false
+	}
+
+	A, err := (&edwards448.Point{}).SetBytes(publicKey)
+	if err != nil {
+		return false
+	}
+	R, err := (&edwards448.Point{}).SetBytes(sig[:57])
+	if err != nil {
+		return false
+	}
+	S, err := edwards448.NewScalar().SetCanonicalBytes(sig[57:])
+	if err != nil {
+		return false
+	}
+
+	kh := sha3.NewShake256()
+	writeDom4(kh, phflag, context)
+	kh.Write(sig[:57])
+	kh.Write(publicKey)
+	kh.Write(message)
+	var hramDigest [114]byte
+	kh.Read(hramDigest[:])
+	k, err := edwards448.NewScalar().SetUniformBytes(hramDigest[:])
+	if err != nil {
+		panic("ed448: internal error: setting scalar failed")
+	}
+
+	// Check the cofactored group equation [4][S]B = [4]R + [4][k]A, as
+	// specified in RFC 8032, Section 5.2.7, as [4]([k](-A) + [S]B - R) = 0.
+	minusA := (&edwards448.Point{}).Negate(A)
+	P := (&edwards448.Point{}).VarTimeDoubleScalarBaseMult(k, minusA, S)
+	P.Subtract(P, R)
+	P.Double(P)
+	P.Double(P)
+
+	return P.Equal(edwards448.NewIdentityPoint()) == 1
+}
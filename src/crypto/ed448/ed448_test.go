@@ -0,0 +1,326 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ed448
+
+import (
+	"bytes"
+	"crypto"
+	"encoding/hex"
+	"log"
+	"strings"
+	"testing"
+
+	"golang.org/x/crypto/sha3"
+)
+
+func Example_ed448ph() {
+	pub, priv, err := GenerateKey(nil)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	msg := []byte("The quick brown fox jumps over the lazy dog")
+	var prehash [PrehashSize]byte
+	sha3.ShakeSum256(prehash[:], msg)
+
+	sig, err := priv.Sign(nil, prehash[:], &Options{
+		Prehashed: true,
+		Context:   "Example_ed448ph",
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err := VerifyWithOptions(pub, prehash[:], sig, &Options{
+		Prehashed: true,
+		Context:   "Example_ed448ph",
+	}); err != nil {
+		log.Fatal("invalid signature")
+	}
+}
+
+type zeroReader struct{}
+
+func (zeroReader) Read(buf []byte) (int, error) {
+	clear(buf)
+	return len(buf), nil
+}
+
+// rfc8032Vectors are the Ed448 and Ed448ph test vectors from RFC 8032,
+// Sections 7.4 and 7.5.
+var rfc8032Vectors = []struct {
+	name      string
+	seed      string
+	public    string
+	message   string
+	context   string
+	prehashed bool
+	signature string
+}{
+	{
+		name:      "blank",
+		seed:      "6c82a562cb808d10d632be89c8513ebf6c929f34ddfa8c9f63c9960ef6e348a3528c8a3fcc2f044e39a3fc5b94492f8f032e7549a20098f95b",
+		public:    "5fd7449b59b461fd2ce787ec616ad46a1da1342485a70e1f8a0ea75d80e96778edf124769b46c7061bd6783df1e50f6cd1fa1abeafe8256180",
+		message:   "",
+		signature: "533a37f6bbe457251f023c0d88f976ae2dfb504a843e34d2074fd823d41a591f2b233f034f628281f2fd7a22ddd47d7828c59bd0a21bfd3980ff0d2028d4b18a9df63e006c5d1c2d345b925d8dc00b4104852db99ac5c7cdda8530a113a0f4dbb61149f05a7363268c71d95808ff2e652600",
+	},
+	{
+		name:      "1 octet",
+		seed:      "c4eab05d357007c632f3dbb48489924d552b08fe0c353a0d4a1f00acda2c463afbea67c5e8d2877c5e3bc397a659949ef8021e954e0a12274e",
+		public:    "43ba28f430cdff456ae531545f7ecd0ac834a55d9358c0372bfa0c6c6798c0866aea01eb00742802b8438ea4cb82169c235160627b4c3a9480",
+		message:   "03",
+		signature: "26b8f91727bd62897af15e41eb43c377efb9c610d48f2335cb0bd0087810f4352541b143c4b981b7e18f62de8ccdf633fc1bf037ab7cd779805e0dbcc0aae1cbcee1afb2e027df36bc04dcecbf154336c19f0af7e0a6472905e799f1953d2a0ff3348ab21aa4adafd1d234441cf807c03a00",
+	},
+	{
+		name:      "1 octet with context",
+		seed:      "c4eab05d357007c632f3dbb48489924d552b08fe0c353a0d4a1f00acda2c463afbea67c5e8d2877c5e3bc397a659949ef8021e954e0a12274e",
+		public:    "43ba28f430cdff456ae531545f7ecd0ac834a55d9358c0372bfa0c6c6798c0866aea01eb00742802b8438ea4cb82169c235160627b4c3a9480",
+		message:   "03",
+		context:   "foo",
+		signature: "d4f8f6131770dd46f40867d6fd5d5055de43541f8c5e35abbcd001b32a89f7d2151f7647f11d8ca2ae279fb842d607217fce6e042f6815ea000c85741de5c8da1144a6a1aba7f96de42505d7a7298524fda538fccbbb754f578c1cad10d54d0d5428407e85dcbc98a49155c13764e66c3c00",
+	},
+	{
+		name:      "11 octets",
+		seed:      "cd23d24f714274e744343237b93290f511f6425f98e64459ff203e8985083ffdf60500553abc0e05cd02184bdb89c4ccd67e187951267eb328",
+		public:    "dcea9e78f35a1bf3499a831b10b86c90aac01cd84b67a0109b55a36e9328b1e365fce161d71ce7131a543ea4cb5f7e9f1d8b00696447001400",
+		message:   "0c3e544074ec63b0265e0c",
+		signature: "1f0a8888ce25e8d458a21130879b840a9089d999aaba039eaf3e3afa090a09d389dba82c4ff2ae8ac5cdfb7c55e94d5d961a29fe0109941e00b8dbdeea6d3b051068df7254c0cdc129cbe62db2dc957dbb47b51fd3f213fb8698f064774250a5028961c9bf8ffd973fe5d5c206492b140e00",
+	},
+	{
+		name:      "Ed448ph",
+		seed:      "833fe62409237b9d62ec77587520911e9a759cec1d19755b7da901b96dca3d42ef7822e0d5104127dc05d6dbefde69e3ab2cec7c867c6e2c49",
+		public:    "259b71c19f83ef77a7abd26524cbdb3161b590a48f7d17de3ee0ba9c52beb743c09428a131d6b1b57303d90d8132c276d5ed3d5d01c0f53880",
+		message:   "616263",
+		prehashed: true,
+		signature: "822f6901f7480f3d5f562c592994d9693602875614483256505600bbc281ae381f54d6bce2ea911574932f52a4e6cadd78769375ec3ffd1b801a0d9b3f4030cd433964b6457ea39476511214f97469b57dd32dbc560a9a94d00bff07620464a3ad203df7dc7ce360c3cd3696d9d9fab90f00",
+	},
+	{
+		name:      "Ed448ph with context",
+		seed:      "833fe62409237b9d62ec77587520911e9a759cec1d19755b7da901b96dca3d42ef7822e0d5104127dc05d6dbefde69e3ab2cec7c867c6e2c49",
+		public:    "259b71c19f83ef77a7abd26524cbdb3161b590a48f7d17de3ee0ba9c52beb743c09428a131d6b1b57303d90d8132c276d5ed3d5d01c0f53880",
+		message:   "616263",
+		context:   "foo",
+		prehashed: true,
+		signature: "c32299d46ec8ff02b54540982814dce9a05812f81962b649d528095916a2aa481065b1580423ef927ecf0af5888f90da0f6a9a85ad5dc3f280d91224ba9911a3653d00e484e2ce232521481c8658df304bb7745a73514cdb9bf3e15784ab71284f8d0704a608c54a6b62d97beb511d132100",
+	},
+}
+
+func decodeHex(t *testing.T, s string) []byte {
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
+
+func TestVectors(t *testing.T) {
+	for _, tt := range rfc8032Vectors {
+		t.Run(tt.name, func(t *testing.T) {
+			priv := NewKeyFromSeed(decodeHex(t, tt.seed))
+			pub := priv.Public().(PublicKey)
+			if !bytes.Equal(pub, decodeHex(t, tt.public)) {
+				t.Errorf("public key = %x, want %s", pub, tt.public)
+			}
+
+			message := decodeHex(t, tt.message)
+			if tt.prehashed {
+				var h [PrehashSize]byte
+				sha3.ShakeSum256(h[:], message)
+				message = h[:]
+			}
+			opts := &Options{Prehashed: tt.prehashed, Context: tt.context}
+			sig, err := priv.Sign(nil, message, opts)
+			if err != nil {
+				t.Fatal(err)
+			}
+			want := decodeHex(t, tt.signature)
+			if !bytes.Equal(sig, want) {
+				t.Errorf("signature = %x, want %s", sig, tt.signature)
+			}
+			if !tt.prehashed && tt.context == "" {
+				if sig := Sign(priv, message); !bytes.Equal(sig, want) {
+					t.Errorf("Sign = %x, want %s", sig, tt.signature)
+				}
+				if !Verify(pub, message, want) {
+					t.Error("Verify rejected a valid signature")
+				}
+			}
+			if err := VerifyWithOptions(pub, message, want, opts); err != nil {
+				t.Errorf("VerifyWithOptions rejected a valid signature: %v", err)
+			}
+
+			if VerifyWithOptions(pub, message, want, &Options{Prehashed: tt.prehashed, Context: tt.context + "x"}) == nil {
+				t.Error("signature with different context accepted")
+			}
+			if !tt.prehashed && VerifyWithOptions(pub, message, want, &Options{Prehashed: true, Context: tt.context}) == nil {
+				t.Error("signature with different variant accepted")
+			}
+		})
+	}
+}
+
+func TestSignVerify(t *testing.T) {
+	var zero zeroReader
+	public, private, _ := GenerateKey(zero)
+
+	message := []byte("test message")
+	sig := Sign(private, message)
+	if !Verify(public, message, sig) {
+		t.Errorf("valid signature rejected")
+	}
+
+	wrongMessage := []byte("wrong message")
+	if Verify(public, wrongMessage, sig) {
+		t.Errorf("signature of different message accepted")
+	}
+
+	sig[0] ^= 0xff
+	if Verify(public, message, sig) {
+		t.Errorf("invalid signature accepted")
+	}
+	sig[0] ^= 0xff
+	sig[SignatureSize-1] ^= 0xff
+	if Verify(public, message, sig) {
+		t.Errorf("invalid signature accepted")
+	}
+	if Verify(public, message, sig[:SignatureSize-1]) {
+		t.Errorf("short signature accepted")
+	}
+}
+
+func TestMalleability(t *testing.T) {
+	// S must be lower than the group order l, so adding l to a valid S must
+	// result in a rejected signature.
+	priv := NewKeyFromSeed(decodeHex(t, rfc8032Vectors[0].seed))
+	pub := priv.Public().(PublicKey)
+	msg := []byte("test message")
+	sig := Sign(priv, msg)
+
+	l := decodeHex(t, "f34458ab92c27823558fc58d72c26c219036d6ae49db4ec4e923ca7cffffffffffffffffffffffffffffffffffffffffffffffffffffff3f00")
+	var carry int
+	for i := 0; i < 57; i++ {
+		v := int(sig[57+i]) + int(l[i]) + carry
+		sig[57+i] = byte(v)
+		carry = v >> 8
+	}
+	if Verify(pub, msg, sig) {
+		t.Error("signature with S + l accepted")
+	}
+}
+
+func TestOptionsErrors(t *testing.T) {
+	var zero zeroReader
+	public, private, _ := GenerateKey(zero)
+	message := []byte("message")
+
+	if _, err := private.Sign(nil, message, crypto.SHA512); err == nil {
+		t.Error("expected error for non-zero hash")
+	}
+	if _, err := private.Sign(nil, message, &Options{Prehashed: true}); err == nil {
+		t.Error("expected error for bad Ed448ph message length")
+	}
+	longContext := strings.Repeat("a", 256)
+	if _, err := private.Sign(nil, message, &Options{Context: longContext}); err == nil {
+		t.Error("expected error for long context")
+	}
+	sig := Sign(private, message)
+	if err := VerifyWithOptions(public, message, sig, &Options{Context: longContext}); err == nil {
+		t.Error("expected error for long context")
+	}
+}
+
+func TestCryptoSigner(t *testing.T) {
+	var zero zeroReader
+	public, private, _ := GenerateKey(zero)
+
+	signer := crypto.Signer(private)
+
+	publicInterface := signer.Public()
+	public2, ok := publicInterface.(PublicKey)
+	if !ok {
+		t.Fatalf("expected PublicKey from Public() but got %T", publicInterface)
+	}
+
+	if !bytes.Equal(public, public2) {
+		t.Errorf("public keys do not match: original:%x vs Public():%x", public, public2)
+	}
+
+	message := []byte("message")
+	var noHash crypto.Hash
+	signature, err := signer.Sign(zero, message, noHash)
+	if err != nil {
+		t.Fatalf("error from Sign(): %s", err)
+	}
+
+	signature2, err := signer.Sign(zero, message, &Options{})
+	if err != nil {
+		t.Fatalf("error from Sign(): %s", err)
+	}
+	if !bytes.Equal(signature, signature2) {
+		t.Errorf("signatures keys do not match")
+	}
+
+	if !Verify(public, message, signature) {
+		t.Errorf("Verify failed on signature from Sign()")
+	}
+}
+
+func TestEqual(t *testing.T) {
+	public, private, _ := GenerateKey(nil)
+
+	if !public.Equal(public) {
+		t.Errorf("public key is not equal to itself: %q", public)
+	}
+	if !public.Equal(crypto.Signer(private).Public()) {
+		t.Errorf("private.Public() is not Equal to public: %q", public)
+	}
+	if !private.Equal(private) {
+		t.Errorf("private key is not equal to itself: %q", private)
+	}
+
+	otherPub, otherPriv, _ := GenerateKey(nil)
+	if public.Equal(otherPub) {
+		t.Errorf("different public keys are Equal")
+	}
+	if private.Equal(otherPriv) {
+		t.Errorf("different private keys are Equal")
+	}
+}
+
+func TestSeed(t *testing.T) {
+	seed := decodeHex(t, rfc8032Vectors[1].seed)
+	priv := NewKeyFromSeed(seed)
+	if !bytes.Equal(priv.Seed(), seed) {
+		t.Errorf("Seed() = %x, want %x", priv.Seed(), seed)
+	}
+}
+
+func BenchmarkSigning(b *testing.B) {
+	var zero zeroReader
+	_, priv, err := GenerateKey(zero)
+	if err != nil {
+		b.Fatal(err)
+	}
+	message := []byte("Hello, world!")
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		Sign(priv, message)
+	}
+}
+
+func BenchmarkVerification(b *testing.B) {
+	var zero zeroReader
+	pub, priv, err := GenerateKey(zero)
+	if err != nil {
+		b.Fatal(err)
+	}
+	message := []byte("Hello, world!")
+	signature := Sign(priv, message)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		Verify(pub, message, signature)
+	}
+}
@@ -0,0 +1,249 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package edwards448 implements group logic for the Edwards curve
+//
+//	x^2 + y^2 = 1 - 39081*x^2*y^2
+//
+// This is better known as edwards448 or Ed448-Goldilocks, the curve used by
+// the Ed448 signature scheme (RFC 8032, Section 5.2). It is 4-isogenous to
+// Curve448, which is used by X448.
+//
+// Most users don't need this package, and should instead use crypto/ed448 for
+// signatures, or crypto/ecdh for Diffie-Hellman.
+package edwards448
+
+import (
+	"crypto/internal/edwards448/field"
+	"errors"
+)
+
+// Point represents a point on the edwards448 curve.
+//
+// This type works similarly to math/big.Int, and all arguments and receivers
+// are allowed to alias.
+//
+// The zero value is NOT valid, and it may be used only as a receiver.
+type Point struct {
+	// The point is internally represented in extended coordinates (X, Y, Z, T)
+	// where x = X/Z, y = Y/Z, and xy = T/Z per https://eprint.iacr.org/2008/522.
+	x, y, z, t field.Element
+
+	// Make the type not comparable (i.e. used with == or as a map key), as
+	// equivalent points can be represented by different Go values.
+	_ incomparable
+}
+
+type incomparable [0]func()
+
+func checkInitialized(points ...*Point) {
+	for _, p := range points {
+		if p.x == (field.Element{}) && p.y == (field.Element{}) {
+			panic("edwards448: use of uninitialized Point")
+		}
+	}
+}
+
+var feOne = new(field.Element).One()
+
+// d is the curve parameter -39081.
+var d = new(field.Element).Negate(new(field.Element).Mult32(feOne, 39081))
+
+var identity = &Point{
+	y: *feOne,
+	z: *feOne,
+}
+
+// NewIdentityPoint returns a new Point set to the identity.
+func NewIdentityPoint() *Point {
+	return new(Point).Set(identity)
+}
+
+var generator, _ = new(Point).SetBytes([]byte{
+	0x14, 0xfa, 0x30, 0xf2, 0x5b, 0x79, 0x08, 0x98, 0xad, 0xc8, 0xd7, 0x4e,
+	0x2c, 0x13, 0xbd, 0xfd, 0xc4, 0x39, 0x7c, 0xe6, 0x1c, 0xff, 0xd3, 0x3a,
+	0xd7, 0xc2, 0xa0, 0x05, 0x1e, 0x9c, 0x78, 0x87, 0x40, 0x98, 0xa3, 0x6c,
+	0x73, 0x73, 0xea, 0x4b, 0x62, 0xc7, 0xc9, 0x56, 0x37, 0x20, 0x76, 0x88,
+	0x24, 0xbc, 0xb6, 0x6e, 0x71, 0x46, 0x3f, 0x69, 0x00})
+
+// NewGeneratorPoint returns a new Point set to the canonical generator, as
+// defined in RFC 8032, Section 5.2.
+func NewGeneratorPoint() *Point {
+	return new(Point).Set(generator)
+}
+
+// Set sets v = u, and returns v.
+func (v *Point) Set(u *Point) *Point {
+	*v = *u
+	return v
+}
+
+// Encoding.
+
+// Bytes returns the canonical 57-byte encoding of v, according to RFC 8032,
+// Section 5.2.2.
+func (v *Point) Bytes() []byte {
+	// This function is outlined to make the allocations inline in the caller
+	// rather than happen on the heap.
+	var buf [57]byte
+	return v.bytes(&buf)
+}
+
+func (v *Point) bytes(buf *[57]byte) []byte {
+	checkInitialized(v)
+
+	var zInv, x, y field.Element
+	zInv.Invert(&v.z)       // zInv = 1 / Z
+	x.Multiply(&v.x, &zInv) // x = X / Z
+	y.Multiply(&v.y, &zInv) // y = Y / Z
+
+	copy(buf[:56], y.Bytes())
+	buf[56] = byte(x.IsNegative() << 7)
+	return buf[:]
+}
+
+// SetBytes sets v = x, where x is a 57-byte encoding of v. If x does not
+// represent a valid point on the curve, SetBytes returns nil and an error and
+// the receiver is unchanged. Otherwise, SetBytes returns v.
+//
+// Unlike the edwards25519 encoding, non-canonical encodings of the
+// y-coordinate are rejected, as required by RFC 8032, Section 5.2.3.
+func (v *Point) SetBytes(x []byte) (*Point, error) {
+	if len(x) != 57 || x[56]&0x7f != 0 {
+		return nil, errors.New("edwards448: invalid point encoding")
+	}
+	y, err := new(field.Element).SetBytes(x[:56])
+	if err != nil {
+		return nil, errors.New("edwards448: invalid point encoding")
+	}
+	if string(y.Bytes()) != string(x[:56]) {
+		return nil, errors.New("edwards448: invalid point encoding")
+	}
+
+	// x² + y² = 1 + dx²y²
+	// x² - dx²y² = x²(1 - dy²) = 1 - y²
+	// x² = (y² - 1) / (dy² - 1)
+
+	// u = y² - 1
+	y2 := new(field.Element).Square(y)
+	u := new(field.Element).Subtract(y2, feOne)
+
+	// v = dy² - 1
+	vv := new(field.Element).Multiply(y2, d)
+	vv = vv.Subtract(vv, feOne)
+
+	// x = +√(u/v)
+	xx, wasSquare := new(field.Element).SqrtRatio(u, vv)
+	if wasSquare == 0 {
+		return nil, errors.New("edwards448: invalid point encoding")
+	}
+
+	// Select the negative square root if the sign bit is set.
+	xxNeg := new(field.Element).Negate(xx)
+	xx = xx.Select(xxNeg, xx, int(x[56]>>7))
+	if xx.IsZero() == 1 && x[56]>>7 == 1 {
+		return nil, errors.New("edwards448: invalid point encoding")
+	}
+
+	v.x.Set(xx)
+	v.y.Set(y)
+	v.z.One()
+	v.t.Multiply(xx, y) // xy = T / Z
+
+	return v, nil
+}
+
+// Group operations.
+
+// Add sets v = p + q, and returns v.
+func (v *Point) Add(p, q *Point) *Point {
+	checkInitialized(p, q)
+
+	// This is the add-2008-hwcd formula with a = 1, which is complete for
+	// edwards448 because d is not a square.
+	var a, b, c, dd, e, f, g, h, t0 field.Element
+	a.Multiply(&p.x, &q.x)
+	b.Multiply(&p.y, &q.y)
+	c.Multiply(&p.t, &q.t)
+	c.Multiply(&c, d)
+	dd.Multiply(&p.z, &q.z)
+	e.Add(&p.x, &p.y)
+	t0.Add(&q.x, &q.y)
+	e.Multiply(&e, &t0)
+	e.Subtract(&e, &a)
+	e.Subtract(&e, &b)
+	f.Subtract(&dd, &c)
+	g.Add(&dd, &c)
+	h.Subtract(&b, &a)
+
+	v.x.Multiply(&e, &f)
+	v.y.Multiply(&g, &h)
+	v.t.Multiply(&e, &h)
+	v.z.Multiply(&f, &g)
+	return v
+}
+
+// Subtract sets v = p - q, and returns v.
+func (v *Point) Subtract(p, q *Point) *Point {
+	checkInitialized(p, q)
+	var qNeg Point
+	return v.Add(p, qNeg.Negate(q))
+}
+
+// Double sets v = p + p, and returns v.
+func (v *Point) Double(p *Point) *Point {
+	checkInitialized(p)
+
+	// This is the dbl-2008-hwcd formula with a = 1.
+	var a, b, c, e, f, g, h field.Element
+	a.Square(&p.x)
+	b.Square(&p.y)
+	c.Square(&p.z)
+	c.Add(&c, &c)
+	e.Add(&p.x, &p.y)
+	e.Square(&e)
+	e.Subtract(&e, &a)
+	e.Subtract(&e, &b)
+	g.Add(&a, &b)
+	f.Subtract(&g, &c)
+	h.Subtract(&a, &b)
+
+	v.x.Multiply(&e, &f)
+	v.y.Multiply(&g, &h)
+	v.t.Multiply(&e, &h)
+	v.z.Multiply(&f, &g)
+	return v
+}
+
+// Negate sets v = -p, and returns v.
+func (v *Point) Negate(p *Point) *Point {
+	checkInitialized(p)
+	v.x.Negate(&p.x)
+	v.y.Set(&p.y)
+	v.z.Set(&p.z)
+	v.t.Negate(&p.t)
+	return v
+}
+
+// Equal returns 1 if v is equivalent to u, and 0 otherwise.
+func (v *Point) Equal(u *Point) int {
+	checkInitialized(v, u)
+
+	var t1, t2, t3, t4 field.Element
+	t1.Multiply(&v.x, &u.z)
+	t2.Multiply(&u.x, &v.z)
+	t3.Multiply(&v.y, &u.z)
+	t4.Multiply(&u.y, &v.z)
+
+	return t1.Equal(&t2) & t3.Equal(&t4)
+}
+
+// Select sets v to a if cond == 1 and to b if cond == 0.
+func (v *Point) Select(a, b *Point, cond int) *Point {
+	v.x.Select(&a.x, &b.x, cond)
+	v.y.Select(&a.y, &b.y, cond)
+	v.z.Select(&a.z, &b.z, cond)
+	v.t.Select(&a.t, &b.t, cond)
+	return v
+}
@@ -0,0 +1,180 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package edwards448
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"math/big"
+	"testing"
+)
+
+var bigL, _ = new(big.Int).SetString("3fffffffffffffffffffffffffffffffffffffffffffffffffffffff"+
+	"7cca23e9c44edb49aed63690216cc2728dc58f552378c292ab5844f3", 16)
+
+func scalarFromBig(n *big.Int) *Scalar {
+	var buf [57]byte
+	n = new(big.Int).Mod(n, bigL)
+	n.FillBytes(buf[1:])
+	reverse(buf[:], append([]byte{}, buf[:]...))
+	s, err := NewScalar().SetCanonicalBytes(buf[:])
+	if err != nil {
+		panic(err)
+	}
+	return s
+}
+
+func scalarToBig(s *Scalar) *big.Int {
+	b := s.Bytes()
+	var be [57]byte
+	reverse(be[:], b)
+	return new(big.Int).SetBytes(be[:])
+}
+
+func randomScalar(t *testing.T) *Scalar {
+	var buf [114]byte
+	rand.Read(buf[:])
+	s, err := NewScalar().SetUniformBytes(buf[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+func TestGenerator(t *testing.T) {
+	B := NewGeneratorPoint()
+	if B.Equal(identity) == 1 {
+		t.Fatal("generator is the identity")
+	}
+
+	// (l - 1) * B = -B
+	lMinusOne := scalarFromBig(new(big.Int).Sub(bigL, big.NewInt(1)))
+	p := new(Point).ScalarBaseMult(lMinusOne)
+	if p.Equal(new(Point).Negate(B)) != 1 {
+		t.Error("(l - 1) * B != -B")
+	}
+	if new(Point).Add(p, B).Equal(identity) != 1 {
+		t.Error("l * B != identity")
+	}
+}
+
+func TestPointEncoding(t *testing.T) {
+	id := NewIdentityPoint().Bytes()
+	want := make([]byte, 57)
+	want[0] = 1
+	if !bytes.Equal(id, want) {
+		t.Errorf("identity encoding = %x", id)
+	}
+
+	for i := 0; i < 10; i++ {
+		p := new(Point).ScalarBaseMult(randomScalar(t))
+		enc := p.Bytes()
+		q, err := new(Point).SetBytes(enc)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if q.Equal(p) != 1 || !bytes.Equal(q.Bytes(), enc) {
+			t.Errorf("round trip of %x failed", enc)
+		}
+	}
+
+	invalid := []string{
+		// Bad length.
+		"",
+		"01",
+		// Non-canonical y = p.
+		"feffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff00",
+		// Unused bits set in the last byte.
+		"0100000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000001",
+		// x = 0 with the sign bit set.
+		"0100000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000080",
+		// y = 2 is not on the curve.
+		"0200000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000",
+	}
+	for _, s := range invalid {
+		b, _ := hex.DecodeString(s)
+		if _, err := new(Point).SetBytes(b); err == nil {
+			t.Errorf("SetBytes(%s) unexpectedly succeeded", s)
+		}
+	}
+}
+
+func TestGroupLaw(t *testing.T) {
+	a, b := randomScalar(t), randomScalar(t)
+	A := new(Point).ScalarBaseMult(a)
+	B := new(Point).ScalarBaseMult(b)
+
+	// a * B + b * B = (a + b) * B
+	sum := new(Point).Add(A, B)
+	if sum.Equal(new(Point).ScalarBaseMult(NewScalar().Add(a, b))) != 1 {
+		t.Error("a * B + b * B != (a + b) * B")
+	}
+
+	// a * (b * B) = (a * b) * B
+	ab := new(Point).ScalarMult(a, B)
+	if ab.Equal(new(Point).ScalarBaseMult(NewScalar().Multiply(a, b))) != 1 {
+		t.Error("a * (b * B) != (a * b) * B")
+	}
+
+	// A + A = 2 * A
+	two := scalarFromBig(big.NewInt(2))
+	if new(Point).Double(A).Equal(new(Point).ScalarMult(two, A)) != 1 {
+		t.Error("A + A != 2 * A")
+	}
+	if new(Point).Double(A).Equal(new(Point).Add(A, A)) != 1 {
+		t.Error("Double(A) != Add(A, A)")
+	}
+
+	// A - A = identity
+	if new(Point).Subtract(A, A).Equal(identity) != 1 {
+		t.Error("A - A != identity")
+	}
+
+	// a * A + b * B
+	got := new(Point).VarTimeDoubleScalarBaseMult(a, A, b)
+	want := new(Point).Add(new(Point).ScalarMult(a, A), B)
+	if got.Equal(want) != 1 {
+		t.Error("VarTimeDoubleScalarBaseMult mismatch")
+	}
+}
+
+func TestScalar(t *testing.T) {
+	for i := 0; i < 100; i++ {
+		x, y, z := randomScalar(t), randomScalar(t), randomScalar(t)
+		got := scalarToBig(NewScalar().MultiplyAdd(x, y, z))
+		want := new(big.Int).Mul(scalarToBig(x), scalarToBig(y))
+		want.Add(want, scalarToBig(z))
+		want.Mod(want, bigL)
+		if got.Cmp(want) != 0 {
+			t.Fatalf("MultiplyAdd: got %v, want %v", got, want)
+		}
+	}
+
+	var wide [114]byte
+	for i := range wide {
+		wide[i] = 0xff
+	}
+	s, err := NewScalar().SetUniformBytes(wide[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 912), big.NewInt(1))
+	if scalarToBig(s).Cmp(want.Mod(want, bigL)) != 0 {
+		t.Error("SetUniformBytes did not reduce correctly")
+	}
+
+	var l [57]byte
+	bigL.FillBytes(l[1:])
+	reverse(l[:], append([]byte{}, l[:]...))
+	if _, err := NewScalar().SetCanonicalBytes(l[:]); err == nil {
+		t.Error("SetCanonicalBytes accepted l")
+	}
+
+	var zero Scalar
+	if zero.Equal(NewScalar()) != 1 || !bytes.Equal(zero.Bytes(), make([]byte, 57)) {
+		t.Error("zero value is not zero")
+	}
+}
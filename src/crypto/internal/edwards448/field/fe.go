@@ -0,0 +1,378 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package field implements arithmetic modulo 2^448 - 2^224 - 1, the prime
+// underlying Curve448 and Ed448-Goldilocks.
+package field
+
+import (
+	"crypto/subtle"
+	"errors"
+	"math/bits"
+)
+
+// Element represents an element of the field GF(2^448 - 2^224 - 1). Note
+// that this is not a cryptographically secure group, and should only be used
+// to interact with edwards448.Point coordinates and X448 values.
+//
+// This type works similarly to math/big.Int, and all arguments and receivers
+// are allowed to alias.
+//
+// The zero value is a valid zero element.
+type Element struct {
+	// An element t represents the integer
+	//     t.l[0] + t.l[1]*2^56 + t.l[2]*2^112 + ... + t.l[7]*2^392
+	//
+	// Between operations, all limbs are expected to be lower than 2^57.
+	l [8]uint64
+}
+
+const maskLow56Bits uint64 = (1 << 56) - 1
+
+var feZero = &Element{}
+
+// Zero sets v = 0, and returns v.
+func (v *Element) Zero() *Element {
+	*v = *feZero
+	return v
+}
+
+var feOne = &Element{[8]uint64{1}}
+
+// One sets v = 1, and returns v.
+func (v *Element) One() *Element {
+	*v = *feOne
+	return v
+}
+
+// carryPropagate brings the limbs below 2^56 + 2^9, applying the reduction
+// identity 2^448 = 2^224 + 1 to the carry out of the top limb.
+func (v *Element) carryPropagate() *Element {
+	var c uint64
+	for i := 0; i < 8; i++ {
+		v.l[i] += c
+		c = v.l[i] >> 56
+		v.l[i] &= maskLow56Bits
+	}
+	v.l[0] += c
+	v.l[4] += c
+	return v
+}
+
+// reduce reduces v modulo 2^448 - 2^224 - 1 and returns it.
+func (v *Element) reduce() *Element {
+	// Three carry passes are enough to bring all limbs strictly below 2^56,
+	// so that v < 2^448: the first leaves a carry of at most 2^9 into limbs
+	// 0 and 4, the second a carry of at most 1, and the third can only
+	// ripple that carry up through limbs that are already small.
+	v.carryPropagate()
+	v.carryPropagate()
+	v.carryPropagate()
+
+	// Now v < 2^448 < 2p, so a single conditional subtraction of p is enough.
+	var t [8]uint64
+	var b uint64
+	for i := 0; i < 8; i++ {
+		p := maskLow56Bits
+		if i == 4 {
+			p--
+		}
+		t[i] = v.l[i] - p - b
+		b = t[i] >> 63
+		t[i] &= maskLow56Bits
+	}
+	// If b is 1, v < p and we keep v, otherwise we use t = v - p.
+	m := b - 1
+	for i := range v.l {
+		v.l[i] = (t[i] & m) | (v.l[i] &^ m)
+	}
+	return v
+}
+
+// Add sets v = a + b, and returns v.
+func (v *Element) Add(a, b *Element) *Element {
+	for i := range v.l {
+		v.l[i] = a.l[i] + b.l[i]
+	}
+	return v.carryPropagate()
+}
+
+// Subtract sets v = a - b, and returns v.
+func (v *Element) Subtract(a, b *Element) *Element {
+	// We first add 4 * p, to guarantee the subtraction won't underflow, and
+	// then subtract b (which can be up to 2^57 per limb).
+	for i := range v.l {
+		p4 := uint64(0x3fffffffffffffc)
+		if i == 4 {
+			p4 = 0x3fffffffffffff8
+		}
+		v.l[i] = a.l[i] + p4 - b.l[i]
+	}
+	return v.carryPropagate()
+}
+
+// Negate sets v = -a, and returns v.
+func (v *Element) Negate(a *Element) *Element {
+	return v.Subtract(feZero, a)
+}
+
+// pow2k sets v = x^(2^k), and returns v. k must be positive.
+func (v *Element) pow2k(x *Element, k int) *Element {
+	v.Square(x)
+	for i := 1; i < k; i++ {
+		v.Square(v)
+	}
+	return v
+}
+
+// powChain sets z222 = x^(2^222 - 1) and z223 = x^(2^223 - 1), the
+// building blocks of the exponents (p - 2) and (p - 3) / 4.
+func powChain(z222, z223, x *Element) {
+	var z2, z3, z6, z12, z24, z30, z48, z96, z192, t Element
+
+	z2.Square(x)
+	z2.Multiply(&z2, x) // 2^2 - 1
+	z3.Square(&z2)
+	z3.Multiply(&z3, x) // 2^3 - 1
+	t.pow2k(&z3, 3)
+	z6.Multiply(&t, &z3) // 2^6 - 1
+	t.pow2k(&z6, 6)
+	z12.Multiply(&t, &z6) // 2^12 - 1
+	t.pow2k(&z12, 12)
+	z24.Multiply(&t, &z12) // 2^24 - 1
+	t.pow2k(&z24, 6)
+	z30.Multiply(&t, &z6) // 2^30 - 1
+	t.pow2k(&z24, 24)
+	z48.Multiply(&t, &z24) // 2^48 - 1
+	t.pow2k(&z48, 48)
+	z96.Multiply(&t, &z48) // 2^96 - 1
+	t.pow2k(&z96, 96)
+	z192.Multiply(&t, &z96) // 2^192 - 1
+	t.pow2k(&z192, 30)
+	z222.Multiply(&t, &z30) // 2^222 - 1
+	t.Square(z222)
+	z223.Multiply(&t, x) // 2^223 - 1
+}
+
+// Invert sets v = 1/z mod p, and returns v.
+//
+// If z == 0, Invert returns v = 0.
+func (v *Element) Invert(z *Element) *Element {
+	// Inversion is implemented as exponentiation with exponent
+	// p - 2 = 2^448 - 2^224 - 3 = (2^223 - 1) * 2^225 + (2^222 - 1) * 4 + 1.
+	var z222, z223, t Element
+	powChain(&z222, &z223, z)
+	t.pow2k(&z223, 225)
+	z222.pow2k(&z222, 2)
+	t.Multiply(&t, &z222)
+	return v.Multiply(&t, z)
+}
+
+// Set sets v = a, and returns v.
+func (v *Element) Set(a *Element) *Element {
+	*v = *a
+	return v
+}
+
+// SetBytes sets v to x, where x is a 56-byte little-endian encoding. If x is
+// not of the right length, SetBytes returns nil and an error, and the
+// receiver is unchanged.
+//
+// Consistent with RFC 7748, non-canonical values (2^448 - 2^224 - 1 through
+// 2^448 - 1) are accepted and reduced.
+func (v *Element) SetBytes(x []byte) (*Element, error) {
+	if len(x) != 56 {
+		return nil, errors.New("edwards448: invalid field element input size")
+	}
+	for i := range v.l {
+		var limb uint64
+		for j := 6; j >= 0; j-- {
+			limb = limb<<8 | uint64(x[i*7+j])
+		}
+		v.l[i] = limb
+	}
+	return v, nil
+}
+
+// Bytes returns the canonical 56-byte little-endian encoding of v.
+func (v *Element) Bytes() []byte {
+	// This function is outlined to make the allocations inline in the caller
+	// rather than happen on the heap.
+	var out [56]byte
+	return v.bytes(&out)
+}
+
+func (v *Element) bytes(out *[56]byte) []byte {
+	t := *v
+	t.reduce()
+	for i, l := range t.l {
+		for j := 0; j < 7; j++ {
+			out[i*7+j] = byte(l >> (8 * j))
+		}
+	}
+	return out[:]
+}
+
+// Equal returns 1 if v and u are equal, and 0 otherwise.
+func (v *Element) Equal(u *Element) int {
+	sa, sv := u.Bytes(), v.Bytes()
+	return subtle.ConstantTimeCompare(sa, sv)
+}
+
+// IsZero returns 1 if v is zero, and 0 otherwise.
+func (v *Element) IsZero() int {
+	return v.Equal(feZero)
+}
+
+// mask64Bits returns 0xffffffff if cond is 1, and 0 otherwise.
+func mask64Bits(cond int) uint64 { return ^(uint64(cond) - 1) }
+
+// Select sets v to a if cond == 1, and to b if cond == 0.
+func (v *Element) Select(a, b *Element, cond int) *Element {
+	m := mask64Bits(cond)
+	for i := range v.l {
+		v.l[i] = (m & a.l[i]) | (^m & b.l[i])
+	}
+	return v
+}
+
+// Swap swaps v and u if cond == 1 or leaves them unchanged if cond == 0, and returns v.
+func (v *Element) Swap(u *Element, cond int) {
+	m := mask64Bits(cond)
+	for i := range v.l {
+		t := m & (v.l[i] ^ u.l[i])
+		v.l[i] ^= t
+		u.l[i] ^= t
+	}
+}
+
+// IsNegative returns 1 if v is negative, and 0 otherwise, where negative
+// field elements are defined as the odd ones, as in RFC 8032.
+func (v *Element) IsNegative() int {
+	return int(v.Bytes()[0] & 1)
+}
+
+// Absolute sets v to |u|, and returns v.
+func (v *Element) Absolute(u *Element) *Element {
+	return v.Select(new(Element).Negate(u), u, u.IsNegative())
+}
+
+// uint128 holds a 128-bit number as two 64-bit limbs, for use with the
+// bits.Mul64 and bits.Add64 intrinsics.
+type uint128 struct {
+	lo, hi uint64
+}
+
+// addMul64 returns v + a * b.
+func addMul64(v uint128, a, b uint64) uint128 {
+	hi, lo := bits.Mul64(a, b)
+	lo, c := bits.Add64(lo, v.lo, 0)
+	hi, _ = bits.Add64(hi, v.hi, c)
+	return uint128{lo, hi}
+}
+
+// add128 returns a + b.
+func add128(a, b uint128) uint128 {
+	lo, c := bits.Add64(a.lo, b.lo, 0)
+	hi, _ := bits.Add64(a.hi, b.hi, c)
+	return uint128{lo, hi}
+}
+
+// shiftRightBy56 returns a >> 56. a is assumed to be at most 120 bits.
+func shiftRightBy56(a uint128) uint64 {
+	return (a.hi << (64 - 56)) | (a.lo >> 56)
+}
+
+// Multiply sets v = x * y, and returns v.
+func (v *Element) Multiply(x, y *Element) *Element {
+	// With limbs below 2^57, each of the 15 schoolbook coefficients is the
+	// sum of at most eight products below 2^114, so it fits in 117 bits.
+	var c [15]uint128
+	for i := 0; i < 8; i++ {
+		for j := 0; j < 8; j++ {
+			c[i+j] = addMul64(c[i+j], x.l[i], y.l[j])
+		}
+	}
+
+	// Fold the upper coefficients using 2^448 = 2^224 + 1, that is, the
+	// coefficient of 2^(56*k) for k >= 8 is added to k-8 and k-4. Folding
+	// from the top down handles coefficients that are folded twice. The
+	// results stay below 2^120.
+	for k := 14; k >= 8; k-- {
+		c[k-8] = add128(c[k-8], c[k])
+		c[k-4] = add128(c[k-4], c[k])
+	}
+
+	// Carry the eight remaining coefficients into 56-bit limbs.
+	var carry uint64
+	for i := 0; i < 8; i++ {
+		c[i] = add128(c[i], uint128{carry, 0})
+		carry = shiftRightBy56(c[i])
+		v.l[i] = c[i].lo & maskLow56Bits
+	}
+
+	// The final carry is below 2^64, and is folded once more into limbs 0
+	// and 4 before a last, short carry chain.
+	c0 := add128(uint128{v.l[0], 0}, uint128{carry, 0})
+	c4 := add128(uint128{v.l[4], 0}, uint128{carry, 0})
+	v.l[0] = c0.lo & maskLow56Bits
+	v.l[1] += shiftRightBy56(c0)
+	v.l[4] = c4.lo & maskLow56Bits
+	v.l[5] += shiftRightBy56(c4)
+	return v.carryPropagate()
+}
+
+// Square sets v = x * x, and returns v.
+func (v *Element) Square(x *Element) *Element {
+	return v.Multiply(x, x)
+}
+
+// Mult32 sets v = x * y, and returns v.
+func (v *Element) Mult32(x *Element, y uint32) *Element {
+	var carry uint64
+	for i := 0; i < 8; i++ {
+		c := addMul64(uint128{carry, 0}, x.l[i], uint64(y))
+		carry = shiftRightBy56(c)
+		v.l[i] = c.lo & maskLow56Bits
+	}
+	// The final carry is below 2^33, so it can be folded directly.
+	v.l[0] += carry
+	v.l[4] += carry
+	return v.carryPropagate()
+}
+
+// SqrtRatio sets r to the non-negative square root of the ratio of u and v.
+//
+// If u/v is square, SqrtRatio returns r and 1. If u/v is not square,
+// SqrtRatio sets r as according to RFC 8032, Section 5.2.3, and returns r
+// and 0. In particular, if v is zero, SqrtRatio returns r = 0 and 0.
+func (r *Element) SqrtRatio(u, v *Element) (R *Element, wasSquare int) {
+	// Since p = 3 mod 4, the candidate root is
+	//
+	//     x = (u/v)^((p+1)/4) = u^3 * v * (u^5 * v^3)^((p-3)/4)
+	//
+	// where (p-3)/4 = 2^446 - 2^222 - 1 = (2^223 - 1) * 2^223 + (2^222 - 1).
+	var u2, u3, u5, v3, t, z222, z223, x, check Element
+	u2.Square(u)
+	u3.Multiply(&u2, u)
+	u5.Multiply(&u3, &u2)
+	v3.Square(v)
+	v3.Multiply(&v3, v)
+	t.Multiply(&u5, &v3) // u^5 * v^3
+
+	powChain(&z222, &z223, &t)
+	z223.pow2k(&z223, 223)
+	t.Multiply(&z223, &z222) // (u^5 * v^3)^((p-3)/4)
+
+	x.Multiply(&u3, v)
+	x.Multiply(&x, &t)
+
+	// If v * x^2 = u, x is a square root of u/v.
+	check.Square(&x)
+	check.Multiply(&check, v)
+	wasSquare = check.Equal(u) & (1 ^ v.IsZero())
+
+	r.Absolute(&x)
+	return r, wasSquare
+}
@@ -0,0 +1,275 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package field
+
+import (
+	"bytes"
+	"encoding/hex"
+	"math/big"
+	mathrand "math/rand"
+	"reflect"
+	"testing"
+	"testing/quick"
+)
+
+func (v Element) String() string {
+	return hex.EncodeToString(v.Bytes())
+}
+
+// quickCheckConfig returns a quick.Config that scales the max count by the
+// given factor if the -short flag is not set.
+func quickCheckConfig(slowScale int) *quick.Config {
+	cfg := new(quick.Config)
+	if !testing.Short() {
+		cfg.MaxCountScale = float64(slowScale)
+	}
+	return cfg
+}
+
+// weirdLimbs can be combined to generate a range of edge-case field elements.
+// 0 and -1 are intentionally more weighted, as they combine well.
+var weirdLimbs = []uint64{
+	0, 0, 0, 0,
+	1,
+	2,
+	0xaaaaaaaaaaaaaa,
+	0x55555555555555,
+	(1 << 56) - 2,
+	(1 << 56) - 1, (1 << 56) - 1,
+	(1 << 56) - 1, (1 << 56) - 1,
+	1 << 56,
+	(1 << 56) + 1,
+	(1 << 57) - 1,
+}
+
+// Generate generates random field elements, with limbs below 2^57 as
+// expected between operations, and with a bias towards edge cases.
+func (Element) Generate(rand *mathrand.Rand, size int) reflect.Value {
+	var v Element
+	if rand.Intn(2) == 0 {
+		for i := range v.l {
+			v.l[i] = weirdLimbs[rand.Intn(len(weirdLimbs))]
+		}
+	} else {
+		for i := range v.l {
+			v.l[i] = rand.Uint64() & (1<<57 - 1)
+		}
+	}
+	return reflect.ValueOf(v)
+}
+
+var bigP = new(big.Int).Sub(new(big.Int).Sub(
+	new(big.Int).Lsh(big.NewInt(1), 448), new(big.Int).Lsh(big.NewInt(1), 224)), big.NewInt(1))
+
+// toBig returns v as a big.Int, without reducing it.
+func (v *Element) toBig() *big.Int {
+	n := new(big.Int)
+	for i := len(v.l) - 1; i >= 0; i-- {
+		n.Lsh(n, 56)
+		n.Add(n, new(big.Int).SetUint64(v.l[i]))
+	}
+	return n
+}
+
+func fromBig(n *big.Int) *Element {
+	var buf [56]byte
+	n = new(big.Int).Mod(n, bigP)
+	n.FillBytes(buf[:])
+	for i, j := 0, len(buf)-1; i < j; i, j = i+1, j-1 {
+		buf[i], buf[j] = buf[j], buf[i]
+	}
+	v, err := new(Element).SetBytes(buf[:])
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func isInBounds(v *Element) bool {
+	for _, l := range v.l {
+		if l >= 1<<57 {
+			return false
+		}
+	}
+	return true
+}
+
+func checkBig(v *Element, want *big.Int) bool {
+	want = new(big.Int).Mod(want, bigP)
+	got := new(big.Int).Mod(v.toBig(), bigP)
+	return isInBounds(v) && got.Cmp(want) == 0
+}
+
+func TestArithmetic(t *testing.T) {
+	add := func(a, b Element) bool {
+		v := new(Element).Add(&a, &b)
+		return checkBig(v, new(big.Int).Add(a.toBig(), b.toBig()))
+	}
+	if err := quick.Check(add, quickCheckConfig(1024)); err != nil {
+		t.Errorf("Add: %v", err)
+	}
+
+	sub := func(a, b Element) bool {
+		v := new(Element).Subtract(&a, &b)
+		return checkBig(v, new(big.Int).Sub(a.toBig(), b.toBig()))
+	}
+	if err := quick.Check(sub, quickCheckConfig(1024)); err != nil {
+		t.Errorf("Subtract: %v", err)
+	}
+
+	mul := func(a, b Element) bool {
+		v := new(Element).Multiply(&a, &b)
+		return checkBig(v, new(big.Int).Mul(a.toBig(), b.toBig()))
+	}
+	if err := quick.Check(mul, quickCheckConfig(1024)); err != nil {
+		t.Errorf("Multiply: %v", err)
+	}
+
+	sq := func(a Element) bool {
+		v := new(Element).Square(&a)
+		return checkBig(v, new(big.Int).Mul(a.toBig(), a.toBig()))
+	}
+	if err := quick.Check(sq, quickCheckConfig(1024)); err != nil {
+		t.Errorf("Square: %v", err)
+	}
+
+	neg := func(a Element) bool {
+		v := new(Element).Negate(&a)
+		return checkBig(v, new(big.Int).Neg(a.toBig()))
+	}
+	if err := quick.Check(neg, quickCheckConfig(1024)); err != nil {
+		t.Errorf("Negate: %v", err)
+	}
+}
+
+func TestAliasing(t *testing.T) {
+	mul := func(a, b Element) bool {
+		want := new(Element).Multiply(&a, &b)
+		a.Multiply(&a, &b)
+		return a.Equal(want) == 1
+	}
+	if err := quick.Check(mul, quickCheckConfig(64)); err != nil {
+		t.Error(err)
+	}
+	sub := func(a, b Element) bool {
+		want := new(Element).Subtract(&a, &b)
+		b.Subtract(&a, &b)
+		return b.Equal(want) == 1
+	}
+	if err := quick.Check(sub, quickCheckConfig(64)); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestBytesRoundTrip(t *testing.T) {
+	f := func(a Element) bool {
+		b := a.Bytes()
+		v, err := new(Element).SetBytes(b)
+		if err != nil {
+			return false
+		}
+		return bytes.Equal(v.Bytes(), b) && a.Equal(v) == 1 &&
+			new(big.Int).Mod(a.toBig(), bigP).Cmp(v.toBig()) == 0
+	}
+	if err := quick.Check(f, quickCheckConfig(1024)); err != nil {
+		t.Error(err)
+	}
+
+	// Non-canonical encodings are reduced.
+	var buf [56]byte
+	for i := range buf {
+		buf[i] = 0xff
+	}
+	v, err := new(Element).SetBytes(buf[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !checkBig(v, new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 448), big.NewInt(1))) {
+		t.Errorf("SetBytes(ff...ff) = %v", v)
+	}
+	p := bigP.Bytes()
+	for i := range buf {
+		buf[i] = p[len(p)-1-i]
+	}
+	v, _ = new(Element).SetBytes(buf[:])
+	if v.IsZero() != 1 {
+		t.Errorf("SetBytes(p) = %v, want 0", v)
+	}
+
+	if _, err := new(Element).SetBytes(buf[:55]); err == nil {
+		t.Error("SetBytes accepted a short input")
+	}
+}
+
+func TestInvert(t *testing.T) {
+	f := func(a Element) bool {
+		if a.IsZero() == 1 {
+			return new(Element).Invert(&a).IsZero() == 1
+		}
+		inv := new(Element).Invert(&a)
+		return new(Element).Multiply(inv, &a).Equal(feOne) == 1
+	}
+	if err := quick.Check(f, quickCheckConfig(16)); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestSelectSwap(t *testing.T) {
+	a := fromBig(big.NewInt(358744748052810))
+	b := fromBig(big.NewInt(1024))
+
+	var c, d Element
+	c.Select(a, b, 1)
+	d.Select(a, b, 0)
+	if c.Equal(a) != 1 || d.Equal(b) != 1 {
+		t.Errorf("Select failed")
+	}
+
+	c.Swap(&d, 0)
+	if c.Equal(a) != 1 || d.Equal(b) != 1 {
+		t.Errorf("Swap failed")
+	}
+	c.Swap(&d, 1)
+	if c.Equal(b) != 1 || d.Equal(a) != 1 {
+		t.Errorf("Swap failed")
+	}
+}
+
+func TestSqrtRatio(t *testing.T) {
+	f := func(u, v Element) bool {
+		r, wasSquare := new(Element).SqrtRatio(&u, &v)
+		if r.IsNegative() != 0 {
+			return false
+		}
+		ratio := new(big.Int).Mod(u.toBig(), bigP)
+		if vb := new(big.Int).Mod(v.toBig(), bigP); vb.Sign() == 0 {
+			return wasSquare == 0 && r.IsZero() == 1
+		} else {
+			ratio.Mul(ratio, new(big.Int).ModInverse(vb, bigP))
+			ratio.Mod(ratio, bigP)
+		}
+		isSquare := ratio.Sign() == 0 || big.Jacobi(ratio, bigP) == 1
+		if isSquare != (wasSquare == 1) {
+			return false
+		}
+		if !isSquare {
+			return true
+		}
+		return checkBig(new(Element).Square(r), ratio)
+	}
+	if err := quick.Check(f, quickCheckConfig(16)); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestMult32(t *testing.T) {
+	f := func(a Element, y uint32) bool {
+		v := new(Element).Mult32(&a, y)
+		return checkBig(v, new(big.Int).Mul(a.toBig(), big.NewInt(int64(y))))
+	}
+	if err := quick.Check(f, quickCheckConfig(1024)); err != nil {
+		t.Error(err)
+	}
+}
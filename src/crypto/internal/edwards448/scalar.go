@@ -0,0 +1,191 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package edwards448
+
+import (
+	"crypto/internal/bigmod"
+	"errors"
+	"math/big"
+)
+
+// A Scalar is an integer modulo
+//
+//	l = 2^446 - 13818066809895115352007386748515426880336692474882178609894547503885
+//
+// which is the prime order of the edwards448 group.
+//
+// This type works similarly to math/big.Int, and all arguments and
+// receivers are allowed to alias.
+//
+// The zero value is a valid zero element.
+type Scalar struct {
+	// n is the scalar as a bigmod.Nat sized for scalarOrder, or nil for zero.
+	n *bigmod.Nat
+}
+
+var scalarOrder = mustModulus("3fffffffffffffffffffffffffffffffffffffffffffffffffffffff" +
+	"7cca23e9c44edb49aed63690216cc2728dc58f552378c292ab5844f3")
+
+// wideModulus is 2^912 + 1, a modulus larger than any 114-byte value, used
+// to load wide inputs before reducing them modulo l.
+var wideModulus = mustModulus("01" + zeroes(113) + "01")
+
+func zeroes(n int) string {
+	b := make([]byte, 2*n)
+	for i := range b {
+		b[i] = '0'
+	}
+	return string(b)
+}
+
+func mustModulus(s string) *bigmod.Modulus {
+	n, ok := new(big.Int).SetString(s, 16)
+	if !ok {
+		panic("edwards448: internal error: invalid modulus")
+	}
+	m, err := bigmod.NewModulusFromBig(n)
+	if err != nil {
+		panic("edwards448: internal error: invalid modulus")
+	}
+	return m
+}
+
+// NewScalar returns a new zero Scalar.
+func NewScalar() *Scalar {
+	return &Scalar{}
+}
+
+// nat returns s.n, initializing it to zero if necessary.
+func (s *Scalar) nat() *bigmod.Nat {
+	if s.n == nil {
+		s.n = bigmod.NewNat().ExpandFor(scalarOrder)
+	}
+	return s.n
+}
+
+// clone returns a copy of s.n that can be modified without affecting s.
+func (s *Scalar) clone() *bigmod.Nat {
+	return bigmod.NewNat().ExpandFor(scalarOrder).Add(s.nat(), scalarOrder)
+}
+
+// MultiplyAdd sets s = x * y + z mod l, and returns s.
+func (s *Scalar) MultiplyAdd(x, y, z *Scalar) *Scalar {
+	n := x.clone()
+	n.Mul(y.nat(), scalarOrder)
+	n.Add(z.nat(), scalarOrder)
+	s.n = n
+	return s
+}
+
+// Add sets s = x + y mod l, and returns s.
+func (s *Scalar) Add(x, y *Scalar) *Scalar {
+	n := x.clone()
+	n.Add(y.nat(), scalarOrder)
+	s.n = n
+	return s
+}
+
+// Multiply sets s = x * y mod l, and returns s.
+func (s *Scalar) Multiply(x, y *Scalar) *Scalar {
+	n := x.clone()
+	n.Mul(y.nat(), scalarOrder)
+	s.n = n
+	return s
+}
+
+// Set sets s = x, and returns s.
+func (s *Scalar) Set(x *Scalar) *Scalar {
+	s.n = x.clone()
+	return s
+}
+
+// SetUniformBytes sets s = x mod l, where x is a 114-byte little-endian
+// integer. If x is not of the right length, SetUniformBytes returns nil and an
+// error, and the receiver is unchanged.
+//
+// SetUniformBytes can be used to set s to a uniformly distributed value given
+// 114 uniformly distributed random bytes, such as a SHAKE256 output in Ed448.
+func (s *Scalar) SetUniformBytes(x []byte) (*Scalar, error) {
+	if len(x) != 114 {
+		return nil, errors.New("edwards448: invalid SetUniformBytes input length")
+	}
+	var buf [114]byte
+	reverse(buf[:], x)
+	w, err := bigmod.NewNat().SetBytes(buf[:], wideModulus)
+	if err != nil {
+		panic("edwards448: internal error: setting wide scalar failed")
+	}
+	s.n = bigmod.NewNat().Mod(w, scalarOrder)
+	return s, nil
+}
+
+// SetCanonicalBytes sets s = x, where x is a 57-byte little-endian encoding of
+// s, and returns s. If x is not a canonical encoding of s, SetCanonicalBytes
+// returns nil and an error, and the receiver is unchanged.
+func (s *Scalar) SetCanonicalBytes(x []byte) (*Scalar, error) {
+	if len(x) != 57 {
+		return nil, errors.New("invalid scalar length")
+	}
+	if x[56] != 0 {
+		return nil, errors.New("invalid scalar encoding")
+	}
+	var buf [56]byte
+	reverse(buf[:], x[:56])
+	n, err := bigmod.NewNat().SetBytes(buf[:], scalarOrder)
+	if err != nil {
+		return nil, errors.New("invalid scalar encoding")
+	}
+	s.n = n
+	return s, nil
+}
+
+// SetBytesWithClamping applies the buffer pruning described in RFC 8032,
+// Section 5.2.5 (also known as clamping) and sets s to the result. The input
+// must be 57 bytes, and it is not modified. If x is not of the right length,
+// SetBytesWithClamping returns nil and an error, and the receiver is unchanged.
+//
+// As for edwards25519, the resulting value is reduced modulo l, which only
+// works as expected for points on the prime order subgroup, like in Ed448.
+func (s *Scalar) SetBytesWithClamping(x []byte) (*Scalar, error) {
+	if len(x) != 57 {
+		return nil, errors.New("edwards448: invalid SetBytesWithClamping input length")
+	}
+
+	// Clamping sets the 2^447 bit, making the value higher than the order, so
+	// we need to use the wide reduction from SetUniformBytes.
+	var wideBytes [114]byte
+	copy(wideBytes[:], x[:])
+	wideBytes[0] &= 252
+	wideBytes[55] |= 128
+	wideBytes[56] = 0
+	return s.SetUniformBytes(wideBytes[:])
+}
+
+// Bytes returns the canonical 57-byte little-endian encoding of s.
+func (s *Scalar) Bytes() []byte {
+	// This function is outlined to make the allocations inline in the caller
+	// rather than happen on the heap.
+	var encoded [57]byte
+	return s.bytes(&encoded)
+}
+
+func (s *Scalar) bytes(out *[57]byte) []byte {
+	reverse(out[:56], s.nat().Bytes(scalarOrder))
+	out[56] = 0
+	return out[:]
+}
+
+// Equal returns 1 if s and t are equal, and 0 otherwise.
+func (s *Scalar) Equal(t *Scalar) int {
+	return int(s.nat().Equal(t.nat()))
+}
+
+// reverse sets dst to the byte-reversed src. dst and src must have the same
+// length and must not overlap.
+func reverse(dst, src []byte) {
+	for i := range src {
+		dst[len(src)-1-i] = src[i]
+	}
+}
@@ -0,0 +1,64 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package edwards448
+
+import "crypto/subtle"
+
+// ScalarBaseMult sets v = x * B, where B is the canonical generator, and
+// returns v.
+//
+// The scalar multiplication is done in constant time.
+func (v *Point) ScalarBaseMult(x *Scalar) *Point {
+	return v.ScalarMult(x, generator)
+}
+
+// ScalarMult sets v = x * q, and returns v.
+//
+// The scalar multiplication is done in constant time.
+func (v *Point) ScalarMult(x *Scalar, q *Point) *Point {
+	checkInitialized(q)
+
+	// Build a table of the multiples 0 * q through 15 * q.
+	var table [16]Point
+	table[0].Set(identity)
+	table[1].Set(q)
+	for i := 2; i < len(table); i++ {
+		table[i].Add(&table[i-1], q)
+	}
+
+	// Process the scalar four bits at a time, from the most significant
+	// nibble. Scalars are reduced, so the top byte of the encoding is zero.
+	var buf [57]byte
+	b := x.bytes(&buf)[:56]
+
+	var acc, t Point
+	acc.Set(identity)
+	for i := 2*len(b) - 1; i >= 0; i-- {
+		acc.Double(&acc)
+		acc.Double(&acc)
+		acc.Double(&acc)
+		acc.Double(&acc)
+
+		nibble := (b[i/2] >> (4 * (i % 2))) & 0xf
+		t.Set(identity)
+		for j := 1; j < len(table); j++ {
+			t.Select(&table[j], &t, subtle.ConstantTimeByteEq(nibble, uint8(j)))
+		}
+		acc.Add(&acc, &t)
+	}
+	return v.Set(&acc)
+}
+
+// VarTimeDoubleScalarBaseMult sets v = a * A + b * B, where B is the canonical
+// generator, and returns v.
+//
+// Execution time depends on the inputs.
+func (v *Point) VarTimeDoubleScalarBaseMult(a *Scalar, A *Point, b *Scalar) *Point {
+	checkInitialized(A)
+	var aA, bB Point
+	aA.ScalarMult(a, A)
+	bB.ScalarBaseMult(b)
+	return v.Add(&aA, &bB)
+}
@@ -0,0 +1,132 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package scrypt implements the scrypt key derivation function
+// defined in RFC 7914.
+package scrypt
+
+import (
+	"crypto/sha256"
+	"errors"
+	"internal/byteorder"
+	"internal/pbkdf2"
+	"math/bits"
+)
+
+const maxInt = int(^uint(0) >> 1)
+
+// salsa208 applies the Salsa20/8 core to the 16 words of b.
+func salsa208(b *[16]uint32) {
+	x := *b
+	for i := 0; i < 8; i += 2 {
+		// Columns.
+		x[4] ^= bits.RotateLeft32(x[0]+x[12], 7)
+		x[8] ^= bits.RotateLeft32(x[4]+x[0], 9)
+		x[12] ^= bits.RotateLeft32(x[8]+x[4], 13)
+		x[0] ^= bits.RotateLeft32(x[12]+x[8], 18)
+		x[9] ^= bits.RotateLeft32(x[5]+x[1], 7)
+		x[13] ^= bits.RotateLeft32(x[9]+x[5], 9)
+		x[1] ^= bits.RotateLeft32(x[13]+x[9], 13)
+		x[5] ^= bits.RotateLeft32(x[1]+x[13], 18)
+		x[14] ^= bits.RotateLeft32(x[10]+x[6], 7)
+		x[2] ^= bits.RotateLeft32(x[14]+x[10], 9)
+		x[6] ^= bits.RotateLeft32(x[2]+x[14], 13)
+		x[10] ^= bits.RotateLeft32(x[6]+x[2], 18)
+		x[3] ^= bits.RotateLeft32(x[15]+x[11], 7)
+		x[7] ^= bits.RotateLeft32(x[3]+x[15], 9)
+		x[11] ^= bits.RotateLeft32(x[7]+x[3], 13)
+		x[15] ^= bits.RotateLeft32(x[11]+x[7], 18)
+
+		// Rows.
+		x[1] ^= bits.RotateLeft32(x[0]+x[3], 7)
+		x[2] ^= bits.RotateLeft32(x[1]+x[0], 9)
+		x[3] ^= bits.RotateLeft32(x[2]+x[1], 13)
+		x[0] ^= bits.RotateLeft32(x[3]+x[2], 18)
+		x[6] ^= bits.RotateLeft32(x[5]+x[4], 7)
+		x[7] ^= bits.RotateLeft32(x[6]+x[5], 9)
+		x[4] ^= bits.RotateLeft32(x[7]+x[6], 13)
+		x[5] ^= bits.RotateLeft32(x[4]+x[7], 18)
+		x[11] ^= bits.RotateLeft32(x[10]+x[9], 7)
+		x[8] ^= bits.RotateLeft32(x[11]+x[10], 9)
+		x[9] ^= bits.RotateLeft32(x[8]+x[11], 13)
+		x[10] ^= bits.RotateLeft32(x[9]+x[8], 18)
+		x[12] ^= bits.RotateLeft32(x[15]+x[14], 7)
+		x[13] ^= bits.RotateLeft32(x[12]+x[15], 9)
+		x[14] ^= bits.RotateLeft32(x[13]+x[12], 13)
+		x[15] ^= bits.RotateLeft32(x[14]+x[13], 18)
+	}
+	for i := range b {
+		b[i] += x[i]
+	}
+}
+
+// blockMix implements scryptBlockMix, RFC 7914, Section 4, reading the
+// 2*r blocks of in and writing the result to out.
+func blockMix(tmp *[16]uint32, in, out []uint32, r int) {
+	copy(tmp[:], in[(2*r-1)*16:])
+	for i := 0; i < 2*r; i++ {
+		for j := range tmp {
+			tmp[j] ^= in[i*16+j]
+		}
+		salsa208(tmp)
+		// Even blocks go to the first half of out, odd ones to the second.
+		copy(out[(i/2+(i%2)*r)*16:], tmp[:])
+	}
+}
+
+// roMix implements scryptROMix, RFC 7914, Section 5, on the 128*r bytes of b.
+func roMix(b []byte, r, N int, v, xy []uint32) {
+	var tmp [16]uint32
+	R := 32 * r
+	x := xy[:R]
+	y := xy[R:]
+
+	for i := range x {
+		x[i] = byteorder.LeUint32(b[4*i:])
+	}
+	for i := 0; i < N; i += 2 {
+		copy(v[i*R:], x)
+		blockMix(&tmp, x, y, r)
+		copy(v[(i+1)*R:], y)
+		blockMix(&tmp, y, x, r)
+	}
+	for i := 0; i < N; i += 2 {
+		j := int(x[R-16] & uint32(N-1))
+		for k, w := range v[j*R : (j+1)*R] {
+			x[k] ^= w
+		}
+		blockMix(&tmp, x, y, r)
+
+		j = int(y[R-16] & uint32(N-1))
+		for k, w := range v[j*R : (j+1)*R] {
+			y[k] ^= w
+		}
+		blockMix(&tmp, y, x, r)
+	}
+	for i, w := range x {
+		byteorder.LePutUint32(b[4*i:], w)
+	}
+}
+
+// Key derives a key of keyLen bytes from password and salt. N is the
+// CPU/memory cost parameter, which must be a power of two greater than
+// one; r and p are the block size and parallelization parameters, whose
+// product must be less than 2³⁰.
+func Key(password, salt []byte, N, r, p, keyLen int) ([]byte, error) {
+	if N <= 1 || N&(N-1) != 0 {
+		return nil, errors.New("scrypt: N must be > 1 and a power of 2")
+	}
+	if r <= 0 || p <= 0 || uint64(r)*uint64(p) >= 1<<30 ||
+		r > maxInt/128/p || r > maxInt/256 || N > maxInt/128/r {
+		return nil, errors.New("scrypt: parameters are too large")
+	}
+
+	xy := make([]uint32, 64*r)
+	v := make([]uint32, 32*N*r)
+	b := pbkdf2.Key(sha256.New, password, salt, 1, p*128*r)
+	for i := 0; i < p; i++ {
+		roMix(b[i*128*r:], r, N, v, xy)
+	}
+	return pbkdf2.Key(sha256.New, password, b, 1, keyLen), nil
+}
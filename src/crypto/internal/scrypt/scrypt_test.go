@@ -0,0 +1,49 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package scrypt
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+)
+
+var scryptTests = []struct {
+	password, salt string
+	N, r, p        int
+	out            string
+}{
+	// RFC 7914, Section 12.
+	{"", "", 16, 1, 1, "77d6576238657b203b19ca42c18a0497f16b4844e3074ae8dfdffa3fede21442" +
+		"fcd0069ded0948f8326a753a0fc81f17e8d3e0fb2e0d3628cf35e20c38d18906"},
+	{"password", "NaCl", 1024, 8, 16, "fdbabe1c9d3472007856e7190d01e9fe7c6ad7cbc8237830e77376634b373162" +
+		"2eaf30d92e22a3886ff109279d9830dac727afb94a83ee6d8360cbdfa2cc0640"},
+	{"pleaseletmein", "SodiumChloride", 16384, 8, 1, "7023bdcb3afd7348461c06cd81fd38ebfda8fbba904f8e3ea9b543f6545da1f2" +
+		"d5432955613f0fcf62d49705242a9af9e61e85dc0d651e40dfcf017b45575887"},
+}
+
+func TestKey(t *testing.T) {
+	for i, tt := range scryptTests {
+		want, _ := hex.DecodeString(tt.out)
+		got, err := Key([]byte(tt.password), []byte(tt.salt), tt.N, tt.r, tt.p, len(want))
+		if err != nil {
+			t.Errorf("#%d: %v", i, err)
+			continue
+		}
+		if !bytes.Equal(got, want) {
+			t.Errorf("#%d: Key = %x, want %x", i, got, want)
+		}
+	}
+}
+
+func TestKeyInvalidParams(t *testing.T) {
+	for _, tt := range []struct{ N, r, p int }{
+		{0, 1, 1}, {1, 1, 1}, {7, 1, 1}, {16, 0, 1}, {16, 1, 0}, {16, 1 << 15, 1 << 15},
+	} {
+		if _, err := Key(nil, nil, tt.N, tt.r, tt.p, 32); err == nil {
+			t.Errorf("Key(N=%d, r=%d, p=%d) succeeded", tt.N, tt.r, tt.p)
+		}
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"crypto"
 	"crypto/ecdsa"
 	"crypto/ed25519"
+	"crypto/ed448"
 	"crypto/elliptic"
 	"crypto/rsa"
 	"errors"
@@ -37,6 +38,16 @@ func verifyHandshakeSignature(sigType uint8, pubkey crypto.PublicKey, hashFunc c
 		if !ed25519.Verify(pubKey, signed, sig) {
 			return errors.New("Ed25519 verification failure")
 		}
+	case signatureEd448:
+		pubKey, ok := pubkey.(ed448.PublicKey)
+		if !ok {
+			return fmt.Errorf("expected an Ed448 public key, got %T", pubkey)
+		}
+		if !ed448.Verify(pubKey, signed, sig) {
+			return errors.New("Ed448 verification failure")
+		}
+		tlsed448.Value() // ensure godebug is initialized
+		tlsed448.IncNonDefault()
 	case signaturePKCS1v15:
 		pubKey, ok := pubkey.(*rsa.PublicKey)
 		if !ok {
@@ -105,6 +116,8 @@ func typeAndHashFromSignatureScheme(signatureAlgorithm SignatureScheme) (sigType
 		sigType = signatureECDSA
 	case Ed25519:
 		sigType = signatureEd25519
+	case Ed448:
+		sigType = signatureEd448
 	default:
 		return 0, 0, fmt.Errorf("unsupported signature algorithm: %v", signatureAlgorithm)
 	}
@@ -117,7 +130,7 @@ func typeAndHashFromSignatureScheme(signatureAlgorithm SignatureScheme) (sigType
 		hash = crypto.SHA384
 	case PKCS1WithSHA512, PSSWithSHA512, ECDSAWithP521AndSHA512:
 		hash = crypto.SHA512
-	case Ed25519:
+	case Ed25519, Ed448:
 		hash = directSigning
 	default:
 		return 0, 0, fmt.Errorf("unsupported signature algorithm: %v", signatureAlgorithm)
@@ -140,6 +153,8 @@ func legacyTypeAndHashFromPublicKey(pub crypto.PublicKey) (sigType uint8, hash c
 		// full signature, and not even OpenSSL bothers with the
 		// complexity, so we can't even test it properly.
 		return 0, 0, fmt.Errorf("tls: Ed25519 public keys are not supported before TLS 1.2")
+	case ed448.PublicKey:
+		return 0, 0, fmt.Errorf("tls: Ed448 public keys are not supported before TLS 1.2")
 	default:
 		return 0, 0, fmt.Errorf("tls: unsupported public key: %T", pub)
 	}
@@ -210,6 +225,11 @@ func signatureSchemesForCertificate(version uint16, cert *Certificate) []Signatu
 		}
 	case ed25519.PublicKey:
 		sigAlgs = []SignatureScheme{Ed25519}
+	case ed448.PublicKey:
+		if tlsed448.Value() != "1" {
+			return nil
+		}
+		sigAlgs = []SignatureScheme{Ed448}
 	default:
 		return nil
 	}
@@ -246,6 +266,9 @@ func selectSignatureScheme(vers uint16, c *Certificate, peerAlgs []SignatureSche
 			continue
 		}
 		if isSupportedSignatureAlgorithm(preferredAlg, supportedAlgs) {
+			if preferredAlg == Ed448 {
+				tlsed448.IncNonDefault()
+			}
 			return preferredAlg, nil
 		}
 	}
@@ -261,6 +284,8 @@ func unsupportedCertificateError(cert *Certificate) error {
 			cert.PrivateKey, cert.PrivateKey)
 	case *ed25519.PrivateKey:
 		return fmt.Errorf("tls: unsupported certificate: private key is *ed25519.PrivateKey, expected ed25519.PrivateKey")
+	case *ed448.PrivateKey:
+		return fmt.Errorf("tls: unsupported certificate: private key is *ed448.PrivateKey, expected ed448.PrivateKey")
 	}
 
 	signer, ok := cert.PrivateKey.(crypto.Signer)
@@ -281,6 +306,10 @@ func unsupportedCertificateError(cert *Certificate) error {
 	case *rsa.PublicKey:
 		return fmt.Errorf("tls: certificate RSA key size too small for supported signature algorithms")
 	case ed25519.PublicKey:
+	case ed448.PublicKey:
+		if tlsed448.Value() != "1" {
+			return errors.New("tls: unsupported certificate: Ed448 is disabled unless GODEBUG tlsed448=1 is set")
+		}
 	default:
 		return fmt.Errorf("tls: unsupported certificate key (%T)", pub)
 	}
@@ -10,6 +10,8 @@ import (
 )
 
 func TestSignatureSelection(t *testing.T) {
+	t.Setenv("GODEBUG", "tlsed448=1")
+
 	rsaCert := &Certificate{
 		Certificate: [][]byte{testRSACertificate},
 		PrivateKey:  testRSAPrivateKey,
@@ -27,6 +29,10 @@ func TestSignatureSelection(t *testing.T) {
 		Certificate: [][]byte{testEd25519Certificate},
 		PrivateKey:  testEd25519PrivateKey,
 	}
+	ed448Cert := &Certificate{
+		Certificate: [][]byte{testEd448Certificate},
+		PrivateKey:  testEd448PrivateKey,
+	}
 
 	tests := []struct {
 		cert        *Certificate
@@ -47,6 +53,8 @@ func TestSignatureSelection(t *testing.T) {
 		{ecdsaCert, []SignatureScheme{ECDSAWithP256AndSHA256}, VersionTLS13, ECDSAWithP256AndSHA256, signatureECDSA, crypto.SHA256},
 		{ed25519Cert, []SignatureScheme{Ed25519}, VersionTLS12, Ed25519, signatureEd25519, directSigning},
 		{ed25519Cert, []SignatureScheme{Ed25519}, VersionTLS13, Ed25519, signatureEd25519, directSigning},
+		{ed448Cert, []SignatureScheme{Ed448}, VersionTLS12, Ed448, signatureEd448, directSigning},
+		{ed448Cert, []SignatureScheme{Ed448}, VersionTLS13, Ed448, signatureEd448, directSigning},
 
 		// TLS 1.2 without signature_algorithms extension
 		{rsaCert, nil, VersionTLS12, PKCS1WithSHA1, signaturePKCS1v15, crypto.SHA1},
@@ -92,6 +100,8 @@ func TestSignatureSelection(t *testing.T) {
 		{rsaCert, []SignatureScheme{0}, VersionTLS12},
 		{ed25519Cert, []SignatureScheme{ECDSAWithP256AndSHA256, ECDSAWithSHA1}, VersionTLS12},
 		{ecdsaCert, []SignatureScheme{Ed25519}, VersionTLS12},
+		{ed448Cert, []SignatureScheme{Ed25519}, VersionTLS13},
+		{ed25519Cert, []SignatureScheme{Ed448}, VersionTLS13},
 		{brokenCert, []SignatureScheme{Ed25519}, VersionTLS12},
 		{brokenCert, []SignatureScheme{PKCS1WithSHA256}, VersionTLS12},
 		// RFC 5246, Section 7.4.1.4.1, says to only consider {sha1,ecdsa} as
@@ -102,6 +112,7 @@ func TestSignatureSelection(t *testing.T) {
 		{rsaCert, nil, VersionTLS13},
 		{ecdsaCert, nil, VersionTLS13},
 		{ed25519Cert, nil, VersionTLS13},
+		{ed448Cert, nil, VersionTLS13},
 		// Wrong curve, which TLS 1.3 checks
 		{ecdsaCert, []SignatureScheme{ECDSAWithP384AndSHA384}, VersionTLS13},
 		// TLS 1.3 does not support PKCS1v1.5 or SHA-1.
@@ -120,6 +131,28 @@ func TestSignatureSelection(t *testing.T) {
 	}
 }
 
+func TestSignatureSelectionEd448Disabled(t *testing.T) {
+	t.Setenv("GODEBUG", "tlsed448=0")
+
+	ed448Cert := &Certificate{
+		Certificate: [][]byte{testEd448Certificate},
+		PrivateKey:  testEd448PrivateKey,
+	}
+	for _, vers := range []uint16{VersionTLS12, VersionTLS13} {
+		if sigAlg, err := selectSignatureScheme(vers, ed448Cert, []SignatureScheme{Ed448}); err == nil {
+			t.Errorf("%x: unexpected success, got %v", vers, sigAlg)
+		}
+	}
+	chi := &ClientHelloInfo{
+		SupportedVersions: []uint16{VersionTLS13},
+		SignatureSchemes:  []SignatureScheme{Ed448},
+		config:            &Config{},
+	}
+	if err := chi.SupportsCertificate(ed448Cert); err == nil {
+		t.Errorf("SupportsCertificate succeeded for an Ed448 certificate")
+	}
+}
+
 func TestLegacyTypeAndHash(t *testing.T) {
 	sigType, hashFunc, err := legacyTypeAndHashFromPublicKey(testRSAPrivateKey.Public())
 	if err != nil {
@@ -148,6 +181,12 @@ func TestLegacyTypeAndHash(t *testing.T) {
 	if err == nil {
 		t.Errorf("Ed25519: unexpected success")
 	}
+
+	// Neither is Ed448.
+	_, _, err = legacyTypeAndHashFromPublicKey(testEd448PrivateKey.Public())
+	if err == nil {
+		t.Errorf("Ed448: unexpected success")
+	}
 }
 
 // TestSupportedSignatureAlgorithms checks that all supportedSignatureAlgorithms
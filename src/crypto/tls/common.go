@@ -11,6 +11,7 @@ import (
 	"crypto"
 	"crypto/ecdsa"
 	"crypto/ed25519"
+	"crypto/ed448"
 	"crypto/elliptic"
 	"crypto/rand"
 	"crypto/rsa"
@@ -148,6 +149,7 @@ const (
 	CurveP384 CurveID = 24
 	CurveP521 CurveID = 25
 	X25519    CurveID = 29
+	X448      CurveID = 30
 
 	// Experimental codepoint for X25519Kyber768Draft00, specified in
 	// draft-tls-westerbaan-xyber768d00-03. Not exported, as support might be
@@ -198,11 +200,12 @@ const (
 	signatureRSAPSS
 	signatureECDSA
 	signatureEd25519
+	signatureEd448
 )
 
 // directSigning is a standard Hash value that signals that no pre-hashing
 // should be performed, and that the input should be signed directly. It is the
-// hash function associated with the Ed25519 signature scheme.
+// hash function associated with the Ed25519 and Ed448 signature schemes.
 var directSigning crypto.Hash = 0
 
 // helloRetryRequestRandom is set as the Random value of a ServerHello
@@ -398,8 +401,11 @@ const (
 	ECDSAWithP384AndSHA384 SignatureScheme = 0x0503
 	ECDSAWithP521AndSHA512 SignatureScheme = 0x0603
 
-	// EdDSA algorithms.
+	// EdDSA algorithms. Ed448 is neither advertised nor accepted, and
+	// certificates with Ed448 keys are not used, unless the GODEBUG
+	// setting tlsed448=1 is set.
 	Ed25519 SignatureScheme = 0x0807
+	Ed448   SignatureScheme = 0x0808
 
 	// Legacy signature and hash algorithms for TLS 1.2.
 	PKCS1WithSHA1 SignatureScheme = 0x0201
@@ -768,6 +774,8 @@ type Config struct {
 	// From Go 1.23, the default includes the X25519Kyber768Draft00 hybrid
 	// post-quantum key exchange. To disable it, set CurvePreferences explicitly
 	// or use the GODEBUG=tlskyber=0 environment variable.
+	//
+	// X448 is never included in the default.
 	CurvePreferences []CurveID
 
 	// DynamicRecordSizingDisabled disables adaptive sizing of TLS records.
@@ -1362,6 +1370,11 @@ func (chi *ClientHelloInfo) SupportsCertificate(c *Certificate) error {
 				return errors.New("connection doesn't support Ed25519")
 			}
 			ecdsaCipherSuite = true
+		case ed448.PublicKey:
+			if tlsed448.Value() != "1" || vers < VersionTLS12 || len(chi.SignatureSchemes) == 0 {
+				return errors.New("connection doesn't support Ed448")
+			}
+			ecdsaCipherSuite = true
 		case *rsa.PublicKey:
 		default:
 			return supportsRSAFallback(unsupportedCertificateError(c))
@@ -1486,7 +1499,7 @@ var writerMutex sync.Mutex
 type Certificate struct {
 	Certificate [][]byte
 	// PrivateKey contains the private key corresponding to the public key in
-	// Leaf. This must implement crypto.Signer with an RSA, ECDSA or Ed25519 PublicKey.
+	// Leaf. This must implement crypto.Signer with an RSA, ECDSA, Ed25519 or Ed448 PublicKey.
 	// For a server up to TLS 1.2, it can also implement crypto.Decrypter with
 	// an RSA PublicKey.
 	PrivateKey crypto.PrivateKey
@@ -1617,10 +1630,13 @@ func unexpectedMessageError(wanted, got any) error {
 
 // supportedSignatureAlgorithms returns the supported signature algorithms.
 func supportedSignatureAlgorithms() []SignatureScheme {
-	if !needFIPS() {
-		return defaultSupportedSignatureAlgorithms
+	if needFIPS() {
+		return defaultSupportedSignatureAlgorithmsFIPS
+	}
+	if tlsed448.Value() == "1" {
+		return defaultSupportedSignatureAlgorithmsEd448
 	}
-	return defaultSupportedSignatureAlgorithmsFIPS
+	return defaultSupportedSignatureAlgorithms
 }
 
 func isSupportedSignatureAlgorithm(sigAlg SignatureScheme, supportedSignatureAlgorithms []SignatureScheme) bool {
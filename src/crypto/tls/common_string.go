@@ -18,6 +18,7 @@ func _() {
 	_ = x[ECDSAWithP384AndSHA384-1283]
 	_ = x[ECDSAWithP521AndSHA512-1539]
 	_ = x[Ed25519-2055]
+	_ = x[Ed448-2056]
 	_ = x[PKCS1WithSHA1-513]
 	_ = x[ECDSAWithSHA1-515]
 }
@@ -31,11 +32,11 @@ const (
 	_SignatureScheme_name_5 = "ECDSAWithP384AndSHA384"
 	_SignatureScheme_name_6 = "PKCS1WithSHA512"
 	_SignatureScheme_name_7 = "ECDSAWithP521AndSHA512"
-	_SignatureScheme_name_8 = "PSSWithSHA256PSSWithSHA384PSSWithSHA512Ed25519"
+	_SignatureScheme_name_8 = "PSSWithSHA256PSSWithSHA384PSSWithSHA512Ed25519Ed448"
 )
 
 var (
-	_SignatureScheme_index_8 = [...]uint8{0, 13, 26, 39, 46}
+	_SignatureScheme_index_8 = [...]uint8{0, 13, 26, 39, 46, 51}
 )
 
 func (i SignatureScheme) String() string {
@@ -56,7 +57,7 @@ func (i SignatureScheme) String() string {
 		return _SignatureScheme_name_6
 	case i == 1539:
 		return _SignatureScheme_name_7
-	case 2052 <= i && i <= 2055:
+	case 2052 <= i && i <= 2056:
 		i -= 2052
 		return _SignatureScheme_name_8[_SignatureScheme_index_8[i]:_SignatureScheme_index_8[i+1]]
 	default:
@@ -71,17 +72,19 @@ func _() {
 	_ = x[CurveP384-24]
 	_ = x[CurveP521-25]
 	_ = x[X25519-29]
+	_ = x[X448-30]
 	_ = x[x25519Kyber768Draft00-25497]
 }
 
 const (
 	_CurveID_name_0 = "CurveP256CurveP384CurveP521"
-	_CurveID_name_1 = "X25519"
+	_CurveID_name_1 = "X25519X448"
 	_CurveID_name_2 = "X25519Kyber768Draft00"
 )
 
 var (
 	_CurveID_index_0 = [...]uint8{0, 9, 18, 27}
+	_CurveID_index_1 = [...]uint8{0, 6, 10}
 )
 
 func (i CurveID) String() string {
@@ -89,8 +92,9 @@ func (i CurveID) String() string {
 	case 23 <= i && i <= 25:
 		i -= 23
 		return _CurveID_name_0[_CurveID_index_0[i]:_CurveID_index_0[i+1]]
-	case i == 29:
-		return _CurveID_name_1
+	case 29 <= i && i <= 30:
+		i -= 29
+		return _CurveID_name_1[_CurveID_index_1[i]:_CurveID_index_1[i+1]]
 	case i == 25497:
 		return _CurveID_name_2
 	default:
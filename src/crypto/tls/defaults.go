@@ -42,6 +42,13 @@ var defaultSupportedSignatureAlgorithms = []SignatureScheme{
 	ECDSAWithSHA1,
 }
 
+var tlsed448 = godebug.New("tlsed448")
+
+// defaultSupportedSignatureAlgorithmsEd448 is defaultSupportedSignatureAlgorithms
+// with Ed448, which is only enabled by the GODEBUG setting tlsed448=1.
+var defaultSupportedSignatureAlgorithmsEd448 = append(
+	slices.Clip(defaultSupportedSignatureAlgorithms), Ed448)
+
 var tlsrsakex = godebug.New("tlsrsakex")
 var tls3des = godebug.New("tls3des")
 
@@ -10,6 +10,7 @@ import (
 	"crypto"
 	"crypto/ecdsa"
 	"crypto/ed25519"
+	"crypto/ed448"
 	"crypto/internal/hpke"
 	"crypto/internal/mlkem768"
 	"crypto/rsa"
@@ -1138,7 +1139,7 @@ func (c *Conn) verifyServerCertificate(certificates [][]byte) error {
 	}
 
 	switch certs[0].PublicKey.(type) {
-	case *rsa.PublicKey, *ecdsa.PublicKey, ed25519.PublicKey:
+	case *rsa.PublicKey, *ecdsa.PublicKey, ed25519.PublicKey, ed448.PublicKey:
 		break
 	default:
 		c.sendAlert(alertUnsupportedCertificate)
@@ -1217,7 +1218,7 @@ func certificateRequestInfoFromMsg(ctx context.Context, vers uint16, certReq *ce
 			continue
 		}
 		switch sigType {
-		case signatureECDSA, signatureEd25519:
+		case signatureECDSA, signatureEd25519, signatureEd448:
 			if ecAvail {
 				cri.SignatureSchemes = append(cri.SignatureSchemes, sigScheme)
 			}
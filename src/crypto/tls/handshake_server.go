@@ -9,6 +9,7 @@ import (
 	"crypto"
 	"crypto/ecdsa"
 	"crypto/ed25519"
+	"crypto/ed448"
 	"crypto/rsa"
 	"crypto/subtle"
 	"crypto/x509"
@@ -263,7 +264,7 @@ func (hs *serverHandshakeState) processClientHello() error {
 		switch priv.Public().(type) {
 		case *ecdsa.PublicKey:
 			hs.ecSignOk = true
-		case ed25519.PublicKey:
+		case ed25519.PublicKey, ed448.PublicKey:
 			hs.ecSignOk = true
 		case *rsa.PublicKey:
 			hs.rsaSignOk = true
@@ -932,7 +933,7 @@ func (c *Conn) processCertsFromClient(certificate Certificate) error {
 
 	if len(certs) > 0 {
 		switch certs[0].PublicKey.(type) {
-		case *ecdsa.PublicKey, *rsa.PublicKey, ed25519.PublicKey:
+		case *ecdsa.PublicKey, *rsa.PublicKey, ed25519.PublicKey, ed448.PublicKey:
 		default:
 			c.sendAlert(alertUnsupportedCertificate)
 			return fmt.Errorf("tls: client certificate contains an unsupported public key of type %T", certs[0].PublicKey)
@@ -127,10 +127,10 @@ func md5SHA1Hash(slices [][]byte) []byte {
 
 // hashForServerKeyExchange hashes the given slices and returns their digest
 // using the given hash function (for TLS 1.2) or using a default based on
-// the sigType (for earlier TLS versions). For Ed25519 signatures, which don't
+// the sigType (for earlier TLS versions). For EdDSA signatures, which don't
 // do pre-hashing, it returns the concatenation of the slices.
 func hashForServerKeyExchange(sigType uint8, hashFunc crypto.Hash, version uint16, slices ...[]byte) []byte {
-	if sigType == signatureEd25519 {
+	if sigType == signatureEd25519 || sigType == signatureEd448 {
 		var signed []byte
 		for _, slice := range slices {
 			signed = append(signed, slice...)
@@ -154,7 +154,7 @@ func hashForServerKeyExchange(sigType uint8, hashFunc crypto.Hash, version uint1
 // ecdheKeyAgreement implements a TLS key agreement where the server
 // generates an ephemeral EC public/private key pair and signs it. The
 // pre-master secret is then calculated using ECDH. The signature may
-// be ECDSA, Ed25519 or RSA.
+// be ECDSA, Ed25519, Ed448 or RSA.
 type ecdheKeyAgreement struct {
 	version uint16
 	isRSA   bool
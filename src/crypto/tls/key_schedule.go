@@ -173,6 +173,8 @@ func curveForCurveID(id CurveID) (ecdh.Curve, bool) {
 	switch id {
 	case X25519:
 		return ecdh.X25519(), true
+	case X448:
+		return ecdh.X448(), true
 	case CurveP256:
 		return ecdh.P256(), true
 	case CurveP384:
@@ -188,6 +190,8 @@ func curveIDForCurve(curve ecdh.Curve) (CurveID, bool) {
 	switch curve {
 	case ecdh.X25519():
 		return X25519, true
+	case ecdh.X448():
+		return X448, true
 	case ecdh.P256():
 		return CurveP256, true
 	case ecdh.P384():
@@ -1694,6 +1694,45 @@ func TestPKCS1OnlyCert(t *testing.T) {
 	}
 }
 
+func TestEd448X448(t *testing.T) {
+	t.Run("TLSv12", func(t *testing.T) { testEd448X448(t, VersionTLS12) })
+	t.Run("TLSv13", func(t *testing.T) { testEd448X448(t, VersionTLS13) })
+}
+
+func testEd448X448(t *testing.T, version uint16) {
+	clientConfig := testConfig.Clone()
+	clientConfig.MaxVersion = version
+	clientConfig.Certificates = []Certificate{{
+		Certificate: [][]byte{testEd448Certificate},
+		PrivateKey:  testEd448PrivateKey,
+	}}
+	serverConfig := testConfig.Clone()
+	serverConfig.MaxVersion = version
+	serverConfig.Certificates = clientConfig.Certificates
+	serverConfig.ClientAuth = RequireAnyClientCert
+
+	// Ed448 is not enabled by default, so the handshake must fail.
+	if _, _, err := testHandshake(t, clientConfig, serverConfig); err == nil {
+		t.Fatal("expected Ed448 certificate to be rejected by default")
+	}
+
+	t.Setenv("GODEBUG", "tlsed448=1")
+	clientConfig.CurvePreferences = []CurveID{X448}
+	serverConfig.CurvePreferences = []CurveID{X448}
+	serverState, clientState, err := testHandshake(t, clientConfig, serverConfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, cs := range []ConnectionState{serverState, clientState} {
+		if cs.testingOnlyCurveID != X448 {
+			t.Errorf("got CurveID %v, expected %v", cs.testingOnlyCurveID, X448)
+		}
+		if len(cs.PeerCertificates) != 1 || !bytes.Equal(cs.PeerCertificates[0].Raw, testEd448Certificate) {
+			t.Errorf("unexpected peer certificates")
+		}
+	}
+}
+
 func TestVerifyCertificates(t *testing.T) {
 	// See https://go.dev/issue/31641.
 	t.Run("TLSv12", func(t *testing.T) { testVerifyCertificates(t, VersionTLS12) })
@@ -0,0 +1,295 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package cms implements the Cryptographic Message Syntax (CMS) as defined in
+// RFC 5652, the successor of PKCS #7.
+//
+// The package supports creating and verifying SignedData content, both with
+// the signed content attached and detached, and creating and opening
+// EnvelopedData content for recipients holding RSA or elliptic curve keys.
+// These are the building blocks used by S/MIME, code signing, time-stamping
+// and SCEP.
+//
+// Inputs are expected to be DER encoded ContentInfo structures. BER encodings
+// using indefinite lengths are not supported.
+package cms
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/x509"
+	"encoding/asn1"
+	"errors"
+	"math/big"
+	"slices"
+
+	"golang.org/x/crypto/cryptobyte"
+	cryptobyte_asn1 "golang.org/x/crypto/cryptobyte/asn1"
+)
+
+var (
+	// RFC 5652, Section 4 and following.
+	oidData          = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 7, 1}
+	oidSignedData    = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 7, 2}
+	oidEnvelopedData = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 7, 3}
+
+	// RFC 5652, Section 11.
+	oidAttributeContentType   = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 9, 3}
+	oidAttributeMessageDigest = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 9, 4}
+	oidAttributeSigningTime   = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 9, 5}
+
+	oidSHA1   = asn1.ObjectIdentifier{1, 3, 14, 3, 2, 26}
+	oidSHA256 = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 2, 1}
+	oidSHA384 = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 2, 2}
+	oidSHA512 = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 2, 3}
+)
+
+// ContentInfo OIDs identifying the type of a CMS message, as returned by
+// [ContentType].
+var (
+	OIDData          = oidData
+	OIDSignedData    = oidSignedData
+	OIDEnvelopedData = oidEnvelopedData
+)
+
+var errMalformed = errors.New("cms: malformed message")
+
+// ContentType returns the content type of the BER or DER encoded ContentInfo
+// in data, which can be compared against [OIDSignedData] and
+// [OIDEnvelopedData] to select the appropriate parsing function.
+func ContentType(data []byte) (asn1.ObjectIdentifier, error) {
+	der, err := toDER(data)
+	if err != nil {
+		return nil, err
+	}
+	contentType, _, err := parseContentInfo(der)
+	return contentType, err
+}
+
+// toDER converts a BER encoded message to DER. Streaming encoders, such as
+// "openssl cms -stream", write indefinite lengths and segmented strings,
+// which the parsers below do not accept.
+func toDER(data []byte) ([]byte, error) {
+	var raw asn1.RawValue
+	rest, err := asn1.UnmarshalBER(data, &raw)
+	if err != nil || len(rest) != 0 {
+		return nil, errMalformed
+	}
+	return raw.FullBytes, nil
+}
+
+// parseContentInfo parses a ContentInfo, RFC 5652, Section 3, returning the
+// content type and the contents of the explicitly tagged content field.
+func parseContentInfo(der []byte) (asn1.ObjectIdentifier, cryptobyte.String, error) {
+	input := cryptobyte.String(der)
+	var ci cryptobyte.String
+	var contentType asn1.ObjectIdentifier
+	var content cryptobyte.String
+	if !input.ReadASN1(&ci, cryptobyte_asn1.SEQUENCE) || !input.Empty() ||
+		!ci.ReadASN1ObjectIdentifier(&contentType) ||
+		!ci.ReadASN1(&content, cryptobyte_asn1.Tag(0).Constructed().ContextSpecific()) ||
+		!ci.Empty() {
+		return nil, nil, errMalformed
+	}
+	return contentType, content, nil
+}
+
+// marshalContentInfo wraps the DER encoded content in a ContentInfo of the
+// given type.
+func marshalContentInfo(contentType asn1.ObjectIdentifier, content []byte) ([]byte, error) {
+	var b cryptobyte.Builder
+	b.AddASN1(cryptobyte_asn1.SEQUENCE, func(b *cryptobyte.Builder) {
+		b.AddASN1ObjectIdentifier(contentType)
+		b.AddASN1(cryptobyte_asn1.Tag(0).Constructed().ContextSpecific(), func(b *cryptobyte.Builder) {
+			b.AddBytes(content)
+		})
+	})
+	return b.Bytes()
+}
+
+// Attribute is a signed or unsigned attribute of a [SignerInfo], RFC 5652,
+// Section 5.3. Each entry in Values is a complete DER encoded ASN.1 value.
+type Attribute struct {
+	Type   asn1.ObjectIdentifier
+	Values []asn1.RawValue
+}
+
+// value returns the single value of the attribute, or false if the attribute
+// does not have exactly one value.
+func (a *Attribute) value() (cryptobyte.String, bool) {
+	if len(a.Values) != 1 {
+		return nil, false
+	}
+	return cryptobyte.String(a.Values[0].FullBytes), true
+}
+
+func parseAttributes(der cryptobyte.String) ([]Attribute, error) {
+	var attrs []Attribute
+	for !der.Empty() {
+		var attr, values cryptobyte.String
+		var a Attribute
+		if !der.ReadASN1(&attr, cryptobyte_asn1.SEQUENCE) ||
+			!attr.ReadASN1ObjectIdentifier(&a.Type) ||
+			!attr.ReadASN1(&values, cryptobyte_asn1.SET) ||
+			!attr.Empty() {
+			return nil, errors.New("cms: malformed attribute")
+		}
+		for !values.Empty() {
+			var v cryptobyte.String
+			var tag cryptobyte_asn1.Tag
+			if !values.ReadAnyASN1Element(&v, &tag) {
+				return nil, errors.New("cms: malformed attribute value")
+			}
+			var raw asn1.RawValue
+			if _, err := asn1.Unmarshal(v, &raw); err != nil {
+				return nil, errors.New("cms: malformed attribute value")
+			}
+			a.Values = append(a.Values, raw)
+		}
+		attrs = append(attrs, a)
+	}
+	return attrs, nil
+}
+
+// marshalAttributes returns the DER encoding of each attribute, sorted as
+// required for the DER encoding of a SET OF.
+func marshalAttributes(attrs []Attribute) ([][]byte, error) {
+	encoded := make([][]byte, 0, len(attrs))
+	for _, a := range attrs {
+		var b cryptobyte.Builder
+		b.AddASN1(cryptobyte_asn1.SEQUENCE, func(b *cryptobyte.Builder) {
+			b.AddASN1ObjectIdentifier(a.Type)
+			b.AddASN1(cryptobyte_asn1.SET, func(b *cryptobyte.Builder) {
+				for _, v := range sortedValues(a.Values) {
+					b.AddBytes(v)
+				}
+			})
+		})
+		der, err := b.Bytes()
+		if err != nil {
+			return nil, err
+		}
+		encoded = append(encoded, der)
+	}
+	sortDERSet(encoded)
+	return encoded, nil
+}
+
+func sortedValues(values []asn1.RawValue) [][]byte {
+	encoded := make([][]byte, 0, len(values))
+	for _, v := range values {
+		encoded = append(encoded, v.FullBytes)
+	}
+	sortDERSet(encoded)
+	return encoded
+}
+
+// issuerAndSerialNumber identifies a certificate by its issuer and serial
+// number, RFC 5652, Section 10.2.4.
+type issuerAndSerialNumber struct {
+	rawIssuer    []byte
+	serialNumber *big.Int
+}
+
+func readIssuerAndSerialNumber(s *cryptobyte.String, out *issuerAndSerialNumber) bool {
+	var ias, issuer cryptobyte.String
+	serial := new(big.Int)
+	if !s.ReadASN1(&ias, cryptobyte_asn1.SEQUENCE) ||
+		!ias.ReadASN1Element(&issuer, cryptobyte_asn1.SEQUENCE) ||
+		!ias.ReadASN1Integer(serial) ||
+		!ias.Empty() {
+		return false
+	}
+	out.rawIssuer = issuer
+	out.serialNumber = serial
+	return true
+}
+
+// sortDERSet sorts the DER encodings of the elements of a SET OF into the
+// order required by X.690, Section 11.6.
+func sortDERSet(elements [][]byte) {
+	slices.SortFunc(elements, bytes.Compare)
+}
+
+func addIssuerAndSerialNumber(b *cryptobyte.Builder, cert *x509.Certificate) {
+	b.AddASN1(cryptobyte_asn1.SEQUENCE, func(b *cryptobyte.Builder) {
+		b.AddBytes(cert.RawIssuer)
+		b.AddASN1BigInt(cert.SerialNumber)
+	})
+}
+
+// certificateIdentifier identifies a certificate either by issuer and serial
+// number or by subject key identifier.
+type certificateIdentifier struct {
+	issuerAndSerialNumber
+	subjectKeyID []byte
+}
+
+func (id *certificateIdentifier) matches(cert *x509.Certificate) bool {
+	if id.subjectKeyID != nil {
+		return len(cert.SubjectKeyId) > 0 && string(id.subjectKeyID) == string(cert.SubjectKeyId)
+	}
+	return id.serialNumber != nil && id.serialNumber.Cmp(cert.SerialNumber) == 0 &&
+		string(id.rawIssuer) == string(cert.RawIssuer)
+}
+
+func hashForOID(oid asn1.ObjectIdentifier) (crypto.Hash, bool) {
+	switch {
+	case oid.Equal(oidSHA1):
+		return crypto.SHA1, true
+	case oid.Equal(oidSHA256):
+		return crypto.SHA256, true
+	case oid.Equal(oidSHA384):
+		return crypto.SHA384, true
+	case oid.Equal(oidSHA512):
+		return crypto.SHA512, true
+	}
+	return 0, false
+}
+
+func oidForHash(h crypto.Hash) (asn1.ObjectIdentifier, bool) {
+	switch h {
+	case crypto.SHA1:
+		return oidSHA1, true
+	case crypto.SHA256:
+		return oidSHA256, true
+	case crypto.SHA384:
+		return oidSHA384, true
+	case crypto.SHA512:
+		return oidSHA512, true
+	}
+	return nil, false
+}
+
+// readAlgorithmIdentifier reads an AlgorithmIdentifier, returning the
+// algorithm and the complete encoding of the optional parameters.
+func readAlgorithmIdentifier(s *cryptobyte.String, oid *asn1.ObjectIdentifier, params *cryptobyte.String) bool {
+	var ai cryptobyte.String
+	if !s.ReadASN1(&ai, cryptobyte_asn1.SEQUENCE) || !ai.ReadASN1ObjectIdentifier(oid) {
+		return false
+	}
+	*params = nil
+	if ai.Empty() {
+		return true
+	}
+	var tag cryptobyte_asn1.Tag
+	return ai.ReadAnyASN1Element(params, &tag) && ai.Empty()
+}
+
+// addAlgorithmIdentifier adds an AlgorithmIdentifier with the given DER
+// encoded parameters, which may be nil.
+func addAlgorithmIdentifier(b *cryptobyte.Builder, oid asn1.ObjectIdentifier, params []byte) {
+	b.AddASN1(cryptobyte_asn1.SEQUENCE, func(b *cryptobyte.Builder) {
+		b.AddASN1ObjectIdentifier(oid)
+		b.AddBytes(params)
+	})
+}
+
+// nullParameters is the DER encoding of an ASN.1 NULL, used as the
+// parameters of some algorithm identifiers.
+var nullParameters = []byte{0x05, 0x00}
+
+func isAbsentOrNull(params cryptobyte.String) bool {
+	return len(params) == 0 || string(params) == string(nullParameters)
+}
@@ -0,0 +1,730 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cms
+
+import (
+	"crypto"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ecdh"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/asn1"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+
+	"golang.org/x/crypto/cryptobyte"
+	cryptobyte_asn1 "golang.org/x/crypto/cryptobyte/asn1"
+)
+
+var (
+	oidRSAESOAEP = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 1, 7}
+	oidMGF1      = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 1, 8}
+
+	oidAES128CBC = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 1, 2}
+	oidAES192CBC = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 1, 22}
+	oidAES256CBC = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 1, 42}
+
+	oidAES128Wrap = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 1, 5}
+	oidAES192Wrap = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 1, 25}
+	oidAES256Wrap = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 1, 45}
+
+	oidPublicKeyECDSA  = asn1.ObjectIdentifier{1, 2, 840, 10045, 2, 1}
+	oidPublicKeyX25519 = asn1.ObjectIdentifier{1, 3, 101, 110}
+
+	// RFC 5753, Section 7.1.4.
+	oidDHSinglePassStdDHSHA1KDF   = asn1.ObjectIdentifier{1, 3, 133, 16, 840, 63, 0, 2}
+	oidDHSinglePassStdDHSHA256KDF = asn1.ObjectIdentifier{1, 3, 132, 1, 11, 1}
+	oidDHSinglePassStdDHSHA384KDF = asn1.ObjectIdentifier{1, 3, 132, 1, 11, 2}
+	oidDHSinglePassStdDHSHA512KDF = asn1.ObjectIdentifier{1, 3, 132, 1, 11, 3}
+)
+
+// ContentEncryptionAlgorithm identifies the symmetric cipher used to encrypt
+// the content of an EnvelopedData message.
+type ContentEncryptionAlgorithm int
+
+const (
+	AES128CBC ContentEncryptionAlgorithm = iota + 1
+	AES192CBC
+	AES256CBC
+)
+
+func (a ContentEncryptionAlgorithm) params() (oid asn1.ObjectIdentifier, keyLen int, ok bool) {
+	switch a {
+	case AES128CBC:
+		return oidAES128CBC, 16, true
+	case AES192CBC:
+		return oidAES192CBC, 24, true
+	case AES256CBC:
+		return oidAES256CBC, 32, true
+	}
+	return nil, 0, false
+}
+
+// EncryptOptions contains optional parameters for [Encrypt].
+type EncryptOptions struct {
+	// ContentEncryptionAlgorithm is the cipher used for the content. If
+	// zero, AES256CBC is used.
+	ContentEncryptionAlgorithm ContentEncryptionAlgorithm
+
+	// ContentType is the type of the content. If nil, [OIDData] is used.
+	ContentType asn1.ObjectIdentifier
+
+	// OAEP selects RSAES-OAEP with SHA-256, RFC 8017, instead of PKCS #1
+	// v1.5 encryption for recipients with RSA keys.
+	OAEP bool
+}
+
+// Encrypt returns a DER encoded ContentInfo containing an EnvelopedData
+// message with content encrypted for each of recipients.
+//
+// The content encryption key is transported with RSA encryption to
+// recipients with RSA keys, and with ephemeral-static ECDH, RFC 5753 and
+// RFC 8418, to recipients with P-256, P-384, P-521 or X25519 keys.
+func Encrypt(content []byte, recipients []*x509.Certificate, opts *EncryptOptions) ([]byte, error) {
+	if opts == nil {
+		opts = &EncryptOptions{}
+	}
+	if len(recipients) == 0 {
+		return nil, errors.New("cms: no recipients")
+	}
+	alg := opts.ContentEncryptionAlgorithm
+	if alg == 0 {
+		alg = AES256CBC
+	}
+	cipherOID, keyLen, ok := alg.params()
+	if !ok {
+		return nil, errors.New("cms: unsupported content encryption algorithm")
+	}
+	contentType := opts.ContentType
+	if contentType == nil {
+		contentType = oidData
+	}
+
+	key := make([]byte, keyLen)
+	if _, err := io.ReadFull(rand.Reader, key); err != nil {
+		return nil, err
+	}
+	iv := make([]byte, aes.BlockSize)
+	if _, err := io.ReadFull(rand.Reader, iv); err != nil {
+		return nil, err
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	padLen := aes.BlockSize - len(content)%aes.BlockSize
+	ciphertext := make([]byte, len(content)+padLen)
+	copy(ciphertext, content)
+	for i := len(content); i < len(ciphertext); i++ {
+		ciphertext[i] = byte(padLen)
+	}
+	cipher.NewCBCEncrypter(block, iv).CryptBlocks(ciphertext, ciphertext)
+
+	var infos [][]byte
+	version := int64(0)
+	for _, cert := range recipients {
+		var info []byte
+		var err error
+		pub := cert.PublicKey
+		if pub == nil {
+			// Certificate parsing leaves keys of unknown algorithms, such
+			// as X25519, unset, but PKIX parsing supports them.
+			pub, _ = x509.ParsePKIXPublicKey(cert.RawSubjectPublicKeyInfo)
+		}
+		switch pub := pub.(type) {
+		case *rsa.PublicKey:
+			info, err = marshalKeyTransRecipientInfo(cert, pub, key, opts.OAEP)
+		case *ecdsa.PublicKey:
+			var ecdhPub *ecdh.PublicKey
+			if ecdhPub, err = pub.ECDH(); err == nil {
+				info, err = marshalKeyAgreeRecipientInfo(cert, ecdhPub, key)
+			}
+			version = 2
+		case *ecdh.PublicKey:
+			info, err = marshalKeyAgreeRecipientInfo(cert, pub, key)
+			version = 2
+		default:
+			err = fmt.Errorf("cms: unsupported recipient public key type %T", pub)
+		}
+		if err != nil {
+			return nil, err
+		}
+		infos = append(infos, info)
+	}
+
+	var b cryptobyte.Builder
+	b.AddASN1(cryptobyte_asn1.SEQUENCE, func(b *cryptobyte.Builder) {
+		b.AddASN1Int64(version)
+		b.AddASN1(cryptobyte_asn1.SET, func(b *cryptobyte.Builder) {
+			for _, info := range infos {
+				b.AddBytes(info)
+			}
+		})
+		b.AddASN1(cryptobyte_asn1.SEQUENCE, func(b *cryptobyte.Builder) {
+			b.AddASN1ObjectIdentifier(contentType)
+			b.AddASN1(cryptobyte_asn1.SEQUENCE, func(b *cryptobyte.Builder) {
+				b.AddASN1ObjectIdentifier(cipherOID)
+				b.AddASN1OctetString(iv)
+			})
+			b.AddASN1(cryptobyte_asn1.Tag(0).ContextSpecific(), func(b *cryptobyte.Builder) {
+				b.AddBytes(ciphertext)
+			})
+		})
+	})
+	ed, err := b.Bytes()
+	if err != nil {
+		return nil, err
+	}
+	return marshalContentInfo(oidEnvelopedData, ed)
+}
+
+// oaepParametersSHA256 is the DER encoded RSAES-OAEP-params selecting
+// SHA-256 for both the hash and MGF1, RFC 8017, Appendix A.2.1.
+var oaepParametersSHA256 = []byte{48, 47, 160, 15, 48, 13, 6, 9, 96, 134, 72, 1, 101, 3, 4, 2, 1, 5, 0, 161, 28, 48, 26, 6, 9, 42, 134, 72, 134, 247, 13, 1, 1, 8, 48, 13, 6, 9, 96, 134, 72, 1, 101, 3, 4, 2, 1, 5, 0}
+
+func marshalKeyTransRecipientInfo(cert *x509.Certificate, pub *rsa.PublicKey, key []byte, oaep bool) ([]byte, error) {
+	var encryptedKey []byte
+	var err error
+	if oaep {
+		encryptedKey, err = rsa.EncryptOAEP(crypto.SHA256.New(), rand.Reader, pub, key, nil)
+	} else {
+		encryptedKey, err = rsa.EncryptPKCS1v15(rand.Reader, pub, key)
+	}
+	if err != nil {
+		return nil, err
+	}
+	var b cryptobyte.Builder
+	b.AddASN1(cryptobyte_asn1.SEQUENCE, func(b *cryptobyte.Builder) {
+		b.AddASN1Int64(0)
+		addIssuerAndSerialNumber(b, cert)
+		if oaep {
+			addAlgorithmIdentifier(b, oidRSAESOAEP, oaepParametersSHA256)
+		} else {
+			addAlgorithmIdentifier(b, oidPublicKeyRSA, nullParameters)
+		}
+		b.AddASN1OctetString(encryptedKey)
+	})
+	return b.Bytes()
+}
+
+// keyAgreeParams returns the key derivation hash and the key wrap algorithm
+// used with ECDH on curve, following the recommendations of RFC 5008 and
+// RFC 8418.
+func keyAgreeParams(curve ecdh.Curve) (kdf asn1.ObjectIdentifier, wrap asn1.ObjectIdentifier, err error) {
+	switch curve {
+	case ecdh.P256(), ecdh.X25519():
+		return oidDHSinglePassStdDHSHA256KDF, oidAES128Wrap, nil
+	case ecdh.P384():
+		return oidDHSinglePassStdDHSHA384KDF, oidAES256Wrap, nil
+	case ecdh.P521():
+		return oidDHSinglePassStdDHSHA512KDF, oidAES256Wrap, nil
+	}
+	return nil, nil, errors.New("cms: unsupported elliptic curve")
+}
+
+func marshalKeyAgreeRecipientInfo(cert *x509.Certificate, pub *ecdh.PublicKey, key []byte) ([]byte, error) {
+	kdfOID, wrapOID, err := keyAgreeParams(pub.Curve())
+	if err != nil {
+		return nil, err
+	}
+	ephemeral, err := pub.Curve().GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	shared, err := ephemeral.ECDH(pub)
+	if err != nil {
+		return nil, err
+	}
+	kek, err := deriveKEK(kdfOID, wrapOID, shared, nil)
+	if err != nil {
+		return nil, err
+	}
+	wrapped, err := wrapKey(kek, key)
+	if err != nil {
+		return nil, err
+	}
+
+	originatorOID := oidPublicKeyECDSA
+	if pub.Curve() == ecdh.X25519() {
+		originatorOID = oidPublicKeyX25519
+	}
+	var b cryptobyte.Builder
+	b.AddASN1(cryptobyte_asn1.Tag(1).Constructed().ContextSpecific(), func(b *cryptobyte.Builder) {
+		b.AddASN1Int64(3)
+		b.AddASN1(cryptobyte_asn1.Tag(0).Constructed().ContextSpecific(), func(b *cryptobyte.Builder) {
+			b.AddASN1(cryptobyte_asn1.Tag(1).Constructed().ContextSpecific(), func(b *cryptobyte.Builder) {
+				addAlgorithmIdentifier(b, originatorOID, nil)
+				b.AddASN1BitString(ephemeral.PublicKey().Bytes())
+			})
+		})
+		b.AddASN1(cryptobyte_asn1.SEQUENCE, func(b *cryptobyte.Builder) {
+			b.AddASN1ObjectIdentifier(kdfOID)
+			addAlgorithmIdentifier(b, wrapOID, nil)
+		})
+		b.AddASN1(cryptobyte_asn1.SEQUENCE, func(b *cryptobyte.Builder) {
+			b.AddASN1(cryptobyte_asn1.SEQUENCE, func(b *cryptobyte.Builder) {
+				addIssuerAndSerialNumber(b, cert)
+				b.AddASN1OctetString(wrapped)
+			})
+		})
+	})
+	return b.Bytes()
+}
+
+// deriveKEK derives the key-encryption key from the ECDH shared secret
+// with the ANSI X9.63 KDF, using the ECC-CMS-SharedInfo structure of
+// RFC 5753, Section 7.2, as shared information.
+func deriveKEK(kdfOID, wrapOID asn1.ObjectIdentifier, shared, ukm []byte) ([]byte, error) {
+	var hash crypto.Hash
+	switch {
+	case kdfOID.Equal(oidDHSinglePassStdDHSHA1KDF):
+		hash = crypto.SHA1
+	case kdfOID.Equal(oidDHSinglePassStdDHSHA256KDF):
+		hash = crypto.SHA256
+	case kdfOID.Equal(oidDHSinglePassStdDHSHA384KDF):
+		hash = crypto.SHA384
+	case kdfOID.Equal(oidDHSinglePassStdDHSHA512KDF):
+		hash = crypto.SHA512
+	default:
+		return nil, fmt.Errorf("cms: unsupported key agreement algorithm %v", kdfOID)
+	}
+	var keyLen int
+	switch {
+	case wrapOID.Equal(oidAES128Wrap):
+		keyLen = 16
+	case wrapOID.Equal(oidAES192Wrap):
+		keyLen = 24
+	case wrapOID.Equal(oidAES256Wrap):
+		keyLen = 32
+	default:
+		return nil, fmt.Errorf("cms: unsupported key wrap algorithm %v", wrapOID)
+	}
+
+	var b cryptobyte.Builder
+	b.AddASN1(cryptobyte_asn1.SEQUENCE, func(b *cryptobyte.Builder) {
+		addAlgorithmIdentifier(b, wrapOID, nil)
+		if ukm != nil {
+			b.AddASN1(cryptobyte_asn1.Tag(0).Constructed().ContextSpecific(), func(b *cryptobyte.Builder) {
+				b.AddASN1OctetString(ukm)
+			})
+		}
+		b.AddASN1(cryptobyte_asn1.Tag(2).Constructed().ContextSpecific(), func(b *cryptobyte.Builder) {
+			b.AddASN1OctetString(binary.BigEndian.AppendUint32(nil, uint32(keyLen*8)))
+		})
+	})
+	sharedInfo, err := b.Bytes()
+	if err != nil {
+		return nil, err
+	}
+
+	var kek []byte
+	for counter := uint32(1); len(kek) < keyLen; counter++ {
+		h := hash.New()
+		h.Write(shared)
+		h.Write(binary.BigEndian.AppendUint32(nil, counter))
+		h.Write(sharedInfo)
+		kek = h.Sum(kek)
+	}
+	return kek[:keyLen], nil
+}
+
+// EnvelopedData is a parsed CMS EnvelopedData message, RFC 5652, Section 6.
+type EnvelopedData struct {
+	// Raw contains the complete DER encoded ContentInfo.
+	Raw []byte
+
+	// ContentType is the type of the encrypted content, usually [OIDData].
+	ContentType asn1.ObjectIdentifier
+
+	recipients       []recipientInfo
+	cipherOID        asn1.ObjectIdentifier
+	cipherParams     cryptobyte.String
+	encryptedContent []byte
+}
+
+// recipientInfo is a KeyTransRecipientInfo or a single RecipientEncryptedKey
+// of a KeyAgreeRecipientInfo.
+type recipientInfo struct {
+	rid          certificateIdentifier
+	keyAgreement bool
+
+	keyEncryptionOID    asn1.ObjectIdentifier
+	keyEncryptionParams cryptobyte.String
+	encryptedKey        []byte
+
+	// Only set for key agreement.
+	originatorOID    asn1.ObjectIdentifier
+	originatorParams cryptobyte.String
+	originatorKey    []byte
+	ukm              []byte
+}
+
+// ParseEnvelopedData parses a BER or DER encoded ContentInfo containing an
+// EnvelopedData message. BER input is converted to DER, which Raw then holds.
+func ParseEnvelopedData(data []byte) (*EnvelopedData, error) {
+	der, err := toDER(data)
+	if err != nil {
+		return nil, err
+	}
+	contentType, content, err := parseContentInfo(der)
+	if err != nil {
+		return nil, err
+	}
+	if !contentType.Equal(oidEnvelopedData) {
+		return nil, fmt.Errorf("cms: content type %v is not EnvelopedData", contentType)
+	}
+
+	ed := &EnvelopedData{Raw: der}
+	var inner, infos, eci cryptobyte.String
+	var version int
+	if !content.ReadASN1(&inner, cryptobyte_asn1.SEQUENCE) || !content.Empty() ||
+		!inner.ReadASN1Integer(&version) ||
+		!inner.SkipOptionalASN1(cryptobyte_asn1.Tag(0).Constructed().ContextSpecific()) ||
+		!inner.ReadASN1(&infos, cryptobyte_asn1.SET) ||
+		!inner.ReadASN1(&eci, cryptobyte_asn1.SEQUENCE) ||
+		!inner.SkipOptionalASN1(cryptobyte_asn1.Tag(1).Constructed().ContextSpecific()) ||
+		!inner.Empty() {
+		return nil, errMalformed
+	}
+	if version < 0 || version > 4 {
+		return nil, fmt.Errorf("cms: unsupported EnvelopedData version %d", version)
+	}
+
+	for !infos.Empty() {
+		var tag cryptobyte_asn1.Tag
+		var info cryptobyte.String
+		if !infos.ReadAnyASN1(&info, &tag) {
+			return nil, errMalformed
+		}
+		switch tag {
+		case cryptobyte_asn1.SEQUENCE:
+			ri, err := parseKeyTransRecipientInfo(info)
+			if err != nil {
+				return nil, err
+			}
+			ed.recipients = append(ed.recipients, ri)
+		case cryptobyte_asn1.Tag(1).Constructed().ContextSpecific():
+			ris, err := parseKeyAgreeRecipientInfo(info)
+			if err != nil {
+				return nil, err
+			}
+			ed.recipients = append(ed.recipients, ris...)
+		}
+		// Other recipient types, such as KEKRecipientInfo and
+		// PasswordRecipientInfo, are not supported and ignored.
+	}
+
+	if !eci.ReadASN1ObjectIdentifier(&ed.ContentType) ||
+		!readAlgorithmIdentifier(&eci, &ed.cipherOID, &ed.cipherParams) {
+		return nil, errMalformed
+	}
+	if !eci.Empty() {
+		if !readEncryptedContent(&eci, &ed.encryptedContent) || !eci.Empty() {
+			return nil, errMalformed
+		}
+	}
+	return ed, nil
+}
+
+// readEncryptedContent reads the implicitly tagged encryptedContent OCTET
+// STRING. Converting BER to DER does not join the segments of a constructed
+// string with an implicit tag, so they are joined here.
+func readEncryptedContent(s *cryptobyte.String, out *[]byte) bool {
+	if s.PeekASN1Tag(cryptobyte_asn1.Tag(0).ContextSpecific()) {
+		return s.ReadASN1Bytes(out, cryptobyte_asn1.Tag(0).ContextSpecific())
+	}
+	var segments cryptobyte.String
+	if !s.ReadASN1(&segments, cryptobyte_asn1.Tag(0).Constructed().ContextSpecific()) {
+		return false
+	}
+	content := []byte{}
+	for !segments.Empty() {
+		var seg cryptobyte.String
+		if !segments.ReadASN1(&seg, cryptobyte_asn1.OCTET_STRING) {
+			return false
+		}
+		content = append(content, seg...)
+	}
+	*out = content
+	return true
+}
+
+func readRecipientIdentifier(s *cryptobyte.String, rid *certificateIdentifier) bool {
+	if s.PeekASN1Tag(cryptobyte_asn1.SEQUENCE) {
+		return readIssuerAndSerialNumber(s, &rid.issuerAndSerialNumber)
+	}
+	if !s.ReadASN1Bytes(&rid.subjectKeyID, cryptobyte_asn1.Tag(0).ContextSpecific()) {
+		return false
+	}
+	if rid.subjectKeyID == nil {
+		rid.subjectKeyID = []byte{}
+	}
+	return true
+}
+
+func parseKeyTransRecipientInfo(info cryptobyte.String) (recipientInfo, error) {
+	var ri recipientInfo
+	var version int
+	if !info.ReadASN1Integer(&version) ||
+		!readRecipientIdentifier(&info, &ri.rid) ||
+		!readAlgorithmIdentifier(&info, &ri.keyEncryptionOID, &ri.keyEncryptionParams) ||
+		!info.ReadASN1Bytes(&ri.encryptedKey, cryptobyte_asn1.OCTET_STRING) ||
+		!info.Empty() {
+		return ri, errMalformed
+	}
+	return ri, nil
+}
+
+func parseKeyAgreeRecipientInfo(info cryptobyte.String) ([]recipientInfo, error) {
+	var version int
+	var originator, keys cryptobyte.String
+	var proto recipientInfo
+	proto.keyAgreement = true
+	if !info.ReadASN1Integer(&version) || version != 3 ||
+		!info.ReadASN1(&originator, cryptobyte_asn1.Tag(0).Constructed().ContextSpecific()) {
+		return nil, errMalformed
+	}
+	var originatorKey cryptobyte.String
+	if !originator.ReadASN1(&originatorKey, cryptobyte_asn1.Tag(1).Constructed().ContextSpecific()) ||
+		!readAlgorithmIdentifier(&originatorKey, &proto.originatorOID, &proto.originatorParams) ||
+		!originatorKey.ReadASN1BitStringAsBytes(&proto.originatorKey) ||
+		!originatorKey.Empty() || !originator.Empty() {
+		// Static-static key agreement, where the originator is identified
+		// by a certificate, is not supported.
+		return nil, errors.New("cms: unsupported key agreement originator")
+	}
+	if info.PeekASN1Tag(cryptobyte_asn1.Tag(1).Constructed().ContextSpecific()) {
+		var ukm cryptobyte.String
+		if !info.ReadASN1(&ukm, cryptobyte_asn1.Tag(1).Constructed().ContextSpecific()) ||
+			!ukm.ReadASN1Bytes(&proto.ukm, cryptobyte_asn1.OCTET_STRING) || !ukm.Empty() {
+			return nil, errMalformed
+		}
+	}
+	if !readAlgorithmIdentifier(&info, &proto.keyEncryptionOID, &proto.keyEncryptionParams) ||
+		!info.ReadASN1(&keys, cryptobyte_asn1.SEQUENCE) || !info.Empty() {
+		return nil, errMalformed
+	}
+
+	var ris []recipientInfo
+	for !keys.Empty() {
+		var rek cryptobyte.String
+		ri := proto
+		if !keys.ReadASN1(&rek, cryptobyte_asn1.SEQUENCE) {
+			return nil, errMalformed
+		}
+		if rek.PeekASN1Tag(cryptobyte_asn1.Tag(0).Constructed().ContextSpecific()) {
+			// rKeyId RecipientKeyIdentifier, which starts with the subject
+			// key identifier followed by optional fields we ignore.
+			var rkeyID cryptobyte.String
+			if !rek.ReadASN1(&rkeyID, cryptobyte_asn1.Tag(0).Constructed().ContextSpecific()) ||
+				!rkeyID.ReadASN1Bytes(&ri.rid.subjectKeyID, cryptobyte_asn1.OCTET_STRING) {
+				return nil, errMalformed
+			}
+			if ri.rid.subjectKeyID == nil {
+				ri.rid.subjectKeyID = []byte{}
+			}
+		} else if !readIssuerAndSerialNumber(&rek, &ri.rid.issuerAndSerialNumber) {
+			return nil, errMalformed
+		}
+		if !rek.ReadASN1Bytes(&ri.encryptedKey, cryptobyte_asn1.OCTET_STRING) || !rek.Empty() {
+			return nil, errMalformed
+		}
+		ris = append(ris, ri)
+	}
+	return ris, nil
+}
+
+// Decrypt decrypts the content for the recipient identified by cert, using
+// key, which must be the private key of cert.
+//
+// key must be an *[rsa.PrivateKey] or a [crypto.Decrypter] with an RSA public
+// key for key transport, or an *[ecdsa.PrivateKey] or *[ecdh.PrivateKey] for
+// key agreement.
+func (ed *EnvelopedData) Decrypt(cert *x509.Certificate, key crypto.PrivateKey) ([]byte, error) {
+	var keyLen int
+	switch {
+	case ed.cipherOID.Equal(oidAES128CBC):
+		keyLen = 16
+	case ed.cipherOID.Equal(oidAES192CBC):
+		keyLen = 24
+	case ed.cipherOID.Equal(oidAES256CBC):
+		keyLen = 32
+	default:
+		return nil, fmt.Errorf("cms: unsupported content encryption algorithm %v", ed.cipherOID)
+	}
+	var iv []byte
+	params := ed.cipherParams
+	if !params.ReadASN1Bytes(&iv, cryptobyte_asn1.OCTET_STRING) || !params.Empty() || len(iv) != aes.BlockSize {
+		return nil, errors.New("cms: invalid content encryption parameters")
+	}
+	if len(ed.encryptedContent) == 0 || len(ed.encryptedContent)%aes.BlockSize != 0 {
+		return nil, errors.New("cms: invalid encrypted content length")
+	}
+
+	var ri *recipientInfo
+	for i := range ed.recipients {
+		if ed.recipients[i].rid.matches(cert) {
+			ri = &ed.recipients[i]
+			break
+		}
+	}
+	if ri == nil {
+		return nil, errors.New("cms: no matching recipient")
+	}
+
+	var cek []byte
+	var err error
+	if ri.keyAgreement {
+		cek, err = ri.agreeKey(key)
+	} else {
+		cek, err = ri.decryptKey(key, keyLen)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(cek) != keyLen {
+		return nil, errors.New("cms: invalid content encryption key length")
+	}
+
+	block, err := aes.NewCipher(cek)
+	if err != nil {
+		return nil, err
+	}
+	plaintext := make([]byte, len(ed.encryptedContent))
+	cipher.NewCBCDecrypter(block, iv).CryptBlocks(plaintext, ed.encryptedContent)
+	padLen := int(plaintext[len(plaintext)-1])
+	if padLen == 0 || padLen > aes.BlockSize {
+		return nil, errors.New("cms: decryption failed")
+	}
+	pad := plaintext[len(plaintext)-padLen:]
+	for _, p := range pad {
+		if int(p) != padLen {
+			return nil, errors.New("cms: decryption failed")
+		}
+	}
+	return plaintext[:len(plaintext)-padLen], nil
+}
+
+// decryptKey decrypts the content encryption key of a key transport
+// recipient.
+func (ri *recipientInfo) decryptKey(key crypto.PrivateKey, keyLen int) ([]byte, error) {
+	decrypter, ok := key.(crypto.Decrypter)
+	if !ok {
+		return nil, fmt.Errorf("cms: unsupported private key type %T for key transport", key)
+	}
+	if _, ok := decrypter.Public().(*rsa.PublicKey); !ok {
+		return nil, errors.New("cms: key transport requires an RSA private key")
+	}
+	switch {
+	case ri.keyEncryptionOID.Equal(oidPublicKeyRSA):
+		if !isAbsentOrNull(ri.keyEncryptionParams) {
+			return nil, errMalformed
+		}
+		// Using SessionKeyLen makes failures indistinguishable from an
+		// incorrect key, as recommended to avoid Bleichenbacher attacks.
+		return decrypter.Decrypt(rand.Reader, ri.encryptedKey, &rsa.PKCS1v15DecryptOptions{SessionKeyLen: keyLen})
+	case ri.keyEncryptionOID.Equal(oidRSAESOAEP):
+		hash, mgfHash, err := parseOAEPParameters(ri.keyEncryptionParams)
+		if err != nil {
+			return nil, err
+		}
+		return decrypter.Decrypt(rand.Reader, ri.encryptedKey, &rsa.OAEPOptions{Hash: hash, MGFHash: mgfHash})
+	}
+	return nil, fmt.Errorf("cms: unsupported key encryption algorithm %v", ri.keyEncryptionOID)
+}
+
+// parseOAEPParameters parses RSAES-OAEP-params, RFC 8017, Appendix A.2.1,
+// returning the hash and MGF1 hash functions. Labels are not supported.
+func parseOAEPParameters(params cryptobyte.String) (hash, mgfHash crypto.Hash, err error) {
+	hash, mgfHash = crypto.SHA1, crypto.SHA1
+	if len(params) == 0 {
+		return hash, mgfHash, nil
+	}
+	var seq cryptobyte.String
+	if !params.ReadASN1(&seq, cryptobyte_asn1.SEQUENCE) || !params.Empty() {
+		return 0, 0, errMalformed
+	}
+	if seq.PeekASN1Tag(cryptobyte_asn1.Tag(0).Constructed().ContextSpecific()) {
+		var hashAlg, hashParams cryptobyte.String
+		var oid asn1.ObjectIdentifier
+		var ok bool
+		if !seq.ReadASN1(&hashAlg, cryptobyte_asn1.Tag(0).Constructed().ContextSpecific()) ||
+			!readAlgorithmIdentifier(&hashAlg, &oid, &hashParams) || !isAbsentOrNull(hashParams) {
+			return 0, 0, errMalformed
+		}
+		if hash, ok = hashForOID(oid); !ok {
+			return 0, 0, fmt.Errorf("cms: unsupported OAEP hash %v", oid)
+		}
+	}
+	if seq.PeekASN1Tag(cryptobyte_asn1.Tag(1).Constructed().ContextSpecific()) {
+		var mgf, mgfParams, mgfHashAlg, mgfHashParams cryptobyte.String
+		var oid, hashOID asn1.ObjectIdentifier
+		var ok bool
+		if !seq.ReadASN1(&mgf, cryptobyte_asn1.Tag(1).Constructed().ContextSpecific()) ||
+			!readAlgorithmIdentifier(&mgf, &oid, &mgfParams) || !oid.Equal(oidMGF1) {
+			return 0, 0, errors.New("cms: unsupported OAEP mask generation function")
+		}
+		mgfHashAlg = mgfParams
+		if !readAlgorithmIdentifier(&mgfHashAlg, &hashOID, &mgfHashParams) || !isAbsentOrNull(mgfHashParams) {
+			return 0, 0, errMalformed
+		}
+		if mgfHash, ok = hashForOID(hashOID); !ok {
+			return 0, 0, fmt.Errorf("cms: unsupported OAEP MGF1 hash %v", hashOID)
+		}
+	}
+	if !seq.Empty() {
+		return 0, 0, errors.New("cms: unsupported OAEP parameters")
+	}
+	return hash, mgfHash, nil
+}
+
+// agreeKey unwraps the content encryption key of a key agreement recipient.
+func (ri *recipientInfo) agreeKey(key crypto.PrivateKey) ([]byte, error) {
+	var priv *ecdh.PrivateKey
+	switch k := key.(type) {
+	case *ecdh.PrivateKey:
+		priv = k
+	case *ecdsa.PrivateKey:
+		var err error
+		if priv, err = k.ECDH(); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("cms: unsupported private key type %T for key agreement", key)
+	}
+
+	isX25519 := priv.Curve() == ecdh.X25519()
+	switch {
+	case ri.originatorOID.Equal(oidPublicKeyECDSA) && !isX25519:
+	case ri.originatorOID.Equal(oidPublicKeyX25519) && isX25519 && len(ri.originatorParams) == 0:
+	default:
+		return nil, errors.New("cms: originator key does not match recipient key")
+	}
+	originator, err := priv.Curve().NewPublicKey(ri.originatorKey)
+	if err != nil {
+		return nil, err
+	}
+	shared, err := priv.ECDH(originator)
+	if err != nil {
+		return nil, err
+	}
+
+	var wrapOID asn1.ObjectIdentifier
+	var wrapParams cryptobyte.String
+	params := ri.keyEncryptionParams
+	if !readAlgorithmIdentifier(&params, &wrapOID, &wrapParams) || !params.Empty() || !isAbsentOrNull(wrapParams) {
+		return nil, errMalformed
+	}
+	kek, err := deriveKEK(ri.keyEncryptionOID, wrapOID, shared, ri.ukm)
+	if err != nil {
+		return nil, err
+	}
+	return unwrapKey(kek, ri.encryptedKey)
+}
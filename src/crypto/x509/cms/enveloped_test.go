@@ -0,0 +1,143 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cms
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"testing"
+)
+
+func TestEncryptDecrypt(t *testing.T) {
+	ca := newTestCA(t)
+	p256, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p384, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p521, err := ecdsa.GenerateKey(elliptic.P521(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	recipients := []struct {
+		name string
+		pub  crypto.PublicKey
+		priv crypto.PrivateKey
+	}{
+		{"RSA", testRSAKey.Public(), testRSAKey},
+		{"P-256", p256.Public(), p256},
+		{"P-384", p384.Public(), p384},
+		{"P-521", p521.Public(), p521},
+	}
+	var certs []*x509.Certificate
+	for i, r := range recipients {
+		certs = append(certs, ca.issue(t, int64(i+2), r.pub))
+	}
+	// crypto/x509 can't create certificates for X25519 keys, so use one
+	// generated by OpenSSL.
+	recipients = append(recipients, struct {
+		name string
+		pub  crypto.PublicKey
+		priv crypto.PrivateKey
+	}{"X25519", nil, readPrivateKey(t, "x25519.key")})
+	certs = append(certs, readCertificate(t, "x25519.pem"))
+
+	for _, content := range [][]byte{
+		nil,
+		[]byte("0123456789abcdef"),
+		[]byte("Hello, Gophers!"),
+		bytes.Repeat([]byte("large content "), 1000),
+	} {
+		for _, opts := range []*EncryptOptions{
+			nil,
+			{ContentEncryptionAlgorithm: AES128CBC},
+			{ContentEncryptionAlgorithm: AES192CBC, OAEP: true},
+		} {
+			der, err := Encrypt(content, certs, opts)
+			if err != nil {
+				t.Fatalf("Encrypt: %v", err)
+			}
+			ed, err := ParseEnvelopedData(der)
+			if err != nil {
+				t.Fatalf("ParseEnvelopedData: %v", err)
+			}
+			if !ed.ContentType.Equal(OIDData) {
+				t.Errorf("got content type %v, want %v", ed.ContentType, OIDData)
+			}
+			for i, r := range recipients {
+				got, err := ed.Decrypt(certs[i], r.priv)
+				if err != nil {
+					t.Errorf("%s: Decrypt: %v", r.name, err)
+					continue
+				}
+				if !bytes.Equal(got, content) {
+					t.Errorf("%s: got %q, want %q", r.name, got, content)
+				}
+			}
+		}
+	}
+}
+
+func TestDecryptWrongRecipient(t *testing.T) {
+	ca := newTestCA(t)
+	cert := ca.issue(t, 2, testRSAKey.Public())
+	other := ca.issue(t, 3, testRSAKey.Public())
+	der, err := Encrypt([]byte("secret"), []*x509.Certificate{cert}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ed, err := ParseEnvelopedData(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ed.Decrypt(other, testRSAKey); err == nil {
+		t.Errorf("Decrypt succeeded for a certificate that is not a recipient")
+	}
+
+	p256, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ed.Decrypt(cert, p256); err == nil {
+		t.Errorf("Decrypt succeeded with the wrong key type")
+	}
+}
+
+func TestDecryptOpenSSL(t *testing.T) {
+	content := readTestdata(t, "msg.txt")
+	rsaCert, rsaKey := readCertificate(t, "rsa.pem"), readPrivateKey(t, "rsa.key")
+	ecCert, ecKey := readCertificate(t, "ec.pem"), readPrivateKey(t, "ec.key")
+	for _, tt := range []struct {
+		file string
+		cert *x509.Certificate
+		key  crypto.PrivateKey
+	}{
+		{"enveloped-rsa.der", rsaCert, rsaKey},
+		{"enveloped-rsa-stream.der", rsaCert, rsaKey},
+		{"enveloped-rsa-oaep.der", rsaCert, rsaKey},
+		{"enveloped-ec.der", ecCert, ecKey},
+		{"enveloped-ec-sha256.der", ecCert, ecKey},
+	} {
+		ed, err := ParseEnvelopedData(readTestdata(t, tt.file))
+		if err != nil {
+			t.Fatalf("%s: %v", tt.file, err)
+		}
+		got, err := ed.Decrypt(tt.cert, tt.key)
+		if err != nil {
+			t.Errorf("%s: Decrypt: %v", tt.file, err)
+			continue
+		}
+		if !bytes.Equal(got, content) {
+			t.Errorf("%s: got %q, want %q", tt.file, got, content)
+		}
+	}
+}
@@ -0,0 +1,66 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cms
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+)
+
+// Test vectors from RFC 3394, Section 4.
+var keyWrapTests = []struct {
+	kek, key, wrapped string
+}{
+	{
+		"000102030405060708090A0B0C0D0E0F",
+		"00112233445566778899AABBCCDDEEFF",
+		"1FA68B0A8112B447AEF34BD8FB5A7B829D3E862371D2CFE5",
+	},
+	{
+		"000102030405060708090A0B0C0D0E0F1011121314151617",
+		"00112233445566778899AABBCCDDEEFF",
+		"96778B25AE6CA435F92B5B97C050AED2468AB8A17AD84E5D",
+	},
+	{
+		"000102030405060708090A0B0C0D0E0F101112131415161718191A1B1C1D1E1F",
+		"00112233445566778899AABBCCDDEEFF0001020304050607",
+		"A8F9BC1612C68B3FF6E6F4FBE30E71E4769C8B80A32CB8958CD5D17D6B254DA1",
+	},
+	{
+		"000102030405060708090A0B0C0D0E0F101112131415161718191A1B1C1D1E1F",
+		"00112233445566778899AABBCCDDEEFF000102030405060708090A0B0C0D0E0F",
+		"28C9F404C4B810F4CBCCB35CFB87F8263F5786E2D80ED326CBC7F0E71A99F43BFB988B9B7A02DD21",
+	},
+}
+
+func TestKeyWrap(t *testing.T) {
+	for _, tt := range keyWrapTests {
+		kek, _ := hex.DecodeString(tt.kek)
+		key, _ := hex.DecodeString(tt.key)
+		want, _ := hex.DecodeString(tt.wrapped)
+
+		got, err := wrapKey(kek, key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(got, want) {
+			t.Errorf("wrapKey(%s, %s) = %X, want %X", tt.kek, tt.key, got, want)
+		}
+
+		unwrapped, err := unwrapKey(kek, want)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(unwrapped, key) {
+			t.Errorf("unwrapKey(%s, %s) = %X, want %X", tt.kek, tt.wrapped, unwrapped, key)
+		}
+
+		want[len(want)-1] ^= 1
+		if _, err := unwrapKey(kek, want); err == nil {
+			t.Errorf("unwrapKey succeeded with corrupted input")
+		}
+	}
+}
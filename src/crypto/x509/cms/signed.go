@@ -0,0 +1,702 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cms
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/asn1"
+	"errors"
+	"fmt"
+	"time"
+
+	"golang.org/x/crypto/cryptobyte"
+	cryptobyte_asn1 "golang.org/x/crypto/cryptobyte/asn1"
+)
+
+var (
+	oidPublicKeyRSA             = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 1, 1}
+	oidSignatureSHA1WithRSA     = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 1, 5}
+	oidSignatureSHA256WithRSA   = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 1, 11}
+	oidSignatureSHA384WithRSA   = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 1, 12}
+	oidSignatureSHA512WithRSA   = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 1, 13}
+	oidSignatureRSAPSS          = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 1, 10}
+	oidSignatureECDSAWithSHA1   = asn1.ObjectIdentifier{1, 2, 840, 10045, 4, 1}
+	oidSignatureECDSAWithSHA256 = asn1.ObjectIdentifier{1, 2, 840, 10045, 4, 3, 2}
+	oidSignatureECDSAWithSHA384 = asn1.ObjectIdentifier{1, 2, 840, 10045, 4, 3, 3}
+	oidSignatureECDSAWithSHA512 = asn1.ObjectIdentifier{1, 2, 840, 10045, 4, 3, 4}
+	oidSignatureEd25519         = asn1.ObjectIdentifier{1, 3, 101, 112}
+)
+
+// DER encoded RSASSA-PSS-params for SHA-256, SHA-384 and SHA-512, with MGF1
+// using the same hash and a salt length equal to the hash length. These are
+// the only PSS parameters accepted by crypto/x509.
+var (
+	pssParametersSHA256 = []byte{48, 52, 160, 15, 48, 13, 6, 9, 96, 134, 72, 1, 101, 3, 4, 2, 1, 5, 0, 161, 28, 48, 26, 6, 9, 42, 134, 72, 134, 247, 13, 1, 1, 8, 48, 13, 6, 9, 96, 134, 72, 1, 101, 3, 4, 2, 1, 5, 0, 162, 3, 2, 1, 32}
+	pssParametersSHA384 = []byte{48, 52, 160, 15, 48, 13, 6, 9, 96, 134, 72, 1, 101, 3, 4, 2, 2, 5, 0, 161, 28, 48, 26, 6, 9, 42, 134, 72, 134, 247, 13, 1, 1, 8, 48, 13, 6, 9, 96, 134, 72, 1, 101, 3, 4, 2, 2, 5, 0, 162, 3, 2, 1, 48}
+	pssParametersSHA512 = []byte{48, 52, 160, 15, 48, 13, 6, 9, 96, 134, 72, 1, 101, 3, 4, 2, 3, 5, 0, 161, 28, 48, 26, 6, 9, 42, 134, 72, 134, 247, 13, 1, 1, 8, 48, 13, 6, 9, 96, 134, 72, 1, 101, 3, 4, 2, 3, 5, 0, 162, 3, 2, 1, 64}
+)
+
+// SignedData is a parsed CMS SignedData message, RFC 5652, Section 5.
+type SignedData struct {
+	// Raw contains the complete DER encoded ContentInfo.
+	Raw []byte
+
+	// ContentType is the type of the encapsulated content, usually
+	// [OIDData].
+	ContentType asn1.ObjectIdentifier
+
+	// Content is the encapsulated content, or nil if the signature is
+	// detached.
+	Content []byte
+
+	// Certificates are the certificates included in the message, which
+	// usually contain the signers' certificates and their intermediates.
+	Certificates []*x509.Certificate
+
+	// CRLs are the certificate revocation lists included in the message.
+	CRLs []*x509.RevocationList
+
+	// Signers describes the signatures present in the message.
+	Signers []*SignerInfo
+}
+
+// SignerInfo describes a single signature of a [SignedData] message,
+// RFC 5652, Section 5.3.
+type SignerInfo struct {
+	// Certificate is the signer's certificate, if it is included in the
+	// message. Otherwise it is nil and verification will fail.
+	Certificate *x509.Certificate
+
+	// Hash is the digest algorithm used for the content.
+	Hash crypto.Hash
+
+	// SignatureAlgorithm is the algorithm used for the signature, or
+	// x509.UnknownSignatureAlgorithm if it is not supported.
+	SignatureAlgorithm x509.SignatureAlgorithm
+
+	// SignedAttributes are the authenticated attributes of the signature.
+	// If present, they include the content type and message digest.
+	SignedAttributes []Attribute
+
+	// UnsignedAttributes are the attributes not covered by the signature,
+	// such as counter-signatures and time-stamp tokens.
+	UnsignedAttributes []Attribute
+
+	// Signature is the signature value.
+	Signature []byte
+
+	sid            certificateIdentifier
+	rawSignedAttrs []byte // the SET OF Attribute covered by the signature
+}
+
+// SigningTime returns the value of the signing-time signed attribute, and
+// whether it was present and well formed.
+func (si *SignerInfo) SigningTime() (time.Time, bool) {
+	for _, a := range si.SignedAttributes {
+		if !a.Type.Equal(oidAttributeSigningTime) {
+			continue
+		}
+		v, ok := a.value()
+		if !ok {
+			return time.Time{}, false
+		}
+		var t time.Time
+		switch {
+		case v.PeekASN1Tag(cryptobyte_asn1.UTCTime):
+			ok = v.ReadASN1UTCTime(&t)
+		case v.PeekASN1Tag(cryptobyte_asn1.GeneralizedTime):
+			ok = v.ReadASN1GeneralizedTime(&t)
+		default:
+			ok = false
+		}
+		return t, ok && v.Empty()
+	}
+	return time.Time{}, false
+}
+
+// SignOptions contains optional parameters for [Sign].
+type SignOptions struct {
+	// Hash is the digest algorithm used for the content and the signed
+	// attributes. If zero, SHA-256 is used, except for Ed25519 keys which
+	// always use SHA-512 as required by RFC 8419.
+	Hash crypto.Hash
+
+	// Detached causes the content to be omitted from the message, so that
+	// it needs to be supplied separately to [SignedData.VerifyDetached].
+	Detached bool
+
+	// ContentType is the type of the content. If nil, [OIDData] is used.
+	ContentType asn1.ObjectIdentifier
+
+	// Certificates are included in the message in addition to the signer's
+	// certificate, typically to supply intermediates to verifiers.
+	Certificates []*x509.Certificate
+
+	// SigningTime, if not zero, is recorded in a signing-time signed
+	// attribute.
+	SigningTime time.Time
+
+	// SignedAttributes are added to the content-type and message-digest
+	// signed attributes generated by Sign.
+	SignedAttributes []Attribute
+
+	// UnsignedAttributes are included in the signer information without
+	// being covered by the signature.
+	UnsignedAttributes []Attribute
+
+	// PSS selects RSASSA-PSS signatures, with a salt length equal to the
+	// hash length, instead of PKCS #1 v1.5 signatures for RSA keys.
+	PSS bool
+
+	// NoSignedAttributes causes the signature to be computed directly over
+	// the content, without any signed attributes. It is only allowed if
+	// ContentType is nil or [OIDData], and SigningTime and SignedAttributes
+	// are empty.
+	NoSignedAttributes bool
+}
+
+// Sign returns a DER encoded ContentInfo containing a SignedData message
+// with content signed by key, which must be the private key of cert.
+//
+// RSA, ECDSA and Ed25519 keys are supported.
+func Sign(content []byte, cert *x509.Certificate, key crypto.Signer, opts *SignOptions) ([]byte, error) {
+	if opts == nil {
+		opts = &SignOptions{}
+	}
+	contentType := opts.ContentType
+	if contentType == nil {
+		contentType = oidData
+	}
+	if opts.NoSignedAttributes && (!contentType.Equal(oidData) ||
+		!opts.SigningTime.IsZero() || len(opts.SignedAttributes) > 0) {
+		return nil, errors.New("cms: signed attributes are required with non-data content or additional attributes")
+	}
+
+	hash := opts.Hash
+	if _, ok := key.Public().(ed25519.PublicKey); ok {
+		if hash != 0 && hash != crypto.SHA512 {
+			return nil, errors.New("cms: Ed25519 requires SHA-512")
+		}
+		hash = crypto.SHA512
+	} else if hash == 0 {
+		hash = crypto.SHA256
+	}
+	digestOID, ok := oidForHash(hash)
+	if !ok || !hash.Available() {
+		return nil, fmt.Errorf("cms: unsupported hash function %v", hash)
+	}
+	sigOID, sigParams, signerOpts, err := signingParams(key.Public(), hash, opts.PSS)
+	if err != nil {
+		return nil, err
+	}
+
+	h := hash.New()
+	h.Write(content)
+	digest := h.Sum(nil)
+
+	var signedAttrs []byte
+	toSign := content
+	if !opts.NoSignedAttributes {
+		attrs := []Attribute{
+			{Type: oidAttributeContentType, Values: []asn1.RawValue{rawOID(contentType)}},
+			{Type: oidAttributeMessageDigest, Values: []asn1.RawValue{rawOctetString(digest)}},
+		}
+		if !opts.SigningTime.IsZero() {
+			attrs = append(attrs, Attribute{Type: oidAttributeSigningTime, Values: []asn1.RawValue{rawTime(opts.SigningTime)}})
+		}
+		attrs = append(attrs, opts.SignedAttributes...)
+		encoded, err := marshalAttributes(attrs)
+		if err != nil {
+			return nil, err
+		}
+		var b cryptobyte.Builder
+		b.AddASN1(cryptobyte_asn1.SET, func(b *cryptobyte.Builder) {
+			for _, e := range encoded {
+				b.AddBytes(e)
+			}
+		})
+		if signedAttrs, err = b.Bytes(); err != nil {
+			return nil, err
+		}
+		toSign = signedAttrs
+	}
+
+	signed := toSign
+	if signerOpts.HashFunc() != 0 {
+		h := hash.New()
+		h.Write(toSign)
+		signed = h.Sum(nil)
+	}
+	signature, err := key.Sign(rand.Reader, signed, signerOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	var unsignedAttrs [][]byte
+	if len(opts.UnsignedAttributes) > 0 {
+		if unsignedAttrs, err = marshalAttributes(opts.UnsignedAttributes); err != nil {
+			return nil, err
+		}
+	}
+
+	version := int64(1)
+	if !contentType.Equal(oidData) {
+		version = 3
+	}
+	var b cryptobyte.Builder
+	b.AddASN1(cryptobyte_asn1.SEQUENCE, func(b *cryptobyte.Builder) {
+		b.AddASN1Int64(version)
+		b.AddASN1(cryptobyte_asn1.SET, func(b *cryptobyte.Builder) {
+			addAlgorithmIdentifier(b, digestOID, nil)
+		})
+		b.AddASN1(cryptobyte_asn1.SEQUENCE, func(b *cryptobyte.Builder) {
+			b.AddASN1ObjectIdentifier(contentType)
+			if !opts.Detached {
+				b.AddASN1(cryptobyte_asn1.Tag(0).Constructed().ContextSpecific(), func(b *cryptobyte.Builder) {
+					b.AddASN1OctetString(content)
+				})
+			}
+		})
+		b.AddASN1(cryptobyte_asn1.Tag(0).Constructed().ContextSpecific(), func(b *cryptobyte.Builder) {
+			b.AddBytes(cert.Raw)
+			for _, c := range opts.Certificates {
+				if !c.Equal(cert) {
+					b.AddBytes(c.Raw)
+				}
+			}
+		})
+		b.AddASN1(cryptobyte_asn1.SET, func(b *cryptobyte.Builder) {
+			b.AddASN1(cryptobyte_asn1.SEQUENCE, func(b *cryptobyte.Builder) {
+				b.AddASN1Int64(1)
+				addIssuerAndSerialNumber(b, cert)
+				addAlgorithmIdentifier(b, digestOID, nil)
+				if signedAttrs != nil {
+					// The signed attributes are encoded with an implicit [0]
+					// tag instead of the SET tag used for the signature.
+					b.AddASN1(cryptobyte_asn1.Tag(0).Constructed().ContextSpecific(), func(b *cryptobyte.Builder) {
+						inner := cryptobyte.String(signedAttrs)
+						var contents cryptobyte.String
+						inner.ReadASN1(&contents, cryptobyte_asn1.SET)
+						b.AddBytes(contents)
+					})
+				}
+				addAlgorithmIdentifier(b, sigOID, sigParams)
+				b.AddASN1OctetString(signature)
+				if unsignedAttrs != nil {
+					b.AddASN1(cryptobyte_asn1.Tag(1).Constructed().ContextSpecific(), func(b *cryptobyte.Builder) {
+						for _, e := range unsignedAttrs {
+							b.AddBytes(e)
+						}
+					})
+				}
+			})
+		})
+	})
+	sd, err := b.Bytes()
+	if err != nil {
+		return nil, err
+	}
+	return marshalContentInfo(oidSignedData, sd)
+}
+
+// signingParams returns the signature algorithm identifier and the
+// crypto.SignerOpts to use for a signature by pub with the given hash.
+func signingParams(pub crypto.PublicKey, hash crypto.Hash, pss bool) (oid asn1.ObjectIdentifier, params []byte, opts crypto.SignerOpts, err error) {
+	switch pub.(type) {
+	case *rsa.PublicKey:
+		if pss {
+			switch hash {
+			case crypto.SHA256:
+				params = pssParametersSHA256
+			case crypto.SHA384:
+				params = pssParametersSHA384
+			case crypto.SHA512:
+				params = pssParametersSHA512
+			default:
+				return nil, nil, nil, fmt.Errorf("cms: unsupported hash function %v for RSA-PSS", hash)
+			}
+			return oidSignatureRSAPSS, params, &rsa.PSSOptions{SaltLength: rsa.PSSSaltLengthEqualsHash, Hash: hash}, nil
+		}
+		return oidPublicKeyRSA, nullParameters, hash, nil
+	case *ecdsa.PublicKey:
+		switch hash {
+		case crypto.SHA256:
+			return oidSignatureECDSAWithSHA256, nil, hash, nil
+		case crypto.SHA384:
+			return oidSignatureECDSAWithSHA384, nil, hash, nil
+		case crypto.SHA512:
+			return oidSignatureECDSAWithSHA512, nil, hash, nil
+		}
+		return nil, nil, nil, fmt.Errorf("cms: unsupported hash function %v for ECDSA", hash)
+	case ed25519.PublicKey:
+		return oidSignatureEd25519, nil, crypto.Hash(0), nil
+	}
+	return nil, nil, nil, fmt.Errorf("cms: unsupported public key type %T", pub)
+}
+
+// signatureAlgorithm maps the digest and signature algorithm identifiers of
+// a SignerInfo to the equivalent x509.SignatureAlgorithm.
+func signatureAlgorithm(hash crypto.Hash, oid asn1.ObjectIdentifier, params cryptobyte.String) x509.SignatureAlgorithm {
+	switch {
+	case oid.Equal(oidPublicKeyRSA):
+		if !isAbsentOrNull(params) {
+			return x509.UnknownSignatureAlgorithm
+		}
+		switch hash {
+		case crypto.SHA1:
+			return x509.SHA1WithRSA
+		case crypto.SHA256:
+			return x509.SHA256WithRSA
+		case crypto.SHA384:
+			return x509.SHA384WithRSA
+		case crypto.SHA512:
+			return x509.SHA512WithRSA
+		}
+	case oid.Equal(oidSignatureSHA1WithRSA) && hash == crypto.SHA1:
+		return x509.SHA1WithRSA
+	case oid.Equal(oidSignatureSHA256WithRSA) && hash == crypto.SHA256:
+		return x509.SHA256WithRSA
+	case oid.Equal(oidSignatureSHA384WithRSA) && hash == crypto.SHA384:
+		return x509.SHA384WithRSA
+	case oid.Equal(oidSignatureSHA512WithRSA) && hash == crypto.SHA512:
+		return x509.SHA512WithRSA
+	case oid.Equal(oidSignatureRSAPSS):
+		switch {
+		case hash == crypto.SHA256 && bytes.Equal(params, pssParametersSHA256):
+			return x509.SHA256WithRSAPSS
+		case hash == crypto.SHA384 && bytes.Equal(params, pssParametersSHA384):
+			return x509.SHA384WithRSAPSS
+		case hash == crypto.SHA512 && bytes.Equal(params, pssParametersSHA512):
+			return x509.SHA512WithRSAPSS
+		}
+	case oid.Equal(oidSignatureECDSAWithSHA1) && hash == crypto.SHA1:
+		return x509.ECDSAWithSHA1
+	case oid.Equal(oidSignatureECDSAWithSHA256) && hash == crypto.SHA256:
+		return x509.ECDSAWithSHA256
+	case oid.Equal(oidSignatureECDSAWithSHA384) && hash == crypto.SHA384:
+		return x509.ECDSAWithSHA384
+	case oid.Equal(oidSignatureECDSAWithSHA512) && hash == crypto.SHA512:
+		return x509.ECDSAWithSHA512
+	case oid.Equal(oidSignatureEd25519) && len(params) == 0 && hash == crypto.SHA512:
+		return x509.PureEd25519
+	}
+	return x509.UnknownSignatureAlgorithm
+}
+
+// ParseSignedData parses a BER or DER encoded ContentInfo containing a
+// SignedData message. BER input is converted to DER, which Raw then holds.
+// The signatures are not verified.
+func ParseSignedData(data []byte) (*SignedData, error) {
+	der, err := toDER(data)
+	if err != nil {
+		return nil, err
+	}
+	contentType, content, err := parseContentInfo(der)
+	if err != nil {
+		return nil, err
+	}
+	if !contentType.Equal(oidSignedData) {
+		return nil, fmt.Errorf("cms: content type %v is not SignedData", contentType)
+	}
+
+	sd := &SignedData{Raw: der}
+	var inner, digestAlgs, encap, signerInfos cryptobyte.String
+	var version int
+	if !content.ReadASN1(&inner, cryptobyte_asn1.SEQUENCE) || !content.Empty() ||
+		!inner.ReadASN1Integer(&version) ||
+		!inner.ReadASN1(&digestAlgs, cryptobyte_asn1.SET) ||
+		!inner.ReadASN1(&encap, cryptobyte_asn1.SEQUENCE) ||
+		!encap.ReadASN1ObjectIdentifier(&sd.ContentType) {
+		return nil, errMalformed
+	}
+	if version < 1 || version > 5 {
+		return nil, fmt.Errorf("cms: unsupported SignedData version %d", version)
+	}
+	if !encap.Empty() {
+		var eContent cryptobyte.String
+		if !encap.ReadASN1(&eContent, cryptobyte_asn1.Tag(0).Constructed().ContextSpecific()) ||
+			!encap.Empty() ||
+			!eContent.ReadASN1Bytes(&sd.Content, cryptobyte_asn1.OCTET_STRING) ||
+			!eContent.Empty() {
+			return nil, errMalformed
+		}
+		if sd.Content == nil {
+			sd.Content = []byte{}
+		}
+	}
+
+	var certs cryptobyte.String
+	var hasCerts bool
+	if !inner.ReadOptionalASN1(&certs, &hasCerts, cryptobyte_asn1.Tag(0).Constructed().ContextSpecific()) {
+		return nil, errMalformed
+	}
+	for !certs.Empty() {
+		var tag cryptobyte_asn1.Tag
+		var raw cryptobyte.String
+		if !certs.ReadAnyASN1Element(&raw, &tag) {
+			return nil, errMalformed
+		}
+		// Other certificate formats, such as attribute certificates, are
+		// identified by context-specific tags and skipped.
+		if tag != cryptobyte_asn1.SEQUENCE {
+			continue
+		}
+		cert, err := x509.ParseCertificate(raw)
+		if err != nil {
+			return nil, err
+		}
+		sd.Certificates = append(sd.Certificates, cert)
+	}
+
+	var crls cryptobyte.String
+	var hasCRLs bool
+	if !inner.ReadOptionalASN1(&crls, &hasCRLs, cryptobyte_asn1.Tag(1).Constructed().ContextSpecific()) {
+		return nil, errMalformed
+	}
+	for !crls.Empty() {
+		var tag cryptobyte_asn1.Tag
+		var raw cryptobyte.String
+		if !crls.ReadAnyASN1Element(&raw, &tag) {
+			return nil, errMalformed
+		}
+		if tag != cryptobyte_asn1.SEQUENCE {
+			continue
+		}
+		crl, err := x509.ParseRevocationList(raw)
+		if err != nil {
+			return nil, err
+		}
+		sd.CRLs = append(sd.CRLs, crl)
+	}
+
+	if !inner.ReadASN1(&signerInfos, cryptobyte_asn1.SET) || !inner.Empty() {
+		return nil, errMalformed
+	}
+	for !signerInfos.Empty() {
+		si, err := parseSignerInfo(&signerInfos)
+		if err != nil {
+			return nil, err
+		}
+		for _, cert := range sd.Certificates {
+			if si.sid.matches(cert) {
+				si.Certificate = cert
+				break
+			}
+		}
+		sd.Signers = append(sd.Signers, si)
+	}
+	return sd, nil
+}
+
+func parseSignerInfo(s *cryptobyte.String) (*SignerInfo, error) {
+	si := new(SignerInfo)
+	var inner cryptobyte.String
+	var version int
+	if !s.ReadASN1(&inner, cryptobyte_asn1.SEQUENCE) || !inner.ReadASN1Integer(&version) {
+		return nil, errMalformed
+	}
+	switch version {
+	case 1:
+		if !readIssuerAndSerialNumber(&inner, &si.sid.issuerAndSerialNumber) {
+			return nil, errMalformed
+		}
+	case 3:
+		if !inner.ReadASN1Bytes(&si.sid.subjectKeyID, cryptobyte_asn1.Tag(0).ContextSpecific()) {
+			return nil, errMalformed
+		}
+		if si.sid.subjectKeyID == nil {
+			si.sid.subjectKeyID = []byte{}
+		}
+	default:
+		return nil, fmt.Errorf("cms: unsupported SignerInfo version %d", version)
+	}
+
+	var digestOID, sigOID asn1.ObjectIdentifier
+	var digestParams, sigParams cryptobyte.String
+	if !readAlgorithmIdentifier(&inner, &digestOID, &digestParams) || !isAbsentOrNull(digestParams) {
+		return nil, errMalformed
+	}
+	si.Hash, _ = hashForOID(digestOID)
+
+	signedAttrsTag := cryptobyte_asn1.Tag(0).Constructed().ContextSpecific()
+	if inner.PeekASN1Tag(signedAttrsTag) {
+		var attrs cryptobyte.String
+		if !inner.ReadASN1(&attrs, signedAttrsTag) {
+			return nil, errMalformed
+		}
+		var err error
+		if si.SignedAttributes, err = parseAttributes(attrs); err != nil {
+			return nil, err
+		}
+		// The signature covers the DER encoding of the attributes with an
+		// explicit SET OF tag, RFC 5652, Section 5.4.
+		var b cryptobyte.Builder
+		b.AddASN1(cryptobyte_asn1.SET, func(b *cryptobyte.Builder) {
+			b.AddBytes(attrs)
+		})
+		if si.rawSignedAttrs, err = b.Bytes(); err != nil {
+			return nil, err
+		}
+	}
+
+	if !readAlgorithmIdentifier(&inner, &sigOID, &sigParams) ||
+		!inner.ReadASN1Bytes(&si.Signature, cryptobyte_asn1.OCTET_STRING) {
+		return nil, errMalformed
+	}
+	si.SignatureAlgorithm = signatureAlgorithm(si.Hash, sigOID, sigParams)
+
+	unsignedAttrsTag := cryptobyte_asn1.Tag(1).Constructed().ContextSpecific()
+	if inner.PeekASN1Tag(unsignedAttrsTag) {
+		var attrs cryptobyte.String
+		if !inner.ReadASN1(&attrs, unsignedAttrsTag) {
+			return nil, errMalformed
+		}
+		var err error
+		if si.UnsignedAttributes, err = parseAttributes(attrs); err != nil {
+			return nil, err
+		}
+	}
+	if !inner.Empty() {
+		return nil, errMalformed
+	}
+	return si, nil
+}
+
+// Verify checks the signatures over the encapsulated content, and verifies
+// each signer's certificate using opts, as described by
+// [x509.Certificate.Verify]. The certificates included in the message are
+// added to opts.Intermediates. If opts.KeyUsages is empty,
+// [x509.ExtKeyUsageAny] is used rather than [x509.ExtKeyUsageServerAuth].
+//
+// Verify returns an error if the message is detached or has no signers, or
+// if any of the signatures or certificates fail verification.
+func (sd *SignedData) Verify(opts x509.VerifyOptions) error {
+	if sd.Content == nil {
+		return errors.New("cms: content is detached")
+	}
+	return sd.verify(sd.Content, opts)
+}
+
+// VerifyDetached is like [SignedData.Verify], but verifies the signatures
+// over the detached content. It returns an error if the message contains
+// encapsulated content.
+func (sd *SignedData) VerifyDetached(content []byte, opts x509.VerifyOptions) error {
+	if sd.Content != nil {
+		return errors.New("cms: content is not detached")
+	}
+	return sd.verify(content, opts)
+}
+
+func (sd *SignedData) verify(content []byte, opts x509.VerifyOptions) error {
+	if len(sd.Signers) == 0 {
+		return errors.New("cms: message has no signers")
+	}
+	if opts.Intermediates == nil {
+		opts.Intermediates = x509.NewCertPool()
+	} else {
+		opts.Intermediates = opts.Intermediates.Clone()
+	}
+	for _, cert := range sd.Certificates {
+		opts.Intermediates.AddCert(cert)
+	}
+	if len(opts.KeyUsages) == 0 {
+		opts.KeyUsages = []x509.ExtKeyUsage{x509.ExtKeyUsageAny}
+	}
+	for _, si := range sd.Signers {
+		if err := si.checkSignature(sd.ContentType, content); err != nil {
+			return err
+		}
+		if _, err := si.Certificate.Verify(opts); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkSignature verifies the signature of si over content, without
+// verifying the signer's certificate.
+func (si *SignerInfo) checkSignature(contentType asn1.ObjectIdentifier, content []byte) error {
+	if si.Certificate == nil {
+		return errors.New("cms: signer certificate not found")
+	}
+	if si.Hash == 0 || !si.Hash.Available() {
+		return errors.New("cms: unsupported digest algorithm")
+	}
+	if si.SignatureAlgorithm == x509.UnknownSignatureAlgorithm {
+		return errors.New("cms: unsupported signature algorithm")
+	}
+
+	signed := content
+	if si.rawSignedAttrs != nil {
+		h := si.Hash.New()
+		h.Write(content)
+		digest := h.Sum(nil)
+
+		var foundDigest, foundType bool
+		for _, a := range si.SignedAttributes {
+			switch {
+			case a.Type.Equal(oidAttributeMessageDigest):
+				v, ok := a.value()
+				var md []byte
+				if foundDigest || !ok || !v.ReadASN1Bytes(&md, cryptobyte_asn1.OCTET_STRING) || !v.Empty() {
+					return errors.New("cms: invalid message-digest attribute")
+				}
+				if !bytes.Equal(md, digest) {
+					return errors.New("cms: message digest mismatch")
+				}
+				foundDigest = true
+			case a.Type.Equal(oidAttributeContentType):
+				v, ok := a.value()
+				var ct asn1.ObjectIdentifier
+				if foundType || !ok || !v.ReadASN1ObjectIdentifier(&ct) || !v.Empty() {
+					return errors.New("cms: invalid content-type attribute")
+				}
+				if !ct.Equal(contentType) {
+					return errors.New("cms: content type mismatch")
+				}
+				foundType = true
+			}
+		}
+		if !foundDigest || !foundType {
+			return errors.New("cms: missing required signed attributes")
+		}
+		signed = si.rawSignedAttrs
+	} else if !contentType.Equal(oidData) {
+		return errors.New("cms: signed attributes are required with non-data content")
+	}
+
+	return si.Certificate.CheckSignature(si.SignatureAlgorithm, signed, si.Signature)
+}
+
+func rawOID(oid asn1.ObjectIdentifier) asn1.RawValue {
+	var b cryptobyte.Builder
+	b.AddASN1ObjectIdentifier(oid)
+	return asn1.RawValue{FullBytes: b.BytesOrPanic()}
+}
+
+func rawOctetString(data []byte) asn1.RawValue {
+	var b cryptobyte.Builder
+	b.AddASN1OctetString(data)
+	return asn1.RawValue{FullBytes: b.BytesOrPanic()}
+}
+
+// rawTime encodes t as a UTCTime if possible, or as a GeneralizedTime
+// otherwise, as required by RFC 5652, Section 11.3.
+func rawTime(t time.Time) asn1.RawValue {
+	var b cryptobyte.Builder
+	t = t.UTC().Truncate(time.Second)
+	if t.Year() >= 1950 && t.Year() < 2050 {
+		b.AddASN1UTCTime(t)
+	} else {
+		b.AddASN1GeneralizedTime(t)
+	}
+	return asn1.RawValue{FullBytes: b.BytesOrPanic()}
+}
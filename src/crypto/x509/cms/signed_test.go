@@ -0,0 +1,390 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cms
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func readTestdata(t *testing.T, name string) []byte {
+	t.Helper()
+	data, err := os.ReadFile(filepath.Join("testdata", name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
+func readCertificate(t *testing.T, name string) *x509.Certificate {
+	t.Helper()
+	block, _ := pem.Decode(readTestdata(t, name))
+	if block == nil {
+		t.Fatalf("no PEM data in %s", name)
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cert
+}
+
+func readPrivateKey(t *testing.T, name string) crypto.PrivateKey {
+	t.Helper()
+	block, _ := pem.Decode(readTestdata(t, name))
+	if block == nil {
+		t.Fatalf("no PEM data in %s", name)
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return key
+}
+
+func testRoots(t *testing.T) *x509.CertPool {
+	roots := x509.NewCertPool()
+	roots.AddCert(readCertificate(t, "ca.pem"))
+	return roots
+}
+
+type testCA struct {
+	cert *x509.Certificate
+	key  crypto.Signer
+}
+
+func newTestCA(t *testing.T) *testCA {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "Test Root"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, key.Public(), key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &testCA{cert, key}
+}
+
+func (ca *testCA) roots() *x509.CertPool {
+	pool := x509.NewCertPool()
+	pool.AddCert(ca.cert)
+	return pool
+}
+
+func (ca *testCA) issue(t *testing.T, serial int64, pub crypto.PublicKey) *x509.Certificate {
+	t.Helper()
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		Subject:      pkix.Name{CommonName: "Test Leaf"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment | x509.KeyUsageKeyAgreement,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageEmailProtection},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, ca.cert, pub, ca.key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cert
+}
+
+var testRSAKey, _ = rsa.GenerateKey(rand.Reader, 2048)
+
+func signingKeys(t *testing.T) map[string]crypto.Signer {
+	p256, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p384, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, ed, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return map[string]crypto.Signer{
+		"RSA":     testRSAKey,
+		"P-256":   p256,
+		"P-384":   p384,
+		"Ed25519": ed,
+	}
+}
+
+func TestSignVerify(t *testing.T) {
+	ca := newTestCA(t)
+	content := []byte("Hello, Gophers!")
+	for name, key := range signingKeys(t) {
+		cert := ca.issue(t, 2, key.Public())
+		for _, opts := range []SignOptions{
+			{},
+			{Detached: true},
+			{Hash: crypto.SHA512, SigningTime: time.Now()},
+			{NoSignedAttributes: true},
+			{ContentType: asn1.ObjectIdentifier{1, 2, 3, 4}},
+			{PSS: true},
+		} {
+			if opts.PSS && name != "RSA" {
+				continue
+			}
+			der, err := Sign(content, cert, key, &opts)
+			if err != nil {
+				t.Fatalf("%s: Sign(%+v): %v", name, opts, err)
+			}
+			sd, err := ParseSignedData(der)
+			if err != nil {
+				t.Fatalf("%s: ParseSignedData: %v", name, err)
+			}
+			if len(sd.Signers) != 1 || sd.Signers[0].Certificate == nil || !sd.Signers[0].Certificate.Equal(cert) {
+				t.Fatalf("%s: signer certificate not found", name)
+			}
+			verifyOpts := x509.VerifyOptions{Roots: ca.roots()}
+			if opts.Detached {
+				if sd.Content != nil {
+					t.Errorf("%s: detached message has content", name)
+				}
+				if err := sd.VerifyDetached(content, verifyOpts); err != nil {
+					t.Errorf("%s: VerifyDetached(%+v): %v", name, opts, err)
+				}
+				if err := sd.VerifyDetached([]byte("Hello, Rustaceans!"), verifyOpts); err == nil {
+					t.Errorf("%s: VerifyDetached succeeded with wrong content", name)
+				}
+				if err := sd.Verify(verifyOpts); err == nil {
+					t.Errorf("%s: Verify succeeded on a detached message", name)
+				}
+			} else {
+				if !bytes.Equal(sd.Content, content) {
+					t.Errorf("%s: got content %q, want %q", name, sd.Content, content)
+				}
+				if err := sd.Verify(verifyOpts); err != nil {
+					t.Errorf("%s: Verify(%+v): %v", name, opts, err)
+				}
+			}
+			if err := sd.Verify(x509.VerifyOptions{Roots: x509.NewCertPool()}); err == nil && !opts.Detached {
+				t.Errorf("%s: Verify succeeded with untrusted root", name)
+			}
+		}
+	}
+}
+
+func TestSignIntermediates(t *testing.T) {
+	root := newTestCA(t)
+	intermediateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(10),
+		Subject:               pkix.Name{CommonName: "Test Intermediate"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, root.cert, intermediateKey.Public(), root.key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	intermediateCert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	intermediate := &testCA{intermediateCert, intermediateKey}
+	leaf := intermediate.issue(t, 11, testRSAKey.Public())
+
+	content := []byte("chained")
+	der, err = Sign(content, leaf, testRSAKey, &SignOptions{Certificates: []*x509.Certificate{intermediateCert}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	sd, err := ParseSignedData(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(sd.Certificates) != 2 {
+		t.Fatalf("got %d certificates, want 2", len(sd.Certificates))
+	}
+	if err := sd.Verify(x509.VerifyOptions{Roots: root.roots()}); err != nil {
+		t.Errorf("Verify: %v", err)
+	}
+
+	der, err = Sign(content, leaf, testRSAKey, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sd, err = ParseSignedData(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := sd.Verify(x509.VerifyOptions{Roots: root.roots()}); err == nil {
+		t.Errorf("Verify succeeded without intermediate")
+	}
+}
+
+func TestSigningTime(t *testing.T) {
+	ca := newTestCA(t)
+	cert := ca.issue(t, 2, testRSAKey.Public())
+	for _, want := range []time.Time{
+		time.Date(2024, 2, 29, 12, 30, 45, 0, time.UTC),
+		time.Date(2051, 1, 1, 0, 0, 0, 0, time.UTC),
+	} {
+		der, err := Sign([]byte("timed"), cert, testRSAKey, &SignOptions{SigningTime: want})
+		if err != nil {
+			t.Fatal(err)
+		}
+		sd, err := ParseSignedData(der)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, ok := sd.Signers[0].SigningTime()
+		if !ok || !got.Equal(want) {
+			t.Errorf("SigningTime() = %v, %v; want %v, true", got, ok, want)
+		}
+	}
+}
+
+func TestSignedAttributes(t *testing.T) {
+	ca := newTestCA(t)
+	cert := ca.issue(t, 2, testRSAKey.Public())
+	custom := Attribute{
+		Type:   asn1.ObjectIdentifier{1, 2, 3, 4, 5},
+		Values: []asn1.RawValue{rawOctetString([]byte("custom"))},
+	}
+	der, err := Sign([]byte("attrs"), cert, testRSAKey, &SignOptions{
+		SignedAttributes:   []Attribute{custom},
+		UnsignedAttributes: []Attribute{custom},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	sd, err := ParseSignedData(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	si := sd.Signers[0]
+	if len(si.SignedAttributes) != 3 {
+		t.Errorf("got %d signed attributes, want 3", len(si.SignedAttributes))
+	}
+	if len(si.UnsignedAttributes) != 1 || !si.UnsignedAttributes[0].Type.Equal(custom.Type) {
+		t.Errorf("got unsigned attributes %v, want %v", si.UnsignedAttributes, custom)
+	}
+	if err := sd.Verify(x509.VerifyOptions{Roots: ca.roots()}); err != nil {
+		t.Errorf("Verify: %v", err)
+	}
+
+	if _, err := Sign([]byte("attrs"), cert, testRSAKey, &SignOptions{
+		NoSignedAttributes: true,
+		SignedAttributes:   []Attribute{custom},
+	}); err == nil {
+		t.Errorf("Sign succeeded with NoSignedAttributes and SignedAttributes")
+	}
+}
+
+func TestVerifyTampered(t *testing.T) {
+	ca := newTestCA(t)
+	cert := ca.issue(t, 2, testRSAKey.Public())
+	content := []byte("do not modify")
+	der, err := Sign(content, cert, testRSAKey, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sd, err := ParseSignedData(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	opts := x509.VerifyOptions{Roots: ca.roots()}
+
+	sd.Content = []byte("do not m0dify")
+	if err := sd.Verify(opts); err == nil {
+		t.Errorf("Verify succeeded with modified content")
+	}
+	sd.Content = content
+
+	sd.Signers[0].Signature[0] ^= 1
+	if err := sd.Verify(opts); err == nil {
+		t.Errorf("Verify succeeded with modified signature")
+	}
+	sd.Signers[0].Signature[0] ^= 1
+
+	sd.Signers[0].rawSignedAttrs[len(sd.Signers[0].rawSignedAttrs)-1] ^= 1
+	if err := sd.Verify(opts); err == nil {
+		t.Errorf("Verify succeeded with modified signed attributes")
+	}
+}
+
+func TestVerifyOpenSSL(t *testing.T) {
+	content := readTestdata(t, "msg.txt")
+	opts := x509.VerifyOptions{Roots: testRoots(t)}
+	for _, name := range []string{"signed-rsa.der", "signed-rsa-keyid.der", "signed-rsa-stream.der"} {
+		sd, err := ParseSignedData(readTestdata(t, name))
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		if !bytes.Equal(sd.Content, content) {
+			t.Errorf("%s: got content %q, want %q", name, sd.Content, content)
+		}
+		if _, ok := sd.Signers[0].SigningTime(); !ok {
+			t.Errorf("%s: missing signing time", name)
+		}
+		if err := sd.Verify(opts); err != nil {
+			t.Errorf("%s: Verify: %v", name, err)
+		}
+	}
+
+	sd, err := ParseSignedData(readTestdata(t, "signed-ec-detached.der"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := sd.VerifyDetached(content, opts); err != nil {
+		t.Errorf("VerifyDetached: %v", err)
+	}
+}
+
+func TestParseSignedDataWrongType(t *testing.T) {
+	der := readTestdata(t, "enveloped-rsa.der")
+	if _, err := ParseSignedData(der); err == nil {
+		t.Errorf("ParseSignedData succeeded on EnvelopedData")
+	}
+	ct, err := ContentType(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !ct.Equal(OIDEnvelopedData) {
+		t.Errorf("ContentType() = %v, want %v", ct, OIDEnvelopedData)
+	}
+}
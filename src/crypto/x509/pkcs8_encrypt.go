@@ -0,0 +1,469 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package x509
+
+import (
+	"crypto"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/des"
+	"crypto/internal/scrypt"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"errors"
+	"hash"
+	"internal/pbkdf2"
+	"io"
+	"strconv"
+)
+
+// RFC 5958, Section 3 defines EncryptedPrivateKeyInfo, which wraps a
+// PKCS #8 PrivateKeyInfo encrypted with a password-based scheme. The
+// scheme supported here is PBES2 from RFC 8018, Section 6.2, with a key
+// derived by PBKDF2 (RFC 8018, Section 5.2) or scrypt (RFC 7914).
+
+var (
+	oidPBES2  = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 5, 13}
+	oidPBKDF2 = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 5, 12}
+	oidScrypt = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 11591, 4, 11}
+
+	oidHMACWithSHA1   = asn1.ObjectIdentifier{1, 2, 840, 113549, 2, 7}
+	oidHMACWithSHA224 = asn1.ObjectIdentifier{1, 2, 840, 113549, 2, 8}
+	oidHMACWithSHA256 = asn1.ObjectIdentifier{1, 2, 840, 113549, 2, 9}
+	oidHMACWithSHA384 = asn1.ObjectIdentifier{1, 2, 840, 113549, 2, 10}
+	oidHMACWithSHA512 = asn1.ObjectIdentifier{1, 2, 840, 113549, 2, 11}
+
+	oidDESEDE3CBC = asn1.ObjectIdentifier{1, 2, 840, 113549, 3, 7}
+	oidAES128CBC  = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 1, 2}
+	oidAES192CBC  = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 1, 22}
+	oidAES256CBC  = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 1, 42}
+	oidAES128GCM  = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 1, 6}
+	oidAES192GCM  = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 1, 26}
+	oidAES256GCM  = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 1, 46}
+)
+
+type encryptedPrivateKeyInfo struct {
+	Algo          pkix.AlgorithmIdentifier
+	EncryptedData []byte
+}
+
+type pbes2Params struct {
+	KeyDerivationFunc pkix.AlgorithmIdentifier
+	EncryptionScheme  pkix.AlgorithmIdentifier
+}
+
+type pbkdf2Params struct {
+	Salt           []byte
+	IterationCount int
+	KeyLength      int                      `asn1:"optional"`
+	PRF            pkix.AlgorithmIdentifier `asn1:"optional"`
+}
+
+type scryptParams struct {
+	Salt                     []byte
+	CostParameter            int
+	BlockSize                int
+	ParallelizationParameter int
+	KeyLength                int `asn1:"optional"`
+}
+
+// gcmParams are the AES-GCM parameters of RFC 5084, Section 3.2.
+type gcmParams struct {
+	Nonce  []byte
+	ICVLen int `asn1:"optional,default:12"`
+}
+
+// PKCS8Cipher is a cipher used to encrypt a PKCS #8 private key.
+type PKCS8Cipher int
+
+// Possible values for [PKCS8EncryptOptions.Cipher].
+const (
+	_ PKCS8Cipher = iota
+	PKCS8CipherAES128CBC
+	PKCS8CipherAES192CBC
+	PKCS8CipherAES256CBC
+	PKCS8CipherAES128GCM
+	PKCS8CipherAES192GCM
+	PKCS8CipherAES256GCM
+)
+
+// pbes2Cipher describes an encryption scheme usable with PBES2.
+type pbes2Cipher struct {
+	cipher  PKCS8Cipher // zero for schemes only supported for decryption
+	oid     asn1.ObjectIdentifier
+	keySize int
+	gcm     bool
+	newFunc func(key []byte) (cipher.Block, error)
+}
+
+var pbes2Ciphers = []pbes2Cipher{
+	{PKCS8CipherAES128CBC, oidAES128CBC, 16, false, aes.NewCipher},
+	{PKCS8CipherAES192CBC, oidAES192CBC, 24, false, aes.NewCipher},
+	{PKCS8CipherAES256CBC, oidAES256CBC, 32, false, aes.NewCipher},
+	{PKCS8CipherAES128GCM, oidAES128GCM, 16, true, aes.NewCipher},
+	{PKCS8CipherAES192GCM, oidAES192GCM, 24, true, aes.NewCipher},
+	{PKCS8CipherAES256GCM, oidAES256GCM, 32, true, aes.NewCipher},
+	{0, oidDESEDE3CBC, 24, false, des.NewTripleDESCipher},
+}
+
+// PKCS8KDF is a key derivation function used to derive the key that
+// encrypts a PKCS #8 private key from a password.
+type PKCS8KDF int
+
+// Possible values for [PKCS8EncryptOptions.KDF].
+const (
+	PKCS8KDFPBKDF2 PKCS8KDF = iota
+	PKCS8KDFScrypt
+)
+
+// PKCS8EncryptOptions controls how [EncryptPKCS8PrivateKey] encrypts a key.
+// The zero value selects AES-256-CBC with a key derived by PBKDF2 with
+// HMAC-SHA256, which is what OpenSSL produces by default.
+type PKCS8EncryptOptions struct {
+	// Cipher is the cipher used to encrypt the key. If zero,
+	// PKCS8CipherAES256CBC is used.
+	Cipher PKCS8Cipher
+
+	// KDF is the key derivation function.
+	KDF PKCS8KDF
+
+	// Hash is the hash function used with HMAC by PBKDF2. It must be
+	// crypto.SHA1, crypto.SHA224, crypto.SHA256, crypto.SHA384 or
+	// crypto.SHA512. If zero, crypto.SHA256 is used.
+	Hash crypto.Hash
+
+	// Iterations is the PBKDF2 iteration count. If zero, 600000 is used.
+	Iterations int
+
+	// ScryptN, ScryptR and ScryptP are the scrypt cost, block size and
+	// parallelization parameters. If zero, they default to 16384, 8 and 1.
+	ScryptN, ScryptR, ScryptP int
+}
+
+const (
+	// Limits on the work a password-protected key may demand of
+	// DecryptPKCS8PrivateKey.
+	maxPBKDF2Iterations = 10_000_000
+	maxScryptMemory     = 1 << 30 // bytes, 128*N*r
+	maxScryptWork       = 1 << 27 // N*r*p
+)
+
+var prfHashes = []struct {
+	hash crypto.Hash
+	oid  asn1.ObjectIdentifier
+}{
+	{crypto.SHA1, oidHMACWithSHA1},
+	{crypto.SHA224, oidHMACWithSHA224},
+	{crypto.SHA256, oidHMACWithSHA256},
+	{crypto.SHA384, oidHMACWithSHA384},
+	{crypto.SHA512, oidHMACWithSHA512},
+}
+
+// EncryptPKCS8PrivateKey encrypts der, a private key in PKCS #8, ASN.1 DER
+// form such as returned by [MarshalPKCS8PrivateKey], with a key derived from
+// password. It returns an EncryptedPrivateKeyInfo structure as defined in
+// RFC 5958, using the PBES2 scheme of RFC 8018, in ASN.1 DER form.
+//
+// The salt and initialization vector are read from rand. If opts is nil,
+// the defaults described at [PKCS8EncryptOptions] are used.
+//
+// This kind of key is commonly encoded in PEM blocks of type
+// "ENCRYPTED PRIVATE KEY".
+func EncryptPKCS8PrivateKey(rand io.Reader, der, password []byte, opts *PKCS8EncryptOptions) ([]byte, error) {
+	if opts == nil {
+		opts = &PKCS8EncryptOptions{}
+	}
+	c := opts.Cipher
+	if c == 0 {
+		c = PKCS8CipherAES256CBC
+	}
+	var ciph *pbes2Cipher
+	for i := range pbes2Ciphers {
+		if pbes2Ciphers[i].cipher == c {
+			ciph = &pbes2Ciphers[i]
+		}
+	}
+	if ciph == nil {
+		return nil, errors.New("x509: unknown PKCS #8 cipher")
+	}
+
+	salt := make([]byte, 16)
+	if _, err := io.ReadFull(rand, salt); err != nil {
+		return nil, errors.New("x509: cannot generate salt: " + err.Error())
+	}
+
+	var kdf pkix.AlgorithmIdentifier
+	var key []byte
+	switch opts.KDF {
+	case PKCS8KDFPBKDF2:
+		h := opts.Hash
+		if h == 0 {
+			h = crypto.SHA256
+		}
+		var prf asn1.ObjectIdentifier
+		for _, p := range prfHashes {
+			if p.hash == h {
+				prf = p.oid
+			}
+		}
+		if prf == nil || !h.Available() {
+			return nil, errors.New("x509: unsupported PBKDF2 hash function " + h.String())
+		}
+		iter := opts.Iterations
+		if iter == 0 {
+			iter = 600000
+		}
+		if iter < 0 || iter > maxPBKDF2Iterations {
+			return nil, errors.New("x509: invalid PBKDF2 iteration count")
+		}
+		params, err := asn1.Marshal(pbkdf2Params{
+			Salt:           salt,
+			IterationCount: iter,
+			PRF:            pkix.AlgorithmIdentifier{Algorithm: prf, Parameters: asn1.NullRawValue},
+		})
+		if err != nil {
+			return nil, err
+		}
+		kdf = pkix.AlgorithmIdentifier{Algorithm: oidPBKDF2, Parameters: asn1.RawValue{FullBytes: params}}
+		key = pbkdf2.Key(h.New, password, salt, iter, ciph.keySize)
+
+	case PKCS8KDFScrypt:
+		p := scryptParams{Salt: salt, CostParameter: opts.ScryptN, BlockSize: opts.ScryptR, ParallelizationParameter: opts.ScryptP}
+		if p.CostParameter == 0 {
+			p.CostParameter = 16384
+		}
+		if p.BlockSize == 0 {
+			p.BlockSize = 8
+		}
+		if p.ParallelizationParameter == 0 {
+			p.ParallelizationParameter = 1
+		}
+		var err error
+		key, err = scryptKey(password, &p, ciph.keySize)
+		if err != nil {
+			return nil, err
+		}
+		params, err := asn1.Marshal(p)
+		if err != nil {
+			return nil, err
+		}
+		kdf = pkix.AlgorithmIdentifier{Algorithm: oidScrypt, Parameters: asn1.RawValue{FullBytes: params}}
+
+	default:
+		return nil, errors.New("x509: unknown PKCS #8 key derivation function")
+	}
+
+	block, err := ciph.newFunc(key)
+	if err != nil {
+		return nil, err
+	}
+	var encParams []byte
+	var encrypted []byte
+	if ciph.gcm {
+		aead, err := cipher.NewGCM(block)
+		if err != nil {
+			return nil, err
+		}
+		nonce := make([]byte, aead.NonceSize())
+		if _, err := io.ReadFull(rand, nonce); err != nil {
+			return nil, errors.New("x509: cannot generate nonce: " + err.Error())
+		}
+		encParams, err = asn1.Marshal(gcmParams{Nonce: nonce, ICVLen: aead.Overhead()})
+		if err != nil {
+			return nil, err
+		}
+		encrypted = aead.Seal(nil, nonce, der, nil)
+	} else {
+		iv := make([]byte, block.BlockSize())
+		if _, err := io.ReadFull(rand, iv); err != nil {
+			return nil, errors.New("x509: cannot generate IV: " + err.Error())
+		}
+		encParams, err = asn1.Marshal(iv)
+		if err != nil {
+			return nil, err
+		}
+		// PKCS #5 padding, RFC 8018, Section 6.1.1.
+		pad := block.BlockSize() - len(der)%block.BlockSize()
+		encrypted = make([]byte, len(der), len(der)+pad)
+		copy(encrypted, der)
+		for i := 0; i < pad; i++ {
+			encrypted = append(encrypted, byte(pad))
+		}
+		cipher.NewCBCEncrypter(block, iv).CryptBlocks(encrypted, encrypted)
+	}
+
+	params, err := asn1.Marshal(pbes2Params{
+		KeyDerivationFunc: kdf,
+		EncryptionScheme:  pkix.AlgorithmIdentifier{Algorithm: ciph.oid, Parameters: asn1.RawValue{FullBytes: encParams}},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return asn1.Marshal(encryptedPrivateKeyInfo{
+		Algo:          pkix.AlgorithmIdentifier{Algorithm: oidPBES2, Parameters: asn1.RawValue{FullBytes: params}},
+		EncryptedData: encrypted,
+	})
+}
+
+func scryptKey(password []byte, p *scryptParams, keySize int) ([]byte, error) {
+	N, r, pp := p.CostParameter, p.BlockSize, p.ParallelizationParameter
+	if N <= 1 || r <= 0 || pp <= 0 ||
+		N > maxScryptMemory/128/r || N*r > maxScryptWork/pp {
+		return nil, errors.New("x509: invalid or too expensive scrypt parameters")
+	}
+	key, err := scrypt.Key(password, p.Salt, N, r, pp, keySize)
+	if err != nil {
+		return nil, errors.New("x509: " + err.Error())
+	}
+	return key, nil
+}
+
+// DecryptPKCS8PrivateKey decrypts der, an EncryptedPrivateKeyInfo structure
+// in ASN.1 DER form as produced by [EncryptPKCS8PrivateKey] or OpenSSL,
+// with password. It returns the private key in PKCS #8, ASN.1 DER form,
+// which can be passed to [ParsePKCS8PrivateKey].
+//
+// Only the PBES2 scheme is supported, with keys derived by PBKDF2 or
+// scrypt and encrypted with AES in CBC or GCM mode or with triple DES
+// in CBC mode. If an incorrect password is detected, an
+// [IncorrectPasswordError] is returned.
+func DecryptPKCS8PrivateKey(der, password []byte) ([]byte, error) {
+	var info encryptedPrivateKeyInfo
+	if rest, err := asn1.Unmarshal(der, &info); err != nil {
+		return nil, err
+	} else if len(rest) != 0 {
+		return nil, asn1.SyntaxError{Msg: "trailing data"}
+	}
+	if !info.Algo.Algorithm.Equal(oidPBES2) {
+		return nil, errors.New("x509: unsupported PKCS #8 encryption scheme " + info.Algo.Algorithm.String())
+	}
+	var params pbes2Params
+	if _, err := asn1.Unmarshal(info.Algo.Parameters.FullBytes, &params); err != nil {
+		return nil, errors.New("x509: invalid PBES2 parameters: " + err.Error())
+	}
+
+	var ciph *pbes2Cipher
+	for i := range pbes2Ciphers {
+		if pbes2Ciphers[i].oid.Equal(params.EncryptionScheme.Algorithm) {
+			ciph = &pbes2Ciphers[i]
+		}
+	}
+	if ciph == nil {
+		return nil, errors.New("x509: unsupported PBES2 cipher " + params.EncryptionScheme.Algorithm.String())
+	}
+
+	key, err := deriveKeyPBES2(params.KeyDerivationFunc, password, ciph.keySize)
+	if err != nil {
+		return nil, err
+	}
+	block, err := ciph.newFunc(key)
+	if err != nil {
+		return nil, err
+	}
+
+	encParams := params.EncryptionScheme.Parameters.FullBytes
+	var data []byte
+	if ciph.gcm {
+		var gp gcmParams
+		if _, err := asn1.Unmarshal(encParams, &gp); err != nil {
+			return nil, errors.New("x509: invalid AES-GCM parameters: " + err.Error())
+		}
+		if gp.ICVLen < 12 || gp.ICVLen > 16 || len(gp.Nonce) == 0 {
+			return nil, errors.New("x509: unsupported AES-GCM parameters")
+		}
+		aead, err := cipher.NewGCMWithNonceSize(block, len(gp.Nonce))
+		if err != nil {
+			return nil, err
+		}
+		if gp.ICVLen != aead.Overhead() {
+			aead, err = cipher.NewGCMWithTagSize(block, gp.ICVLen)
+			if err != nil || len(gp.Nonce) != aead.NonceSize() {
+				return nil, errors.New("x509: unsupported AES-GCM parameters")
+			}
+		}
+		data, err = aead.Open(nil, gp.Nonce, info.EncryptedData, nil)
+		if err != nil {
+			return nil, IncorrectPasswordError
+		}
+	} else {
+		var iv []byte
+		if _, err := asn1.Unmarshal(encParams, &iv); err != nil {
+			return nil, errors.New("x509: invalid CBC parameters: " + err.Error())
+		}
+		bs := block.BlockSize()
+		if len(iv) != bs {
+			return nil, errors.New("x509: incorrect IV size")
+		}
+		if len(info.EncryptedData) == 0 || len(info.EncryptedData)%bs != 0 {
+			return nil, errors.New("x509: encrypted PKCS #8 data is not a multiple of the block size")
+		}
+		data = make([]byte, len(info.EncryptedData))
+		cipher.NewCBCDecrypter(block, iv).CryptBlocks(data, info.EncryptedData)
+
+		// A bad padding most likely means a wrong password.
+		last := int(data[len(data)-1])
+		if last == 0 || last > bs {
+			return nil, IncorrectPasswordError
+		}
+		for _, val := range data[len(data)-last:] {
+			if int(val) != last {
+				return nil, IncorrectPasswordError
+			}
+		}
+		data = data[:len(data)-last]
+	}
+
+	// A correct padding can occur by chance, so check that
+	// the result is at least a single DER SEQUENCE.
+	var raw asn1.RawValue
+	if rest, err := asn1.Unmarshal(data, &raw); err != nil || len(rest) != 0 ||
+		raw.Class != asn1.ClassUniversal || raw.Tag != asn1.TagSequence {
+		return nil, IncorrectPasswordError
+	}
+	return data, nil
+}
+
+// deriveKeyPBES2 derives a key of keySize bytes as described by the
+// PBES2 key derivation function algorithm identifier kdf.
+func deriveKeyPBES2(kdf pkix.AlgorithmIdentifier, password []byte, keySize int) ([]byte, error) {
+	switch {
+	case kdf.Algorithm.Equal(oidPBKDF2):
+		var p pbkdf2Params
+		if _, err := asn1.Unmarshal(kdf.Parameters.FullBytes, &p); err != nil {
+			return nil, errors.New("x509: invalid PBKDF2 parameters: " + err.Error())
+		}
+		if p.KeyLength != 0 && p.KeyLength != keySize {
+			return nil, errors.New("x509: PBKDF2 key length does not match cipher")
+		}
+		if p.IterationCount <= 0 || p.IterationCount > maxPBKDF2Iterations {
+			return nil, errors.New("x509: invalid or too large PBKDF2 iteration count " + strconv.Itoa(p.IterationCount))
+		}
+		var h func() hash.Hash
+		if len(p.PRF.Algorithm) == 0 {
+			// The default PRF is HMAC-SHA1.
+			h = crypto.SHA1.New
+		}
+		for _, prf := range prfHashes {
+			if prf.oid.Equal(p.PRF.Algorithm) && prf.hash.Available() {
+				h = prf.hash.New
+			}
+		}
+		if h == nil {
+			return nil, errors.New("x509: unsupported PBKDF2 PRF " + p.PRF.Algorithm.String())
+		}
+		return pbkdf2.Key(h, password, p.Salt, p.IterationCount, keySize), nil
+
+	case kdf.Algorithm.Equal(oidScrypt):
+		var p scryptParams
+		if _, err := asn1.Unmarshal(kdf.Parameters.FullBytes, &p); err != nil {
+			return nil, errors.New("x509: invalid scrypt parameters: " + err.Error())
+		}
+		if p.KeyLength != 0 && p.KeyLength != keySize {
+			return nil, errors.New("x509: scrypt key length does not match cipher")
+		}
+		return scryptKey(password, &p, keySize)
+	}
+	return nil, errors.New("x509: unsupported PBES2 key derivation function " + kdf.Algorithm.String())
+}
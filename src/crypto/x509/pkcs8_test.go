@@ -9,6 +9,7 @@ import (
 	"crypto/ecdh"
 	"crypto/ecdsa"
 	"crypto/ed25519"
+	"crypto/ed448"
 	"crypto/elliptic"
 	"crypto/rsa"
 	"encoding/hex"
@@ -55,6 +56,16 @@ var pkcs8Ed25519PrivateKeyHex = `302e020100300506032b657004220420d4ee72dbf913584
 //	openssl genpkey -algorithm x25519
 var pkcs8X25519PrivateKeyHex = `302e020100300506032b656e0422042068ff93a73c5adefd6d498b24e588fd4daa10924d992afed01b43ca5725025a6b`
 
+// Generated using:
+//
+//	openssl genpkey -algorithm ed448
+var pkcs8Ed448PrivateKeyHex = `3047020100300506032b6571043b04391a1ae4e15361451613a47253d347f115f5b545dc6c2c568fa8c21b511211a5b0be79a483496359dce209a6a89ba7f2e88ce0cddb537f9a6bc4`
+
+// Generated using:
+//
+//	openssl genpkey -algorithm x448
+var pkcs8X448PrivateKeyHex = `3046020100300506032b656f043a043874a1103e190881f6f1cbb5dd5addd7598658042821ce2916cf97bc92b2e7d02cb07af8b905af21ec341413e3860cc9288deb8a55cfeba3fb`
+
 func TestPKCS8(t *testing.T) {
 	tests := []struct {
 		name    string
@@ -101,6 +112,16 @@ func TestPKCS8(t *testing.T) {
 			keyHex:  pkcs8X25519PrivateKeyHex,
 			keyType: reflect.TypeOf(&ecdh.PrivateKey{}),
 		},
+		{
+			name:    "Ed448 private key",
+			keyHex:  pkcs8Ed448PrivateKeyHex,
+			keyType: reflect.TypeOf(ed448.PrivateKey{}),
+		},
+		{
+			name:    "X448 private key",
+			keyHex:  pkcs8X448PrivateKeyHex,
+			keyType: reflect.TypeOf(&ecdh.PrivateKey{}),
+		},
 	}
 
 	for _, test := range tests {
@@ -11,6 +11,7 @@ import (
 	"crypto/ecdh"
 	"crypto/ecdsa"
 	"crypto/ed25519"
+	"crypto/ed448"
 	"crypto/elliptic"
 	"crypto/rand"
 	"crypto/rsa"
@@ -126,6 +127,20 @@ func TestParsePKIXPublicKey(t *testing.T) {
 			t.Errorf("Value returned from ParsePKIXPublicKey was not an X25519 public key")
 		}
 	})
+	t.Run("Ed448", func(t *testing.T) {
+		pub := testParsePKIXPublicKey(t, pemEd448Key)
+		_, ok := pub.(ed448.PublicKey)
+		if !ok {
+			t.Errorf("Value returned from ParsePKIXPublicKey was not an Ed448 public key")
+		}
+	})
+	t.Run("X448", func(t *testing.T) {
+		pub := testParsePKIXPublicKey(t, pemX448Key)
+		k, ok := pub.(*ecdh.PublicKey)
+		if !ok || k.Curve() != ecdh.X448() {
+			t.Errorf("Value returned from ParsePKIXPublicKey was not an X448 public key")
+		}
+	})
 }
 
 var pemPublicKey = `-----BEGIN PUBLIC KEY-----
@@ -171,6 +186,22 @@ MCowBQYDK2VuAyEA5yGXrH/6OzxuWEhEWS01/f4OP+Of3Yrddy6/J1kDTVM=
 -----END PUBLIC KEY-----
 `
 
+// pemEd448Key was generated from pkcs8Ed448PrivateKeyHex with "openssl pkey -pubout".
+var pemEd448Key = `
+-----BEGIN PUBLIC KEY-----
+MEMwBQYDK2VxAzoAj+cA7C18YYdANr3IhqMBvdvDUN6ZZEB5ULt1LQiwoer8EPq1
+Ck5u6TADtux46EswjLOPuj2UUPYA
+-----END PUBLIC KEY-----
+`
+
+// pemX448Key was generated from pkcs8X448PrivateKeyHex with "openssl pkey -pubout".
+var pemX448Key = `
+-----BEGIN PUBLIC KEY-----
+MEIwBQYDK2VvAzkAA8W0j0v4knXTJ9LxdAjWbhtYt5xEuQtb7WR4n/X96IQsQ4KQ
+QzNfMBXO2+HvgxU0/YpU2XJOn4Y=
+-----END PUBLIC KEY-----
+`
+
 func TestPKIXMismatchPublicKeyFormat(t *testing.T) {
 
 	const pkcs1PublicKey = "308201080282010100817cfed98bcaa2e2a57087451c7674e0c675686dc33ff1268b0c2a6ee0202dec710858ee1c31bdf5e7783582e8ca800be45f3275c6576adc35d98e26e95bb88ca5beb186f853b8745d88bc9102c5f38753bcda519fb05948d5c77ac429255ff8aaf27d9f45d1586e95e2e9ba8a7cb771b8a09dd8c8fed3f933fd9b439bc9f30c475953418ef25f71a2b6496f53d94d39ce850aa0cc75d445b5f5b4f4ee4db78ab197a9a8d8a852f44529a007ac0ac23d895928d60ba538b16b0b087a7f903ed29770e215019b77eaecc360f35f7ab11b6d735978795b2c4a74e5bdea4dc6594cd67ed752a108e666729a753ab36d6c4f606f8760f507e1765be8cd744007e629020103"
@@ -611,6 +642,11 @@ func TestCreateSelfSignedCertificate(t *testing.T) {
 		t.Fatalf("Failed to generate Ed25519 key: %s", err)
 	}
 
+	ed448Pub, ed448Priv, err := ed448.GenerateKey(random)
+	if err != nil {
+		t.Fatalf("Failed to generate Ed448 key: %s", err)
+	}
+
 	tests := []struct {
 		name      string
 		pub, priv any
@@ -625,6 +661,7 @@ func TestCreateSelfSignedCertificate(t *testing.T) {
 		{"ECDSA/RSAPSS", &ecdsaPriv.PublicKey, testPrivateKey, false, SHA256WithRSAPSS},
 		{"RSAPSS/ECDSA", &testPrivateKey.PublicKey, ecdsaPriv, false, ECDSAWithSHA384},
 		{"Ed25519", ed25519Pub, ed25519Priv, true, PureEd25519},
+		{"Ed448", ed448Pub, ed448Priv, true, PureEd448},
 	}
 
 	testExtKeyUsage := []ExtKeyUsage{ExtKeyUsageClientAuth, ExtKeyUsageServerAuth}
@@ -1150,6 +1187,75 @@ pVgcLDsqnqydTqUdX11tprUI3hKC85cgrvrYmPQagzJrkfUkHcQgfyziTdoTO21U
 GtKoKNxgudT0eEs8HJEA
 -----END CERTIFICATE-----`
 
+const ed448Certificate = `
+Certificate:
+    Data:
+        Version: 3 (0x2)
+        Serial Number:
+            38:0e:50:46:cb:96:b7:f5:10:b0:0f:3e:1e:22:33:e7:e2:06:c5:40
+        Signature Algorithm: ED448
+        Issuer: CN = Ed448 test certificate
+        Validity
+            Not Before: Oct 18 22:59:03 2026 GMT
+            Not After : Oct 15 22:59:03 2036 GMT
+        Subject: CN = Ed448 test certificate
+        Subject Public Key Info:
+            Public Key Algorithm: ED448
+                ED448 Public-Key:
+                pub:
+                    8f:e7:00:ec:2d:7c:61:87:40:36:bd:c8:86:a3:01:
+                    bd:db:c3:50:de:99:64:40:79:50:bb:75:2d:08:b0:
+                    a1:ea:fc:10:fa:b5:0a:4e:6e:e9:30:03:b6:ec:78:
+                    e8:4b:30:8c:b3:8f:ba:3d:94:50:f6:00
+        X509v3 extensions:
+            X509v3 Subject Key Identifier:
+                62:82:0D:EA:5F:20:FE:7D:28:F4:8B:03:8A:8C:CE:4D:D1:AC:A2:3A
+            X509v3 Authority Key Identifier:
+                62:82:0D:EA:5F:20:FE:7D:28:F4:8B:03:8A:8C:CE:4D:D1:AC:A2:3A
+            X509v3 Basic Constraints: critical
+                CA:TRUE
+-----BEGIN CERTIFICATE-----
+MIIBojCCASKgAwIBAgIUOA5QRsuWt/UQsA8+HiIz5+IGxUAwBQYDK2VxMCExHzAd
+BgNVBAMMFkVkNDQ4IHRlc3QgY2VydGlmaWNhdGUwHhcNMjYxMDE4MjI1OTAzWhcN
+MzYxMDE1MjI1OTAzWjAhMR8wHQYDVQQDDBZFZDQ0OCB0ZXN0IGNlcnRpZmljYXRl
+MEMwBQYDK2VxAzoAj+cA7C18YYdANr3IhqMBvdvDUN6ZZEB5ULt1LQiwoer8EPq1
+Ck5u6TADtux46EswjLOPuj2UUPYAo1MwUTAdBgNVHQ4EFgQUYoIN6l8g/n0o9IsD
+iozOTdGsojowHwYDVR0jBBgwFoAUYoIN6l8g/n0o9IsDiozOTdGsojowDwYDVR0T
+AQH/BAUwAwEB/zAFBgMrZXEDcwCgyI8Jv//yHMapgfl4CbTYNO/dW6YJ0ciMcC8i
+wj2nCz+qw16E4zojb8Lpz/6sj4RblCxwMR0dcwBNmsHZUJRRDjtFLDsyyOfDPhR7
+6pcdlC2eXXrxPAmUMqFelnq5CZuxzchaSpZIuhiOiyZ8YCJLFQA=
+-----END CERTIFICATE-----`
+
+func TestEd448SelfSigned(t *testing.T) {
+	der, _ := pem.Decode([]byte(ed448Certificate))
+	if der == nil {
+		t.Fatalf("Failed to find PEM block")
+	}
+
+	cert, err := ParseCertificate(der.Bytes)
+	if err != nil {
+		t.Fatalf("Failed to parse: %s", err)
+	}
+
+	if cert.PublicKeyAlgorithm != Ed448 {
+		t.Fatalf("Parsed key algorithm was not Ed448")
+	}
+	if cert.SignatureAlgorithm != PureEd448 {
+		t.Fatalf("Parsed signature algorithm was not Ed448")
+	}
+	parsedKey, ok := cert.PublicKey.(ed448.PublicKey)
+	if !ok {
+		t.Fatalf("Parsed key was not an Ed448 key: %s", err)
+	}
+	if len(parsedKey) != ed448.PublicKeySize {
+		t.Fatalf("Invalid Ed448 key")
+	}
+
+	if err = cert.CheckSignatureFrom(cert); err != nil {
+		t.Fatalf("Signature check failed: %s", err)
+	}
+}
+
 func TestEd25519SelfSigned(t *testing.T) {
 	der, _ := pem.Decode([]byte(ed25519Certificate))
 	if der == nil {
@@ -1414,6 +1520,11 @@ func TestCreateCertificateRequest(t *testing.T) {
 		t.Fatalf("Failed to generate Ed25519 key: %s", err)
 	}
 
+	_, ed448Priv, err := ed448.GenerateKey(random)
+	if err != nil {
+		t.Fatalf("Failed to generate Ed448 key: %s", err)
+	}
+
 	tests := []struct {
 		name    string
 		priv    any
@@ -1425,6 +1536,7 @@ func TestCreateCertificateRequest(t *testing.T) {
 		{"ECDSA-384", ecdsa384Priv, ECDSAWithSHA256},
 		{"ECDSA-521", ecdsa521Priv, ECDSAWithSHA256},
 		{"Ed25519", ed25519Priv, PureEd25519},
+		{"Ed448", ed448Priv, PureEd448},
 	}
 
 	for _, test := range tests {
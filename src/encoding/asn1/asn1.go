@@ -3,7 +3,8 @@
 // license that can be found in the LICENSE file.
 
 // Package asn1 implements parsing of DER-encoded ASN.1 data structures,
-// as defined in ITU-T Rec X.690.
+// as defined in ITU-T Rec X.690. [UnmarshalBER] and [Reader] also
+// accept the more permissive BER and CER encodings.
 //
 // See also “A Layman's Guide to a Subset of ASN.1, BER, and DER,”
 // http://luca.ntop.org/Teaching/Appunti/asn1.html.
@@ -302,6 +303,191 @@ type Enumerated int
 // A Flag accepts any data and is set to true if present.
 type Flag bool
 
+// REAL
+
+// parseReal parses an ASN.1 REAL from the given bytes. Any of the
+// encodings permitted by BER is accepted: binary with base 2, 8 or 16,
+// decimal in the ISO 6093 forms, and the special values.
+func parseReal(bytes []byte) (float64, error) {
+	if len(bytes) == 0 {
+		return 0, nil
+	}
+	b := bytes[0]
+	switch {
+	case b&0x80 != 0:
+		// Binary encoding.
+		var shift int
+		switch b >> 4 & 3 {
+		case 0:
+			shift = 1
+		case 1:
+			shift = 3
+		case 2:
+			shift = 4
+		default:
+			return 0, SyntaxError{"invalid REAL base"}
+		}
+		scale := int(b >> 2 & 3)
+		bytes = bytes[1:]
+		n := int(b&3) + 1
+		if n == 4 {
+			if len(bytes) == 0 {
+				return 0, SyntaxError{"truncated REAL"}
+			}
+			n = int(bytes[0])
+			bytes = bytes[1:]
+		}
+		if n == 0 || len(bytes) < n {
+			return 0, SyntaxError{"truncated REAL"}
+		}
+		if n > 4 {
+			return 0, StructuralError{"REAL exponent too large"}
+		}
+		exp, err := parseInt64(bytes[:n])
+		if err != nil {
+			return 0, err
+		}
+		if exp > 1<<20 || exp < -1<<20 {
+			return 0, StructuralError{"REAL out of range"}
+		}
+		mant := new(big.Int).SetBytes(bytes[n:])
+		f := new(big.Float).SetInt(mant)
+		f.SetMantExp(f, int(exp)*shift+scale)
+		if b&0x40 != 0 {
+			f.Neg(f)
+		}
+		r, acc := f.Float64()
+		if math.IsInf(r, 0) || r == 0 && acc != big.Exact {
+			return 0, StructuralError{"REAL out of range"}
+		}
+		return r, nil
+
+	case b&0x40 != 0:
+		// Special real values.
+		if len(bytes) != 1 {
+			return 0, SyntaxError{"invalid special REAL value"}
+		}
+		switch b {
+		case 0x40:
+			return math.Inf(1), nil
+		case 0x41:
+			return math.Inf(-1), nil
+		case 0x42:
+			return math.NaN(), nil
+		case 0x43:
+			return math.Copysign(0, -1), nil
+		}
+		return 0, SyntaxError{"invalid special REAL value"}
+
+	default:
+		// Decimal encoding: an ISO 6093 NR1, NR2 or NR3 number.
+		if form := b & 0x3f; form < 1 || form > 3 {
+			return 0, SyntaxError{"invalid REAL decimal form"}
+		}
+		s := strings.TrimLeft(string(bytes[1:]), " ")
+		s = strings.Replace(s, ",", ".", 1)
+		if s == "" || strings.ContainsAny(s, "_xXpPiInN") {
+			return 0, SyntaxError{"invalid REAL decimal number"}
+		}
+		r, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return 0, SyntaxError{"invalid REAL decimal number"}
+		}
+		return r, nil
+	}
+}
+
+// parseRealDER parses a REAL, requiring the canonical binary
+// form if binary encoding is used.
+func parseRealDER(bytes []byte) (float64, error) {
+	r, err := parseReal(bytes)
+	if err != nil {
+		return 0, err
+	}
+	if len(bytes) > 0 && bytes[0]&0x80 != 0 && string(appendReal(nil, r)) != string(bytes) {
+		return 0, SyntaxError{"REAL is not in canonical form"}
+	}
+	return r, nil
+}
+
+// RELATIVE-OID
+
+// A RelativeObjectIdentifier represents an ASN.1 RELATIVE-OID:
+// the arcs of an object identifier relative to some known node.
+type RelativeObjectIdentifier []int
+
+// Equal reports whether oi and other represent the same identifier.
+func (oi RelativeObjectIdentifier) Equal(other RelativeObjectIdentifier) bool {
+	return ObjectIdentifier(oi).Equal(ObjectIdentifier(other))
+}
+
+func (oi RelativeObjectIdentifier) String() string {
+	return ObjectIdentifier(oi).String()
+}
+
+// parseRelativeObjectIdentifier parses a RELATIVE-OID from the given bytes.
+// Unlike an OBJECT IDENTIFIER, every arc is encoded separately.
+func parseRelativeObjectIdentifier(bytes []byte) (s RelativeObjectIdentifier, err error) {
+	if len(bytes) == 0 {
+		err = SyntaxError{"zero length RELATIVE-OID"}
+		return
+	}
+	for offset := 0; offset < len(bytes); {
+		var v int
+		v, offset, err = parseBase128Int(bytes, offset)
+		if err != nil {
+			return nil, err
+		}
+		s = append(s, v)
+	}
+	return
+}
+
+// TIME-OF-DAY
+
+// A TimeOfDay represents an ASN.1 TIME-OF-DAY: a local time
+// without a date or time zone.
+type TimeOfDay struct {
+	Hour, Minute, Second int
+}
+
+// String returns t in the form "15:04:05".
+func (t TimeOfDay) String() string {
+	b := appendTwoDigits(nil, t.Hour)
+	b = append(b, ':')
+	b = appendTwoDigits(b, t.Minute)
+	b = append(b, ':')
+	b = appendTwoDigits(b, t.Second)
+	return string(b)
+}
+
+func (t TimeOfDay) valid() bool {
+	return 0 <= t.Hour && t.Hour <= 23 && 0 <= t.Minute && t.Minute <= 59 && 0 <= t.Second && t.Second <= 60
+}
+
+// parseTimeOfDay parses a TIME-OF-DAY, which X.690 encodes
+// as the six characters "HHMMSS".
+func parseTimeOfDay(bytes []byte) (ret TimeOfDay, err error) {
+	if len(bytes) != 6 {
+		err = SyntaxError{"invalid TIME-OF-DAY"}
+		return
+	}
+	var v [3]int
+	for i := range v {
+		c0, c1 := bytes[2*i], bytes[2*i+1]
+		if !isNumeric(c0) || !isNumeric(c1) || c0 == ' ' || c1 == ' ' {
+			err = SyntaxError{"invalid TIME-OF-DAY"}
+			return
+		}
+		v[i] = int(c0-'0')*10 + int(c1-'0')
+	}
+	ret = TimeOfDay{v[0], v[1], v[2]}
+	if !ret.valid() {
+		err = StructuralError{"TIME-OF-DAY out of range"}
+	}
+	return
+}
+
 // parseBase128Int parses a base-128 encoded int from the given offset in the
 // given byte slice. It returns the value and the new offset.
 func parseBase128Int(bytes []byte, initOffset int) (ret, offset int, err error) {
@@ -664,6 +850,8 @@ var (
 	rawValueType         = reflect.TypeFor[RawValue]()
 	rawContentsType      = reflect.TypeFor[RawContent]()
 	bigIntType           = reflect.TypeFor[*big.Int]()
+	relativeOIDType      = reflect.TypeFor[RelativeObjectIdentifier]()
+	timeOfDayType        = reflect.TypeFor[TimeOfDay]()
 )
 
 // invalidLength reports whether offset + length > sliceLength, or if the
@@ -726,6 +914,12 @@ func parseField(v reflect.Value, bytes []byte, initOffset int, params fieldParam
 				result = innerBytes
 			case TagBMPString:
 				result, err = parseBMPString(innerBytes)
+			case TagReal:
+				result, err = parseRealDER(innerBytes)
+			case TagRelativeOID:
+				result, err = parseRelativeObjectIdentifier(innerBytes)
+			case TagTimeOfDay:
+				result, err = parseTimeOfDay(innerBytes)
 			default:
 				// If we don't know how to handle the type, we just leave Value as nil.
 			}
@@ -864,6 +1058,12 @@ func parseField(v reflect.Value, bytes []byte, initOffset int, params fieldParam
 	case *BitString:
 		*v, err = parseBitString(innerBytes)
 		return
+	case *RelativeObjectIdentifier:
+		*v, err = parseRelativeObjectIdentifier(innerBytes)
+		return
+	case *TimeOfDay:
+		*v, err = parseTimeOfDay(innerBytes)
+		return
 	case *time.Time:
 		if universalTag == TagUTCTime {
 			*v, err = parseUTCTime(innerBytes)
@@ -913,6 +1113,17 @@ func parseField(v reflect.Value, bytes []byte, initOffset int, params fieldParam
 		}
 		return
 	// TODO(dfc) Add support for the remaining integer types
+	case reflect.Float32, reflect.Float64:
+		parsedReal, err1 := parseRealDER(innerBytes)
+		if err1 == nil {
+			if val.OverflowFloat(parsedReal) {
+				err1 = StructuralError{"REAL too large for " + val.Type().String()}
+			} else {
+				val.SetFloat(parsedReal)
+			}
+		}
+		err = err1
+		return
 	case reflect.Struct:
 		structType := fieldType
 
@@ -1042,6 +1253,12 @@ func setDefaultValue(v reflect.Value, params fieldParameters) (ok bool) {
 //
 //   - An ASN.1 OBJECT IDENTIFIER can be written to an [ObjectIdentifier].
 //
+//   - An ASN.1 RELATIVE-OID can be written to a [RelativeObjectIdentifier].
+//
+//   - An ASN.1 REAL can be written to a float32 or float64.
+//
+//   - An ASN.1 TIME-OF-DAY can be written to a [TimeOfDay].
+//
 //   - An ASN.1 ENUMERATED can be written to an [Enumerated].
 //
 //   - An ASN.1 UTCTIME or GENERALIZEDTIME can be written to a [time.Time].
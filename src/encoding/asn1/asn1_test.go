@@ -423,6 +423,8 @@ func newString(s string) *string { return &s }
 
 func newBool(b bool) *bool { return &b }
 
+func newFloat64(f float64) *float64 { return &f }
+
 var parseFieldParametersTestData []parseFieldParametersTest = []parseFieldParametersTest{
 	{"", fieldParameters{}},
 	{"ia5", fieldParameters{stringType: TagIA5String}},
@@ -506,6 +508,10 @@ var unmarshalTestData = []struct {
 	{[]byte{0x30, 0x05, 0x02, 0x03, 0x12, 0x34, 0x56}, &TestBigInt{big.NewInt(0x123456)}},
 	{[]byte{0x30, 0x0b, 0x31, 0x09, 0x02, 0x01, 0x01, 0x02, 0x01, 0x02, 0x02, 0x01, 0x03}, &TestSet{Ints: []int{1, 2, 3}}},
 	{[]byte{0x12, 0x0b, '0', '1', '2', '3', '4', '5', '6', '7', '8', '9', ' '}, newString("0123456789 ")},
+	{[]byte{0x09, 0x03, 0x80, 0xff, 0x01}, newFloat64(0.5)},
+	{[]byte{0x09, 0x00}, newFloat64(0)},
+	{[]byte{0x0d, 0x04, 0xc2, 0x7b, 0x03, 0x02}, &RelativeObjectIdentifier{8571, 3, 2}},
+	{[]byte{0x1f, 0x20, 0x06, '1', '5', '0', '4', '0', '5'}, &TimeOfDay{15, 4, 5}},
 }
 
 func TestUnmarshal(t *testing.T) {
@@ -1175,3 +1181,107 @@ func BenchmarkObjectIdentifierString(b *testing.B) {
 		_ = oidPublicKeyRSA.String()
 	}
 }
+
+func TestParseReal(t *testing.T) {
+	tests := []struct {
+		in  []byte
+		out float64
+		ok  bool
+	}{
+		{[]byte{}, 0, true},
+		{[]byte{0x80, 0x00, 0x01}, 1, true},
+		{[]byte{0xc0, 0x00, 0x03}, -3, true},
+		{[]byte{0x81, 0xfb, 0xce, 0x01}, math.SmallestNonzeroFloat64, true},
+		{[]byte{0xa0, 0x01, 0x01}, 16, true},  // base 16
+		{[]byte{0x94, 0x02, 0x03}, 384, true}, // base 8, scale factor 1
+		{[]byte{0x83, 0x01, 0x02, 0x05}, 20, true},
+		{[]byte{0x80, 0x00, 0x02}, 2, true}, // even mantissa
+		{[]byte{0x03, '1', '.', '5', 'E', '2'}, 150, true},
+		{[]byte{0x02, ' ', '2', ',', '5'}, 2.5, true},
+		{[]byte{0x01, '-', '7'}, -7, true},
+		{[]byte{0x40}, math.Inf(1), true},
+		{[]byte{0x41}, math.Inf(-1), true},
+		{[]byte{0x43}, math.Copysign(0, -1), true},
+		{[]byte{0xb0, 0x00, 0x01}, 0, false}, // reserved base
+		{[]byte{0x81, 0x00}, 0, false},
+		{[]byte{0x80, 0x00, 0x01, 0x00}, 256, true},
+		{[]byte{0x81, 0x7f, 0xff, 0x01}, 0, false}, // too large
+		{[]byte{0x44}, 0, false},
+		{[]byte{0x40, 0x00}, 0, false},
+		{[]byte{0x04, '1'}, 0, false},
+		{[]byte{0x03, 'i', 'n', 'f'}, 0, false},
+		{[]byte{0x03}, 0, false},
+	}
+	for _, test := range tests {
+		out, err := parseReal(test.in)
+		if (err == nil) != test.ok {
+			t.Errorf("parseReal(%x) error = %v, want ok = %v", test.in, err, test.ok)
+			continue
+		}
+		if test.ok && (out != test.out || math.Signbit(out) != math.Signbit(test.out)) {
+			t.Errorf("parseReal(%x) = %v, want %v", test.in, out, test.out)
+		}
+	}
+
+	if f, err := parseReal([]byte{0x42}); err != nil || !math.IsNaN(f) {
+		t.Errorf("parseReal(42) = %v, %v, want NaN", f, err)
+	}
+
+	// DER requires the canonical binary form.
+	var f float64
+	if _, err := Unmarshal([]byte{0x09, 0x03, 0x80, 0x00, 0x02}, &f); err == nil {
+		t.Errorf("Unmarshal of REAL with even mantissa succeeded")
+	}
+	var f32 float32
+	if _, err := Unmarshal([]byte{0x09, 0x04, 0x81, 0x00, 0xc8, 0x01}, &f32); err == nil {
+		t.Errorf("Unmarshal of too large REAL into float32 succeeded")
+	}
+}
+
+func TestTimeOfDay(t *testing.T) {
+	for _, in := range []string{"240000", "126000", "1200", "12:00:00", "12 000"} {
+		if _, err := parseTimeOfDay([]byte(in)); err == nil {
+			t.Errorf("parseTimeOfDay(%q) succeeded", in)
+		}
+	}
+	if s := (TimeOfDay{9, 5, 0}).String(); s != "09:05:00" {
+		t.Errorf("String() = %q, want 09:05:00", s)
+	}
+}
+
+func TestRelativeObjectIdentifier(t *testing.T) {
+	if _, err := parseRelativeObjectIdentifier(nil); err == nil {
+		t.Errorf("parseRelativeObjectIdentifier of empty input succeeded")
+	}
+	if _, err := parseRelativeObjectIdentifier([]byte{0x81}); err == nil {
+		t.Errorf("parseRelativeObjectIdentifier of truncated input succeeded")
+	}
+	oid := RelativeObjectIdentifier{8571, 3, 2}
+	if s := oid.String(); s != "8571.3.2" {
+		t.Errorf("String() = %q, want 8571.3.2", s)
+	}
+	if !oid.Equal(RelativeObjectIdentifier{8571, 3, 2}) || oid.Equal(RelativeObjectIdentifier{8571, 3}) {
+		t.Errorf("Equal returned wrong result")
+	}
+}
+
+func TestUnmarshalAnyNewTypes(t *testing.T) {
+	tests := []struct {
+		in  []byte
+		out any
+	}{
+		{[]byte{0x09, 0x03, 0x80, 0x00, 0x01}, 1.0},
+		{[]byte{0x0d, 0x01, 0x05}, RelativeObjectIdentifier{5}},
+		{[]byte{0x1f, 0x20, 0x06, '2', '3', '5', '9', '5', '9'}, TimeOfDay{23, 59, 59}},
+	}
+	for _, test := range tests {
+		var v any
+		if _, err := Unmarshal(test.in, &v); err != nil {
+			t.Errorf("Unmarshal(%x) error: %v", test.in, err)
+			continue
+		}
+		if !reflect.DeepEqual(v, test.out) {
+			t.Errorf("Unmarshal(%x) = %#v, want %#v", test.in, v, test.out)
+		}
+	}
+}
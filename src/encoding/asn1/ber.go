@@ -0,0 +1,279 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package asn1
+
+import (
+	"io"
+	"reflect"
+)
+
+// BER, the Basic Encoding Rules, permit several encodings of each value:
+// lengths may be given in the indefinite form, terminated by two zero
+// bytes, or in long form with leading zeros; strings may be split into
+// segments wrapped in a constructed value; and any non-zero byte encodes
+// a true BOOLEAN. CER, the Canonical Encoding Rules, is a subset of BER.
+//
+// Rather than teach parseField about each of these, BER input is first
+// converted to the DER form it understands.
+
+// maxBERDepth limits the nesting of constructed values in BER input.
+const maxBERDepth = 128
+
+// sliceByteReader is an io.ByteReader reading from a byte slice.
+type sliceByteReader struct {
+	b   []byte
+	off int
+}
+
+func (r *sliceByteReader) ReadByte() (byte, error) {
+	if r.off >= len(r.b) {
+		return 0, io.EOF
+	}
+	c := r.b[r.off]
+	r.off++
+	return c, nil
+}
+
+// readHeader reads an identifier and length in any form BER permits.
+// It returns io.EOF if no bytes could be read and io.ErrUnexpectedEOF
+// if the input ends within the header.
+func readHeader(r io.ByteReader) (h Header, err error) {
+	b, err := r.ReadByte()
+	if err != nil {
+		return
+	}
+	h.Class = int(b >> 6)
+	h.IsCompound = b&0x20 == 0x20
+	h.Tag = int(b & 0x1f)
+
+	if h.Tag == 0x1f {
+		h.Tag = 0
+		for i := 0; ; i++ {
+			if b, err = r.ReadByte(); err != nil {
+				return h, noEOF(err)
+			}
+			if i == 0 && b == 0x80 {
+				return h, SyntaxError{"integer is not minimally encoded"}
+			}
+			if i == 4 {
+				return h, StructuralError{"base 128 integer too large"}
+			}
+			h.Tag = h.Tag<<7 | int(b&0x7f)
+			if b&0x80 == 0 {
+				break
+			}
+		}
+		if h.Tag < 0x1f {
+			return h, SyntaxError{"non-minimal tag"}
+		}
+	}
+
+	if b, err = r.ReadByte(); err != nil {
+		return h, noEOF(err)
+	}
+	switch {
+	case b&0x80 == 0:
+		h.Length = int(b)
+	case b == 0x80:
+		if !h.IsCompound {
+			return h, SyntaxError{"indefinite length on primitive value"}
+		}
+		h.Length = -1
+	case b == 0xff:
+		return h, SyntaxError{"reserved length octet"}
+	default:
+		for n := b & 0x7f; n > 0; n-- {
+			if b, err = r.ReadByte(); err != nil {
+				return h, noEOF(err)
+			}
+			if h.Length >= 1<<23 {
+				return h, StructuralError{"length too large"}
+			}
+			h.Length = h.Length<<8 | int(b)
+		}
+	}
+	return h, nil
+}
+
+func noEOF(err error) error {
+	if err == io.EOF {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
+
+// isStringTag reports whether values with the universal tag
+// may use the constructed form for a string of segments.
+func isStringTag(tag int) bool {
+	switch tag {
+	case TagBitString, TagOctetString, 7, // ObjectDescriptor
+		TagUTF8String, TagNumericString, TagPrintableString, TagT61String,
+		21, // VideotexString
+		TagIA5String, TagUTCTime, TagGeneralizedTime,
+		25, 26, // GraphicString, VisibleString
+		TagGeneralString,
+		28, // UniversalString
+		TagBMPString:
+		return true
+	}
+	return false
+}
+
+// appendDER appends to dst the DER form of the BER value at the start of b
+// and returns the number of bytes of b it occupies. Lengths are written in
+// definite, minimal form; constructed strings are joined into primitive
+// ones; BOOLEAN and REAL values are made canonical. Other encodings,
+// such as the order of SET elements, are left unchanged.
+func appendDER(dst, b []byte, depth int) ([]byte, int, error) {
+	if depth > maxBERDepth {
+		return nil, 0, StructuralError{"BER value nested too deeply"}
+	}
+	r := &sliceByteReader{b: b}
+	h, err := readHeader(r)
+	if err != nil {
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			err = SyntaxError{"truncated tag or length"}
+		}
+		return nil, 0, err
+	}
+	offset := r.off
+
+	if !h.IsCompound {
+		if invalidLength(offset, h.Length, len(b)) {
+			return nil, 0, SyntaxError{"data truncated"}
+		}
+		content := b[offset : offset+h.Length]
+		if h.Class == ClassUniversal {
+			switch h.Tag {
+			case TagBoolean:
+				if len(content) == 1 && content[0] != 0 {
+					content = []byte{0xff}
+				}
+			case TagReal:
+				f, err := parseReal(content)
+				if err != nil {
+					return nil, 0, err
+				}
+				content = appendReal(nil, f)
+			}
+		}
+		dst = appendTagAndLength(dst, tagAndLength{h.Class, h.Tag, len(content), false})
+		return append(dst, content...), offset + h.Length, nil
+	}
+
+	body := b[offset:]
+	if h.Length >= 0 {
+		if invalidLength(offset, h.Length, len(b)) {
+			return nil, 0, SyntaxError{"data truncated"}
+		}
+		body = body[:h.Length]
+	}
+	var contents []byte
+	pos := 0
+	for {
+		if h.Length < 0 {
+			if pos+2 <= len(body) && body[pos] == 0 && body[pos+1] == 0 {
+				pos += 2
+				break
+			}
+			if pos >= len(body) {
+				return nil, 0, SyntaxError{"missing end-of-contents"}
+			}
+		} else if pos == len(body) {
+			break
+		}
+		var n int
+		contents, n, err = appendDER(contents, body[pos:], depth+1)
+		if err != nil {
+			return nil, 0, err
+		}
+		pos += n
+	}
+
+	if h.Class == ClassUniversal && isStringTag(h.Tag) {
+		contents, err = joinSegments(h.Tag, contents)
+		if err != nil {
+			return nil, 0, err
+		}
+		dst = appendTagAndLength(dst, tagAndLength{h.Class, h.Tag, len(contents), false})
+	} else {
+		dst = appendTagAndLength(dst, tagAndLength{h.Class, h.Tag, len(contents), true})
+	}
+	return append(dst, contents...), offset + pos, nil
+}
+
+// joinSegments returns the contents of the constructed string with the
+// given universal tag whose DER-form segments are in b. The segments of
+// a BIT STRING are BIT STRINGs; those of other strings are OCTET STRINGs.
+func joinSegments(tag int, b []byte) ([]byte, error) {
+	segTag := TagOctetString
+	if tag == TagBitString {
+		segTag = TagBitString
+	}
+	var out []byte
+	unused := byte(0)
+	if tag == TagBitString {
+		out = append(out, 0)
+	}
+	for offset := 0; offset < len(b); {
+		t, off, err := parseTagAndLength(b, offset)
+		if err != nil {
+			return nil, err
+		}
+		if t.class != ClassUniversal || t.tag != segTag || t.isCompound {
+			return nil, StructuralError{"invalid segment in constructed string"}
+		}
+		seg := b[off : off+t.length]
+		offset = off + t.length
+		if tag == TagBitString {
+			if len(seg) == 0 || unused != 0 {
+				return nil, SyntaxError{"invalid BIT STRING segment"}
+			}
+			unused, seg = seg[0], seg[1:]
+		}
+		out = append(out, seg...)
+	}
+	if tag == TagBitString {
+		out[0] = unused
+	}
+	return out, nil
+}
+
+// UnmarshalBER is like [Unmarshal] but accepts data in any encoding
+// permitted by the Basic Encoding Rules (BER), including CER: values of
+// indefinite length, lengths in non-minimal form, strings made up of
+// segments, and any non-zero octet as a true BOOLEAN.
+//
+// The data is converted to DER before it is parsed, so [RawValue] and
+// [RawContent] fields receive the converted encoding rather than the
+// original bytes. Strings with IMPLICIT tags must use the primitive form.
+func UnmarshalBER(b []byte, val any) (rest []byte, err error) {
+	return UnmarshalBERWithParams(b, val, "")
+}
+
+// UnmarshalBERWithParams is like [UnmarshalWithParams] but accepts
+// BER-encoded data as described for [UnmarshalBER].
+func UnmarshalBERWithParams(b []byte, val any, params string) (rest []byte, err error) {
+	v := reflect.ValueOf(val)
+	if v.Kind() != reflect.Pointer || v.IsNil() {
+		return nil, &invalidUnmarshalError{reflect.TypeOf(val)}
+	}
+	if len(b) == 0 {
+		return UnmarshalWithParams(b, val, params)
+	}
+	der, n, err := appendDER(nil, b, 0)
+	if err != nil {
+		return nil, err
+	}
+	offset, err := parseField(v.Elem(), der, 0, parseFieldParameters(params))
+	if err != nil {
+		return nil, err
+	}
+	if offset == 0 {
+		// An absent optional value.
+		return b, nil
+	}
+	return b[n:], nil
+}
@@ -0,0 +1,125 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package asn1
+
+import (
+	"bytes"
+	"encoding/hex"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+type berSequence struct {
+	A int
+	B bool
+}
+
+func mustHex(s string) []byte {
+	b, err := hex.DecodeString(strings.ReplaceAll(s, " ", ""))
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+var unmarshalBERTests = []struct {
+	in  string
+	out any
+}{
+	// Indefinite length, and a BOOLEAN true encoded as 0x01.
+	{"3080 020105 010101 0000", &berSequence{5, true}},
+	// Non-minimal long-form length.
+	{"048200 03 616263", &[]byte{'a', 'b', 'c'}},
+	// Constructed OCTET STRING, with a nested constructed segment.
+	{"2480 04026162 0401 63 0000", &[]byte{'a', 'b', 'c'}},
+	{"2480 2403 040161 040162 0000", &[]byte{'a', 'b'}},
+	// Constructed character string.
+	{"3380 04026869 0000", newString("hi")},
+	// Constructed BIT STRING.
+	{"2380 030200ff 030204f0 0000", &BitString{[]byte{0xff, 0xf0}, 12}},
+	// REAL in base 16.
+	{"0903a00101", newFloat64(16)},
+	// Definite lengths are accepted too.
+	{"3006020105010100", &berSequence{5, false}},
+	// SEQUENCE OF with indefinite length.
+	{"3080 020101 020102 020103 0000", &[]int{1, 2, 3}},
+	// RawValue receives the DER form.
+	{"3080 0500 0000", &RawValue{0, 16, true, []byte{5, 0}, []byte{0x30, 2, 5, 0}}},
+}
+
+func TestUnmarshalBER(t *testing.T) {
+	for i, test := range unmarshalBERTests {
+		pv := reflect.New(reflect.TypeOf(test.out).Elem())
+		val := pv.Interface()
+		in := append(mustHex(test.in), 0xaa)
+		rest, err := UnmarshalBER(in, val)
+		if err != nil {
+			t.Errorf("#%d: UnmarshalBER error: %v", i, err)
+			continue
+		}
+		if !reflect.DeepEqual(val, test.out) {
+			t.Errorf("#%d:\nhave %#v\nwant %#v", i, val, test.out)
+		}
+		if !bytes.Equal(rest, []byte{0xaa}) {
+			t.Errorf("#%d: rest = %x, want aa", i, rest)
+		}
+		if _, err := Unmarshal(in, val); err == nil && i < 7 {
+			t.Errorf("#%d: Unmarshal accepted non-DER input", i)
+		}
+	}
+}
+
+func TestUnmarshalBERCER(t *testing.T) {
+	// CER splits long strings into segments of 1000 bytes.
+	data := bytes.Repeat([]byte("0123456789"), 250)
+	in := []byte{0x24, 0x80}
+	for rest := data; len(rest) > 0; {
+		n := min(len(rest), 1000)
+		in = appendTagAndLength(in, tagAndLength{ClassUniversal, TagOctetString, n, false})
+		in = append(in, rest[:n]...)
+		rest = rest[n:]
+	}
+	in = append(in, 0, 0)
+	var out []byte
+	rest, err := UnmarshalBER(in, &out)
+	if err != nil || len(rest) != 0 {
+		t.Fatalf("UnmarshalBER = %x, %v", rest, err)
+	}
+	if !bytes.Equal(out, data) {
+		t.Errorf("UnmarshalBER did not join segments")
+	}
+}
+
+func TestUnmarshalBERErrors(t *testing.T) {
+	tests := []string{
+		"",
+		"3080 020105",            // missing end-of-contents
+		"0480 0000",              // indefinite primitive
+		"3004 020105",            // truncated
+		"2308 030204f0 030200ff", // unused bits in non-final segment
+		"2480 0c0161 0000",       // segment of the wrong type
+		"30ff",                   // reserved length
+		"1f1e00",                 // non-minimal tag
+		"0903b00001",             // reserved REAL base
+	}
+	for _, in := range tests {
+		var v any
+		if _, err := UnmarshalBER(mustHex(in), &v); err == nil {
+			t.Errorf("UnmarshalBER(%s) succeeded", in)
+		}
+	}
+
+	deep := bytes.Repeat([]byte{0x30, 0x80}, maxBERDepth+2)
+	deep = append(deep, make([]byte, 2*(maxBERDepth+2))...)
+	var v RawValue
+	if _, err := UnmarshalBER(deep, &v); err == nil {
+		t.Errorf("UnmarshalBER of deeply nested value succeeded")
+	}
+
+	if _, err := UnmarshalBER([]byte{5, 0}, nil); err == nil {
+		t.Errorf("UnmarshalBER into nil succeeded")
+	}
+}
@@ -26,8 +26,10 @@ const (
 	TagOctetString     = 4
 	TagNull            = 5
 	TagOID             = 6
+	TagReal            = 9
 	TagEnum            = 10
 	TagUTF8String      = 12
+	TagRelativeOID     = 13
 	TagSequence        = 16
 	TagSet             = 17
 	TagNumericString   = 18
@@ -38,6 +40,7 @@ const (
 	TagGeneralizedTime = 24
 	TagGeneralString   = 27
 	TagBMPString       = 30
+	TagTimeOfDay       = 32
 )
 
 // ASN.1 class types represent the namespace of the tag.
@@ -162,12 +165,18 @@ func getUniversalType(t reflect.Type) (matchAny bool, tagNumber int, isCompound,
 		return false, TagEnum, false, true
 	case bigIntType:
 		return false, TagInteger, false, true
+	case relativeOIDType:
+		return false, TagRelativeOID, false, true
+	case timeOfDayType:
+		return false, TagTimeOfDay, false, true
 	}
 	switch t.Kind() {
 	case reflect.Bool:
 		return false, TagBoolean, false, true
 	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
 		return false, TagInteger, false, true
+	case reflect.Float32, reflect.Float64:
+		return false, TagReal, false, true
 	case reflect.Struct:
 		return false, TagSequence, true, true
 	case reflect.Slice:
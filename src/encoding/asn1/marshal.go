@@ -8,7 +8,9 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
+	"math"
 	"math/big"
+	"math/bits"
 	"reflect"
 	"slices"
 	"time"
@@ -447,6 +449,72 @@ func appendTimeCommon(dst []byte, t time.Time) []byte {
 	return dst
 }
 
+// appendReal appends the DER encoding of the REAL f to dst: binary
+// with base 2, a scale factor of zero and an odd mantissa.
+func appendReal(dst []byte, f float64) []byte {
+	switch {
+	case f == 0:
+		if math.Signbit(f) {
+			return append(dst, 0x43)
+		}
+		return dst
+	case math.IsInf(f, 1):
+		return append(dst, 0x40)
+	case math.IsInf(f, -1):
+		return append(dst, 0x41)
+	case math.IsNaN(f):
+		return append(dst, 0x42)
+	}
+	first := byte(0x80)
+	if f < 0 {
+		first |= 0x40
+		f = -f
+	}
+	frac, exp := math.Frexp(f)
+	mant := uint64(math.Ldexp(frac, 64))
+	exp -= 64
+	n := bits.TrailingZeros64(mant)
+	mant >>= n
+	exp += n
+
+	e := int64Encoder(exp)
+	if e.Len() > 1 {
+		first |= 0x01
+	}
+	dst = append(dst, first)
+	dst = append(dst, make([]byte, e.Len())...)
+	e.Encode(dst[len(dst)-e.Len():])
+	for i := (bits.Len64(mant) - 1) / 8; i >= 0; i-- {
+		dst = append(dst, byte(mant>>(8*i)))
+	}
+	return dst
+}
+
+// appendRelativeObjectIdentifier appends the encoding of the arcs of oid to dst.
+func appendRelativeObjectIdentifier(dst []byte, oid RelativeObjectIdentifier) ([]byte, error) {
+	if len(oid) == 0 {
+		return nil, StructuralError{"empty RELATIVE-OID"}
+	}
+	for _, v := range oid {
+		if v < 0 {
+			return nil, StructuralError{"invalid RELATIVE-OID"}
+		}
+		dst = appendBase128Int(dst, int64(v))
+	}
+	return dst, nil
+}
+
+func makeTimeOfDay(t TimeOfDay) (e encoder, err error) {
+	if !t.valid() {
+		return nil, StructuralError{"invalid TIME-OF-DAY"}
+	}
+	dst := make([]byte, 0, 6)
+	dst = appendTwoDigits(dst, t.Hour)
+	dst = appendTwoDigits(dst, t.Minute)
+	dst = appendTwoDigits(dst, t.Second)
+	return bytesEncoder(dst), nil
+}
+
 func stripTagAndLength(in []byte) []byte {
 	_, offset, err := parseTagAndLength(in, 0)
 	if err != nil {
@@ -471,6 +539,14 @@ func makeBody(value reflect.Value, params fieldParameters) (e encoder, err error
 		return makeObjectIdentifier(value.Interface().(ObjectIdentifier))
 	case bigIntType:
 		return makeBigInt(value.Interface().(*big.Int))
+	case relativeOIDType:
+		b, err := appendRelativeObjectIdentifier(nil, value.Interface().(RelativeObjectIdentifier))
+		if err != nil {
+			return nil, err
+		}
+		return bytesEncoder(b), nil
+	case timeOfDayType:
+		return makeTimeOfDay(value.Interface().(TimeOfDay))
 	}
 
 	switch v := value; v.Kind() {
@@ -481,6 +557,8 @@ func makeBody(value reflect.Value, params fieldParameters) (e encoder, err error
 		return byte00Encoder, nil
 	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
 		return int64Encoder(v.Int()), nil
+	case reflect.Float32, reflect.Float64:
+		return bytesEncoder(appendReal(nil, v.Float())), nil
 	case reflect.Struct:
 		t := v.Type()
 
@@ -7,6 +7,7 @@ package asn1
 import (
 	"bytes"
 	"encoding/hex"
+	"math"
 	"math/big"
 	"reflect"
 	"slices"
@@ -180,6 +181,19 @@ var marshalTests = []marshalTest{
 	{applicationTest{1, 2}, "30084001016103020102"},
 	{privateTest{1, 2, 3, 4}, "3011c00101e103020102df1f0103df81000104"},
 	{numericStringTest{"1 9"}, "30051203312039"},
+	{1.0, "0903800001"},
+	{0.5, "090380ff01"},
+	{-3.0, "0903c00003"},
+	{1024.0, "0903800a01"},
+	{float32(0.25), "090380fe01"},
+	{math.SmallestNonzeroFloat64, "090481fbce01"},
+	{0.0, "0900"},
+	{math.Copysign(0, -1), "090143"},
+	{math.Inf(1), "090140"},
+	{math.Inf(-1), "090141"},
+	{math.NaN(), "090142"},
+	{RelativeObjectIdentifier{8571, 3, 2}, "0d04c27b0302"},
+	{TimeOfDay{15, 4, 5}, "1f2006313530343035"},
 }
 
 func TestMarshal(t *testing.T) {
@@ -232,6 +246,8 @@ var marshalErrTests = []marshalErrTest{
 	{numericStringTest{"a"}, "invalid character"},
 	{ia5StringTest{"\xb0"}, "invalid character"},
 	{printableStringTest{"!"}, "invalid character"},
+	{RelativeObjectIdentifier{}, "empty RELATIVE-OID"},
+	{TimeOfDay{Hour: 24}, "invalid TIME-OF-DAY"},
 }
 
 func TestMarshalError(t *testing.T) {
@@ -0,0 +1,236 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package asn1
+
+import (
+	"bufio"
+	"errors"
+	"io"
+)
+
+// A Header holds the identifier and length octets that
+// precede the contents of an encoded value.
+type Header struct {
+	Class, Tag int
+	IsCompound bool
+
+	// Length is the length of the contents in bytes, or -1 for a
+	// constructed value in the indefinite-length form.
+	Length int
+}
+
+// A Reader reads a stream of BER-encoded values, which includes
+// DER and CER, without holding whole values in memory.
+//
+// [Reader.Next] visits the values in the order they appear: after it
+// returns the header of a constructed value, the following calls return
+// the headers of the values it contains. The contents of a primitive
+// value are read with [Reader.Read].
+type Reader struct {
+	r      countingReader
+	stack  []int64 // end offset of each open constructed value, or -1
+	remain int     // unread contents of the current primitive value
+	err    error
+}
+
+// countingReader reads from a bufio.Reader, counting the bytes
+// read and, while capturing is set, saving them.
+type countingReader struct {
+	r         *bufio.Reader
+	n         int64
+	capturing bool
+	captured  []byte
+}
+
+func (c *countingReader) ReadByte() (byte, error) {
+	b, err := c.r.ReadByte()
+	if err == nil {
+		c.n++
+		if c.capturing {
+			c.captured = append(c.captured, b)
+		}
+	}
+	return b, err
+}
+
+func (c *countingReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	c.n += int64(n)
+	if c.capturing {
+		c.captured = append(c.captured, p[:n]...)
+	}
+	return n, err
+}
+
+// NewReader returns a new Reader reading from r.
+func NewReader(r io.Reader) *Reader {
+	return &Reader{r: countingReader{r: bufio.NewReader(r)}}
+}
+
+func (r *Reader) fail(err error) (Header, error) {
+	r.err = err
+	return Header{}, err
+}
+
+// discard skips the unread contents of the current primitive value.
+func (r *Reader) discard() error {
+	if r.remain > 0 {
+		n, err := io.CopyN(io.Discard, &r.r, int64(r.remain))
+		r.remain -= int(n)
+		if err != nil {
+			r.err = noEOF(err)
+			return r.err
+		}
+	}
+	return nil
+}
+
+// Next returns the header of the next value, skipping the unread
+// contents of the previous one.
+//
+// At the end of the contents of a constructed value, Next returns
+// [io.EOF]; the following call returns the value after the constructed
+// one. At the end of the input, outside any constructed value, Next
+// also returns io.EOF. Use [Reader.Depth] to tell the two apart.
+func (r *Reader) Next() (Header, error) {
+	if r.err != nil {
+		return Header{}, r.err
+	}
+	if err := r.discard(); err != nil {
+		return Header{}, err
+	}
+	top := len(r.stack) - 1
+	if top >= 0 {
+		if end := r.stack[top]; end >= 0 && r.r.n >= end {
+			if r.r.n > end {
+				return r.fail(SyntaxError{"value extends past end of enclosing value"})
+			}
+			r.stack = r.stack[:top]
+			return Header{}, io.EOF
+		}
+	}
+
+	h, err := readHeader(&r.r)
+	if err != nil {
+		if err == io.EOF && top < 0 {
+			return Header{}, io.EOF
+		}
+		return r.fail(noEOF(err))
+	}
+	if h.Class == ClassUniversal && h.Tag == 0 {
+		// End-of-contents octets.
+		if top < 0 || r.stack[top] >= 0 || h.IsCompound || h.Length != 0 {
+			return r.fail(SyntaxError{"unexpected end-of-contents"})
+		}
+		r.stack = r.stack[:top]
+		return Header{}, io.EOF
+	}
+	if top >= 0 && r.stack[top] >= 0 && h.Length >= 0 && r.r.n+int64(h.Length) > r.stack[top] {
+		return r.fail(SyntaxError{"value extends past end of enclosing value"})
+	}
+	if h.IsCompound {
+		if len(r.stack) >= maxBERDepth {
+			return r.fail(StructuralError{"BER value nested too deeply"})
+		}
+		end := int64(-1)
+		if h.Length >= 0 {
+			end = r.r.n + int64(h.Length)
+		}
+		r.stack = append(r.stack, end)
+	} else {
+		r.remain = h.Length
+	}
+	return h, nil
+}
+
+// Read reads up to len(p) bytes of the contents of the primitive value
+// whose header was most recently returned by [Reader.Next]. It returns
+// [io.EOF] at the end of the contents.
+func (r *Reader) Read(p []byte) (int, error) {
+	if r.err != nil {
+		return 0, r.err
+	}
+	if r.remain == 0 {
+		return 0, io.EOF
+	}
+	if len(p) > r.remain {
+		p = p[:r.remain]
+	}
+	n, err := r.r.Read(p)
+	r.remain -= n
+	if err != nil {
+		r.err = noEOF(err)
+	}
+	return n, r.err
+}
+
+var errSkipTopLevel = errors.New("asn1: Skip called outside a constructed value")
+
+// Skip discards the rest of the innermost constructed value being
+// read, so that the next call to [Reader.Next] returns the value after it.
+func (r *Reader) Skip() error {
+	depth := len(r.stack)
+	if depth == 0 {
+		return errSkipTopLevel
+	}
+	if err := r.discard(); err != nil {
+		return err
+	}
+	if end := r.stack[depth-1]; end >= 0 {
+		// The length is known; values nested within it
+		// need not be parsed.
+		if _, err := io.CopyN(io.Discard, &r.r, end-r.r.n); err != nil {
+			r.err = noEOF(err)
+			return r.err
+		}
+		r.stack = r.stack[:depth-1]
+		return nil
+	}
+	for len(r.stack) >= depth {
+		if _, err := r.Next(); err != nil && err != io.EOF {
+			return err
+		}
+	}
+	return nil
+}
+
+// Decode reads the next value in full and stores it in the value pointed
+// to by val, as [UnmarshalBER] does. Like [Reader.Next], it returns
+// [io.EOF] at the end of the contents of a constructed value or of
+// the input.
+func (r *Reader) Decode(val any) error {
+	if err := r.discard(); err != nil {
+		return err
+	}
+	r.r.capturing = true
+	r.r.captured = r.r.captured[:0]
+	defer func() { r.r.capturing = false }()
+
+	h, err := r.Next()
+	if err != nil {
+		return err
+	}
+	if h.IsCompound {
+		err = r.Skip()
+	} else {
+		err = r.discard()
+	}
+	if err != nil {
+		return err
+	}
+	_, err = UnmarshalBER(r.r.captured, val)
+	return err
+}
+
+// Depth returns the number of constructed values
+// that enclose the current position.
+func (r *Reader) Depth() int {
+	return len(r.stack)
+}
+
+// InputOffset returns the number of bytes read from the input so far.
+func (r *Reader) InputOffset() int64 {
+	return r.r.n
+}
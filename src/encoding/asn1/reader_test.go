@@ -0,0 +1,136 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package asn1
+
+import (
+	"bytes"
+	"io"
+	"reflect"
+	"testing"
+	"testing/iotest"
+)
+
+func TestReader(t *testing.T) {
+	in := mustHex("3080 020107 3106 040141 040142 2480 040143 0000 0000 0500")
+	type step struct {
+		h       Header
+		eof     bool
+		depth   int
+		content string
+	}
+	steps := []step{
+		{h: Header{ClassUniversal, TagSequence, true, -1}, depth: 1},
+		{h: Header{ClassUniversal, TagInteger, false, 1}, depth: 1, content: "\x07"},
+		{h: Header{ClassUniversal, TagSet, true, 6}, depth: 2},
+		{h: Header{ClassUniversal, TagOctetString, false, 1}, depth: 2},
+		{h: Header{ClassUniversal, TagOctetString, false, 1}, depth: 2, content: "B"},
+		{eof: true, depth: 1},
+		{h: Header{ClassUniversal, TagOctetString, true, -1}, depth: 2},
+		{h: Header{ClassUniversal, TagOctetString, false, 1}, depth: 2, content: "C"},
+		{eof: true, depth: 1},
+		{eof: true, depth: 0},
+		{h: Header{ClassUniversal, TagNull, false, 0}, depth: 0},
+		{eof: true, depth: 0},
+	}
+	r := NewReader(iotest.OneByteReader(bytes.NewReader(in)))
+	for i, s := range steps {
+		h, err := r.Next()
+		if s.eof {
+			if err != io.EOF {
+				t.Fatalf("step %d: Next = %+v, %v, want io.EOF", i, h, err)
+			}
+		} else if err != nil || h != s.h {
+			t.Fatalf("step %d: Next = %+v, %v, want %+v", i, h, err, s.h)
+		}
+		if d := r.Depth(); d != s.depth {
+			t.Errorf("step %d: Depth = %d, want %d", i, d, s.depth)
+		}
+		if s.content != "" {
+			b, err := io.ReadAll(r)
+			if err != nil || string(b) != s.content {
+				t.Errorf("step %d: contents = %q, %v, want %q", i, b, err, s.content)
+			}
+		}
+	}
+	if off := r.InputOffset(); off != int64(len(in)) {
+		t.Errorf("InputOffset = %d, want %d", off, len(in))
+	}
+}
+
+func TestReaderDecode(t *testing.T) {
+	// A SEQUENCE OF in CER form, decoded one element at a time.
+	in := mustHex("3080 020101 3080 020102 0101ff 0000 020103 0000")
+	r := NewReader(bytes.NewReader(in))
+	if _, err := r.Next(); err != nil {
+		t.Fatal(err)
+	}
+	var n int
+	if err := r.Decode(&n); err != nil || n != 1 {
+		t.Fatalf("Decode = %d, %v, want 1", n, err)
+	}
+	var s berSequence
+	if err := r.Decode(&s); err != nil || s != (berSequence{2, true}) {
+		t.Fatalf("Decode = %+v, %v, want {2 true}", s, err)
+	}
+	if err := r.Decode(&n); err != nil || n != 3 {
+		t.Fatalf("Decode = %d, %v, want 3", n, err)
+	}
+	if err := r.Decode(&n); err != io.EOF {
+		t.Fatalf("Decode at end of sequence error = %v, want io.EOF", err)
+	}
+	if err := r.Decode(&n); err != io.EOF {
+		t.Fatalf("Decode at end of input error = %v, want io.EOF", err)
+	}
+}
+
+func TestReaderSkip(t *testing.T) {
+	for _, in := range []string{
+		"3080 020101 3080 0500 0000 0000 0101ff",
+		"3009 020101 3080 0500 0000 0101ff",
+	} {
+		r := NewReader(bytes.NewReader(mustHex(in)))
+		if err := r.Skip(); err == nil {
+			t.Errorf("Skip at top level succeeded")
+		}
+		r.Next()
+		r.Next()
+		if err := r.Skip(); err != nil {
+			t.Fatalf("%s: Skip error: %v", in, err)
+		}
+		h, err := r.Next()
+		if want := (Header{ClassUniversal, TagBoolean, false, 1}); err != nil || h != want {
+			t.Errorf("%s: Next after Skip = %+v, %v, want %+v", in, h, err, want)
+		}
+	}
+}
+
+func TestReaderErrors(t *testing.T) {
+	tests := []string{
+		"3003 020501",  // value extends past the enclosing one
+		"0000",         // end-of-contents at top level
+		"3003 0000 00", // end-of-contents in definite-length value
+		"0403 6162",    // truncated contents
+		"3080 020101",  // missing end-of-contents
+		"1f",           // truncated tag
+		"04 80",        // indefinite primitive
+	}
+	for _, in := range tests {
+		r := NewReader(bytes.NewReader(mustHex(in)))
+		var err error
+		for err == nil {
+			_, err = r.Next()
+			if err == io.EOF && r.Depth() > 0 {
+				err = nil
+			}
+		}
+		if err == io.EOF {
+			t.Errorf("%s: reached end of input without error", in)
+			continue
+		}
+		if _, err2 := r.Next(); !reflect.DeepEqual(err2, err) {
+			t.Errorf("%s: error is not sticky: %v then %v", in, err, err2)
+		}
+	}
+}
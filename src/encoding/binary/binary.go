@@ -10,6 +10,30 @@
 // type (bool, int8, uint8, int16, float32, complex64, ...)
 // or an array or struct containing only fixed-size values.
 //
+// Struct fields may carry a "binary" tag describing a layout other
+// than the fixed-size one. The tag is a comma-separated list of options:
+//
+//	le, be      encode the numbers in the field, including any length
+//	            prefix, in little- or big-endian order regardless of the
+//	            byte order passed to [Read] or [Write]
+//	varint      encode an integer, or each integer of an array or slice,
+//	            as a varint (signed types) or uvarint (unsigned types)
+//	len:K       precede a slice or string with its length, in elements
+//	            or bytes, encoded as K: one of u8, u16, u32, u64 or uvarint
+//	len:Name    take the length of a slice or string from the earlier
+//	            integer field Name, which is not updated on encoding
+//	pad:N       precede the field with N zero bytes, ignored when reading
+//	bits:N      pack an integer or boolean into N bits
+//
+// A tag of "-" omits the field. Consecutive bit fields are packed together
+// starting with the most significant bit of the first byte, or, if the first
+// field of the run has the le option, with its least significant bit. A run
+// of bit fields must fill a whole number of bytes, at most 8.
+//
+// Slices and strings within a tagged struct need a len option, and int and
+// uint fields the varint option. The layout of each tagged type is computed
+// once and cached.
+//
 // The varint functions encode and decode single integer values using
 // a variable-length encoding; smaller values require fewer bytes.
 // For a specification, see
@@ -233,6 +257,8 @@ func (nativeEndian) GoString() string { return "binary.NativeEndian" }
 // may be used for padding.
 // When reading into a struct, all non-blank fields must be exported
 // or Read may panic.
+// Structs with binary field tags may also contain variable-size data;
+// see the package documentation.
 //
 // The error is [io.EOF] only if no bytes were read.
 // If an [io.EOF] happens after reading some but not all the bytes,
@@ -250,6 +276,13 @@ func Read(r io.Reader, order ByteOrder, data any) error {
 		}
 	}
 
+	if c, v, err := decodeLayoutOf(data); c != nil || err != nil {
+		if err != nil {
+			return err
+		}
+		return c.decode(&layoutDecoder{order: order, r: r}, v)
+	}
+
 	// Fallback to reflect-based decoding.
 	v := reflect.ValueOf(data)
 	size := -1
@@ -287,6 +320,17 @@ func Decode(buf []byte, order ByteOrder, data any) (int, error) {
 		}
 	}
 
+	if c, v, err := decodeLayoutOf(data); c != nil || err != nil {
+		if err != nil {
+			return 0, err
+		}
+		d := &layoutDecoder{order: order, buf: buf}
+		if err := c.decode(d, v); err != nil {
+			return 0, err
+		}
+		return d.off, nil
+	}
+
 	// Fallback to reflect-based decoding.
 	v := reflect.ValueOf(data)
 	size := -1
@@ -389,6 +433,8 @@ func decodeFast(bs []byte, order ByteOrder, data any) bool {
 // and read from successive fields of the data.
 // When writing structs, zero values are written for fields
 // with blank (_) field names.
+// Structs with binary field tags may also contain variable-size data;
+// see the package documentation.
 func Write(w io.Writer, order ByteOrder, data any) error {
 	// Fast path for basic types and slices.
 	if n, bs := intDataSize(data); n != 0 {
@@ -401,6 +447,18 @@ func Write(w io.Writer, order ByteOrder, data any) error {
 		return err
 	}
 
+	if c, v, err := encodeLayoutOf(data); c != nil || err != nil {
+		if err != nil {
+			return err
+		}
+		e := &layoutEncoder{order: order, buf: make([]byte, c.size(v))}
+		if err := c.encode(e, v); err != nil {
+			return err
+		}
+		_, err := w.Write(e.buf)
+		return err
+	}
+
 	// Fallback to reflect-based encoding.
 	v := reflect.Indirect(reflect.ValueOf(data))
 	size := dataSize(v)
@@ -430,6 +488,20 @@ func Encode(buf []byte, order ByteOrder, data any) (int, error) {
 		return n, nil
 	}
 
+	if c, v, err := encodeLayoutOf(data); c != nil || err != nil {
+		if err != nil {
+			return 0, err
+		}
+		size := c.size(v)
+		if len(buf) < size {
+			return 0, errBufferTooSmall
+		}
+		if err := c.encode(&layoutEncoder{order: order, buf: buf[:size]}, v); err != nil {
+			return 0, err
+		}
+		return size, nil
+	}
+
 	// Fallback to reflect-based encoding.
 	v := reflect.Indirect(reflect.ValueOf(data))
 	size := dataSize(v)
@@ -457,6 +529,17 @@ func Append(buf []byte, order ByteOrder, data any) ([]byte, error) {
 		return buf, nil
 	}
 
+	if c, v, err := encodeLayoutOf(data); c != nil || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		buf, pos := ensure(buf, c.size(v))
+		if err := c.encode(&layoutEncoder{order: order, buf: pos}, v); err != nil {
+			return nil, err
+		}
+		return buf, nil
+	}
+
 	// Fallback to reflect-based encoding.
 	v := reflect.Indirect(reflect.ValueOf(data))
 	size := dataSize(v)
@@ -576,6 +659,8 @@ func encodeFast(bs []byte, order ByteOrder, data any) {
 // Size returns how many bytes [Write] would generate to encode the value v, which
 // must be a fixed-size value or a slice of fixed-size values, or a pointer to such data.
 // If v is neither of these, Size returns -1.
+// For a struct with binary field tags, it returns the size of the
+// encoding of v itself.
 func Size(v any) int {
 	switch data := v.(type) {
 	case bool, int8, uint8:
@@ -668,6 +753,12 @@ func Size(v any) int {
 	case []float64:
 		return 8 * len(data)
 	}
+	if c, data, err := encodeLayoutOf(v); c != nil || err != nil {
+		if err != nil {
+			return -1
+		}
+		return c.size(data)
+	}
 	return dataSize(reflect.Indirect(reflect.ValueOf(v)))
 }
 
@@ -77,6 +77,26 @@ func ExampleRead_multi() {
 	// 61374
 }
 
+func ExampleRead_structTags() {
+	// A message with a bit-packed header, a varint sequence
+	// number and a length-prefixed payload.
+	type message struct {
+		Version uint8  `binary:"bits:3"`
+		Urgent  bool   `binary:"bits:1"`
+		Kind    uint8  `binary:"bits:4"`
+		Seq     uint64 `binary:"varint"`
+		Payload string `binary:"len:u16"`
+	}
+	b := []byte{0x52, 0xac, 0x02, 0x00, 0x05, 'h', 'e', 'l', 'l', 'o'}
+
+	var m message
+	if err := binary.Read(bytes.NewReader(b), binary.BigEndian, &m); err != nil {
+		fmt.Println("binary.Read failed:", err)
+	}
+	fmt.Printf("%+v\n", m)
+	// Output: {Version:2 Urgent:true Kind:2 Seq:300 Payload:hello}
+}
+
 func ExampleByteOrder_put() {
 	b := make([]byte, 4)
 	binary.LittleEndian.PutUint16(b[0:], 0x03e8)
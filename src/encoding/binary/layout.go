@@ -0,0 +1,1049 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package binary
+
+// This file implements struct layouts described by field tags.
+// The layout of each tagged type is compiled once into a tree of
+// codecs, which Read, Write, Decode, Encode, Append and Size then use
+// in place of the fixed-size reflection path.
+
+import (
+	"errors"
+	"io"
+	"math"
+	"reflect"
+	"slices"
+	"strconv"
+	"sync"
+)
+
+// A layoutCodec encodes and decodes values of one type.
+type layoutCodec interface {
+	// size returns the number of bytes needed to encode v.
+	size(v reflect.Value) int
+	// minSize returns a lower bound on the encoded size of any value.
+	minSize() int
+	encode(e *layoutEncoder, v reflect.Value) error
+	decode(d *layoutDecoder, v reflect.Value) error
+}
+
+// A layoutEncoder writes into a buffer known to be large enough.
+type layoutEncoder struct {
+	order ByteOrder
+	buf   []byte
+	off   int
+}
+
+func (e *layoutEncoder) next(n int) []byte {
+	b := e.buf[e.off : e.off+n]
+	e.off += n
+	return b
+}
+
+// A layoutDecoder reads from either buf or, if it is not nil, r.
+type layoutDecoder struct {
+	order   ByteOrder
+	buf     []byte
+	off     int
+	r       io.Reader
+	n       int64 // bytes read from r
+	scratch [16]byte
+}
+
+// readFull fills p from d.r.
+func (d *layoutDecoder) readFull(p []byte) error {
+	n, err := io.ReadFull(d.r, p)
+	d.n += int64(n)
+	if err == io.EOF && d.n > 0 {
+		err = io.ErrUnexpectedEOF
+	}
+	return err
+}
+
+// next returns the next n bytes of input. When reading from r, the
+// result is only valid until the following call.
+func (d *layoutDecoder) next(n int) ([]byte, error) {
+	if d.r == nil {
+		if len(d.buf)-d.off < n {
+			return nil, errBufferTooSmall
+		}
+		b := d.buf[d.off : d.off+n]
+		d.off += n
+		return b, nil
+	}
+	var b []byte
+	if n <= len(d.scratch) {
+		b = d.scratch[:n]
+	} else {
+		b = make([]byte, n)
+	}
+	if err := d.readFull(b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+func (d *layoutDecoder) ReadByte() (byte, error) {
+	b, err := d.next(1)
+	if err != nil {
+		return 0, err
+	}
+	return b[0], nil
+}
+
+// remaining returns the number of bytes left in buf,
+// or -1 if reading from r.
+func (d *layoutDecoder) remaining() int {
+	if d.r != nil {
+		return -1
+	}
+	return len(d.buf) - d.off
+}
+
+// bytes returns the next n bytes of input in a newly allocated slice.
+// Input from r is read in chunks so that a corrupt length cannot
+// cause a large allocation.
+func (d *layoutDecoder) bytes(n int) ([]byte, error) {
+	if d.r == nil {
+		b, err := d.next(n)
+		if err != nil {
+			return nil, err
+		}
+		return slices.Clone(b), nil
+	}
+	const chunk = 64 << 10
+	var out []byte
+	for n > 0 {
+		k := min(n, chunk)
+		out = slices.Grow(out, k)
+		l := len(out)
+		out = out[:l+k]
+		if err := d.readFull(out[l:]); err != nil {
+			return nil, err
+		}
+		n -= k
+	}
+	return out, nil
+}
+
+var layouts sync.Map // map[reflect.Type]layoutEntry
+
+type layoutEntry struct {
+	codec layoutCodec
+	err   error
+}
+
+// layoutOf returns the codec for t if the layout of t is described by
+// binary struct tags. It returns a nil codec and error for other types,
+// which use the fixed-size encoding.
+func layoutOf(t reflect.Type) (layoutCodec, error) {
+	if e, ok := layouts.Load(t); ok {
+		e := e.(layoutEntry)
+		return e.codec, e.err
+	}
+	var e layoutEntry
+	if hasLayoutTags(t, make(map[reflect.Type]bool)) {
+		b := layoutBuilder{structs: make(map[reflect.Type]*structCodec)}
+		e.codec, e.err = b.codec(t, fieldOptions{})
+	}
+	layouts.Store(t, e)
+	return e.codec, e.err
+}
+
+// layoutOfValue is like layoutOf but also accepts slices of tagged
+// types, as Read and Write do for fixed-size types.
+func layoutOfValue(v reflect.Value) (layoutCodec, error) {
+	if !v.IsValid() {
+		return nil, nil
+	}
+	t := v.Type()
+	if t.Kind() == reflect.Slice {
+		c, err := layoutOf(t.Elem())
+		if c == nil {
+			return nil, err
+		}
+		return &arrayCodec{elem: c, n: -1}, nil
+	}
+	return layoutOf(t)
+}
+
+// decodeLayoutOf returns the codec and the value to decode into if data
+// is a pointer to, or a slice of, a type with a tagged layout.
+func decodeLayoutOf(data any) (layoutCodec, reflect.Value, error) {
+	v := reflect.ValueOf(data)
+	switch v.Kind() {
+	case reflect.Pointer:
+		v = v.Elem()
+	case reflect.Slice:
+	default:
+		return nil, v, nil
+	}
+	c, err := layoutOfValue(v)
+	return c, v, err
+}
+
+// encodeLayoutOf returns the codec and the value to encode if data,
+// or what it points to, has a tagged layout.
+func encodeLayoutOf(data any) (layoutCodec, reflect.Value, error) {
+	v := reflect.Indirect(reflect.ValueOf(data))
+	c, err := layoutOfValue(v)
+	return c, v, err
+}
+
+// hasLayoutTags reports whether t contains a struct field with a binary tag.
+func hasLayoutTags(t reflect.Type, seen map[reflect.Type]bool) bool {
+	if seen[t] {
+		return false
+	}
+	seen[t] = true
+	switch t.Kind() {
+	case reflect.Array, reflect.Slice:
+		return hasLayoutTags(t.Elem(), seen)
+	case reflect.Struct:
+		for i := 0; i < t.NumField(); i++ {
+			f := t.Field(i)
+			if _, ok := f.Tag.Lookup("binary"); ok || hasLayoutTags(f.Type, seen) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// A prefixKind says how the length of a slice or string is encoded.
+type prefixKind int
+
+const (
+	prefixNone prefixKind = iota
+	prefixU8
+	prefixU16
+	prefixU32
+	prefixU64
+	prefixUvarint
+)
+
+var prefixKinds = map[string]prefixKind{
+	"u8":      prefixU8,
+	"u16":     prefixU16,
+	"u32":     prefixU32,
+	"u64":     prefixU64,
+	"uvarint": prefixUvarint,
+}
+
+// fieldOptions holds the options given in a binary struct tag.
+type fieldOptions struct {
+	order    ByteOrder // nil for the order passed by the caller
+	varint   bool
+	prefix   prefixKind
+	lenField string // name of the field holding the length
+	pad      int
+	bits     int
+	omit     bool
+}
+
+func layoutError(t reflect.Type, field, msg string) error {
+	return errors.New("binary: " + msg + " in field " + field + " of type " + t.String())
+}
+
+// parseFieldOptions parses the binary tag of field f of struct type t.
+func parseFieldOptions(t reflect.Type, f reflect.StructField) (opts fieldOptions, err error) {
+	tag := f.Tag.Get("binary")
+	if tag == "-" {
+		opts.omit = true
+		return opts, nil
+	}
+	for tag != "" {
+		var opt string
+		opt, tag = tag, ""
+		for i := 0; i < len(opt); i++ {
+			if opt[i] == ',' {
+				opt, tag = opt[:i], opt[i+1:]
+				break
+			}
+		}
+		name, arg := opt, ""
+		for i := 0; i < len(opt); i++ {
+			if opt[i] == ':' {
+				name, arg = opt[:i], opt[i+1:]
+				break
+			}
+		}
+		switch name {
+		case "le":
+			opts.order = LittleEndian
+		case "be":
+			opts.order = BigEndian
+		case "varint":
+			opts.varint = true
+		case "len":
+			if k, ok := prefixKinds[arg]; ok {
+				opts.prefix = k
+			} else if arg != "" {
+				opts.lenField = arg
+			} else {
+				return opts, layoutError(t, f.Name, "missing length in tag option "+strconv.Quote(opt))
+			}
+		case "pad", "bits":
+			n, err := strconv.Atoi(arg)
+			if err != nil || n < 0 || name == "bits" && (n == 0 || n > 64) {
+				return opts, layoutError(t, f.Name, "invalid tag option "+strconv.Quote(opt))
+			}
+			if name == "pad" {
+				opts.pad = n
+			} else {
+				opts.bits = n
+			}
+		default:
+			return opts, layoutError(t, f.Name, "unknown tag option "+strconv.Quote(opt))
+		}
+	}
+	if opts.bits > 0 && (opts.varint || opts.prefix != prefixNone || opts.lenField != "") {
+		return opts, layoutError(t, f.Name, "bits cannot be combined with varint or len")
+	}
+	return opts, nil
+}
+
+// A layoutBuilder compiles codecs. It remembers the struct types it
+// has seen, so that types which refer to themselves through a slice
+// share one codec.
+type layoutBuilder struct {
+	structs map[reflect.Type]*structCodec
+}
+
+func (b *layoutBuilder) codec(t reflect.Type, opts fieldOptions) (layoutCodec, error) {
+	if opts.varint {
+		switch t.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			return varintCodec{signed: true}, nil
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+			return varintCodec{}, nil
+		case reflect.Array, reflect.Slice:
+		default:
+			return nil, errors.New("binary: varint used with non-integer type " + t.String())
+		}
+	}
+
+	switch t.Kind() {
+	case reflect.Bool,
+		reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Float32, reflect.Float64, reflect.Complex64, reflect.Complex128:
+		return &numCodec{kind: t.Kind(), n: int(t.Size()), order: opts.order}, nil
+
+	case reflect.Array:
+		elem, err := b.codec(t.Elem(), fieldOptions{order: opts.order, varint: opts.varint})
+		if err != nil {
+			return nil, err
+		}
+		return &arrayCodec{elem: elem, n: t.Len()}, nil
+
+	case reflect.Slice, reflect.String:
+		if opts.prefix == prefixNone && opts.lenField == "" {
+			return nil, errors.New("binary: " + t.String() + " needs a len tag option")
+		}
+		c := &sliceCodec{typ: t, prefix: opts.prefix, order: opts.order}
+		if t.Kind() == reflect.Slice && (t.Elem().Kind() != reflect.Uint8 || opts.varint) {
+			elem, err := b.codec(t.Elem(), fieldOptions{order: opts.order, varint: opts.varint})
+			if err != nil {
+				return nil, err
+			}
+			c.elem = elem
+		}
+		return c, nil
+
+	case reflect.Struct:
+		if opts.order != nil {
+			return nil, errors.New("binary: byte order option used with struct type " + t.String())
+		}
+		return b.structCodec(t)
+	}
+	return nil, errors.New("binary: invalid type " + t.String())
+}
+
+func (b *layoutBuilder) structCodec(t reflect.Type) (*structCodec, error) {
+	if c, ok := b.structs[t]; ok {
+		return c, nil
+	}
+	c := new(structCodec)
+	b.structs[t] = c
+
+	var group *bitGroupCodec
+	endGroup := func() error {
+		if group == nil {
+			return nil
+		}
+		if group.nbits%8 != 0 || group.nbits > 64 {
+			return layoutError(t, group.fields[0].name, "bit fields do not make up whole bytes")
+		}
+		c.fields = append(c.fields, structField{index: -1, codec: group})
+		group = nil
+		return nil
+	}
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		opts, err := parseFieldOptions(t, f)
+		if err != nil {
+			return nil, err
+		}
+		if opts.omit {
+			continue
+		}
+		if opts.bits == 0 || opts.pad > 0 {
+			if err := endGroup(); err != nil {
+				return nil, err
+			}
+		}
+		if opts.pad > 0 {
+			c.fields = append(c.fields, structField{index: -1, codec: padCodec(opts.pad)})
+		}
+		if f.Name == "_" {
+			if opts.bits > 0 {
+				return nil, layoutError(t, f.Name, "blank bit field")
+			}
+			n := sizeof(f.Type)
+			if n < 0 {
+				return nil, layoutError(t, f.Name, "blank field is not fixed-size")
+			}
+			c.fields = append(c.fields, structField{index: -1, codec: padCodec(n)})
+			continue
+		}
+		if !f.IsExported() {
+			return nil, layoutError(t, f.Name, "unexported field")
+		}
+
+		if opts.bits > 0 {
+			switch f.Type.Kind() {
+			case reflect.Bool,
+				reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+				reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+			default:
+				return nil, layoutError(t, f.Name, "bits used with non-integer type")
+			}
+			if group == nil {
+				group = &bitGroupCodec{lsbFirst: opts.order == LittleEndian}
+			}
+			group.fields = append(group.fields, bitField{index: i, name: f.Name, bits: opts.bits, kind: f.Type.Kind()})
+			group.nbits += opts.bits
+			continue
+		}
+
+		sf := structField{index: i, name: f.Name, lenField: -1}
+		if opts.lenField != "" {
+			lf, ok := t.FieldByName(opts.lenField)
+			if !ok || len(lf.Index) != 1 || lf.Index[0] >= i {
+				return nil, layoutError(t, f.Name, "length field "+opts.lenField+" is not an earlier field")
+			}
+			switch lf.Type.Kind() {
+			case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+				reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+			default:
+				return nil, layoutError(t, f.Name, "length field "+opts.lenField+" is not an integer")
+			}
+			if k := f.Type.Kind(); k != reflect.Slice && k != reflect.String {
+				return nil, layoutError(t, f.Name, "len used with type "+f.Type.String())
+			}
+			sf.lenField = lf.Index[0]
+		} else if opts.prefix != prefixNone {
+			if k := f.Type.Kind(); k != reflect.Slice && k != reflect.String {
+				return nil, layoutError(t, f.Name, "len used with type "+f.Type.String())
+			}
+		}
+		sf.codec, err = b.codec(f.Type, opts)
+		if err != nil {
+			return nil, err
+		}
+		c.fields = append(c.fields, sf)
+	}
+	if err := endGroup(); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// A numCodec handles fixed-size numbers and booleans.
+type numCodec struct {
+	kind  reflect.Kind
+	n     int
+	order ByteOrder // nil for the order passed by the caller
+}
+
+func (c *numCodec) size(reflect.Value) int { return c.n }
+func (c *numCodec) minSize() int           { return c.n }
+
+func putUint(order ByteOrder, b []byte, x uint64) {
+	switch len(b) {
+	case 1:
+		b[0] = byte(x)
+	case 2:
+		order.PutUint16(b, uint16(x))
+	case 4:
+		order.PutUint32(b, uint32(x))
+	case 8:
+		order.PutUint64(b, x)
+	}
+}
+
+func getUint(order ByteOrder, b []byte) uint64 {
+	switch len(b) {
+	case 1:
+		return uint64(b[0])
+	case 2:
+		return uint64(order.Uint16(b))
+	case 4:
+		return uint64(order.Uint32(b))
+	}
+	return order.Uint64(b)
+}
+
+func (c *numCodec) encode(e *layoutEncoder, v reflect.Value) error {
+	order := c.order
+	if order == nil {
+		order = e.order
+	}
+	b := e.next(c.n)
+	switch c.kind {
+	case reflect.Bool:
+		b[0] = 0
+		if v.Bool() {
+			b[0] = 1
+		}
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		putUint(order, b, uint64(v.Int()))
+	case reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		putUint(order, b, v.Uint())
+	case reflect.Float32:
+		order.PutUint32(b, math.Float32bits(float32(v.Float())))
+	case reflect.Float64:
+		order.PutUint64(b, math.Float64bits(v.Float()))
+	case reflect.Complex64:
+		x := v.Complex()
+		order.PutUint32(b, math.Float32bits(float32(real(x))))
+		order.PutUint32(b[4:], math.Float32bits(float32(imag(x))))
+	case reflect.Complex128:
+		x := v.Complex()
+		order.PutUint64(b, math.Float64bits(real(x)))
+		order.PutUint64(b[8:], math.Float64bits(imag(x)))
+	}
+	return nil
+}
+
+func (c *numCodec) decode(d *layoutDecoder, v reflect.Value) error {
+	order := c.order
+	if order == nil {
+		order = d.order
+	}
+	b, err := d.next(c.n)
+	if err != nil {
+		return err
+	}
+	switch c.kind {
+	case reflect.Bool:
+		v.SetBool(b[0] != 0)
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		v.SetInt(signExtend(getUint(order, b), 8*c.n))
+	case reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		v.SetUint(getUint(order, b))
+	case reflect.Float32:
+		v.SetFloat(float64(math.Float32frombits(order.Uint32(b))))
+	case reflect.Float64:
+		v.SetFloat(math.Float64frombits(order.Uint64(b)))
+	case reflect.Complex64:
+		v.SetComplex(complex(
+			float64(math.Float32frombits(order.Uint32(b))),
+			float64(math.Float32frombits(order.Uint32(b[4:]))),
+		))
+	case reflect.Complex128:
+		v.SetComplex(complex(
+			math.Float64frombits(order.Uint64(b)),
+			math.Float64frombits(order.Uint64(b[8:])),
+		))
+	}
+	return nil
+}
+
+// signExtend interprets the low n bits of x as a signed integer.
+func signExtend(x uint64, n int) int64 {
+	return int64(x<<(64-n)) >> (64 - n)
+}
+
+// A varintCodec handles integers encoded as varints.
+type varintCodec struct {
+	signed bool
+}
+
+func uvarintLen(x uint64) int {
+	n := 1
+	for x >= 0x80 {
+		x >>= 7
+		n++
+	}
+	return n
+}
+
+func (c varintCodec) size(v reflect.Value) int {
+	if !c.signed {
+		return uvarintLen(v.Uint())
+	}
+	x := v.Int()
+	ux := uint64(x) << 1
+	if x < 0 {
+		ux = ^ux
+	}
+	return uvarintLen(ux)
+}
+
+func (c varintCodec) minSize() int { return 1 }
+
+func (c varintCodec) encode(e *layoutEncoder, v reflect.Value) error {
+	b := e.buf[e.off:]
+	if c.signed {
+		e.off += PutVarint(b, v.Int())
+	} else {
+		e.off += PutUvarint(b, v.Uint())
+	}
+	return nil
+}
+
+var errVarintRange = errors.New("binary: varint value out of range for field type")
+
+func (c varintCodec) decode(d *layoutDecoder, v reflect.Value) error {
+	if c.signed {
+		x, err := ReadVarint(d)
+		if err != nil {
+			return err
+		}
+		if v.OverflowInt(x) {
+			return errVarintRange
+		}
+		v.SetInt(x)
+		return nil
+	}
+	x, err := ReadUvarint(d)
+	if err != nil {
+		return err
+	}
+	if v.OverflowUint(x) {
+		return errVarintRange
+	}
+	v.SetUint(x)
+	return nil
+}
+
+// An arrayCodec handles arrays and, with n < 0, the slices
+// passed directly to Read and Write.
+type arrayCodec struct {
+	elem layoutCodec
+	n    int
+}
+
+func (c *arrayCodec) size(v reflect.Value) int {
+	n := 0
+	for i := 0; i < v.Len(); i++ {
+		n += c.elem.size(v.Index(i))
+	}
+	return n
+}
+
+func (c *arrayCodec) minSize() int { return max(c.n, 0) * c.elem.minSize() }
+
+func (c *arrayCodec) encode(e *layoutEncoder, v reflect.Value) error {
+	for i := 0; i < v.Len(); i++ {
+		if err := c.elem.encode(e, v.Index(i)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *arrayCodec) decode(d *layoutDecoder, v reflect.Value) error {
+	for i := 0; i < v.Len(); i++ {
+		if err := c.elem.decode(d, v.Index(i)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// A sliceCodec handles length-prefixed slices and strings, and those
+// whose length is held in another field. The length counts elements,
+// or bytes for strings and byte slices.
+type sliceCodec struct {
+	typ    reflect.Type
+	elem   layoutCodec // nil for strings and byte slices
+	prefix prefixKind
+	order  ByteOrder // of the prefix; nil for the order passed by the caller
+}
+
+func (c *sliceCodec) prefixSize(n int) int {
+	switch c.prefix {
+	case prefixU8:
+		return 1
+	case prefixU16:
+		return 2
+	case prefixU32:
+		return 4
+	case prefixU64:
+		return 8
+	case prefixUvarint:
+		return uvarintLen(uint64(n))
+	}
+	return 0
+}
+
+func (c *sliceCodec) size(v reflect.Value) int {
+	n := c.prefixSize(v.Len())
+	if c.elem == nil {
+		return n + v.Len()
+	}
+	for i := 0; i < v.Len(); i++ {
+		n += c.elem.size(v.Index(i))
+	}
+	return n
+}
+
+func (c *sliceCodec) minSize() int { return c.prefixSize(0) }
+
+func (c *sliceCodec) encode(e *layoutEncoder, v reflect.Value) error {
+	n := v.Len()
+	switch c.prefix {
+	case prefixUvarint:
+		e.off += PutUvarint(e.buf[e.off:], uint64(n))
+	case prefixU8, prefixU16, prefixU32, prefixU64:
+		b := e.next(c.prefixSize(n))
+		if len(b) < 8 && uint64(n)>>(8*len(b)) != 0 {
+			return errors.New("binary: length " + strconv.Itoa(n) + " of " + c.typ.String() + " overflows its length prefix")
+		}
+		order := c.order
+		if order == nil {
+			order = e.order
+		}
+		putUint(order, b, uint64(n))
+	}
+	return c.encodeElems(e, v)
+}
+
+func (c *sliceCodec) encodeElems(e *layoutEncoder, v reflect.Value) error {
+	if c.elem == nil {
+		if v.Kind() == reflect.String {
+			copy(e.next(v.Len()), v.String())
+		} else {
+			copy(e.next(v.Len()), v.Bytes())
+		}
+		return nil
+	}
+	for i := 0; i < v.Len(); i++ {
+		if err := c.elem.encode(e, v.Index(i)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *sliceCodec) decode(d *layoutDecoder, v reflect.Value) error {
+	var n uint64
+	switch c.prefix {
+	case prefixUvarint:
+		x, err := ReadUvarint(d)
+		if err != nil {
+			return err
+		}
+		n = x
+	case prefixU8, prefixU16, prefixU32, prefixU64:
+		b, err := d.next(c.prefixSize(0))
+		if err != nil {
+			return err
+		}
+		order := c.order
+		if order == nil {
+			order = d.order
+		}
+		n = getUint(order, b)
+	}
+	return c.decodeElems(d, v, n)
+}
+
+// maxPrealloc is the largest number of elements allocated up front
+// when reading a slice from an io.Reader.
+const maxPrealloc = 1024
+
+func (c *sliceCodec) decodeElems(d *layoutDecoder, v reflect.Value, n uint64) error {
+	if n > math.MaxInt {
+		return errors.New("binary: length of " + c.typ.String() + " too large")
+	}
+	if rem := d.remaining(); rem >= 0 {
+		m := 1
+		if c.elem != nil {
+			m = c.elem.minSize()
+		}
+		if m > 0 && n > uint64(rem/m) {
+			return errBufferTooSmall
+		}
+	}
+	if c.elem == nil {
+		b, err := d.bytes(int(n))
+		if err != nil {
+			return err
+		}
+		if v.Kind() == reflect.String {
+			v.SetString(string(b))
+		} else {
+			v.SetBytes(b)
+		}
+		return nil
+	}
+	if d.r == nil || n <= maxPrealloc {
+		v.Set(reflect.MakeSlice(c.typ, int(n), int(n)))
+		for i := 0; i < int(n); i++ {
+			if err := c.elem.decode(d, v.Index(i)); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	v.Set(reflect.MakeSlice(c.typ, 0, maxPrealloc))
+	zero := reflect.Zero(c.typ.Elem())
+	for i := 0; i < int(n); i++ {
+		v.Set(reflect.Append(v, zero))
+		if err := c.elem.decode(d, v.Index(i)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// A padCodec writes zero bytes and skips them when reading.
+type padCodec int
+
+func (c padCodec) size(reflect.Value) int { return int(c) }
+func (c padCodec) minSize() int           { return int(c) }
+
+func (c padCodec) encode(e *layoutEncoder, v reflect.Value) error {
+	clear(e.next(int(c)))
+	return nil
+}
+
+func (c padCodec) decode(d *layoutDecoder, v reflect.Value) error {
+	if d.r != nil {
+		n, err := io.CopyN(io.Discard, d.r, int64(c))
+		d.n += n
+		if err == io.EOF && d.n > 0 {
+			err = io.ErrUnexpectedEOF
+		}
+		return err
+	}
+	_, err := d.next(int(c))
+	return err
+}
+
+// A bitField is one field of a bitGroupCodec.
+type bitField struct {
+	index int
+	name  string
+	bits  int
+	kind  reflect.Kind
+}
+
+// A bitGroupCodec packs a run of consecutive bit fields into whole
+// bytes. It operates on the enclosing struct.
+type bitGroupCodec struct {
+	fields   []bitField
+	nbits    int
+	lsbFirst bool
+}
+
+func (c *bitGroupCodec) size(reflect.Value) int { return c.nbits / 8 }
+func (c *bitGroupCodec) minSize() int           { return c.nbits / 8 }
+
+func (c *bitGroupCodec) encode(e *layoutEncoder, v reflect.Value) error {
+	var acc uint64
+	shift := 0
+	for _, f := range c.fields {
+		fv := v.Field(f.index)
+		var x uint64
+		switch f.kind {
+		case reflect.Bool:
+			if fv.Bool() {
+				x = 1
+			}
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			i := fv.Int()
+			if signExtend(uint64(i), f.bits) != i {
+				return errors.New("binary: value of field " + f.name + " overflows " + strconv.Itoa(f.bits) + " bits")
+			}
+			x = uint64(i)
+		default:
+			x = fv.Uint()
+			if f.bits < 64 && x>>f.bits != 0 {
+				return errors.New("binary: value of field " + f.name + " overflows " + strconv.Itoa(f.bits) + " bits")
+			}
+		}
+		if f.bits < 64 {
+			x &= 1<<f.bits - 1
+		}
+		if c.lsbFirst {
+			acc |= x << shift
+			shift += f.bits
+		} else if f.bits < 64 {
+			acc = acc<<f.bits | x
+		} else {
+			acc = x
+		}
+	}
+	b := e.next(c.nbits / 8)
+	for i := range b {
+		if c.lsbFirst {
+			b[i] = byte(acc >> (8 * i))
+		} else {
+			b[i] = byte(acc >> (8 * (len(b) - 1 - i)))
+		}
+	}
+	return nil
+}
+
+func (c *bitGroupCodec) decode(d *layoutDecoder, v reflect.Value) error {
+	b, err := d.next(c.nbits / 8)
+	if err != nil {
+		return err
+	}
+	var acc uint64
+	for i := range b {
+		if c.lsbFirst {
+			acc |= uint64(b[i]) << (8 * i)
+		} else {
+			acc = acc<<8 | uint64(b[i])
+		}
+	}
+	shift := 0
+	for _, f := range c.fields {
+		var x uint64
+		if c.lsbFirst {
+			x = acc >> shift
+		} else {
+			x = acc >> (c.nbits - shift - f.bits)
+		}
+		shift += f.bits
+		if f.bits < 64 {
+			x &= 1<<f.bits - 1
+		}
+		fv := v.Field(f.index)
+		switch f.kind {
+		case reflect.Bool:
+			fv.SetBool(x != 0)
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			i := signExtend(x, f.bits)
+			if fv.OverflowInt(i) {
+				return errors.New("binary: value of field " + f.name + " overflows " + fv.Type().String())
+			}
+			fv.SetInt(i)
+		default:
+			if fv.OverflowUint(x) {
+				return errors.New("binary: value of field " + f.name + " overflows " + fv.Type().String())
+			}
+			fv.SetUint(x)
+		}
+	}
+	return nil
+}
+
+// A structField is one element of a structCodec. Fields with a
+// negative index, such as padding and bit groups, receive the
+// struct itself.
+type structField struct {
+	index    int
+	name     string
+	codec    layoutCodec
+	lenField int // index of the field holding the length, or -1
+}
+
+type structCodec struct {
+	fields []structField
+}
+
+func (c *structCodec) size(v reflect.Value) int {
+	n := 0
+	for _, f := range c.fields {
+		switch {
+		case f.index < 0:
+			n += f.codec.size(v)
+		case f.lenField >= 0:
+			sc := f.codec.(*sliceCodec)
+			fv := v.Field(f.index)
+			n += sc.size(fv) - sc.prefixSize(fv.Len())
+		default:
+			n += f.codec.size(v.Field(f.index))
+		}
+	}
+	return n
+}
+
+func (c *structCodec) minSize() int {
+	n := 0
+	for _, f := range c.fields {
+		if f.lenField < 0 {
+			n += f.codec.minSize()
+		}
+	}
+	return n
+}
+
+// intField returns the value of the integer field v.
+func intField(v reflect.Value) uint64 {
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if i := v.Int(); i < 0 {
+			return math.MaxUint64
+		} else {
+			return uint64(i)
+		}
+	}
+	return v.Uint()
+}
+
+func (c *structCodec) encode(e *layoutEncoder, v reflect.Value) error {
+	for _, f := range c.fields {
+		if f.index < 0 {
+			if err := f.codec.encode(e, v); err != nil {
+				return err
+			}
+			continue
+		}
+		fv := v.Field(f.index)
+		if f.lenField >= 0 {
+			if intField(v.Field(f.lenField)) != uint64(fv.Len()) {
+				return errors.New("binary: length of field " + f.name + " does not match field " + v.Type().Field(f.lenField).Name)
+			}
+			if err := f.codec.(*sliceCodec).encodeElems(e, fv); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := f.codec.encode(e, fv); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *structCodec) decode(d *layoutDecoder, v reflect.Value) error {
+	for _, f := range c.fields {
+		if f.index < 0 {
+			if err := f.codec.decode(d, v); err != nil {
+				return err
+			}
+			continue
+		}
+		fv := v.Field(f.index)
+		var err error
+		if f.lenField >= 0 {
+			err = f.codec.(*sliceCodec).decodeElems(d, fv, intField(v.Field(f.lenField)))
+		} else {
+			err = f.codec.decode(d, fv)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,328 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package binary
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+type ipv4Header struct {
+	Version  uint8 `binary:"bits:4"`
+	IHL      uint8 `binary:"bits:4"`
+	TOS      uint8
+	Length   uint16
+	ID       uint16
+	Flags    uint8  `binary:"bits:3"`
+	Fragment uint16 `binary:"bits:13"`
+	TTL      uint8
+	Protocol uint8
+	Checksum uint16
+	Src, Dst [4]byte
+}
+
+type record struct {
+	Kind    uint8
+	Seq     int64  `binary:"varint"`
+	Port    uint16 `binary:"le"`
+	Name    string `binary:"len:u8"`
+	Data    []byte `binary:"len:uvarint"`
+	N       uint16
+	Values  []int32 `binary:"len:N"`
+	Cache   []byte  `binary:"-"`
+	Trailer uint8   `binary:"pad:2"`
+}
+
+type node struct {
+	Value    uint32 `binary:"varint"`
+	Children []node `binary:"len:u8"`
+}
+
+type cFlags struct {
+	A bool  `binary:"le,bits:1"`
+	B int8  `binary:"bits:3"`
+	C uint8 `binary:"bits:4"`
+}
+
+var layoutTests = []struct {
+	name  string
+	order ByteOrder
+	val   any
+	enc   []byte
+}{
+	{
+		name:  "ipv4",
+		order: BigEndian,
+		val: &ipv4Header{
+			Version: 4, IHL: 5, Length: 20, ID: 0x1234,
+			Flags: 2, Fragment: 0x123, TTL: 64, Protocol: 6, Checksum: 0xbeef,
+			Src: [4]byte{10, 0, 0, 1}, Dst: [4]byte{10, 0, 0, 2},
+		},
+		enc: []byte{
+			0x45, 0x00, 0x00, 0x14, 0x12, 0x34, 0x41, 0x23,
+			0x40, 0x06, 0xbe, 0xef, 10, 0, 0, 1, 10, 0, 0, 2,
+		},
+	},
+	{
+		name:  "record",
+		order: BigEndian,
+		val: &record{
+			Kind: 1, Seq: -3, Port: 0x0102, Name: "go",
+			Data: []byte{0xaa, 0xbb}, N: 2, Values: []int32{1, -1}, Trailer: 9,
+		},
+		enc: []byte{
+			0x01, 0x05, 0x02, 0x01, 0x02, 'g', 'o', 0x02, 0xaa, 0xbb,
+			0x00, 0x02, 0x00, 0x00, 0x00, 0x01, 0xff, 0xff, 0xff, 0xff,
+			0x00, 0x00, 0x09,
+		},
+	},
+	{
+		name:  "recursive",
+		order: LittleEndian,
+		val:   &node{Value: 300, Children: []node{{Value: 1, Children: []node{}}, {Value: 2, Children: []node{}}}},
+		enc:   []byte{0xac, 0x02, 0x02, 0x01, 0x00, 0x02, 0x00},
+	},
+	{
+		name:  "lsb-first bits",
+		order: BigEndian,
+		val:   &cFlags{A: true, B: -2, C: 0xa},
+		enc:   []byte{0xad},
+	},
+	{
+		name:  "slice",
+		order: BigEndian,
+		val:   []cFlags{{C: 1}, {A: true}},
+		enc:   []byte{0x10, 0x01},
+	},
+}
+
+func TestLayout(t *testing.T) {
+	for _, tt := range layoutTests {
+		t.Run(tt.name, func(t *testing.T) {
+			if n := Size(tt.val); n != len(tt.enc) {
+				t.Errorf("Size = %d, want %d", n, len(tt.enc))
+			}
+
+			got, err := Append([]byte{0xff}, tt.order, tt.val)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(got[1:], tt.enc) || got[0] != 0xff {
+				t.Errorf("Append = % x, want ff % x", got, tt.enc)
+			}
+
+			buf := make([]byte, len(tt.enc)+1)
+			n, err := Encode(buf, tt.order, tt.val)
+			if err != nil || n != len(tt.enc) || !bytes.Equal(buf[:n], tt.enc) {
+				t.Errorf("Encode = %d, %v, % x", n, err, buf[:n])
+			}
+			if _, err := Encode(buf[:len(tt.enc)-1], tt.order, tt.val); err != errBufferTooSmall {
+				t.Errorf("Encode into short buffer: err = %v, want %v", err, errBufferTooSmall)
+			}
+
+			var w bytes.Buffer
+			if err := Write(&w, tt.order, tt.val); err != nil || !bytes.Equal(w.Bytes(), tt.enc) {
+				t.Errorf("Write = % x, %v", w.Bytes(), err)
+			}
+
+			v := reflect.ValueOf(tt.val)
+			var dst any
+			if v.Kind() == reflect.Slice {
+				dst = reflect.MakeSlice(v.Type(), v.Len(), v.Len()).Interface()
+			} else {
+				dst = reflect.New(v.Type().Elem()).Interface()
+			}
+			n, err = Decode(append(tt.enc, 0xee), tt.order, dst)
+			if err != nil || n != len(tt.enc) {
+				t.Fatalf("Decode = %d, %v", n, err)
+			}
+			if !reflect.DeepEqual(dst, tt.val) {
+				t.Errorf("Decode:\nhave %+v\nwant %+v", dst, tt.val)
+			}
+			if _, err := Decode(tt.enc[:len(tt.enc)-1], tt.order, dst); err != errBufferTooSmall {
+				t.Errorf("Decode of short buffer: err = %v, want %v", err, errBufferTooSmall)
+			}
+
+			if v.Kind() == reflect.Slice {
+				dst = reflect.MakeSlice(v.Type(), v.Len(), v.Len()).Interface()
+			} else {
+				dst = reflect.New(v.Type().Elem()).Interface()
+			}
+			r := bytes.NewReader(tt.enc)
+			if err := Read(r, tt.order, dst); err != nil {
+				t.Fatalf("Read: %v", err)
+			}
+			if !reflect.DeepEqual(dst, tt.val) || r.Len() != 0 {
+				t.Errorf("Read:\nhave %+v\nwant %+v", dst, tt.val)
+			}
+			if len(tt.enc) > 1 {
+				if err := Read(bytes.NewReader(tt.enc[:len(tt.enc)-1]), tt.order, dst); err != io.ErrUnexpectedEOF {
+					t.Errorf("Read of truncated input: err = %v, want %v", err, io.ErrUnexpectedEOF)
+				}
+			}
+			if err := Read(bytes.NewReader(nil), tt.order, dst); err != io.EOF {
+				t.Errorf("Read of empty input: err = %v, want %v", err, io.EOF)
+			}
+		})
+	}
+}
+
+func TestLayoutInvalidTypes(t *testing.T) {
+	tests := []struct {
+		val  any
+		want string
+	}{
+		{&struct {
+			S []byte `binary:"le"`
+		}{}, "needs a len tag option"},
+		{&struct {
+			X uint8 `binary:"bits:3"`
+		}{}, "bit fields do not make up whole bytes"},
+		{&struct {
+			X float32 `binary:"varint"`
+		}{}, "varint used with non-integer type"},
+		{&struct {
+			X uint8 `binary:"size:3"`
+		}{}, `unknown tag option "size:3"`},
+		{&struct {
+			S []byte `binary:"len:N"`
+			N uint8
+		}{}, "not an earlier field"},
+		{&struct {
+			X int `binary:"be"`
+		}{}, "invalid type int"},
+		{&struct {
+			x uint8 `binary:"le"`
+		}{}, "unexported field"},
+	}
+	for _, tt := range tests {
+		_, err := Append(nil, BigEndian, tt.val)
+		if err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("Append(%T): err = %v, want %q", tt.val, err, tt.want)
+		}
+		if err2 := Read(bytes.NewReader(make([]byte, 16)), BigEndian, tt.val); err2 == nil || err2.Error() != err.Error() {
+			t.Errorf("Read(%T): err = %v, want %v", tt.val, err2, err)
+		}
+		if n := Size(tt.val); n != -1 {
+			t.Errorf("Size(%T) = %d, want -1", tt.val, n)
+		}
+	}
+}
+
+func TestLayoutEncodeErrors(t *testing.T) {
+	tests := []struct {
+		val  any
+		want string
+	}{
+		{&record{N: 3, Values: []int32{1}}, "length of field Values does not match field N"},
+		{&record{Name: strings.Repeat("x", 256)}, "overflows its length prefix"},
+		{&ipv4Header{Version: 16}, "value of field Version overflows 4 bits"},
+		{&cFlags{B: 4}, "value of field B overflows 3 bits"},
+	}
+	for _, tt := range tests {
+		_, err := Append(nil, BigEndian, tt.val)
+		if err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("Append(%+v): err = %v, want %q", tt.val, err, tt.want)
+		}
+	}
+}
+
+func TestLayoutDecodeErrors(t *testing.T) {
+	var s struct {
+		X int8 `binary:"varint"`
+	}
+	if _, err := Decode([]byte{0x80, 0x02}, BigEndian, &s); err != errVarintRange {
+		t.Errorf("Decode of out of range varint: err = %v, want %v", err, errVarintRange)
+	}
+
+	// A corrupt length must not cause a large allocation.
+	var r struct {
+		S []uint32 `binary:"len:u32"`
+		B []byte   `binary:"len:u32"`
+	}
+	in := []byte{0xff, 0xff, 0xff, 0xff, 0}
+	if _, err := Decode(in, BigEndian, &r); err != errBufferTooSmall {
+		t.Errorf("Decode of corrupt length: err = %v, want %v", err, errBufferTooSmall)
+	}
+	if err := Read(bytes.NewReader(in), BigEndian, &r); err != io.ErrUnexpectedEOF {
+		t.Errorf("Read of corrupt length: err = %v, want %v", err, io.ErrUnexpectedEOF)
+	}
+	allocs := testing.AllocsPerRun(10, func() {
+		Read(bytes.NewReader([]byte{0, 0, 0, 0, 0xff, 0xff, 0xff, 0xff}), BigEndian, &r)
+	})
+	if allocs > 10 {
+		t.Errorf("Read of corrupt length: %v allocations", allocs)
+	}
+}
+
+func TestLayoutCache(t *testing.T) {
+	typ := reflect.TypeFor[record]()
+	c1, err := layoutOf(typ)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c2, _ := layoutOf(typ)
+	if c1 != c2 {
+		t.Errorf("layoutOf did not cache the codec")
+	}
+	if c, err := layoutOf(reflect.TypeFor[Struct]()); c != nil || err != nil {
+		t.Errorf("layoutOf(untagged) = %v, %v, want nil, nil", c, err)
+	}
+}
+
+func TestLayoutAllocs(t *testing.T) {
+	v := &ipv4Header{Version: 4, IHL: 5}
+	buf := make([]byte, 0, 32)
+	allocs := testing.AllocsPerRun(100, func() {
+		if _, err := Append(buf[:0], BigEndian, v); err != nil {
+			t.Fatal(err)
+		}
+	})
+	if allocs > 1 {
+		t.Errorf("Append of tagged struct: %v allocations, want at most 1", allocs)
+	}
+}
+
+func TestLayoutVarintRead(t *testing.T) {
+	var s struct {
+		A uint64 `binary:"varint"`
+		B []int  `binary:"varint,len:uvarint"`
+	}
+	in := []byte{0xff, 0xff, 0x03, 0x02, 0x01, 0x04}
+	if err := Read(bytes.NewReader(in), LittleEndian, &s); err != nil {
+		t.Fatal(err)
+	}
+	if s.A != 0xffff || !reflect.DeepEqual(s.B, []int{-1, 2}) {
+		t.Errorf("Read = %+v", s)
+	}
+	if err := Read(bytes.NewReader(in[:1]), LittleEndian, &s); err != io.ErrUnexpectedEOF {
+		t.Errorf("Read of truncated varint: err = %v, want %v", err, io.ErrUnexpectedEOF)
+	}
+	if err := Read(bytes.NewReader(in[:4]), LittleEndian, &s); !errors.Is(err, io.ErrUnexpectedEOF) {
+		t.Errorf("Read of truncated slice: err = %v, want %v", err, io.ErrUnexpectedEOF)
+	}
+}
+
+func BenchmarkDecodeLayout(b *testing.B) {
+	enc := layoutTests[0].enc
+	var h ipv4Header
+	b.SetBytes(int64(len(enc)))
+	for i := 0; i < b.N; i++ {
+		Decode(enc, BigEndian, &h)
+	}
+}
+
+func BenchmarkAppendLayout(b *testing.B) {
+	h := layoutTests[0].val
+	buf := make([]byte, 0, 32)
+	b.SetBytes(20)
+	for i := 0; i < b.N; i++ {
+		buf, _ = Append(buf[:0], BigEndian, h)
+	}
+}
@@ -0,0 +1,922 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cbor
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+	"math/big"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Unmarshal parses the CBOR-encoded data and stores the result
+// in the value pointed to by v. If v is nil or not a pointer,
+// Unmarshal returns an [InvalidUnmarshalError]. The data must consist of
+// exactly one well-formed data item.
+//
+// Unmarshal uses the inverse of the encodings that
+// [Marshal] uses, allocating maps, slices, and pointers as necessary,
+// with the following additional rules:
+//
+// To unmarshal CBOR into a pointer, Unmarshal first handles the case of
+// the CBOR being the simple value null or undefined. In that case,
+// Unmarshal sets the pointer to nil. Otherwise, Unmarshal unmarshals the
+// CBOR into the value pointed at by the pointer. If the pointer is nil,
+// Unmarshal allocates a new value for it to point to.
+//
+// To unmarshal CBOR into a value implementing [Unmarshaler],
+// Unmarshal calls that value's UnmarshalCBOR method, including
+// when the input is null. Otherwise, if the value implements
+// [encoding.BinaryUnmarshaler] and the input is a byte string,
+// Unmarshal calls that value's UnmarshalBinary method with the
+// contents of the byte string.
+//
+// To unmarshal CBOR into a struct, Unmarshal matches incoming map keys to
+// the keys used by [Marshal], either the struct field name, its tag, or
+// the integer given by a "keyasint" tag. Unlike package encoding/json,
+// matching is exact. By default, map keys which don't have a
+// corresponding struct field are ignored (see [Decoder.DisallowUnknownFields]
+// for an alternative). A struct with the "toarray" option is unmarshaled
+// from an array instead.
+//
+// To unmarshal CBOR into an interface value,
+// Unmarshal stores one of these in the interface value:
+//
+//   - bool, for booleans
+//   - uint64, for unsigned integers
+//   - int64, for negative integers, or *[big.Int] if they do not fit
+//   - float64, for floating-point numbers
+//   - []byte, for byte strings
+//   - string, for text strings
+//   - []any, for arrays
+//   - map[any]any, for maps
+//   - [time.Time], for tag numbers 0 and 1
+//   - *[big.Int], for tag numbers 2 and 3
+//   - [Tag], for other tags
+//   - [Simple], for other simple values
+//   - nil for null and undefined
+//
+// Map keys that cannot be stored in a map[any]any, such as byte strings
+// and arrays, cause Unmarshal to return an [UnmarshalTypeError].
+//
+// When unmarshaling into a Go value other than an interface, [Tag],
+// [time.Time] or [big.Int], tags are ignored and their content is
+// unmarshaled as if it were untagged. Integers and floating-point numbers
+// may both be unmarshaled into floating-point Go values; unmarshaling a
+// number that does not fit in the Go type is an error.
+//
+// To unmarshal a CBOR array into a slice, Unmarshal resets the slice length
+// to zero and then appends each element to the slice.
+// To unmarshal a CBOR array into a Go array, Unmarshal decodes
+// CBOR array elements into corresponding Go array elements.
+// If the Go array is smaller than the CBOR array,
+// the additional CBOR array elements are discarded.
+// If the CBOR array is smaller than the Go array,
+// the additional Go array elements are set to zero values.
+// Byte strings are unmarshaled into byte slices and byte arrays in the
+// same way.
+//
+// To unmarshal a CBOR map into a Go map, Unmarshal first establishes a map
+// to use. If the map is nil, Unmarshal allocates a new map. Otherwise
+// Unmarshal reuses the existing map, keeping existing entries. Unmarshal
+// then stores key-value pairs from the CBOR map into the Go map.
+//
+// If a CBOR value is not appropriate for a given target type,
+// or if a CBOR number overflows the target type, Unmarshal
+// skips that field and completes the unmarshaling as best it can.
+// If no more serious errors are encountered, Unmarshal returns
+// an [UnmarshalTypeError] describing the earliest such error.
+//
+// The CBOR null and undefined values unmarshal into an interface, map,
+// pointer, or slice by setting that Go value to nil. Because null is often
+// used to mean “not present,” unmarshaling them into any other Go type
+// except [Simple] has no effect on the value and produces no error.
+func Unmarshal(data []byte, v any) error {
+	d := decodeState{opts: decOpts{maxDepth: defaultMaxDepth}}
+	n, err := checkValid(data, &d.opts)
+	if err == io.ErrUnexpectedEOF {
+		return &SyntaxError{"cbor: unexpected end of input", int64(len(data))}
+	}
+	if err != nil {
+		return err
+	}
+	if n != len(data) {
+		return &SyntaxError{"cbor: extra data after data item", int64(n)}
+	}
+	d.data = data
+	return d.unmarshal(v)
+}
+
+// Unmarshaler is the interface implemented by types
+// that can unmarshal a CBOR description of themselves.
+// The input can be assumed to be a single well-formed data item.
+// UnmarshalCBOR must copy the CBOR data if it wishes
+// to retain the data after returning.
+type Unmarshaler interface {
+	UnmarshalCBOR([]byte) error
+}
+
+// An UnmarshalTypeError describes a CBOR value that was
+// not appropriate for a value of a specific Go type.
+type UnmarshalTypeError struct {
+	Value  string       // description of CBOR value - "boolean", "array", "negative integer"
+	Type   reflect.Type // type of Go value it could not be assigned to
+	Offset int64        // error occurred after reading Offset bytes
+	Struct string       // name of the struct type containing the field
+	Field  string       // the full path from root node to the field, include embedded struct
+}
+
+func (e *UnmarshalTypeError) Error() string {
+	if e.Struct != "" || e.Field != "" {
+		return "cbor: cannot unmarshal " + e.Value + " into Go struct field " + e.Struct + "." + e.Field + " of type " + e.Type.String()
+	}
+	return "cbor: cannot unmarshal " + e.Value + " into Go value of type " + e.Type.String()
+}
+
+// An InvalidUnmarshalError describes an invalid argument passed to [Unmarshal].
+// (The argument to [Unmarshal] must be a non-nil pointer.)
+type InvalidUnmarshalError struct {
+	Type reflect.Type
+}
+
+func (e *InvalidUnmarshalError) Error() string {
+	if e.Type == nil {
+		return "cbor: Unmarshal(nil)"
+	}
+	if e.Type.Kind() != reflect.Pointer {
+		return "cbor: Unmarshal(non-pointer " + e.Type.String() + ")"
+	}
+	return "cbor: Unmarshal(nil " + e.Type.String() + ")"
+}
+
+// decodeState represents the state while decoding a CBOR data item that
+// has already been checked to be well-formed.
+type decodeState struct {
+	data         []byte
+	off          int // next read offset in data
+	opts         decOpts
+	errorContext *errorContext
+	savedError   error
+}
+
+// An errorContext provides context for type errors during decoding.
+type errorContext struct {
+	Struct     reflect.Type
+	FieldStack []string
+}
+
+func (d *decodeState) unmarshal(v any) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() {
+		return &InvalidUnmarshalError{reflect.TypeOf(v)}
+	}
+	// We decode rv not rv.Elem because the Unmarshaler interface
+	// test must be applied at the top level of the value.
+	if err := d.value(rv); err != nil {
+		return d.addErrorContext(err)
+	}
+	return d.savedError
+}
+
+// saveError saves the first err it is called with,
+// for reporting at the end of the unmarshal.
+func (d *decodeState) saveError(err error) {
+	if d.savedError == nil {
+		d.savedError = d.addErrorContext(err)
+	}
+}
+
+// addErrorContext returns a new error enhanced with information from d.errorContext.
+func (d *decodeState) addErrorContext(err error) error {
+	if d.errorContext != nil && (d.errorContext.Struct != nil || len(d.errorContext.FieldStack) > 0) {
+		switch err := err.(type) {
+		case *UnmarshalTypeError:
+			err.Struct = d.errorContext.Struct.Name()
+			fieldStack := d.errorContext.FieldStack
+			if err.Field != "" {
+				fieldStack = append(fieldStack, err.Field)
+			}
+			err.Field = strings.Join(fieldStack, ".")
+		}
+	}
+	return err
+}
+
+// typeError skips the data item starting at start and records that it
+// could not be stored in a value of type t.
+func (d *decodeState) typeError(start int, t reflect.Type) {
+	d.off = start
+	desc := describe(d.data[start])
+	d.skip()
+	d.saveError(&UnmarshalTypeError{Value: desc, Type: t, Offset: int64(start)})
+}
+
+// describe returns a description of the kind of data item with initial
+// byte b, for use in error messages.
+func describe(b byte) string {
+	switch b >> 5 {
+	case majorUint:
+		return "unsigned integer"
+	case majorNegInt:
+		return "negative integer"
+	case majorBytes:
+		return "byte string"
+	case majorText:
+		return "text string"
+	case majorArray:
+		return "array"
+	case majorMap:
+		return "map"
+	case majorTag:
+		return "tag"
+	}
+	switch b & 0x1f {
+	case simpleFalse, simpleTrue:
+		return "boolean"
+	case simpleNull:
+		return "null"
+	case simpleUndefined:
+		return "undefined"
+	case 25, 26, 27:
+		return "floating-point number"
+	}
+	return "simple value"
+}
+
+// readHead reads the initial byte and argument of the data item at d.off.
+// For floating-point numbers, arg holds their bits.
+func (d *decodeState) readHead() (major, ai byte, arg uint64) {
+	b := d.data[d.off]
+	d.off++
+	major, ai = b>>5, b&0x1f
+	switch {
+	case ai < 24:
+		arg = uint64(ai)
+	case ai == 24:
+		arg = uint64(d.data[d.off])
+		d.off++
+	case ai == 25:
+		arg = uint64(binary.BigEndian.Uint16(d.data[d.off:]))
+		d.off += 2
+	case ai == 26:
+		arg = uint64(binary.BigEndian.Uint32(d.data[d.off:]))
+		d.off += 4
+	case ai == 27:
+		arg = binary.BigEndian.Uint64(d.data[d.off:])
+		d.off += 8
+	}
+	return major, ai, arg
+}
+
+// skip skips over the data item at d.off.
+func (d *decodeState) skip() {
+	major, ai, arg := d.readHead()
+	switch major {
+	case majorBytes, majorText, majorArray, majorMap:
+		if ai == 31 {
+			for d.data[d.off] != 0xff {
+				d.skip()
+			}
+			d.off++
+			return
+		}
+		switch major {
+		case majorBytes, majorText:
+			d.off += int(arg)
+		case majorArray:
+			for range arg {
+				d.skip()
+			}
+		case majorMap:
+			for range arg {
+				d.skip()
+				d.skip()
+			}
+		}
+	case majorTag:
+		d.skip()
+	}
+}
+
+// next reports whether another element follows in an array or map whose
+// head had additional information ai, counting down the number of
+// remaining elements in *n. It consumes the break code that ends an
+// indefinite-length array or map.
+func (d *decodeState) next(ai byte, n *uint64) bool {
+	if ai == 31 {
+		if d.data[d.off] == 0xff {
+			d.off++
+			return false
+		}
+		return true
+	}
+	if *n == 0 {
+		return false
+	}
+	*n--
+	return true
+}
+
+// stringContent returns the content of the byte or text string whose head
+// has just been read. The result aliases d.data for definite-length
+// strings.
+func (d *decodeState) stringContent(ai byte, arg uint64) []byte {
+	if ai != 31 {
+		b := d.data[d.off : d.off+int(arg)]
+		d.off += int(arg)
+		return b
+	}
+	b := []byte{}
+	for d.data[d.off] != 0xff {
+		_, cai, carg := d.readHead()
+		b = append(b, d.stringContent(cai, carg)...)
+	}
+	d.off++
+	return b
+}
+
+// float returns the value of the floating-point number whose head had
+// additional information ai and argument arg.
+func float(ai byte, arg uint64) float64 {
+	switch ai {
+	case 25:
+		return float16ToFloat64(uint16(arg))
+	case 26:
+		return float64(math.Float32frombits(uint32(arg)))
+	}
+	return math.Float64frombits(arg)
+}
+
+// indirect walks down v allocating pointers as needed,
+// until it gets to a non-pointer.
+// If it encounters an Unmarshaler, indirect stops and returns that.
+// If decodingNull is true, indirect stops at the first settable pointer so it
+// can be set to nil.
+func indirect(v reflect.Value, decodingNull bool) (Unmarshaler, encoding.BinaryUnmarshaler, reflect.Value) {
+	// See the comment in the function of the same name in encoding/json
+	// for why v0 and haveAddr are needed.
+	v0 := v
+	haveAddr := false
+
+	// If v is a named type and is addressable,
+	// start with its address, so that if the type has pointer methods,
+	// we find them.
+	if v.Kind() != reflect.Pointer && v.Type().Name() != "" && v.CanAddr() {
+		haveAddr = true
+		v = v.Addr()
+	}
+	for {
+		// Load value from interface, but only if the result will be
+		// usefully addressable.
+		if v.Kind() == reflect.Interface && !v.IsNil() {
+			e := v.Elem()
+			if e.Kind() == reflect.Pointer && !e.IsNil() && (!decodingNull || e.Elem().Kind() == reflect.Pointer) {
+				haveAddr = false
+				v = e
+				continue
+			}
+		}
+
+		if v.Kind() != reflect.Pointer {
+			break
+		}
+
+		if decodingNull && v.CanSet() {
+			break
+		}
+
+		// Prevent infinite loop if v is an interface pointing to its own address:
+		//     var v any
+		//     v = &v
+		if v.Elem().Kind() == reflect.Interface && v.Elem().Elem() == v {
+			v = v.Elem()
+			break
+		}
+		if v.IsNil() {
+			v.Set(reflect.New(v.Type().Elem()))
+		}
+		if v.Type().NumMethod() > 0 && v.CanInterface() {
+			if u, ok := v.Interface().(Unmarshaler); ok {
+				return u, nil, reflect.Value{}
+			}
+			// Types with an encoding defined by RFC 8949 do not use
+			// their UnmarshalBinary method, to match Marshal.
+			if !decodingNull && v.Type().Elem() != timeType {
+				if u, ok := v.Interface().(encoding.BinaryUnmarshaler); ok {
+					return nil, u, reflect.Value{}
+				}
+			}
+		}
+
+		if haveAddr {
+			v = v0 // restore original value after round-trip Value.Addr().Elem()
+			haveAddr = false
+		} else {
+			v = v.Elem()
+		}
+	}
+	return nil, nil, v
+}
+
+// value decodes the data item at d.off into v. If v is invalid, the item
+// is skipped. It returns errors from Unmarshaler and BinaryUnmarshaler
+// methods, and saves type errors with saveError.
+func (d *decodeState) value(v reflect.Value) error {
+	start := d.off
+	if !v.IsValid() {
+		d.skip()
+		return nil
+	}
+	b := d.data[start]
+	isNull := b == majorSimple<<5|simpleNull || b == majorSimple<<5|simpleUndefined
+	u, bu, pv := indirect(v, isNull)
+	if u != nil {
+		d.skip()
+		return u.UnmarshalCBOR(d.data[start:d.off])
+	}
+	if bu != nil {
+		major, ai, arg := d.readHead()
+		if major != majorBytes {
+			d.typeError(start, reflect.TypeOf(bu))
+			return nil
+		}
+		return bu.UnmarshalBinary(d.stringContent(ai, arg))
+	}
+	v = pv
+
+	if isNull && v.Type() != simpleType {
+		d.off++
+		switch v.Kind() {
+		case reflect.Interface, reflect.Pointer, reflect.Map, reflect.Slice:
+			v.SetZero()
+		}
+		return nil
+	}
+
+	switch v.Type() {
+	case timeType:
+		t, ok := d.timeValue()
+		if !ok {
+			d.typeError(start, v.Type())
+			return nil
+		}
+		v.Set(reflect.ValueOf(t))
+		return nil
+	case bigIntType:
+		x, ok := d.bigIntValue()
+		if !ok {
+			d.typeError(start, v.Type())
+			return nil
+		}
+		v.Set(reflect.ValueOf(x).Elem())
+		return nil
+	case tagType:
+		major, _, arg := d.readHead()
+		if major != majorTag {
+			d.typeError(start, v.Type())
+			return nil
+		}
+		v.Set(reflect.ValueOf(Tag{arg, d.valueInterface()}))
+		return nil
+	case simpleType:
+		major, ai, arg := d.readHead()
+		if major != majorSimple || ai > 24 {
+			d.typeError(start, v.Type())
+			return nil
+		}
+		v.SetUint(arg)
+		return nil
+	}
+
+	if v.Kind() == reflect.Interface && v.NumMethod() == 0 {
+		if x := d.valueInterface(); x != nil {
+			v.Set(reflect.ValueOf(x))
+		} else {
+			v.SetZero()
+		}
+		return nil
+	}
+
+	major, ai, arg := d.readHead()
+	switch major {
+	case majorTag:
+		return d.value(v)
+
+	case majorUint, majorNegInt:
+		switch v.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			n := int64(arg)
+			if major == majorNegInt {
+				n = ^n
+			}
+			if arg > math.MaxInt64 || v.OverflowInt(n) {
+				break
+			}
+			v.SetInt(n)
+			return nil
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+			if major == majorNegInt || v.OverflowUint(arg) {
+				break
+			}
+			v.SetUint(arg)
+			return nil
+		case reflect.Float32, reflect.Float64:
+			f := float64(arg)
+			if major == majorNegInt {
+				f = -1 - f
+			}
+			v.SetFloat(f)
+			return nil
+		}
+
+	case majorBytes:
+		switch v.Kind() {
+		case reflect.Slice:
+			if v.Type().Elem().Kind() != reflect.Uint8 {
+				break
+			}
+			v.SetBytes(bytes.Clone(d.stringContent(ai, arg)))
+			return nil
+		case reflect.Array:
+			if v.Type().Elem().Kind() != reflect.Uint8 {
+				break
+			}
+			b := d.stringContent(ai, arg)
+			for i := range v.Len() {
+				if i < len(b) {
+					v.Index(i).SetUint(uint64(b[i]))
+				} else {
+					v.Index(i).SetZero()
+				}
+			}
+			return nil
+		}
+
+	case majorText:
+		if v.Kind() == reflect.String {
+			v.SetString(string(d.stringContent(ai, arg)))
+			return nil
+		}
+
+	case majorArray:
+		switch v.Kind() {
+		case reflect.Slice, reflect.Array:
+			return d.array(v, ai, arg)
+		case reflect.Struct:
+			if cachedTypeFields(v.Type()).toArray {
+				return d.structArray(v, ai, arg)
+			}
+		}
+
+	case majorMap:
+		switch v.Kind() {
+		case reflect.Map:
+			return d.mapValue(v, ai, arg)
+		case reflect.Struct:
+			if !cachedTypeFields(v.Type()).toArray {
+				return d.structMap(v, ai, arg)
+			}
+		}
+
+	case majorSimple:
+		switch {
+		case ai == simpleFalse || ai == simpleTrue:
+			if v.Kind() == reflect.Bool {
+				v.SetBool(ai == simpleTrue)
+				return nil
+			}
+		case ai >= 25 && ai <= 27:
+			switch v.Kind() {
+			case reflect.Float32, reflect.Float64:
+				f := float(ai, arg)
+				if v.OverflowFloat(f) {
+					break
+				}
+				v.SetFloat(f)
+				return nil
+			}
+		}
+	}
+	d.typeError(start, v.Type())
+	return nil
+}
+
+// array decodes the elements of the array whose head has just been read
+// into the slice or array v.
+func (d *decodeState) array(v reflect.Value, ai byte, n uint64) error {
+	i := 0
+	for ; d.next(ai, &n); i++ {
+		// Expand slice length, growing the slice if necessary.
+		if v.Kind() == reflect.Slice {
+			if i >= v.Cap() {
+				v.Grow(1)
+			}
+			if i >= v.Len() {
+				v.SetLen(i + 1)
+			}
+		}
+		if i < v.Len() {
+			// Decode into element.
+			if err := d.value(v.Index(i)); err != nil {
+				return err
+			}
+		} else {
+			// Ran out of fixed array: skip.
+			d.skip()
+		}
+	}
+
+	if i < v.Len() {
+		if v.Kind() == reflect.Array {
+			for ; i < v.Len(); i++ {
+				v.Index(i).SetZero() // zero remainder of array
+			}
+		} else {
+			v.SetLen(i) // truncate the slice
+		}
+	}
+	if i == 0 && v.Kind() == reflect.Slice {
+		v.Set(reflect.MakeSlice(v.Type(), 0, 0))
+	}
+	return nil
+}
+
+// mapValue decodes the members of the map whose head has just been read
+// into the Go map v.
+func (d *decodeState) mapValue(v reflect.Value, ai byte, n uint64) error {
+	t := v.Type()
+	if v.IsNil() {
+		v.Set(reflect.MakeMap(t))
+	}
+	for d.next(ai, &n) {
+		start := d.off
+		kv := reflect.New(t.Key()).Elem()
+		if err := d.value(kv); err != nil {
+			return err
+		}
+		if !kv.Comparable() {
+			d.saveError(&UnmarshalTypeError{Value: describe(d.data[start]) + " map key", Type: t, Offset: int64(start)})
+			d.skip()
+			continue
+		}
+		ev := reflect.New(t.Elem()).Elem()
+		if err := d.value(ev); err != nil {
+			return err
+		}
+		v.SetMapIndex(kv, ev)
+	}
+	return nil
+}
+
+// structMap decodes the members of the map whose head has just been read
+// into the struct v.
+func (d *decodeState) structMap(v reflect.Value, ai byte, n uint64) error {
+	t := v.Type()
+	fields := cachedTypeFields(t)
+	var origErrorContext errorContext
+	if d.errorContext != nil {
+		origErrorContext = *d.errorContext
+	}
+	for d.next(ai, &n) {
+		key, name := d.structKey()
+		f := fields.byKey[string(key)]
+		if f == nil {
+			if d.opts.disallowUnknownFields {
+				d.saveError(fmt.Errorf("cbor: unknown field %s", name))
+			}
+			d.skip()
+			continue
+		}
+		subv := d.field(v, f)
+		if d.errorContext == nil {
+			d.errorContext = new(errorContext)
+		}
+		d.errorContext.FieldStack = append(d.errorContext.FieldStack, f.name)
+		d.errorContext.Struct = t
+		if err := d.value(subv); err != nil {
+			return err
+		}
+		if d.errorContext != nil {
+			// Reset errorContext to its original state.
+			// Keep the same underlying array for FieldStack, to reuse the
+			// space and avoid unnecessary allocs.
+			d.errorContext.FieldStack = d.errorContext.FieldStack[:len(origErrorContext.FieldStack)]
+			d.errorContext.Struct = origErrorContext.Struct
+		}
+	}
+	return nil
+}
+
+// structArray decodes the elements of the array whose head has just been
+// read into the fields of the struct v, which has the "toarray" option.
+func (d *decodeState) structArray(v reflect.Value, ai byte, n uint64) error {
+	fields := cachedTypeFields(v.Type())
+	for i := 0; d.next(ai, &n); i++ {
+		if i >= len(fields.list) {
+			if d.opts.disallowUnknownFields {
+				d.saveError(fmt.Errorf("cbor: too many elements for %v", v.Type()))
+			}
+			d.skip()
+			continue
+		}
+		if err := d.value(d.field(v, &fields.list[i])); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// structKey reads a map key and returns its canonical encoding, as used
+// in structFields.byKey, and a description for error messages. The
+// encoding is nil for keys that cannot name a struct field.
+func (d *decodeState) structKey() (key []byte, name string) {
+	switch d.data[d.off] >> 5 {
+	case majorText:
+		_, ai, arg := d.readHead()
+		s := d.stringContent(ai, arg)
+		return appendText(nil, string(s)), strconv.Quote(string(s))
+	case majorUint, majorNegInt:
+		major, _, arg := d.readHead()
+		key = appendHead(nil, major, arg)
+		if major == majorNegInt {
+			return key, "-" + new(big.Int).Add(new(big.Int).SetUint64(arg), big.NewInt(1)).String()
+		}
+		return key, strconv.FormatUint(arg, 10)
+	}
+	start := d.off
+	d.skip()
+	return nil, describe(d.data[start])
+}
+
+// field returns the field f of the struct v, allocating embedded
+// pointers as needed. It returns the invalid Value if the field cannot
+// be set.
+func (d *decodeState) field(v reflect.Value, f *field) reflect.Value {
+	subv := v
+	for _, i := range f.index {
+		if subv.Kind() == reflect.Pointer {
+			if subv.IsNil() {
+				// If a struct embeds a pointer to an unexported type,
+				// it is not possible to set a newly allocated value
+				// since the field is unexported.
+				if !subv.CanSet() {
+					d.saveError(fmt.Errorf("cbor: cannot set embedded pointer to unexported struct: %v", subv.Type().Elem()))
+					return reflect.Value{}
+				}
+				subv.Set(reflect.New(subv.Type().Elem()))
+			}
+			subv = subv.Elem()
+		}
+		subv = subv.Field(i)
+	}
+	return subv
+}
+
+// timeValue decodes a tag number 0 or 1 and its content as a time.
+func (d *decodeState) timeValue() (time.Time, bool) {
+	major, _, arg := d.readHead()
+	if major != majorTag || arg > tagEpochDateTime {
+		return time.Time{}, false
+	}
+	return timeFromTag(arg, d.valueInterface())
+}
+
+// timeFromTag returns the time represented by the content of a tag
+// with number 0 or 1.
+func timeFromTag(number uint64, content any) (time.Time, bool) {
+	if number == tagDateTimeString {
+		s, ok := content.(string)
+		if !ok {
+			return time.Time{}, false
+		}
+		t, err := time.Parse(time.RFC3339Nano, s)
+		return t, err == nil
+	}
+	switch c := content.(type) {
+	case uint64:
+		if c <= math.MaxInt64 {
+			return time.Unix(int64(c), 0), true
+		}
+	case int64:
+		return time.Unix(c, 0), true
+	case float64:
+		if c > -(1<<63) && c < 1<<63 {
+			sec, frac := math.Modf(c)
+			return time.Unix(int64(sec), int64(frac*1e9)), true
+		}
+	}
+	return time.Time{}, false
+}
+
+// bigIntValue decodes an integer or bignum.
+func (d *decodeState) bigIntValue() (*big.Int, bool) {
+	major, ai, arg := d.readHead()
+	switch major {
+	case majorUint:
+		return new(big.Int).SetUint64(arg), true
+	case majorNegInt:
+		x := new(big.Int).SetUint64(arg)
+		return x.Not(x), true
+	case majorTag:
+		if arg != tagPosBignum && arg != tagNegBignum || d.data[d.off]>>5 != majorBytes {
+			return nil, false
+		}
+		number := arg
+		_, ai, arg = d.readHead()
+		return bigIntFromTag(number, d.stringContent(ai, arg)), true
+	}
+	return nil, false
+}
+
+// bigIntFromTag returns the integer represented by the content of a
+// tag with number 2 or 3.
+func bigIntFromTag(number uint64, content []byte) *big.Int {
+	x := new(big.Int).SetBytes(content)
+	if number == tagNegBignum {
+		// The tag content n represents the integer -1-n.
+		x.Not(x)
+	}
+	return x
+}
+
+// valueInterface decodes the data item at d.off into a Go value of the
+// type listed in the documentation for Unmarshal.
+func (d *decodeState) valueInterface() any {
+	start := d.off
+	major, ai, arg := d.readHead()
+	switch major {
+	case majorUint:
+		return arg
+	case majorNegInt:
+		if arg <= math.MaxInt64 {
+			return ^int64(arg)
+		}
+		x := new(big.Int).SetUint64(arg)
+		return x.Not(x)
+	case majorBytes:
+		return bytes.Clone(d.stringContent(ai, arg))
+	case majorText:
+		return string(d.stringContent(ai, arg))
+	case majorArray:
+		a := []any{}
+		for d.next(ai, &arg) {
+			a = append(a, d.valueInterface())
+		}
+		return a
+	case majorMap:
+		m := map[any]any{}
+		for d.next(ai, &arg) {
+			keyStart := d.off
+			k := d.valueInterface()
+			if k != nil && !reflect.TypeOf(k).Comparable() {
+				d.saveError(&UnmarshalTypeError{Value: describe(d.data[keyStart]) + " map key", Type: reflect.TypeOf(m), Offset: int64(keyStart)})
+				d.skip()
+				continue
+			}
+			m[k] = d.valueInterface()
+		}
+		return m
+	case majorTag:
+		return d.tagInterface(start, arg)
+	}
+	switch ai {
+	case simpleFalse, simpleTrue:
+		return ai == simpleTrue
+	case simpleNull, simpleUndefined:
+		return nil
+	case 25, 26, 27:
+		return float(ai, arg)
+	}
+	return Simple(arg)
+}
+
+// tagInterface decodes the content of the tag with the given number, which
+// starts at start, into a Go value.
+func (d *decodeState) tagInterface(start int, number uint64) any {
+	switch number {
+	case tagDateTimeString, tagEpochDateTime:
+		t, ok := timeFromTag(number, d.valueInterface())
+		if !ok {
+			d.saveError(&UnmarshalTypeError{Value: "tag " + strconv.FormatUint(number, 10), Type: timeType, Offset: int64(start)})
+			return nil
+		}
+		return t
+	case tagPosBignum, tagNegBignum:
+		b, ok := d.valueInterface().([]byte)
+		if !ok {
+			d.saveError(&UnmarshalTypeError{Value: "tag " + strconv.FormatUint(number, 10), Type: reflect.PointerTo(bigIntType), Offset: int64(start)})
+			return nil
+		}
+		return bigIntFromTag(number, b)
+	}
+	return Tag{number, d.valueInterface()}
+}
@@ -0,0 +1,331 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cbor
+
+import (
+	"errors"
+	"math"
+	"math/big"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+// Examples from RFC 8949, Appendix A, decoded into an interface value.
+var decodeInterfaceTests = []struct {
+	in   string
+	want any
+}{
+	{"00", uint64(0)},
+	{"17", uint64(23)},
+	{"1818", uint64(24)},
+	{"1b000000e8d4a51000", uint64(1000000000000)},
+	{"1bffffffffffffffff", uint64(math.MaxUint64)},
+	{"c249010000000000000000", bigInt("18446744073709551616")},
+	{"3bffffffffffffffff", bigInt("-18446744073709551616")},
+	{"c349010000000000000000", bigInt("-18446744073709551617")},
+	{"20", int64(-1)},
+	{"3903e7", int64(-1000)},
+	{"f90000", 0.0},
+	{"f93c00", 1.0},
+	{"fb3ff199999999999a", 1.1},
+	{"f97bff", 65504.0},
+	{"fa47c35000", 100000.0},
+	{"f90001", 5.960464477539063e-8},
+	{"f9c400", -4.0},
+	{"fa7f800000", math.Inf(1)},
+	{"fbfff0000000000000", math.Inf(-1)},
+	{"f4", false},
+	{"f5", true},
+	{"f6", nil},
+	{"f7", nil},
+	{"f0", Simple(16)},
+	{"f8ff", Simple(255)},
+	{"c074323031332d30332d32315432303a30343a30305a", time.Date(2013, 3, 21, 20, 4, 0, 0, time.UTC)},
+	{"c11a514b67b0", time.Unix(1363896240, 0)},
+	{"c1fb41d452d9ec200000", time.Unix(1363896240, 500000000)},
+	{"d74401020304", Tag{23, []byte{1, 2, 3, 4}}},
+	{"d82076687474703a2f2f7777772e6578616d706c652e636f6d", Tag{32, "http://www.example.com"}},
+	{"40", []byte{}},
+	{"4401020304", []byte{1, 2, 3, 4}},
+	{"60", ""},
+	{"64f0908591", "\U00010151"},
+	{"80", []any{}},
+	{"8301820203820405", []any{uint64(1), []any{uint64(2), uint64(3)}, []any{uint64(4), uint64(5)}}},
+	{"a0", map[any]any{}},
+	{"a201020304", map[any]any{uint64(1): uint64(2), uint64(3): uint64(4)}},
+	{"826161a161626163", []any{"a", map[any]any{"b": "c"}}},
+	// Indefinite-length items.
+	{"5f42010243030405ff", []byte{1, 2, 3, 4, 5}},
+	{"7f657374726561646d696e67ff", "streaming"},
+	{"9fff", []any{}},
+	{"9f018202039f0405ffff", []any{uint64(1), []any{uint64(2), uint64(3)}, []any{uint64(4), uint64(5)}}},
+	{"bf61610161629f0203ffff", map[any]any{"a": uint64(1), "b": []any{uint64(2), uint64(3)}}},
+	// Non-preferred encodings are accepted by Unmarshal.
+	{"1817", uint64(23)},
+	{"fb3ff0000000000000", 1.0},
+}
+
+func TestUnmarshalInterface(t *testing.T) {
+	for _, tt := range decodeInterfaceTests {
+		var got any
+		if err := Unmarshal(mustHex(tt.in), &got); err != nil {
+			t.Errorf("Unmarshal(%s) error: %v", tt.in, err)
+			continue
+		}
+		if !equal(got, tt.want) {
+			t.Errorf("Unmarshal(%s) = %#v, want %#v", tt.in, got, tt.want)
+		}
+	}
+}
+
+// equal is like reflect.DeepEqual, but compares big.Int and time.Time
+// values by their value.
+func equal(x, y any) bool {
+	switch x := x.(type) {
+	case *big.Int:
+		y, ok := y.(*big.Int)
+		return ok && x.Cmp(y) == 0
+	case time.Time:
+		y, ok := y.(time.Time)
+		return ok && x.Equal(y)
+	}
+	return reflect.DeepEqual(x, y)
+}
+
+func TestRoundTrip(t *testing.T) {
+	for _, tt := range encodeTests {
+		b, err := Marshal(tt.in)
+		if err != nil {
+			t.Fatalf("Marshal(%#v) error: %v", tt.in, err)
+		}
+		if !Valid(b) {
+			t.Errorf("Valid(Marshal(%#v)) = false", tt.in)
+			continue
+		}
+		if tt.in == nil || hasInterface(reflect.TypeOf(tt.in)) {
+			// Interface values do not keep their dynamic type.
+			continue
+		}
+		if m, ok := tt.in.(RawMessage); ok && m == nil {
+			continue
+		}
+		v := reflect.New(reflect.TypeOf(tt.in))
+		if err := Unmarshal(b, v.Interface()); err != nil {
+			t.Errorf("Unmarshal(%x) into %T error: %v", b, tt.in, err)
+			continue
+		}
+		got := v.Elem().Interface()
+		if f, ok := tt.in.(float64); ok && math.IsNaN(f) {
+			if !math.IsNaN(got.(float64)) {
+				t.Errorf("Unmarshal(%x) = %v, want NaN", b, got)
+			}
+			continue
+		}
+		if f, ok := tt.in.(float32); ok && f != f {
+			continue
+		}
+		if !equal(got, tt.in) {
+			t.Errorf("Unmarshal(%x) = %#v, want %#v", b, got, tt.in)
+		}
+	}
+}
+
+// hasInterface reports whether values of type t can hold interface values.
+func hasInterface(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Interface:
+		return true
+	case reflect.Array, reflect.Pointer, reflect.Slice:
+		return hasInterface(t.Elem())
+	case reflect.Map:
+		return hasInterface(t.Key()) || hasInterface(t.Elem())
+	}
+	return false
+}
+
+type unmarshalStruct struct {
+	Name    string
+	Count   uint8          `cbor:"count"`
+	Tags    []string       `cbor:"tags"`
+	Extra   map[string]int `cbor:"extra"`
+	Ptr     *int
+	Created time.Time
+	Raw     RawMessage
+	Any     any
+}
+
+func TestUnmarshalStruct(t *testing.T) {
+	one := 1
+	in := unmarshalStruct{
+		Name:    "gopher",
+		Count:   7,
+		Tags:    []string{"a", "b"},
+		Extra:   map[string]int{"x": -1},
+		Ptr:     &one,
+		Created: time.Date(2024, 1, 2, 3, 4, 5, 6, time.UTC),
+		Raw:     RawMessage{0x83, 0x01, 0x02, 0x03},
+		Any:     "any",
+	}
+	b, err := Marshal(in)
+	if err != nil {
+		t.Fatalf("Marshal error: %v", err)
+	}
+	var out unmarshalStruct
+	if err := Unmarshal(b, &out); err != nil {
+		t.Fatalf("Unmarshal error: %v", err)
+	}
+	if !out.Created.Equal(in.Created) {
+		t.Errorf("Created = %v, want %v", out.Created, in.Created)
+	}
+	out.Created = in.Created
+	if !reflect.DeepEqual(out, in) {
+		t.Errorf("Unmarshal = %+v, want %+v", out, in)
+	}
+}
+
+func TestUnmarshalNull(t *testing.T) {
+	one := 1
+	v := struct {
+		P *int
+		S []int
+		M map[string]int
+		I int
+		A any
+	}{&one, []int{1}, map[string]int{"a": 1}, 5, "x"}
+	if err := Unmarshal(mustHex("a5"+"6141f6"+"6149f6"+"614df6"+"6150f6"+"6153f7"), &v); err != nil {
+		t.Fatalf("Unmarshal error: %v", err)
+	}
+	if v.P != nil || v.S != nil || v.M != nil || v.A != nil || v.I != 5 {
+		t.Errorf("Unmarshal = %+v, want nil fields and I = 5", v)
+	}
+}
+
+func TestUnmarshalArray(t *testing.T) {
+	var a [2]int
+	if err := Unmarshal(mustHex("83010203"), &a); err != nil || a != [2]int{1, 2} {
+		t.Errorf("Unmarshal into [2]int = %v, %v", a, err)
+	}
+	a = [2]int{5, 6}
+	if err := Unmarshal(mustHex("8101"), &a); err != nil || a != [2]int{1, 0} {
+		t.Errorf("Unmarshal into [2]int = %v, %v", a, err)
+	}
+	var b [3]byte
+	if err := Unmarshal(mustHex("420102"), &b); err != nil || b != [3]byte{1, 2, 0} {
+		t.Errorf("Unmarshal into [3]byte = %v, %v", b, err)
+	}
+}
+
+func TestUnmarshalBigInt(t *testing.T) {
+	var x big.Int
+	if err := Unmarshal(mustHex("c349010000000000000000"), &x); err != nil {
+		t.Fatalf("Unmarshal error: %v", err)
+	}
+	if want := bigInt("-18446744073709551617"); x.Cmp(want) != 0 {
+		t.Errorf("Unmarshal = %v, want %v", &x, want)
+	}
+	if err := Unmarshal(mustHex("3863"), &x); err != nil || x.Int64() != -100 {
+		t.Errorf("Unmarshal = %v, %v, want -100", &x, err)
+	}
+}
+
+func TestUnmarshalTypeError(t *testing.T) {
+	tests := []struct {
+		in   string
+		v    any
+		want string
+	}{
+		{"6161", new(int), "cbor: cannot unmarshal text string into Go value of type int"},
+		{"1901f4", new(int8), "cbor: cannot unmarshal unsigned integer into Go value of type int8"},
+		{"20", new(uint), "cbor: cannot unmarshal negative integer into Go value of type uint"},
+		{"3bffffffffffffffff", new(int64), "cbor: cannot unmarshal negative integer into Go value of type int64"},
+		{"fa7f7fffff", new(float32), ""},
+		{"fb7e37e43c8800759c", new(float32), "cbor: cannot unmarshal floating-point number into Go value of type float32"},
+		{"a165636f756e74190100", new(unmarshalStruct), "cbor: cannot unmarshal unsigned integer into Go struct field unmarshalStruct.count of type uint8"},
+		{"a18001", new(any), "cbor: cannot unmarshal array map key into Go value of type map[interface {}]interface {}"},
+		{"8301026170", new(point), ""},
+		{"a0", new(point), "cbor: cannot unmarshal map into Go value of type cbor.point"},
+		{"c06161", new(time.Time), "cbor: cannot unmarshal tag into Go value of type time.Time"},
+	}
+	for _, tt := range tests {
+		err := Unmarshal(mustHex(tt.in), tt.v)
+		if tt.want == "" {
+			if err != nil {
+				t.Errorf("Unmarshal(%s) error: %v", tt.in, err)
+			}
+			continue
+		}
+		var uerr *UnmarshalTypeError
+		if !errors.As(err, &uerr) || err.Error() != tt.want {
+			t.Errorf("Unmarshal(%s) error = %v, want %s", tt.in, err, tt.want)
+		}
+	}
+}
+
+func TestUnmarshalSyntaxError(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{"", "cbor: unexpected end of input"},
+		{"1a0000", "cbor: unexpected end of input"},
+		{"0000", "cbor: extra data after data item"},
+		{"1c", "cbor: reserved additional information value"},
+		{"1f", "cbor: invalid indefinite-length item"},
+		{"ff", "cbor: unexpected break"},
+		{"f818", "cbor: invalid simple value"},
+		{"62c328", "cbor: invalid UTF-8 in text string"},
+		{"5f6161ff", "cbor: invalid chunk in indefinite-length string"},
+		{"5a7fffffff", "cbor: unexpected end of input"},
+		{"5bffffffffffffffff", "cbor: string too long"},
+		{strings.Repeat("81", defaultMaxDepth+1) + "00", "cbor: exceeded max depth"},
+	}
+	for _, tt := range tests {
+		var v any
+		err := Unmarshal(mustHex(tt.in), &v)
+		var serr *SyntaxError
+		if !errors.As(err, &serr) || err.Error() != tt.want {
+			t.Errorf("Unmarshal(%s) error = %v, want %s", tt.in, err, tt.want)
+		}
+	}
+}
+
+func TestInvalidUnmarshal(t *testing.T) {
+	for _, v := range []any{nil, 1, (*int)(nil)} {
+		var ierr *InvalidUnmarshalError
+		if err := Unmarshal([]byte{0}, v); !errors.As(err, &ierr) {
+			t.Errorf("Unmarshal(%#v) error = %v, want InvalidUnmarshalError", v, err)
+		}
+	}
+}
+
+type binaryValue struct{ b []byte }
+
+func (v *binaryValue) UnmarshalBinary(b []byte) error {
+	v.b = append(v.b[:0], b...)
+	return nil
+}
+
+type cborValue struct{ raw []byte }
+
+func (v *cborValue) UnmarshalCBOR(b []byte) error {
+	if len(b) == 0 {
+		return errors.New("empty")
+	}
+	v.raw = append(v.raw[:0], b...)
+	return nil
+}
+
+func TestUnmarshaler(t *testing.T) {
+	var bv binaryValue
+	if err := Unmarshal(mustHex("5f41014102ff"), &bv); err != nil || string(bv.b) != "\x01\x02" {
+		t.Errorf("UnmarshalBinary got %x, %v", bv.b, err)
+	}
+	var cv struct{ V cborValue }
+	if err := Unmarshal(mustHex("a16156820102"), &cv); err != nil || string(cv.V.raw) != "\x82\x01\x02" {
+		t.Errorf("UnmarshalCBOR got %x, %v", cv.V.raw, err)
+	}
+}
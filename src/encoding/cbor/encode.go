@@ -0,0 +1,857 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package cbor implements encoding and decoding of the Concise Binary Object
+// Representation (CBOR) as defined in RFC 8949. The mapping between CBOR and
+// Go values is described in the documentation for the Marshal and Unmarshal
+// functions, and follows that of package encoding/json where possible.
+//
+// Marshal always produces the core deterministic encoding described in
+// RFC 8949, Section 4.2.1, so equal values have identical encodings.
+// A [Decoder] can be put in strict mode, in which it only accepts that
+// encoding.
+package cbor
+
+import (
+	"bytes"
+	"cmp"
+	"encoding"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"math/big"
+	"reflect"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+	"unicode/utf8"
+)
+
+// Major types, as defined in RFC 8949, Section 3.1.
+const (
+	majorUint   = 0
+	majorNegInt = 1
+	majorBytes  = 2
+	majorText   = 3
+	majorArray  = 4
+	majorMap    = 5
+	majorTag    = 6
+	majorSimple = 7
+)
+
+// Marshal returns the CBOR encoding of v.
+//
+// Marshal traverses the value v recursively.
+// If an encountered value implements [Marshaler]
+// and is not a nil pointer, Marshal calls its MarshalCBOR method
+// to produce CBOR. The returned data must be a single well-formed data
+// item. If no MarshalCBOR method is present but the value implements
+// [encoding.BinaryMarshaler] instead, Marshal calls its MarshalBinary
+// method and encodes the result as a byte string.
+//
+// Otherwise, Marshal uses the following type-dependent default encodings:
+//
+// Boolean values encode as the simple values false and true.
+//
+// Integer values encode as unsigned or negative integers, in the shortest
+// form. Floating point values encode as the shortest of the half, single
+// and double precision floating-point encodings that represents the value
+// exactly. All NaN values encode as the half precision quiet NaN.
+//
+// String values encode as text strings. A string that is not valid UTF-8
+// causes Marshal to return an [UnsupportedValueError].
+//
+// Slices and arrays of bytes encode as byte strings. Other array and slice
+// values encode as arrays. A nil slice encodes as null.
+//
+// Struct values encode as maps. Each exported struct field becomes a
+// member of the map, keyed by the field name as a text string, unless
+//   - the field's tag is "-", or
+//   - the field is empty and its tag specifies the "omitempty" option, or
+//   - the field is zero and its tag specifies the "omitzero" option.
+//
+// The empty values are false, 0, any nil pointer or interface value, and
+// any array, slice, map, or string of length zero. A value is zero if it
+// has an IsZero() bool method that reports true, or otherwise if it is
+// the zero value of its type.
+//
+// The key of each field can be customized by the format string stored
+// under the "cbor" key in the struct field's tag, which gives the name of
+// the field, possibly followed by a comma-separated list of options.
+// The "keyasint" option specifies that the name is a decimal integer and
+// that the field is keyed by that integer instead of a text string, as
+// is common in protocols such as COSE and CTAP2:
+//
+//	// Field appears in CBOR as key "myName".
+//	Field int `cbor:"myName"`
+//
+//	// Field appears in CBOR as key -1 and is omitted if empty.
+//	Field []byte `cbor:"-1,keyasint,omitempty"`
+//
+//	// Field is ignored by this package.
+//	Field int `cbor:"-"`
+//
+// A struct with a blank field tagged with the "toarray" option encodes as
+// an array of its field values instead, in declaration order. The
+// "omitempty" and "omitzero" options are ignored for such structs:
+//
+//	type Point struct {
+//		_    struct{} `cbor:",toarray"`
+//		X, Y int
+//	}
+//
+// Embedded struct fields are handled as in package encoding/json: their
+// exported fields are promoted to the outer struct, following the Go
+// visibility rules as amended for tagged fields.
+//
+// Map values encode as maps. Keys may be of any type that can be encoded.
+// The members of a map are sorted by the bytewise lexicographic order of
+// their encoded keys. Two keys with the same encoding, such as two NaN
+// keys, cause Marshal to return an [UnsupportedValueError]. A nil map
+// encodes as null.
+//
+// Pointer values encode as the value pointed to, and interface values
+// encode as the value contained in the interface. A nil pointer or nil
+// interface value encodes as null.
+//
+// Some types have encodings defined by RFC 8949:
+//   - [time.Time] values encode as a text string in RFC 3339 format
+//     with tag number 0.
+//   - [big.Int] values encode as integers when they fit in 64 bits, and as
+//     byte strings with tag number 2 or 3 (bignums) otherwise.
+//   - [Tag] values encode as a tag with the given number and content.
+//   - [Simple] values encode as simple values.
+//   - [RawMessage] values are written unchanged.
+//
+// Channel, complex, and function values cannot be encoded in CBOR.
+// Attempting to encode such a value causes Marshal to return
+// an [UnsupportedTypeError].
+//
+// CBOR cannot represent cyclic data structures and Marshal does not
+// handle them. Passing cyclic structures to Marshal will result in
+// an error.
+func Marshal(v any) ([]byte, error) {
+	var e encodeState
+	if err := e.reflectValue(reflect.ValueOf(v)); err != nil {
+		return nil, err
+	}
+	return e.buf, nil
+}
+
+// Marshaler is the interface implemented by types that
+// can marshal themselves into a valid CBOR data item.
+type Marshaler interface {
+	MarshalCBOR() ([]byte, error)
+}
+
+// An UnsupportedTypeError is returned by [Marshal] when attempting
+// to encode an unsupported value type.
+type UnsupportedTypeError struct {
+	Type reflect.Type
+}
+
+func (e *UnsupportedTypeError) Error() string {
+	return "cbor: unsupported type: " + e.Type.String()
+}
+
+// An UnsupportedValueError is returned by [Marshal] when attempting
+// to encode an unsupported value.
+type UnsupportedValueError struct {
+	Value reflect.Value
+	Str   string
+}
+
+func (e *UnsupportedValueError) Error() string {
+	return "cbor: unsupported value: " + e.Str
+}
+
+// A MarshalerError represents an error from calling a
+// [Marshaler.MarshalCBOR] or [encoding.BinaryMarshaler.MarshalBinary] method.
+type MarshalerError struct {
+	Type       reflect.Type
+	Err        error
+	sourceFunc string
+}
+
+func (e *MarshalerError) Error() string {
+	srcFunc := e.sourceFunc
+	if srcFunc == "" {
+		srcFunc = "MarshalCBOR"
+	}
+	return "cbor: error calling " + srcFunc +
+		" for type " + e.Type.String() +
+		": " + e.Err.Error()
+}
+
+// Unwrap returns the underlying error.
+func (e *MarshalerError) Unwrap() error { return e.Err }
+
+var (
+	marshalerType       = reflect.TypeFor[Marshaler]()
+	binaryMarshalerType = reflect.TypeFor[encoding.BinaryMarshaler]()
+	timeType            = reflect.TypeFor[time.Time]()
+	bigIntType          = reflect.TypeFor[big.Int]()
+	tagType             = reflect.TypeFor[Tag]()
+	simpleType          = reflect.TypeFor[Simple]()
+)
+
+// An encodeState encodes CBOR into a byte slice.
+type encodeState struct {
+	buf []byte
+
+	// Keep track of what pointers we've seen in the current recursive call
+	// path, to avoid cycles that could lead to a stack overflow. Only do
+	// the relatively expensive map operations if ptrLevel is larger than
+	// startDetectingCyclesAfter, so that we skip the work if we're within a
+	// reasonable amount of nested pointers deep.
+	ptrLevel uint
+	ptrSeen  map[any]struct{}
+}
+
+const startDetectingCyclesAfter = 1000
+
+// enter records that e is entering the value v identified by ptr, and
+// reports an error if doing so would start a cycle. If enter returns nil,
+// the caller must call e.leave(ptr) when done with v.
+func (e *encodeState) enter(v reflect.Value, ptr any) error {
+	if e.ptrLevel++; e.ptrLevel > startDetectingCyclesAfter {
+		if e.ptrSeen == nil {
+			e.ptrSeen = make(map[any]struct{})
+		}
+		if _, ok := e.ptrSeen[ptr]; ok {
+			e.ptrLevel--
+			return &UnsupportedValueError{v, fmt.Sprintf("encountered a cycle via %s", v.Type())}
+		}
+		e.ptrSeen[ptr] = struct{}{}
+	}
+	return nil
+}
+
+func (e *encodeState) leave(ptr any) {
+	if e.ptrLevel > startDetectingCyclesAfter {
+		delete(e.ptrSeen, ptr)
+	}
+	e.ptrLevel--
+}
+
+// appendHead appends the initial byte and argument of a data item with the
+// given major type, using the shortest encoding of n.
+func appendHead(b []byte, major byte, n uint64) []byte {
+	m := major << 5
+	switch {
+	case n < 24:
+		return append(b, m|byte(n))
+	case n <= math.MaxUint8:
+		return append(b, m|24, byte(n))
+	case n <= math.MaxUint16:
+		return binary.BigEndian.AppendUint16(append(b, m|25), uint16(n))
+	case n <= math.MaxUint32:
+		return binary.BigEndian.AppendUint32(append(b, m|26), uint32(n))
+	}
+	return binary.BigEndian.AppendUint64(append(b, m|27), n)
+}
+
+func appendInt(b []byte, i int64) []byte {
+	if i < 0 {
+		return appendHead(b, majorNegInt, uint64(^i))
+	}
+	return appendHead(b, majorUint, uint64(i))
+}
+
+func appendText(b []byte, s string) []byte {
+	b = appendHead(b, majorText, uint64(len(s)))
+	return append(b, s...)
+}
+
+func appendBytes(b []byte, s []byte) []byte {
+	b = appendHead(b, majorBytes, uint64(len(s)))
+	return append(b, s...)
+}
+
+func appendBigInt(b []byte, x *big.Int) []byte {
+	major, tag := byte(majorUint), uint64(tagPosBignum)
+	if x.Sign() < 0 {
+		// A negative integer n is encoded as -1-n.
+		major, tag = majorNegInt, tagNegBignum
+		x = new(big.Int).Not(x)
+	}
+	if x.IsUint64() {
+		return appendHead(b, major, x.Uint64())
+	}
+	b = appendHead(b, majorTag, tag)
+	return appendBytes(b, x.Bytes())
+}
+
+const (
+	simpleFalse     = 20
+	simpleTrue      = 21
+	simpleNull      = 22
+	simpleUndefined = 23
+)
+
+func appendNull(b []byte) []byte {
+	return append(b, majorSimple<<5|simpleNull)
+}
+
+func (e *encodeState) reflectValue(v reflect.Value) error {
+	if !v.IsValid() {
+		e.buf = appendNull(e.buf)
+		return nil
+	}
+	t := v.Type()
+	switch t.Kind() {
+	case reflect.Pointer:
+		if v.IsNil() {
+			e.buf = appendNull(e.buf)
+			return nil
+		}
+		if err := e.enter(v, v.UnsafePointer()); err != nil {
+			return err
+		}
+		defer e.leave(v.UnsafePointer())
+		return e.reflectValue(v.Elem())
+	case reflect.Interface:
+		if v.IsNil() {
+			e.buf = appendNull(e.buf)
+			return nil
+		}
+		return e.reflectValue(v.Elem())
+	}
+
+	// Look for methods with value receivers, then for methods with pointer
+	// receivers on addressable values.
+	if t.Implements(marshalerType) {
+		return e.marshalerValue(v)
+	}
+	if v.CanAddr() && reflect.PointerTo(t).Implements(marshalerType) {
+		return e.marshalerValue(v.Addr())
+	}
+
+	switch t {
+	case timeType:
+		return e.timeValue(v)
+	case bigIntType:
+		x := v.Interface().(big.Int)
+		e.buf = appendBigInt(e.buf, &x)
+		return nil
+	case tagType:
+		tag := v.Interface().(Tag)
+		e.buf = appendHead(e.buf, majorTag, tag.Number)
+		return e.reflectValue(reflect.ValueOf(tag.Content))
+	case simpleType:
+		s := v.Uint()
+		if s >= 24 && s < 32 {
+			return &UnsupportedValueError{v, "reserved simple value " + strconv.FormatUint(s, 10)}
+		}
+		if s < 24 {
+			e.buf = append(e.buf, majorSimple<<5|byte(s))
+		} else {
+			e.buf = append(e.buf, majorSimple<<5|24, byte(s))
+		}
+		return nil
+	}
+
+	if t.Implements(binaryMarshalerType) {
+		return e.binaryMarshalerValue(v)
+	}
+	if v.CanAddr() && reflect.PointerTo(t).Implements(binaryMarshalerType) {
+		return e.binaryMarshalerValue(v.Addr())
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		if v.Bool() {
+			e.buf = append(e.buf, majorSimple<<5|simpleTrue)
+		} else {
+			e.buf = append(e.buf, majorSimple<<5|simpleFalse)
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		e.buf = appendInt(e.buf, v.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		e.buf = appendHead(e.buf, majorUint, v.Uint())
+	case reflect.Float32:
+		e.buf = appendFloat32(e.buf, float32(v.Float()))
+	case reflect.Float64:
+		e.buf = appendFloat(e.buf, v.Float())
+	case reflect.String:
+		s := v.String()
+		if !utf8.ValidString(s) {
+			return &UnsupportedValueError{v, "invalid UTF-8 in string " + strconv.Quote(s)}
+		}
+		e.buf = appendText(e.buf, s)
+	case reflect.Slice:
+		if v.IsNil() {
+			e.buf = appendNull(e.buf)
+			return nil
+		}
+		if isByteElem(t.Elem()) {
+			e.buf = appendBytes(e.buf, v.Bytes())
+			return nil
+		}
+		// A slice can be a cycle through its backing array, identified
+		// by its pointer and length.
+		ptr := struct {
+			ptr any
+			len int
+		}{v.UnsafePointer(), v.Len()}
+		if err := e.enter(v, ptr); err != nil {
+			return err
+		}
+		defer e.leave(ptr)
+		return e.arrayValue(v)
+	case reflect.Array:
+		if isByteElem(t.Elem()) {
+			e.buf = appendHead(e.buf, majorBytes, uint64(v.Len()))
+			for i := range v.Len() {
+				e.buf = append(e.buf, byte(v.Index(i).Uint()))
+			}
+			return nil
+		}
+		return e.arrayValue(v)
+	case reflect.Map:
+		if v.IsNil() {
+			e.buf = appendNull(e.buf)
+			return nil
+		}
+		if err := e.enter(v, v.UnsafePointer()); err != nil {
+			return err
+		}
+		defer e.leave(v.UnsafePointer())
+		return e.mapValue(v)
+	case reflect.Struct:
+		return e.structValue(v)
+	default:
+		return &UnsupportedTypeError{t}
+	}
+	return nil
+}
+
+// isByteElem reports whether a slice or array with elements of type t is
+// encoded as a byte string.
+func isByteElem(t reflect.Type) bool {
+	if t.Kind() != reflect.Uint8 {
+		return false
+	}
+	p := reflect.PointerTo(t)
+	return !p.Implements(marshalerType) && !p.Implements(binaryMarshalerType)
+}
+
+func (e *encodeState) marshalerValue(v reflect.Value) error {
+	if v.Kind() == reflect.Pointer && v.IsNil() {
+		e.buf = appendNull(e.buf)
+		return nil
+	}
+	b, err := v.Interface().(Marshaler).MarshalCBOR()
+	if err == nil {
+		var n int
+		n, err = checkValid(b, &decOpts{maxDepth: defaultMaxDepth})
+		if err == nil && n != len(b) {
+			err = &SyntaxError{"cbor: extra data after data item", int64(n)}
+		}
+	}
+	if err != nil {
+		return &MarshalerError{v.Type(), err, "MarshalCBOR"}
+	}
+	e.buf = append(e.buf, b...)
+	return nil
+}
+
+func (e *encodeState) binaryMarshalerValue(v reflect.Value) error {
+	if v.Kind() == reflect.Pointer && v.IsNil() {
+		e.buf = appendNull(e.buf)
+		return nil
+	}
+	b, err := v.Interface().(encoding.BinaryMarshaler).MarshalBinary()
+	if err != nil {
+		return &MarshalerError{v.Type(), err, "MarshalBinary"}
+	}
+	e.buf = appendBytes(e.buf, b)
+	return nil
+}
+
+func (e *encodeState) timeValue(v reflect.Value) error {
+	t := v.Interface().(time.Time)
+	if y := t.Year(); y < 0 || y >= 10000 {
+		return &UnsupportedValueError{v, "time year outside of range [0,9999]"}
+	}
+	e.buf = appendHead(e.buf, majorTag, tagDateTimeString)
+	e.buf = appendText(e.buf, t.Format(time.RFC3339Nano))
+	return nil
+}
+
+func (e *encodeState) arrayValue(v reflect.Value) error {
+	n := v.Len()
+	e.buf = appendHead(e.buf, majorArray, uint64(n))
+	for i := range n {
+		if err := e.reflectValue(v.Index(i)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (e *encodeState) mapValue(v reflect.Value) error {
+	// Encode all members, then sort them by their encoded keys.
+	type member struct{ start, keyEnd, end int }
+	start := len(e.buf)
+	members := make([]member, 0, v.Len())
+	for iter := v.MapRange(); iter.Next(); {
+		var m member
+		m.start = len(e.buf)
+		if err := e.reflectValue(iter.Key()); err != nil {
+			return err
+		}
+		m.keyEnd = len(e.buf)
+		if err := e.reflectValue(iter.Value()); err != nil {
+			return err
+		}
+		m.end = len(e.buf)
+		members = append(members, m)
+	}
+	key := func(m member) []byte { return e.buf[m.start:m.keyEnd] }
+	slices.SortFunc(members, func(a, b member) int {
+		return bytes.Compare(key(a), key(b))
+	})
+	for i := 1; i < len(members); i++ {
+		if bytes.Equal(key(members[i-1]), key(members[i])) {
+			return &UnsupportedValueError{v, "duplicate map key"}
+		}
+	}
+	data := bytes.Clone(e.buf[start:])
+	e.buf = appendHead(e.buf[:start], majorMap, uint64(len(members)))
+	for _, m := range members {
+		e.buf = append(e.buf, data[m.start-start:m.end-start]...)
+	}
+	return nil
+}
+
+func (e *encodeState) structValue(v reflect.Value) error {
+	fields := cachedTypeFields(v.Type())
+	if fields.toArray {
+		e.buf = appendHead(e.buf, majorArray, uint64(len(fields.list)))
+		for i := range fields.list {
+			fv, ok := fieldByIndex(v, fields.list[i].index)
+			if !ok {
+				e.buf = appendNull(e.buf)
+				continue
+			}
+			if err := e.reflectValue(fv); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	start := len(e.buf)
+	n := 0
+	for i := range fields.list {
+		f := &fields.list[i]
+		fv, ok := fieldByIndex(v, f.index)
+		if !ok ||
+			f.omitEmpty && isEmptyValue(fv) ||
+			f.omitZero && (f.isZero == nil && fv.IsZero() || (f.isZero != nil && f.isZero(fv))) {
+			continue
+		}
+		e.buf = append(e.buf, f.key...)
+		if err := e.reflectValue(fv); err != nil {
+			return err
+		}
+		n++
+	}
+	head := appendHead(nil, majorMap, uint64(n))
+	e.buf = slices.Insert(e.buf, start, head...)
+	return nil
+}
+
+// fieldByIndex returns the nested field of v with the given index
+// sequence. It reports false if the field is reached through a nil
+// embedded pointer.
+func fieldByIndex(v reflect.Value, index []int) (reflect.Value, bool) {
+	for _, i := range index {
+		if v.Kind() == reflect.Pointer {
+			if v.IsNil() {
+				return reflect.Value{}, false
+			}
+			v = v.Elem()
+		}
+		v = v.Field(i)
+	}
+	return v, true
+}
+
+func isEmptyValue(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Array, reflect.Map, reflect.Slice, reflect.String:
+		return v.Len() == 0
+	case reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64,
+		reflect.Interface, reflect.Pointer:
+		return v.IsZero()
+	}
+	return false
+}
+
+type isZeroer interface {
+	IsZero() bool
+}
+
+var isZeroerType = reflect.TypeFor[isZeroer]()
+
+// isZeroFunc returns a function reporting whether a value of type t is zero
+// using its IsZero method, or nil if t has no such method.
+func isZeroFunc(t reflect.Type) func(reflect.Value) bool {
+	switch {
+	case t.Kind() == reflect.Interface && t.Implements(isZeroerType):
+		return func(v reflect.Value) bool {
+			// Avoid panics calling IsZero on a nil interface or
+			// non-nil interface with nil pointer.
+			return v.IsNil() ||
+				(v.Elem().Kind() == reflect.Pointer && v.Elem().IsNil()) ||
+				v.Interface().(isZeroer).IsZero()
+		}
+	case t.Kind() == reflect.Pointer && t.Implements(isZeroerType):
+		return func(v reflect.Value) bool {
+			// Avoid panics calling IsZero on nil pointer.
+			return v.IsNil() || v.Interface().(isZeroer).IsZero()
+		}
+	case t.Implements(isZeroerType):
+		return func(v reflect.Value) bool {
+			return v.Interface().(isZeroer).IsZero()
+		}
+	case reflect.PointerTo(t).Implements(isZeroerType):
+		return func(v reflect.Value) bool {
+			if !v.CanAddr() {
+				// Temporarily box v so we can take the address.
+				v2 := reflect.New(v.Type()).Elem()
+				v2.Set(v)
+				v = v2
+			}
+			return v.Addr().Interface().(isZeroer).IsZero()
+		}
+	}
+	return nil
+}
+
+// A field represents a single field found in a struct.
+type field struct {
+	name  string
+	key   []byte // encoded map key
+	tag   bool
+	index []int
+	typ   reflect.Type
+
+	omitEmpty bool
+	omitZero  bool
+	isZero    func(reflect.Value) bool
+}
+
+type structFields struct {
+	// list holds the fields in the order they are encoded: sorted by
+	// encoded key, or in declaration order if toArray is set.
+	list    []field
+	byKey   map[string]*field
+	toArray bool
+}
+
+// typeFields returns a list of fields that CBOR should recognize for the
+// given type. The algorithm is breadth-first search over the set of
+// structs to include - the top struct and then any reachable anonymous
+// structs, as in package encoding/json.
+func typeFields(t reflect.Type) structFields {
+	// Anonymous fields to explore at the current level and the next.
+	current := []field{}
+	next := []field{{typ: t}}
+
+	// Count of queued names for current level and the next.
+	var count, nextCount map[reflect.Type]int
+
+	// Types already visited at an earlier level.
+	visited := map[reflect.Type]bool{}
+
+	// Fields found.
+	var fields []field
+
+	toArray := false
+
+	for len(next) > 0 {
+		current, next = next, current[:0]
+		count, nextCount = nextCount, map[reflect.Type]int{}
+
+		for _, f := range current {
+			if visited[f.typ] {
+				continue
+			}
+			visited[f.typ] = true
+
+			// Scan f.typ for fields to include.
+			for i := 0; i < f.typ.NumField(); i++ {
+				sf := f.typ.Field(i)
+				if sf.Name == "_" {
+					if _, opts := parseTag(sf.Tag.Get("cbor")); opts.Contains("toarray") && len(f.index) == 0 {
+						toArray = true
+					}
+					continue
+				}
+				if sf.Anonymous {
+					t := sf.Type
+					if t.Kind() == reflect.Pointer {
+						t = t.Elem()
+					}
+					if !sf.IsExported() && t.Kind() != reflect.Struct {
+						// Ignore embedded fields of unexported non-struct types.
+						continue
+					}
+					// Do not ignore embedded fields of unexported struct types
+					// since they may have exported fields.
+				} else if !sf.IsExported() {
+					// Ignore unexported non-embedded fields.
+					continue
+				}
+				tag := sf.Tag.Get("cbor")
+				if tag == "-" {
+					continue
+				}
+				name, opts := parseTag(tag)
+				index := make([]int, len(f.index)+1)
+				copy(index, f.index)
+				index[len(f.index)] = i
+
+				ft := sf.Type
+				if ft.Name() == "" && ft.Kind() == reflect.Pointer {
+					// Follow pointer.
+					ft = ft.Elem()
+				}
+
+				// Record found field and index sequence.
+				if name != "" || !sf.Anonymous || ft.Kind() != reflect.Struct {
+					tagged := name != ""
+					if name == "" {
+						name = sf.Name
+					}
+					field := field{
+						name:      name,
+						tag:       tagged,
+						index:     index,
+						typ:       ft,
+						omitEmpty: opts.Contains("omitempty"),
+						omitZero:  opts.Contains("omitzero"),
+					}
+					if n, err := strconv.ParseInt(name, 10, 64); err == nil && tagged && opts.Contains("keyasint") {
+						field.key = appendInt(nil, n)
+					} else {
+						field.key = appendText(nil, name)
+					}
+					if field.omitZero {
+						field.isZero = isZeroFunc(sf.Type)
+					}
+
+					fields = append(fields, field)
+					if count[f.typ] > 1 {
+						// If there were multiple instances, add a second,
+						// so that the annihilation code will see a duplicate.
+						// It only cares about the distinction between 1 and 2,
+						// so don't bother generating any more copies.
+						fields = append(fields, fields[len(fields)-1])
+					}
+					continue
+				}
+
+				// Record new anonymous struct to explore in next round.
+				nextCount[ft]++
+				if nextCount[ft] == 1 {
+					next = append(next, field{name: ft.Name(), index: index, typ: ft})
+				}
+			}
+		}
+	}
+
+	slices.SortFunc(fields, func(a, b field) int {
+		// sort field by key, breaking ties with depth, then
+		// breaking ties with "name came from cbor tag", then
+		// breaking ties with index sequence.
+		if c := bytes.Compare(a.key, b.key); c != 0 {
+			return c
+		}
+		if c := cmp.Compare(len(a.index), len(b.index)); c != 0 {
+			return c
+		}
+		if a.tag != b.tag {
+			if a.tag {
+				return -1
+			}
+			return +1
+		}
+		return slices.Compare(a.index, b.index)
+	})
+
+	// Delete all fields that are hidden by the Go rules for embedded fields,
+	// except that fields with CBOR tags are promoted.
+
+	// The fields are sorted in primary order of key, secondary order
+	// of field index length. Loop over keys; for each key, delete
+	// hidden fields by choosing the one dominant field that survives.
+	out := fields[:0]
+	for advance, i := 0, 0; i < len(fields); i += advance {
+		// One iteration per key.
+		// Find the sequence of fields with the key of this first field.
+		fi := fields[i]
+		for advance = 1; i+advance < len(fields); advance++ {
+			fj := fields[i+advance]
+			if !bytes.Equal(fj.key, fi.key) {
+				break
+			}
+		}
+		if advance == 1 { // Only one field with this key
+			out = append(out, fi)
+			continue
+		}
+		dominant, ok := dominantField(fields[i : i+advance])
+		if ok {
+			out = append(out, dominant)
+		}
+	}
+	fields = out
+
+	if toArray {
+		slices.SortFunc(fields, func(i, j field) int {
+			return slices.Compare(i.index, j.index)
+		})
+	}
+
+	byKey := make(map[string]*field, len(fields))
+	for i, field := range fields {
+		byKey[string(field.key)] = &fields[i]
+	}
+	return structFields{list: fields, byKey: byKey, toArray: toArray}
+}
+
+// dominantField looks through the fields, all of which are known to
+// have the same key, to find the single field that dominates the
+// others using Go's embedding rules, modified by the presence of
+// CBOR tags. If there are multiple top-level fields, the boolean
+// will be false: This condition is an error in Go and we skip all
+// the fields.
+func dominantField(fields []field) (field, bool) {
+	// The fields are sorted in increasing index-length order, then by presence of tag.
+	// That means that the first field is the dominant one. We need only check
+	// for error cases: two fields at top level, either both tagged or neither tagged.
+	if len(fields) > 1 && len(fields[0].index) == len(fields[1].index) && fields[0].tag == fields[1].tag {
+		return field{}, false
+	}
+	return fields[0], true
+}
+
+var fieldCache sync.Map // map[reflect.Type]structFields
+
+// cachedTypeFields is like typeFields but uses a cache to avoid repeated work.
+func cachedTypeFields(t reflect.Type) structFields {
+	if f, ok := fieldCache.Load(t); ok {
+		return f.(structFields)
+	}
+	f, _ := fieldCache.LoadOrStore(t, typeFields(t))
+	return f.(structFields)
+}
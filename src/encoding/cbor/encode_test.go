@@ -0,0 +1,267 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cbor
+
+import (
+	"encoding/hex"
+	"errors"
+	"math"
+	"math/big"
+	"testing"
+	"time"
+)
+
+func mustHex(s string) []byte {
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+func bigInt(s string) *big.Int {
+	x, ok := new(big.Int).SetString(s, 10)
+	if !ok {
+		panic("bad big.Int " + s)
+	}
+	return x
+}
+
+// Examples from RFC 8949, Appendix A, restricted to those that are in the
+// preferred serialization.
+var encodeTests = []struct {
+	in   any
+	want string
+}{
+	{0, "00"},
+	{1, "01"},
+	{10, "0a"},
+	{23, "17"},
+	{24, "1818"},
+	{25, "1819"},
+	{100, "1864"},
+	{1000, "1903e8"},
+	{1000000, "1a000f4240"},
+	{uint64(1000000000000), "1b000000e8d4a51000"},
+	{uint64(18446744073709551615), "1bffffffffffffffff"},
+	{bigInt("18446744073709551616"), "c249010000000000000000"},
+	{bigInt("-18446744073709551616"), "3bffffffffffffffff"},
+	{bigInt("-18446744073709551617"), "c349010000000000000000"},
+	{-1, "20"},
+	{-10, "29"},
+	{-100, "3863"},
+	{-1000, "3903e7"},
+	{0.0, "f90000"},
+	{math.Copysign(0, -1), "f98000"},
+	{1.0, "f93c00"},
+	{1.1, "fb3ff199999999999a"},
+	{1.5, "f93e00"},
+	{65504.0, "f97bff"},
+	{100000.0, "fa47c35000"},
+	{3.4028234663852886e+38, "fa7f7fffff"},
+	{1.0e+300, "fb7e37e43c8800759c"},
+	{5.960464477539063e-8, "f90001"},
+	{0.00006103515625, "f90400"},
+	{-4.0, "f9c400"},
+	{-4.1, "fbc010666666666666"},
+	{math.Inf(1), "f97c00"},
+	{math.NaN(), "f97e00"},
+	{math.Inf(-1), "f9fc00"},
+	{float32(100000), "fa47c35000"},
+	{float32(math.NaN()), "f97e00"},
+	{false, "f4"},
+	{true, "f5"},
+	{nil, "f6"},
+	{Simple(23), "f7"},
+	{Simple(16), "f0"},
+	{Simple(255), "f8ff"},
+	{time.Date(2013, 3, 21, 20, 4, 0, 0, time.UTC), "c074323031332d30332d32315432303a30343a30305a"},
+	{Tag{23, []byte{1, 2, 3, 4}}, "d74401020304"},
+	{Tag{24, []byte("dIETF")}, "d818456449455446"},
+	{Tag{32, "http://www.example.com"}, "d82076687474703a2f2f7777772e6578616d706c652e636f6d"},
+	{[]byte{}, "40"},
+	{[]byte{1, 2, 3, 4}, "4401020304"},
+	{[4]byte{1, 2, 3, 4}, "4401020304"},
+	{"", "60"},
+	{"a", "6161"},
+	{"IETF", "6449455446"},
+	{"\"\\", "62225c"},
+	{"ü", "62c3bc"},
+	{"水", "63e6b0b4"},
+	{"\U00010151", "64f0908591"},
+	{[]int{}, "80"},
+	{[]int{1, 2, 3}, "83010203"},
+	{[]any{1, []int{2, 3}, [2]int{4, 5}}, "8301820203820405"},
+	{[]int(nil), "f6"},
+	{map[int]int{}, "a0"},
+	{map[int]int{1: 2, 3: 4}, "a201020304"},
+	{map[string]any{"a": 1, "b": []int{2, 3}}, "a26161016162820203"},
+	{[]any{"a", map[string]string{"b": "c"}}, "826161a161626163"},
+	{map[string]string{"a": "A", "b": "B", "c": "C", "d": "D", "e": "E"}, "a56161614161626142616361436164614461656145"},
+	// Keys are sorted by their encoding, not by their value.
+	{map[any]int{"aa": 1, "b": 2, 100: 3, -1: 4}, "a4" + "186403" + "2004" + "616202" + "62616101"},
+	{RawMessage(mustHex("83010203")), "83010203"},
+	{RawMessage(nil), "f6"},
+}
+
+func TestMarshal(t *testing.T) {
+	for _, tt := range encodeTests {
+		got, err := Marshal(tt.in)
+		if err != nil {
+			t.Errorf("Marshal(%#v) error: %v", tt.in, err)
+			continue
+		}
+		if want := mustHex(tt.want); string(got) != string(want) {
+			t.Errorf("Marshal(%#v) = %x, want %s", tt.in, got, tt.want)
+		}
+	}
+}
+
+type optionals struct {
+	Sr  string         `cbor:"sr"`
+	So  string         `cbor:"so,omitempty"`
+	Sw  string         `cbor:"-"`
+	Io  int            `cbor:"io,omitempty"`
+	Slo []string       `cbor:"slo,omitempty"`
+	Mo  map[string]int `cbor:"mo,omitempty"`
+	Tz  time.Time      `cbor:"tz,omitzero"`
+	Str struct{}       `cbor:"str,omitzero"`
+}
+
+func TestOmitEmpty(t *testing.T) {
+	var o optionals
+	o.Sw = "something"
+	got, err := Marshal(&o)
+	if err != nil {
+		t.Fatalf("Marshal error: %v", err)
+	}
+	if want := mustHex("a1627372" + "60"); string(got) != string(want) {
+		t.Errorf("Marshal = %x, want %x", got, want)
+	}
+}
+
+type coseKey struct {
+	Kty int    `cbor:"1,keyasint"`
+	Alg int    `cbor:"3,keyasint,omitempty"`
+	Crv int    `cbor:"-1,keyasint"`
+	X   []byte `cbor:"-2,keyasint"`
+	Y   []byte `cbor:"-3,keyasint"`
+}
+
+func TestKeyAsInt(t *testing.T) {
+	k := coseKey{Kty: 2, Alg: -7, Crv: 1, X: []byte{1}, Y: []byte{2}}
+	got, err := Marshal(k)
+	if err != nil {
+		t.Fatalf("Marshal error: %v", err)
+	}
+	// Keys in the order of their encoding: 1, 3, -1, -2, -3.
+	const want = "a5" + "0102" + "0326" + "2001" + "214101" + "224102"
+	if string(got) != string(mustHex(want)) {
+		t.Errorf("Marshal = %x, want %s", got, want)
+	}
+	var k2 coseKey
+	if err := Unmarshal(got, &k2); err != nil {
+		t.Fatalf("Unmarshal error: %v", err)
+	}
+	if k2.Kty != k.Kty || k2.Alg != k.Alg || k2.Crv != k.Crv || string(k2.X) != string(k.X) || string(k2.Y) != string(k.Y) {
+		t.Errorf("Unmarshal = %+v, want %+v", k2, k)
+	}
+}
+
+type point struct {
+	_    struct{} `cbor:",toarray"`
+	X, Y int
+	Name string
+}
+
+func TestToArray(t *testing.T) {
+	got, err := Marshal(point{X: 1, Y: -2, Name: "p"})
+	if err != nil {
+		t.Fatalf("Marshal error: %v", err)
+	}
+	const want = "83" + "01" + "21" + "6170"
+	if string(got) != string(mustHex(want)) {
+		t.Errorf("Marshal = %x, want %s", got, want)
+	}
+	var p point
+	if err := Unmarshal(got, &p); err != nil {
+		t.Fatalf("Unmarshal error: %v", err)
+	}
+	if p.X != 1 || p.Y != -2 || p.Name != "p" {
+		t.Errorf("Unmarshal = %+v", p)
+	}
+}
+
+type Embedded struct {
+	A int
+	B int `cbor:"b"`
+}
+
+type outer struct {
+	Embedded
+	C int
+	B string
+}
+
+func TestEmbedded(t *testing.T) {
+	got, err := Marshal(outer{Embedded{1, 2}, 3, "x"})
+	if err != nil {
+		t.Fatalf("Marshal error: %v", err)
+	}
+	const want = "a4" + "614101" + "614261" + "78" + "614303" + "616202"
+	if string(got) != string(mustHex(want)) {
+		t.Errorf("Marshal = %x, want %s", got, want)
+	}
+}
+
+type badMarshaler struct{}
+
+func (badMarshaler) MarshalCBOR() ([]byte, error) { return []byte{0x82, 0x01}, nil }
+
+func TestMarshalErrors(t *testing.T) {
+	tests := []struct {
+		in      any
+		wantErr any
+	}{
+		{make(chan int), new(*UnsupportedTypeError)},
+		{complex(1, 2), new(*UnsupportedTypeError)},
+		{"\xff", new(*UnsupportedValueError)},
+		{Simple(24), new(*UnsupportedValueError)},
+		{map[float64]int{math.NaN(): 1, math.NaN(): 2}, new(*UnsupportedValueError)},
+		{time.Date(10000, 1, 1, 0, 0, 0, 0, time.UTC), new(*UnsupportedValueError)},
+		{badMarshaler{}, new(*MarshalerError)},
+	}
+	for _, tt := range tests {
+		_, err := Marshal(tt.in)
+		if err == nil || !errors.As(err, tt.wantErr) {
+			t.Errorf("Marshal(%#v) error = %v, want %T", tt.in, err, tt.wantErr)
+		}
+	}
+}
+
+func TestMarshalCycle(t *testing.T) {
+	type node struct{ Next *node }
+	n := new(node)
+	n.Next = n
+	var uerr *UnsupportedValueError
+	if _, err := Marshal(n); !errors.As(err, &uerr) {
+		t.Errorf("Marshal(cycle) error = %v, want UnsupportedValueError", err)
+	}
+}
+
+func TestFloat16(t *testing.T) {
+	// Every finite half-precision value must round-trip through
+	// float16FromFloat32 and float16ToFloat64.
+	for h := range uint16(math.MaxUint16) {
+		if h&0x7c00 == 0x7c00 && h&0x3ff != 0 {
+			continue // NaN
+		}
+		f := float16ToFloat64(h)
+		got, ok := float16FromFloat32(float32(f))
+		if !ok || got != h {
+			t.Fatalf("float16FromFloat32(%v) = %#x, %v, want %#x, true", f, got, ok, h)
+		}
+	}
+}
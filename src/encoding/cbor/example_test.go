@@ -0,0 +1,59 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cbor_test
+
+import (
+	"bytes"
+	"encoding/cbor"
+	"fmt"
+	"log"
+)
+
+func ExampleMarshal() {
+	type ColorGroup struct {
+		ID     int
+		Name   string
+		Colors []string
+	}
+	group := ColorGroup{
+		ID:     1,
+		Name:   "Reds",
+		Colors: []string{"Crimson", "Red"},
+	}
+	b, err := cbor.Marshal(group)
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Printf("%x\n", b)
+	// Output:
+	// a362494401644e616d65645265647366436f6c6f727382674372696d736f6e63526564
+}
+
+func ExampleUnmarshal() {
+	// A COSE_Key (RFC 9052) uses integer map keys.
+	type Key struct {
+		Kty int    `cbor:"1,keyasint"`
+		Crv int    `cbor:"-1,keyasint"`
+		X   []byte `cbor:"-2,keyasint"`
+	}
+	data := []byte{0xa3, 0x01, 0x01, 0x20, 0x06, 0x21, 0x42, 0xca, 0xfe}
+	var k Key
+	if err := cbor.Unmarshal(data, &k); err != nil {
+		log.Fatal(err)
+	}
+	fmt.Printf("kty=%d crv=%d x=%x\n", k.Kty, k.Crv, k.X)
+	// Output:
+	// kty=1 crv=6 x=cafe
+}
+
+func ExampleDecoder_Strict() {
+	// 0x18 0x01 is a non-shortest encoding of the integer 1.
+	dec := cbor.NewDecoder(bytes.NewReader([]byte{0x18, 0x01}))
+	dec.Strict()
+	var n int
+	fmt.Println(dec.Decode(&n))
+	// Output:
+	// cbor: non-shortest encoding of argument
+}
@@ -0,0 +1,94 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cbor
+
+import (
+	"encoding/binary"
+	"math"
+)
+
+// appendFloat appends the preferred serialization of f, as defined in
+// RFC 8949, Section 4.2.2: the shortest of the half, single and double
+// precision encodings that represents f exactly. All NaNs are encoded as
+// the half-precision quiet NaN 0x7e00.
+func appendFloat(b []byte, f float64) []byte {
+	if math.IsNaN(f) {
+		return append(b, majorSimple<<5|25, 0x7e, 0x00)
+	}
+	if f32 := float32(f); float64(f32) == f {
+		return appendFloat32(b, f32)
+	}
+	b = append(b, majorSimple<<5|27)
+	return binary.BigEndian.AppendUint64(b, math.Float64bits(f))
+}
+
+func appendFloat32(b []byte, f float32) []byte {
+	if f != f {
+		return append(b, majorSimple<<5|25, 0x7e, 0x00)
+	}
+	if h, ok := float16FromFloat32(f); ok {
+		b = append(b, majorSimple<<5|25)
+		return binary.BigEndian.AppendUint16(b, h)
+	}
+	b = append(b, majorSimple<<5|26)
+	return binary.BigEndian.AppendUint32(b, math.Float32bits(f))
+}
+
+// float16FromFloat32 returns the IEEE 754 half-precision encoding of f,
+// and whether it represents f exactly. f must not be a NaN.
+func float16FromFloat32(f float32) (uint16, bool) {
+	bits := math.Float32bits(f)
+	sign := uint16(bits>>16) & 0x8000
+	exp := int(bits>>23) & 0xff
+	mant := bits & 0x7fffff
+	switch {
+	case exp == 0xff: // infinity
+		return sign | 0x7c00, true
+	case exp == 0 && mant == 0:
+		return sign, true
+	case exp == 0: // float32 subnormals are too small
+		return 0, false
+	}
+	e := exp - 127
+	switch {
+	case e >= -14 && e <= 15:
+		if mant&0x1fff != 0 {
+			return 0, false
+		}
+		return sign | uint16(e+15)<<10 | uint16(mant>>13), true
+	case e >= -24 && e < -14:
+		// Half-precision subnormal: m × 2⁻²⁴.
+		m := mant | 1<<23
+		shift := uint(-1 - e)
+		if m&(1<<shift-1) != 0 {
+			return 0, false
+		}
+		return sign | uint16(m>>shift), true
+	}
+	return 0, false
+}
+
+// float16ToFloat64 returns the value of the IEEE 754 half-precision
+// number with encoding h.
+func float16ToFloat64(h uint16) float64 {
+	exp := int(h>>10) & 0x1f
+	mant := float64(h & 0x3ff)
+	var f float64
+	switch exp {
+	case 0:
+		f = math.Ldexp(mant, -24)
+	case 0x1f:
+		if mant != 0 {
+			return math.NaN()
+		}
+		f = math.Inf(1)
+	default:
+		f = math.Ldexp(mant+0x400, exp-25)
+	}
+	if h&0x8000 != 0 {
+		f = -f
+	}
+	return f
+}
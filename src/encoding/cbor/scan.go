@@ -0,0 +1,240 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cbor
+
+// This file checks that input is well-formed CBOR, as defined in
+// RFC 8949, Appendix C, before it is decoded. Checking first means that
+// the decoder can rely on lengths and counts being consistent with the
+// size of the input, which bounds the memory it allocates.
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"math"
+	"unicode/utf8"
+)
+
+// Valid reports whether data is a single well-formed CBOR data item.
+func Valid(data []byte) bool {
+	n, err := checkValid(data, &decOpts{maxDepth: defaultMaxDepth})
+	return err == nil && n == len(data)
+}
+
+// A SyntaxError is a description of a CBOR syntax error.
+type SyntaxError struct {
+	msg    string // description of error
+	Offset int64  // error occurred after reading Offset bytes
+}
+
+func (e *SyntaxError) Error() string { return e.msg }
+
+// defaultMaxDepth is the default limit on the nesting of arrays, maps and
+// tags.
+const defaultMaxDepth = 10000
+
+// decOpts holds the options that affect decoding.
+type decOpts struct {
+	maxDepth              int
+	strict                bool
+	disallowUnknownFields bool
+}
+
+// checkValid checks that data starts with a well-formed data item, and
+// returns its length. It returns io.ErrUnexpectedEOF if data ends in the
+// middle of the item.
+func checkValid(data []byte, opts *decOpts) (int, error) {
+	s := scanner{data: data, opts: opts}
+	return s.item(0, 0)
+}
+
+type scanner struct {
+	data []byte
+	opts *decOpts
+
+	// need is the minimum length of data needed to make progress after
+	// io.ErrUnexpectedEOF is returned.
+	need int
+}
+
+func (s *scanner) eof(need int) error {
+	s.need = need
+	return io.ErrUnexpectedEOF
+}
+
+func (s *scanner) error(msg string, off int) error {
+	return &SyntaxError{"cbor: " + msg, int64(off)}
+}
+
+// head scans the initial byte and argument of the data item at off, and
+// returns them along with the offset following the head. For indefinite
+// lengths and the break code, ai is 31 and arg is 0. For floating-point
+// numbers, arg holds their bits.
+func (s *scanner) head(off int) (major, ai byte, arg uint64, end int, err error) {
+	if off >= len(s.data) {
+		return 0, 0, 0, 0, s.eof(off + 1)
+	}
+	b := s.data[off]
+	major, ai = b>>5, b&0x1f
+	end = off + 1
+	switch {
+	case ai < 24:
+		arg = uint64(ai)
+	case ai <= 27:
+		size := 1 << (ai - 24)
+		if len(s.data)-end < size {
+			return 0, 0, 0, 0, s.eof(end + size)
+		}
+		p := s.data[end:]
+		switch size {
+		case 1:
+			arg = uint64(p[0])
+		case 2:
+			arg = uint64(binary.BigEndian.Uint16(p))
+		case 4:
+			arg = uint64(binary.BigEndian.Uint32(p))
+		case 8:
+			arg = binary.BigEndian.Uint64(p)
+		}
+		end += size
+		if s.opts.strict && major != majorSimple && ai > 24 && arg <= math.MaxUint64>>(64-4*size) ||
+			s.opts.strict && major != majorSimple && ai == 24 && arg < 24 {
+			return 0, 0, 0, 0, s.error("non-shortest encoding of argument", off)
+		}
+	case ai == 31:
+		switch major {
+		case majorUint, majorNegInt, majorTag:
+			return 0, 0, 0, 0, s.error("invalid indefinite-length item", off)
+		}
+		if major != majorSimple && s.opts.strict {
+			return 0, 0, 0, 0, s.error("indefinite-length item in strict mode", off)
+		}
+	default:
+		return 0, 0, 0, 0, s.error("reserved additional information value", off)
+	}
+	return major, ai, arg, end, nil
+}
+
+// item scans the data item at off, nested depth levels deep, and returns
+// the offset following it.
+func (s *scanner) item(off, depth int) (int, error) {
+	if depth > s.opts.maxDepth {
+		return 0, s.error("exceeded max depth", off)
+	}
+	major, ai, arg, end, err := s.head(off)
+	if err != nil {
+		return 0, err
+	}
+	switch major {
+	case majorBytes, majorText:
+		if ai != 31 {
+			return s.string(major, arg, off, end)
+		}
+		for {
+			if end >= len(s.data) {
+				return 0, s.eof(end + 1)
+			}
+			if s.data[end] == 0xff {
+				return end + 1, nil
+			}
+			chunk := end
+			cmajor, cai, carg, cend, err := s.head(chunk)
+			if err != nil {
+				return 0, err
+			}
+			if cmajor != major || cai == 31 {
+				return 0, s.error("invalid chunk in indefinite-length string", chunk)
+			}
+			if end, err = s.string(major, carg, chunk, cend); err != nil {
+				return 0, err
+			}
+		}
+
+	case majorArray, majorMap:
+		var prevKey []byte
+		for i := uint64(0); ai == 31 || i < arg; i++ {
+			if ai == 31 {
+				if end >= len(s.data) {
+					return 0, s.eof(end + 1)
+				}
+				if s.data[end] == 0xff {
+					return end + 1, nil
+				}
+			}
+			key := end
+			if end, err = s.item(end, depth+1); err != nil {
+				return 0, err
+			}
+			if major == majorArray {
+				continue
+			}
+			if s.opts.strict {
+				// Keys must be sorted in bytewise lexicographic order of
+				// their encoding, which also rules out duplicate keys.
+				switch c := bytes.Compare(prevKey, s.data[key:end]); {
+				case c == 0 && prevKey != nil:
+					return 0, s.error("duplicate map key in strict mode", key)
+				case c > 0:
+					return 0, s.error("unsorted map keys in strict mode", key)
+				}
+				prevKey = s.data[key:end]
+			}
+			if end, err = s.item(end, depth+1); err != nil {
+				return 0, err
+			}
+		}
+		return end, nil
+
+	case majorTag:
+		return s.item(end, depth+1)
+
+	case majorSimple:
+		switch ai {
+		case 24:
+			if arg < 32 {
+				return 0, s.error("invalid simple value", off)
+			}
+		case 25, 26, 27:
+			if s.opts.strict && !isPreferredFloat(s.data[off:end]) {
+				return 0, s.error("non-shortest encoding of floating-point number", off)
+			}
+		case 31:
+			return 0, s.error("unexpected break", off)
+		}
+	}
+	return end, nil
+}
+
+// string scans the content of a definite-length byte or text string of
+// length n, which starts at off.
+func (s *scanner) string(major byte, n uint64, start, off int) (int, error) {
+	if uint64(len(s.data)-off) < n {
+		if n > math.MaxInt-uint64(off) {
+			return 0, s.error("string too long", start)
+		}
+		return 0, s.eof(off + int(n))
+	}
+	end := off + int(n)
+	if major == majorText && !utf8.Valid(s.data[off:end]) {
+		return 0, s.error("invalid UTF-8 in text string", start)
+	}
+	return end, nil
+}
+
+// isPreferredFloat reports whether the encoded floating-point number b is
+// in its preferred serialization, as produced by appendFloat.
+func isPreferredFloat(b []byte) bool {
+	var f float64
+	switch len(b) {
+	case 3:
+		f = float16ToFloat64(binary.BigEndian.Uint16(b[1:]))
+	case 5:
+		f = float64(math.Float32frombits(binary.BigEndian.Uint32(b[1:])))
+	default:
+		f = math.Float64frombits(binary.BigEndian.Uint64(b[1:]))
+	}
+	var buf [9]byte
+	return bytes.Equal(appendFloat(buf[:0], f), b)
+}
@@ -0,0 +1,212 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cbor
+
+import (
+	"bytes"
+	"errors"
+	"io"
+)
+
+// A Decoder reads and decodes CBOR data items from an input stream, such
+// as a CBOR sequence (RFC 8742).
+type Decoder struct {
+	r           io.Reader
+	buf         []byte
+	d           decodeState
+	scanp       int   // start of unread data in buf
+	scanned     int64 // amount of data already scanned
+	maxItemSize int
+	err         error
+}
+
+// NewDecoder returns a new decoder that reads from r.
+//
+// The decoder introduces its own buffering and may
+// read data from r beyond the CBOR data items requested.
+func NewDecoder(r io.Reader) *Decoder {
+	return &Decoder{r: r, d: decodeState{opts: decOpts{maxDepth: defaultMaxDepth}}}
+}
+
+// Strict causes the Decoder to accept only data items in the core
+// deterministic encoding produced by [Marshal], as described in RFC 8949,
+// Section 4.2.1: arguments and floating-point numbers use their shortest
+// form, indefinite-length items are not allowed, and map keys must be
+// sorted by their encoding without duplicates.
+func (dec *Decoder) Strict() { dec.d.opts.strict = true }
+
+// DisallowUnknownFields causes the Decoder to return an error when the
+// destination is a struct and the input contains map keys which do not
+// match any non-ignored, exported fields in the destination, or more
+// array elements than a struct with the "toarray" option has fields.
+func (dec *Decoder) DisallowUnknownFields() { dec.d.opts.disallowUnknownFields = true }
+
+// SetMaxDepth sets the maximum nesting depth of arrays, maps and tags
+// that the Decoder accepts. The default is 10000.
+func (dec *Decoder) SetMaxDepth(n int) { dec.d.opts.maxDepth = n }
+
+// SetMaxItemSize sets the maximum size in bytes of a single encoded data
+// item that the Decoder reads. It bounds the memory the Decoder allocates
+// for its input. A value of zero or less means no limit, which is the
+// default.
+func (dec *Decoder) SetMaxItemSize(n int) { dec.maxItemSize = n }
+
+// Decode reads the next CBOR data item from its
+// input and stores it in the value pointed to by v.
+//
+// See the documentation for [Unmarshal] for details about
+// the conversion of CBOR into a Go value.
+func (dec *Decoder) Decode(v any) error {
+	if dec.err != nil {
+		return dec.err
+	}
+
+	// Read whole data item into buffer.
+	n, err := dec.readValue()
+	if err != nil {
+		return err
+	}
+	dec.d.data = dec.buf[dec.scanp : dec.scanp+n]
+	dec.d.off = 0
+	dec.d.errorContext = nil
+	dec.d.savedError = nil
+	dec.scanp += n
+
+	// Don't save err from unmarshal into dec.err:
+	// the stream is still usable since we read a complete
+	// data item from it before the error happened.
+	return dec.d.unmarshal(v)
+}
+
+// Buffered returns a reader of the data remaining in the Decoder's
+// buffer. The reader is valid until the next call to [Decoder.Decode].
+func (dec *Decoder) Buffered() io.Reader {
+	return bytes.NewReader(dec.buf[dec.scanp:])
+}
+
+// InputOffset returns the input stream byte offset of the current decoder
+// position. The offset gives the location of the end of the most recently
+// decoded data item and the beginning of the next one.
+func (dec *Decoder) InputOffset() int64 {
+	return dec.scanned + int64(dec.scanp)
+}
+
+// readValue reads a CBOR data item into dec.buf.
+// It returns the length of the encoding.
+func (dec *Decoder) readValue() (int, error) {
+	var rerr error
+	for {
+		s := scanner{data: dec.buf[dec.scanp:], opts: &dec.d.opts}
+		n, err := s.item(0, 0)
+		if err == nil {
+			return n, nil
+		}
+		if serr, ok := err.(*SyntaxError); ok {
+			serr.Offset += dec.InputOffset()
+			dec.err = err
+			return 0, err
+		}
+		if dec.maxItemSize > 0 && s.need > dec.maxItemSize {
+			dec.err = &SyntaxError{"cbor: data item exceeds maximum size", dec.InputOffset()}
+			return 0, dec.err
+		}
+
+		// Did the last read have an error?
+		// Delayed until now to allow buffer scan.
+		if rerr != nil {
+			if rerr == io.EOF && len(dec.buf) > dec.scanp {
+				rerr = io.ErrUnexpectedEOF
+			}
+			dec.err = rerr
+			return 0, rerr
+		}
+
+		for len(dec.buf)-dec.scanp < s.need && rerr == nil {
+			rerr = dec.refill()
+		}
+	}
+}
+
+func (dec *Decoder) refill() error {
+	// Make room to read more into the buffer.
+	// First slide down data already consumed.
+	if dec.scanp > 0 {
+		dec.scanned += int64(dec.scanp)
+		n := copy(dec.buf, dec.buf[dec.scanp:])
+		dec.buf = dec.buf[:n]
+		dec.scanp = 0
+	}
+
+	// Grow buffer if not large enough. The buffer grows only as data
+	// arrives, so a length in the input that exceeds the size of the
+	// input does not cause a large allocation.
+	const minRead = 512
+	if cap(dec.buf)-len(dec.buf) < minRead {
+		newBuf := make([]byte, len(dec.buf), 2*cap(dec.buf)+minRead)
+		copy(newBuf, dec.buf)
+		dec.buf = newBuf
+	}
+
+	// Read. Delay error for next iteration (after scan).
+	n, err := dec.r.Read(dec.buf[len(dec.buf):cap(dec.buf)])
+	dec.buf = dec.buf[0 : len(dec.buf)+n]
+
+	return err
+}
+
+// An Encoder writes CBOR data items to an output stream. Successive data
+// items form a CBOR sequence (RFC 8742).
+type Encoder struct {
+	w   io.Writer
+	err error
+}
+
+// NewEncoder returns a new encoder that writes to w.
+func NewEncoder(w io.Writer) *Encoder {
+	return &Encoder{w: w}
+}
+
+// Encode writes the CBOR encoding of v to the stream.
+//
+// See the documentation for [Marshal] for details about the
+// conversion of Go values to CBOR.
+func (enc *Encoder) Encode(v any) error {
+	if enc.err != nil {
+		return enc.err
+	}
+	b, err := Marshal(v)
+	if err != nil {
+		return err
+	}
+	if _, err = enc.w.Write(b); err != nil {
+		enc.err = err
+	}
+	return err
+}
+
+// RawMessage is a raw encoded CBOR data item.
+// It implements [Marshaler] and [Unmarshaler] and can
+// be used to delay CBOR decoding or precompute a CBOR encoding.
+type RawMessage []byte
+
+// MarshalCBOR returns m as the CBOR encoding of m.
+func (m RawMessage) MarshalCBOR() ([]byte, error) {
+	if m == nil {
+		return appendNull(nil), nil
+	}
+	return m, nil
+}
+
+// UnmarshalCBOR sets *m to a copy of data.
+func (m *RawMessage) UnmarshalCBOR(data []byte) error {
+	if m == nil {
+		return errors.New("cbor.RawMessage: UnmarshalCBOR on nil pointer")
+	}
+	*m = append((*m)[0:0], data...)
+	return nil
+}
+
+var _ Marshaler = (*RawMessage)(nil)
+var _ Unmarshaler = (*RawMessage)(nil)
@@ -0,0 +1,148 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cbor
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+var streamTest = []any{
+	uint64(1),
+	"hello",
+	[]any{uint64(1), "two", 3.5},
+	map[any]any{"a": true, uint64(2): nil},
+	[]byte{0xde, 0xad},
+}
+
+func TestEncoderDecoder(t *testing.T) {
+	var buf bytes.Buffer
+	enc := NewEncoder(&buf)
+	for _, v := range streamTest {
+		if err := enc.Encode(v); err != nil {
+			t.Fatalf("Encode(%#v) error: %v", v, err)
+		}
+	}
+
+	// Read the stream one byte at a time to exercise refilling.
+	dec := NewDecoder(iotest.OneByteReader(bytes.NewReader(buf.Bytes())))
+	for i, want := range streamTest {
+		var got any
+		if err := dec.Decode(&got); err != nil {
+			t.Fatalf("Decode #%d error: %v", i, err)
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("Decode #%d = %#v, want %#v", i, got, want)
+		}
+	}
+	if off := dec.InputOffset(); off != int64(buf.Len()) {
+		t.Errorf("InputOffset = %d, want %d", off, buf.Len())
+	}
+	var v any
+	if err := dec.Decode(&v); err != io.EOF {
+		t.Errorf("Decode at end of stream error = %v, want io.EOF", err)
+	}
+}
+
+func TestDecoderUnexpectedEOF(t *testing.T) {
+	dec := NewDecoder(bytes.NewReader(mustHex("8301")))
+	var v any
+	if err := dec.Decode(&v); err != io.ErrUnexpectedEOF {
+		t.Errorf("Decode error = %v, want io.ErrUnexpectedEOF", err)
+	}
+}
+
+func TestDecoderBuffered(t *testing.T) {
+	dec := NewDecoder(bytes.NewReader(mustHex("01ffff")))
+	var v int
+	if err := dec.Decode(&v); err != nil || v != 1 {
+		t.Fatalf("Decode = %v, %v, want 1", v, err)
+	}
+	rest, _ := io.ReadAll(dec.Buffered())
+	if want := mustHex("ffff"); !bytes.Equal(rest, want) {
+		t.Errorf("Buffered = %x, want %x", rest, want)
+	}
+}
+
+func TestDecoderStrict(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string // error, or "" for valid input
+	}{
+		{"a201020304", ""},
+		{"f97e00", ""},
+		{"1817", "cbor: non-shortest encoding of argument"},
+		{"190017", "cbor: non-shortest encoding of argument"},
+		{"1a0000ffff", "cbor: non-shortest encoding of argument"},
+		{"fa3f800000", "cbor: non-shortest encoding of floating-point number"},
+		{"f97e01", "cbor: non-shortest encoding of floating-point number"},
+		{"9fff", "cbor: indefinite-length item in strict mode"},
+		{"a203040102", "cbor: unsorted map keys in strict mode"},
+		{"a201020102", "cbor: duplicate map key in strict mode"},
+	}
+	for _, tt := range tests {
+		dec := NewDecoder(bytes.NewReader(mustHex(tt.in)))
+		dec.Strict()
+		var v any
+		err := dec.Decode(&v)
+		if tt.want == "" {
+			if err != nil {
+				t.Errorf("Decode(%s) error: %v", tt.in, err)
+			}
+			continue
+		}
+		var serr *SyntaxError
+		if !errors.As(err, &serr) || err.Error() != tt.want {
+			t.Errorf("Decode(%s) error = %v, want %s", tt.in, err, tt.want)
+		}
+	}
+}
+
+func TestDecoderLimits(t *testing.T) {
+	dec := NewDecoder(bytes.NewReader(mustHex("818181818100")))
+	dec.SetMaxDepth(3)
+	var v any
+	if err := dec.Decode(&v); err == nil || err.Error() != "cbor: exceeded max depth" {
+		t.Errorf("Decode with max depth error = %v", err)
+	}
+
+	// The claimed length of the string exceeds the limit, so the
+	// decoder must fail without reading or allocating it.
+	dec = NewDecoder(strings.NewReader("\x5a\x7f\xff\xff\xff"))
+	dec.SetMaxItemSize(1 << 20)
+	if err := dec.Decode(&v); err == nil || err.Error() != "cbor: data item exceeds maximum size" {
+		t.Errorf("Decode with max item size error = %v", err)
+	}
+}
+
+func TestDecoderDisallowUnknownFields(t *testing.T) {
+	var v struct{ A int }
+	dec := NewDecoder(bytes.NewReader(mustHex("a2614101614202")))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&v); err == nil || err.Error() != `cbor: unknown field "B"` {
+		t.Errorf("Decode error = %v, want unknown field", err)
+	}
+	if v.A != 1 {
+		t.Errorf("A = %d, want 1", v.A)
+	}
+}
+
+func TestRawMessage(t *testing.T) {
+	var v struct {
+		A RawMessage
+		B int
+	}
+	if err := Unmarshal(mustHex("a26141820102614203"), &v); err != nil {
+		t.Fatalf("Unmarshal error: %v", err)
+	}
+	if want := mustHex("820102"); !bytes.Equal(v.A, want) || v.B != 3 {
+		t.Errorf("Unmarshal = %x, %d, want %x, 3", v.A, v.B, want)
+	}
+}
@@ -0,0 +1,66 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cbor
+
+import (
+	"strings"
+)
+
+// A Tag is a CBOR tagged data item (major type 6), consisting of a tag
+// number and the tag content. It is used to encode and decode tags that
+// have no more specific Go representation.
+type Tag struct {
+	Number  uint64
+	Content any
+}
+
+// A Simple is a CBOR simple value (major type 7) other than the
+// floating-point numbers. The values 20 through 23 are false, true, null and
+// undefined. The values 24 through 31 are reserved and cannot be encoded.
+type Simple uint8
+
+const (
+	SimpleFalse     Simple = 20
+	SimpleTrue      Simple = 21
+	SimpleNull      Simple = 22
+	SimpleUndefined Simple = 23
+)
+
+// Tag numbers with a predefined meaning in RFC 8949, Section 3.4.
+const (
+	tagDateTimeString = 0
+	tagEpochDateTime  = 1
+	tagPosBignum      = 2
+	tagNegBignum      = 3
+)
+
+// tagOptions is the string following a comma in a struct field's "cbor"
+// tag, or the empty string. It does not include the leading comma.
+type tagOptions string
+
+// parseTag splits a struct field's cbor tag into its name and
+// comma-separated options.
+func parseTag(tag string) (string, tagOptions) {
+	tag, opt, _ := strings.Cut(tag, ",")
+	return tag, tagOptions(opt)
+}
+
+// Contains reports whether a comma-separated list of options
+// contains a particular substr flag. substr must be surrounded by a
+// string boundary or commas.
+func (o tagOptions) Contains(optionName string) bool {
+	if len(o) == 0 {
+		return false
+	}
+	s := string(o)
+	for s != "" {
+		var name string
+		name, s, _ = strings.Cut(s, ",")
+		if name == optionName {
+			return true
+		}
+	}
+	return false
+}
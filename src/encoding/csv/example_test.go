@@ -129,3 +129,72 @@ func ExampleWriter_WriteAll() {
 	// Ken,Thompson,ken
 	// Robert,Griesemer,gri
 }
+
+func ExampleReader_All() {
+	in := `first_name,last_name,username
+"Rob","Pike",rob
+Ken,Thompson,ken
+`
+	r := csv.NewReader(strings.NewReader(in))
+
+	for record, err := range r.All() {
+		if err != nil {
+			log.Fatal(err)
+		}
+		fmt.Println(record)
+	}
+	// Output:
+	// [first_name last_name username]
+	// [Rob Pike rob]
+	// [Ken Thompson ken]
+}
+
+func ExampleDecoder() {
+	in := `username,first_name,last_name,commits
+rob,"Rob","Pike",12
+ken,Ken,Thompson,
+`
+	type User struct {
+		First    string `csv:"first_name"`
+		Last     string `csv:"last_name"`
+		Username string `csv:"username"`
+		Commits  int    `csv:"commits"`
+	}
+	d := csv.NewDecoder(csv.NewReader(strings.NewReader(in)))
+
+	for {
+		var u User
+		err := d.Decode(&u)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			log.Fatal(err)
+		}
+		fmt.Printf("%+v\n", u)
+	}
+	// Output:
+	// {First:Rob Last:Pike Username:rob Commits:12}
+	// {First:Ken Last:Thompson Username:ken Commits:0}
+}
+
+func ExampleEncoder() {
+	type User struct {
+		First    string `csv:"first_name"`
+		Last     string `csv:"last_name"`
+		Username string `csv:"username"`
+	}
+	users := []User{
+		{"Rob", "Pike", "rob"},
+		{"Ken", "Thompson", "ken"},
+	}
+
+	e := csv.NewEncoder(csv.NewWriter(os.Stdout))
+	if err := e.EncodeAll(users); err != nil {
+		log.Fatal(err)
+	}
+	// Output:
+	// first_name,last_name,username
+	// Rob,Pike,rob
+	// Ken,Thompson,ken
+}
@@ -58,6 +58,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"iter"
 	"unicode"
 	"unicode/utf8"
 )
@@ -248,6 +249,25 @@ func (r *Reader) ReadAll() (records [][]string, err error) {
 	}
 }
 
+// All returns an iterator over the remaining records in r.
+// Each iteration yields a record and the error, if any, returned by
+// [Reader.Read] for it. Iteration stops at the end of the input or
+// after the first error. As with Read, the record yielded together
+// with an [ErrFieldCount] error is complete.
+func (r *Reader) All() iter.Seq2[[]string, error] {
+	return func(yield func([]string, error) bool) {
+		for {
+			record, err := r.Read()
+			if err == io.EOF {
+				return
+			}
+			if !yield(record, err) || err != nil {
+				return
+			}
+		}
+	}
+}
+
 // readLine reads the next line (with the trailing endline).
 // If EOF is hit without a trailing endline, it will be omitted.
 // If some bytes were read, then the error is never [io.EOF].
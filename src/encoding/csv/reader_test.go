@@ -560,6 +560,34 @@ func makePositions(text string) ([][][2]int, map[int][2]int, string) {
 	return positions, errPositions, string(buf)
 }
 
+func TestReaderAll(t *testing.T) {
+	r := NewReader(strings.NewReader("a,b\nc,d\ne\nf,g\n"))
+	var got [][]string
+	var gotErr error
+	for record, err := range r.All() {
+		if err != nil {
+			gotErr = err
+			break
+		}
+		got = append(got, record)
+	}
+	if want := [][]string{{"a", "b"}, {"c", "d"}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("All() = %q, want %q", got, want)
+	}
+	if !errors.Is(gotErr, ErrFieldCount) {
+		t.Errorf("All() error = %v, want %v", gotErr, ErrFieldCount)
+	}
+
+	// Breaking out of the loop leaves the rest of the input unread.
+	r = NewReader(strings.NewReader("a\nb\nc\n"))
+	for range r.All() {
+		break
+	}
+	if rest, _ := r.ReadAll(); len(rest) != 2 {
+		t.Errorf("records after break = %q, want 2 records", rest)
+	}
+}
+
 // nTimes is an io.Reader which yields the string s n times.
 type nTimes struct {
 	s   string
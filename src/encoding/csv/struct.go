@@ -0,0 +1,521 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package csv
+
+import (
+	"encoding"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// A Decoder reads records from a CSV file that starts with a header
+// row and stores them in structs.
+//
+// Each exported struct field is mapped to the column whose header
+// matches the field name, or the name given in a "csv" struct tag.
+// A tag of "-" causes the field to be ignored. Fields of anonymous
+// struct type without a tag are treated as if their fields were
+// fields of the outer struct.
+//
+//	type Person struct {
+//		Name  string    `csv:"name"`
+//		Age   int       `csv:"age"`
+//		Born  time.Time `csv:"born"`
+//		Notes string    `csv:"-"`
+//	}
+//
+// Fields may be strings, booleans, integers, floating-point numbers,
+// types implementing [encoding.TextUnmarshaler], or pointers to any of
+// these. An empty column stores the zero value in the field; for a
+// pointer field this is nil. Columns with no matching field are ignored,
+// and fields with no matching column are left unchanged.
+type Decoder struct {
+	r      *Reader
+	header []string
+	err    error // error reading the header
+
+	typ    reflect.Type
+	fields []*field // field for each column, or nil
+}
+
+// NewDecoder returns a new Decoder that reads records from r.
+// The first record read from r is the header.
+func NewDecoder(r *Reader) *Decoder {
+	return &Decoder{r: r}
+}
+
+// Header returns the header record, reading it if
+// no record has been read yet.
+func (d *Decoder) Header() ([]string, error) {
+	if d.header == nil && d.err == nil {
+		header, err := d.r.Read()
+		if err != nil {
+			if err == io.EOF {
+				err = io.ErrUnexpectedEOF
+			}
+			d.err = err
+			return nil, err
+		}
+		// Tolerate a byte order mark, as written by some spreadsheets.
+		if len(header) > 0 {
+			header[0] = strings.TrimPrefix(header[0], "\uFEFF")
+		}
+		// The Reader may reuse the record; see [Reader.ReuseRecord].
+		d.header = slices.Clone(header)
+	}
+	return d.header, d.err
+}
+
+// Decode reads the next record and stores it in the struct pointed to by v.
+// If there are no records left, Decode returns [io.EOF].
+// A column whose value cannot be stored in its field is reported as a
+// [*ParseError] positioned at the start of the column, wrapping an
+// [*UnmarshalTypeError].
+func (d *Decoder) Decode(v any) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("csv: Decode requires a non-nil pointer to a struct, got %T", v)
+	}
+	rv = rv.Elem()
+	if _, err := d.Header(); err != nil {
+		return err
+	}
+	if rv.Type() != d.typ {
+		fields, err := cachedFields(rv.Type())
+		if err != nil {
+			return err
+		}
+		d.typ = rv.Type()
+		d.fields = make([]*field, len(d.header))
+		for i, h := range d.header {
+			f := fields.byName[h]
+			for j := range i {
+				if d.header[j] == h {
+					// The first column with a name is used.
+					f = nil
+					break
+				}
+			}
+			d.fields[i] = f
+		}
+	}
+
+	record, err := d.r.Read()
+	if err != nil {
+		return err
+	}
+	for i, s := range record {
+		if i >= len(d.fields) || d.fields[i] == nil {
+			continue
+		}
+		f := d.fields[i]
+		fv, ok := fieldByIndex(rv, f.index, true)
+		if !ok {
+			continue
+		}
+		if err := unmarshalField(fv, s); err != nil {
+			line, col := d.r.FieldPos(i)
+			start, _ := d.r.FieldPos(0)
+			return &ParseError{
+				StartLine: start,
+				Line:      line,
+				Column:    col,
+				Err: &UnmarshalTypeError{
+					Value:  s,
+					Type:   fv.Type(),
+					Header: d.header[i],
+					Err:    err,
+				},
+			}
+		}
+	}
+	return nil
+}
+
+// An UnmarshalTypeError describes a CSV value that
+// could not be stored in a struct field.
+type UnmarshalTypeError struct {
+	Value  string       // the value of the column
+	Type   reflect.Type // type of the struct field
+	Header string       // header of the column
+	Err    error        // the underlying error, if any
+}
+
+func (e *UnmarshalTypeError) Error() string {
+	s := fmt.Sprintf("cannot unmarshal %q in column %q into Go value of type %s", e.Value, e.Header, e.Type)
+	if e.Err != nil {
+		s += ": " + e.Err.Error()
+	}
+	return s
+}
+
+func (e *UnmarshalTypeError) Unwrap() error { return e.Err }
+
+// An Encoder writes structs as CSV records, preceded by a header row.
+// The mapping between struct fields and columns is described
+// in the documentation for [Decoder]; columns are written in
+// the order of the struct fields. The "omitempty" tag option causes
+// a zero value to be written as an empty column.
+//
+// Fields of types implementing [encoding.TextMarshaler] are
+// encoded with MarshalText.
+type Encoder struct {
+	w      *Writer
+	typ    reflect.Type
+	fields *structFields
+	record []string
+}
+
+// NewEncoder returns a new Encoder that writes records to w.
+func NewEncoder(w *Writer) *Encoder {
+	return &Encoder{w: w}
+}
+
+// Encode writes the struct v, or the struct v points to, as a record.
+// The first call to Encode also writes the header row. All
+// values passed to Encode must have the same type.
+// Like [Writer.Write], Encode buffers its output;
+// [Writer.Flush] must eventually be called.
+func (e *Encoder) Encode(v any) error {
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Pointer && !rv.IsNil() {
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return fmt.Errorf("csv: Encode requires a struct, got %T", v)
+	}
+	if err := e.writeHeader(rv.Type()); err != nil {
+		return err
+	}
+	if e.typ != rv.Type() {
+		return fmt.Errorf("csv: Encode of %s after %s", rv.Type(), e.typ)
+	}
+	for i, f := range e.fields.list {
+		e.record[i] = ""
+		fv, ok := fieldByIndex(rv, f.index, false)
+		if !ok || f.omitEmpty && fv.IsZero() {
+			continue
+		}
+		s, err := marshalField(fv)
+		if err != nil {
+			return fmt.Errorf("csv: encoding field %s: %w", f.name, err)
+		}
+		e.record[i] = s
+	}
+	return e.w.Write(e.record)
+}
+
+// EncodeAll writes each element of the slice v, which must
+// be a slice of structs or of pointers to structs, using
+// [Encoder.Encode] and then flushes the underlying [Writer].
+// The header row is written even if v is empty.
+func (e *Encoder) EncodeAll(v any) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+		return fmt.Errorf("csv: EncodeAll requires a slice, got %T", v)
+	}
+	t := rv.Type().Elem()
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if err := e.writeHeader(t); err != nil {
+		return err
+	}
+	for i := range rv.Len() {
+		if err := e.Encode(rv.Index(i).Interface()); err != nil {
+			return err
+		}
+	}
+	e.w.Flush()
+	return e.w.Error()
+}
+
+// writeHeader writes the header row for structs of type t,
+// unless a header has already been written.
+func (e *Encoder) writeHeader(t reflect.Type) error {
+	if e.typ != nil {
+		return nil
+	}
+	if t.Kind() != reflect.Struct {
+		return fmt.Errorf("csv: cannot encode %s", t)
+	}
+	fields, err := cachedFields(t)
+	if err != nil {
+		return err
+	}
+	header := make([]string, len(fields.list))
+	for i, f := range fields.list {
+		header[i] = f.name
+	}
+	if err := e.w.Write(header); err != nil {
+		return err
+	}
+	e.typ = t
+	e.fields = fields
+	e.record = make([]string, len(fields.list))
+	return nil
+}
+
+// A field describes a struct field mapped to a column.
+type field struct {
+	name      string
+	index     []int
+	omitEmpty bool
+}
+
+type structFields struct {
+	list   []*field
+	byName map[string]*field
+}
+
+var fieldCache sync.Map // map[reflect.Type]*structFields or error
+
+func cachedFields(t reflect.Type) (*structFields, error) {
+	if v, ok := fieldCache.Load(t); ok {
+		if err, ok := v.(error); ok {
+			return nil, err
+		}
+		return v.(*structFields), nil
+	}
+	fields, err := typeFields(t)
+	if err != nil {
+		fieldCache.LoadOrStore(t, err)
+		return nil, err
+	}
+	v, _ := fieldCache.LoadOrStore(t, fields)
+	return v.(*structFields), nil
+}
+
+var (
+	textMarshalerType   = reflect.TypeFor[encoding.TextMarshaler]()
+	textUnmarshalerType = reflect.TypeFor[encoding.TextUnmarshaler]()
+)
+
+// typeFields returns the fields of struct type t that map to columns.
+// As in encoding/json, of several fields with the same name the least
+// nested one is used, and names that are ambiguous at that depth
+// are dropped.
+func typeFields(t reflect.Type) (*structFields, error) {
+	var all []*field
+	var walk func(t reflect.Type, index []int) error
+	walk = func(t reflect.Type, index []int) error {
+		for i := range t.NumField() {
+			sf := t.Field(i)
+			tag := sf.Tag.Get("csv")
+			if tag == "-" {
+				continue
+			}
+			name, opts, _ := strings.Cut(tag, ",")
+			idx := append(index[:len(index):len(index)], i)
+			ft := sf.Type
+			if ft.Name() == "" && ft.Kind() == reflect.Pointer {
+				ft = ft.Elem()
+			}
+			if sf.Anonymous && name == "" && ft.Kind() == reflect.Struct && !isTextType(ft) {
+				if len(idx) > 8 {
+					continue
+				}
+				if err := walk(ft, idx); err != nil {
+					return err
+				}
+				continue
+			}
+			if !sf.IsExported() {
+				continue
+			}
+			if !isTextType(sf.Type) && !isScalar(sf.Type) {
+				return fmt.Errorf("csv: unsupported type %s for field %s", sf.Type, sf.Name)
+			}
+			if name == "" {
+				name = sf.Name
+			}
+			all = append(all, &field{
+				name:      name,
+				index:     idx,
+				omitEmpty: opts == "omitempty",
+			})
+		}
+		return nil
+	}
+	if err := walk(t, nil); err != nil {
+		return nil, err
+	}
+
+	fields := &structFields{byName: make(map[string]*field)}
+	for _, f := range all {
+		if _, ok := fields.byName[f.name]; ok {
+			continue
+		}
+		var dominant *field
+		ambiguous := false
+		for _, g := range all {
+			switch {
+			case g.name != f.name:
+			case dominant == nil || len(g.index) < len(dominant.index):
+				dominant, ambiguous = g, false
+			case len(g.index) == len(dominant.index):
+				ambiguous = true
+			}
+		}
+		if ambiguous {
+			fields.byName[f.name] = nil
+			continue
+		}
+		fields.byName[f.name] = dominant
+	}
+	for _, f := range all {
+		if fields.byName[f.name] == f {
+			fields.list = append(fields.list, f)
+		}
+	}
+	for name, f := range fields.byName {
+		if f == nil {
+			delete(fields.byName, name)
+		}
+	}
+	return fields, nil
+}
+
+func isTextType(t reflect.Type) bool {
+	return t.Implements(textMarshalerType) || reflect.PointerTo(t).Implements(textUnmarshalerType)
+}
+
+func isScalar(t reflect.Type) bool {
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
+		if isTextType(t) {
+			return true
+		}
+	}
+	switch t.Kind() {
+	case reflect.String, reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+	return false
+}
+
+// fieldByIndex returns the field of v with the given index sequence.
+// If alloc is set, nil embedded struct pointers are allocated;
+// otherwise fieldByIndex reports false if it finds one.
+func fieldByIndex(v reflect.Value, index []int, alloc bool) (reflect.Value, bool) {
+	for i, x := range index {
+		if i > 0 && v.Kind() == reflect.Pointer {
+			if v.IsNil() {
+				if !alloc {
+					return reflect.Value{}, false
+				}
+				if !v.CanSet() {
+					// Embedded pointer to an unexported struct type.
+					return reflect.Value{}, false
+				}
+				v.Set(reflect.New(v.Type().Elem()))
+			}
+			v = v.Elem()
+		}
+		v = v.Field(x)
+	}
+	return v, true
+}
+
+var errUnsupported = errors.New("unsupported type")
+
+func unmarshalField(v reflect.Value, s string) error {
+	if s == "" {
+		v.SetZero()
+		return nil
+	}
+	if v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			v.Set(reflect.New(v.Type().Elem()))
+		}
+		v = v.Elem()
+	}
+	if u, ok := v.Addr().Interface().(encoding.TextUnmarshaler); ok {
+		return u.UnmarshalText([]byte(s))
+	}
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(s)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return numError(err)
+		}
+		v.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(s, 10, v.Type().Bits())
+		if err != nil {
+			return numError(err)
+		}
+		v.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		n, err := strconv.ParseUint(s, 10, v.Type().Bits())
+		if err != nil {
+			return numError(err)
+		}
+		v.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		n, err := strconv.ParseFloat(s, v.Type().Bits())
+		if err != nil {
+			return numError(err)
+		}
+		v.SetFloat(n)
+	default:
+		return errUnsupported
+	}
+	return nil
+}
+
+// numError returns the underlying error of a strconv.NumError,
+// since the value being parsed is already reported by UnmarshalTypeError.
+func numError(err error) error {
+	if ne, ok := err.(*strconv.NumError); ok {
+		return ne.Err
+	}
+	return err
+}
+
+func marshalField(v reflect.Value) (string, error) {
+	if v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return "", nil
+		}
+		if !v.Type().Implements(textMarshalerType) {
+			v = v.Elem()
+		}
+	}
+	if m, ok := v.Interface().(encoding.TextMarshaler); ok {
+		b, err := m.MarshalText()
+		return string(b), err
+	}
+	if v.CanAddr() {
+		if m, ok := v.Addr().Interface().(encoding.TextMarshaler); ok {
+			b, err := m.MarshalText()
+			return string(b), err
+		}
+	}
+	switch v.Kind() {
+	case reflect.String:
+		return v.String(), nil
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool()), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return strconv.FormatUint(v.Uint(), 10), nil
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(v.Float(), 'g', -1, v.Type().Bits()), nil
+	}
+	return "", errUnsupported
+}
@@ -0,0 +1,229 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package csv
+
+import (
+	"errors"
+	"io"
+	"net/netip"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+type Meta struct {
+	Owner string `csv:"owner"`
+	Note  string `csv:"note,omitempty"`
+}
+
+type host struct {
+	Name    string     `csv:"name"`
+	Addr    netip.Addr `csv:"addr"`
+	Port    uint16     `csv:"port"`
+	Weight  float64    `csv:"weight"`
+	Enabled bool       `csv:"enabled"`
+	Backup  *int       `csv:"backup"`
+	Ignored string     `csv:"-"`
+	secret  string
+	Meta
+}
+
+var hostsCSV = `name,addr,port,weight,enabled,backup,owner,note
+alpha,10.0.0.1,80,0.5,true,,ops,
+beta,::1,8080,1,false,2,dev,spare
+`
+
+func intPtr(i int) *int { return &i }
+
+var hosts = []host{
+	{Name: "alpha", Addr: netip.MustParseAddr("10.0.0.1"), Port: 80, Weight: 0.5, Enabled: true, Meta: Meta{Owner: "ops"}},
+	{Name: "beta", Addr: netip.MustParseAddr("::1"), Port: 8080, Weight: 1, Backup: intPtr(2), Meta: Meta{Owner: "dev", Note: "spare"}},
+}
+
+func TestDecoder(t *testing.T) {
+	// Columns are matched by name, not by position.
+	in := "note,extra,port,name,owner,addr,weight,enabled,backup\r\n" +
+		",x,80,alpha,ops,10.0.0.1,0.5,true,\r\n" +
+		"spare,y,8080,beta,dev,::1,1,false,2\r\n"
+	d := NewDecoder(NewReader(strings.NewReader(in)))
+	var got []host
+	for {
+		var h host
+		err := d.Decode(&h)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		got = append(got, h)
+	}
+	if !reflect.DeepEqual(got, hosts) {
+		t.Errorf("Decode:\nhave %+v\nwant %+v", got, hosts)
+	}
+	header, err := d.Header()
+	if err != nil || len(header) != 9 || header[1] != "extra" {
+		t.Errorf("Header() = %q, %v", header, err)
+	}
+}
+
+func TestDecoderReuseRecord(t *testing.T) {
+	r := NewReader(strings.NewReader("name,port\nbob,1\nx,y\n"))
+	r.ReuseRecord = true
+	d := NewDecoder(r)
+	var h host
+	if err := d.Decode(&h); err != nil {
+		t.Fatal(err)
+	}
+	err := d.Decode(&h)
+	if want := `parse error on line 3, column 3: cannot unmarshal "y" in column "port" into Go value of type uint16: invalid syntax`; err == nil || err.Error() != want {
+		t.Errorf("Decode error:\nhave %v\nwant %s", err, want)
+	}
+	if header, err := d.Header(); err != nil || !reflect.DeepEqual(header, []string{"name", "port"}) {
+		t.Errorf("Header() = %q, %v, want [name port]", header, err)
+	}
+}
+
+func TestDecoderEmptyColumn(t *testing.T) {
+	in := "\uFEFFname,port,backup\nx,,\n"
+	d := NewDecoder(NewReader(strings.NewReader(in)))
+	h := host{Port: 1, Backup: intPtr(1)}
+	if err := d.Decode(&h); err != nil {
+		t.Fatal(err)
+	}
+	if h.Name != "x" || h.Port != 0 || h.Backup != nil {
+		t.Errorf("Decode = %+v, want name x and zero port and backup", h)
+	}
+}
+
+func TestDecoderErrors(t *testing.T) {
+	in := "name,port\nalpha,80\nbeta,\"80\n80\"\ngamma,99999\n"
+	d := NewDecoder(NewReader(strings.NewReader(in)))
+	var h host
+	if err := d.Decode(&h); err != nil {
+		t.Fatal(err)
+	}
+	err := d.Decode(&h)
+	want := &ParseError{
+		StartLine: 3,
+		Line:      3,
+		Column:    6,
+		Err: &UnmarshalTypeError{
+			Value:  "80\n80",
+			Type:   reflect.TypeFor[uint16](),
+			Header: "port",
+			Err:    strconv.ErrSyntax,
+		},
+	}
+	if !reflect.DeepEqual(err, want) {
+		t.Errorf("Decode error:\nhave %v\nwant %v", err, want)
+	}
+	err = d.Decode(&h)
+	var ute *UnmarshalTypeError
+	if !errors.As(err, &ute) || !errors.Is(err, strconv.ErrRange) {
+		t.Errorf("Decode error = %v, want out of range", err)
+	}
+	if want := `parse error on line 5, column 7: cannot unmarshal "99999" in column "port" into Go value of type uint16: value out of range`; err.Error() != want {
+		t.Errorf("Decode error:\nhave %s\nwant %s", err, want)
+	}
+
+	d = NewDecoder(NewReader(strings.NewReader("")))
+	if err := d.Decode(&h); err != io.ErrUnexpectedEOF {
+		t.Errorf("Decode of empty input error = %v, want io.ErrUnexpectedEOF", err)
+	}
+	d = NewDecoder(NewReader(strings.NewReader("name,port\na\n")))
+	if err := d.Decode(&h); !errors.Is(err, ErrFieldCount) {
+		t.Errorf("Decode of short record error = %v, want %v", err, ErrFieldCount)
+	}
+	if err := d.Decode(h); err == nil {
+		t.Errorf("Decode of non-pointer succeeded")
+	}
+	var bad struct{ Tags []string }
+	d = NewDecoder(NewReader(strings.NewReader("Tags\nx\n")))
+	if err := d.Decode(&bad); err == nil {
+		t.Errorf("Decode of unsupported field type succeeded")
+	}
+}
+
+func TestEncoder(t *testing.T) {
+	var b strings.Builder
+	e := NewEncoder(NewWriter(&b))
+	if err := e.EncodeAll(hosts); err != nil {
+		t.Fatal(err)
+	}
+	if got := b.String(); got != hostsCSV {
+		t.Errorf("EncodeAll:\nhave %s\nwant %s", got, hostsCSV)
+	}
+
+	b.Reset()
+	w := NewWriter(&b)
+	e = NewEncoder(w)
+	for i := range hosts {
+		if err := e.Encode(&hosts[i]); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := e.Encode(Meta{}); err == nil {
+		t.Errorf("Encode of different type succeeded")
+	}
+	w.Flush()
+	if got := b.String(); got != hostsCSV {
+		t.Errorf("Encode:\nhave %s\nwant %s", got, hostsCSV)
+	}
+
+	// The header is written for empty slices.
+	b.Reset()
+	if err := NewEncoder(NewWriter(&b)).EncodeAll([]*Meta{}); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := b.String(), "owner,note\n"; got != want {
+		t.Errorf("EncodeAll of empty slice = %q, want %q", got, want)
+	}
+}
+
+func TestEncoderDecoder(t *testing.T) {
+	var b strings.Builder
+	if err := NewEncoder(NewWriter(&b)).EncodeAll(hosts); err != nil {
+		t.Fatal(err)
+	}
+	d := NewDecoder(NewReader(strings.NewReader(b.String())))
+	for i, want := range hosts {
+		var h host
+		if err := d.Decode(&h); err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(h, want) {
+			t.Errorf("record %d = %+v, want %+v", i, h, want)
+		}
+	}
+}
+
+type ambiguous struct {
+	A
+	B
+	Name string
+}
+
+type A struct{ X, Name string }
+type B struct{ X string }
+
+func TestTypeFieldsDominance(t *testing.T) {
+	fields, err := typeFields(reflect.TypeFor[ambiguous]())
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, f := range fields.list {
+		names = append(names, f.name)
+	}
+	// X is ambiguous and A.Name is shadowed by Name.
+	if want := []string{"Name"}; !reflect.DeepEqual(names, want) {
+		t.Errorf("fields = %q, want %q", names, want)
+	}
+	if f := fields.byName["Name"]; f == nil || len(f.index) != 1 {
+		t.Errorf("Name field = %+v, want outer field", f)
+	}
+}
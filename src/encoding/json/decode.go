@@ -8,6 +8,7 @@
 package json
 
 import (
+	"bytes"
 	"encoding"
 	"encoding/base64"
 	"fmt"
@@ -43,9 +44,11 @@ import (
 //
 // To unmarshal JSON into a struct, Unmarshal matches incoming object
 // keys to the keys used by [Marshal] (either the struct field name or its tag),
-// preferring an exact match but also accepting a case-insensitive match. By
-// default, object keys which don't have a corresponding struct field are
-// ignored (see [Decoder.DisallowUnknownFields] for an alternative).
+// preferring an exact match but also accepting a case-insensitive match
+// (see [Decoder.CaseSensitive] for an alternative). By default, object keys
+// which don't have a corresponding struct field are ignored, unless the struct
+// has a field with the "inline" option, in which case they are stored there
+// (see [Decoder.DisallowUnknownFields] for an alternative).
 //
 // To unmarshal JSON into an interface value,
 // Unmarshal stores one of these in the interface value:
@@ -217,6 +220,7 @@ type decodeState struct {
 	savedError            error
 	useNumber             bool
 	disallowUnknownFields bool
+	caseSensitive         bool
 }
 
 // readIndex returns the position of the last byte read.
@@ -648,7 +652,11 @@ func (d *decodeState) object(v reflect.Value) error {
 		}
 	case reflect.Struct:
 		fields = cachedTypeFields(t)
-		// ok
+		if fields.inlineErr != nil {
+			d.saveError(fields.inlineErr)
+			d.skip()
+			return nil
+		}
 	default:
 		d.saveError(&UnmarshalTypeError{Value: "object", Type: t, Offset: int64(d.off)})
 		d.skip()
@@ -683,6 +691,7 @@ func (d *decodeState) object(v reflect.Value) error {
 
 		// Figure out field corresponding to key.
 		var subv reflect.Value
+		var inline *field // the inline field storing an unknown key, if any
 		destring := false // whether the value is wrapped in a string to be decoded first
 
 		if v.Kind() == reflect.Map {
@@ -695,7 +704,7 @@ func (d *decodeState) object(v reflect.Value) error {
 			subv = mapElem
 		} else {
 			f := fields.byExactName[string(key)]
-			if f == nil {
+			if f == nil && !d.caseSensitive {
 				f = fields.byFoldedName[string(foldName(key))]
 			}
 			if f != nil {
@@ -734,6 +743,8 @@ func (d *decodeState) object(v reflect.Value) error {
 				}
 				d.errorContext.Struct = t
 				d.errorContext.FieldStack = append(d.errorContext.FieldStack, f.name)
+			} else if fields.inline != nil {
+				inline = fields.inline
 			} else if d.disallowUnknownFields {
 				d.saveError(fmt.Errorf("json: unknown field %q", key))
 			}
@@ -761,6 +772,10 @@ func (d *decodeState) object(v reflect.Value) error {
 			default:
 				d.saveError(fmt.Errorf("json: invalid use of ,string struct tag, trying to unmarshal unquoted value into %v", subv.Type()))
 			}
+		} else if inline != nil {
+			if err := d.inlineValue(v, inline, item, key); err != nil {
+				return err
+			}
 		} else {
 			if err := d.value(subv); err != nil {
 				return err
@@ -831,6 +846,66 @@ func (d *decodeState) object(v reflect.Value) error {
 	return nil
 }
 
+// inlineValue decodes the next value, which belongs to the unknown object key
+// with the given quoted and unquoted forms, into the inline field f of the
+// struct v.
+func (d *decodeState) inlineValue(v reflect.Value, f *field, quotedKey, key []byte) error {
+	subv := v
+	for _, ind := range f.index {
+		if subv.Kind() == reflect.Pointer {
+			if subv.IsNil() {
+				if !subv.CanSet() {
+					d.saveError(fmt.Errorf("json: cannot set embedded pointer to unexported struct: %v", subv.Type().Elem()))
+					return d.value(reflect.Value{})
+				}
+				subv.Set(reflect.New(subv.Type().Elem()))
+			}
+			subv = subv.Elem()
+		}
+		subv = subv.Field(ind)
+	}
+
+	if f.typ == rawMessageType {
+		start := d.readIndex()
+		if err := d.value(reflect.Value{}); err != nil {
+			return err
+		}
+		raw := subv.Addr().Interface().(*RawMessage)
+		b := bytes.TrimRight(*raw, " \t\r\n")
+		switch {
+		case len(b) == 0 || string(b) == "null":
+			b = append(b[:0], '{')
+		case b[len(b)-1] == '}':
+			// Reopen the object collected so far.
+			b = bytes.TrimRight(b[:len(b)-1], " \t\r\n")
+			if b[len(b)-1] != '{' {
+				b = append(b, ',')
+			}
+		default:
+			d.saveError(&UnmarshalTypeError{Value: "object", Type: f.typ, Offset: int64(start), Field: f.name})
+			return nil
+		}
+		b = append(b, quotedKey...)
+		b = append(b, ':')
+		b = append(b, d.data[start:d.readIndex()]...)
+		*raw = append(b, '}')
+		return nil
+	}
+
+	t := f.typ
+	if subv.IsNil() {
+		subv.Set(reflect.MakeMap(t))
+	}
+	elem := reflect.New(t.Elem()).Elem()
+	if err := d.value(elem); err != nil {
+		return err
+	}
+	kv := reflect.New(t.Key()).Elem()
+	kv.SetString(string(key))
+	subv.SetMapIndex(kv, elem)
+	return nil
+}
+
 // convertNumber converts the number literal s to a float64 or a Number
 // depending on the setting of d.useNumber.
 func (d *decodeState) convertNumber(s string) (any, error) {
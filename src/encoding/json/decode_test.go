@@ -413,6 +413,26 @@ type DoublePtr struct {
 	J **int
 }
 
+type InlineMap struct {
+	A     int            `json:"a"`
+	Extra map[string]any `json:",inline"`
+}
+
+type InlineRaw struct {
+	A     int        `json:"a"`
+	Extra RawMessage `json:",inline"`
+}
+
+type InlineEmbedded struct {
+	*InlineMap
+	B string `json:"b"`
+}
+
+type CaseFields struct {
+	Name  string
+	Other string `json:"other"`
+}
+
 var unmarshalTests = []struct {
 	CaseName
 	in                    string
@@ -422,6 +442,7 @@ var unmarshalTests = []struct {
 	useNumber             bool
 	golden                bool
 	disallowUnknownFields bool
+	caseSensitive         bool
 }{
 	// basic types
 	{CaseName: Name(""), in: `true`, ptr: new(bool), out: true},
@@ -466,6 +487,19 @@ var unmarshalTests = []struct {
 	{CaseName: Name(""), in: `{"alphabet": "xyz"}`, ptr: new(U), out: U{}},
 	{CaseName: Name(""), in: `{"alphabet": "xyz"}`, ptr: new(U), err: fmt.Errorf("json: unknown field \"alphabet\""), disallowUnknownFields: true},
 
+	// inline fields collect unknown keys
+	{CaseName: Name(""), in: `{"a":1,"b":"x","c":[1,{"d":null}]}`, ptr: new(InlineMap), out: InlineMap{A: 1, Extra: map[string]any{"b": "x", "c": []any{float64(1), map[string]any{"d": nil}}}}, golden: true},
+	{CaseName: Name(""), in: `{"b":2,"a":1}`, ptr: new(InlineMap), out: InlineMap{A: 1, Extra: map[string]any{"b": float64(2)}}, disallowUnknownFields: true},
+	{CaseName: Name(""), in: `{"a":1}`, ptr: new(InlineMap), out: InlineMap{A: 1}, golden: true},
+	{CaseName: Name(""), in: `{"a":1,"b":"x","c":[1,{"d":null}]}`, ptr: new(InlineRaw), out: InlineRaw{A: 1, Extra: RawMessage(`{"b":"x","c":[1,{"d":null}]}`)}},
+	{CaseName: Name(""), in: `{"z":true,"a":1}`, ptr: new(InlineRaw), out: InlineRaw{A: 1, Extra: RawMessage(`{"z":true}`)}},
+	{CaseName: Name(""), in: `{"a":1,"b":"x","c":3}`, ptr: new(InlineEmbedded), out: InlineEmbedded{InlineMap: &InlineMap{A: 1, Extra: map[string]any{"c": float64(3)}}, B: "x"}, golden: true},
+
+	// case-sensitive field matching
+	{CaseName: Name(""), in: `{"name":"a","OTHER":"b"}`, ptr: new(CaseFields), out: CaseFields{Name: "a", Other: "b"}},
+	{CaseName: Name(""), in: `{"name":"a","OTHER":"b","Name":"c"}`, ptr: new(CaseFields), out: CaseFields{Name: "c"}, caseSensitive: true},
+	{CaseName: Name(""), in: `{"OTHER":"b"}`, ptr: new(CaseFields), err: fmt.Errorf("json: unknown field \"OTHER\""), caseSensitive: true, disallowUnknownFields: true},
+
 	// syntax errors
 	{CaseName: Name(""), in: `{"X": "foo", "Y"}`, err: &SyntaxError{"invalid character '}' after object key", 17}},
 	{CaseName: Name(""), in: `[1, 2, 3+]`, err: &SyntaxError{"invalid character '+' after array element", 9}},
@@ -1216,6 +1250,9 @@ func TestUnmarshal(t *testing.T) {
 			if tt.disallowUnknownFields {
 				dec.DisallowUnknownFields()
 			}
+			if tt.caseSensitive {
+				dec.CaseSensitive()
+			}
 			if err := dec.Decode(v.Interface()); !equalError(err, tt.err) {
 				t.Fatalf("%s: Decode error:\n\tgot:  %v\n\twant: %v", tt.Where, err, tt.err)
 			} else if err != nil {
@@ -2221,6 +2258,23 @@ func TestPrefilled(t *testing.T) {
 	}
 }
 
+func TestUnmarshalInlineRawMessageAppend(t *testing.T) {
+	v := InlineRaw{Extra: RawMessage(`{"x": 0 }`)}
+	if err := Unmarshal([]byte(`{"y":1,"z":{}}`), &v); err != nil {
+		t.Fatalf("Unmarshal error: %v", err)
+	}
+	if got, want := string(v.Extra), `{"x": 0,"y":1,"z":{}}`; got != want {
+		t.Errorf("Unmarshal:\n\tgot:  %s\n\twant: %s", got, want)
+	}
+
+	v = InlineRaw{Extra: RawMessage(`[]`)}
+	err := Unmarshal([]byte(`{"y":1}`), &v)
+	want := &UnmarshalTypeError{Value: "object", Type: reflect.TypeFor[RawMessage](), Offset: 5, Field: "Extra"}
+	if !equalError(err, want) {
+		t.Errorf("Unmarshal error:\n\tgot:  %v\n\twant: %v", err, want)
+	}
+}
+
 func TestInvalidUnmarshal(t *testing.T) {
 	buf := []byte(`{"a":"1"}`)
 	tests := []struct {
@@ -75,6 +75,29 @@ import (
 // false, 0, a nil pointer, a nil interface value, and any empty array,
 // slice, map, or string.
 //
+// The "omitzero" option specifies that the field should be omitted
+// from the encoding if the field has a zero value, according to rules:
+//
+// 1) If the field type has an "IsZero() bool" method, that will be used to
+// determine whether the value is zero.
+//
+// 2) Otherwise, the value is zero if it is the zero value for its type.
+//
+// Unlike "omitempty", "omitzero" omits zero structs and values such as
+// [time.Time] that report themselves as zero. If both "omitempty" and
+// "omitzero" are specified, the field is omitted if the value is either
+// empty or zero (or both).
+//
+// The "inline" option applies to a field of map type with string keys, or of
+// type [RawMessage]. Instead of appearing under its own key, the members of
+// such a field are written as members of the enclosing object, after all other
+// fields. A RawMessage field must hold a JSON object or null. Members must not
+// duplicate the names of other fields; Marshal returns an [UnsupportedValueError]
+// if they do. When unmarshaling, object keys which don't have a corresponding
+// struct field are stored in the inline field. At most one inline field is
+// recognized per struct type. Using the option on a field of any other type
+// causes Marshal and Unmarshal to return an [UnsupportedTypeError].
+//
 // As a special case, if the field tag is "-", the field is always omitted.
 // Note that a field with name "-" can still be generated using the tag "-,".
 //
@@ -93,6 +116,14 @@ import (
 //	// Note the leading comma.
 //	Field int `json:",omitempty"`
 //
+//	// Field appears in JSON as key "created", and is omitted
+//	// if its IsZero method reports true.
+//	Field time.Time `json:"created,omitzero"`
+//
+//	// Unknown object members are collected in Field when unmarshaling,
+//	// and written back as members of the enclosing object when marshaling.
+//	Field map[string]any `json:",inline"`
+//
 //	// Field is ignored by this package.
 //	Field int `json:"-"`
 //
@@ -681,9 +712,18 @@ type structFields struct {
 	list         []field
 	byExactName  map[string]*field
 	byFoldedName map[string]*field
+
+	// inline is the field marked with the "inline" option, if any.
+	inline *field
+
+	// inlineErr reports an "inline" option on a field of unsupported type.
+	inlineErr error
 }
 
 func (se structEncoder) encode(e *encodeState, v reflect.Value, opts encOpts) {
+	if se.fields.inlineErr != nil {
+		e.error(se.fields.inlineErr)
+	}
 	next := byte('{')
 FieldLoop:
 	for i := range se.fields.list {
@@ -701,7 +741,8 @@ FieldLoop:
 			fv = fv.Field(i)
 		}
 
-		if f.omitEmpty && isEmptyValue(fv) {
+		if (f.omitEmpty && isEmptyValue(fv)) ||
+			(f.omitZero && (f.isZero == nil && fv.IsZero() || (f.isZero != nil && f.isZero(fv)))) {
 			continue
 		}
 		e.WriteByte(next)
@@ -714,6 +755,28 @@ FieldLoop:
 		opts.quoted = f.quoted
 		f.encoder(e, fv, opts)
 	}
+	if f := se.fields.inline; f != nil {
+		if fv, ok := fieldByIndex(v, f.index); ok {
+			// Encode the inline map or RawMessage as an object, then splice
+			// its members into the enclosing object.
+			start := e.Len()
+			opts.quoted = false
+			f.encoder(e, fv, opts)
+			switch b := e.Bytes()[start:]; {
+			case string(b) == "null" || string(b) == "{}":
+				e.Truncate(start)
+			case b[0] == '{':
+				if key, ok := se.fields.duplicateKey(b); ok {
+					e.error(&UnsupportedValueError{fv, "inline field " + f.name + " duplicates field " + strconv.Quote(key)})
+				}
+				b[0] = next
+				next = ','
+				e.Truncate(e.Len() - 1)
+			default:
+				e.error(&UnsupportedValueError{fv, "inline field " + f.name + " is not a JSON object"})
+			}
+		}
+	}
 	if next == '{' {
 		e.WriteString("{}")
 	} else {
@@ -721,6 +784,46 @@ FieldLoop:
 	}
 }
 
+// duplicateKey returns the first member name of the compact JSON object b
+// that is also the name of one of the fields, if any.
+func (sf *structFields) duplicateKey(b []byte) (string, bool) {
+	scan := newScanner()
+	defer freeScanner(scan)
+	start := -1
+	for i, c := range b {
+		switch scan.step(scan, c) {
+		case scanBeginLiteral:
+			if len(scan.parseState) == 1 && scan.parseState[0] == parseObjectKey {
+				start = i
+			}
+		case scanObjectKey:
+			if len(scan.parseState) == 1 && start >= 0 {
+				key, ok := unquoteBytes(b[start:i])
+				if _, dup := sf.byExactName[string(key)]; ok && dup {
+					return string(key), true
+				}
+				start = -1
+			}
+		}
+	}
+	return "", false
+}
+
+// fieldByIndex returns the nested field of v with the given index sequence,
+// reporting false if it is reached through a nil pointer.
+func fieldByIndex(v reflect.Value, index []int) (reflect.Value, bool) {
+	for _, i := range index {
+		if v.Kind() == reflect.Pointer {
+			if v.IsNil() {
+				return reflect.Value{}, false
+			}
+			v = v.Elem()
+		}
+		v = v.Field(i)
+	}
+	return v, true
+}
+
 func newStructEncoder(t reflect.Type) encoderFunc {
 	se := structEncoder{fields: cachedTypeFields(t)}
 	return se.encode
@@ -1048,11 +1151,26 @@ type field struct {
 	index     []int
 	typ       reflect.Type
 	omitEmpty bool
+	omitZero  bool
+	isZero    func(reflect.Value) bool
 	quoted    bool
 
 	encoder encoderFunc
 }
 
+type isZeroer interface {
+	IsZero() bool
+}
+
+var isZeroerType = reflect.TypeFor[isZeroer]()
+
+// isInlineType reports whether t can be used with the "inline" option.
+func isInlineType(t reflect.Type) bool {
+	return t == rawMessageType || t.Kind() == reflect.Map && t.Key().Kind() == reflect.String
+}
+
+var rawMessageType = reflect.TypeFor[RawMessage]()
+
 // typeFields returns a list of fields that JSON should recognize for the given type.
 // The algorithm is breadth-first search over the set of structs to include - the top struct
 // and then any reachable anonymous structs.
@@ -1083,6 +1201,10 @@ func typeFields(t reflect.Type) structFields {
 	// Buffer to run appendHTMLEscape on field names.
 	var nameEscBuf []byte
 
+	// Inline fields found, and the depth at which the first was found.
+	var inlines []field
+	var inlineErr error
+
 	for len(next) > 0 {
 		current, next = next, current[:0]
 		count, nextCount = nextCount, map[reflect.Type]int{}
@@ -1142,6 +1264,23 @@ func typeFields(t reflect.Type) structFields {
 					}
 				}
 
+				// Record inline field, which is not matched by name.
+				if opts.Contains("inline") {
+					if !isInlineType(sf.Type) {
+						if inlineErr == nil {
+							inlineErr = &UnsupportedTypeError{sf.Type}
+						}
+						continue
+					}
+					if len(inlines) == 0 || len(inlines[0].index) == len(index) {
+						inlines = append(inlines, field{name: sf.Name, index: index, typ: sf.Type})
+						if count[f.typ] > 1 {
+							inlines = append(inlines, inlines[len(inlines)-1])
+						}
+					}
+					continue
+				}
+
 				// Record found field and index sequence.
 				if name != "" || !sf.Anonymous || ft.Kind() != reflect.Struct {
 					tagged := name != ""
@@ -1154,9 +1293,13 @@ func typeFields(t reflect.Type) structFields {
 						index:     index,
 						typ:       ft,
 						omitEmpty: opts.Contains("omitempty"),
+						omitZero:  opts.Contains("omitzero"),
 						quoted:    quoted,
 					}
 					field.nameBytes = []byte(field.name)
+					if field.omitZero {
+						field.isZero = isZeroFunc(sf.Type)
+					}
 
 					// Build nameEscHTML and nameNonEsc ahead of time.
 					nameEscBuf = appendHTMLEscape(nameEscBuf[:0], field.nameBytes)
@@ -1248,7 +1391,51 @@ func typeFields(t reflect.Type) structFields {
 			foldedNameIndex[string(foldName(field.nameBytes))] = &fields[i]
 		}
 	}
-	return structFields{fields, exactNameIndex, foldedNameIndex}
+	sf := structFields{list: fields, byExactName: exactNameIndex, byFoldedName: foldedNameIndex, inlineErr: inlineErr}
+
+	// As with named fields, multiple inline fields at the same
+	// least-nested level annihilate each other.
+	if len(inlines) == 1 {
+		f := inlines[0]
+		f.encoder = typeEncoder(f.typ)
+		sf.inline = &f
+	}
+	return sf
+}
+
+// isZeroFunc returns a function reporting whether a value of type t is zero
+// using its IsZero method, or nil if t has no such method.
+func isZeroFunc(t reflect.Type) func(reflect.Value) bool {
+	switch {
+	case t.Kind() == reflect.Interface && t.Implements(isZeroerType):
+		return func(v reflect.Value) bool {
+			// Avoid panics calling IsZero on a nil interface or
+			// non-nil interface with nil pointer.
+			return v.IsNil() ||
+				(v.Elem().Kind() == reflect.Pointer && v.Elem().IsNil()) ||
+				v.Interface().(isZeroer).IsZero()
+		}
+	case t.Kind() == reflect.Pointer && t.Implements(isZeroerType):
+		return func(v reflect.Value) bool {
+			// Avoid panics calling IsZero on nil pointer.
+			return v.IsNil() || v.Interface().(isZeroer).IsZero()
+		}
+	case t.Implements(isZeroerType):
+		return func(v reflect.Value) bool {
+			return v.Interface().(isZeroer).IsZero()
+		}
+	case reflect.PointerTo(t).Implements(isZeroerType):
+		return func(v reflect.Value) bool {
+			if !v.CanAddr() {
+				// Temporarily box v so we can take the address.
+				v2 := reflect.New(v.Type()).Elem()
+				v2.Set(v)
+				v = v2
+			}
+			return v.Addr().Interface().(isZeroer).IsZero()
+		}
+	}
+	return nil
 }
 
 // dominantField looks through the fields, all of which are known to
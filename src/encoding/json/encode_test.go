@@ -15,6 +15,7 @@ import (
 	"runtime/debug"
 	"strconv"
 	"testing"
+	"time"
 )
 
 type Optionals struct {
@@ -70,6 +71,169 @@ func TestOmitEmpty(t *testing.T) {
 	}
 }
 
+type NonZeroStruct struct{}
+
+func (nzs NonZeroStruct) IsZero() bool {
+	return false
+}
+
+type NoPanicStruct struct {
+	Int int `json:"int,omitzero"`
+}
+
+func (nps *NoPanicStruct) IsZero() bool {
+	return nps.Int != 0
+}
+
+type OptionalsZero struct {
+	Sr string `json:"sr"`
+	So string `json:"so,omitzero"`
+
+	Ir int `json:"omitzero"` // actually named omitzero, not an option
+	Io int `json:"io,omitzero"`
+
+	Slr       []string `json:"slr"`
+	Slo       []string `json:"slo,omitzero"`
+	SloNonNil []string `json:"slononnil,omitzero"`
+
+	Mr  map[string]any `json:"mr"`
+	Mo  map[string]any `json:",omitzero"`
+	Moe map[int]string `json:"moe,omitzero"`
+
+	Str struct{} `json:"str"`
+	Sto struct{} `json:"sto,omitzero"`
+
+	Time      time.Time     `json:"time,omitzero"`
+	TimeLocal time.Time     `json:"timelocal,omitzero"`
+	Nzs       NonZeroStruct `json:"nzs,omitzero"`
+
+	NilIsZeroer    isZeroer       `json:"niliszeroer,omitzero"`    // nil interface
+	NonNilIsZeroer isZeroer       `json:"nonniliszeroer,omitzero"` // non-nil interface
+	NoPanicStruct0 isZeroer       `json:"nps0,omitzero"`           // non-nil interface with nil pointer
+	NoPanicStruct1 isZeroer       `json:"nps1,omitzero"`           // non-nil interface with non-nil pointer
+	NoPanicStruct2 *NoPanicStruct `json:"nps2,omitzero"`           // nil pointer
+	NoPanicStruct3 *NoPanicStruct `json:"nps3,omitzero"`           // non-nil pointer
+	NoPanicStruct4 NoPanicStruct  `json:"nps4,omitzero"`           // concrete type
+
+	Both int `json:"both,omitempty,omitzero"`
+}
+
+func TestOmitZero(t *testing.T) {
+	const want = `{
+ "sr": "",
+ "omitzero": 0,
+ "slr": null,
+ "slononnil": [],
+ "mr": {},
+ "Mo": {},
+ "str": {},
+ "nzs": {},
+ "nps1": {},
+ "nps3": {},
+ "nps4": {}
+}`
+	var o OptionalsZero
+	o.Sr = ""
+	o.Mr = map[string]any{}
+	o.Mo = map[string]any{}
+	o.SloNonNil = make([]string, 0)
+	o.TimeLocal = time.Time{}.Local()
+	o.NonNilIsZeroer = time.Time{}
+	o.NoPanicStruct0 = (*NoPanicStruct)(nil)
+	o.NoPanicStruct1 = &NoPanicStruct{}
+	o.NoPanicStruct3 = &NoPanicStruct{}
+
+	got, err := MarshalIndent(&o, "", " ")
+	if err != nil {
+		t.Fatalf("MarshalIndent error: %v", err)
+	}
+	if got := string(got); got != want {
+		t.Errorf("MarshalIndent:\n\tgot:  %s\n\twant: %s\n", indentNewlines(got), indentNewlines(want))
+	}
+
+	// Values that are not addressable use IsZero on a copy.
+	got, err = Marshal(o)
+	if err != nil {
+		t.Fatalf("Marshal error: %v", err)
+	}
+	var compacted bytes.Buffer
+	Compact(&compacted, []byte(want))
+	if string(got) != compacted.String() {
+		t.Errorf("Marshal:\n\tgot:  %s\n\twant: %s", got, compacted.String())
+	}
+}
+
+func TestMarshalInline(t *testing.T) {
+	type inlineRawHTML struct {
+		A     string     `json:"a"`
+		Extra RawMessage `json:",inline"`
+	}
+	tests := []struct {
+		CaseName
+		in   any
+		want string
+	}{
+		{Name(""), InlineMap{}, `{"a":0}`},
+		{Name(""), InlineMap{Extra: map[string]any{"z": 1, "b": []int{2}}}, `{"a":0,"b":[2],"z":1}`},
+		{Name(""), &InlineRaw{A: 1, Extra: RawMessage(` { "x" : true } `)}, `{"a":1,"x":true}`},
+		{Name(""), InlineRaw{Extra: RawMessage(`null`)}, `{"a":0}`},
+		{Name(""), InlineRaw{Extra: RawMessage(`{}`)}, `{"a":0}`},
+		{Name(""), InlineEmbedded{B: "b"}, `{"b":"b"}`},
+		{Name(""), InlineEmbedded{InlineMap: &InlineMap{Extra: map[string]any{"<": ">"}}}, `{"a":0,"b":"","\u003c":"\u003e"}`},
+		{Name(""), struct {
+			Extra map[string]int `json:",inline"`
+		}{map[string]int{"k": 1}}, `{"k":1}`},
+		{Name(""), inlineRawHTML{A: "<", Extra: RawMessage(`{"<":"&"}`)}, `{"a":"\u003c","\u003c":"\u0026"}`},
+		// Multiple inline fields at the same level annihilate each other.
+		{Name(""), struct {
+			M1 map[string]int `json:",inline"`
+			M2 map[string]int `json:",inline"`
+		}{map[string]int{"a": 1}, map[string]int{"b": 2}}, `{}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			got, err := Marshal(tt.in)
+			if err != nil {
+				t.Fatalf("%s: Marshal error: %v", tt.Where, err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("%s: Marshal:\n\tgot:  %s\n\twant: %s", tt.Where, got, tt.want)
+			}
+		})
+	}
+
+	for _, in := range []any{
+		InlineRaw{Extra: RawMessage(`[1]`)},
+		InlineRaw{Extra: RawMessage(`{"x":1,"a":2}`)},
+		InlineMap{Extra: map[string]any{"a": 1}},
+		InlineEmbedded{InlineMap: &InlineMap{Extra: map[string]any{"b": 1}}},
+	} {
+		_, err := Marshal(in)
+		if _, ok := err.(*UnsupportedValueError); !ok {
+			t.Errorf("Marshal(%#v) error: got %T(%v), want *UnsupportedValueError", in, err, err)
+		}
+	}
+
+	// Keys of nested objects and case-insensitive matches do not collide.
+	got, err := Marshal(InlineRaw{Extra: RawMessage(`{"A":{"a":1}}`)})
+	if want := `{"a":0,"A":{"a":1}}`; err != nil || string(got) != want {
+		t.Errorf("Marshal:\n\tgot:  %s, %v\n\twant: %s", got, err, want)
+	}
+
+	// The inline option is an error on fields of other types.
+	type inlineSlice struct {
+		Extra []int `json:",inline"`
+	}
+	_, err = Marshal(inlineSlice{[]int{1}})
+	if _, ok := err.(*UnsupportedTypeError); !ok {
+		t.Errorf("Marshal error: got %T(%v), want *UnsupportedTypeError", err, err)
+	}
+	err = Unmarshal([]byte(`{"Extra":[1]}`), new(inlineSlice))
+	if _, ok := err.(*UnsupportedTypeError); !ok {
+		t.Errorf("Unmarshal error: got %T(%v), want *UnsupportedTypeError", err, err)
+	}
+}
+
 type StringTag struct {
 	BoolStr    bool    `json:",string"`
 	IntStr     int64   `json:",string"`
@@ -120,6 +120,41 @@ func ExampleDecoder_Token() {
 	// json.Delim: }
 }
 
+// This example uses an Encoder to write a large JSON object token by token,
+// without holding the whole document in memory.
+func ExampleEncoder_WriteToken() {
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+
+	write := func(t json.Token) {
+		if err := enc.WriteToken(t); err != nil {
+			log.Fatal(err)
+		}
+	}
+	write(json.Delim('{'))
+	write("Name")
+	write("Ed")
+	write("Primes")
+	write(json.Delim('['))
+	for _, p := range []int{2, 3, 5, 7} {
+		if err := enc.Encode(p); err != nil {
+			log.Fatal(err)
+		}
+	}
+	write(json.Delim(']'))
+	write(json.Delim('}'))
+	// Output:
+	// {
+	//   "Name": "Ed",
+	//   "Primes": [
+	//     2,
+	//     3,
+	//     5,
+	//     7
+	//   ]
+	// }
+}
+
 // This example uses a Decoder to decode a streaming array of JSON objects.
 func ExampleDecoder_Decode_stream() {
 	const jsonStream = `
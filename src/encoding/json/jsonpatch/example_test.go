@@ -0,0 +1,53 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package jsonpatch_test
+
+import (
+	"encoding/json/jsonpatch"
+	"fmt"
+	"log"
+)
+
+func ExamplePatch_Apply() {
+	doc := []byte(`{"name":"gopher","tags":["go"]}`)
+	patch, err := jsonpatch.DecodePatch([]byte(`[
+		{"op": "test", "path": "/name", "value": "gopher"},
+		{"op": "add", "path": "/tags/-", "value": "json"},
+		{"op": "replace", "path": "/name", "value": "Gopher"}
+	]`))
+	if err != nil {
+		log.Fatal(err)
+	}
+	out, err := patch.Apply(doc)
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Printf("%s\n", out)
+	// Output:
+	// {"name":"Gopher","tags":["go","json"]}
+}
+
+func ExamplePointer_Eval() {
+	doc := []byte(`{"a/b": [10, {"c": true}]}`)
+	v, err := jsonpatch.MustParsePointer("/a~1b/1/c").Eval(doc)
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Printf("%s\n", v)
+	// Output:
+	// true
+}
+
+func ExampleMergePatch() {
+	doc := []byte(`{"title":"Goodbye!","author":{"givenName":"John","familyName":"Doe"}}`)
+	patch := []byte(`{"title":"Hello!","author":{"familyName":null}}`)
+	out, err := jsonpatch.MergePatch(doc, patch)
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Printf("%s\n", out)
+	// Output:
+	// {"author":{"givenName":"John"},"title":"Hello!"}
+}
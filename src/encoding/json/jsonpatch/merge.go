@@ -0,0 +1,131 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package jsonpatch
+
+import "errors"
+
+// MergePatch applies the JSON Merge Patch patch to the JSON document doc,
+// as defined in RFC 7396, and returns the modified document.
+//
+// If patch is an object, its members are merged recursively into doc:
+// members with a null value are removed from doc, and other members are
+// added or replaced. Any other patch replaces doc entirely.
+func MergePatch(doc, patch []byte) ([]byte, error) {
+	v, err := decode(doc)
+	if err != nil {
+		return nil, err
+	}
+	p, err := decode(patch)
+	if err != nil {
+		return nil, err
+	}
+	return encode(mergePatch(v, p))
+}
+
+func mergePatch(target, patch any) any {
+	p, ok := patch.(map[string]any)
+	if !ok {
+		return patch
+	}
+	t, ok := target.(map[string]any)
+	if !ok {
+		t = make(map[string]any, len(p))
+	}
+	for k, v := range p {
+		if v == nil {
+			delete(t, k)
+		} else {
+			t[k] = mergePatch(t[k], v)
+		}
+	}
+	return t
+}
+
+// errMergeNull is returned by CreateMergePatch when modified cannot be
+// represented by a merge patch.
+var errMergeNull = errors.New("jsonpatch: merge patch cannot set an object member to null")
+
+// CreateMergePatch returns a JSON Merge Patch that transforms the JSON
+// document original into modified.
+//
+// Merge patches use null to remove object members, so they cannot express
+// setting a member to null. CreateMergePatch returns an error if modified
+// contains a null member that is not in original, or that replaces another
+// value.
+func CreateMergePatch(original, modified []byte) ([]byte, error) {
+	a, err := decode(original)
+	if err != nil {
+		return nil, err
+	}
+	b, err := decode(modified)
+	if err != nil {
+		return nil, err
+	}
+	p, err := createMergePatch(a, b)
+	if err != nil {
+		return nil, err
+	}
+	return encode(p)
+}
+
+func createMergePatch(a, b any) (any, error) {
+	bm, ok := b.(map[string]any)
+	if !ok {
+		return b, nil
+	}
+	am, ok := a.(map[string]any)
+	if !ok {
+		// The patch replaces a with an object, so it is merged into an
+		// empty object and must not contain null members.
+		if hasNullMember(bm) {
+			return nil, errMergeNull
+		}
+		return bm, nil
+	}
+	p := make(map[string]any)
+	for k := range am {
+		if _, ok := bm[k]; !ok {
+			p[k] = nil
+		}
+	}
+	for k, bv := range bm {
+		av, ok := am[k]
+		if ok && equal(av, bv) {
+			continue
+		}
+		if bv == nil {
+			return nil, errMergeNull
+		}
+		if !ok {
+			if m, isMap := bv.(map[string]any); isMap && hasNullMember(m) {
+				return nil, errMergeNull
+			}
+			p[k] = bv
+			continue
+		}
+		v, err := createMergePatch(av, bv)
+		if err != nil {
+			return nil, err
+		}
+		p[k] = v
+	}
+	return p, nil
+}
+
+// hasNullMember reports whether m or any object nested in it through
+// objects has a member with a null value.
+func hasNullMember(m map[string]any) bool {
+	for _, v := range m {
+		switch v := v.(type) {
+		case nil:
+			return true
+		case map[string]any:
+			if hasNullMember(v) {
+				return true
+			}
+		}
+	}
+	return false
+}
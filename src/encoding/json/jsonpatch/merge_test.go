@@ -0,0 +1,86 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package jsonpatch
+
+import "testing"
+
+// mergeTests are the examples from RFC 7396, Appendix A.
+var mergeTests = []struct {
+	doc, patch, want string
+}{
+	{`{"a":"b"}`, `{"a":"c"}`, `{"a":"c"}`},
+	{`{"a":"b"}`, `{"b":"c"}`, `{"a":"b","b":"c"}`},
+	{`{"a":"b"}`, `{"a":null}`, `{}`},
+	{`{"a":"b","b":"c"}`, `{"a":null}`, `{"b":"c"}`},
+	{`{"a":["b"]}`, `{"a":"c"}`, `{"a":"c"}`},
+	{`{"a":"c"}`, `{"a":["b"]}`, `{"a":["b"]}`},
+	{`{"a":{"b":"c"}}`, `{"a":{"b":"d","c":null}}`, `{"a":{"b":"d"}}`},
+	{`{"a":[{"b":"c"}]}`, `{"a":[1]}`, `{"a":[1]}`},
+	{`["a","b"]`, `["c","d"]`, `["c","d"]`},
+	{`{"a":"b"}`, `["c"]`, `["c"]`},
+	{`{"a":"foo"}`, `null`, `null`},
+	{`{"a":"foo"}`, `"bar"`, `"bar"`},
+	{`{"e":null}`, `{"a":1}`, `{"a":1,"e":null}`},
+	{`[1,2]`, `{"a":"b","c":null}`, `{"a":"b"}`},
+	{`{}`, `{"a":{"bb":{"ccc":null}}}`, `{"a":{"bb":{}}}`},
+
+	{`{"n":1}`, `{"n":0.1000000000000000000001}`, `{"n":0.1000000000000000000001}`},
+}
+
+func TestMergePatch(t *testing.T) {
+	for _, tt := range mergeTests {
+		got, err := MergePatch([]byte(tt.doc), []byte(tt.patch))
+		if err != nil {
+			t.Errorf("MergePatch(%s, %s) error: %v", tt.doc, tt.patch, err)
+			continue
+		}
+		if string(got) != tt.want {
+			t.Errorf("MergePatch(%s, %s) = %s, want %s", tt.doc, tt.patch, got, tt.want)
+		}
+	}
+}
+
+func TestCreateMergePatch(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want string // empty if an error is expected
+	}{
+		{`{"a":"b"}`, `{"a":"b"}`, `{}`},
+		{`{"a":"b","c":{"d":1,"e":2}}`, `{"a":"b","c":{"d":1.0,"f":3}}`, `{"c":{"e":null,"f":3}}`},
+		{`{"a":[1]}`, `{"a":[1,2]}`, `{"a":[1,2]}`},
+		{`[1]`, `{"a":{"b":1}}`, `{"a":{"b":1}}`},
+		{`{"a":1}`, `[1]`, `[1]`},
+		{`{"a":1}`, `null`, `null`},
+		{`{"a":1}`, `{"a":null}`, ``},
+		{`{"a":null}`, `{"a":null}`, `{}`},
+		{`{}`, `{"a":{"b":null}}`, ``},
+		{`1`, `{"a":null}`, ``},
+	}
+	for _, tt := range tests {
+		got, err := CreateMergePatch([]byte(tt.a), []byte(tt.b))
+		if tt.want == "" {
+			if err == nil {
+				t.Errorf("CreateMergePatch(%s, %s) = %s, want error", tt.a, tt.b, got)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("CreateMergePatch(%s, %s) error: %v", tt.a, tt.b, err)
+			continue
+		}
+		if string(got) != tt.want {
+			t.Errorf("CreateMergePatch(%s, %s) = %s, want %s", tt.a, tt.b, got, tt.want)
+		}
+		applied, err := MergePatch([]byte(tt.a), got)
+		if err != nil {
+			t.Fatal(err)
+		}
+		x, _ := decode(applied)
+		y, _ := decode([]byte(tt.b))
+		if !equal(x, y) {
+			t.Errorf("MergePatch(%s, CreateMergePatch(%s, %s)) = %s", tt.a, tt.a, tt.b, applied)
+		}
+	}
+}
@@ -0,0 +1,424 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package jsonpatch
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// An Operation is a single JSON Patch operation, as defined in RFC 6902,
+// Section 4.
+type Operation struct {
+	// Op is one of "add", "remove", "replace", "move", "copy" or "test".
+	Op string `json:"op"`
+
+	// Path is the JSON Pointer to the target location.
+	Path string `json:"path"`
+
+	// From is the JSON Pointer to the source location of "move" and
+	// "copy" operations.
+	From string `json:"from,omitempty"`
+
+	// Value is the value of "add", "replace" and "test" operations.
+	// A JSON null is represented by the RawMessage "null".
+	Value json.RawMessage `json:"value,omitempty"`
+}
+
+// A Patch is a JSON Patch document, a sequence of operations.
+type Patch []Operation
+
+// ErrTestFailed is returned, wrapped in an [*OperationError], when a "test"
+// operation does not match.
+var ErrTestFailed = errors.New("jsonpatch: test operation failed")
+
+// An OperationError describes a JSON Patch operation that could not be
+// applied.
+type OperationError struct {
+	Index int    // index of the operation in the Patch
+	Op    string // the operation
+	Path  string // the target location of the operation
+	Err   error
+}
+
+func (e *OperationError) Error() string {
+	return "jsonpatch: operation " + strconv.Itoa(e.Index) + " (" + e.Op + " " + strconv.Quote(e.Path) + "): " + e.Err.Error()
+}
+
+func (e *OperationError) Unwrap() error { return e.Err }
+
+// DecodePatch parses a JSON Patch document.
+func DecodePatch(data []byte) (Patch, error) {
+	var p Patch
+	if err := json.Unmarshal(data, &p); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// Apply applies the patch to the JSON document doc, and returns the modified
+// document. Operations are applied in order, and if any of them fails, Apply
+// returns an error and no document, as the patch must be applied atomically.
+func (p Patch) Apply(doc []byte) ([]byte, error) {
+	v, err := decode(doc)
+	if err != nil {
+		return nil, err
+	}
+	for i, op := range p {
+		v, err = op.apply(v)
+		if err != nil {
+			return nil, &OperationError{Index: i, Op: op.Op, Path: op.Path, Err: err}
+		}
+	}
+	return encode(v)
+}
+
+func (op *Operation) apply(doc any) (any, error) {
+	path, err := ParsePointer(op.Path)
+	if err != nil {
+		return nil, err
+	}
+	switch op.Op {
+	case "add":
+		value, err := op.value()
+		if err != nil {
+			return nil, err
+		}
+		return add(doc, path, value)
+
+	case "remove":
+		if len(path) == 0 {
+			return nil, errors.New("cannot remove the whole document")
+		}
+		doc, _, err := remove(doc, path)
+		return doc, err
+
+	case "replace":
+		value, err := op.value()
+		if err != nil {
+			return nil, err
+		}
+		if doc, _, err = remove(doc, path); err != nil {
+			return nil, err
+		}
+		return add(doc, path, value)
+
+	case "move":
+		from, err := ParsePointer(op.From)
+		if err != nil {
+			return nil, err
+		}
+		if len(path) > len(from) && slices.Equal(path[:len(from)], from) {
+			return nil, errors.New("cannot move a value into one of its children")
+		}
+		doc, value, err := remove(doc, from)
+		if err != nil {
+			return nil, err
+		}
+		return add(doc, path, value)
+
+	case "copy":
+		from, err := ParsePointer(op.From)
+		if err != nil {
+			return nil, err
+		}
+		value, err := from.Lookup(doc)
+		if err != nil {
+			return nil, err
+		}
+		return add(doc, path, deepCopy(value))
+
+	case "test":
+		value, err := op.value()
+		if err != nil {
+			return nil, err
+		}
+		target, err := path.Lookup(doc)
+		if err != nil {
+			return nil, err
+		}
+		if !equal(target, value) {
+			return nil, ErrTestFailed
+		}
+		return doc, nil
+	}
+	return nil, errors.New("unknown operation " + strconv.Quote(op.Op))
+}
+
+func (op *Operation) value() (any, error) {
+	if op.Value == nil {
+		return nil, errors.New("missing value")
+	}
+	return decode(op.Value)
+}
+
+// add adds value at path in doc, and returns the new document.
+func add(doc any, path Pointer, value any) (any, error) {
+	if len(path) == 0 {
+		return value, nil
+	}
+	return update(doc, path, func(parent any, token string) (any, error) {
+		switch parent := parent.(type) {
+		case map[string]any:
+			parent[token] = value
+			return parent, nil
+		case []any:
+			i, err := arrayIndex(token, len(parent), true)
+			if err != nil {
+				return nil, err
+			}
+			return slices.Insert(parent, i, value), nil
+		}
+		return nil, ErrNotFound
+	})
+}
+
+// remove removes the value at path in doc, and returns the new document and
+// the removed value.
+func remove(doc any, path Pointer) (newDoc, removed any, err error) {
+	if len(path) == 0 {
+		return nil, doc, nil
+	}
+	newDoc, err = update(doc, path, func(parent any, token string) (any, error) {
+		switch parent := parent.(type) {
+		case map[string]any:
+			v, ok := parent[token]
+			if !ok {
+				return nil, ErrNotFound
+			}
+			removed = v
+			delete(parent, token)
+			return parent, nil
+		case []any:
+			i, err := arrayIndex(token, len(parent), false)
+			if err != nil {
+				return nil, err
+			}
+			removed = parent[i]
+			return slices.Delete(parent, i, i+1), nil
+		}
+		return nil, ErrNotFound
+	})
+	return newDoc, removed, err
+}
+
+// update calls f with the parent of the location path refers to in doc, and
+// the last reference token of path. f returns the new parent, which replaces
+// the old one in doc. update returns the new document.
+func update(doc any, path Pointer, f func(parent any, token string) (any, error)) (any, error) {
+	parent, err := path[:len(path)-1].Lookup(doc)
+	if err != nil {
+		return nil, err
+	}
+	newParent, err := f(parent, path[len(path)-1])
+	if err != nil {
+		return nil, pointerError(path, err)
+	}
+	if len(path) == 1 {
+		return newParent, nil
+	}
+	// Arrays may have been reallocated, so store the new parent in the
+	// grandparent. Maps are updated in place.
+	grandparent, _ := path[:len(path)-2].Lookup(doc)
+	switch gp := grandparent.(type) {
+	case map[string]any:
+		gp[path[len(path)-2]] = newParent
+	case []any:
+		i, _ := arrayIndex(path[len(path)-2], len(gp), false)
+		gp[i] = newParent
+	}
+	return doc, nil
+}
+
+func deepCopy(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		m := make(map[string]any, len(v))
+		for k, e := range v {
+			m[k] = deepCopy(e)
+		}
+		return m
+	case []any:
+		s := make([]any, len(v))
+		for i, e := range v {
+			s[i] = deepCopy(e)
+		}
+		return s
+	}
+	return v
+}
+
+// equal reports whether two decoded JSON values are equal, as defined in
+// RFC 6902, Section 4.6. Numbers are equal if their values are numerically
+// equal.
+func equal(a, b any) bool {
+	switch a := a.(type) {
+	case map[string]any:
+		b, ok := b.(map[string]any)
+		if !ok || len(a) != len(b) {
+			return false
+		}
+		for k, av := range a {
+			bv, ok := b[k]
+			if !ok || !equal(av, bv) {
+				return false
+			}
+		}
+		return true
+	case []any:
+		b, ok := b.([]any)
+		return ok && slices.EqualFunc(a, b, equal)
+	case json.Number:
+		b, ok := b.(json.Number)
+		if !ok {
+			return false
+		}
+		if a == b {
+			return true
+		}
+		an, aok := normalizeNumber(string(a))
+		bn, bok := normalizeNumber(string(b))
+		return aok && bok && an == bn
+	}
+	return a == b
+}
+
+// A decimal is a JSON number in canonical form: the value is
+// ±0.digits × 10^exp, where digits has no leading or trailing zeros.
+type decimal struct {
+	neg    bool
+	digits string
+	exp    int64
+}
+
+// normalizeNumber returns the canonical form of the JSON number s, so that
+// numerically equal numbers have the same form. Unlike converting to a
+// floating-point or rational number, this is exact and takes time linear
+// in len(s), even for very large exponents.
+//
+// It reports false if the exponent of the canonical form does not fit in
+// an int64; such numbers are not equal to any number.
+func normalizeNumber(s string) (decimal, bool) {
+	var d decimal
+	if strings.HasPrefix(s, "-") {
+		d.neg = true
+		s = s[1:]
+	}
+	mant, exp, hasExp := strings.Cut(strings.ToLower(s), "e")
+	intPart, frac, _ := strings.Cut(mant, ".")
+	digits := strings.TrimLeft(intPart+frac, "0")
+	d.digits = strings.TrimRight(digits, "0")
+	if d.digits == "" {
+		return decimal{}, true // all zeros are equal, regardless of sign
+	}
+	if hasExp {
+		var err error
+		if d.exp, err = strconv.ParseInt(exp, 10, 64); err != nil {
+			return decimal{}, false
+		}
+	}
+	shift := int64(len(digits) - len(frac))
+	if shift > 0 && d.exp > math.MaxInt64-shift || shift < 0 && d.exp < math.MinInt64-shift {
+		return decimal{}, false
+	}
+	d.exp += shift
+	return d, true
+}
+
+// CreatePatch returns a patch that transforms the JSON document original
+// into modified. Applying the patch to original results in a document equal
+// to modified, as defined for the "test" operation.
+func CreatePatch(original, modified []byte) (Patch, error) {
+	a, err := decode(original)
+	if err != nil {
+		return nil, err
+	}
+	b, err := decode(modified)
+	if err != nil {
+		return nil, err
+	}
+	var p Patch
+	if err := diff(&p, Pointer{}, a, b); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+func diff(p *Patch, path Pointer, a, b any) error {
+	switch a := a.(type) {
+	case map[string]any:
+		b, ok := b.(map[string]any)
+		if !ok {
+			break
+		}
+		for _, k := range sortedKeys(a) {
+			if _, ok := b[k]; !ok {
+				*p = append(*p, Operation{Op: "remove", Path: path.Append(k).String()})
+			}
+		}
+		for _, k := range sortedKeys(b) {
+			if av, ok := a[k]; ok {
+				if err := diff(p, path.Append(k), av, b[k]); err != nil {
+					return err
+				}
+				continue
+			}
+			if err := p.appendValueOp("add", path.Append(k), b[k]); err != nil {
+				return err
+			}
+		}
+		return nil
+
+	case []any:
+		b, ok := b.([]any)
+		if !ok {
+			break
+		}
+		n := min(len(a), len(b))
+		for i := range n {
+			if err := diff(p, path.Append(strconv.Itoa(i)), a[i], b[i]); err != nil {
+				return err
+			}
+		}
+		// Remove trailing elements from the end, so that indexes
+		// remain valid.
+		for i := len(a) - 1; i >= n; i-- {
+			*p = append(*p, Operation{Op: "remove", Path: path.Append(strconv.Itoa(i)).String()})
+		}
+		for i := n; i < len(b); i++ {
+			if err := p.appendValueOp("add", path.Append("-"), b[i]); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if equal(a, b) {
+		return nil
+	}
+	return p.appendValueOp("replace", path, b)
+}
+
+func (p *Patch) appendValueOp(op string, path Pointer, v any) error {
+	value, err := encode(v)
+	if err != nil {
+		return fmt.Errorf("jsonpatch: encoding value at %q: %w", path.String(), err)
+	}
+	*p = append(*p, Operation{Op: op, Path: path.String(), Value: value})
+	return nil
+}
+
+func sortedKeys(m map[string]any) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	return keys
+}
@@ -0,0 +1,198 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package jsonpatch
+
+import (
+	"errors"
+	"testing"
+)
+
+// patchTests are the examples from RFC 6902, Appendix A, plus additional
+// cases.
+var patchTests = []struct {
+	name  string
+	doc   string
+	patch string
+	want  string // empty if the patch must fail
+}{
+	{"A.1", `{"foo":"bar"}`, `[{"op":"add","path":"/baz","value":"qux"}]`, `{"baz":"qux","foo":"bar"}`},
+	{"A.2", `{"foo":["bar","baz"]}`, `[{"op":"add","path":"/foo/1","value":"qux"}]`, `{"foo":["bar","qux","baz"]}`},
+	{"A.3", `{"baz":"qux","foo":"bar"}`, `[{"op":"remove","path":"/baz"}]`, `{"foo":"bar"}`},
+	{"A.4", `{"foo":["bar","qux","baz"]}`, `[{"op":"remove","path":"/foo/1"}]`, `{"foo":["bar","baz"]}`},
+	{"A.5", `{"baz":"qux","foo":"bar"}`, `[{"op":"replace","path":"/baz","value":"boo"}]`, `{"baz":"boo","foo":"bar"}`},
+	{"A.6", `{"foo":{"bar":"baz","waldo":"fred"},"qux":{"corge":"grault"}}`,
+		`[{"op":"move","from":"/foo/waldo","path":"/qux/thud"}]`,
+		`{"foo":{"bar":"baz"},"qux":{"corge":"grault","thud":"fred"}}`},
+	{"A.7", `{"foo":["all","grass","cows","eat"]}`, `[{"op":"move","from":"/foo/1","path":"/foo/3"}]`, `{"foo":["all","cows","eat","grass"]}`},
+	{"A.8", `{"baz":"qux","foo":["a",2,"c"]}`,
+		`[{"op":"test","path":"/baz","value":"qux"},{"op":"test","path":"/foo/1","value":2}]`,
+		`{"baz":"qux","foo":["a",2,"c"]}`},
+	{"A.9", `{"baz":"qux"}`, `[{"op":"test","path":"/baz","value":"bar"}]`, ``},
+	{"A.10", `{"foo":"bar"}`, `[{"op":"add","path":"/child","value":{"grandchild":{}}}]`, `{"child":{"grandchild":{}},"foo":"bar"}`},
+	{"A.11", `{"foo":"bar"}`, `[{"op":"add","path":"/baz","value":"qux","xyz":123}]`, `{"baz":"qux","foo":"bar"}`},
+	{"A.12", `{"foo":"bar"}`, `[{"op":"add","path":"/baz/bat","value":"qux"}]`, ``},
+	{"A.14", `{"/":9,"~1":10}`, `[{"op":"test","path":"/~01","value":10}]`, `{"/":9,"~1":10}`},
+	{"A.15", `{"/":9,"~1":10}`, `[{"op":"test","path":"/~01","value":"10"}]`, ``},
+	{"A.16", `{"foo":["bar"]}`, `[{"op":"add","path":"/foo/-","value":["abc","def"]}]`, `{"foo":["bar",["abc","def"]]}`},
+
+	{"replace root", `{"a":1}`, `[{"op":"replace","path":"","value":[1]}]`, `[1]`},
+	{"add root", `{"a":1}`, `[{"op":"add","path":"","value":null}]`, `null`},
+	{"remove root", `{"a":1}`, `[{"op":"remove","path":""}]`, ``},
+	{"add null", `{}`, `[{"op":"add","path":"/a","value":null}]`, `{"a":null}`},
+	{"add missing value", `{}`, `[{"op":"add","path":"/a"}]`, ``},
+	{"add past end", `[1]`, `[{"op":"add","path":"/2","value":3}]`, ``},
+	{"add at end", `[1]`, `[{"op":"add","path":"/1","value":3}]`, `[1,3]`},
+	{"add to scalar", `{"a":1}`, `[{"op":"add","path":"/a/b","value":3}]`, ``},
+	{"remove missing", `{"a":1}`, `[{"op":"remove","path":"/b"}]`, ``},
+	{"replace missing", `{"a":1}`, `[{"op":"replace","path":"/b","value":2}]`, ``},
+	{"nested arrays", `[[1],[2,[3]]]`, `[{"op":"add","path":"/1/1/0","value":0},{"op":"remove","path":"/0/0"}]`, `[[],[2,[0,3]]]`},
+	{"move into child", `{"a":{"b":1}}`, `[{"op":"move","from":"/a","path":"/a/c"}]`, ``},
+	{"move to self", `{"a":{"b":1}}`, `[{"op":"move","from":"/a","path":"/a"}]`, `{"a":{"b":1}}`},
+	{"move to sibling prefix", `{"a":1}`, `[{"op":"move","from":"/a","path":"/ab"}]`, `{"ab":1}`},
+	{"copy is deep", `{"a":{"b":[1]}}`,
+		`[{"op":"copy","from":"/a","path":"/c"},{"op":"add","path":"/c/b/-","value":2}]`,
+		`{"a":{"b":[1]},"c":{"b":[1,2]}}`},
+	{"unknown op", `{}`, `[{"op":"frob","path":""}]`, ``},
+	{"bad path", `{}`, `[{"op":"add","path":"a","value":1}]`, ``},
+	{"atomic", `{"a":1}`, `[{"op":"remove","path":"/a"},{"op":"test","path":"/a","value":1}]`, ``},
+
+	// Numbers keep their precision and compare numerically.
+	{"big number", `{"n":12345678901234567890.123456789}`,
+		`[{"op":"copy","from":"/n","path":"/m"}]`,
+		`{"m":12345678901234567890.123456789,"n":12345678901234567890.123456789}`},
+	{"number equality", `[1, 100, 0.01, -0, 1e400]`,
+		`[{"op":"test","path":"","value":[1.0, 1e2, 1E-2, 0.0, 10e399]}]`,
+		`[1,100,0.01,-0,1e400]`},
+	{"number inequality", `[12345678901234567890]`, `[{"op":"test","path":"/0","value":12345678901234567891}]`, ``},
+	{"exponent overflow", `[1e9223372036854775807]`, `[{"op":"test","path":"/0","value":0.1e-9223372036854775808}]`, ``},
+	{"no html escaping", `{"a":"<&>"}`, `[]`, `{"a":"<&>"}`},
+}
+
+func TestApply(t *testing.T) {
+	for _, tt := range patchTests {
+		p, err := DecodePatch([]byte(tt.patch))
+		if err != nil {
+			t.Fatalf("%s: DecodePatch error: %v", tt.name, err)
+		}
+		got, err := p.Apply([]byte(tt.doc))
+		if tt.want == "" {
+			if err == nil {
+				t.Errorf("%s: Apply = %s, want error", tt.name, got)
+			} else if _, ok := err.(*OperationError); !ok {
+				t.Errorf("%s: Apply error %T(%v), want *OperationError", tt.name, err, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: Apply error: %v", tt.name, err)
+			continue
+		}
+		if string(got) != tt.want {
+			t.Errorf("%s: Apply:\n\tgot:  %s\n\twant: %s", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestApplyErrors(t *testing.T) {
+	p := Patch{{Op: "test", Path: "/a", Value: []byte(`2`)}}
+	_, err := p.Apply([]byte(`{"a":1}`))
+	if !errors.Is(err, ErrTestFailed) {
+		t.Errorf("Apply error = %v, want ErrTestFailed", err)
+	}
+
+	p = Patch{{Op: "add", Path: "/a", Value: []byte(`1`)}, {Op: "remove", Path: "/b/c"}}
+	_, err = p.Apply([]byte(`{}`))
+	var oe *OperationError
+	if !errors.As(err, &oe) || oe.Index != 1 || !errors.Is(err, ErrNotFound) {
+		t.Errorf("Apply error = %v, want *OperationError at index 1 wrapping ErrNotFound", err)
+	}
+
+	if _, err := (Patch{}).Apply([]byte(`{} {}`)); err == nil {
+		t.Errorf("Apply on document with trailing data succeeded")
+	}
+}
+
+func TestCreatePatch(t *testing.T) {
+	tests := []struct {
+		a, b string
+	}{
+		{`{}`, `{}`},
+		{`{"a":1}`, `{"a":1.0}`},
+		{`{"a":1,"b":{"c":[1,2,3]}}`, `{"a":2,"b":{"c":[1,5]},"d":null}`},
+		{`{"a":[1]}`, `{"a":[1,{"x":"y"},[2]]}`},
+		{`[1,2,3,4]`, `[]`},
+		{`{"a":{"b":1}}`, `{"a":[1]}`},
+		{`1`, `"one"`},
+		{`{"a/b":{"~":1}}`, `{"a/b":{"~":2}}`},
+		{`{"n":1.000000000000000000001}`, `{"n":1.000000000000000000002}`},
+	}
+	for _, tt := range tests {
+		p, err := CreatePatch([]byte(tt.a), []byte(tt.b))
+		if err != nil {
+			t.Errorf("CreatePatch(%s, %s) error: %v", tt.a, tt.b, err)
+			continue
+		}
+		got, err := p.Apply([]byte(tt.a))
+		if err != nil {
+			t.Errorf("CreatePatch(%s, %s) = %v, Apply error: %v", tt.a, tt.b, p, err)
+			continue
+		}
+		x, _ := decode(got)
+		y, _ := decode([]byte(tt.b))
+		if !equal(x, y) {
+			t.Errorf("CreatePatch(%s, %s) = %v, Apply = %s", tt.a, tt.b, p, got)
+		}
+		if equal(mustDecode(t, tt.a), y) && len(p) != 0 {
+			t.Errorf("CreatePatch(%s, %s) = %v, want empty patch", tt.a, tt.b, p)
+		}
+	}
+}
+
+func mustDecode(t *testing.T, s string) any {
+	t.Helper()
+	v, err := decode([]byte(s))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return v
+}
+
+func TestNormalizeNumber(t *testing.T) {
+	groups := [][]string{
+		{"0", "-0", "0.0", "0e10", "-0.000E-5", "0e99999999999999999999"},
+		{"1", "1.0", "10e-1", "0.1E1", "100e-2"},
+		{"-123.45", "-12345e-2", "-1.2345E2"},
+		{"1e9223372036854775806", "10e9223372036854775805"},
+		{"0.1e-9223372036854775808"},
+		{"12345678901234567890123"},
+		{"12345678901234567890124"},
+	}
+	for i, g := range groups {
+		for _, a := range g {
+			da, ok := normalizeNumber(a)
+			if !ok {
+				t.Fatalf("normalizeNumber(%q) failed", a)
+			}
+			for j, h := range groups {
+				for _, b := range h {
+					db, _ := normalizeNumber(b)
+					if (da == db) != (i == j) {
+						t.Errorf("normalizeNumber(%q) == normalizeNumber(%q) is %v", a, b, da == db)
+					}
+				}
+			}
+		}
+	}
+	for _, s := range []string{
+		"1e99999999999999999999",
+		"1e9223372036854775807",
+		"10e9223372036854775806",
+		"0.01e-9223372036854775808",
+	} {
+		if _, ok := normalizeNumber(s); ok {
+			t.Errorf("normalizeNumber(%q) accepted an exponent overflowing int64", s)
+		}
+	}
+}
@@ -0,0 +1,238 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package jsonpatch implements JSON Pointer (RFC 6901), JSON Patch (RFC 6902)
+// and JSON Merge Patch (RFC 7396).
+//
+// Functions in this package operate on raw JSON documents. Documents are
+// decoded with [json.Decoder.UseNumber], so that numbers keep their original
+// precision, and re-encoded without HTML escaping. As with [json.Marshal],
+// object members are written in sorted key order, and insignificant
+// whitespace is not preserved, except in values returned unchanged by
+// [Pointer.Eval].
+package jsonpatch
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// A Pointer is a parsed JSON Pointer, as defined in RFC 6901. It holds the
+// unescaped reference tokens of the pointer. The empty Pointer refers to the
+// whole document.
+type Pointer []string
+
+// ErrNotFound is returned, possibly wrapped, when a Pointer does not refer
+// to an existing value.
+var ErrNotFound = errors.New("jsonpatch: value not found")
+
+// ParsePointer parses a JSON Pointer in its string representation, such as
+// "/a~1b/0". The empty string is the pointer to the whole document.
+func ParsePointer(s string) (Pointer, error) {
+	if s == "" {
+		return Pointer{}, nil
+	}
+	if s[0] != '/' {
+		return nil, errors.New("jsonpatch: invalid JSON pointer " + strconv.Quote(s) + ": must start with /")
+	}
+	tokens := strings.Split(s[1:], "/")
+	for i, t := range tokens {
+		if !strings.Contains(t, "~") {
+			continue
+		}
+		// Decode ~1 before ~0, so that "~01" becomes "~1" and not "/".
+		for j := 0; j < len(t); j++ {
+			if t[j] == '~' && (j+1 == len(t) || t[j+1] != '0' && t[j+1] != '1') {
+				return nil, errors.New("jsonpatch: invalid JSON pointer " + strconv.Quote(s) + ": bad escape sequence")
+			}
+		}
+		tokens[i] = strings.ReplaceAll(strings.ReplaceAll(t, "~1", "/"), "~0", "~")
+	}
+	return Pointer(tokens), nil
+}
+
+// MustParsePointer is like [ParsePointer] but panics if s cannot be parsed.
+func MustParsePointer(s string) Pointer {
+	p, err := ParsePointer(s)
+	if err != nil {
+		panic(err)
+	}
+	return p
+}
+
+// String returns the string representation of p, escaping "~" and "/"
+// in reference tokens.
+func (p Pointer) String() string {
+	var b strings.Builder
+	for _, t := range p {
+		b.WriteByte('/')
+		if strings.ContainsAny(t, "~/") {
+			t = strings.ReplaceAll(strings.ReplaceAll(t, "~", "~0"), "/", "~1")
+		}
+		b.WriteString(t)
+	}
+	return b.String()
+}
+
+// Append returns a new Pointer referring to the member or element named
+// token of the value p refers to.
+func (p Pointer) Append(token string) Pointer {
+	q := make(Pointer, len(p), len(p)+1)
+	copy(q, p)
+	return append(q, token)
+}
+
+// Eval returns the raw JSON value that p refers to in doc.
+// The value is returned exactly as it appears in doc.
+func (p Pointer) Eval(doc []byte) (json.RawMessage, error) {
+	v := json.RawMessage(bytes.TrimSpace(doc))
+	for i, t := range p {
+		var err error
+		v, err = evalToken(v, t)
+		if err != nil {
+			return nil, pointerError(p[:i+1], err)
+		}
+	}
+	if !json.Valid(v) {
+		return nil, errors.New("jsonpatch: invalid JSON document")
+	}
+	return v, nil
+}
+
+func evalToken(v json.RawMessage, token string) (json.RawMessage, error) {
+	switch firstByte(v) {
+	case '{':
+		var obj map[string]json.RawMessage
+		if err := json.Unmarshal(v, &obj); err != nil {
+			return nil, err
+		}
+		m, ok := obj[token]
+		if !ok {
+			return nil, ErrNotFound
+		}
+		return m, nil
+	case '[':
+		var arr []json.RawMessage
+		if err := json.Unmarshal(v, &arr); err != nil {
+			return nil, err
+		}
+		i, err := arrayIndex(token, len(arr), false)
+		if err != nil {
+			return nil, err
+		}
+		return arr[i], nil
+	}
+	return nil, ErrNotFound
+}
+
+func firstByte(b []byte) byte {
+	b = bytes.TrimLeft(b, " \t\r\n")
+	if len(b) == 0 {
+		return 0
+	}
+	return b[0]
+}
+
+// Lookup returns the value that p refers to in v, which must be composed of
+// the types produced by decoding JSON into an empty interface value, such as
+// map[string]any and []any.
+func (p Pointer) Lookup(v any) (any, error) {
+	for i, t := range p {
+		var err error
+		v, err = get(v, t)
+		if err != nil {
+			return nil, pointerError(p[:i+1], err)
+		}
+	}
+	return v, nil
+}
+
+func get(v any, token string) (any, error) {
+	switch v := v.(type) {
+	case map[string]any:
+		m, ok := v[token]
+		if !ok {
+			return nil, ErrNotFound
+		}
+		return m, nil
+	case []any:
+		i, err := arrayIndex(token, len(v), false)
+		if err != nil {
+			return nil, err
+		}
+		return v[i], nil
+	}
+	return nil, ErrNotFound
+}
+
+// arrayIndex parses token as an index into an array of length n. If past is
+// true, the index n, which can also be written as "-", is allowed.
+func arrayIndex(token string, n int, past bool) (int, error) {
+	if token == "-" {
+		if past {
+			return n, nil
+		}
+		return 0, ErrNotFound
+	}
+	// RFC 6901 does not allow leading zeros or signs.
+	if token == "" || len(token) > 1 && token[0] == '0' || token[0] < '0' || token[0] > '9' {
+		return 0, errors.New("jsonpatch: invalid array index " + strconv.Quote(token))
+	}
+	i, err := strconv.Atoi(token)
+	if err != nil {
+		return 0, errors.New("jsonpatch: invalid array index " + strconv.Quote(token))
+	}
+	if i > n || i == n && !past {
+		return 0, ErrNotFound
+	}
+	return i, nil
+}
+
+func pointerError(p Pointer, err error) error {
+	if err == ErrNotFound {
+		return &PointerError{Pointer: p.String(), Err: err}
+	}
+	return err
+}
+
+// A PointerError records a JSON Pointer that could not be resolved.
+type PointerError struct {
+	Pointer string // the unresolved prefix of the pointer
+	Err     error
+}
+
+func (e *PointerError) Error() string {
+	return "jsonpatch: " + strconv.Quote(e.Pointer) + ": " + strings.TrimPrefix(e.Err.Error(), "jsonpatch: ")
+}
+
+func (e *PointerError) Unwrap() error { return e.Err }
+
+// decode decodes a JSON document, preserving numbers as json.Number.
+func decode(doc []byte) (any, error) {
+	dec := json.NewDecoder(bytes.NewReader(doc))
+	dec.UseNumber()
+	var v any
+	if err := dec.Decode(&v); err != nil {
+		return nil, err
+	}
+	if _, err := dec.Token(); err != io.EOF {
+		return nil, errors.New("jsonpatch: invalid JSON document: trailing data")
+	}
+	return v, nil
+}
+
+// encode encodes v without escaping HTML characters.
+func encode(v any) ([]byte, error) {
+	var b bytes.Buffer
+	enc := json.NewEncoder(&b)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(v); err != nil {
+		return nil, err
+	}
+	return bytes.TrimSuffix(b.Bytes(), []byte("\n")), nil
+}
@@ -0,0 +1,116 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package jsonpatch
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+// rfc6901Doc is the example document from RFC 6901, Section 5.
+const rfc6901Doc = `{
+	"foo": ["bar", "baz"],
+	"": 0,
+	"a/b": 1,
+	"c%d": 2,
+	"e^f": 3,
+	"g|h": 4,
+	"i\\j": 5,
+	"k\"l": 6,
+	" ": 7,
+	"m~n": 8
+}`
+
+func TestPointerEval(t *testing.T) {
+	tests := []struct {
+		pointer string
+		want    string
+	}{
+		{"", rfc6901Doc},
+		{"/foo", `["bar", "baz"]`},
+		{"/foo/0", `"bar"`},
+		{"/", `0`},
+		{"/a~1b", `1`},
+		{"/c%d", `2`},
+		{"/e^f", `3`},
+		{"/g|h", `4`},
+		{"/i\\j", `5`},
+		{"/k\"l", `6`},
+		{"/ ", `7`},
+		{"/m~0n", `8`},
+	}
+	for _, tt := range tests {
+		p, err := ParsePointer(tt.pointer)
+		if err != nil {
+			t.Fatalf("ParsePointer(%q) error: %v", tt.pointer, err)
+		}
+		if s := p.String(); s != tt.pointer {
+			t.Errorf("ParsePointer(%q).String() = %q", tt.pointer, s)
+		}
+		got, err := p.Eval([]byte(rfc6901Doc))
+		if err != nil {
+			t.Errorf("Eval(%q) error: %v", tt.pointer, err)
+			continue
+		}
+		if string(got) != tt.want {
+			t.Errorf("Eval(%q) = %s, want %s", tt.pointer, got, tt.want)
+		}
+	}
+}
+
+func TestPointerErrors(t *testing.T) {
+	for _, s := range []string{"foo", "/~", "/a~2", "/~a"} {
+		if _, err := ParsePointer(s); err == nil {
+			t.Errorf("ParsePointer(%q) succeeded, want error", s)
+		}
+	}
+
+	for _, s := range []string{"/bar", "/foo/2", "/foo/-", "/foo/0/x", "/a~1b/c"} {
+		_, err := MustParsePointer(s).Eval([]byte(rfc6901Doc))
+		if !errors.Is(err, ErrNotFound) {
+			t.Errorf("Eval(%q) error = %v, want ErrNotFound", s, err)
+		}
+	}
+
+	for _, s := range []string{"/foo/01", "/foo/-1", "/foo/+1", "/foo/", "/foo/1e0"} {
+		_, err := MustParsePointer(s).Eval([]byte(rfc6901Doc))
+		if err == nil || errors.Is(err, ErrNotFound) {
+			t.Errorf("Eval(%q) error = %v, want invalid index", s, err)
+		}
+	}
+
+	if _, err := (Pointer{}).Eval([]byte(`{"a":`)); err == nil {
+		t.Errorf("Eval on invalid document succeeded")
+	}
+}
+
+func TestPointerLookup(t *testing.T) {
+	doc, err := decode([]byte(rfc6901Doc))
+	if err != nil {
+		t.Fatal(err)
+	}
+	v, err := MustParsePointer("/foo/1").Lookup(doc)
+	if err != nil || v != "baz" {
+		t.Errorf("Lookup(/foo/1) = %v, %v; want baz", v, err)
+	}
+	_, err = MustParsePointer("/foo/1/x").Lookup(doc)
+	var pe *PointerError
+	if !errors.As(err, &pe) || pe.Pointer != "/foo/1/x" {
+		t.Errorf("Lookup(/foo/1/x) error = %v, want *PointerError for /foo/1/x", err)
+	}
+}
+
+func TestPointerRoundTrip(t *testing.T) {
+	p := Pointer{"a/b", "~", "", "0"}.Append("x~/y")
+	s := p.String()
+	if want := "/a~1b/~0//0/x~0~1y"; s != want {
+		t.Errorf("String() = %q, want %q", s, want)
+	}
+	q, err := ParsePointer(s)
+	if err != nil || !slices.Equal(p, q) {
+		t.Errorf("ParsePointer(%q) = %q, %v; want %q", s, q, err, p)
+	}
+}
@@ -8,6 +8,8 @@ import (
 	"bytes"
 	"errors"
 	"io"
+	"reflect"
+	"strings"
 )
 
 // A Decoder reads and decodes JSON values from an input stream.
@@ -38,9 +40,14 @@ func (dec *Decoder) UseNumber() { dec.d.useNumber = true }
 
 // DisallowUnknownFields causes the Decoder to return an error when the destination
 // is a struct and the input contains object keys which do not match any
-// non-ignored, exported fields in the destination.
+// non-ignored, exported fields in the destination. Keys stored in a field
+// with the "inline" option are not considered unknown.
 func (dec *Decoder) DisallowUnknownFields() { dec.d.disallowUnknownFields = true }
 
+// CaseSensitive causes the Decoder to match object keys to struct field
+// names exactly, rather than also accepting case-insensitive matches.
+func (dec *Decoder) CaseSensitive() { dec.d.caseSensitive = true }
+
 // Decode reads the next JSON-encoded value from its
 // input and stores it in the value pointed to by v.
 //
@@ -186,6 +193,9 @@ type Encoder struct {
 	indentBuf    []byte
 	indentPrefix string
 	indentValue  string
+
+	tokenState int
+	tokenStack []int
 }
 
 // NewEncoder returns a new encoder that writes to w.
@@ -197,12 +207,19 @@ func NewEncoder(w io.Writer) *Encoder {
 // with insignificant space characters elided,
 // followed by a newline character.
 //
+// Encode may be interleaved with calls to [Encoder.WriteToken], in which
+// case v is written as the next value of the enclosing array or object,
+// with any necessary separator, and without the trailing newline.
+//
 // See the documentation for [Marshal] for details about the
 // conversion of Go values to JSON.
 func (enc *Encoder) Encode(v any) error {
 	if enc.err != nil {
 		return enc.err
 	}
+	if !enc.tokenValueAllowed() {
+		return enc.tokenError("value")
+	}
 
 	e := newEncodeState()
 	defer encodeStatePool.Put(e)
@@ -212,6 +229,10 @@ func (enc *Encoder) Encode(v any) error {
 		return err
 	}
 
+	if enc.tokenState != tokenTopValue {
+		return enc.writeValue(e.Bytes())
+	}
+
 	// Terminate each value with a newline.
 	// This makes the output look a little nicer
 	// when debugging, and some kind of space
@@ -234,6 +255,170 @@ func (enc *Encoder) Encode(v any) error {
 	return err
 }
 
+// WriteToken writes the JSON token t to the stream.
+// It is the counterpart of [Decoder.Token], and allows arbitrarily
+// large arrays and objects to be written without holding them in memory.
+//
+// The token must be one of the types listed in the documentation for
+// [Token]. A string written where an object key is expected is written as
+// that key. Commas, colons and, if [Encoder.SetIndent] was called,
+// indentation are inserted as needed, and a newline is written after each
+// complete top-level value, as with [Encoder.Encode].
+//
+// WriteToken guarantees that the delimiters [ ] { } it writes are
+// properly nested and matched, and that object keys and values alternate:
+// if t is not valid at the current position, WriteToken returns an error
+// and writes nothing.
+func (enc *Encoder) WriteToken(t Token) error {
+	if enc.err != nil {
+		return enc.err
+	}
+	switch t := t.(type) {
+	case Delim:
+		switch t {
+		case '[', '{':
+			if !enc.tokenValueAllowed() {
+				return enc.tokenError("delimiter " + quoteChar(byte(t)))
+			}
+			enc.indentBuf = append(enc.tokenSeparator(enc.indentBuf[:0]), byte(t))
+			enc.tokenStack = append(enc.tokenStack, enc.tokenState)
+			if t == '[' {
+				enc.tokenState = tokenArrayStart
+			} else {
+				enc.tokenState = tokenObjectStart
+			}
+			return enc.write(enc.indentBuf)
+
+		case ']', '}':
+			start, comma := tokenArrayStart, tokenArrayComma
+			if t == '}' {
+				start, comma = tokenObjectStart, tokenObjectComma
+			}
+			if enc.tokenState != start && enc.tokenState != comma {
+				return enc.tokenError("delimiter " + quoteChar(byte(t)))
+			}
+			b := enc.indentBuf[:0]
+			if enc.tokenState == comma {
+				b = enc.appendTokenIndent(b, len(enc.tokenStack)-1)
+			}
+			b = append(b, byte(t))
+			enc.tokenState = enc.tokenStack[len(enc.tokenStack)-1]
+			enc.tokenStack = enc.tokenStack[:len(enc.tokenStack)-1]
+			if enc.tokenState == tokenTopValue {
+				b = append(b, '\n')
+			}
+			enc.tokenValueEnd()
+			enc.indentBuf = b
+			return enc.write(b)
+		}
+		return errors.New("json: invalid delimiter " + quoteChar(byte(t)))
+
+	case string:
+		if enc.tokenState == tokenObjectStart || enc.tokenState == tokenObjectComma {
+			b := enc.tokenSeparator(enc.indentBuf[:0])
+			b = appendString(b, t, enc.escapeHTML)
+			b = append(b, ':')
+			if enc.indentPrefix != "" || enc.indentValue != "" {
+				b = append(b, ' ')
+			}
+			enc.tokenState = tokenObjectValue
+			enc.indentBuf = b
+			return enc.write(b)
+		}
+		return enc.Encode(t)
+
+	case bool, float64, Number, nil:
+		return enc.Encode(t)
+	}
+	return errors.New("json: invalid token type " + reflect.TypeOf(t).String())
+}
+
+// writeValue writes the encoded value b as the next element of the
+// enclosing array or object.
+func (enc *Encoder) writeValue(b []byte) error {
+	buf := enc.tokenSeparator(enc.indentBuf[:0])
+	if enc.indentPrefix != "" || enc.indentValue != "" {
+		var err error
+		prefix := enc.indentPrefix + strings.Repeat(enc.indentValue, len(enc.tokenStack))
+		buf, err = appendIndent(buf, b, prefix, enc.indentValue)
+		if err != nil {
+			return err
+		}
+	} else {
+		buf = append(buf, b...)
+	}
+	enc.tokenValueEnd()
+	enc.indentBuf = buf
+	return enc.write(buf)
+}
+
+// tokenSeparator appends to b the comma and indentation, if any,
+// that precede the next token.
+func (enc *Encoder) tokenSeparator(b []byte) []byte {
+	switch enc.tokenState {
+	case tokenArrayComma, tokenObjectComma:
+		b = append(b, ',')
+		fallthrough
+	case tokenArrayStart, tokenObjectStart:
+		b = enc.appendTokenIndent(b, len(enc.tokenStack))
+	}
+	return b
+}
+
+// appendTokenIndent appends to b a newline followed by the indentation
+// for the given nesting depth, if SetIndent was called.
+func (enc *Encoder) appendTokenIndent(b []byte, depth int) []byte {
+	if enc.indentPrefix == "" && enc.indentValue == "" {
+		return b
+	}
+	b = append(b, '\n')
+	b = append(b, enc.indentPrefix...)
+	for range depth {
+		b = append(b, enc.indentValue...)
+	}
+	return b
+}
+
+func (enc *Encoder) write(b []byte) error {
+	if _, err := enc.w.Write(b); err != nil {
+		enc.err = err
+		return err
+	}
+	return nil
+}
+
+func (enc *Encoder) tokenValueAllowed() bool {
+	switch enc.tokenState {
+	case tokenTopValue, tokenArrayStart, tokenArrayComma, tokenObjectValue:
+		return true
+	}
+	return false
+}
+
+func (enc *Encoder) tokenValueEnd() {
+	switch enc.tokenState {
+	case tokenArrayStart, tokenArrayComma:
+		enc.tokenState = tokenArrayComma
+	case tokenObjectValue:
+		enc.tokenState = tokenObjectComma
+	}
+}
+
+func (enc *Encoder) tokenError(what string) error {
+	var context string
+	switch enc.tokenState {
+	case tokenTopValue:
+		context = " outside of array or object"
+	case tokenArrayStart, tokenArrayComma:
+		context = " inside array"
+	case tokenObjectStart, tokenObjectComma:
+		context = " looking for object key string"
+	case tokenObjectValue:
+		context = " looking for object value"
+	}
+	return errors.New("json: unexpected " + what + context)
+}
+
 // SetIndent instructs the encoder to format each subsequent encoded
 // value as if indented by the package-level function Indent(dst, src, prefix, indent).
 // Calling SetIndent("", "") disables indentation.
@@ -278,7 +463,8 @@ func (m *RawMessage) UnmarshalJSON(data []byte) error {
 var _ Marshaler = (*RawMessage)(nil)
 var _ Unmarshaler = (*RawMessage)(nil)
 
-// A Token holds a value of one of these types:
+// A Token holds a value of one of these types, as returned by
+// [Decoder.Token] and accepted by [Encoder.WriteToken]:
 //
 //   - [Delim], for the four JSON delimiters [ ] { }
 //   - bool, for JSON booleans
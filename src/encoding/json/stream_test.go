@@ -6,9 +6,11 @@ package json
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
 	"io"
 	"log"
+	"math"
 	"net"
 	"net/http"
 	"net/http/httptest"
@@ -487,6 +489,147 @@ func TestDecodeInStream(t *testing.T) {
 	}
 }
 
+type encodeThis struct {
+	v any
+}
+
+func TestEncodeTokens(t *testing.T) {
+	tests := []struct {
+		CaseName
+		tokens []any
+		want   string
+	}{
+		{CaseName: Name(""), tokens: []any{float64(10)}, want: "10\n"},
+		{CaseName: Name(""), tokens: []any{
+			Delim('['), Delim(']')},
+			want: "[]\n"},
+		{CaseName: Name(""), tokens: []any{
+			Delim('['), false, float64(10), "b", nil, Number("1e3"), Delim(']')},
+			want: `[false,10,"b",null,1e3]` + "\n"},
+		{CaseName: Name(""), tokens: []any{
+			Delim('{'), "a", float64(1), "b", "3", Delim('}')},
+			want: `{"a":1,"b":"3"}` + "\n"},
+		{CaseName: Name(""), tokens: []any{
+			Delim('{'), "obj", Delim('['),
+			Delim('{'), "a", float64(1), Delim('}'),
+			Delim('{'), Delim('}'),
+			Delim(']'), Delim('}')},
+			want: `{"obj":[{"a":1},{}]}` + "\n"},
+		{CaseName: Name(""), tokens: []any{
+			Delim('['), float64(1), Delim(']'),
+			Delim('{'), Delim('}'),
+			"x"},
+			want: "[1]\n{}\n\"x\"\n"},
+
+		// streaming tokens with intermittent Encode()
+		{CaseName: Name(""), tokens: []any{
+			Delim('['),
+			encodeThis{map[string]any{"a": 1}},
+			encodeThis{[]int{1, 2}},
+			Delim(']')},
+			want: `[{"a":1},[1,2]]` + "\n"},
+		{CaseName: Name(""), tokens: []any{
+			Delim('{'), "obj",
+			encodeThis{struct{ A string }{"<>"}},
+			"n", encodeThis{nil},
+			Delim('}')},
+			want: `{"obj":{"A":"\u003c\u003e"},"n":null}` + "\n"},
+
+		// invalid token sequences
+		{CaseName: Name(""), tokens: []any{
+			Delim(']')},
+			want: "json: unexpected delimiter ']' outside of array or object"},
+		{CaseName: Name(""), tokens: []any{
+			Delim('['), Delim('}')},
+			want: "json: unexpected delimiter '}' inside array"},
+		{CaseName: Name(""), tokens: []any{
+			Delim('{'), float64(1)},
+			want: "json: unexpected value looking for object key string"},
+		{CaseName: Name(""), tokens: []any{
+			Delim('{'), encodeThis{"a"}},
+			want: "json: unexpected value looking for object key string"},
+		{CaseName: Name(""), tokens: []any{
+			Delim('{'), "a", Delim('}')},
+			want: "json: unexpected delimiter '}' looking for object value"},
+		{CaseName: Name(""), tokens: []any{
+			Delim('['), Delim('(')},
+			want: "json: invalid delimiter '('"},
+		{CaseName: Name(""), tokens: []any{
+			Delim('['), 1},
+			want: "json: invalid token type int"},
+		{CaseName: Name(""), tokens: []any{
+			Delim('['), Number("1x")},
+			want: `json: invalid number literal "1x"`},
+		{CaseName: Name(""), tokens: []any{
+			Delim('['), math.NaN()},
+			want: "json: unsupported value: NaN"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			var buf strings.Builder
+			enc := NewEncoder(&buf)
+			for _, tok := range tt.tokens {
+				var err error
+				if et, ok := tok.(encodeThis); ok {
+					err = enc.Encode(et.v)
+				} else {
+					err = enc.WriteToken(tok)
+				}
+				if err != nil {
+					if got := err.Error(); got != tt.want {
+						t.Fatalf("%s:\n\tgot error:  %v\n\twant error: %v", tt.Where, got, tt.want)
+					}
+					return
+				}
+			}
+			if got := buf.String(); got != tt.want {
+				t.Fatalf("%s:\n\tgot:  %q\n\twant: %q", tt.Where, got, tt.want)
+			}
+
+			// The indented output must match Indent applied to each value.
+			buf.Reset()
+			enc = NewEncoder(&buf)
+			enc.SetIndent(">", "\t")
+			for _, tok := range tt.tokens {
+				if et, ok := tok.(encodeThis); ok {
+					enc.Encode(et.v)
+				} else {
+					enc.WriteToken(tok)
+				}
+			}
+			var want bytes.Buffer
+			dec := NewDecoder(strings.NewReader(tt.want))
+			for {
+				var raw RawMessage
+				if err := dec.Decode(&raw); err == io.EOF {
+					break
+				} else if err != nil {
+					t.Fatal(err)
+				}
+				Indent(&want, raw, ">", "\t")
+				want.WriteByte('\n')
+			}
+			if got := buf.String(); got != want.String() {
+				t.Fatalf("%s: SetIndent:\n\tgot:  %q\n\twant: %q", tt.Where, got, want.String())
+			}
+		})
+	}
+}
+
+func TestEncodeTokenWriteError(t *testing.T) {
+	enc := NewEncoder(&errorWriter{})
+	if err := enc.WriteToken(Delim('[')); err == nil {
+		t.Fatal("WriteToken: got nil error, want write error")
+	}
+	if err := enc.WriteToken(Delim(']')); err == nil {
+		t.Fatal("WriteToken after error: got nil error")
+	}
+}
+
+type errorWriter struct{}
+
+func (*errorWriter) Write([]byte) (int, error) { return 0, errors.New("write error") }
+
 // Test from golang.org/issue/11893
 func TestHTTPDecoding(t *testing.T) {
 	const raw = `{ "foo": "bar" }`
@@ -0,0 +1,483 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package xml
+
+import (
+	"bufio"
+	"cmp"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+)
+
+// A CanonicalizationMethod is a canonicalization algorithm supported by
+// [Canonicalizer].
+type CanonicalizationMethod int
+
+const (
+	// Canonical XML 1.0, https://www.w3.org/TR/2001/REC-xml-c14n-20010315.
+	CanonicalXML10 CanonicalizationMethod = 1 + iota
+	CanonicalXML10WithComments
+
+	// Exclusive XML Canonicalization 1.0,
+	// https://www.w3.org/TR/2002/REC-xml-exc-c14n-20020718.
+	ExclusiveCanonicalXML
+	ExclusiveCanonicalXMLWithComments
+)
+
+// c14nURIs holds the algorithm URIs identifying the canonicalization
+// methods in XML Signature.
+var c14nURIs = [...]string{
+	CanonicalXML10:                    "http://www.w3.org/TR/2001/REC-xml-c14n-20010315",
+	CanonicalXML10WithComments:        "http://www.w3.org/TR/2001/REC-xml-c14n-20010315#WithComments",
+	ExclusiveCanonicalXML:             "http://www.w3.org/2001/10/xml-exc-c14n#",
+	ExclusiveCanonicalXMLWithComments: "http://www.w3.org/2001/10/xml-exc-c14n#WithComments",
+}
+
+// String returns the algorithm URI identifying m in XML Signature.
+func (m CanonicalizationMethod) String() string {
+	if m > 0 && int(m) < len(c14nURIs) {
+		return c14nURIs[m]
+	}
+	return "CanonicalizationMethod(" + strconv.Itoa(int(m)) + ")"
+}
+
+// ParseCanonicalizationMethod returns the canonicalization method
+// identified by the given XML Signature algorithm URI.
+func ParseCanonicalizationMethod(uri string) (CanonicalizationMethod, error) {
+	for m, u := range c14nURIs {
+		if m > 0 && u == uri {
+			return CanonicalizationMethod(m), nil
+		}
+	}
+	return 0, fmt.Errorf("xml: unsupported canonicalization method %q", uri)
+}
+
+// A Canonicalizer writes the canonical form of an XML document or element,
+// as defined by Canonical XML 1.0 or Exclusive XML Canonicalization 1.0,
+// for use with XML signatures.
+//
+// Canonical XML preserves the name space prefixes of the input, so the
+// tokens given to a Canonicalizer must be those returned by
+// [Decoder.RawToken], in which [Name.Space] holds the prefix rather than
+// the name space URL. The decoder must have [Decoder.NormalizeAttributes]
+// set; its line ending, character reference and attribute value
+// processing provide the rest of the normalization that canonical XML
+// requires. DTDs are not processed, so default attributes declared in a
+// DTD are not added.
+type Canonicalizer struct {
+	w         *bufio.Writer
+	exclusive bool
+	comments  bool
+	inclusive map[string]bool // InclusiveNamespaces PrefixList
+	stack     []c14nFrame
+	depth     int  // number of open elements being written
+	afterRoot bool // whether an element has been written at the top level
+	err       error
+}
+
+// A c14nFrame holds the name space context of an open element, or of an
+// ancestor of the elements being written.
+type c14nFrame struct {
+	name     string
+	output   bool              // whether the element is being written
+	decls    map[string]string // map prefix -> name space declared on the element
+	rendered map[string]string // map prefix -> name space declaration written on the element
+	xmlAttrs []Attr            // attributes in the xml name space
+}
+
+// NewCanonicalizer returns a new Canonicalizer that writes to w using the
+// given canonicalization method.
+func NewCanonicalizer(w io.Writer, method CanonicalizationMethod) (*Canonicalizer, error) {
+	c := &Canonicalizer{w: bufio.NewWriter(w)}
+	switch method {
+	case CanonicalXML10:
+	case CanonicalXML10WithComments:
+		c.comments = true
+	case ExclusiveCanonicalXML:
+		c.exclusive = true
+	case ExclusiveCanonicalXMLWithComments:
+		c.exclusive, c.comments = true, true
+	default:
+		return nil, fmt.Errorf("xml: unsupported canonicalization method %v", method)
+	}
+	return c, nil
+}
+
+// SetInclusiveNamespaces sets the InclusiveNamespaces PrefixList of
+// exclusive canonicalization: the prefixes whose declarations are treated
+// as in Canonical XML 1.0 rather than written only where they are used.
+// The prefix "#default" stands for the default name space. It has no
+// effect for the other methods.
+func (c *Canonicalizer) SetInclusiveNamespaces(prefixes []string) {
+	c.inclusive = make(map[string]bool, len(prefixes))
+	for _, prefix := range prefixes {
+		if prefix == "#default" {
+			prefix = ""
+		}
+		c.inclusive[prefix] = true
+	}
+}
+
+// SetContext sets the start elements of the ancestors of the element to be
+// canonicalized, outermost first, as returned by [Decoder.RawToken]. The
+// ancestors are not written, but the name spaces they declare are in
+// scope, as required when canonicalizing part of a document such as the
+// SignedInfo element of an XML signature. For Canonical XML 1.0, their
+// attributes in the xml name space, such as xml:lang, are inherited by the
+// top-level elements written.
+//
+// SetContext must be called before any tokens are written.
+func (c *Canonicalizer) SetContext(ancestors []StartElement) {
+	for _, start := range ancestors {
+		c.stack = append(c.stack, newC14nFrame(start, false))
+	}
+}
+
+func newC14nFrame(start StartElement, output bool) c14nFrame {
+	f := c14nFrame{name: qualifiedName(start.Name), output: output}
+	for _, a := range start.Attr {
+		switch {
+		case a.Name.Space == xmlnsPrefix:
+			if f.decls == nil {
+				f.decls = make(map[string]string)
+			}
+			f.decls[a.Name.Local] = a.Value
+		case a.Name.Space == "" && a.Name.Local == xmlnsPrefix:
+			if f.decls == nil {
+				f.decls = make(map[string]string)
+			}
+			f.decls[""] = a.Value
+		case a.Name.Space == xmlPrefix:
+			f.xmlAttrs = append(f.xmlAttrs, a)
+		}
+	}
+	return f
+}
+
+func qualifiedName(name Name) string {
+	if name.Space == "" {
+		return name.Local
+	}
+	return name.Space + ":" + name.Local
+}
+
+// lookup returns the name space bound to prefix in the current context.
+func (c *Canonicalizer) lookup(prefix string) string {
+	if prefix == xmlPrefix {
+		return xmlURL
+	}
+	for i := len(c.stack) - 1; i >= 0; i-- {
+		if url, ok := c.stack[i].decls[prefix]; ok {
+			return url
+		}
+	}
+	return ""
+}
+
+// lookupRendered returns the name space declaration for prefix written on
+// the nearest enclosing element that is being written, if any.
+func (c *Canonicalizer) lookupRendered(prefix string) (string, bool) {
+	for i := len(c.stack) - 1; i >= 0; i-- {
+		if url, ok := c.stack[i].rendered[prefix]; ok {
+			return url, true
+		}
+	}
+	return "", false
+}
+
+// needsDecl reports whether the binding of prefix to url must be declared
+// on an element whose name space context is on top of the stack, except
+// for the element's own frame.
+func (c *Canonicalizer) needsDecl(prefix, url string) bool {
+	rendered, ok := c.lookupRendered(prefix)
+	if url == "" {
+		// Only the default name space can be undeclared, and only if
+		// an output ancestor declared it.
+		return prefix == "" && ok && rendered != ""
+	}
+	return !ok || rendered != url
+}
+
+// EncodeToken writes the canonical form of t. The tokens must be those
+// returned by [Decoder.RawToken]. [Directive] tokens and the XML
+// declaration are omitted, as are [Comment] tokens unless the method
+// includes comments.
+//
+// EncodeToken does not call [Canonicalizer.Flush].
+func (c *Canonicalizer) EncodeToken(t Token) error {
+	if c.err != nil {
+		return c.err
+	}
+	switch t := t.(type) {
+	case StartElement:
+		if err := c.writeStart(t); err != nil {
+			return err
+		}
+	case EndElement:
+		if c.depth == 0 {
+			return fmt.Errorf("xml: end tag </%s> without start tag", qualifiedName(t.Name))
+		}
+		top := c.stack[len(c.stack)-1]
+		if name := qualifiedName(t.Name); name != top.name {
+			return fmt.Errorf("xml: end tag </%s> does not match start tag <%s>", name, top.name)
+		}
+		c.stack = c.stack[:len(c.stack)-1]
+		c.depth--
+		c.w.WriteString("</")
+		c.w.WriteString(top.name)
+		c.w.WriteByte('>')
+		if c.depth == 0 {
+			c.afterRoot = true
+		}
+	case CharData:
+		// Whitespace outside the document element is not written.
+		if c.depth > 0 {
+			c.escape(t, false)
+		}
+	case Comment:
+		if c.comments {
+			c.topLevel(func() {
+				c.w.WriteString("<!--")
+				c.w.Write(t)
+				c.w.WriteString("-->")
+			})
+		}
+	case ProcInst:
+		if t.Target != "xml" {
+			c.topLevel(func() {
+				c.w.WriteString("<?")
+				c.w.WriteString(t.Target)
+				if len(t.Inst) > 0 {
+					c.w.WriteByte(' ')
+					c.w.Write(t.Inst)
+				}
+				c.w.WriteString("?>")
+			})
+		}
+	case Directive:
+	default:
+		return errors.New("xml: EncodeToken of invalid token type")
+	}
+	_, c.err = c.w.Write(nil)
+	return c.err
+}
+
+// topLevel calls write to write a comment or processing instruction,
+// separating it with a line feed from the document element if it is
+// outside of it.
+func (c *Canonicalizer) topLevel(write func()) {
+	if c.depth > 0 {
+		write()
+		return
+	}
+	if c.afterRoot {
+		c.w.WriteByte('\n')
+	}
+	write()
+	if !c.afterRoot {
+		c.w.WriteByte('\n')
+	}
+}
+
+func (c *Canonicalizer) writeStart(start StartElement) error {
+	// Whether the parent of the element is written, or the element is at
+	// the top of the output.
+	apex := c.depth == 0
+
+	// Collect the name space declarations to write. The element's frame
+	// is pushed first, so that lookup sees its own declarations.
+	f := newC14nFrame(start, true)
+	c.stack = append(c.stack, f)
+
+	if err := c.checkBound(start); err != nil {
+		c.stack = c.stack[:len(c.stack)-1]
+		return err
+	}
+	c.depth++
+	var prefixes []string
+	if c.exclusive {
+		// Write the bindings of the prefixes visibly utilized by the
+		// element and its attributes, and of those in the
+		// InclusiveNamespaces PrefixList.
+		prefixes = append(prefixes, start.Name.Space)
+		for _, a := range start.Attr {
+			if a.Name.Space != "" && a.Name.Space != xmlnsPrefix {
+				prefixes = append(prefixes, a.Name.Space)
+			}
+		}
+		for prefix := range c.inclusive {
+			prefixes = append(prefixes, prefix)
+		}
+	} else {
+		// Write the bindings of all prefixes in scope.
+		for i := range c.stack {
+			for prefix := range c.stack[i].decls {
+				prefixes = append(prefixes, prefix)
+			}
+		}
+	}
+	slices.Sort(prefixes)
+	prefixes = slices.Compact(prefixes)
+
+	rendered := make(map[string]string)
+	for _, prefix := range prefixes {
+		if prefix == xmlPrefix {
+			continue
+		}
+		url := c.lookup(prefix)
+		if c.exclusive && c.inclusive[prefix] && url == "" && prefix != "" {
+			continue
+		}
+		if c.needsDecl(prefix, url) {
+			rendered[prefix] = url
+		}
+	}
+	c.stack[len(c.stack)-1].rendered = rendered
+
+	// Collect the attributes, sorted by name space URL and then local name.
+	type attr struct {
+		Attr
+		url string
+	}
+	var attrs []attr
+	for _, a := range start.Attr {
+		if a.Name.Space == xmlnsPrefix || a.Name.Space == "" && a.Name.Local == xmlnsPrefix {
+			continue
+		}
+		url := ""
+		if a.Name.Space != "" {
+			url = c.lookup(a.Name.Space)
+		}
+		attrs = append(attrs, attr{a, url})
+	}
+	if apex && !c.exclusive {
+		// Inherit attributes in the xml name space from the ancestors.
+		for i := len(c.stack) - 2; i >= 0; i-- {
+			for _, a := range c.stack[i].xmlAttrs {
+				if !slices.ContainsFunc(attrs, func(b attr) bool { return b.Name == a.Name }) {
+					attrs = append(attrs, attr{a, xmlURL})
+				}
+			}
+		}
+	}
+	slices.SortFunc(attrs, func(a, b attr) int {
+		if c := cmp.Compare(a.url, b.url); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.Name.Local, b.Name.Local)
+	})
+
+	c.w.WriteByte('<')
+	c.w.WriteString(f.name)
+	for _, prefix := range prefixes {
+		url, ok := rendered[prefix]
+		if !ok {
+			continue
+		}
+		c.w.WriteString(" xmlns")
+		if prefix != "" {
+			c.w.WriteByte(':')
+			c.w.WriteString(prefix)
+		}
+		c.w.WriteString(`="`)
+		c.escape([]byte(url), true)
+		c.w.WriteByte('"')
+	}
+	for _, a := range attrs {
+		c.w.WriteByte(' ')
+		c.w.WriteString(qualifiedName(a.Name))
+		c.w.WriteString(`="`)
+		c.escape([]byte(a.Value), true)
+		c.w.WriteByte('"')
+	}
+	c.w.WriteByte('>')
+	return nil
+}
+
+// checkBound returns an error if the name of start or of one of its
+// attributes has a prefix with no name space binding in scope, since
+// the output could not then be parsed with name spaces.
+func (c *Canonicalizer) checkBound(start StartElement) error {
+	names := []Name{start.Name}
+	for _, a := range start.Attr {
+		names = append(names, a.Name)
+	}
+	for _, n := range names {
+		if n.Space != "" && n.Space != xmlnsPrefix && c.lookup(n.Space) == "" {
+			return fmt.Errorf("xml: name space prefix %q of <%s> is not bound", n.Space, qualifiedName(start.Name))
+		}
+	}
+	return nil
+}
+
+// escape writes s escaped as required by canonical XML for text, or for
+// attribute values if attr is set.
+func (c *Canonicalizer) escape(s []byte, attr bool) {
+	last := 0
+	for i, b := range s {
+		var esc string
+		switch {
+		case b == '&':
+			esc = "&amp;"
+		case b == '<':
+			esc = "&lt;"
+		case b == '>' && !attr:
+			esc = "&gt;"
+		case b == '"' && attr:
+			esc = "&quot;"
+		case b == '\t' && attr:
+			esc = "&#x9;"
+		case b == '\n' && attr:
+			esc = "&#xA;"
+		case b == '\r':
+			esc = "&#xD;"
+		default:
+			continue
+		}
+		c.w.Write(s[last:i])
+		c.w.WriteString(esc)
+		last = i + 1
+	}
+	c.w.Write(s[last:])
+}
+
+// Flush flushes any buffered output to the underlying writer.
+func (c *Canonicalizer) Flush() error {
+	if c.err != nil {
+		return c.err
+	}
+	c.err = c.w.Flush()
+	return c.err
+}
+
+// Canonicalize reads an XML document from r and writes its canonical form
+// to w, using the given canonicalization method as in [NewCanonicalizer].
+func Canonicalize(w io.Writer, r io.Reader, method CanonicalizationMethod) error {
+	c, err := NewCanonicalizer(w, method)
+	if err != nil {
+		return err
+	}
+	d := NewDecoder(r)
+	d.NormalizeAttributes = true
+	for {
+		t, err := d.RawToken()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if err := c.EncodeToken(t); err != nil {
+			return err
+		}
+	}
+	if c.depth > 0 {
+		return fmt.Errorf("xml: unclosed tag <%s>", c.stack[len(c.stack)-1].name)
+	}
+	return c.Flush()
+}
@@ -0,0 +1,196 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package xml
+
+import (
+	"io"
+	"strings"
+	"testing"
+)
+
+// Examples from Canonical XML 1.0, Section 3.
+const c14nPIInput = `<?xml version="1.0"?>
+
+<?xml-stylesheet   href="doc.xsl"
+   type="text/xsl"   ?>
+
+<!DOCTYPE doc SYSTEM "doc.dtd">
+
+<doc>Hello, world!<!-- Comment 1 --></doc>
+
+<?pi-without-data     ?>
+
+<!-- Comment 2 -->
+
+<!-- Comment 3 -->`
+
+const c14nElementsInput = `<doc>
+   <e1   />
+   <e2   ></e2>
+   <e3   name = "elem3"   id="elem3"   />
+   <e4   name="elem4"   id="elem4"   ></e4>
+   <e5 a:attr="out" b:attr="sorted" attr2="all" attr="I'm"
+      xmlns:b="http://www.ietf.org"
+      xmlns:a="http://www.w3.org"
+      xmlns="http://example.org"/>
+   <e6 xmlns="" xmlns:a="http://www.w3.org">
+      <e7 xmlns="http://www.ietf.org">
+         <e8 xmlns="" xmlns:a="http://www.w3.org">
+            <e9 xmlns="" xmlns:a="http://www.ietf.org"/>
+         </e8>
+      </e7>
+   </e6>
+</doc>`
+
+const c14nCharsInput = `<doc>
+   <text>First line&#x0d;&#10;Second line</text>
+   <value>&#x32;</value>
+   <compute><![CDATA[value>"0" && value<"10" ?"valid":"error"]]></compute>
+   <compute expr='value>"0" &amp;&amp; value&lt;"10" ?"valid":"error"'>valid</compute>
+   <norm attr=' &apos;   &#x20;&#13;&#xa;&#9;   &apos; '/>
+</doc>`
+
+var canonicalizeTests = []struct {
+	in     string
+	method CanonicalizationMethod
+	want   string
+}{
+	{c14nPIInput, CanonicalXML10, `<?xml-stylesheet href="doc.xsl"
+   type="text/xsl"   ?>
+<doc>Hello, world!</doc>
+<?pi-without-data?>`},
+	{c14nPIInput, CanonicalXML10WithComments, `<?xml-stylesheet href="doc.xsl"
+   type="text/xsl"   ?>
+<doc>Hello, world!<!-- Comment 1 --></doc>
+<?pi-without-data?>
+<!-- Comment 2 -->
+<!-- Comment 3 -->`},
+	{c14nElementsInput, CanonicalXML10, `<doc>
+   <e1></e1>
+   <e2></e2>
+   <e3 id="elem3" name="elem3"></e3>
+   <e4 id="elem4" name="elem4"></e4>
+   <e5 xmlns="http://example.org" xmlns:a="http://www.w3.org" xmlns:b="http://www.ietf.org" attr="I'm" attr2="all" b:attr="sorted" a:attr="out"></e5>
+   <e6 xmlns:a="http://www.w3.org">
+      <e7 xmlns="http://www.ietf.org">
+         <e8 xmlns="">
+            <e9 xmlns:a="http://www.ietf.org"></e9>
+         </e8>
+      </e7>
+   </e6>
+</doc>`},
+	{c14nCharsInput, CanonicalXML10, `<doc>
+   <text>First line&#xD;
+Second line</text>
+   <value>2</value>
+   <compute>value&gt;"0" &amp;&amp; value&lt;"10" ?"valid":"error"</compute>
+   <compute expr="value>&quot;0&quot; &amp;&amp; value&lt;&quot;10&quot; ?&quot;valid&quot;:&quot;error&quot;">valid</compute>
+   <norm attr=" '    &#xD;&#xA;&#x9;   ' "></norm>
+</doc>`},
+	// Exclusive canonicalization only declares the name spaces that
+	// are used.
+	{`<a xmlns="urn:a" xmlns:x="urn:x" xmlns:y="urn:y"><b x:attr="1"><y:c/></b></a>`, ExclusiveCanonicalXML,
+		`<a xmlns="urn:a"><b xmlns:x="urn:x" x:attr="1"><y:c xmlns:y="urn:y"></y:c></b></a>`},
+	{`<a xmlns="urn:a" xmlns:x="urn:x" xmlns:y="urn:y"><b x:attr="1"><y:c/></b></a>`, CanonicalXML10,
+		`<a xmlns="urn:a" xmlns:x="urn:x" xmlns:y="urn:y"><b x:attr="1"><y:c></y:c></b></a>`},
+	// Literal white space in attribute values is normalized to spaces,
+	// unlike white space written as character references.
+	{"<a x=\"1\t2\r\n3\n4&#9;5&#xA;6&#13;7\"/>", CanonicalXML10,
+		`<a x="1 2 3 4&#x9;5&#xA;6&#xD;7"></a>`},
+}
+
+func TestCanonicalize(t *testing.T) {
+	for _, tt := range canonicalizeTests {
+		var b strings.Builder
+		if err := Canonicalize(&b, strings.NewReader(tt.in), tt.method); err != nil {
+			t.Errorf("Canonicalize(%q, %s) error: %v", tt.in, tt.method, err)
+			continue
+		}
+		if got := b.String(); got != tt.want {
+			t.Errorf("Canonicalize(%q, %s):\nhave %s\nwant %s", tt.in, tt.method, got, tt.want)
+		}
+	}
+}
+
+// The document subset example from Exclusive XML Canonicalization 1.0,
+// Section 2.2.
+const excC14nInput = `<n0:local xmlns:n0="foo:bar" xmlns:n3="ftp://example.org"><n1:elem2 xmlns:n1="http://example.net" xml:lang="en"><n3:stuff xmlns:n3="ftp://example.org"/></n1:elem2></n0:local>`
+
+func TestCanonicalizeSubset(t *testing.T) {
+	tests := []struct {
+		method    CanonicalizationMethod
+		inclusive []string
+		want      string
+	}{
+		{CanonicalXML10, nil, `<n1:elem2 xmlns:n0="foo:bar" xmlns:n1="http://example.net" xmlns:n3="ftp://example.org" xml:lang="en"><n3:stuff></n3:stuff></n1:elem2>`},
+		{ExclusiveCanonicalXML, nil, `<n1:elem2 xmlns:n1="http://example.net" xml:lang="en"><n3:stuff xmlns:n3="ftp://example.org"></n3:stuff></n1:elem2>`},
+		{ExclusiveCanonicalXML, []string{"n3"}, `<n1:elem2 xmlns:n1="http://example.net" xmlns:n3="ftp://example.org" xml:lang="en"><n3:stuff></n3:stuff></n1:elem2>`},
+	}
+	for _, tt := range tests {
+		var b strings.Builder
+		c, err := NewCanonicalizer(&b, tt.method)
+		if err != nil {
+			t.Fatal(err)
+		}
+		c.SetInclusiveNamespaces(tt.inclusive)
+		d := NewDecoder(strings.NewReader(excC14nInput))
+		d.NormalizeAttributes = true
+		root, err := d.RawToken()
+		if err != nil {
+			t.Fatal(err)
+		}
+		c.SetContext([]StartElement{root.(StartElement).Copy()})
+		for depth := 0; ; {
+			tok, err := d.RawToken()
+			if err != nil {
+				t.Fatal(err)
+			}
+			switch tok.(type) {
+			case StartElement:
+				depth++
+			case EndElement:
+				depth--
+			}
+			if depth < 0 {
+				break
+			}
+			if err := c.EncodeToken(tok); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if err := c.Flush(); err != nil {
+			t.Fatal(err)
+		}
+		if got := b.String(); got != tt.want {
+			t.Errorf("%s %v:\nhave %s\nwant %s", tt.method, tt.inclusive, got, tt.want)
+		}
+	}
+}
+
+func TestParseCanonicalizationMethod(t *testing.T) {
+	for _, m := range []CanonicalizationMethod{CanonicalXML10, CanonicalXML10WithComments, ExclusiveCanonicalXML, ExclusiveCanonicalXMLWithComments} {
+		got, err := ParseCanonicalizationMethod(m.String())
+		if err != nil || got != m {
+			t.Errorf("ParseCanonicalizationMethod(%q) = %v, %v, want %v", m.String(), int(got), err, int(m))
+		}
+	}
+	if got, want := ExclusiveCanonicalXMLWithComments.String(), "http://www.w3.org/2001/10/xml-exc-c14n#WithComments"; got != want {
+		t.Errorf("ExclusiveCanonicalXMLWithComments.String() = %q, want %q", got, want)
+	}
+}
+
+func TestCanonicalizeErrors(t *testing.T) {
+	if _, err := NewCanonicalizer(io.Discard, 0); err == nil {
+		t.Errorf("NewCanonicalizer with unknown method succeeded")
+	}
+	if _, err := ParseCanonicalizationMethod("urn:unknown"); err == nil {
+		t.Errorf("ParseCanonicalizationMethod with unknown method succeeded")
+	}
+	for _, in := range []string{`<a></b>`, `<a>`, `<p:a/>`, `<a p:x="1"/>`, `<a xmlns:p="urn:p"/><p:b/>`} {
+		if err := Canonicalize(io.Discard, strings.NewReader(in), CanonicalXML10); err == nil {
+			t.Errorf("Canonicalize(%q) succeeded", in)
+		}
+	}
+}
@@ -0,0 +1,511 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package xml
+
+import (
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// A Validator checks a document as it is decoded, for example
+// against an XML Schema. See [Decoder.Validator].
+type Validator interface {
+	// ValidateToken is called by [Decoder.Token] with each StartElement,
+	// EndElement and CharData token, after name space translation and
+	// before the token is returned to the caller. The token is only
+	// valid for the duration of the call. A non-nil error stops
+	// decoding and is returned by Token.
+	ValidateToken(t Token) error
+}
+
+// DefaultMaxEntityExpansion is the default value of
+// [Decoder.MaxEntityExpansion].
+const DefaultMaxEntityExpansion = 1 << 20
+
+// maxEntityDepth limits how deeply entity references declared
+// in a DTD may nest within each other's replacement text.
+const maxEntityDepth = 16
+
+// A dtd holds the declarations read from the internal subset
+// of a document type declaration.
+type dtd struct {
+	entities map[string]dtdEntity
+	attrs    map[string][]dtdAttr // keyed by element name as written
+
+	// expanded counts the bytes produced by entity expansion
+	// so far in the document.
+	expanded int
+	max      int
+}
+
+type dtdEntity struct {
+	value    string // replacement text, with character references expanded
+	external bool
+}
+
+type dtdAttr struct {
+	name  string // as written, possibly with a prefix
+	value string // default value, fully expanded and normalized
+
+	// entities reports whether value refers to entities, in which
+	// case it counts towards the expansion limit each time it is used.
+	entities bool
+}
+
+// doctype processes the body of a <!DOCTYPE ...> directive
+// when d.ParseDTD is set, recording the declarations of its
+// internal subset.
+func (d *Decoder) doctype(dir []byte) error {
+	s, ok := strings.CutPrefix(string(dir), "DOCTYPE")
+	if !ok {
+		return nil
+	}
+	i := indexUnquoted(s, '[')
+	if i < 0 {
+		return nil
+	}
+	j := strings.LastIndexByte(s, ']')
+	if j < i {
+		return d.syntaxError("unterminated internal subset in DOCTYPE")
+	}
+	if d.dtd == nil {
+		max := d.MaxEntityExpansion
+		if max == 0 {
+			max = DefaultMaxEntityExpansion
+		}
+		d.dtd = &dtd{
+			entities: make(map[string]dtdEntity),
+			attrs:    make(map[string][]dtdAttr),
+			max:      max,
+		}
+	}
+	if msg := d.dtd.parse(s[i+1 : j]); msg != "" {
+		return d.syntaxError(msg)
+	}
+	return nil
+}
+
+// indexUnquoted returns the index of the first c in s
+// outside of a quoted literal, or -1.
+func indexUnquoted(s string, c byte) int {
+	var quote byte
+	for i := 0; i < len(s); i++ {
+		switch {
+		case quote != 0:
+			if s[i] == quote {
+				quote = 0
+			}
+		case s[i] == '"' || s[i] == '\'':
+			quote = s[i]
+		case s[i] == c:
+			return i
+		}
+	}
+	return -1
+}
+
+// dtdScanner reads the markup declarations of an internal subset.
+type dtdScanner struct {
+	s string
+}
+
+func (p *dtdScanner) space() bool {
+	t := strings.TrimLeft(p.s, " \t\r\n")
+	skipped := len(t) < len(p.s)
+	p.s = t
+	return skipped
+}
+
+func (p *dtdScanner) name() (string, bool) {
+	i := 0
+	for i < len(p.s) && !strings.ContainsRune(" \t\r\n>\"'()|,", rune(p.s[i])) {
+		i++
+	}
+	name := p.s[:i]
+	if !isNameString(name) {
+		return "", false
+	}
+	p.s = p.s[i:]
+	return name, true
+}
+
+func (p *dtdScanner) literal() (string, bool) {
+	if p.s == "" || p.s[0] != '"' && p.s[0] != '\'' {
+		return "", false
+	}
+	i := strings.IndexByte(p.s[1:], p.s[0])
+	if i < 0 {
+		return "", false
+	}
+	lit := p.s[1 : i+1]
+	p.s = p.s[i+2:]
+	return lit, true
+}
+
+// skip discards the rest of the current declaration.
+func (p *dtdScanner) skip() bool {
+	i := indexUnquoted(p.s, '>')
+	if i < 0 {
+		return false
+	}
+	p.s = p.s[i+1:]
+	return true
+}
+
+// end consumes the closing '>' of a declaration.
+func (p *dtdScanner) end() bool {
+	p.space()
+	if !strings.HasPrefix(p.s, ">") {
+		return false
+	}
+	p.s = p.s[1:]
+	return true
+}
+
+// parse records the declarations in the internal subset s.
+// It returns a description of the first error found, or "".
+func (t *dtd) parse(s string) string {
+	p := &dtdScanner{s}
+	for {
+		p.space()
+		switch {
+		case p.s == "":
+			return ""
+		case strings.HasPrefix(p.s, "<!ENTITY"):
+			p.s = p.s[len("<!ENTITY"):]
+			if msg := t.parseEntity(p); msg != "" {
+				return msg
+			}
+		case strings.HasPrefix(p.s, "<!ATTLIST"):
+			p.s = p.s[len("<!ATTLIST"):]
+			if msg := t.parseAttlist(p); msg != "" {
+				return msg
+			}
+		case strings.HasPrefix(p.s, "<?"):
+			i := strings.Index(p.s, "?>")
+			if i < 0 {
+				return "unterminated processing instruction in DTD"
+			}
+			p.s = p.s[i+2:]
+		case strings.HasPrefix(p.s, "<!"):
+			// Element and notation declarations.
+			if !p.skip() {
+				return "unterminated markup declaration in DTD"
+			}
+		case p.s[0] == '%':
+			// Parameter entity references are not expanded.
+			i := strings.IndexByte(p.s, ';')
+			if i < 0 {
+				return "invalid parameter entity reference in DTD"
+			}
+			p.s = p.s[i+1:]
+		default:
+			return "invalid markup in DTD internal subset"
+		}
+	}
+}
+
+func (t *dtd) parseEntity(p *dtdScanner) string {
+	if !p.space() {
+		return "invalid ENTITY declaration"
+	}
+	param := false
+	if strings.HasPrefix(p.s, "%") {
+		param = true
+		p.s = p.s[1:]
+		p.space()
+	}
+	name, ok := p.name()
+	if !ok || !p.space() {
+		return "invalid ENTITY declaration"
+	}
+	var e dtdEntity
+	if lit, ok := p.literal(); ok {
+		v, msg := expandCharRefs(lit)
+		if msg != "" {
+			return msg
+		}
+		e.value = v
+		if !p.end() {
+			return "invalid ENTITY declaration"
+		}
+	} else {
+		// External entity: SYSTEM "uri" or PUBLIC "id" "uri",
+		// optionally followed by NDATA name.
+		e.external = true
+		if !p.skip() {
+			return "invalid ENTITY declaration"
+		}
+	}
+	if param || isPredefinedEntity(name) {
+		return ""
+	}
+	// The first declaration of an entity is binding.
+	if _, ok := t.entities[name]; !ok {
+		t.entities[name] = e
+	}
+	return ""
+}
+
+func (t *dtd) parseAttlist(p *dtdScanner) string {
+	if !p.space() {
+		return "invalid ATTLIST declaration"
+	}
+	elem, ok := p.name()
+	if !ok {
+		return "invalid ATTLIST declaration"
+	}
+	for {
+		if p.end() {
+			return ""
+		}
+		name, ok := p.name()
+		if !ok || !p.space() {
+			return "invalid ATTLIST declaration"
+		}
+
+		// Attribute type: a name, or an enumeration
+		// optionally preceded by NOTATION.
+		typ, ok := p.name()
+		if ok && typ == "NOTATION" {
+			p.space()
+			ok = false
+		}
+		if !ok {
+			if !strings.HasPrefix(p.s, "(") {
+				return "invalid ATTLIST declaration"
+			}
+			i := strings.IndexByte(p.s, ')')
+			if i < 0 {
+				return "invalid ATTLIST declaration"
+			}
+			p.s = p.s[i+1:]
+		}
+		if !p.space() {
+			return "invalid ATTLIST declaration"
+		}
+
+		// Default declaration.
+		if strings.HasPrefix(p.s, "#REQUIRED") || strings.HasPrefix(p.s, "#IMPLIED") {
+			p.s = strings.TrimPrefix(strings.TrimPrefix(p.s, "#REQUIRED"), "#IMPLIED")
+			continue
+		}
+		if s, ok := strings.CutPrefix(p.s, "#FIXED"); ok {
+			p.s = s
+			if !p.space() {
+				return "invalid ATTLIST declaration"
+			}
+		}
+		lit, ok := p.literal()
+		if !ok {
+			return "invalid ATTLIST declaration"
+		}
+		expanded := t.expanded
+		v, msg := t.expandText(lit)
+		if msg != "" {
+			return msg
+		}
+		v = normalizeAttr(v, typ == "CDATA")
+		if !t.hasAttr(elem, name) {
+			t.attrs[elem] = append(t.attrs[elem], dtdAttr{name, v, t.expanded > expanded})
+		}
+	}
+}
+
+func (t *dtd) hasAttr(elem, name string) bool {
+	for _, a := range t.attrs[elem] {
+		if a.name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// normalizeAttr applies attribute-value normalization to a default value.
+func normalizeAttr(v string, cdata bool) string {
+	v = strings.Map(func(r rune) rune {
+		if r == '\t' || r == '\n' || r == '\r' {
+			return ' '
+		}
+		return r
+	}, v)
+	if !cdata {
+		v = strings.Join(strings.Fields(v), " ")
+	}
+	return v
+}
+
+func isPredefinedEntity(name string) bool {
+	_, ok := entity[name]
+	return ok
+}
+
+// expandCharRefs replaces the character references in an entity value
+// with the characters they refer to. Entity references are left alone,
+// to be expanded when the entity is used.
+func expandCharRefs(s string) (string, string) {
+	if !strings.Contains(s, "&") {
+		return s, ""
+	}
+	var b strings.Builder
+	for {
+		i := strings.IndexByte(s, '&')
+		if i < 0 {
+			break
+		}
+		b.WriteString(s[:i])
+		s = s[i:]
+		j := strings.IndexByte(s, ';')
+		if j < 0 {
+			return "", "invalid reference in entity value"
+		}
+		ref := s[1:j]
+		if num, ok := strings.CutPrefix(ref, "#"); ok {
+			r, ok := parseCharRef(num)
+			if !ok {
+				return "", "invalid character reference &" + ref + "; in entity value"
+			}
+			b.WriteRune(r)
+		} else {
+			if !isNameString(ref) {
+				return "", "invalid reference in entity value"
+			}
+			b.WriteString(s[:j+1])
+		}
+		s = s[j+1:]
+	}
+	b.WriteString(s)
+	return b.String(), ""
+}
+
+// parseCharRef parses the digits of a character reference like
+// "65" or "x41".
+func parseCharRef(s string) (rune, bool) {
+	base := 10
+	if h, ok := strings.CutPrefix(s, "x"); ok {
+		s, base = h, 16
+	}
+	n, err := strconv.ParseUint(s, base, 64)
+	if err != nil || n > unicode.MaxRune || !isInCharacterRange(rune(n)) {
+		return 0, false
+	}
+	return rune(n), true
+}
+
+// entity returns the replacement text of the general entity name,
+// with the references it contains expanded. It reports whether
+// the entity is declared; a non-empty msg reports an error
+// expanding it.
+func (t *dtd) entity(name string) (text string, ok bool, msg string) {
+	e, ok := t.entities[name]
+	if !ok {
+		return "", false, ""
+	}
+	text, msg = t.expand(name, e, nil)
+	return text, true, msg
+}
+
+func (t *dtd) expand(name string, e dtdEntity, stack []string) (string, string) {
+	if e.external {
+		return "", "reference to external entity &" + name + ";"
+	}
+	for _, s := range stack {
+		if s == name {
+			return "", "recursive reference to entity &" + name + ";"
+		}
+	}
+	if len(stack) >= maxEntityDepth {
+		return "", "entity references nested too deeply"
+	}
+	text, msg := t.expandRefs(e.value, append(stack, name))
+	if msg != "" {
+		return "", msg
+	}
+	t.expanded += len(text)
+	if t.expanded > t.max {
+		return "", "entity expansion exceeds limit"
+	}
+	return text, ""
+}
+
+// expandText expands the entity references in s,
+// which may only refer to entities already declared.
+func (t *dtd) expandText(s string) (string, string) {
+	s, msg := expandCharRefs(s)
+	if msg != "" {
+		return "", msg
+	}
+	return t.expandRefs(s, nil)
+}
+
+func (t *dtd) expandRefs(s string, stack []string) (string, string) {
+	if !strings.Contains(s, "&") {
+		return s, ""
+	}
+	var b strings.Builder
+	for {
+		i := strings.IndexByte(s, '&')
+		if i < 0 {
+			break
+		}
+		b.WriteString(s[:i])
+		s = s[i+1:]
+		j := strings.IndexByte(s, ';')
+		if j < 0 {
+			return "", "invalid reference in entity value"
+		}
+		ref := s[:j]
+		s = s[j+1:]
+		if r, ok := entity[ref]; ok {
+			b.WriteRune(r)
+			continue
+		}
+		e, ok := t.entities[ref]
+		if !ok {
+			return "", "undeclared entity &" + ref + ";"
+		}
+		text, msg := t.expand(ref, e, stack)
+		if msg != "" {
+			return "", msg
+		}
+		b.WriteString(text)
+	}
+	b.WriteString(s)
+	return b.String(), ""
+}
+
+// addDefaultAttrs appends to attr the default attributes declared
+// for the element name that it does not already specify.
+// Default values that refer to entities are charged against the
+// expansion limit each time they are added.
+func (t *dtd) addDefaultAttrs(name Name, attr []Attr) ([]Attr, string) {
+	qname := name.Local
+	if name.Space != "" {
+		qname = name.Space + ":" + name.Local
+	}
+Defaults:
+	for _, def := range t.attrs[qname] {
+		var n Name
+		if space, local, ok := strings.Cut(def.name, ":"); ok && space != "" && local != "" {
+			n = Name{Space: space, Local: local}
+		} else {
+			n.Local = def.name
+		}
+		for _, a := range attr {
+			if a.Name == n {
+				continue Defaults
+			}
+		}
+		if def.entities {
+			t.expanded += len(def.value)
+			if t.expanded > t.max {
+				return nil, "entity expansion exceeds limit"
+			}
+		}
+		attr = append(attr, Attr{n, def.value})
+	}
+	return attr, ""
+}
@@ -0,0 +1,265 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package xml
+
+import (
+	"errors"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const dtdInput = `<?xml version="1.0"?>
+<!DOCTYPE doc [
+  <!ELEMENT doc (item*)>
+  <!-- Character entities. -->
+  <!ENTITY nbsp "&#160;">
+  <!ENTITY copy "&#xA9;">
+  <!ENTITY owner "ACME &amp; Co.">
+  <!ENTITY notice "&copy; &owner;">
+  <!ENTITY notice "ignored">
+  <!ENTITY logo SYSTEM "logo.gif" NDATA gif>
+  <!ENTITY % param "ignored">
+  <!ATTLIST item
+      lang    CDATA          "en"
+      kind    (a|b)          "a"
+      id      ID             #IMPLIED
+      xmlns:x CDATA  #FIXED  "urn:x"
+      x:note  CDATA          "by &owner;">
+]>
+<doc><item lang="fr">a&nbsp;b</item><item>&notice;</item></doc>`
+
+func TestParseDTD(t *testing.T) {
+	d := NewDecoder(strings.NewReader(dtdInput))
+	d.ParseDTD = true
+	var items []StartElement
+	var text []string
+	for {
+		tok, err := d.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatalf("Token: %v", err)
+		}
+		switch tok := tok.(type) {
+		case StartElement:
+			if tok.Name.Local == "item" {
+				items = append(items, tok.Copy())
+			}
+		case CharData:
+			text = append(text, string(tok))
+		}
+	}
+	if want := []string{"\n", "\n", "a b", "© ACME & Co."}; !reflect.DeepEqual(text, want) {
+		t.Errorf("character data = %q, want %q", text, want)
+	}
+	want := []Attr{
+		{Name{"", "lang"}, "fr"},
+		{Name{"", "kind"}, "a"},
+		{Name{"xmlns", "x"}, "urn:x"},
+		{Name{"urn:x", "note"}, "by ACME & Co."},
+	}
+	if len(items) != 2 {
+		t.Fatalf("got %d items, want 2", len(items))
+	}
+	if !reflect.DeepEqual(items[0].Attr, want) {
+		t.Errorf("item attributes:\nhave %v\nwant %v", items[0].Attr, want)
+	}
+	want[0].Value = "en"
+	if !reflect.DeepEqual(items[1].Attr, want) {
+		t.Errorf("item attributes:\nhave %v\nwant %v", items[1].Attr, want)
+	}
+}
+
+func TestParseDTDDisabled(t *testing.T) {
+	d := NewDecoder(strings.NewReader(dtdInput))
+	for {
+		_, err := d.Token()
+		if err == io.EOF {
+			t.Fatal("decoded document with undeclared entity")
+		}
+		if err != nil {
+			break
+		}
+	}
+}
+
+func TestParseDTDUnmarshal(t *testing.T) {
+	var v struct {
+		Items []struct {
+			Lang string `xml:"lang,attr"`
+			Text string `xml:",chardata"`
+		} `xml:"item"`
+	}
+	d := NewDecoder(strings.NewReader(dtdInput))
+	d.ParseDTD = true
+	if err := d.Decode(&v); err != nil {
+		t.Fatal(err)
+	}
+	if len(v.Items) != 2 || v.Items[1].Lang != "en" || v.Items[1].Text != "© ACME & Co." {
+		t.Errorf("Decode = %+v", v)
+	}
+}
+
+var dtdErrorTests = []struct {
+	in  string
+	err string
+}{
+	{`<!DOCTYPE d [<!ENTITY e SYSTEM "file:///etc/passwd">]><d>&e;</d>`, "reference to external entity &e;"},
+	{`<!DOCTYPE d [<!ENTITY a "&b;"><!ENTITY b "&a;">]><d>&a;</d>`, "recursive reference to entity &a;"},
+	{`<!DOCTYPE d [<!ENTITY a "&undefined;">]><d>&a;</d>`, "undeclared entity &undefined;"},
+	{`<!DOCTYPE d [<!ENTITY a "&#0;">]><d/>`, "invalid character reference &#0; in entity value"},
+	{`<!DOCTYPE d [<!ENTITY a>]><d/>`, "invalid ENTITY declaration"},
+	{`<!DOCTYPE d [<!ATTLIST d a CDATA>]><d/>`, "invalid ATTLIST declaration"},
+	{`<!DOCTYPE d [junk]><d/>`, "invalid markup in DTD internal subset"},
+	{`<!DOCTYPE d [
+<!ENTITY lol "lol">
+<!ENTITY lol1 "&lol;&lol;&lol;&lol;&lol;&lol;&lol;&lol;&lol;&lol;">
+<!ENTITY lol2 "&lol1;&lol1;&lol1;&lol1;&lol1;&lol1;&lol1;&lol1;&lol1;&lol1;">
+<!ENTITY lol3 "&lol2;&lol2;&lol2;&lol2;&lol2;&lol2;&lol2;&lol2;&lol2;&lol2;">
+<!ENTITY lol4 "&lol3;&lol3;&lol3;&lol3;&lol3;&lol3;&lol3;&lol3;&lol3;&lol3;">
+<!ENTITY lol5 "&lol4;&lol4;&lol4;&lol4;&lol4;&lol4;&lol4;&lol4;&lol4;&lol4;">
+<!ENTITY lol6 "&lol5;&lol5;&lol5;&lol5;&lol5;&lol5;&lol5;&lol5;&lol5;&lol5;">
+<!ENTITY lol7 "&lol6;&lol6;&lol6;&lol6;&lol6;&lol6;&lol6;&lol6;&lol6;&lol6;">
+<!ENTITY lol8 "&lol7;&lol7;&lol7;&lol7;&lol7;&lol7;&lol7;&lol7;&lol7;&lol7;">
+<!ENTITY lol9 "&lol8;&lol8;&lol8;&lol8;&lol8;&lol8;&lol8;&lol8;&lol8;&lol8;">
+]><d>&lol9;</d>`, "entity expansion exceeds limit"},
+}
+
+func TestParseDTDErrors(t *testing.T) {
+	for _, tt := range dtdErrorTests {
+		d := NewDecoder(strings.NewReader(tt.in))
+		d.ParseDTD = true
+		var err error
+		for err == nil {
+			_, err = d.Token()
+		}
+		var serr *SyntaxError
+		if !errors.As(err, &serr) || serr.Msg != tt.err {
+			t.Errorf("decoding %q: error = %v, want %s", tt.in, err, tt.err)
+		}
+	}
+}
+
+func TestMaxEntityExpansion(t *testing.T) {
+	in := `<!DOCTYPE d [<!ENTITY e "0123456789">]><d>&e;&e;&e;</d>`
+	d := NewDecoder(strings.NewReader(in))
+	d.ParseDTD = true
+	d.MaxEntityExpansion = 25
+	var err error
+	for err == nil {
+		_, err = d.Token()
+	}
+	if serr, ok := err.(*SyntaxError); !ok || serr.Msg != "entity expansion exceeds limit" {
+		t.Errorf("error = %v, want entity expansion limit", err)
+	}
+}
+
+func TestMaxEntityExpansionDefaultAttr(t *testing.T) {
+	// The default value is expanded once, but added to every element.
+	in := `<!DOCTYPE d [
+<!ENTITY e "0123456789">
+<!ATTLIST x a CDATA "&e;" b CDATA "literal">
+]><d>` + strings.Repeat("<x/>", 10) + `</d>`
+	for _, tt := range []struct {
+		max     int
+		wantErr bool
+	}{
+		{110, false}, // 10 bytes for the declaration and 10 for each element
+		{109, true},
+	} {
+		d := NewDecoder(strings.NewReader(in))
+		d.ParseDTD = true
+		d.MaxEntityExpansion = tt.max
+		var err error
+		for err == nil {
+			_, err = d.Token()
+		}
+		if tt.wantErr {
+			if serr, ok := err.(*SyntaxError); !ok || serr.Msg != "entity expansion exceeds limit" {
+				t.Errorf("max %d: error = %v, want entity expansion limit", tt.max, err)
+			}
+		} else if err != io.EOF {
+			t.Errorf("max %d: error = %v, want EOF", tt.max, err)
+		}
+	}
+}
+
+// elementValidator rejects documents in which an element
+// contains elements not listed in its content model.
+type elementValidator struct {
+	content map[string][]string
+	stack   []string
+	tokens  []string
+}
+
+func (v *elementValidator) ValidateToken(t Token) error {
+	switch t := t.(type) {
+	case StartElement:
+		if n := len(v.stack); n > 0 {
+			parent := v.stack[n-1]
+			ok := false
+			for _, c := range v.content[parent] {
+				ok = ok || c == t.Name.Local
+			}
+			if !ok {
+				return errors.New("element " + t.Name.Local + " not allowed in " + parent)
+			}
+		}
+		v.stack = append(v.stack, t.Name.Local)
+		v.tokens = append(v.tokens, "<"+t.Name.Space+" "+t.Name.Local+">")
+	case EndElement:
+		v.stack = v.stack[:len(v.stack)-1]
+		v.tokens = append(v.tokens, "</"+t.Name.Local+">")
+	case CharData:
+		v.tokens = append(v.tokens, string(t))
+	}
+	return nil
+}
+
+func TestValidator(t *testing.T) {
+	content := map[string][]string{"list": {"item"}}
+
+	v := &elementValidator{content: content}
+	d := NewDecoder(strings.NewReader(`<?xml version="1.0"?><list xmlns="urn:l"><!-- c --><item>1</item></list>`))
+	d.Validator = v
+	for {
+		_, err := d.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	want := []string{"<urn:l list>", "<urn:l item>", "1", "</item>", "</list>"}
+	if !reflect.DeepEqual(v.tokens, want) {
+		t.Errorf("validated tokens = %q, want %q", v.tokens, want)
+	}
+
+	v = &elementValidator{content: content}
+	d = NewDecoder(strings.NewReader(`<list><item/><other/><item/></list>`))
+	d.Validator = v
+	var err error
+	for err == nil {
+		_, err = d.Token()
+	}
+	if err == nil || err.Error() != "element other not allowed in list" {
+		t.Errorf("error = %v, want validation error", err)
+	}
+	if _, err2 := d.Token(); err2 != err {
+		t.Errorf("Token after validation error = %v, want %v", err2, err)
+	}
+
+	// Validation errors are returned by Decode.
+	d = NewDecoder(strings.NewReader(`<list><item/><other/></list>`))
+	d.Validator = &elementValidator{content: content}
+	var x struct{}
+	if err := d.Decode(&x); err == nil || err.Error() != "element other not allowed in list" {
+		t.Errorf("Decode error = %v, want validation error", err)
+	}
+}
@@ -160,6 +160,46 @@ func (enc *Encoder) Indent(prefix, indent string) {
 	enc.p.indent = indent
 }
 
+// BindPrefix causes the encoder to write element and attribute names in
+// the name space url with the given prefix, declaring the binding with an
+// xmlns:prefix attribute on the outermost element that needs it. If prefix
+// is empty, elements in url are written without a prefix and url is
+// declared as the default name space where it is not already in scope.
+// Attributes cannot be in a default name space, so attribute names in url
+// are then written with a generated prefix. An element with an empty
+// Name.Space is written without a prefix and inherits the default name
+// space of its parent, as it does when no prefixes are bound.
+//
+// By default, the encoder writes an xmlns attribute on every element with
+// a name space and invents prefixes for attributes. Once a prefix has been
+// bound, the encoder also honors the name space declarations among the
+// attributes of a [StartElement], such as those returned by [Decoder.Token]:
+// an attribute with Name{Space: "xmlns", Local: p} binds the prefix p, and
+// an attribute with Name{Local: "xmlns"} sets the default name space, for
+// that element and its children. Names in those name spaces are then
+// written with the declared prefix, so tokens read from a document can be
+// encoded again with their original prefixes.
+//
+// BindPrefix returns an error if prefix is not a valid name without a colon,
+// if it begins with "xml", or if url is empty or one of the reserved name
+// spaces bound to the xml and xmlns prefixes.
+func (enc *Encoder) BindPrefix(prefix, url string) error {
+	switch {
+	case prefix != "" && (!isNameString(prefix) || strings.Contains(prefix, ":")):
+		return fmt.Errorf("xml: invalid name space prefix %q", prefix)
+	case len(prefix) >= 3 && strings.EqualFold(prefix[:3], "xml"):
+		return fmt.Errorf("xml: reserved name space prefix %q", prefix)
+	case url == "" || url == xmlURL || url == xmlnsURL:
+		return fmt.Errorf("xml: cannot bind prefix %q to name space %q", prefix, url)
+	}
+	p := &enc.p
+	if p.bindings == nil {
+		p.bindings = make(map[string]string)
+	}
+	p.bindings[url] = prefix
+	return nil
+}
+
 // Encode writes the XML encoding of v to the stream.
 //
 // See the documentation for [Marshal] for details about the conversion
@@ -326,8 +366,10 @@ type printer struct {
 	putNewline bool
 	attrNS     map[string]string // map prefix -> name space
 	attrPrefix map[string]string // map name space -> prefix
-	prefixes   []string
+	prefixes   []prefixBinding
+	bindings   map[string]string // map name space -> prefix, set by BindPrefix
 	tags       []Name
+	qnames     []string // qualified names of the open elements
 	closed     bool
 	err        error
 }
@@ -348,9 +390,18 @@ func (p *printer) createAttrPrefix(url string) string {
 	}
 
 	// Need to define a new name space.
-	if p.attrPrefix == nil {
-		p.attrPrefix = make(map[string]string)
-		p.attrNS = make(map[string]string)
+	prefix := p.newPrefix(url)
+	p.bindPrefix(prefix, url)
+	p.writeNSDecl(prefix, url)
+	return prefix
+}
+
+// newPrefix returns an unused prefix for the name space url.
+func (p *printer) newPrefix(url string) string {
+	// Use the prefix bound with BindPrefix, unless it is in use
+	// for another name space.
+	if prefix := p.bindings[url]; prefix != "" && p.attrNS[prefix] == "" {
+		return prefix
 	}
 
 	// Pick a name. We try to use the final element of the path
@@ -369,48 +420,92 @@ func (p *printer) createAttrPrefix(url string) string {
 	if len(prefix) >= 3 && strings.EqualFold(prefix[:3], "xml") {
 		prefix = "_" + prefix
 	}
-	if p.attrNS[prefix] != "" {
+	if p.attrNS[prefix] != "" || p.isBound(prefix) {
 		// Name is taken. Find a better one.
 		for p.seq++; ; p.seq++ {
-			if id := prefix + "_" + strconv.Itoa(p.seq); p.attrNS[id] == "" {
+			if id := prefix + "_" + strconv.Itoa(p.seq); p.attrNS[id] == "" && !p.isBound(id) {
 				prefix = id
 				break
 			}
 		}
 	}
+	return prefix
+}
 
-	p.attrPrefix[url] = prefix
+// isBound reports whether prefix has been bound to a name space with
+// BindPrefix.
+func (p *printer) isBound(prefix string) bool {
+	for _, bound := range p.bindings {
+		if bound == prefix {
+			return true
+		}
+	}
+	return false
+}
+
+// A prefixBinding records a name space prefix bound by an open element,
+// and the name space it was bound to before, so that the binding can be
+// undone when the element ends. A binding with mark set separates the
+// bindings of successive elements.
+type prefixBinding struct {
+	mark    bool
+	prefix  string // "" for the default name space
+	prevURL string
+}
+
+// bindPrefix binds prefix to url for the innermost open element.
+func (p *printer) bindPrefix(prefix, url string) {
+	if p.attrPrefix == nil {
+		p.attrPrefix = make(map[string]string)
+		p.attrNS = make(map[string]string)
+	}
+	p.prefixes = append(p.prefixes, prefixBinding{prefix: prefix, prevURL: p.attrNS[prefix]})
 	p.attrNS[prefix] = url
+	if prefix != "" {
+		p.attrPrefix[url] = prefix
+	}
+}
 
-	p.WriteString(`xmlns:`)
-	p.WriteString(prefix)
+// writeNSDecl writes an attribute declaring the binding of prefix to url,
+// preceded by a space.
+func (p *printer) writeNSDecl(prefix, url string) {
+	p.WriteString(` xmlns`)
+	if prefix != "" {
+		p.WriteByte(':')
+		p.WriteString(prefix)
+	}
 	p.WriteString(`="`)
 	EscapeText(p, []byte(url))
-	p.WriteString(`" `)
-
-	p.prefixes = append(p.prefixes, prefix)
-
-	return prefix
+	p.WriteByte('"')
 }
 
-// deleteAttrPrefix removes an attribute name space prefix.
-func (p *printer) deleteAttrPrefix(prefix string) {
-	delete(p.attrPrefix, p.attrNS[prefix])
-	delete(p.attrNS, prefix)
+// deleteAttrPrefix undoes the binding of a name space prefix.
+func (p *printer) deleteAttrPrefix(b prefixBinding) {
+	if url := p.attrNS[b.prefix]; b.prefix != "" && p.attrPrefix[url] == b.prefix {
+		delete(p.attrPrefix, url)
+	}
+	if b.prevURL == "" {
+		delete(p.attrNS, b.prefix)
+		return
+	}
+	p.attrNS[b.prefix] = b.prevURL
+	if b.prefix != "" {
+		p.attrPrefix[b.prevURL] = b.prefix
+	}
 }
 
 func (p *printer) markPrefix() {
-	p.prefixes = append(p.prefixes, "")
+	p.prefixes = append(p.prefixes, prefixBinding{mark: true})
 }
 
 func (p *printer) popPrefix() {
 	for len(p.prefixes) > 0 {
-		prefix := p.prefixes[len(p.prefixes)-1]
+		b := p.prefixes[len(p.prefixes)-1]
 		p.prefixes = p.prefixes[:len(p.prefixes)-1]
-		if prefix == "" {
+		if b.mark {
 			break
 		}
-		p.deleteAttrPrefix(prefix)
+		p.deleteAttrPrefix(b)
 	}
 }
 
@@ -726,12 +821,16 @@ func (p *printer) writeStart(start *StartElement) error {
 
 	p.writeIndent(1)
 	p.WriteByte('<')
-	p.WriteString(start.Name.Local)
-
-	if start.Name.Space != "" {
-		p.WriteString(` xmlns="`)
-		p.EscapeString(start.Name.Space)
-		p.WriteByte('"')
+	if p.bindings != nil {
+		p.writePrefixedName(start)
+	} else {
+		p.qnames = append(p.qnames, start.Name.Local)
+		p.WriteString(start.Name.Local)
+		if start.Name.Space != "" {
+			p.WriteString(` xmlns="`)
+			p.EscapeString(start.Name.Space)
+			p.WriteByte('"')
+		}
 	}
 
 	// Attributes
@@ -740,9 +839,17 @@ func (p *printer) writeStart(start *StartElement) error {
 		if name.Local == "" {
 			continue
 		}
-		p.WriteByte(' ')
+		if p.bindings != nil && isNSDecl(name) {
+			// Declared by writePrefixedName.
+			continue
+		}
+		var prefix string
 		if name.Space != "" {
-			p.WriteString(p.createAttrPrefix(name.Space))
+			prefix = p.createAttrPrefix(name.Space)
+		}
+		p.WriteByte(' ')
+		if prefix != "" {
+			p.WriteString(prefix)
 			p.WriteByte(':')
 		}
 		p.WriteString(name.Local)
@@ -768,16 +875,87 @@ func (p *printer) writeEnd(name Name) error {
 		return fmt.Errorf("xml: end tag </%s> in namespace %s does not match start tag <%s> in namespace %s", name.Local, name.Space, top.Local, top.Space)
 	}
 	p.tags = p.tags[:len(p.tags)-1]
+	qname := p.qnames[len(p.qnames)-1]
+	p.qnames = p.qnames[:len(p.qnames)-1]
 
 	p.writeIndent(-1)
 	p.WriteByte('<')
 	p.WriteByte('/')
-	p.WriteString(name.Local)
+	p.WriteString(qname)
 	p.WriteByte('>')
 	p.popPrefix()
 	return nil
 }
 
+// isNSDecl reports whether an attribute with the given name is a name
+// space declaration.
+func isNSDecl(name Name) bool {
+	return name.Space == xmlnsPrefix || name.Space == "" && name.Local == xmlnsPrefix
+}
+
+// writePrefixedName writes the name of the start element and the name
+// space declarations it needs, when prefixes have been bound with
+// BindPrefix.
+func (p *printer) writePrefixedName(start *StartElement) {
+	// Apply the element's own declarations first, so that its name
+	// can use them.
+	explicitDefault := false
+	for _, attr := range start.Attr {
+		switch {
+		case attr.Name.Space == xmlnsPrefix && attr.Name.Local != "":
+			p.bindPrefix(attr.Name.Local, attr.Value)
+		case attr.Name.Space == "" && attr.Name.Local == xmlnsPrefix:
+			p.bindPrefix("", attr.Value)
+			explicitDefault = true
+		}
+	}
+
+	name := start.Name
+	var prefix string
+	declare := false
+	switch bound, ok := p.bindings[name.Space]; {
+	case name.Space == "" || name.Space == p.attrNS[""]:
+		// In the default name space.
+	case name.Space == xmlURL:
+		prefix = xmlPrefix
+	case ok && bound == "" && !explicitDefault:
+		p.bindPrefix("", name.Space)
+		declare = true
+	case ok && bound != "" && p.attrNS[bound] == name.Space:
+		prefix = bound
+	case p.attrPrefix[name.Space] != "":
+		prefix = p.attrPrefix[name.Space]
+	case ok || explicitDefault:
+		// Use the bound prefix if it is free. If the element
+		// declares a different default name space, we need a
+		// prefix anyway.
+		prefix = p.newPrefix(name.Space)
+		p.bindPrefix(prefix, name.Space)
+		declare = true
+	default:
+		p.bindPrefix("", name.Space)
+		declare = true
+	}
+
+	qname := name.Local
+	if prefix != "" {
+		qname = prefix + ":" + name.Local
+	}
+	p.qnames = append(p.qnames, qname)
+	p.WriteString(qname)
+	if declare {
+		p.writeNSDecl(prefix, name.Space)
+	}
+	for _, attr := range start.Attr {
+		switch {
+		case attr.Name.Space == xmlnsPrefix && attr.Name.Local != "":
+			p.writeNSDecl(attr.Name.Local, attr.Value)
+		case attr.Name.Space == "" && attr.Name.Local == xmlnsPrefix:
+			p.writeNSDecl("", attr.Value)
+		}
+	}
+}
+
 func (p *printer) marshalSimple(typ reflect.Type, val reflect.Value) (string, []byte, error) {
 	switch val.Kind() {
 	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
@@ -2589,3 +2589,112 @@ func TestClose(t *testing.T) {
 		})
 	}
 }
+
+const soapURL = "http://schemas.xmlsoap.org/soap/envelope/"
+
+type soapEnvelope struct {
+	XMLName Name     `xml:"http://schemas.xmlsoap.org/soap/envelope/ Envelope"`
+	Body    soapBody `xml:"http://schemas.xmlsoap.org/soap/envelope/ Body"`
+}
+
+type soapBody struct {
+	Request getQuote
+}
+
+type getQuote struct {
+	XMLName Name   `xml:"urn:stocks GetQuote"`
+	Lang    string `xml:"http://www.w3.org/XML/1998/namespace lang,attr"`
+	Must    string `xml:"http://schemas.xmlsoap.org/soap/envelope/ mustUnderstand,attr"`
+	Symbol  string `xml:"urn:stocks Symbol"`
+}
+
+func TestBindPrefix(t *testing.T) {
+	v := soapEnvelope{Body: soapBody{getQuote{Lang: "en", Must: "1", Symbol: "GOOG"}}}
+	tests := []struct {
+		bindings [][2]string
+		want     string
+	}{{
+		bindings: [][2]string{{"soap", soapURL}},
+		want: `<soap:Envelope xmlns:soap="http://schemas.xmlsoap.org/soap/envelope/"><soap:Body>` +
+			`<GetQuote xmlns="urn:stocks" xml:lang="en" soap:mustUnderstand="1"><Symbol>GOOG</Symbol></GetQuote>` +
+			`</soap:Body></soap:Envelope>`,
+	}, {
+		bindings: [][2]string{{"soap", soapURL}, {"m", "urn:stocks"}},
+		want: `<soap:Envelope xmlns:soap="http://schemas.xmlsoap.org/soap/envelope/"><soap:Body>` +
+			`<m:GetQuote xmlns:m="urn:stocks" xml:lang="en" soap:mustUnderstand="1"><m:Symbol>GOOG</m:Symbol></m:GetQuote>` +
+			`</soap:Body></soap:Envelope>`,
+	}, {
+		bindings: [][2]string{{"", soapURL}},
+		want: `<Envelope xmlns="http://schemas.xmlsoap.org/soap/envelope/"><Body>` +
+			`<GetQuote xmlns="urn:stocks" xml:lang="en" xmlns:envelope="http://schemas.xmlsoap.org/soap/envelope/" envelope:mustUnderstand="1"><Symbol>GOOG</Symbol></GetQuote>` +
+			`</Body></Envelope>`,
+	}}
+	for _, tt := range tests {
+		var b strings.Builder
+		enc := NewEncoder(&b)
+		for _, binding := range tt.bindings {
+			if err := enc.BindPrefix(binding[0], binding[1]); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if err := enc.Encode(v); err != nil {
+			t.Fatal(err)
+		}
+		if got := b.String(); got != tt.want {
+			t.Errorf("bindings %q:\nhave %s\nwant %s", tt.bindings, got, tt.want)
+		}
+		var v2 soapEnvelope
+		if err := Unmarshal([]byte(b.String()), &v2); err != nil {
+			t.Fatal(err)
+		}
+		if v2.Body.Request.Symbol != "GOOG" || v2.Body.Request.Must != "1" {
+			t.Errorf("bindings %q: Unmarshal = %+v", tt.bindings, v2)
+		}
+	}
+}
+
+func TestBindPrefixTokens(t *testing.T) {
+	// Tokens read with Token are encoded again with their original prefixes.
+	const in = `<ds:Signature xmlns:ds="http://www.w3.org/2000/09/xmldsig#" xmlns:x="urn:x">` +
+		`<ds:SignedInfo x:id="a"><Other xmlns="urn:other"><Inner></Inner></Other></ds:SignedInfo></ds:Signature>`
+	d := NewDecoder(strings.NewReader(in))
+	var b strings.Builder
+	enc := NewEncoder(&b)
+	if err := enc.BindPrefix("ds", "http://www.w3.org/2000/09/xmldsig#"); err != nil {
+		t.Fatal(err)
+	}
+	for {
+		tok, err := d.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := enc.EncodeToken(tok); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := enc.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if got := b.String(); got != in {
+		t.Errorf("have %s\nwant %s", got, in)
+	}
+}
+
+func TestBindPrefixErrors(t *testing.T) {
+	enc := NewEncoder(io.Discard)
+	for _, tt := range [][2]string{
+		{"a:b", "urn:x"},
+		{"1a", "urn:x"},
+		{"xmlfoo", "urn:x"},
+		{"a", ""},
+		{"a", xmlURL},
+		{"a", xmlnsURL},
+	} {
+		if err := enc.BindPrefix(tt[0], tt[1]); err == nil {
+			t.Errorf("BindPrefix(%q, %q) succeeded", tt[0], tt[1])
+		}
+	}
+}
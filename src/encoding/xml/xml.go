@@ -197,6 +197,35 @@ type Decoder struct {
 	// the attribute xmlns="DefaultSpace".
 	DefaultSpace string
 
+	// ParseDTD, if set, causes the parser to process the internal
+	// subset of the document type declaration. Entities declared
+	// there with a literal value can then be referenced in character
+	// data and attribute values; their replacement text is treated
+	// as character data. Default attribute values declared with
+	// ATTLIST are added to elements that do not specify them.
+	// External entities are never fetched: referring to one is an
+	// error. Parameter entities are ignored.
+	ParseDTD bool
+
+	// MaxEntityExpansion limits the total number of bytes that
+	// references to entities declared in the DTD may expand to over
+	// the whole document, guarding against entity expansion attacks.
+	// A default attribute value that refers to entities counts
+	// each time it is added to an element.
+	// Zero means DefaultMaxEntityExpansion.
+	MaxEntityExpansion int
+
+	// NormalizeAttributes, if set, causes the parser to normalize
+	// attribute values as the XML specification requires: literal tab,
+	// newline and carriage return characters, including those in the
+	// replacement text of entities, become spaces, while those written
+	// as character references are kept.
+	NormalizeAttributes bool
+
+	// Validator, if non-nil, is called with each token returned by
+	// Token, allowing a document to be validated while it is decoded.
+	Validator Validator
+
 	r              io.ByteReader
 	t              TokenReader
 	buf            bytes.Buffer
@@ -213,6 +242,7 @@ type Decoder struct {
 	linestart      int64
 	offset         int64
 	unmarshalDepth int
+	dtd            *dtd
 }
 
 // NewDecoder creates a new XML parser reading from r.
@@ -330,11 +360,21 @@ func (d *Decoder) Token() (Token, error) {
 		}
 		t = t1
 	}
+	if d.Validator != nil {
+		switch t.(type) {
+		case StartElement, EndElement, CharData:
+			if verr := d.Validator.ValidateToken(t); verr != nil {
+				d.err = verr
+				return nil, verr
+			}
+		}
+	}
 	return t, err
 }
 
 const (
 	xmlURL      = "http://www.w3.org/XML/1998/namespace"
+	xmlnsURL    = "http://www.w3.org/2000/xmlns/"
 	xmlnsPrefix = "xmlns"
 	xmlPrefix   = "xml"
 )
@@ -775,6 +815,12 @@ func (d *Decoder) rawToken() (Token, error) {
 				d.buf.WriteByte(' ')
 			}
 		}
+		if d.ParseDTD {
+			if err := d.doctype(d.buf.Bytes()); err != nil {
+				d.err = err
+				return nil, err
+			}
+		}
 		return Directive(d.buf.Bytes()), nil
 	}
 
@@ -847,6 +893,13 @@ func (d *Decoder) rawToken() (Token, error) {
 		d.needClose = true
 		d.toClose = name
 	}
+	if d.dtd != nil {
+		var msg string
+		if attr, msg = d.dtd.addDefaultAttrs(name, attr); msg != "" {
+			d.err = d.syntaxError(msg)
+			return nil, d.err
+		}
+	}
 	return StartElement{name, attr}, nil
 }
 
@@ -989,6 +1042,7 @@ var entity = map[string]rune{
 func (d *Decoder) text(quote int, cdata bool) []byte {
 	var b0, b1 byte
 	var trunc int
+	normalize := quote >= 0 && d.NormalizeAttributes
 	d.buf.Reset()
 Input:
 	for {
@@ -1037,11 +1091,12 @@ Input:
 			d.buf.WriteByte('&')
 			var ok bool
 			var text string
-			var haveText bool
+			var haveText, charRef bool
 			if b, ok = d.mustgetc(); !ok {
 				return nil
 			}
 			if b == '#' {
+				charRef = true
 				d.buf.WriteByte(b)
 				if b, ok = d.mustgetc(); !ok {
 					return nil
@@ -1097,11 +1152,22 @@ Input:
 						} else if d.Entity != nil {
 							text, haveText = d.Entity[s]
 						}
+						if !haveText && d.dtd != nil {
+							var msg string
+							text, haveText, msg = d.dtd.entity(s)
+							if msg != "" {
+								d.err = d.syntaxError(msg)
+								return nil
+							}
+						}
 					}
 				}
 			}
 
 			if haveText {
+				if normalize && !charRef {
+					text = normalizeAttr(text, true)
+				}
 				d.buf.Truncate(before)
 				d.buf.WriteString(text)
 				b0, b1 = 0, 0
@@ -1119,12 +1185,16 @@ Input:
 			return nil
 		}
 
-		// We must rewrite unescaped \r and \r\n into \n.
-		if b == '\r' {
+		// We must rewrite unescaped \r and \r\n into \n,
+		// or into a space when normalizing an attribute value.
+		switch {
+		case b1 == '\r' && b == '\n':
+			// Skip \r\n--we already rewrote the \r.
+		case normalize && (b == '\t' || b == '\n' || b == '\r'):
+			d.buf.WriteByte(' ')
+		case b == '\r':
 			d.buf.WriteByte('\n')
-		} else if b1 == '\r' && b == '\n' {
-			// Skip \r\n--we already wrote \n.
-		} else {
+		default:
 			d.buf.WriteByte(b)
 		}
 
@@ -707,6 +707,45 @@ func TestValuelessAttrs(t *testing.T) {
 	}
 }
 
+func TestNormalizeAttributes(t *testing.T) {
+	tests := []struct {
+		in, normalized, raw string
+	}{
+		{"<a x='1\t2\r\n3\r4\n5'>text\t\n</a>", "1 2 3 4 5", "1\t2\n3\n4\n5"},
+		{"<a x='1&#9;2&#xA;3&#13;4'/>", "1\t2\n3\r4", "1\t2\n3\r4"},
+		{"<!DOCTYPE a [<!ENTITY e 'x&#9;y\nz'>]><a x='&e;&#9;'/>", "x y z\t", "x\ty\nz\t"},
+	}
+	for _, tt := range tests {
+		for _, normalize := range []bool{true, false} {
+			d := NewDecoder(strings.NewReader(tt.in))
+			d.ParseDTD = true
+			d.NormalizeAttributes = normalize
+			var tok Token
+			var err error
+			for {
+				if tok, err = d.Token(); err != nil {
+					t.Fatalf("%q: %v", tt.in, err)
+				}
+				if _, ok := tok.(StartElement); ok {
+					break
+				}
+			}
+			want := tt.raw
+			if normalize {
+				want = tt.normalized
+			}
+			if got := tok.(StartElement).Attr[0].Value; got != want {
+				t.Errorf("%q with NormalizeAttributes=%v: attribute value %q, want %q", tt.in, normalize, got, want)
+			}
+			if tok, _ = d.Token(); tok != nil {
+				if cd, ok := tok.(CharData); ok && string(cd) != "text\t\n" {
+					t.Errorf("%q: character data %q, want %q", tt.in, cd, "text\t\n")
+				}
+			}
+		}
+	}
+}
+
 func TestCopyTokenCharData(t *testing.T) {
 	data := []byte("same data")
 	var tok1 Token = CharData(data)
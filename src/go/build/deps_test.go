@@ -224,7 +224,9 @@ var depsRules = `
 
 	# Misc packages needing only FMT.
 	FMT
-	< html,
+	< archive/ar,
+	  archive/cpio,
+	  html,
 	  internal/dag,
 	  internal/goroot,
 	  internal/types/errors,
@@ -255,6 +257,9 @@ var depsRules = `
 	< encoding/ascii85, encoding/csv, encoding/gob, encoding/hex,
 	  encoding/json, encoding/pem, encoding/xml, mime;
 
+	encoding/json
+	< encoding/json/jsonpatch;
+
 	# hashes
 	io
 	< hash
@@ -264,10 +269,14 @@ var depsRules = `
 	FMT, math/rand
 	< math/big;
 
+	FMT, encoding/binary, math/big
+	< encoding/cbor;
+
 	# compression
 	FMT, encoding/binary, hash/adler32, hash/crc32, sort
-	< compress/bzip2, compress/flate, compress/lzw, internal/zstd
-	< archive/zip, compress/gzip, compress/zlib;
+	< compress/bzip2, compress/brotli, compress/flate, compress/lzw, internal/zstd
+	< compress/zstd
+	< compress/gzip, compress/zlib;
 
 	# templates
 	FMT
@@ -348,13 +357,18 @@ var depsRules = `
 	database/sql/driver, math/rand/v2 < database/sql;
 
 	# images
-	FMT, compress/lzw, compress/zlib
+	FMT, compress/lzw, compress/zlib, encoding/binary
 	< image/color
 	< image, image/color/palette
 	< image/internal/imageutil
 	< image/draw
 	< image/gif, image/jpeg, image/png;
 
+	image/internal/imageutil
+	< image/tiff/internal/ccitt, image/tiff/internal/lzw,
+	  image/webp/internal/riff, image/webp/internal/vp8, image/webp/internal/vp8l
+	< image/bmp, image/tiff, image/webp;
+
 	# cgo, delayed as long as possible.
 	# If you add a dependency on CGO, you must add the package
 	# to cgoPackages in cmd/dist/test.go as well.
@@ -467,13 +481,22 @@ var depsRules = `
 	< crypto/internal/edwards25519/field
 	< crypto/internal/edwards25519;
 
+	crypto/internal/alias
+	< crypto/internal/edwards448/field;
+
 	crypto/boring
 	< crypto/aes, crypto/des, crypto/hmac, crypto/md5, crypto/rc4,
 	  crypto/sha1, crypto/sha256, crypto/sha512;
 
-	crypto/boring, crypto/internal/edwards25519/field
+	crypto/boring,
+	crypto/internal/edwards25519/field,
+	crypto/internal/edwards448/field
 	< crypto/ecdh;
 
+	crypto/hmac, crypto/sha256
+	< internal/pbkdf2
+	< crypto/internal/scrypt;
+
 	# Unfortunately, stuck with reflect via encoding/binary.
 	encoding/binary, crypto/boring < golang.org/x/crypto/sha3;
 
@@ -482,6 +505,7 @@ var depsRules = `
 	crypto/ecdh,
 	crypto/hmac,
 	crypto/internal/edwards25519,
+	crypto/internal/scrypt,
 	crypto/md5,
 	crypto/rc4,
 	crypto/sha1,
@@ -492,6 +516,10 @@ var depsRules = `
 
 	CGO, fmt, net !< CRYPTO;
 
+	# compress/xz can check SHA-256 sums.
+	CRYPTO, FMT, hash/crc32, hash/crc64
+	< compress/xz;
+
 	# CRYPTO-MATH is core bignum-based crypto - no cgo, net; fmt now ok.
 	CRYPTO, FMT, math/big
 	< crypto/internal/boring/bbig
@@ -502,12 +530,19 @@ var depsRules = `
 	< golang.org/x/crypto/cryptobyte/asn1
 	< golang.org/x/crypto/cryptobyte
 	< crypto/internal/bigmod
+	< crypto/internal/edwards448
+	< crypto/ed448
 	< crypto/dsa, crypto/elliptic, crypto/rsa
 	< crypto/ecdsa
 	< CRYPTO-MATH;
 
 	CGO, net !< CRYPTO-MATH;
 
+	# archive/zip encrypts files with a random salt
+	# and derives the keys with PBKDF2.
+	compress/zstd, crypto/rand, internal/pbkdf2
+	< archive/zip;
+
 	# TLS, Prince of Dependencies.
 	CRYPTO-MATH, NET, container/list, encoding/hex, encoding/pem
 	< golang.org/x/crypto/internal/alias
@@ -524,6 +559,9 @@ var depsRules = `
 	< crypto/x509
 	< crypto/tls;
 
+	crypto/x509
+	< crypto/x509/cms;
+
 	# crypto-aware packages
 
 	DEBUG, go/build, go/types, text/scanner, crypto/md5
@@ -558,6 +596,7 @@ var depsRules = `
 	< net/http/httptrace;
 
 	compress/gzip,
+	compress/zstd,
 	golang.org/x/net/http/httpguts,
 	golang.org/x/net/http/httpproxy,
 	golang.org/x/net/http2/hpack,
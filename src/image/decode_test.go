@@ -12,9 +12,12 @@ import (
 	"os"
 	"testing"
 
+	_ "image/bmp"
 	_ "image/gif"
 	_ "image/jpeg"
 	_ "image/png"
+	_ "image/tiff"
+	_ "image/webp"
 )
 
 type imageTest struct {
@@ -40,6 +43,11 @@ var imageTests = []imageTest{
 	// Grayscale images.
 	{"testdata/video-005.gray.png", "testdata/video-005.gray.jpeg", 8 << 8},
 	{"testdata/video-005.gray.png", "testdata/video-005.gray.png", 0},
+	// BMP and TIFF images are lossless.
+	{"testdata/video-001.png", "testdata/video-001.bmp", 0},
+	{"testdata/video-001.png", "testdata/video-001.tiff", 0},
+	// Lossless WebP image.
+	{"testdata/blue-purple-pink.png", "testdata/blue-purple-pink.lossless.webp", 0},
 }
 
 func decode(filename string) (image.Image, string, error) {
@@ -4,6 +4,11 @@
 
 // Package draw provides image composition functions.
 //
+// Besides [Draw] and [DrawMask], which composite images of the same scale,
+// the package provides interpolators, such as [BiLinear] and [CatmullRom],
+// that implement [Scaler] and [Transformer] to resize images and to apply
+// affine transformations to them.
+//
 // See "The Go image/draw package" for an introduction to this package:
 // https://golang.org/doc/articles/image_draw.html
 package draw
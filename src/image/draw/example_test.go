@@ -46,3 +46,49 @@ func ExampleDrawer_floydSteinberg() {
 		}
 	}
 }
+
+func ExampleKernel_Scale() {
+	// Make a 4x2 source image, with a dark left half and a light right half.
+	src := image.NewGray(image.Rect(0, 0, 4, 2))
+	for y := 0; y < 2; y++ {
+		for x := 0; x < 4; x++ {
+			src.SetGray(x, y, color.Gray{Y: uint8(0x20 + 0xc0*(x/2))})
+		}
+	}
+
+	// Shrink it to a 2x1 thumbnail and enlarge it to 8x1.
+	for _, width := range []int{2, 8} {
+		dst := image.NewGray(image.Rect(0, 0, width, 1))
+		draw.CatmullRom.Scale(dst, dst.Bounds(), src, src.Bounds(), draw.Src, nil)
+		fmt.Printf("% x\n", dst.Pix)
+	}
+
+	// Output:
+	// 30 d0
+	// 20 1b 12 47 b9 ee e5 e0
+}
+
+func ExampleTransformer() {
+	src := image.NewGray(image.Rect(0, 0, 3, 2))
+	copy(src.Pix, []uint8{
+		1, 2, 3,
+		4, 5, 6,
+	})
+
+	// Rotate the image by 90 degrees clockwise: the src-space point (x, y)
+	// maps to the dst-space point (2-y, x).
+	dst := image.NewGray(image.Rect(0, 0, 2, 3))
+	s2d := draw.Aff3{
+		0, -1, 2,
+		1, 0, 0,
+	}
+	draw.NearestNeighbor.Transform(dst, s2d, src, src.Bounds(), draw.Src, nil)
+	for y := 0; y < 3; y++ {
+		fmt.Println(dst.Pix[y*dst.Stride : y*dst.Stride+2])
+	}
+
+	// Output:
+	// [4 1]
+	// [5 2]
+	// [6 3]
+}
@@ -0,0 +1,464 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package png
+
+import (
+	"encoding/binary"
+	"hash/crc32"
+	"image"
+	"image/color"
+	"io"
+	"strconv"
+	"time"
+)
+
+// Disposal methods of the frames of an animated PNG, which say how the
+// region of the canvas that a frame covers is prepared for the next frame.
+const (
+	DisposalNone       = 0x00 // Leave the canvas as is.
+	DisposalBackground = 0x01 // Clear the region to transparent black.
+	DisposalPrevious   = 0x02 // Restore the region to what it was before the frame.
+)
+
+// Blend operations of the frames of an animated PNG, which say how a frame
+// is combined with the canvas, in the manner of the Src and Over operators
+// of the image/draw package.
+const (
+	BlendSource = 0x00 // Replace the region with the frame.
+	BlendOver   = 0x01 // Composite the frame over the region.
+)
+
+// APNG represents the possibly multiple images stored in a PNG file, which
+// is an animated PNG (APNG) if it has more than one.
+type APNG struct {
+	// Image holds the successive frames. The bounds of each frame give its
+	// position on the canvas.
+	Image []image.Image
+	// Delay holds the successive delay times, one per frame.
+	Delay []time.Duration
+	// Disposal holds the successive disposal methods, one per frame.
+	Disposal []byte
+	// Blend holds the successive blend operations, one per frame.
+	Blend []byte
+
+	// NumPlays is the number of times that the animation is played.
+	// Zero means forever.
+	NumPlays int
+
+	// Default, if non-nil, is the image that decoders which do not support
+	// animation show, which is not part of the animation. If nil, the
+	// first frame is that image.
+	Default image.Image
+
+	// Config is the color model and the size of the canvas. On encoding,
+	// a zero Config means that the canvas has the size of the bounds'
+	// maximum point of Default, or of the first frame if Default is nil.
+	// The color model is ignored on encoding.
+	Config image.Config
+
+	// Metadata holds the ancillary chunks of the image.
+	Metadata *Metadata
+}
+
+// frameControl is the content of an fcTL chunk.
+type frameControl struct {
+	width, height int
+	x, y          int
+	delay         time.Duration
+	disposal      byte
+	blend         byte
+}
+
+func (d *decoder) parseacTL(length uint32) error {
+	if length != 8 {
+		return FormatError("bad acTL length")
+	}
+	if _, err := io.ReadFull(d.r, d.tmp[:8]); err != nil {
+		return err
+	}
+	d.crc.Write(d.tmp[:8])
+	d.numFrames = binary.BigEndian.Uint32(d.tmp[0:4])
+	numPlays := binary.BigEndian.Uint32(d.tmp[4:8])
+	if d.numFrames == 0 || d.numFrames > 1<<31-1 || numPlays > 1<<31-1 {
+		return FormatError("bad acTL chunk")
+	}
+	d.anim.NumPlays = int(numPlays)
+	return d.verifyChecksum()
+}
+
+// readSequenceNumber reads the sequence number that starts fcTL and fdAT
+// chunks, and verifies that it is the next one.
+func (d *decoder) readSequenceNumber() error {
+	if _, err := io.ReadFull(d.r, d.tmp[:4]); err != nil {
+		return err
+	}
+	d.crc.Write(d.tmp[:4])
+	if binary.BigEndian.Uint32(d.tmp[:4]) != d.seq {
+		return FormatError("bad sequence number")
+	}
+	d.seq++
+	return nil
+}
+
+func (d *decoder) parsefcTL(length uint32) error {
+	if length != 26 {
+		return FormatError("bad fcTL length")
+	}
+	if err := d.readSequenceNumber(); err != nil {
+		return err
+	}
+	if _, err := io.ReadFull(d.r, d.tmp[:22]); err != nil {
+		return err
+	}
+	d.crc.Write(d.tmp[:22])
+	if d.frame != nil {
+		return FormatError("missing frame data")
+	}
+	if uint32(len(d.anim.Image)) == d.numFrames {
+		return FormatError("too many frames")
+	}
+	w := int64(binary.BigEndian.Uint32(d.tmp[0:4]))
+	h := int64(binary.BigEndian.Uint32(d.tmp[4:8]))
+	x := int64(binary.BigEndian.Uint32(d.tmp[8:12]))
+	y := int64(binary.BigEndian.Uint32(d.tmp[12:16]))
+	if w == 0 || h == 0 || x+w > int64(d.width) || y+h > int64(d.height) {
+		return FormatError("bad frame bounds")
+	}
+	if d.stage < dsSeenIDAT && (x != 0 || y != 0 || w != int64(d.width) || h != int64(d.height)) {
+		return FormatError("bad first frame bounds")
+	}
+	num := time.Duration(binary.BigEndian.Uint16(d.tmp[16:18]))
+	den := time.Duration(binary.BigEndian.Uint16(d.tmp[18:20]))
+	if den == 0 {
+		den = 100
+	}
+	if d.tmp[20] > DisposalPrevious || d.tmp[21] > BlendOver {
+		return FormatError("bad fcTL chunk")
+	}
+	d.frame = &frameControl{
+		width:    int(w),
+		height:   int(h),
+		x:        int(x),
+		y:        int(y),
+		delay:    num * time.Second / den,
+		disposal: d.tmp[20],
+		blend:    d.tmp[21],
+	}
+	return d.verifyChecksum()
+}
+
+func (d *decoder) parsefdAT(length uint32) error {
+	if length < 4 {
+		return FormatError("bad fdAT length")
+	}
+	if err := d.readSequenceNumber(); err != nil {
+		return err
+	}
+	// The frame is decoded like the default image, with the frame's size
+	// in place of the canvas's, and with the data in fdAT chunks.
+	d.idatLength = length - 4
+	d.fdAT = true
+	width, height := d.width, d.height
+	d.width, d.height = d.frame.width, d.frame.height
+	img, err := d.decode()
+	d.width, d.height = width, height
+	d.fdAT = false
+	if err != nil {
+		return err
+	}
+	d.addFrame(img)
+	return d.verifyChecksum()
+}
+
+// addFrame adds img, decoded from the data that follows the pending fcTL
+// chunk, to the frames of d.anim.
+func (d *decoder) addFrame(img image.Image) {
+	f := d.frame
+	d.frame = nil
+	setOrigin(img, image.Pt(f.x, f.y))
+	a := d.anim
+	a.Image = append(a.Image, img)
+	a.Delay = append(a.Delay, f.delay)
+	a.Disposal = append(a.Disposal, f.disposal)
+	a.Blend = append(a.Blend, f.blend)
+}
+
+// setOrigin moves an image, of a type that readImagePass returns, so that
+// its bounds start at p.
+func setOrigin(img image.Image, p image.Point) {
+	switch m := img.(type) {
+	case *image.Gray:
+		m.Rect = m.Rect.Add(p)
+	case *image.Gray16:
+		m.Rect = m.Rect.Add(p)
+	case *image.NRGBA:
+		m.Rect = m.Rect.Add(p)
+	case *image.NRGBA64:
+		m.Rect = m.Rect.Add(p)
+	case *image.Paletted:
+		m.Rect = m.Rect.Add(p)
+	case *image.RGBA:
+		m.Rect = m.Rect.Add(p)
+	case *image.RGBA64:
+		m.Rect = m.Rect.Add(p)
+	}
+}
+
+// DecodeAll reads a PNG image from r and returns its frames, which are
+// those of an animated PNG or else the single image, and its metadata.
+func DecodeAll(r io.Reader) (*APNG, error) {
+	d := &decoder{
+		r:    r,
+		crc:  crc32.NewIEEE(),
+		meta: new(Metadata),
+		anim: new(APNG),
+	}
+	if err := d.checkHeader(); err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return nil, err
+	}
+	for d.stage != dsSeenIEND {
+		if err := d.parseChunk(false); err != nil {
+			if err == io.EOF {
+				err = io.ErrUnexpectedEOF
+			}
+			return nil, err
+		}
+	}
+	a := d.anim
+	if d.numFrames == 0 {
+		// This is not an animated PNG.
+		a.Image = []image.Image{d.img}
+		a.Delay = []time.Duration{0}
+		a.Disposal = []byte{DisposalNone}
+		a.Blend = []byte{BlendSource}
+		a.Default = nil
+	} else if d.frame != nil || uint32(len(a.Image)) != d.numFrames {
+		return nil, FormatError("wrong number of frames")
+	}
+	a.Config = image.Config{
+		ColorModel: d.colorModel(),
+		Width:      d.width,
+		Height:     d.height,
+	}
+	a.Metadata = d.meta
+	return a, nil
+}
+
+// EncodeAll writes the frames of a to w in PNG format, as an animated PNG
+// if there is more than one frame or a Default image, and as a PNG image
+// otherwise.
+func EncodeAll(w io.Writer, a *APNG) error {
+	var e Encoder
+	return e.EncodeAll(w, a)
+}
+
+// EncodeAll writes the frames of a to w in PNG format. It writes
+// a.Metadata, or enc.Metadata if a.Metadata is nil.
+//
+// All frames share the PNG color type. If the frames do not all have the
+// same color model, or are paletted images with different palettes, they
+// are all encoded as 8-bit or 16-bit non-alpha-premultiplied RGBA.
+func (enc *Encoder) EncodeAll(w io.Writer, a *APNG) error {
+	n := len(a.Image)
+	if n == 0 {
+		return FormatError("no frames")
+	}
+	if (a.Delay != nil && len(a.Delay) != n) ||
+		(a.Disposal != nil && len(a.Disposal) != n) ||
+		(a.Blend != nil && len(a.Blend) != n) {
+		return FormatError("mismatched frame and delay, disposal or blend lengths")
+	}
+	if a.NumPlays < 0 || int64(a.NumPlays) > 1<<31-1 {
+		return FormatError("invalid number of plays: " + strconv.Itoa(a.NumPlays))
+	}
+	md := a.Metadata
+	if md == nil {
+		md = enc.Metadata
+	}
+	if n == 1 && a.Default == nil {
+		return enc.encode(w, a.Image[0], md)
+	}
+
+	def := a.Default
+	if def == nil {
+		def = a.Image[0]
+	}
+	width, height := a.Config.Width, a.Config.Height
+	if width == 0 && height == 0 {
+		p := def.Bounds().Max
+		width, height = p.X, p.Y
+	}
+	if width <= 0 || height <= 0 || int64(width) >= 1<<31 || int64(height) >= 1<<31 {
+		return FormatError("invalid canvas size: " + strconv.Itoa(width) + "x" + strconv.Itoa(height))
+	}
+	canvas := image.Rect(0, 0, width, height)
+	if a.Default != nil && (def.Bounds().Dx() != width || def.Bounds().Dy() != height) {
+		return FormatError("default image does not have the canvas size")
+	}
+	if a.Default == nil && def.Bounds() != canvas {
+		return FormatError("first frame does not cover the canvas")
+	}
+	for _, m := range a.Image {
+		if b := m.Bounds(); b.Empty() || !b.In(canvas) {
+			return FormatError("frame bounds " + b.String() + " not within the canvas")
+		}
+	}
+
+	e, put := enc.newEncoder(w)
+	defer put()
+
+	// All frames must have the color type and palette of the IHDR and
+	// PLTE chunks.
+	var pal color.Palette
+	e.cb, pal = colorBits(def)
+	mixed, deep := false, false
+	for _, m := range append([]image.Image{def}, a.Image...) {
+		cb, p := colorBits(m)
+		if cb != e.cb || !samePalette(p, pal) {
+			mixed = true
+		}
+		switch cb {
+		case cbG16, cbTC16, cbTCA16:
+			deep = true
+		}
+	}
+	if mixed {
+		pal = nil
+		e.cb = cbTCA8
+		if deep {
+			e.cb = cbTCA16
+		}
+	}
+
+	e.m = def
+	_, e.err = io.WriteString(w, pngHeader)
+	e.writeIHDR()
+	e.writeacTL(uint32(n), uint32(a.NumPlays))
+	e.writeMetadata(md)
+	if pal != nil {
+		e.writePLTEAndTRNS(pal)
+	}
+	if a.Default != nil {
+		e.writeIDATs()
+	}
+	for i, m := range a.Image {
+		var delay time.Duration
+		var disposal, blend byte
+		if a.Delay != nil {
+			delay = a.Delay[i]
+		}
+		if a.Disposal != nil {
+			disposal = a.Disposal[i]
+		}
+		if a.Blend != nil {
+			blend = a.Blend[i]
+		}
+		e.writefcTL(m.Bounds(), delay, disposal, blend)
+		e.m = m
+		e.fdAT = i > 0 || a.Default != nil
+		e.writeIDATs()
+	}
+	e.fdAT = false
+	e.writeIEND()
+	return e.err
+}
+
+func samePalette(p, q color.Palette) bool {
+	if len(p) != len(q) {
+		return false
+	}
+	for i := range p {
+		if p[i] != q[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func (e *encoder) writeacTL(numFrames, numPlays uint32) {
+	binary.BigEndian.PutUint32(e.tmp[0:4], numFrames)
+	binary.BigEndian.PutUint32(e.tmp[4:8], numPlays)
+	e.writeChunk(e.tmp[:8], "acTL")
+}
+
+func (e *encoder) writefcTL(b image.Rectangle, delay time.Duration, disposal, blend byte) {
+	if e.err != nil {
+		return
+	}
+	num, den, ok := delayFraction(delay)
+	if !ok {
+		e.err = FormatError("invalid delay: " + delay.String())
+		return
+	}
+	if disposal > DisposalPrevious || blend > BlendOver {
+		e.err = FormatError("invalid disposal method or blend operation")
+		return
+	}
+	binary.BigEndian.PutUint32(e.tmp[0:4], e.seq)
+	binary.BigEndian.PutUint32(e.tmp[4:8], uint32(b.Dx()))
+	binary.BigEndian.PutUint32(e.tmp[8:12], uint32(b.Dy()))
+	binary.BigEndian.PutUint32(e.tmp[12:16], uint32(b.Min.X))
+	binary.BigEndian.PutUint32(e.tmp[16:20], uint32(b.Min.Y))
+	binary.BigEndian.PutUint16(e.tmp[20:22], num)
+	binary.BigEndian.PutUint16(e.tmp[22:24], den)
+	e.tmp[24] = disposal
+	e.tmp[25] = blend
+	e.writeChunk(e.tmp[:26], "fcTL")
+	e.seq++
+}
+
+// delayFraction returns d in seconds as the fraction num/den of an fcTL
+// chunk. The fraction is exact when d is a whole number of milliseconds
+// that fits, and rounded otherwise.
+func delayFraction(d time.Duration) (num, den uint16, ok bool) {
+	if d < 0 || d > 0xffff*time.Second {
+		return 0, 0, false
+	}
+	for den := time.Duration(1); den <= 1000; den *= 10 {
+		if n := d * den; n%time.Second == 0 && n/time.Second <= 0xffff {
+			return uint16(n / time.Second), uint16(den), true
+		}
+	}
+	for den := time.Duration(1000); ; den /= 10 {
+		if n := (d*den + time.Second/2) / time.Second; n <= 0xffff {
+			return uint16(n), uint16(den), true
+		}
+	}
+}
+
+// writefdAT writes b as the data of an fdAT chunk, after the next sequence
+// number.
+func (e *encoder) writefdAT(b []byte) {
+	if e.err != nil {
+		return
+	}
+	n := uint32(len(b) + 4)
+	if int(n) != len(b)+4 {
+		e.err = UnsupportedError("fdAT chunk is too large: " + strconv.Itoa(len(b)))
+		return
+	}
+	var header [12]byte
+	binary.BigEndian.PutUint32(header[0:4], n)
+	copy(header[4:8], "fdAT")
+	binary.BigEndian.PutUint32(header[8:12], e.seq)
+	crc := crc32.NewIEEE()
+	crc.Write(header[4:12])
+	crc.Write(b)
+	binary.BigEndian.PutUint32(e.footer[:4], crc.Sum32())
+
+	_, e.err = e.w.Write(header[:])
+	if e.err != nil {
+		return
+	}
+	_, e.err = e.w.Write(b)
+	if e.err != nil {
+		return
+	}
+	_, e.err = e.w.Write(e.footer[:4])
+	e.seq++
+}
@@ -0,0 +1,410 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package png
+
+import (
+	"bytes"
+	"encoding/binary"
+	"hash/crc32"
+	"image"
+	"image/color"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+// appendChunk appends a chunk with the given type and data to b.
+func appendChunk(b []byte, name string, data ...[]byte) []byte {
+	var n int
+	for _, d := range data {
+		n += len(d)
+	}
+	b = binary.BigEndian.AppendUint32(b, uint32(n))
+	start := len(b)
+	b = append(b, name...)
+	for _, d := range data {
+		b = append(b, d...)
+	}
+	return binary.BigEndian.AppendUint32(b, crc32.ChecksumIEEE(b[start:]))
+}
+
+// encodeChunks encodes m and returns its IHDR chunk and the concatenated
+// data of its IDAT chunks.
+func encodeChunks(t *testing.T, m image.Image) (ihdr, idat []byte) {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := Encode(&buf, m); err != nil {
+		t.Fatal(err)
+	}
+	b := buf.Bytes()[len(pngHeader):]
+	for len(b) > 0 {
+		n := binary.BigEndian.Uint32(b)
+		name, data := string(b[4:8]), b[8:8+n]
+		switch name {
+		case "IHDR":
+			ihdr = appendChunk(nil, name, data)
+		case "IDAT":
+			idat = append(idat, data...)
+		}
+		b = b[12+n:]
+	}
+	return ihdr, idat
+}
+
+func seqNum(n uint32) []byte {
+	return binary.BigEndian.AppendUint32(nil, n)
+}
+
+func fcTL(seq uint32, r image.Rectangle, num, den uint16, disposal, blend byte) []byte {
+	b := seqNum(seq)
+	for _, v := range []int{r.Dx(), r.Dy(), r.Min.X, r.Min.Y} {
+		b = binary.BigEndian.AppendUint32(b, uint32(v))
+	}
+	b = binary.BigEndian.AppendUint16(b, num)
+	b = binary.BigEndian.AppendUint16(b, den)
+	return append(b, disposal, blend)
+}
+
+func grayImage(r image.Rectangle, y0 uint8) *image.Gray {
+	m := image.NewGray(r)
+	for i := range m.Pix {
+		m.Pix[i] = y0 + uint8(i)
+	}
+	return m
+}
+
+// animation returns an animated PNG of a 4x3 canvas, with a default image
+// that is not part of the animation and two frames, the first of which is
+// split into two fdAT chunks.
+func animation(t *testing.T) (data []byte, def *image.Gray, frames []*image.Gray) {
+	def = grayImage(image.Rect(0, 0, 4, 3), 0x10)
+	frames = []*image.Gray{
+		grayImage(image.Rect(1, 1, 3, 3), 0x80),
+		grayImage(image.Rect(0, 0, 4, 3), 0xc0),
+	}
+	ihdr, idat := encodeChunks(t, def)
+	_, fdat0 := encodeChunks(t, frames[0])
+	_, fdat1 := encodeChunks(t, frames[1])
+
+	data = []byte(pngHeader)
+	data = append(data, ihdr...)
+	data = appendChunk(data, "acTL", seqNum(2), seqNum(3))
+	data = appendChunk(data, "IDAT", idat)
+	data = appendChunk(data, "fcTL", fcTL(0, frames[0].Bounds(), 1, 4, DisposalBackground, BlendOver))
+	data = appendChunk(data, "fdAT", seqNum(1), fdat0[:5])
+	data = appendChunk(data, "fdAT", seqNum(2), fdat0[5:])
+	data = appendChunk(data, "fcTL", fcTL(3, frames[1].Bounds(), 7, 0, DisposalPrevious, BlendSource))
+	data = appendChunk(data, "fdAT", seqNum(4), fdat1)
+	data = appendChunk(data, "IEND")
+	return data, def, frames
+}
+
+func TestDecodeAll(t *testing.T) {
+	data, def, frames := animation(t)
+	a, err := DecodeAll(bytes.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(a.Default, image.Image(def)) {
+		t.Errorf("Default = %v, want %v", a.Default, def)
+	}
+	if len(a.Image) != len(frames) {
+		t.Fatalf("got %d frames, want %d", len(a.Image), len(frames))
+	}
+	for i, m := range a.Image {
+		if !reflect.DeepEqual(m, image.Image(frames[i])) {
+			t.Errorf("frame %d = %v, want %v", i, m, frames[i])
+		}
+	}
+	if want := []time.Duration{250 * time.Millisecond, 70 * time.Millisecond}; !reflect.DeepEqual(a.Delay, want) {
+		t.Errorf("Delay = %v, want %v", a.Delay, want)
+	}
+	if want := []byte{DisposalBackground, DisposalPrevious}; !bytes.Equal(a.Disposal, want) {
+		t.Errorf("Disposal = %v, want %v", a.Disposal, want)
+	}
+	if want := []byte{BlendOver, BlendSource}; !bytes.Equal(a.Blend, want) {
+		t.Errorf("Blend = %v, want %v", a.Blend, want)
+	}
+	if a.NumPlays != 3 {
+		t.Errorf("NumPlays = %d, want 3", a.NumPlays)
+	}
+	if want := (image.Config{ColorModel: color.GrayModel, Width: 4, Height: 3}); a.Config != want {
+		t.Errorf("Config = %v, want %v", a.Config, want)
+	}
+
+	// Decode returns the default image.
+	m, err := Decode(bytes.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(m, image.Image(def)) {
+		t.Errorf("Decode = %v, want %v", m, def)
+	}
+}
+
+func TestDecodeAllStatic(t *testing.T) {
+	m0, err := readPNG("testdata/pngsuite/basn3p08.png")
+	if err != nil {
+		t.Fatal(err)
+	}
+	f, err := os.Open("testdata/pngsuite/basn3p08.png")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	a, err := DecodeAll(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &APNG{
+		Image:    []image.Image{m0},
+		Delay:    []time.Duration{0},
+		Disposal: []byte{DisposalNone},
+		Blend:    []byte{BlendSource},
+		Config:   image.Config{ColorModel: m0.ColorModel(), Width: 32, Height: 32},
+		Metadata: &Metadata{Gamma: 100000},
+	}
+	if !reflect.DeepEqual(a, want) {
+		t.Errorf("got %+v, want %+v", a, want)
+	}
+}
+
+func TestDecodeAllErrors(t *testing.T) {
+	data, _, _ := animation(t)
+	s := string(data)
+	chunk := func(name string, data ...[]byte) string {
+		return string(appendChunk(nil, name, data...))
+	}
+	i := strings.Index(s, "acTL") - 4
+	j := strings.Index(s, "IEND") - 4
+	// s3 has one more frame in its acTL chunk than s has.
+	s3 := strings.Replace(s, chunk("acTL", seqNum(2), seqNum(3)), chunk("acTL", seqNum(3), seqNum(3)), 1)
+	tests := []struct {
+		name string
+		data string
+		want string
+	}{
+		{
+			"too few frames",
+			s3,
+			"wrong number of frames",
+		},
+		{
+			"too many frames",
+			strings.Replace(s, chunk("acTL", seqNum(2), seqNum(3)), chunk("acTL", seqNum(1), seqNum(3)), 1),
+			"too many frames",
+		},
+		{
+			"bad sequence number",
+			s[:j] + chunk("fcTL", fcTL(4, image.Rect(0, 0, 1, 1), 0, 0, 0, 0)) + s[j:],
+			"bad sequence number",
+		},
+		{
+			"frame outside canvas",
+			s3[:j] + chunk("fcTL", fcTL(5, image.Rect(3, 2, 5, 3), 0, 0, 0, 0)) + s3[j:],
+			"bad frame bounds",
+		},
+		{
+			"missing frame data",
+			s3[:j] + chunk("fcTL", fcTL(5, image.Rect(0, 0, 1, 1), 0, 0, 0, 0)) + s3[j:],
+			"wrong number of frames",
+		},
+		{
+			"fdAT without fcTL",
+			s[:j] + chunk("fdAT", seqNum(5), []byte{0}) + s[j:],
+			"chunk out of order",
+		},
+		{
+			"bad first frame bounds",
+			s[:i+20] + chunk("fcTL", fcTL(0, image.Rect(0, 0, 2, 2), 0, 0, 0, 0)) + s[i+20:],
+			"bad first frame bounds",
+		},
+	}
+	for _, tt := range tests {
+		_, err := DecodeAll(strings.NewReader(tt.data))
+		if err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("%s: got error %v, want %q", tt.name, err, tt.want)
+		}
+		// Decode ignores the animation.
+		if _, err := Decode(strings.NewReader(tt.data)); err != nil {
+			t.Errorf("%s: Decode: %v", tt.name, err)
+		}
+	}
+
+	// Without an acTL chunk, the fcTL and fdAT chunks are ignored.
+	a, err := DecodeAll(strings.NewReader(s[:i] + s[i+20:]))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(a.Image) != 1 || a.Default != nil {
+		t.Errorf("got %d frames and Default %v, want 1 frame and no Default", len(a.Image), a.Default)
+	}
+}
+
+func TestEncodeAll(t *testing.T) {
+	opaque := image.NewRGBA(image.Rect(0, 0, 5, 4))
+	for i := range opaque.Pix {
+		opaque.Pix[i] = uint8(i * 7)
+		if i%4 == 3 {
+			opaque.Pix[i] = 0xff
+		}
+	}
+	translucent := image.NewNRGBA(image.Rect(2, 1, 4, 4))
+	for i := range translucent.Pix {
+		translucent.Pix[i] = uint8(i * 13)
+	}
+	pal := color.Palette{color.Gray{0x00}, color.Gray{0x80}, color.NRGBA{0xff, 0, 0, 0x80}}
+	paletted0 := image.NewPaletted(image.Rect(0, 0, 5, 4), pal)
+	paletted1 := image.NewPaletted(image.Rect(1, 1, 4, 2), pal)
+	for i := range paletted0.Pix {
+		paletted0.Pix[i] = uint8(i % 3)
+	}
+	paletted1.Pix[1] = 2
+
+	tests := []struct {
+		name string
+		a    *APNG
+	}{
+		{
+			"mixed color models",
+			&APNG{
+				Image:    []image.Image{opaque, translucent, grayImage(image.Rect(0, 2, 5, 4), 3)},
+				Delay:    []time.Duration{100 * time.Millisecond, 1500 * time.Millisecond, time.Second / 3},
+				Disposal: []byte{DisposalNone, DisposalBackground, DisposalPrevious},
+				Blend:    []byte{BlendSource, BlendOver, BlendSource},
+				NumPlays: 2,
+			},
+		},
+		{
+			"paletted with default",
+			&APNG{
+				Image:   []image.Image{paletted1, paletted0},
+				Default: paletted0,
+			},
+		},
+		{
+			"single frame with default",
+			&APNG{
+				Image:   []image.Image{grayImage(image.Rect(1, 0, 2, 1), 0)},
+				Default: grayImage(image.Rect(0, 0, 3, 3), 9),
+			},
+		},
+	}
+	for _, tt := range tests {
+		var buf bytes.Buffer
+		if err := EncodeAll(&buf, tt.a); err != nil {
+			t.Errorf("%s: EncodeAll: %v", tt.name, err)
+			continue
+		}
+		a, err := DecodeAll(&buf)
+		if err != nil {
+			t.Errorf("%s: DecodeAll: %v", tt.name, err)
+			continue
+		}
+		if len(a.Image) != len(tt.a.Image) {
+			t.Errorf("%s: got %d frames, want %d", tt.name, len(a.Image), len(tt.a.Image))
+			continue
+		}
+		for i, m := range a.Image {
+			if got, want := m.Bounds(), tt.a.Image[i].Bounds(); got != want {
+				t.Errorf("%s: frame %d: got bounds %v, want %v", tt.name, i, got, want)
+			} else if err := diff(m, tt.a.Image[i]); err != nil {
+				t.Errorf("%s: frame %d: %v", tt.name, i, err)
+			}
+		}
+		if (a.Default == nil) != (tt.a.Default == nil) {
+			t.Errorf("%s: got Default %v, want %v", tt.name, a.Default, tt.a.Default)
+		} else if a.Default != nil {
+			if err := diff(a.Default, tt.a.Default); err != nil {
+				t.Errorf("%s: Default: %v", tt.name, err)
+			}
+		}
+		for i := range a.Image {
+			var delay time.Duration
+			var disposal, blend byte
+			if tt.a.Delay != nil {
+				delay, disposal, blend = tt.a.Delay[i], tt.a.Disposal[i], tt.a.Blend[i]
+			}
+			if d := a.Delay[i] - delay; d < -time.Millisecond/2 || d > time.Millisecond/2 {
+				t.Errorf("%s: frame %d: got delay %v, want %v", tt.name, i, a.Delay[i], delay)
+			}
+			if a.Disposal[i] != disposal || a.Blend[i] != blend {
+				t.Errorf("%s: frame %d: got disposal %d and blend %d, want %d and %d",
+					tt.name, i, a.Disposal[i], a.Blend[i], disposal, blend)
+			}
+		}
+		if a.NumPlays != tt.a.NumPlays {
+			t.Errorf("%s: got NumPlays %d, want %d", tt.name, a.NumPlays, tt.a.NumPlays)
+		}
+	}
+}
+
+func TestEncodeAllStatic(t *testing.T) {
+	m := grayImage(image.Rect(0, 0, 3, 2), 0)
+	var buf bytes.Buffer
+	if err := EncodeAll(&buf, &APNG{Image: []image.Image{m}}); err != nil {
+		t.Fatal(err)
+	}
+	var want bytes.Buffer
+	if err := Encode(&want, m); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(buf.Bytes(), want.Bytes()) {
+		t.Error("EncodeAll of a single frame differs from Encode")
+	}
+}
+
+func TestEncodeAllErrors(t *testing.T) {
+	full := grayImage(image.Rect(0, 0, 4, 4), 0)
+	small := grayImage(image.Rect(1, 1, 3, 3), 0)
+	tests := []struct {
+		name string
+		a    *APNG
+	}{
+		{"no frames", &APNG{}},
+		{"mismatched delays", &APNG{Image: []image.Image{full, small}, Delay: []time.Duration{0}}},
+		{"first frame too small", &APNG{Image: []image.Image{small, full}}},
+		{"frame outside canvas", &APNG{Image: []image.Image{full, small}, Config: image.Config{Width: 2, Height: 2}}},
+		{"default too small", &APNG{Image: []image.Image{small}, Default: small}},
+		{"delay too long", &APNG{Image: []image.Image{full, small}, Delay: []time.Duration{0, 24 * time.Hour}}},
+		{"bad disposal", &APNG{Image: []image.Image{full, small}, Disposal: []byte{0, 3}}},
+		{"negative plays", &APNG{Image: []image.Image{full, small}, NumPlays: -1}},
+	}
+	for _, tt := range tests {
+		if err := EncodeAll(new(bytes.Buffer), tt.a); err == nil {
+			t.Errorf("%s: got nil error", tt.name)
+		}
+	}
+}
+
+func TestDelayFraction(t *testing.T) {
+	tests := []struct {
+		d        time.Duration
+		num, den uint16
+	}{
+		{0, 0, 1},
+		{2 * time.Second, 2, 1},
+		{100 * time.Millisecond, 1, 10},
+		{40 * time.Millisecond, 4, 100},
+		{33 * time.Millisecond, 33, 1000},
+		{time.Second / 3, 333, 1000},
+		{70*time.Second + 10*time.Millisecond, 7001, 100},
+		{100*time.Second + time.Millisecond, 10000, 100},
+		{0xffff * time.Second, 0xffff, 1},
+	}
+	for _, tt := range tests {
+		num, den, ok := delayFraction(tt.d)
+		if !ok || num != tt.num || den != tt.den {
+			t.Errorf("delayFraction(%v) = %d, %d, %v, want %d, %d, true", tt.d, num, den, ok, tt.num, tt.den)
+		}
+	}
+	for _, d := range []time.Duration{-1, 0xffff*time.Second + 1} {
+		if _, _, ok := delayFraction(d); ok {
+			t.Errorf("delayFraction(%v) succeeded", d)
+		}
+	}
+}
@@ -72,3 +72,61 @@ func FuzzDecode(f *testing.F) {
 		}
 	})
 }
+
+func FuzzDecodeAll(f *testing.F) {
+	if testing.Short() {
+		f.Skip("Skipping in short mode")
+	}
+
+	for _, dir := range []string{"../testdata", "testdata/pngsuite"} {
+		testdata, err := os.ReadDir(dir)
+		if err != nil {
+			f.Fatalf("failed to read testdata directory: %s", err)
+		}
+		for _, de := range testdata {
+			if de.IsDir() || !strings.HasSuffix(de.Name(), ".png") {
+				continue
+			}
+			b, err := os.ReadFile(filepath.Join(dir, de.Name()))
+			if err != nil {
+				f.Fatalf("failed to read testdata: %s", err)
+			}
+			f.Add(b)
+		}
+	}
+
+	f.Fuzz(func(t *testing.T, b []byte) {
+		cfg, err := DecodeConfig(bytes.NewReader(b))
+		if err != nil {
+			return
+		}
+		if cfg.Width*cfg.Height > 1e6 {
+			return
+		}
+		a, err := DecodeAll(bytes.NewReader(b))
+		if err != nil {
+			return
+		}
+		if len(a.Image)*cfg.Width*cfg.Height > 1e7 {
+			return
+		}
+		var w bytes.Buffer
+		if err := EncodeAll(&w, a); err != nil {
+			t.Fatalf("failed to encode valid animation: %s", err)
+		}
+		a1, err := DecodeAll(&w)
+		if err != nil {
+			t.Fatalf("failed to decode roundtripped animation: %s", err)
+		}
+		if len(a1.Image) != len(a.Image) {
+			t.Fatalf("roundtripped animation has %d frames, want %d", len(a1.Image), len(a.Image))
+		}
+		for i := range a.Image {
+			got := a1.Image[i].Bounds()
+			want := a.Image[i].Bounds()
+			if !got.Eq(want) {
+				t.Errorf("roundtripped frame %d bounds have changed, got: %s, want: %s", i, got, want)
+			}
+		}
+	})
+}
@@ -0,0 +1,366 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package png
+
+import (
+	"bytes"
+	"compress/zlib"
+	"encoding/binary"
+	"io"
+	"strings"
+	"unicode/utf8"
+)
+
+// maxMetadataSize limits the size of an ancillary chunk, and of its
+// decompressed data, that is read into memory.
+const maxMetadataSize = 1 << 26
+
+// Metadata holds the ancillary chunks of a PNG image that describe its color
+// space and carry textual and Exif metadata. The zero value means that the
+// image has none of these chunks.
+type Metadata struct {
+	// Gamma is the value of the gAMA chunk: the exponent of the image's
+	// transfer function times 100000, such as 45455 for an exponent of
+	// 1/2.2. Zero means that there is no gAMA chunk.
+	Gamma uint32
+
+	// Chromaticities, if non-nil, holds the value of the cHRM chunk.
+	Chromaticities *Chromaticities
+
+	// SRGB reports whether the image has an sRGB chunk, which means that
+	// its samples are in the sRGB color space, to be rendered with the
+	// given RenderingIntent.
+	SRGB            bool
+	RenderingIntent RenderingIntent
+
+	// ICCProfile, if non-nil, holds the uncompressed ICC profile of the
+	// iCCP chunk, and ICCProfileName the name of that profile.
+	ICCProfileName string
+	ICCProfile     []byte
+
+	// Exif, if non-nil, holds the Exif data of the eXIf chunk, starting
+	// with the TIFF header.
+	Exif []byte
+
+	// Text holds the tEXt, zTXt and iTXt chunks, in order.
+	Text []TextChunk
+}
+
+// Chromaticities holds the CIE 1931 x and y chromaticities of the white
+// point and the primaries of an image, each times 100000.
+type Chromaticities struct {
+	WhiteX, WhiteY uint32
+	RedX, RedY     uint32
+	GreenX, GreenY uint32
+	BlueX, BlueY   uint32
+}
+
+// RenderingIntent is the rendering intent of an sRGB chunk, as defined by
+// the International Color Consortium.
+type RenderingIntent uint8
+
+const (
+	IntentPerceptual RenderingIntent = iota
+	IntentRelativeColorimetric
+	IntentSaturation
+	IntentAbsoluteColorimetric
+)
+
+// A TextChunk is a keyword and text pair of a tEXt, zTXt or iTXt chunk.
+//
+// The strings are UTF-8. A TextChunk is encoded as a tEXt chunk, or as a
+// zTXt chunk if Compressed is set, when its Keyword and Text can be
+// represented in Latin-1 and it has no LanguageTag or TranslatedKeyword.
+// Otherwise it is encoded as an iTXt chunk. The keyword must always be
+// representable in Latin-1.
+type TextChunk struct {
+	Keyword string
+	Text    string
+
+	// LanguageTag and TranslatedKeyword are those of an iTXt chunk.
+	LanguageTag       string
+	TranslatedKeyword string
+
+	// Compressed reports whether the text is compressed.
+	Compressed bool
+}
+
+// readChunkData reads the data of a chunk of the given length, and verifies
+// its checksum.
+func (d *decoder) readChunkData(length uint32) ([]byte, error) {
+	if length > maxMetadataSize {
+		return nil, UnsupportedError("chunk too large")
+	}
+	// Read into a growing buffer, instead of allocating length bytes, so
+	// that a bad length in a truncated image does not allocate much.
+	var buf bytes.Buffer
+	if _, err := io.CopyN(&buf, d.r, int64(length)); err != nil {
+		return nil, err
+	}
+	d.crc.Write(buf.Bytes())
+	if err := d.verifyChecksum(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// parseMetadata parses the ancillary chunk of the given type into d.meta.
+// Chunks that describe the color space are ignored unless they come before
+// the PLTE and IDAT chunks, and eXIf chunks unless they come before the IDAT
+// chunks, as the specification requires.
+func (d *decoder) parseMetadata(chunk string, length uint32) error {
+	b, err := d.readChunkData(length)
+	if err != nil {
+		return err
+	}
+	md := d.meta
+	beforePLTE := d.stage == dsSeenIHDR
+	switch chunk {
+	case "gAMA":
+		if len(b) != 4 {
+			return FormatError("bad gAMA length")
+		}
+		if beforePLTE {
+			md.Gamma = binary.BigEndian.Uint32(b)
+		}
+	case "cHRM":
+		if len(b) != 32 {
+			return FormatError("bad cHRM length")
+		}
+		if beforePLTE {
+			md.Chromaticities = &Chromaticities{
+				WhiteX: binary.BigEndian.Uint32(b[0:]),
+				WhiteY: binary.BigEndian.Uint32(b[4:]),
+				RedX:   binary.BigEndian.Uint32(b[8:]),
+				RedY:   binary.BigEndian.Uint32(b[12:]),
+				GreenX: binary.BigEndian.Uint32(b[16:]),
+				GreenY: binary.BigEndian.Uint32(b[20:]),
+				BlueX:  binary.BigEndian.Uint32(b[24:]),
+				BlueY:  binary.BigEndian.Uint32(b[28:]),
+			}
+		}
+	case "sRGB":
+		if len(b) != 1 || b[0] > byte(IntentAbsoluteColorimetric) {
+			return FormatError("bad sRGB chunk")
+		}
+		if beforePLTE {
+			md.SRGB = true
+			md.RenderingIntent = RenderingIntent(b[0])
+		}
+	case "iCCP":
+		name, rest, ok := splitKeyword(b)
+		if !ok || len(rest) < 1 || rest[0] != 0 {
+			return FormatError("bad iCCP chunk")
+		}
+		profile, err := decompress(rest[1:])
+		if err != nil {
+			return err
+		}
+		if beforePLTE {
+			md.ICCProfileName = name
+			md.ICCProfile = profile
+		}
+	case "eXIf":
+		if d.stage < dsSeenIDAT {
+			md.Exif = b
+		}
+	case "tEXt":
+		keyword, text, ok := splitKeyword(b)
+		if !ok {
+			return FormatError("bad tEXt chunk")
+		}
+		md.Text = append(md.Text, TextChunk{Keyword: keyword, Text: latin1ToString(text)})
+	case "zTXt":
+		keyword, rest, ok := splitKeyword(b)
+		if !ok || len(rest) < 1 || rest[0] != 0 {
+			return FormatError("bad zTXt chunk")
+		}
+		text, err := decompress(rest[1:])
+		if err != nil {
+			return err
+		}
+		md.Text = append(md.Text, TextChunk{Keyword: keyword, Text: latin1ToString(text), Compressed: true})
+	case "iTXt":
+		keyword, rest, ok := splitKeyword(b)
+		if !ok || len(rest) < 2 || rest[0] > 1 || rest[1] != 0 {
+			return FormatError("bad iTXt chunk")
+		}
+		compressed := rest[0] == 1
+		lang, rest, ok1 := bytes.Cut(rest[2:], []byte{0})
+		translated, text, ok2 := bytes.Cut(rest, []byte{0})
+		if !ok1 || !ok2 {
+			return FormatError("bad iTXt chunk")
+		}
+		if compressed {
+			if text, err = decompress(text); err != nil {
+				return err
+			}
+		}
+		if !utf8.Valid(translated) || !utf8.Valid(text) {
+			return FormatError("bad iTXt chunk")
+		}
+		md.Text = append(md.Text, TextChunk{
+			Keyword:           keyword,
+			Text:              string(text),
+			LanguageTag:       string(lang),
+			TranslatedKeyword: string(translated),
+			Compressed:        compressed,
+		})
+	}
+	return nil
+}
+
+// splitKeyword splits the data of a chunk at the NUL that ends the keyword,
+// and returns the keyword in UTF-8.
+func splitKeyword(b []byte) (keyword string, rest []byte, ok bool) {
+	k, rest, ok := bytes.Cut(b, []byte{0})
+	if !ok || len(k) < 1 || len(k) > 79 {
+		return "", nil, false
+	}
+	return latin1ToString(k), rest, true
+}
+
+// decompress returns the zlib-decompressed data of b.
+func decompress(b []byte) ([]byte, error) {
+	r, err := zlib.NewReader(bytes.NewReader(b))
+	if err != nil {
+		return nil, FormatError(err.Error())
+	}
+	defer r.Close()
+	out, err := io.ReadAll(io.LimitReader(r, maxMetadataSize+1))
+	if err != nil {
+		return nil, FormatError(err.Error())
+	}
+	if len(out) > maxMetadataSize {
+		return nil, UnsupportedError("decompressed chunk too large")
+	}
+	return out, nil
+}
+
+func latin1ToString(b []byte) string {
+	var sb strings.Builder
+	for _, c := range b {
+		sb.WriteRune(rune(c))
+	}
+	return sb.String()
+}
+
+// stringToLatin1 returns s in Latin-1, and whether s is representable in
+// Latin-1.
+func stringToLatin1(s string) ([]byte, bool) {
+	b := make([]byte, 0, len(s))
+	for _, r := range s {
+		if r > 0xff {
+			return nil, false
+		}
+		b = append(b, byte(r))
+	}
+	return b, true
+}
+
+// writeMetadata writes the chunks of md, which must come after the IHDR
+// chunk and before the PLTE chunk.
+func (e *encoder) writeMetadata(md *Metadata) {
+	if md == nil {
+		return
+	}
+	if c := md.Chromaticities; c != nil {
+		b := e.tmp[:32]
+		for i, v := range [8]uint32{c.WhiteX, c.WhiteY, c.RedX, c.RedY, c.GreenX, c.GreenY, c.BlueX, c.BlueY} {
+			binary.BigEndian.PutUint32(b[4*i:], v)
+		}
+		e.writeChunk(b, "cHRM")
+	}
+	if md.Gamma != 0 {
+		binary.BigEndian.PutUint32(e.tmp[:4], md.Gamma)
+		e.writeChunk(e.tmp[:4], "gAMA")
+	}
+	if md.ICCProfile != nil {
+		name := md.ICCProfileName
+		if name == "" {
+			name = "ICC profile"
+		}
+		b, ok := appendKeyword(nil, name)
+		if !ok {
+			e.err = FormatError("invalid ICC profile name")
+			return
+		}
+		b = append(b, 0) // Compression method.
+		e.writeChunk(e.compress(b, md.ICCProfile), "iCCP")
+	}
+	if md.SRGB {
+		if md.RenderingIntent > IntentAbsoluteColorimetric {
+			e.err = FormatError("invalid rendering intent")
+			return
+		}
+		e.tmp[0] = byte(md.RenderingIntent)
+		e.writeChunk(e.tmp[:1], "sRGB")
+	}
+	if md.Exif != nil {
+		e.writeChunk(md.Exif, "eXIf")
+	}
+	for _, t := range md.Text {
+		b, ok := appendKeyword(nil, t.Keyword)
+		if !ok {
+			e.err = FormatError("invalid text keyword")
+			return
+		}
+		text, latin1 := stringToLatin1(t.Text)
+		switch {
+		case latin1 && t.LanguageTag == "" && t.TranslatedKeyword == "" && !t.Compressed:
+			e.writeChunk(append(b, text...), "tEXt")
+		case latin1 && t.LanguageTag == "" && t.TranslatedKeyword == "":
+			b = append(b, 0) // Compression method.
+			e.writeChunk(e.compress(b, text), "zTXt")
+		default:
+			if !utf8.ValidString(t.Text) || strings.IndexByte(t.LanguageTag, 0) >= 0 || strings.IndexByte(t.TranslatedKeyword, 0) >= 0 {
+				e.err = FormatError("invalid international text")
+				return
+			}
+			if t.Compressed {
+				b = append(b, 1, 0)
+			} else {
+				b = append(b, 0, 0)
+			}
+			b = append(b, t.LanguageTag...)
+			b = append(b, 0)
+			b = append(b, t.TranslatedKeyword...)
+			b = append(b, 0)
+			if t.Compressed {
+				b = e.compress(b, []byte(t.Text))
+			} else {
+				b = append(b, t.Text...)
+			}
+			e.writeChunk(b, "iTXt")
+		}
+	}
+}
+
+// appendKeyword appends the Latin-1 keyword k, and the NUL that ends it,
+// to b. It reports whether k is a valid keyword.
+func appendKeyword(b []byte, k string) ([]byte, bool) {
+	l, ok := stringToLatin1(k)
+	if !ok || len(l) < 1 || len(l) > 79 || bytes.IndexByte(l, 0) >= 0 {
+		return nil, false
+	}
+	b = append(b, l...)
+	return append(b, 0), true
+}
+
+// compress appends the zlib-compressed data to b.
+func (e *encoder) compress(b, data []byte) []byte {
+	buf := bytes.NewBuffer(b)
+	zw, err := zlib.NewWriterLevel(buf, levelToZlib(e.enc.CompressionLevel))
+	if err != nil {
+		e.err = err
+		return nil
+	}
+	zw.Write(data)
+	if err := zw.Close(); err != nil {
+		e.err = err
+		return nil
+	}
+	return buf.Bytes()
+}
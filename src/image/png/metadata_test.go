@@ -0,0 +1,176 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package png
+
+import (
+	"bytes"
+	"encoding/binary"
+	"image"
+	"image/color"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+var testMetadata = &Metadata{
+	Gamma: 45455,
+	Chromaticities: &Chromaticities{
+		WhiteX: 31270, WhiteY: 32900,
+		RedX: 64000, RedY: 33000,
+		GreenX: 30000, GreenY: 60000,
+		BlueX: 15000, BlueY: 6000,
+	},
+	SRGB:            true,
+	RenderingIntent: IntentRelativeColorimetric,
+	ICCProfileName:  "sRGB built-in",
+	ICCProfile:      bytes.Repeat([]byte("profile "), 100),
+	Exif:            []byte("MM\x00\x2a\x00\x00\x00\x08\x00\x00"),
+	Text: []TextChunk{
+		{Keyword: "Title", Text: "A gopher"},
+		{Keyword: "Comment", Text: "Café au lait", Compressed: true},
+		{Keyword: "Description", Text: "日本語のテキスト"},
+		{Keyword: "Title", Text: "ホリネズミ", LanguageTag: "ja", TranslatedKeyword: "タイトル", Compressed: true},
+		{Keyword: "Auteur", Text: "", LanguageTag: "fr"},
+	},
+}
+
+// chunkTypes returns the types of the chunks of the PNG image b.
+func chunkTypes(b []byte) []string {
+	var types []string
+	b = b[len(pngHeader):]
+	for len(b) >= 12 {
+		n := binary.BigEndian.Uint32(b)
+		types = append(types, string(b[4:8]))
+		b = b[12+n:]
+	}
+	return types
+}
+
+func TestMetadata(t *testing.T) {
+	m := image.NewPaletted(image.Rect(0, 0, 3, 2), testPalette(3))
+	var buf bytes.Buffer
+	enc := &Encoder{Metadata: testMetadata}
+	if err := enc.Encode(&buf, m); err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"IHDR", "cHRM", "gAMA", "iCCP", "sRGB", "eXIf", "tEXt", "zTXt", "iTXt", "iTXt", "iTXt", "PLTE", "IDAT", "IEND"}
+	if got := chunkTypes(buf.Bytes()); !reflect.DeepEqual(got, want) {
+		t.Errorf("got chunks %q, want %q", got, want)
+	}
+
+	a, err := DecodeAll(bytes.NewReader(buf.Bytes()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(a.Metadata, testMetadata) {
+		t.Errorf("got metadata %+v, want %+v", a.Metadata, testMetadata)
+	}
+	if err := diff(a.Image[0], m); err != nil {
+		t.Error(err)
+	}
+
+	// EncodeAll writes the metadata of the APNG.
+	buf.Reset()
+	if err := EncodeAll(&buf, a); err != nil {
+		t.Fatal(err)
+	}
+	a, err = DecodeAll(bytes.NewReader(buf.Bytes()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(a.Metadata, testMetadata) {
+		t.Errorf("EncodeAll: got metadata %+v, want %+v", a.Metadata, testMetadata)
+	}
+}
+
+func testPalette(n int) []color.Color {
+	p := make([]color.Color, n)
+	for i := range p {
+		p[i] = color.Gray{uint8(i * 0x40)}
+	}
+	return p
+}
+
+func TestDecodeMetadata(t *testing.T) {
+	b, err := os.ReadFile("testdata/pngsuite/ftbbn0g02.png")
+	if err != nil {
+		t.Fatal(err)
+	}
+	a, err := DecodeAll(bytes.NewReader(b))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (&Metadata{Gamma: 45455}); !reflect.DeepEqual(a.Metadata, want) {
+		t.Errorf("got metadata %+v, want %+v", a.Metadata, want)
+	}
+
+	// Color space chunks after the PLTE chunk are ignored.
+	b, err = os.ReadFile("testdata/pngsuite/basn3p08.png")
+	if err != nil {
+		t.Fatal(err)
+	}
+	i := bytes.Index(b, []byte("PLTE")) - 4
+	i += 12 + int(binary.BigEndian.Uint32(b[i:]))
+	gAMA := appendChunk(nil, "gAMA", binary.BigEndian.AppendUint32(nil, 45455))
+	sRGB := appendChunk(nil, "sRGB", []byte{0})
+	b = append(b[:i:i], append(append(gAMA, sRGB...), b[i:]...)...)
+	a, err = DecodeAll(bytes.NewReader(b))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (&Metadata{Gamma: 100000}); !reflect.DeepEqual(a.Metadata, want) {
+		t.Errorf("got metadata %+v, want %+v", a.Metadata, want)
+	}
+}
+
+func TestDecodeMetadataErrors(t *testing.T) {
+	b, err := os.ReadFile("testdata/pngsuite/basn0g08.png")
+	if err != nil {
+		t.Fatal(err)
+	}
+	i := bytes.Index(b, []byte("IDAT")) - 4
+	tests := []struct {
+		name  string
+		chunk []byte
+	}{
+		{"bad gAMA", appendChunk(nil, "gAMA", []byte{1, 2, 3})},
+		{"bad sRGB", appendChunk(nil, "sRGB", []byte{4})},
+		{"bad iCCP", appendChunk(nil, "iCCP", []byte("name\x00\x00not zlib"))},
+		{"missing keyword", appendChunk(nil, "tEXt", []byte("\x00text"))},
+		{"bad iTXt", appendChunk(nil, "iTXt", []byte("k\x00\x00\x00en"))},
+		{"invalid UTF-8", appendChunk(nil, "iTXt", []byte("k\x00\x00\x00\x00\x00\xff"))},
+	}
+	for _, tt := range tests {
+		data := append(b[:i:i], append(tt.chunk, b[i:]...)...)
+		if _, err := DecodeAll(bytes.NewReader(data)); err == nil {
+			t.Errorf("%s: DecodeAll succeeded", tt.name)
+		}
+		// Decode ignores the metadata.
+		if _, err := Decode(bytes.NewReader(data)); err != nil {
+			t.Errorf("%s: Decode: %v", tt.name, err)
+		}
+	}
+}
+
+func TestEncodeMetadataErrors(t *testing.T) {
+	m := image.NewGray(image.Rect(0, 0, 1, 1))
+	tests := []struct {
+		name string
+		md   *Metadata
+	}{
+		{"empty keyword", &Metadata{Text: []TextChunk{{Text: "text"}}}},
+		{"long keyword", &Metadata{Text: []TextChunk{{Keyword: strings.Repeat("k", 80)}}}},
+		{"non-Latin-1 keyword", &Metadata{Text: []TextChunk{{Keyword: "日本"}}}},
+		{"invalid UTF-8", &Metadata{Text: []TextChunk{{Keyword: "k", Text: "\xff\xfe"}}}},
+		{"bad rendering intent", &Metadata{SRGB: true, RenderingIntent: 4}},
+	}
+	for _, tt := range tests {
+		enc := &Encoder{Metadata: tt.md}
+		if err := enc.Encode(new(bytes.Buffer), m); err == nil {
+			t.Errorf("%s: Encode succeeded", tt.name)
+		}
+	}
+}
@@ -122,6 +122,15 @@ type decoder struct {
 	// transparency, as opposed to palette transparency.
 	useTransparent bool
 	transparent    [6]byte
+
+	// meta and anim, which only DecodeAll sets, receive the ancillary
+	// chunks and the frames of an animated PNG.
+	meta      *Metadata
+	anim      *APNG
+	numFrames uint32        // Number of frames of the acTL chunk, or 0
+	seq       uint32        // Next sequence number of fcTL and fdAT chunks
+	frame     *frameControl // The fcTL chunk of the frame to be decoded next
+	fdAT      bool          // Whether Read reads fdAT chunks, not IDAT chunks
 }
 
 // A FormatError reports that the input is not a valid PNG.
@@ -321,7 +330,8 @@ func (d *decoder) parsetRNS(length uint32) error {
 }
 
 // Read presents one or more IDAT chunks as one continuous stream (minus the
-// intermediate chunk headers and footers). If the PNG data looked like:
+// intermediate chunk headers and footers), or fdAT chunks (minus their
+// sequence numbers too) if d.fdAT is set. If the PNG data looked like:
 //
 //	... len0 IDAT xxx crc0 len1 IDAT yy crc1 len2 IEND crc2
 //
@@ -339,16 +349,29 @@ func (d *decoder) Read(p []byte) (int, error) {
 			return 0, err
 		}
 		// Read the length and chunk type of the next chunk, and check that
-		// it is an IDAT chunk.
+		// it is an IDAT (or fdAT) chunk.
 		if _, err := io.ReadFull(d.r, d.tmp[:8]); err != nil {
 			return 0, err
 		}
 		d.idatLength = binary.BigEndian.Uint32(d.tmp[:4])
-		if string(d.tmp[4:8]) != "IDAT" {
+		chunk := "IDAT"
+		if d.fdAT {
+			chunk = "fdAT"
+		}
+		if string(d.tmp[4:8]) != chunk {
 			return 0, FormatError("not enough pixel data")
 		}
 		d.crc.Reset()
 		d.crc.Write(d.tmp[4:8])
+		if d.fdAT {
+			if d.idatLength < 4 {
+				return 0, FormatError("bad fdAT length")
+			}
+			if err := d.readSequenceNumber(); err != nil {
+				return 0, err
+			}
+			d.idatLength -= 4
+		}
 	}
 	if int(d.idatLength) < 0 {
 		return 0, UnsupportedError("IDAT chunk length overflow")
@@ -857,6 +880,11 @@ func (d *decoder) parseIDAT(length uint32) (err error) {
 	if err != nil {
 		return err
 	}
+	if d.frame != nil {
+		d.addFrame(d.img)
+	} else if d.anim != nil {
+		d.anim.Default = d.img
+	}
 	return d.verifyChecksum()
 }
 
@@ -877,7 +905,7 @@ func (d *decoder) parseChunk(configOnly bool) error {
 	d.crc.Write(d.tmp[4:8])
 
 	// Read the chunk data.
-	switch string(d.tmp[4:8]) {
+	switch chunk := string(d.tmp[4:8]); chunk {
 	case "IHDR":
 		if d.stage != dsStart {
 			return chunkOrderError
@@ -926,6 +954,32 @@ func (d *decoder) parseChunk(configOnly bool) error {
 		}
 		d.stage = dsSeenIEND
 		return d.parseIEND(length)
+	case "acTL":
+		// An acTL chunk after the IDAT chunks does not make an animated
+		// PNG, and without an acTL chunk, fcTL and fdAT chunks are
+		// ignored.
+		if d.anim == nil || d.stage >= dsSeenIDAT || d.numFrames != 0 {
+			break
+		}
+		return d.parseacTL(length)
+	case "fcTL":
+		if d.numFrames == 0 {
+			break
+		}
+		return d.parsefcTL(length)
+	case "fdAT":
+		if d.numFrames == 0 {
+			break
+		}
+		if d.stage != dsSeenIDAT || d.frame == nil {
+			return chunkOrderError
+		}
+		return d.parsefdAT(length)
+	case "gAMA", "cHRM", "sRGB", "iCCP", "eXIf", "tEXt", "zTXt", "iTXt":
+		if d.meta == nil || d.stage == dsStart {
+			break
+		}
+		return d.parseMetadata(chunk, length)
 	}
 	if length > 0x7fffffff {
 		return FormatError(fmt.Sprintf("Bad chunk length: %d", length))
@@ -1021,6 +1075,15 @@ func DecodeConfig(r io.Reader) (image.Config, error) {
 		}
 	}
 
+	return image.Config{
+		ColorModel: d.colorModel(),
+		Width:      d.width,
+		Height:     d.height,
+	}, nil
+}
+
+// colorModel returns the color model of the images that d decodes.
+func (d *decoder) colorModel() color.Model {
 	var cm color.Model
 	switch d.cb {
 	case cbG1, cbG2, cbG4, cbG8:
@@ -1042,11 +1105,7 @@ func DecodeConfig(r io.Reader) (image.Config, error) {
 	case cbTCA16:
 		cm = color.NRGBA64Model
 	}
-	return image.Config{
-		ColorModel: cm,
-		Width:      d.width,
-		Height:     d.height,
-	}, nil
+	return cm
 }
 
 func init() {
@@ -22,6 +22,9 @@ type Encoder struct {
 	// BufferPool optionally specifies a buffer pool to get temporary
 	// EncoderBuffers when encoding an image.
 	BufferPool EncoderBufferPool
+
+	// Metadata optionally specifies ancillary chunks to write.
+	Metadata *Metadata
 }
 
 // EncoderBufferPool is an interface for getting and returning temporary
@@ -49,6 +52,8 @@ type encoder struct {
 	zw      *zlib.Writer
 	zwLevel int
 	bw      *bufio.Writer
+	seq     uint32 // Next sequence number of fcTL and fdAT chunks
+	fdAT    bool   // Whether Write writes fdAT chunks, not IDAT chunks
 }
 
 // CompressionLevel indicates the compression level.
@@ -188,14 +193,19 @@ func (e *encoder) writePLTEAndTRNS(p color.Palette) {
 	}
 }
 
-// An encoder is an io.Writer that satisfies writes by writing PNG IDAT chunks,
-// including an 8-byte header and 4-byte CRC checksum per Write call. Such calls
-// should be relatively infrequent, since writeIDATs uses a [bufio.Writer].
+// An encoder is an io.Writer that satisfies writes by writing PNG IDAT chunks
+// (or fdAT chunks, if e.fdAT is set), including an 8-byte header and 4-byte
+// CRC checksum per Write call. Such calls should be relatively infrequent,
+// since writeIDATs uses a [bufio.Writer].
 //
 // This method should only be called from writeIDATs (via writeImage).
 // No other code should treat an encoder as an io.Writer.
 func (e *encoder) Write(b []byte) (int, error) {
-	e.writeChunk(b, "IDAT")
+	if e.fdAT {
+		e.writefdAT(b)
+	} else {
+		e.writeChunk(b, "IDAT")
+	}
 	if e.err != nil {
 		return 0, e.err
 	}
@@ -588,6 +598,10 @@ func Encode(w io.Writer, m image.Image) error {
 
 // Encode writes the Image m to w in PNG format.
 func (enc *Encoder) Encode(w io.Writer, m image.Image) error {
+	return enc.encode(w, m, enc.Metadata)
+}
+
+func (enc *Encoder) encode(w io.Writer, m image.Image, md *Metadata) error {
 	// Obviously, negative widths and heights are invalid. Furthermore, the PNG
 	// spec section 11.2.2 says that zero is invalid. Excessively large images are
 	// also rejected.
@@ -596,65 +610,83 @@ func (enc *Encoder) Encode(w io.Writer, m image.Image) error {
 		return FormatError("invalid image size: " + strconv.FormatInt(mw, 10) + "x" + strconv.FormatInt(mh, 10))
 	}
 
-	var e *encoder
+	e, put := enc.newEncoder(w)
+	defer put()
+	e.m = m
+
+	var pal color.Palette
+	e.cb, pal = colorBits(m)
+
+	_, e.err = io.WriteString(w, pngHeader)
+	e.writeIHDR()
+	e.writeMetadata(md)
+	if pal != nil {
+		e.writePLTEAndTRNS(pal)
+	}
+	e.writeIDATs()
+	e.writeIEND()
+	return e.err
+}
+
+// newEncoder returns an encoder that writes to w, from enc.BufferPool if
+// set, and a function that returns it to the pool.
+func (enc *Encoder) newEncoder(w io.Writer) (e *encoder, put func()) {
 	if enc.BufferPool != nil {
 		buffer := enc.BufferPool.Get()
 		e = (*encoder)(buffer)
-
 	}
 	if e == nil {
 		e = &encoder{}
 	}
+	put = func() {}
 	if enc.BufferPool != nil {
-		defer enc.BufferPool.Put((*EncoderBuffer)(e))
+		put = func() { enc.BufferPool.Put((*EncoderBuffer)(e)) }
 	}
 
 	e.enc = enc
 	e.w = w
-	e.m = m
+	e.err = nil
+	e.seq = 0
+	e.fdAT = false
+	return e, put
+}
 
-	var pal color.Palette
+// colorBits returns the combination of color type and bit depth that m is
+// encoded with, and its palette if that is paletted.
+func colorBits(m image.Image) (cb int, pal color.Palette) {
 	// cbP8 encoding needs PalettedImage's ColorIndexAt method.
 	if _, ok := m.(image.PalettedImage); ok {
 		pal, _ = m.ColorModel().(color.Palette)
 	}
 	if pal != nil {
 		if len(pal) <= 2 {
-			e.cb = cbP1
+			cb = cbP1
 		} else if len(pal) <= 4 {
-			e.cb = cbP2
+			cb = cbP2
 		} else if len(pal) <= 16 {
-			e.cb = cbP4
+			cb = cbP4
 		} else {
-			e.cb = cbP8
+			cb = cbP8
 		}
-	} else {
-		switch m.ColorModel() {
-		case color.GrayModel:
-			e.cb = cbG8
-		case color.Gray16Model:
-			e.cb = cbG16
-		case color.RGBAModel, color.NRGBAModel, color.AlphaModel:
-			if opaque(m) {
-				e.cb = cbTC8
-			} else {
-				e.cb = cbTCA8
-			}
-		default:
-			if opaque(m) {
-				e.cb = cbTC16
-			} else {
-				e.cb = cbTCA16
-			}
+		return cb, pal
+	}
+	switch m.ColorModel() {
+	case color.GrayModel:
+		cb = cbG8
+	case color.Gray16Model:
+		cb = cbG16
+	case color.RGBAModel, color.NRGBAModel, color.AlphaModel:
+		if opaque(m) {
+			cb = cbTC8
+		} else {
+			cb = cbTCA8
+		}
+	default:
+		if opaque(m) {
+			cb = cbTC16
+		} else {
+			cb = cbTCA16
 		}
 	}
-
-	_, e.err = io.WriteString(w, pngHeader)
-	e.writeIHDR()
-	if pal != nil {
-		e.writePLTEAndTRNS(pal)
-	}
-	e.writeIDATs()
-	e.writeIEND()
-	return e.err
+	return cb, nil
 }
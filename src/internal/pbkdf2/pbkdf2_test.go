@@ -0,0 +1,44 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pbkdf2
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/hex"
+	"hash"
+	"testing"
+)
+
+var pbkdf2Tests = []struct {
+	h        func() hash.Hash
+	password string
+	salt     string
+	iter     int
+	out      string
+}{
+	// RFC 6070, Section 2.
+	{sha1.New, "password", "salt", 1, "0c60c80f961f0e71f3a9b524af6012062fe037a6"},
+	{sha1.New, "password", "salt", 2, "ea6c014dc72d6f8ccd1ed92ace1d41f0d8de8957"},
+	{sha1.New, "password", "salt", 4096, "4b007901b765489abead49d926f721d065a429c1"},
+	{sha1.New, "passwordPASSWORDpassword", "saltSALTsaltSALTsaltSALTsaltSALTsalt", 4096,
+		"3d2eec4fe41c849b80c8d83662c0e44a8b291a964cf2f07038"},
+	{sha1.New, "pass\x00word", "sa\x00lt", 4096, "56fa6aa75548099dcc37d7f03425e0c3"},
+	// Generated with Python's hashlib.pbkdf2_hmac.
+	{sha256.New, "password", "salt", 1, "120fb6cffcf8b32c43e7225256c4f837a86548c9"},
+	{sha256.New, "password", "salt", 4096,
+		"c5e478d59288c841aa530db6845c4c8d962893a001ce4e11a4963873aa98134a"},
+}
+
+func TestKey(t *testing.T) {
+	for i, tt := range pbkdf2Tests {
+		want, _ := hex.DecodeString(tt.out)
+		got := Key(tt.h, []byte(tt.password), []byte(tt.salt), tt.iter, len(want))
+		if !bytes.Equal(got, want) {
+			t.Errorf("#%d: Key = %x, want %x", i, got, want)
+		}
+	}
+}
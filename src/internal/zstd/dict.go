@@ -0,0 +1,171 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package zstd
+
+import (
+	"encoding/binary"
+	"errors"
+)
+
+// dictMagic is the magic number that starts a dictionary
+// in the zstd dictionary format. RFC 5.
+const dictMagic = 0xec30a437
+
+// A Dict is a dictionary that may be used to decompress frames.
+// A Dict is immutable and may be shared by multiple Readers.
+type Dict struct {
+	id      uint32
+	content []byte
+
+	// The initial repeated offsets.
+	repeatedOffsets [3]uint32
+
+	// Entropy tables, only present for formatted dictionaries.
+	hasTables        bool
+	huffmanTable     []uint16
+	huffmanTableBits int
+	seqTables        [3][]fseBaselineEntry
+	seqTableBits     [3]uint8
+}
+
+// ParseDict parses a dictionary.
+// If b starts with the zstd dictionary magic number it is parsed
+// as a formatted dictionary as described by RFC 8878 section 5.
+// Otherwise b is used as raw content, with a dictionary ID of zero.
+// The returned Dict retains b.
+func ParseDict(b []byte) (*Dict, error) {
+	if len(b) < 8 || binary.LittleEndian.Uint32(b) != dictMagic {
+		return &Dict{
+			content:         b,
+			repeatedOffsets: [3]uint32{1, 4, 8},
+		}, nil
+	}
+
+	d := &Dict{
+		id:        binary.LittleEndian.Uint32(b[4:]),
+		hasTables: true,
+	}
+	if d.id == 0 {
+		return nil, errors.New("zstd: dictionary ID must not be zero")
+	}
+
+	// Use a temporary Reader to decode the entropy tables,
+	// which have the same format as in a compressed block.
+	r := new(Reader)
+	data := block(b)
+	off := 8
+
+	d.huffmanTable = make([]uint16, 1<<maxHuffmanBits)
+	tableBits, off, err := r.readHuff(data, off, d.huffmanTable)
+	if err != nil {
+		return nil, dictError(err)
+	}
+	d.huffmanTableBits = tableBits
+
+	// The sequence tables appear in the order offsets,
+	// match lengths, literal lengths.
+	for _, kind := range [...]seqCode{seqOffset, seqMatch, seqLiteral} {
+		info := &seqCodeInfo[kind]
+		scratch := make([]fseEntry, 1<<info.maxBits)
+		tableBits, roff, err := r.readFSE(data, off, info.maxSym, info.maxBits, scratch)
+		if err != nil {
+			return nil, dictError(err)
+		}
+		table := make([]fseBaselineEntry, 1<<tableBits)
+		if err := info.toBaseline(r, roff, scratch[:1<<tableBits], table); err != nil {
+			return nil, dictError(err)
+		}
+		d.seqTables[kind] = table
+		d.seqTableBits[kind] = uint8(tableBits)
+		off = roff
+	}
+
+	if len(b)-off < 12 {
+		return nil, errors.New("zstd: truncated dictionary")
+	}
+	d.content = b[off+12:]
+	for i := range d.repeatedOffsets {
+		o := binary.LittleEndian.Uint32(b[off+4*i:])
+		if o == 0 || o > uint32(len(d.content)) {
+			return nil, errors.New("zstd: invalid dictionary repeated offset")
+		}
+		d.repeatedOffsets[i] = o
+	}
+	return d, nil
+}
+
+// dictError converts an error from decoding the entropy tables of
+// a dictionary into a dictionary error. The block offsets of
+// the temporary Reader are not meaningful to the caller.
+func dictError(err error) error {
+	if ze, ok := err.(*zstdError); ok {
+		err = ze.err
+	}
+	return errors.New("zstd: invalid dictionary: " + err.Error())
+}
+
+// ID returns the dictionary ID. It is zero for a raw content dictionary.
+func (d *Dict) ID() uint32 {
+	return d.id
+}
+
+// Content returns the dictionary content, which is used as history
+// preceding the first block of a frame. The caller must not modify it.
+func (d *Dict) Content() []byte {
+	return d.content
+}
+
+// RepeatedOffsets returns the repeated offsets in effect at the
+// start of a frame that uses the dictionary.
+func (d *Dict) RepeatedOffsets() [3]uint32 {
+	return d.repeatedOffsets
+}
+
+// SetDicts sets the dictionaries available to decompress frames.
+// A frame whose header names a dictionary ID uses the dictionary
+// with that ID. A frame without a dictionary ID uses the first
+// dictionary, if any. The dictionaries are retained across calls to Reset.
+func (r *Reader) SetDicts(dicts ...*Dict) {
+	r.dicts = append(r.dicts[:0], dicts...)
+}
+
+// findDict returns the dictionary to use for a frame with the given
+// dictionary ID, or nil if none.
+func (r *Reader) findDict(id uint32) *Dict {
+	if id == 0 {
+		if len(r.dicts) > 0 {
+			return r.dicts[0]
+		}
+		return nil
+	}
+	for _, d := range r.dicts {
+		if d.id == id {
+			return d
+		}
+	}
+	return nil
+}
+
+// useDict prepares the Reader state to decompress a frame using d.
+// This is called after the window has been reset for the frame.
+func (r *Reader) useDict(d *Dict, windowSize int) {
+	r.window.reset(windowSize + len(d.content))
+	r.window.save(d.content)
+	r.repeatedOffset1 = d.repeatedOffsets[0]
+	r.repeatedOffset2 = d.repeatedOffsets[1]
+	r.repeatedOffset3 = d.repeatedOffsets[2]
+	if !d.hasTables {
+		return
+	}
+	if len(r.huffmanTable) < 1<<maxHuffmanBits {
+		r.huffmanTable = make([]uint16, 1<<maxHuffmanBits)
+	}
+	copy(r.huffmanTable, d.huffmanTable[:1<<d.huffmanTableBits])
+	r.huffmanTableBits = d.huffmanTableBits
+	// The tables are never modified while decoding,
+	// as new tables are built in r.seqTableBuffers.
+	r.seqTables = d.seqTables
+	r.seqTableBits = d.seqTableBits
+}
@@ -146,3 +146,27 @@ func (xh *xxhash64) mergeRound(v, n uint64) uint64 {
 	v = v*xxhPrime64c1 + xxhPrime64c4
 	return v
 }
+
+// XXHash64 computes the xxHash-64 checksum with a seed of 0,
+// the low 32 bits of which are used as the zstd frame checksum.
+// It is exported for use by encoders.
+// Reset must be called before the first Write.
+type XXHash64 struct {
+	xh xxhash64
+}
+
+// Reset discards the current state and prepares to compute a new hash.
+func (h *XXHash64) Reset() {
+	h.xh.reset()
+}
+
+// Write adds b to the hash. It never returns an error.
+func (h *XXHash64) Write(b []byte) (int, error) {
+	h.xh.update(b)
+	return len(b), nil
+}
+
+// Sum64 returns the hash of the data written since the last Reset.
+func (h *XXHash64) Sum64() uint64 {
+	return h.xh.digest()
+}
@@ -3,7 +3,7 @@
 // license that can be found in the LICENSE file.
 
 // Package zstd provides a decompressor for zstd streams,
-// described in RFC 8878. It does not support dictionaries.
+// described in RFC 8878.
 package zstd
 
 import (
@@ -82,6 +82,9 @@ type Reader struct {
 
 	// For checksum computation.
 	checksum xxhash64
+
+	// Dictionaries available for decompression.
+	dicts []*Dict
 }
 
 // NewReader creates a new Reader that decompresses data from the given reader.
@@ -119,6 +122,7 @@ func (r *Reader) Reset(input io.Reader) {
 	// seqTableBuffers
 	// scratch
 	// fseScratch
+	// dicts
 }
 
 // Read implements [io.Reader].
@@ -255,14 +259,13 @@ retry:
 	}
 
 	// Dictionary_ID. RFC 3.1.1.1.3.
-	if dictionaryIdSize != 0 {
-		dictionaryId := r.scratch[windowDescriptorSize : windowDescriptorSize+dictionaryIdSize]
-		// Allow only zero Dictionary ID.
-		for _, b := range dictionaryId {
-			if b != 0 {
-				return r.makeError(relativeOffset, "dictionaries are not supported")
-			}
-		}
+	var dictionaryId uint32
+	for i, b := range r.scratch[windowDescriptorSize : windowDescriptorSize+dictionaryIdSize] {
+		dictionaryId |= uint32(b) << (8 * i)
+	}
+	dict := r.findDict(dictionaryId)
+	if dict == nil && dictionaryId != 0 {
+		return r.makeError(relativeOffset, fmt.Sprintf("unknown dictionary ID %d", dictionaryId))
 	}
 
 	// Frame_Content_Size. RFC 3.1.1.1.4.
@@ -312,6 +315,9 @@ retry:
 	r.seqTables[0] = nil
 	r.seqTables[1] = nil
 	r.seqTables[2] = nil
+	if dict != nil {
+		r.useDict(dict, int(windowSize))
+	}
 
 	return nil
 }
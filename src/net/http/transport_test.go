@@ -1014,13 +1014,23 @@ func testTransportHeadChunkedResponse(t *testing.T, mode testMode) {
 	}
 }
 
+// defaultAcceptEncoding returns the Accept-Encoding header that the
+// Transport adds to requests in the given mode. The HTTP/2 transport,
+// bundled from golang.org/x/net/http2, does not request zstd yet.
+func defaultAcceptEncoding(mode testMode) string {
+	if mode == http2Mode {
+		return "gzip"
+	}
+	return "gzip, zstd"
+}
+
 var roundTripTests = []struct {
 	accept       string
-	expectAccept string
+	expectAccept string // empty for defaultAcceptEncoding
 	compressed   bool
 }{
 	// Requests with no accept-encoding header use transparent compression
-	{"", "gzip", false},
+	{"", "", false},
 	// Requests with other accept-encoding should pass through unmodified
 	{"foo", "foo", false},
 	// Requests with accept-encoding == gzip should be passed through
@@ -1037,7 +1047,7 @@ func testRoundTripGzip(t *testing.T, mode testMode) {
 			t.Errorf("in handler, test %v: Accept-Encoding = %q, want %q",
 				req.FormValue("testnum"), accept, expect)
 		}
-		if accept == "gzip" {
+		if accept == "gzip" || accept == "gzip, zstd" {
 			rw.Header().Set("Content-Encoding", "gzip")
 			gz := gzip.NewWriter(rw)
 			gz.Write([]byte(responseBody))
@@ -1050,8 +1060,12 @@ func testRoundTripGzip(t *testing.T, mode testMode) {
 	tr := ts.Client().Transport.(*Transport)
 
 	for i, test := range roundTripTests {
+		expectAccept := test.expectAccept
+		if expectAccept == "" {
+			expectAccept = defaultAcceptEncoding(mode)
+		}
 		// Test basic request (no accept-encoding)
-		req, _ := NewRequest("GET", fmt.Sprintf("%s/?testnum=%d&expect_accept=%s", ts.URL, i, test.expectAccept), nil)
+		req, _ := NewRequest("GET", fmt.Sprintf("%s/?testnum=%d&expect_accept=%s", ts.URL, i, url.QueryEscape(expectAccept)), nil)
 		if test.accept != "" {
 			req.Header.Set("Accept-Encoding", test.accept)
 		}
@@ -1104,7 +1118,7 @@ func testTransportGzip(t *testing.T, mode testMode) {
 			}
 			return
 		}
-		if g, e := req.Header.Get("Accept-Encoding"), "gzip"; g != e {
+		if g, e := req.Header.Get("Accept-Encoding"), "gzip, zstd"; g != e {
 			t.Errorf("Accept-Encoding = %q, want %q", g, e)
 		}
 		rw.Header().Set("Content-Encoding", "gzip")
@@ -3799,7 +3813,7 @@ func testRetryRequestsOnError(t *testing.T, mode testMode) {
 			req: func() *Request {
 				return newRequest("GET", "http://fake.golang", nil)
 			},
-			reqString: `GET / HTTP/1.1\r\nHost: fake.golang\r\nUser-Agent: Go-http-client/1.1\r\nAccept-Encoding: gzip\r\n\r\n`,
+			reqString: `GET / HTTP/1.1\r\nHost: fake.golang\r\nUser-Agent: Go-http-client/1.1\r\nAccept-Encoding: gzip, zstd\r\n\r\n`,
 		},
 		{
 			name: "IdempotentGetBodySomeWritten",
@@ -3811,7 +3825,7 @@ func testRetryRequestsOnError(t *testing.T, mode testMode) {
 			req: func() *Request {
 				return newRequest("GET", "http://fake.golang", strings.NewReader("foo\n"))
 			},
-			reqString: `GET / HTTP/1.1\r\nHost: fake.golang\r\nUser-Agent: Go-http-client/1.1\r\nContent-Length: 4\r\nAccept-Encoding: gzip\r\n\r\nfoo\n`,
+			reqString: `GET / HTTP/1.1\r\nHost: fake.golang\r\nUser-Agent: Go-http-client/1.1\r\nContent-Length: 4\r\nAccept-Encoding: gzip, zstd\r\n\r\nfoo\n`,
 		},
 		{
 			name: "NothingWrittenNoBody",
@@ -3822,7 +3836,7 @@ func testRetryRequestsOnError(t *testing.T, mode testMode) {
 			req: func() *Request {
 				return newRequest("DELETE", "http://fake.golang", nil)
 			},
-			reqString: `DELETE / HTTP/1.1\r\nHost: fake.golang\r\nUser-Agent: Go-http-client/1.1\r\nAccept-Encoding: gzip\r\n\r\n`,
+			reqString: `DELETE / HTTP/1.1\r\nHost: fake.golang\r\nUser-Agent: Go-http-client/1.1\r\nAccept-Encoding: gzip, zstd\r\n\r\n`,
 		},
 		{
 			name: "NothingWrittenGetBody",
@@ -3835,7 +3849,7 @@ func testRetryRequestsOnError(t *testing.T, mode testMode) {
 			req: func() *Request {
 				return newRequest("POST", "http://fake.golang", strings.NewReader("foo\n"))
 			},
-			reqString: `POST / HTTP/1.1\r\nHost: fake.golang\r\nUser-Agent: Go-http-client/1.1\r\nContent-Length: 4\r\nAccept-Encoding: gzip\r\n\r\nfoo\n`,
+			reqString: `POST / HTTP/1.1\r\nHost: fake.golang\r\nUser-Agent: Go-http-client/1.1\r\nContent-Length: 4\r\nAccept-Encoding: gzip, zstd\r\n\r\nfoo\n`,
 		},
 	}
 
@@ -4448,7 +4462,7 @@ func TestTransportFlushesBodyChunks(t *testing.T) {
 	defer res.Body.Close()
 
 	want := []string{
-		"POST / HTTP/1.1\r\nHost: localhost:8080\r\nUser-Agent: x\r\nTransfer-Encoding: chunked\r\nAccept-Encoding: gzip\r\n\r\n",
+		"POST / HTTP/1.1\r\nHost: localhost:8080\r\nUser-Agent: x\r\nTransfer-Encoding: chunked\r\nAccept-Encoding: gzip, zstd\r\n\r\n",
 		"5\r\nnum0\n\r\n",
 		"5\r\nnum1\n\r\n",
 		"5\r\nnum2\n\r\n",
@@ -5013,7 +5027,7 @@ func testTransportEventTrace(t *testing.T, mode testMode, noHooks bool) {
 		wantOnce(fmt.Sprintf("WroteHeaderField: Host: [dns-is-faked.golang:%s]", port))
 		wantOnce(fmt.Sprintf("WroteHeaderField: Content-Length: [%d]", len(body)))
 		wantOnce("WroteHeaderField: X-Foo-Multiple-Vals: [bar baz]")
-		wantOnce("WroteHeaderField: Accept-Encoding: [gzip]")
+		wantOnce("WroteHeaderField: Accept-Encoding: [gzip, zstd]")
 	}
 	wantOnce("WroteHeaders")
 	wantOnce("Wait100Continue")